@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
@@ -622,3 +624,132 @@ func executeStrategyFromConfig(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+var optimiseStrategyFromFileCommand = &cli.Command{
+	Name:      "optimisestrategyfromfile",
+	Usage:     "runs the strategy from a config file once per parameter set and ranks the results. Increase the timeout for large searches",
+	ArgsUsage: "<path>",
+	Action:    optimiseStrategyFromFile,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "path",
+			Aliases: []string{"p"},
+			Usage:   "the filepath to a strategy to optimise",
+		},
+		&cli.StringFlag{
+			Name:  "method",
+			Usage: "overrides the optimiser method: 'grid', 'random' or 'walk-forward'",
+		},
+		&cli.StringFlag{
+			Name:  "metric",
+			Usage: "overrides the ranking metric: 'sharpe', 'sortino', 'calmar' or 'max-drawdown'",
+		},
+		&cli.StringSliceFlag{
+			Name:  "parameter",
+			Usage: "overrides the parameter ranges in the format 'key:minimum:maximum:step', eg 'rsi-period:10:20:2'. Can be set multiple times",
+		},
+		&cli.Int64Flag{
+			Name:  "randomiterations",
+			Usage: "the amount of parameter sets to sample for a random search",
+		},
+		&cli.Int64Flag{
+			Name:  "randomseed",
+			Usage: "the seed for a random search, allows a search to be repeated",
+		},
+		&cli.Int64Flag{
+			Name:  "maxparallel",
+			Usage: "the maximum amount of tasks to run at once",
+		},
+		&cli.StringFlag{
+			Name:  "searchmethod",
+			Usage: "the walk forward in-sample search method: 'grid' or 'random'",
+		},
+		&cli.DurationFlag{
+			Name:  "insample",
+			Usage: "the walk forward in-sample window. eg '720h' for 30 days",
+		},
+		&cli.DurationFlag{
+			Name:  "outofsample",
+			Usage: "the walk forward out-of-sample window. eg '168h' for 7 days",
+		},
+	},
+}
+
+func optimiseStrategyFromFile(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	params := c.StringSlice("parameter")
+	parameters := make([]*btrpc.OptimiserParameterRange, len(params))
+	for i := range params {
+		p, err := parseParameterRange(params[i])
+		if err != nil {
+			return err
+		}
+		parameters[i] = p
+	}
+
+	if c.Duration("insample") < 0 || c.Duration("outofsample") < 0 {
+		return errors.New("walk forward windows cannot be less than 0")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.OptimiseStrategyFromFile(
+		c.Context,
+		&btrpc.OptimiseStrategyFromFileRequest{
+			StrategyFilePath:        path,
+			Method:                  c.String("method"),
+			Metric:                  c.String("metric"),
+			Parameters:              parameters,
+			RandomIterations:        c.Int64("randomiterations"),
+			RandomSeed:              c.Int64("randomseed"),
+			MaximumParallelTasks:    c.Int64("maxparallel"),
+			WalkForwardSearchMethod: c.String("searchmethod"),
+			InSampleWindow:          durationpb.New(c.Duration("insample")),
+			OutOfSampleWindow:       durationpb.New(c.Duration("outofsample")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// parseParameterRange converts 'key:minimum:maximum:step' into a parameter range.
+// The step is optional
+func parseParameterRange(s string) (*btrpc.OptimiserParameterRange, error) {
+	fields := strings.Split(s, ":")
+	if len(fields) < 3 || len(fields) > 4 || fields[0] == "" {
+		return nil, fmt.Errorf("invalid parameter '%v', expected 'key:minimum:maximum:step'", s)
+	}
+	values := make([]float64, 3)
+	for i := 1; i < len(fields); i++ {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter '%v': %w", s, err)
+		}
+		values[i-1] = v
+	}
+	return &btrpc.OptimiserParameterRange{
+		Key:     fields[0],
+		Minimum: values[0],
+		Maximum: values[1],
+		Step:    values[2],
+	}, nil
+}
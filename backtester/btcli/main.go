@@ -120,6 +120,7 @@ func main() {
 		stopAllTasksCommand,
 		clearTaskCommand,
 		clearAllTasksCommand,
+		optimiseStrategyFromFileCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
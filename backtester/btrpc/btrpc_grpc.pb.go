@@ -28,6 +28,7 @@ const (
 	BacktesterService_StopAllTasks_FullMethodName              = "/btrpc.BacktesterService/StopAllTasks"
 	BacktesterService_ClearTask_FullMethodName                 = "/btrpc.BacktesterService/ClearTask"
 	BacktesterService_ClearAllTasks_FullMethodName             = "/btrpc.BacktesterService/ClearAllTasks"
+	BacktesterService_OptimiseStrategyFromFile_FullMethodName  = "/btrpc.BacktesterService/OptimiseStrategyFromFile"
 )
 
 // BacktesterServiceClient is the client API for BacktesterService service.
@@ -43,6 +44,7 @@ type BacktesterServiceClient interface {
 	StopAllTasks(ctx context.Context, in *StopAllTasksRequest, opts ...grpc.CallOption) (*StopAllTasksResponse, error)
 	ClearTask(ctx context.Context, in *ClearTaskRequest, opts ...grpc.CallOption) (*ClearTaskResponse, error)
 	ClearAllTasks(ctx context.Context, in *ClearAllTasksRequest, opts ...grpc.CallOption) (*ClearAllTasksResponse, error)
+	OptimiseStrategyFromFile(ctx context.Context, in *OptimiseStrategyFromFileRequest, opts ...grpc.CallOption) (*OptimiseStrategyResponse, error)
 }
 
 type backtesterServiceClient struct {
@@ -143,6 +145,16 @@ func (c *backtesterServiceClient) ClearAllTasks(ctx context.Context, in *ClearAl
 	return out, nil
 }
 
+func (c *backtesterServiceClient) OptimiseStrategyFromFile(ctx context.Context, in *OptimiseStrategyFromFileRequest, opts ...grpc.CallOption) (*OptimiseStrategyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimiseStrategyResponse)
+	err := c.cc.Invoke(ctx, BacktesterService_OptimiseStrategyFromFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BacktesterServiceServer is the server API for BacktesterService service.
 // All implementations must embed UnimplementedBacktesterServiceServer
 // for forward compatibility
@@ -156,6 +168,7 @@ type BacktesterServiceServer interface {
 	StopAllTasks(context.Context, *StopAllTasksRequest) (*StopAllTasksResponse, error)
 	ClearTask(context.Context, *ClearTaskRequest) (*ClearTaskResponse, error)
 	ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error)
+	OptimiseStrategyFromFile(context.Context, *OptimiseStrategyFromFileRequest) (*OptimiseStrategyResponse, error)
 	mustEmbedUnimplementedBacktesterServiceServer()
 }
 
@@ -190,6 +203,9 @@ func (UnimplementedBacktesterServiceServer) ClearTask(context.Context, *ClearTas
 func (UnimplementedBacktesterServiceServer) ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAllTasks not implemented")
 }
+func (UnimplementedBacktesterServiceServer) OptimiseStrategyFromFile(context.Context, *OptimiseStrategyFromFileRequest) (*OptimiseStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimiseStrategyFromFile not implemented")
+}
 func (UnimplementedBacktesterServiceServer) mustEmbedUnimplementedBacktesterServiceServer() {}
 
 // UnsafeBacktesterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -365,6 +381,24 @@ func _BacktesterService_ClearAllTasks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktesterService_OptimiseStrategyFromFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimiseStrategyFromFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).OptimiseStrategyFromFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktesterService_OptimiseStrategyFromFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).OptimiseStrategyFromFile(ctx, req.(*OptimiseStrategyFromFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktesterService_ServiceDesc is the grpc.ServiceDesc for BacktesterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -408,6 +442,10 @@ var BacktesterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAllTasks",
 			Handler:    _BacktesterService_ClearAllTasks_Handler,
 		},
+		{
+			MethodName: "OptimiseStrategyFromFile",
+			Handler:    _BacktesterService_OptimiseStrategyFromFile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "btrpc.proto",
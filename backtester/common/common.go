@@ -22,6 +22,8 @@ func DataTypeToInt(dataType string) (int64, error) {
 		return DataCandle, nil
 	case TradeStr:
 		return DataTrade, nil
+	case OrderbookStr:
+		return DataOrderbook, nil
 	default:
 		return 0, fmt.Errorf("unrecognised dataType '%v'", dataType)
 	}
@@ -126,6 +128,10 @@ func RegisterBacktesterSubLoggers() error {
 	if err != nil {
 		return err
 	}
+	Optimiser, err = log.NewSubLogger("Optimiser")
+	if err != nil {
+		return err
+	}
 
 	// Set to existing registered sub-loggers
 	Config = log.ConfigMgr
@@ -50,7 +50,68 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
-	return c.validateMinMaxes()
+	err = c.validateMinMaxes()
+	if err != nil {
+		return err
+	}
+	if c.OptimiserSettings != nil {
+		return c.ValidateOptimiserSettings()
+	}
+	return nil
+}
+
+// ValidateOptimiserSettings ensures the optimiser settings can be used to
+// search the strategy's custom settings
+func (c *Config) ValidateOptimiserSettings() error {
+	if c.OptimiserSettings == nil {
+		return fmt.Errorf("%w optimiser settings", gctcommon.ErrNilPointer)
+	}
+	o := c.OptimiserSettings
+	switch o.Metric {
+	case OptimiserMetricSharpe, OptimiserMetricSortino, OptimiserMetricCalmar, OptimiserMetricMaxDrawdown:
+	default:
+		return fmt.Errorf("%w '%v'", errUnsupportedOptimiserMetric, o.Metric)
+	}
+	if len(o.Parameters) == 0 {
+		return errNoOptimiserParameters
+	}
+	keys := make(map[string]bool, len(o.Parameters))
+	for i := range o.Parameters {
+		p := o.Parameters[i]
+		if p.Key == "" || keys[p.Key] {
+			return fmt.Errorf("%w key '%v' must be set and unique", errInvalidParameterRange, p.Key)
+		}
+		keys[p.Key] = true
+		if p.Maximum < p.Minimum || p.Step < 0 {
+			return fmt.Errorf("%w %v minimum %v maximum %v step %v", errInvalidParameterRange, p.Key, p.Minimum, p.Maximum, p.Step)
+		}
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w live data", errFeatureIncompatible)
+	}
+	searchMethod := o.Method
+	if o.Method == OptimiserWalkForward {
+		if o.WalkForward == nil {
+			return fmt.Errorf("%w %w walk forward settings", errInvalidWalkForwardSettings, gctcommon.ErrNilPointer)
+		}
+		if o.WalkForward.InSampleWindow <= 0 || o.WalkForward.OutOfSampleWindow <= 0 {
+			return fmt.Errorf("%w in-sample and out-of-sample windows must be greater than zero", errInvalidWalkForwardSettings)
+		}
+		if c.DataSettings.APIData == nil && c.DataSettings.DatabaseData == nil {
+			return fmt.Errorf("%w walk forward requires api or database data", errInvalidWalkForwardSettings)
+		}
+		searchMethod = o.WalkForward.SearchMethod
+	}
+	switch searchMethod {
+	case OptimiserGridSearch:
+	case OptimiserRandomSearch:
+		if o.RandomIterations <= 0 {
+			return errInvalidRandomIterations
+		}
+	default:
+		return fmt.Errorf("%w '%v'", errUnsupportedOptimiserMethod, searchMethod)
+	}
+	return nil
 }
 
 // validate ensures no one sets bad config values on purpose
@@ -155,13 +216,20 @@ func (c *Config) validateCurrencySettings() error {
 	}
 	var hasFutures, hasSlippage bool
 	for i := range c.CurrencySettings {
-		if c.CurrencySettings[i].Asset == asset.PerpetualSwap ||
-			c.CurrencySettings[i].Asset == asset.PerpetualContract {
+		hasFundingRates := c.CurrencySettings[i].FuturesDetails != nil &&
+			c.CurrencySettings[i].FuturesDetails.FundingRates != nil
+		if hasFundingRates {
+			if err := c.validateFundingRates(&c.CurrencySettings[i]); err != nil {
+				return err
+			}
+		}
+		if (c.CurrencySettings[i].Asset == asset.PerpetualSwap ||
+			c.CurrencySettings[i].Asset == asset.PerpetualContract) && !hasFundingRates {
 			return errPerpetualsUnsupported
 		}
 		if c.CurrencySettings[i].Asset.IsFutures() {
 			hasFutures = true
-			if c.CurrencySettings[i].Quote.String() == "PERP" || c.CurrencySettings[i].Base.String() == "PI" {
+			if (c.CurrencySettings[i].Quote.String() == "PERP" || c.CurrencySettings[i].Base.String() == "PI") && !hasFundingRates {
 				return errPerpetualsUnsupported
 			}
 		}
@@ -220,6 +288,23 @@ func (c *Config) validateCurrencySettings() error {
 	return nil
 }
 
+// validateFundingRates ensures funding rates are only applied to futures
+// and can be sourced from the configured data settings
+func (c *Config) validateFundingRates(cs *CurrencySettings) error {
+	if !cs.Asset.IsFutures() {
+		return fmt.Errorf("%v %w", cs.Asset, errFundingRatesFuturesOnly)
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("live data %w", errFundingRatesSourceUnsupported)
+	}
+	if cs.FuturesDetails.FundingRates.CSVFilePath == "" &&
+		c.DataSettings.APIData == nil &&
+		c.DataSettings.DatabaseData == nil {
+		return fmt.Errorf("%w without a funding rate csv file path", errFundingRatesSourceUnsupported)
+	}
+	return nil
+}
+
 // PrintSetting prints relevant settings to the console for easy reading
 func (c *Config) PrintSetting() {
 	log.Infoln(common.Config, common.CMDColours.H1+"------------------Backtester Settings------------------------"+common.CMDColours.Default)
@@ -295,6 +380,9 @@ func (c *Config) PrintSetting() {
 		if c.CurrencySettings[i].FuturesDetails != nil && c.CurrencySettings[i].Asset == asset.Futures {
 			log.Infof(common.Config, "Leverage rules: %+v", c.CurrencySettings[i].FuturesDetails.Leverage)
 		}
+		if c.CurrencySettings[i].FuturesDetails != nil && c.CurrencySettings[i].FuturesDetails.FundingRates != nil {
+			log.Infof(common.Config, "Funding rates: %+v", *c.CurrencySettings[i].FuturesDetails.FundingRates)
+		}
 		log.Infof(common.Config, "Can use exchange defined order execution limits: %+v", c.CurrencySettings[i].CanUseExchangeLimits)
 	}
 
@@ -326,6 +414,12 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(common.Config, "CSV file: %v", c.DataSettings.CSVData.FullPath)
 	}
+	if c.DataSettings.TickData != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Tick Data Settings-------------------------"+common.CMDColours.Default)
+		log.Infof(common.Config, "Data type: %v", c.DataSettings.DataType)
+		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
+		log.Infof(common.Config, "Tick data path: %v", c.DataSettings.TickData.FullPath)
+	}
 	if c.DataSettings.DatabaseData != nil {
 		log.Infoln(common.Config, common.CMDColours.H2+"------------------Database Settings--------------------------"+common.CMDColours.Default)
 		log.Infof(common.Config, "Data type: %v", c.DataSettings.DataType)
@@ -177,6 +177,49 @@ func TestValidateCurrencySettings(t *testing.T) {
 	assert.ErrorIs(t, err, errBadInitialFunds)
 }
 
+func TestValidateFundingRates(t *testing.T) {
+	t.Parallel()
+	c := Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         currency.BTC,
+				Quote:        currency.USDT,
+				FuturesDetails: &FuturesDetails{
+					FundingRates: &FundingRates{},
+				},
+			},
+		},
+	}
+	err := c.validateCurrencySettings()
+	assert.ErrorIs(t, err, errFundingRatesFuturesOnly)
+
+	c.CurrencySettings[0].Asset = asset.PerpetualContract
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateCurrencySettings()
+	assert.ErrorIs(t, err, errFundingRatesSourceUnsupported)
+
+	c.DataSettings.LiveData = nil
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateCurrencySettings()
+	assert.ErrorIs(t, err, errFundingRatesSourceUnsupported)
+
+	c.CurrencySettings[0].FuturesDetails.FundingRates.CSVFilePath = "fundingrates.csv"
+	err = c.validateCurrencySettings()
+	assert.NoError(t, err, "validateCurrencySettings should allow perpetuals with funding rates")
+
+	c.DataSettings.CSVData = nil
+	c.DataSettings.APIData = &APIData{}
+	c.CurrencySettings[0].FuturesDetails.FundingRates.CSVFilePath = ""
+	err = c.validateCurrencySettings()
+	assert.NoError(t, err)
+
+	c.CurrencySettings[0].FuturesDetails.FundingRates = nil
+	err = c.validateCurrencySettings()
+	assert.ErrorIs(t, err, errPerpetualsUnsupported)
+}
+
 func TestValidateMinMaxes(t *testing.T) {
 	t.Parallel()
 	c := &Config{}
@@ -298,6 +341,79 @@ func TestValidateStrategySettings(t *testing.T) {
 	assert.ErrorIs(t, err, errExchangeLevelFundingRequired)
 }
 
+func TestValidateOptimiserSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	c.OptimiserSettings = &OptimiserSettings{}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errUnsupportedOptimiserMetric)
+
+	c.OptimiserSettings.Metric = OptimiserMetricSharpe
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errNoOptimiserParameters)
+
+	c.OptimiserSettings.Parameters = []ParameterRange{{Minimum: 1, Maximum: 2}}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidParameterRange)
+
+	c.OptimiserSettings.Parameters = []ParameterRange{{Key: "rsi-low", Minimum: 1, Maximum: 2}, {Key: "rsi-low", Minimum: 1, Maximum: 2}}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidParameterRange)
+
+	c.OptimiserSettings.Parameters = []ParameterRange{{Key: "rsi-low", Minimum: 2, Maximum: 1}}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidParameterRange)
+
+	c.OptimiserSettings.Parameters = []ParameterRange{{Key: "rsi-low", Minimum: 1, Maximum: 2, Step: -1}}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidParameterRange)
+
+	c.OptimiserSettings.Parameters = []ParameterRange{{Key: "rsi-low", Minimum: 20, Maximum: 40, Step: 10}}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errUnsupportedOptimiserMethod)
+
+	c.OptimiserSettings.Method = OptimiserGridSearch
+	err = c.ValidateOptimiserSettings()
+	assert.NoError(t, err)
+
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errFeatureIncompatible)
+	c.DataSettings.LiveData = nil
+
+	c.OptimiserSettings.Method = OptimiserRandomSearch
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidRandomIterations)
+
+	c.OptimiserSettings.RandomIterations = 10
+	err = c.ValidateOptimiserSettings()
+	assert.NoError(t, err)
+
+	c.OptimiserSettings.Method = OptimiserWalkForward
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidWalkForwardSettings)
+
+	c.OptimiserSettings.WalkForward = &WalkForward{}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidWalkForwardSettings)
+
+	c.OptimiserSettings.WalkForward.InSampleWindow = time.Hour * 24 * 30
+	c.OptimiserSettings.WalkForward.OutOfSampleWindow = time.Hour * 24 * 7
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errInvalidWalkForwardSettings)
+
+	c.DataSettings.APIData = &APIData{}
+	err = c.ValidateOptimiserSettings()
+	assert.ErrorIs(t, err, errUnsupportedOptimiserMethod)
+
+	c.OptimiserSettings.WalkForward.SearchMethod = OptimiserGridSearch
+	err = c.ValidateOptimiserSettings()
+	assert.NoError(t, err)
+}
+
 func TestPrintSettings(t *testing.T) {
 	t.Parallel()
 	cfg := Config{
@@ -1008,6 +1124,79 @@ func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForRSICSVCandlesOptimiser(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyRSICSVCandlesOptimiser",
+		Goal:     "To demonstrate a grid search over RSI custom settings ranked by sharpe ratio",
+		StrategySettings: StrategySettings{
+			Name:               "rsi",
+			DisableUSDTracking: true,
+			CustomSettings: map[string]any{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14.0,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		OptimiserSettings: &OptimiserSettings{
+			Method: OptimiserGridSearch,
+			Metric: OptimiserMetricSharpe,
+			Parameters: []ParameterRange{
+				{Key: "rsi-low", Minimum: 20, Maximum: 40, Step: 10},
+				{Key: "rsi-high", Minimum: 60, Maximum: 80, Step: 10},
+				{Key: "rsi-period", Minimum: 10, Maximum: 20, Step: 5},
+			},
+			MaximumParallelTasks: 4,
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-csv-candles-optimiser.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 	if !saveConfig {
 		t.Skip("saveConfig false, skipping")
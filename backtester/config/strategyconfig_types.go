@@ -26,6 +26,29 @@ var (
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
 	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errFundingRatesFuturesOnly          = errors.New("funding rates can only be applied to futures")
+	errFundingRatesSourceUnsupported    = errors.New("funding rates cannot be loaded from the data source")
+	errUnsupportedOptimiserMethod       = errors.New("unsupported optimiser method")
+	errUnsupportedOptimiserMetric       = errors.New("unsupported optimiser metric")
+	errNoOptimiserParameters            = errors.New("no optimiser parameters set")
+	errInvalidParameterRange            = errors.New("invalid optimiser parameter range")
+	errInvalidRandomIterations          = errors.New("random search requires random iterations greater than zero")
+	errInvalidWalkForwardSettings       = errors.New("invalid walk forward settings")
+)
+
+// Optimiser search methods
+const (
+	OptimiserGridSearch   = "grid"
+	OptimiserRandomSearch = "random"
+	OptimiserWalkForward  = "walk-forward"
+)
+
+// Optimiser ranking metrics
+const (
+	OptimiserMetricSharpe      = "sharpe"
+	OptimiserMetricSortino     = "sortino"
+	OptimiserMetricCalmar      = "calmar"
+	OptimiserMetricMaxDrawdown = "max-drawdown"
 )
 
 // Config defines what is in an individual strategy config
@@ -38,6 +61,38 @@ type Config struct {
 	DataSettings      DataSettings       `json:"data-settings"`
 	PortfolioSettings PortfolioSettings  `json:"portfolio-settings"`
 	StatisticSettings StatisticSettings  `json:"statistic-settings"`
+	// OptimiserSettings is only used when running the strategy in
+	// optimiser mode and is ignored by a regular backtest
+	OptimiserSettings *OptimiserSettings `json:"optimiser-settings,omitempty"`
+}
+
+// OptimiserSettings declares the custom strategy setting ranges to search
+// and how to rank each run's results
+type OptimiserSettings struct {
+	Method               string           `json:"method"`
+	Metric               string           `json:"metric"`
+	Parameters           []ParameterRange `json:"parameters"`
+	RandomIterations     int64            `json:"random-iterations,omitempty"`
+	RandomSeed           int64            `json:"random-seed,omitempty"`
+	MaximumParallelTasks int64            `json:"maximum-parallel-tasks,omitempty"`
+	WalkForward          *WalkForward     `json:"walk-forward,omitempty"`
+}
+
+// ParameterRange is an inclusive range of values for a custom strategy
+// setting. A zero step only searches the minimum and maximum
+type ParameterRange struct {
+	Key     string  `json:"key"`
+	Minimum float64 `json:"minimum"`
+	Maximum float64 `json:"maximum"`
+	Step    float64 `json:"step,omitempty"`
+}
+
+// WalkForward defines the rolling in-sample windows used to search for
+// parameters and the out-of-sample windows used to test them
+type WalkForward struct {
+	SearchMethod      string        `json:"search-method"`
+	InSampleWindow    time.Duration `json:"in-sample-window"`
+	OutOfSampleWindow time.Duration `json:"out-of-sample-window"`
 }
 
 // DataSettings is a container for each type of data retrieval setting.
@@ -50,6 +105,7 @@ type DataSettings struct {
 	DatabaseData            *DatabaseData  `json:"database-data,omitempty"`
 	LiveData                *LiveData      `json:"live-data,omitempty"`
 	CSVData                 *CSVData       `json:"csv-data,omitempty"`
+	TickData                *TickData      `json:"tick-data,omitempty"`
 }
 
 // FundingSettings contains funding details for individual currencies
@@ -165,6 +221,16 @@ type SpotDetails struct {
 // FuturesDetails contains data relevant to futures currency pairs
 type FuturesDetails struct {
 	Leverage Leverage `json:"leverage"`
+	// FundingRates when set, applies historical funding rate payments
+	// to open perpetual futures positions
+	FundingRates *FundingRates `json:"funding-rates,omitempty"`
+}
+
+// FundingRates defines where historical funding rates are loaded from.
+// Funding rates are loaded from the API or database data source used for
+// candles unless a CSV file of unix timestamps and rates is provided
+type FundingRates struct {
+	CSVFilePath string `json:"csv-file-path,omitempty"`
 }
 
 // APIData defines all fields to configure API based data
@@ -179,6 +245,12 @@ type CSVData struct {
 	FullPath string `json:"full-path"`
 }
 
+// TickData defines all fields to configure data recorded by the GoCryptoTrader
+// tick recorder. FullPath can be a single tick data file or a directory of them
+type TickData struct {
+	FullPath string `json:"full-path"`
+}
+
 // DatabaseData defines all fields to configure database based data
 type DatabaseData struct {
 	StartDate        time.Time       `json:"start-date"`
@@ -10,6 +10,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 var (
@@ -83,6 +85,18 @@ type Streamer interface {
 	HasDataAtTime(time.Time) (bool, error)
 }
 
+// OrderbookHandler is implemented by handlers which can replay a recorded
+// orderbook for each candle so that orders fill against the recorded depth
+type OrderbookHandler interface {
+	GetOrderbookAtTime(time.Time) *orderbook.Book
+}
+
+// FundingRateHandler is implemented by handlers which hold historical
+// funding rates to apply to open perpetual futures positions
+type FundingRateHandler interface {
+	GetFundingRatesBetween(start, end time.Time) []fundingrate.Rate
+}
+
 // Event interface used for loading and interacting with Data
 type Event interface {
 	common.Event
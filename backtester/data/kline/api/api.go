@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
@@ -50,3 +51,21 @@ func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time,
 	candles.Exchange = strings.ToLower(candles.Exchange)
 	return candles, nil
 }
+
+// LoadFundingRates retrieves historical funding rates from a GoCryptoTrader exchange wrapper
+func LoadFundingRates(ctx context.Context, startDate, endDate time.Time, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item) ([]gctfundingrate.Rate, error) {
+	rates, err := exch.GetHistoricalFundingRates(ctx, &gctfundingrate.HistoricalRatesRequest{
+		Asset:                a,
+		Pair:                 fPair,
+		StartDate:            startDate,
+		EndDate:              endDate,
+		RespectHistoryLimits: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve funding rates for %v %v %v, %w", exch.GetName(), a, fPair, err)
+	}
+	if len(rates.FundingRates) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w", exch.GetName(), a, fPair, gctfundingrate.ErrNoFundingRatesFound)
+	}
+	return rates.FundingRates, nil
+}
@@ -10,10 +10,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
@@ -163,3 +165,47 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 
 	return resp, nil
 }
+
+// LoadFundingRates reads a csv file of unix timestamps and funding rates
+func LoadFundingRates(filepath, exchangeName string, fPair currency.Pair, a asset.Item) ([]gctfundingrate.Rate, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := csvFile.Close(); err != nil {
+			log.Errorln(common.Data, err)
+		}
+	}()
+
+	var rates []gctfundingrate.Rate
+	csvData := csv.NewReader(csvFile)
+	for {
+		row, err := csvData.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("could not read csv funding rate data for %v %v %v, %v", exchangeName, a, fPair, err)
+		}
+		if len(row) < 2 {
+			return nil, fmt.Errorf("could not read csv funding rate data for %v %v %v, invalid row %v", exchangeName, a, fPair, row)
+		}
+		v, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid funding rate timestamp received on row %v %v", row, err)
+		}
+		rate, err := decimal.NewFromString(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("could not process funding rate %v %v", row[1], err)
+		}
+		rates = append(rates, gctfundingrate.Rate{
+			Time: time.Unix(v, 0).UTC(),
+			Rate: rate,
+		})
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w", exchangeName, a, fPair, gctfundingrate.ErrNoFundingRatesFound)
+	}
+	return rates, nil
+}
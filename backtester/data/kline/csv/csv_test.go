@@ -1,13 +1,16 @@
 package csv
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
@@ -67,3 +70,32 @@ func TestLoadDataInvalid(t *testing.T) {
 		true)
 	assert.ErrorIs(t, err, errNoUSDData)
 }
+
+func TestLoadFundingRates(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	a := asset.PerpetualContract
+	dir := t.TempDir()
+
+	_, err := LoadFundingRates(filepath.Join(dir, "missing.csv"), testExchange, p, a)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	empty := filepath.Join(dir, "empty.csv")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	_, err = LoadFundingRates(empty, testExchange, p, a)
+	assert.ErrorIs(t, err, gctfundingrate.ErrNoFundingRatesFound)
+
+	bad := filepath.Join(dir, "bad.csv")
+	require.NoError(t, os.WriteFile(bad, []byte("1577836800,lol\n"), 0o600))
+	_, err = LoadFundingRates(bad, testExchange, p, a)
+	assert.Error(t, err, "LoadFundingRates should error on an invalid rate")
+
+	good := filepath.Join(dir, "good.csv")
+	require.NoError(t, os.WriteFile(good, []byte("1577836800,0.0001\n1577865600,-0.0002\n"), 0o600))
+	rates, err := LoadFundingRates(good, testExchange, p, a)
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, int64(1577836800), rates[0].Time.Unix())
+	assert.Equal(t, "0.0001", rates[0].Rate.String())
+	assert.Equal(t, "-0.0002", rates[1].Rate.String())
+}
@@ -6,10 +6,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -82,3 +85,28 @@ func getCandleDatabaseData(startDate, endDate time.Time, interval time.Duration,
 		startDate,
 		endDate)
 }
+
+// LoadFundingRates retrieves historical funding rates from an existing database
+func LoadFundingRates(startDate, endDate time.Time, exchangeName string, fPair currency.Pair, a asset.Item) ([]gctfundingrate.Rate, error) {
+	data, err := fundingrate.GetInRange(
+		exchangeName,
+		a.String(),
+		fPair.Base.String(),
+		fPair.Quote.String(),
+		startDate,
+		endDate)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve database funding rates for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w", exchangeName, a, fPair, gctfundingrate.ErrNoFundingRatesFound)
+	}
+	rates := make([]gctfundingrate.Rate, len(data))
+	for i := range data {
+		rates[i] = gctfundingrate.Rate{
+			Time: data[i].Timestamp,
+			Rate: decimal.NewFromFloat(data[i].Rate),
+		}
+	}
+	return rates, nil
+}
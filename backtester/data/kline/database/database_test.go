@@ -15,10 +15,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -196,3 +198,50 @@ func TestLoadDataInvalid(t *testing.T) {
 	_, err = LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, -1, p, a, true)
 	assert.ErrorIs(t, err, errNoUSDData)
 }
+
+func TestLoadFundingRates(t *testing.T) {
+	exch := testExchange
+	a := asset.PerpetualContract
+	p := currency.NewBTCUSDT()
+	dbConfg := database.Config{
+		Enabled: true,
+		Driver:  "sqlite",
+		ConnectionDetails: drivers.ConnectionDetails{
+			Host:     "localhost",
+			Database: "test",
+		},
+	}
+	database.MigrationDir = filepath.Join("..", "..", "..", "..", "database", "migrations")
+	testhelpers.MigrationDir = filepath.Join("..", "..", "..", "..", "database", "migrations")
+	conn, err := testhelpers.ConnectToDatabase(&dbConfg)
+	require.NoError(t, err)
+
+	err = exchangeDB.InsertMany([]exchangeDB.Details{{Name: testExchange}})
+	require.NoError(t, err)
+	dStart := time.Date(2020, 1, 0, 0, 0, 0, 0, time.UTC)
+	dInsert := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	dEnd := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	_, err = LoadFundingRates(dStart, dEnd, exch, p, a)
+	assert.ErrorIs(t, err, gctfundingrate.ErrNoFundingRatesFound)
+
+	err = fundingrate.Insert(fundingrate.Data{
+		Exchange:  exch,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+		AssetType: a.String(),
+		Rate:      0.0001,
+		Timestamp: dInsert,
+	})
+	require.NoError(t, err)
+
+	rates, err := LoadFundingRates(dStart, dEnd, exch, p, a)
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.True(t, rates[0].Time.Equal(dInsert))
+	assert.Equal(t, "0.0001", rates[0].Rate.String())
+
+	if err = conn.SQL.Close(); err != nil {
+		t.Error(err)
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // NewDataFromKline returns a new struct
@@ -45,6 +47,24 @@ func (d *DataFromKline) HasDataAtTime(t time.Time) (bool, error) {
 	return d.RangeHolder.HasDataAtDate(t), nil
 }
 
+// GetOrderbookAtTime returns the recorded orderbook at the close of the candle
+// at the time provided, or nil when no orderbook was recorded
+func (d *DataFromKline) GetOrderbookAtTime(t time.Time) *orderbook.Book {
+	return d.Orderbooks[t.Unix()]
+}
+
+// GetFundingRatesBetween returns the funding rates which occurred after the
+// start time up to and including the end time
+func (d *DataFromKline) GetFundingRatesBetween(start, end time.Time) []fundingrate.Rate {
+	var resp []fundingrate.Rate
+	for i := range d.FundingRates {
+		if d.FundingRates[i].Time.After(start) && !d.FundingRates[i].Time.After(end) {
+			resp = append(resp, d.FundingRates[i])
+		}
+	}
+	return resp
+}
+
 // Load sets the candle data to the stream for processing
 func (d *DataFromKline) Load() error {
 	if d.Item == nil || len(d.Item.Candles) == 0 {
@@ -13,7 +13,9 @@ import (
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -52,6 +54,37 @@ func TestLoad(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetOrderbookAtTime(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	d := NewDataFromKline()
+	assert.Nil(t, d.GetOrderbookAtTime(tt), "GetOrderbookAtTime should return nil without orderbooks")
+
+	ob := &orderbook.Book{Exchange: testExchange}
+	d.Orderbooks = map[int64]*orderbook.Book{tt.Unix(): ob}
+	assert.Equal(t, ob, d.GetOrderbookAtTime(tt))
+	assert.Nil(t, d.GetOrderbookAtTime(tt.Add(time.Hour)), "GetOrderbookAtTime should return nil for a time without an orderbook")
+}
+
+func TestGetFundingRatesBetween(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := NewDataFromKline()
+	assert.Empty(t, d.GetFundingRatesBetween(tt, tt.Add(time.Hour)), "GetFundingRatesBetween should return nothing without funding rates")
+
+	d.FundingRates = []fundingrate.Rate{
+		{Time: tt, Rate: decimal.NewFromFloat(0.0001)},
+		{Time: tt.Add(time.Hour * 8), Rate: decimal.NewFromFloat(0.0002)},
+		{Time: tt.Add(time.Hour * 16), Rate: decimal.NewFromFloat(0.0003)},
+	}
+	rates := d.GetFundingRatesBetween(tt, tt.Add(time.Hour*8))
+	require.Len(t, rates, 1, "GetFundingRatesBetween must exclude the start time")
+	assert.Equal(t, d.FundingRates[1], rates[0])
+
+	rates = d.GetFundingRatesBetween(tt.Add(-time.Hour), tt.Add(time.Hour*16))
+	assert.Len(t, rates, 3)
+}
+
 func TestHasDataAtTime(t *testing.T) {
 	t.Parallel()
 	dStart := time.Date(2020, 1, 0, 0, 0, 0, 0, time.UTC)
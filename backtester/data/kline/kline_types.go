@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 var errNoCandleData = errors.New("no candle data provided")
@@ -15,4 +17,10 @@ type DataFromKline struct {
 	*data.Base
 	Item        *gctkline.Item
 	RangeHolder *gctkline.IntervalRangeHolder
+	// Orderbooks holds the recorded orderbook at the close of each candle,
+	// keyed by the candle's unix time
+	Orderbooks map[int64]*orderbook.Book
+	// FundingRates holds historical perpetual futures funding rates
+	// sorted by time
+	FundingRates []fundingrate.Rate
 }
@@ -0,0 +1,220 @@
+package tickdata
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	gcttickdata "github.com/thrasher-corp/gocryptotrader/exchanges/tickdata"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errNoTickData = errors.New("no tick data found")
+	errNoUSDData  = errors.New("could not retrieve USD tick data")
+)
+
+// candleBuilder accumulates the tick data records within a candle interval
+type candleBuilder struct {
+	first, last            time.Time
+	open, high, low, close float64
+	volume                 float64
+	hasPrice               bool
+	book                   *orderbook.Book
+	bookTime               time.Time
+}
+
+// LoadData reads tick data files recorded by the GoCryptoTrader tick recorder
+// and converts them into candles. Path can be a single file or a directory,
+// in which case every file recorded for the exchange, asset and pair is used.
+// The orderbook data type builds candles from the orderbook mid price and
+// keeps the last orderbook of each candle so orders fill against the
+// recorded depth. The trade data type builds candles from the recorded trades
+func LoadData(dataType int64, path, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
+	if dataType != common.DataOrderbook && dataType != common.DataTrade {
+		if isUSDTrackingPair {
+			return nil, fmt.Errorf("%w for %v %v %v. Please add USD pair data to your tick data or set `disable-usd-tracking` to `true` in your config", errNoUSDData, exchangeName, a, fPair)
+		}
+		return nil, fmt.Errorf("could not process tick data for %v %v %v, %w", exchangeName, a, fPair, common.ErrInvalidDataType)
+	}
+	if interval < time.Second {
+		return nil, fmt.Errorf("%w %v, tick data candles must be at least one second", gctkline.ErrInvalidInterval, interval)
+	}
+	files, err := getFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	builders := make(map[int64]*candleBuilder)
+	for i := range files {
+		if err = readFile(files[i], dataType, exchangeName, interval, fPair, a, builders); err != nil {
+			return nil, fmt.Errorf("could not read tick data file %v for %v %v %v, %w", files[i], exchangeName, a, fPair, err)
+		}
+	}
+
+	times := make([]int64, 0, len(builders))
+	for t, b := range builders {
+		if b.hasPrice {
+			times = append(times, t)
+		}
+	}
+	if len(times) == 0 {
+		if isUSDTrackingPair {
+			return nil, fmt.Errorf("%w for %v %v %v. Please add USD pair data to your tick data or set `disable-usd-tracking` to `true` in your config", errNoUSDData, exchangeName, a, fPair)
+		}
+		return nil, fmt.Errorf("%w for %v %v %v in %v", errNoTickData, exchangeName, a, fPair, path)
+	}
+	slices.Sort(times)
+
+	resp := kline.NewDataFromKline()
+	resp.Item = &gctkline.Item{
+		Exchange: strings.ToLower(exchangeName),
+		Pair:     fPair,
+		Asset:    a,
+		Interval: gctkline.Interval(interval),
+	}
+	if dataType == common.DataOrderbook {
+		resp.Orderbooks = make(map[int64]*orderbook.Book, len(times))
+	}
+	step := int64(interval / time.Second)
+	var prev *candleBuilder
+	for _, t := range times {
+		if dataType == common.DataOrderbook && prev != nil {
+			// An unchanged orderbook is not recorded, so any intervals
+			// without an update carry the previous orderbook forward
+			for gap := resp.Item.Candles[len(resp.Item.Candles)-1].Time.Unix() + step; gap < t; gap += step {
+				var volume float64
+				if gb, ok := builders[gap]; ok {
+					volume = gb.volume
+				}
+				resp.Item.Candles = append(resp.Item.Candles, gctkline.Candle{
+					Time:   time.Unix(gap, 0).UTC(),
+					Open:   prev.close,
+					High:   prev.close,
+					Low:    prev.close,
+					Close:  prev.close,
+					Volume: volume,
+				})
+				resp.Orderbooks[gap] = prev.book
+			}
+		}
+		b := builders[t]
+		resp.Item.Candles = append(resp.Item.Candles, gctkline.Candle{
+			Time:   time.Unix(t, 0).UTC(),
+			Open:   b.open,
+			High:   b.high,
+			Low:    b.low,
+			Close:  b.close,
+			Volume: b.volume,
+		})
+		if dataType == common.DataOrderbook {
+			resp.Orderbooks[t] = b.book
+		}
+		prev = b
+	}
+	return resp, nil
+}
+
+// getFiles returns the tick data files at the path
+func getFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	files, err := filepath.Glob(filepath.Join(path, "*"+gcttickdata.FileExtension))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w in directory %v", errNoTickData, path)
+	}
+	return files, nil
+}
+
+// readFile adds the records of a tick data file to the candle builders when
+// the file was recorded for the exchange, asset and pair
+func readFile(path string, dataType int64, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item, builders map[int64]*candleBuilder) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorln(common.Data, err)
+		}
+	}()
+	r, err := gcttickdata.NewReader(f)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(r.Exchange, exchangeName) || r.Asset != a || !r.Pair.Equal(fPair) {
+		return nil
+	}
+	for {
+		rec, err := r.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		t := rec.Time.Truncate(interval).Unix()
+		b, ok := builders[t]
+		if !ok {
+			b = &candleBuilder{}
+			builders[t] = b
+		}
+		switch rec.Type {
+		case gcttickdata.RecordSnapshot, gcttickdata.RecordDelta:
+			if dataType != common.DataOrderbook {
+				continue
+			}
+			if b.book == nil || !rec.Time.Before(b.bookTime) {
+				b.book = rec.Book
+				b.bookTime = rec.Time
+			}
+			if len(rec.Book.Bids) > 0 && len(rec.Book.Asks) > 0 {
+				b.addPrice(rec.Time, (rec.Book.Bids[0].Price+rec.Book.Asks[0].Price)/2)
+			}
+		case gcttickdata.RecordTrade:
+			b.volume += rec.Trade.Amount
+			if dataType == common.DataTrade {
+				b.addPrice(rec.Time, rec.Trade.Price)
+			}
+		}
+	}
+}
+
+// addPrice updates the candle prices. Records from separate files may be
+// read out of order so open and close use the record times
+func (b *candleBuilder) addPrice(t time.Time, price float64) {
+	if !b.hasPrice {
+		b.first, b.last = t, t
+		b.open, b.high, b.low, b.close = price, price, price, price
+		b.hasPrice = true
+		return
+	}
+	b.high = max(b.high, price)
+	b.low = min(b.low, price)
+	if t.Before(b.first) {
+		b.first = t
+		b.open = price
+	}
+	if !t.Before(b.last) {
+		b.last = t
+		b.close = price
+	}
+}
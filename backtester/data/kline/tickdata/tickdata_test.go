@@ -0,0 +1,110 @@
+package tickdata
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	gcttickdata "github.com/thrasher-corp/gocryptotrader/exchanges/tickdata"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "binance"
+
+var (
+	testPair  = currency.NewBTCUSDT()
+	testStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// writeTestFile records three minutes of orderbooks with no update in the
+// second minute and a trade in the first and third minutes
+func writeTestFile(t *testing.T, dir string, p currency.Pair) string {
+	t.Helper()
+	path := filepath.Join(dir, p.Lower().String()+gcttickdata.FileExtension)
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	w, err := gcttickdata.NewWriter(f, testExchange, asset.Spot, p)
+	require.NoError(t, err)
+	book := func(offset time.Duration, bid, ask float64) *orderbook.Book {
+		return &orderbook.Book{
+			Exchange:    testExchange,
+			Asset:       asset.Spot,
+			Pair:        p,
+			Bids:        orderbook.Levels{{Price: bid, Amount: 1}},
+			Asks:        orderbook.Levels{{Price: ask, Amount: 1}},
+			LastUpdated: testStart.Add(offset),
+		}
+	}
+	require.NoError(t, w.WriteBook(book(0, 99, 101)))
+	require.NoError(t, w.WriteBook(book(10*time.Second, 103, 105)))
+	require.NoError(t, w.WriteBook(book(50*time.Second, 97, 99)))
+	require.NoError(t, w.WriteTrade(&trade.Data{
+		Exchange:     testExchange,
+		AssetType:    asset.Spot,
+		CurrencyPair: p,
+		Side:         order.Buy,
+		Price:        102,
+		Amount:       2,
+		Timestamp:    testStart.Add(20 * time.Second),
+	}))
+	require.NoError(t, w.WriteBook(book(2*time.Minute+time.Second, 109, 111)))
+	require.NoError(t, w.WriteTrade(&trade.Data{
+		Exchange:     testExchange,
+		AssetType:    asset.Spot,
+		CurrencyPair: p,
+		Side:         order.Sell,
+		Price:        109,
+		Amount:       3,
+		Timestamp:    testStart.Add(2*time.Minute + 2*time.Second),
+	}))
+	require.NoError(t, w.Close())
+	return path
+}
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := writeTestFile(t, dir, testPair)
+	writeTestFile(t, dir, currency.NewBTCUSD())
+
+	_, err := LoadData(common.DataCandle, path, testExchange, time.Minute, testPair, asset.Spot, false)
+	assert.ErrorIs(t, err, common.ErrInvalidDataType)
+	_, err = LoadData(common.DataCandle, path, testExchange, time.Minute, testPair, asset.Spot, true)
+	assert.ErrorIs(t, err, errNoUSDData)
+	_, err = LoadData(common.DataOrderbook, path, testExchange, time.Millisecond, testPair, asset.Spot, false)
+	assert.ErrorIs(t, err, gctkline.ErrInvalidInterval)
+	_, err = LoadData(common.DataOrderbook, filepath.Join(dir, "missing"), testExchange, time.Minute, testPair, asset.Spot, false)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	_, err = LoadData(common.DataOrderbook, t.TempDir(), testExchange, time.Minute, testPair, asset.Spot, false)
+	assert.ErrorIs(t, err, errNoTickData)
+	_, err = LoadData(common.DataOrderbook, dir, testExchange, time.Minute, currency.NewPair(currency.ETH, currency.USDT), asset.Spot, false)
+	assert.ErrorIs(t, err, errNoTickData, "files recorded for other pairs should be ignored")
+
+	resp, err := LoadData(common.DataOrderbook, dir, testExchange, time.Minute, testPair, asset.Spot, false)
+	require.NoError(t, err)
+	require.Len(t, resp.Item.Candles, 3, "the minute without an orderbook update should be filled")
+	assert.Equal(t, gctkline.Candle{Time: testStart, Open: 100, High: 104, Low: 98, Close: 98, Volume: 2}, resp.Item.Candles[0])
+	assert.Equal(t, gctkline.Candle{Time: testStart.Add(time.Minute), Open: 98, High: 98, Low: 98, Close: 98}, resp.Item.Candles[1])
+	assert.Equal(t, gctkline.Candle{Time: testStart.Add(2 * time.Minute), Open: 110, High: 110, Low: 110, Close: 110, Volume: 3}, resp.Item.Candles[2])
+	require.Len(t, resp.Orderbooks, 3)
+	ob := resp.GetOrderbookAtTime(testStart)
+	require.NotNil(t, ob)
+	assert.Equal(t, 97.0, ob.Bids[0].Price, "the last orderbook of the candle should be kept")
+	assert.Equal(t, ob, resp.GetOrderbookAtTime(testStart.Add(time.Minute)), "the previous orderbook should be carried forward")
+
+	resp, err = LoadData(common.DataTrade, path, testExchange, time.Minute, testPair, asset.Spot, false)
+	require.NoError(t, err)
+	require.Len(t, resp.Item.Candles, 2)
+	assert.Equal(t, gctkline.Candle{Time: testStart, Open: 102, High: 102, Low: 102, Close: 102, Volume: 2}, resp.Item.Candles[0])
+	assert.Empty(t, resp.Orderbooks, "trade data should not replay orderbooks")
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
@@ -270,6 +271,12 @@ func (bt *BackTest) handleEvent(ev common.Event) error {
 			err = bt.processSingleDataEvent(eType, funds.FundReleaser())
 		}
 	case signal.Event:
+		if eType.IsCancellingRestingOrders() {
+			err = bt.Exchange.CancelRestingOrders(eType, funds.FundReleaser())
+			if err != nil {
+				log.Errorf(common.Backtester, "CancelRestingOrders %v %v %v %v", eType.GetExchange(), eType.GetAssetType(), eType.Pair(), err)
+			}
+		}
 		err = bt.processSignalEvent(eType, funds.FundReserver())
 	case order.Event:
 		err = bt.processOrderEvent(eType, funds.FundReleaser())
@@ -304,6 +311,7 @@ func (bt *BackTest) processSingleDataEvent(ev data.Event, funds funding.IFundRel
 	if err != nil {
 		return err
 	}
+	bt.matchRestingOrders(ev, funds)
 	d, err := bt.DataHolder.GetDataForCurrency(ev)
 	if err != nil {
 		return err
@@ -363,6 +371,7 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 				log.Errorln(common.Backtester, err)
 			}
 		}
+		bt.matchRestingOrders(latestData, funds.FundReleaser())
 		dataEvents = append(dataEvents, dataHolders[i])
 	}
 	signals, err := bt.Strategy.OnSimultaneousSignals(dataEvents, bt.Funding, bt.Portfolio)
@@ -419,6 +428,11 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 			return err
 		}
 
+		err = bt.applyFundingRates(ev)
+		if err != nil {
+			return fmt.Errorf("applyFundingRates %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+		}
+
 		err = bt.Portfolio.UpdatePNL(ev, ev.GetClosePrice())
 		if err != nil {
 			if errors.Is(err, futures.ErrPositionNotFound) {
@@ -457,6 +471,111 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 	return nil
 }
 
+// applyFundingRates settles funding rates which occurred since the previous
+// candle against the latest open position using the candle's open price.
+// Longs pay shorts when the funding rate is positive
+func (bt *BackTest) applyFundingRates(ev data.Event) error {
+	if bt.DataHolder == nil {
+		return nil
+	}
+	dh, err := bt.DataHolder.GetDataForCurrency(ev)
+	if err != nil {
+		return err
+	}
+	frh, ok := dh.(data.FundingRateHandler)
+	if !ok {
+		return nil
+	}
+	rates := frh.GetFundingRatesBetween(ev.GetTime().Add(-ev.GetInterval().Duration()), ev.GetTime())
+	if len(rates) == 0 {
+		return nil
+	}
+	positions, err := bt.Portfolio.GetPositions(ev)
+	if err != nil {
+		return err
+	}
+	if len(positions) == 0 {
+		return nil
+	}
+	pos := positions[len(positions)-1]
+	if pos.Status != gctorder.Open || pos.LatestSize.IsZero() {
+		return nil
+	}
+	notional := pos.LatestSize.Abs().Mul(ev.GetOpenPrice())
+	var payment decimal.Decimal
+	for i := range rates {
+		payment = payment.Add(notional.Mul(rates[i].Rate))
+	}
+	if pos.LatestDirection.IsLong() {
+		payment = payment.Neg()
+	}
+	if payment.IsZero() {
+		return nil
+	}
+
+	exch, err := bt.exchangeManager.GetExchangeByName(ev.GetExchange())
+	if err != nil {
+		return err
+	}
+	receivingCurrency, receivingAsset, err := exch.GetCurrencyForRealisedPNL(ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return err
+	}
+	err = bt.Funding.RealisePNL(ev.GetExchange(), receivingAsset, receivingCurrency, payment)
+	if err != nil {
+		return err
+	}
+	err = bt.Statistic.AddFundingPNLForTime(ev, payment)
+	if err != nil {
+		return err
+	}
+	return bt.Funding.UpdateCollateralForEvent(ev, false)
+}
+
+// matchRestingOrders raises fill events for resting orders on the simulated
+// exchange which the data event's candle has matched. The fills are queued
+// ahead of any signal raised for the same candle
+func (bt *BackTest) matchRestingOrders(ev data.Event, funds funding.IFundReleaser) {
+	fills, err := bt.Exchange.MatchRestingOrders(ev, bt.orderManager, funds)
+	if err != nil {
+		log.Errorf(common.Backtester, "MatchRestingOrders %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	for i := range fills {
+		err = bt.Statistic.SetEventForOffset(fills[i])
+		if err != nil {
+			log.Errorf(common.Backtester, "SetEventForOffset %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+		}
+		bt.EventQueue.AppendEvent(fills[i])
+	}
+}
+
+// cancelAllRestingOrders cancels every resting order on the simulated
+// exchange once the run has finished, returning their reserved funds
+func (bt *BackTest) cancelAllRestingOrders() error {
+	dataHolders, err := bt.DataHolder.GetAllData()
+	if err != nil {
+		return err
+	}
+	var errs error
+	for i := range dataHolders {
+		latest, err := dataHolders[i].Latest()
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		if latest == nil {
+			continue
+		}
+		funds, err := bt.Funding.GetFundingForEvent(latest)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		errs = gctcommon.AppendError(errs, bt.Exchange.CancelRestingOrders(latest, funds.FundReleaser()))
+	}
+	return errs
+}
+
 // processSignalEvent receives an event from the strategy for processing under the portfolio
 func (bt *BackTest) processSignalEvent(ev signal.Event, funds funding.IFundReserver) error {
 	if ev == nil {
@@ -625,6 +744,9 @@ func (bt *BackTest) Stop() error {
 	if !bt.hasProcessedAnEvent {
 		return nil
 	}
+	if err := bt.cancelAllRestingOrders(); err != nil {
+		log.Errorf(common.Backtester, "Could not cancel resting orders on stop: %s", err)
+	}
 	err := bt.Statistic.CalculateAllResults()
 	if err != nil {
 		return err
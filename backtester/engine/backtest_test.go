@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -44,8 +45,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/tickdata"
 )
 
 const testExchange = "binanceus"
@@ -235,6 +240,53 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadDataTickData(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewBTCUSDT()
+	path := filepath.Join(t.TempDir(), "ticks"+tickdata.FileExtension)
+	f, err := os.Create(path)
+	require.NoError(t, err, "os.Create must not error")
+	w, err := tickdata.NewWriter(f, testExchange, asset.Spot, cp)
+	require.NoError(t, err, "NewWriter must not error")
+	tt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 3 {
+		require.NoError(t, w.WriteBook(&orderbook.Book{
+			Exchange:    testExchange,
+			Asset:       asset.Spot,
+			Pair:        cp,
+			Bids:        orderbook.Levels{{Price: 99 + float64(i), Amount: 1}},
+			Asks:        orderbook.Levels{{Price: 101 + float64(i), Amount: 1}},
+			LastUpdated: tt.Add(time.Duration(i) * time.Minute),
+		}), "WriteBook must not error")
+	}
+	require.NoError(t, w.Close(), "Close must not error")
+	require.NoError(t, f.Close(), "Close must not error")
+
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.OrderbookStr,
+			Interval: gctkline.OneMin,
+			TickData: &config.TickData{FullPath: path},
+			CSVData:  &config.CSVData{FullPath: path},
+		},
+	}
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	assert.ErrorIs(t, err, errAmbiguousDataSource)
+
+	cfg.DataSettings.CSVData = nil
+	resp, err := bt.loadData(cfg, exch, cp, asset.Spot, false)
+	require.NoError(t, err, "loadData must not error")
+	assert.Len(t, resp.Item.Candles, 3)
+	assert.Len(t, resp.Orderbooks, 3)
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -792,6 +844,110 @@ func TestUpdateStatsForDataEvent(t *testing.T) {
 	assert.NoError(t, err, "updateStatsForDataEvent should not error")
 }
 
+type fundingRateFolio struct {
+	fakeFolio
+	positions []futures.Position
+}
+
+func (f fundingRateFolio) GetPositions(common.Event) ([]futures.Position, error) {
+	return f.positions, nil
+}
+
+type realisedPNLExchange struct {
+	*binance.Exchange
+}
+
+func (r realisedPNLExchange) GetCurrencyForRealisedPNL(a asset.Item, p currency.Pair) (currency.Code, asset.Item, error) {
+	return p.Quote, a, nil
+}
+
+func TestApplyFundingRates(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewBTCUSDT()
+	a := asset.PerpetualContract
+	tt := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	ev := &evkline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			Interval:     gctkline.OneHour,
+			CurrencyPair: cp,
+			AssetType:    a,
+			Offset:       1,
+		},
+		Open:  decimal.NewFromInt(100),
+		Close: decimal.NewFromInt(100),
+	}
+	bt := &BackTest{
+		Statistic: &statistics.Statistic{},
+		Funding:   &fakeFunding{hasFutures: true},
+		Portfolio: &fundingRateFolio{},
+	}
+	err := bt.applyFundingRates(ev)
+	assert.NoError(t, err, "applyFundingRates should not error without a data holder")
+
+	bt.DataHolder = data.NewHandlerHolder()
+	err = bt.applyFundingRates(ev)
+	assert.ErrorIs(t, err, data.ErrHandlerNotFound)
+
+	k := kline.NewDataFromKline()
+	k.FundingRates = []gctfundingrate.Rate{
+		{Time: tt.Add(-time.Hour), Rate: decimal.NewFromFloat(0.5)},
+		{Time: tt, Rate: decimal.NewFromFloat(0.01)},
+	}
+	err = bt.DataHolder.SetDataForCurrency(testExchange, a, cp, k)
+	require.NoError(t, err, "SetDataForCurrency must not error")
+
+	err = bt.applyFundingRates(ev)
+	assert.NoError(t, err, "applyFundingRates should not error without a position")
+
+	bt.Portfolio = &fundingRateFolio{
+		positions: []futures.Position{
+			{
+				Status:          gctorder.Open,
+				LatestSize:      decimal.NewFromInt(2),
+				LatestDirection: gctorder.Long,
+			},
+		},
+	}
+	em := engine.NewExchangeManager()
+	exch := &binance.Exchange{}
+	exch.SetDefaults()
+	exch.Name = testExchange
+	err = em.Add(realisedPNLExchange{Exchange: exch})
+	require.NoError(t, err, "Add must not error")
+	bt.exchangeManager = em
+
+	err = bt.Statistic.SetEventForOffset(ev)
+	require.NoError(t, err, "SetEventForOffset must not error")
+
+	err = bt.applyFundingRates(ev)
+	require.NoError(t, err, "applyFundingRates must not error")
+
+	stats := bt.Statistic.(*statistics.Statistic).ExchangeAssetPairStatistics[key.ExchangePairAsset{
+		Exchange: testExchange,
+		Base:     cp.Base.Item,
+		Quote:    cp.Quote.Item,
+		Asset:    a,
+	}]
+	require.NotNil(t, stats, "statistics must be set")
+	require.Len(t, stats.Events, 1)
+	assert.Equal(t, "-2", stats.Events[0].FundingPNL.String(), "longs should pay a positive funding rate only for the candle's funding time")
+
+	bt.Portfolio = &fundingRateFolio{
+		positions: []futures.Position{
+			{
+				Status:          gctorder.Open,
+				LatestSize:      decimal.NewFromInt(2),
+				LatestDirection: gctorder.Short,
+			},
+		},
+	}
+	err = bt.applyFundingRates(ev)
+	require.NoError(t, err, "applyFundingRates must not error")
+	assert.True(t, stats.Events[0].FundingPNL.IsZero(), "shorts should receive a positive funding rate")
+}
+
 func TestProcessSignalEvent(t *testing.T) {
 	t.Parallel()
 	bt := &BackTest{
@@ -1697,6 +1853,7 @@ func TestProcessSingleDataEvent(t *testing.T) {
 	bt := &BackTest{
 		Strategy:   &fakeStrat{},
 		Portfolio:  &fakeFolio{},
+		Exchange:   &exchange.Exchange{},
 		Statistic:  &fakeStats{},
 		Reports:    &fakeReport{},
 		Funding:    &fakeFunding{},
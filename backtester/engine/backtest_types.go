@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
@@ -18,14 +19,15 @@ import (
 )
 
 var (
-	errNilConfig           = errors.New("unable to setup backtester with nil config")
-	errAmbiguousDataSource = errors.New("ambiguous settings received. Only one data type can be set")
-	errNoDataSource        = errors.New("no data settings set in config")
-	errIntervalUnset       = errors.New("candle interval unset")
-	errUnhandledDatatype   = errors.New("unhandled datatype")
-	errNilData             = errors.New("nil data received")
-	errLiveOnly            = errors.New("close all positions is only supported by live data type")
-	errNotSetup            = errors.New("backtesting task not setup")
+	errNilConfig              = errors.New("unable to setup backtester with nil config")
+	errAmbiguousDataSource    = errors.New("ambiguous settings received. Only one data type can be set")
+	errNoDataSource           = errors.New("no data settings set in config")
+	errFundingRateSourceUnset = errors.New("no funding rate data source set")
+	errIntervalUnset          = errors.New("candle interval unset")
+	errUnhandledDatatype      = errors.New("unhandled datatype")
+	errNilData                = errors.New("nil data received")
+	errLiveOnly               = errors.New("close all positions is only supported by live data type")
+	errNotSetup               = errors.New("backtesting task not setup")
 )
 
 // BackTest is the main holder of all backtesting functionality
@@ -75,4 +77,6 @@ type TaskMetaData struct {
 type TaskManager struct {
 	m     sync.Mutex
 	tasks []*BackTest
+	// newBacktester creates the backtest of each optimiser run
+	newBacktester func(*config.Config, *config.BacktesterConfig) (*BackTest, error)
 }
@@ -153,6 +153,10 @@ func (f *fakeStats) AddPNLForTime(*portfolio.PNLSummary) error {
 	return nil
 }
 
+func (f *fakeStats) AddFundingPNLForTime(common.Event, decimal.Decimal) error {
+	return nil
+}
+
 func (f *fakeStats) CreateLog(common.Event) (string, error) {
 	return "", nil
 }
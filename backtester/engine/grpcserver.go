@@ -5,10 +5,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"net"
 	"net/http"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/optimiser"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
@@ -32,6 +35,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -801,3 +805,138 @@ func (s *GRPCServer) ClearAllTasks(_ context.Context, _ *btrpc.ClearAllTasksRequ
 		RemainingTasks: remainingResponse,
 	}, nil
 }
+
+// OptimiseStrategyFromFile runs a strategy once for every parameter set defined by
+// its optimiser settings and returns the ranked results. Any optimiser fields
+// set on the request override those in the strategy file
+func (s *GRPCServer) OptimiseStrategyFromFile(_ context.Context, request *btrpc.OptimiseStrategyFromFileRequest) (*btrpc.OptimiseStrategyResponse, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("%w server config", gctcommon.ErrNilPointer)
+	}
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	cfg, err := config.ReadStrategyConfigFromFile(request.StrategyFilePath)
+	if err != nil {
+		return nil, err
+	}
+	applyOptimiserOverrides(cfg, request)
+
+	report, err := s.manager.Optimise(cfg, s.config)
+	if err != nil {
+		return nil, err
+	}
+	report.PrintResults()
+	resp := &btrpc.OptimiseStrategyResponse{
+		Strategy: report.Strategy,
+		Method:   report.Method,
+		Metric:   report.Metric,
+		Results:  make([]*btrpc.OptimiserResult, len(report.Results)),
+		Windows:  make([]*btrpc.OptimiserWindow, len(report.Windows)),
+	}
+	if s.config.Report.GenerateReport && s.config.Report.OutputPath != "" {
+		resp.ReportPath, err = report.Save(s.config.Report.OutputPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i := range report.Results {
+		resp.Results[i] = convertOptimiserResult(&report.Results[i])
+	}
+	for i := range report.Windows {
+		w := &report.Windows[i]
+		resp.Windows[i] = &btrpc.OptimiserWindow{
+			InSampleStart:    timestamppb.New(w.InSampleStart),
+			InSampleEnd:      timestamppb.New(w.InSampleEnd),
+			OutOfSampleStart: timestamppb.New(w.OutOfSampleStart),
+			OutOfSampleEnd:   timestamppb.New(w.OutOfSampleEnd),
+			InSample:         make([]*btrpc.OptimiserResult, len(w.InSample)),
+		}
+		for j := range w.InSample {
+			resp.Windows[i].InSample[j] = convertOptimiserResult(&w.InSample[j])
+		}
+		if w.OutOfSample != nil {
+			resp.Windows[i].OutOfSample = convertOptimiserResult(w.OutOfSample)
+		}
+	}
+	return resp, nil
+}
+
+// applyOptimiserOverrides sets any optimiser fields from the request onto the
+// strategy config
+func applyOptimiserOverrides(cfg *config.Config, request *btrpc.OptimiseStrategyFromFileRequest) {
+	if cfg.OptimiserSettings == nil {
+		cfg.OptimiserSettings = &config.OptimiserSettings{}
+	}
+	o := cfg.OptimiserSettings
+	if request.Method != "" {
+		o.Method = request.Method
+	}
+	if request.Metric != "" {
+		o.Metric = request.Metric
+	}
+	if len(request.Parameters) > 0 {
+		o.Parameters = make([]config.ParameterRange, len(request.Parameters))
+		for i := range request.Parameters {
+			o.Parameters[i] = config.ParameterRange{
+				Key:     request.Parameters[i].Key,
+				Minimum: request.Parameters[i].Minimum,
+				Maximum: request.Parameters[i].Maximum,
+				Step:    request.Parameters[i].Step,
+			}
+		}
+	}
+	if request.RandomIterations > 0 {
+		o.RandomIterations = request.RandomIterations
+	}
+	if request.RandomSeed != 0 {
+		o.RandomSeed = request.RandomSeed
+	}
+	if request.MaximumParallelTasks > 0 {
+		o.MaximumParallelTasks = request.MaximumParallelTasks
+	}
+	inSample := request.InSampleWindow.AsDuration()
+	outOfSample := request.OutOfSampleWindow.AsDuration()
+	if request.WalkForwardSearchMethod == "" && inSample <= 0 && outOfSample <= 0 {
+		return
+	}
+	if o.WalkForward == nil {
+		o.WalkForward = &config.WalkForward{}
+	}
+	if request.WalkForwardSearchMethod != "" {
+		o.WalkForward.SearchMethod = request.WalkForwardSearchMethod
+	}
+	if inSample > 0 {
+		o.WalkForward.InSampleWindow = inSample
+	}
+	if outOfSample > 0 {
+		o.WalkForward.OutOfSampleWindow = outOfSample
+	}
+}
+
+func convertOptimiserResult(r *optimiser.Result) *btrpc.OptimiserResult {
+	resp := &btrpc.OptimiserResult{
+		Rank:       int64(r.Rank),
+		Parameters: make([]*btrpc.OptimiserParameter, 0, len(r.Parameters)),
+		Error:      r.Error,
+	}
+	for _, k := range slices.Sorted(maps.Keys(r.Parameters)) {
+		resp.Parameters = append(resp.Parameters, &btrpc.OptimiserParameter{
+			Key:   k,
+			Value: r.Parameters[k],
+		})
+	}
+	if r.Score != nil {
+		resp.Score = &btrpc.OptimiserScore{
+			SharpeRatio:      r.Score.SharpeRatio.String(),
+			SortinoRatio:     r.Score.SortinoRatio.String(),
+			CalmarRatio:      r.Score.CalmarRatio.String(),
+			MaxDrawdown:      r.Score.MaxDrawdown.String(),
+			StrategyMovement: r.Score.StrategyMovement.String(),
+		}
+	}
+	return resp
+}
@@ -544,3 +544,62 @@ func TestGRPCClearAllTasks(t *testing.T) {
 	assert.NoError(t, err, "ClearAllTasks should not error")
 	assert.Empty(t, s.manager.tasks, "tasks should be empty")
 }
+
+func TestOptimiseStrategyFromFile(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.OptimiseStrategyFromFile(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "OptimiseStrategyFromFile should error correctly with a nil config")
+
+	s.config, err = config.GenerateDefaultConfig()
+	require.NoError(t, err, "GenerateDefaultConfig must not error")
+	s.config.Report.GenerateReport = false
+	_, err = s.OptimiseStrategyFromFile(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "OptimiseStrategyFromFile should error correctly with a nil task manager")
+
+	s.manager = NewTaskManager()
+	_, err = s.OptimiseStrategyFromFile(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "OptimiseStrategyFromFile should error correctly with a nil request")
+
+	_, err = s.OptimiseStrategyFromFile(t.Context(), &btrpc.OptimiseStrategyFromFileRequest{})
+	assert.ErrorIs(t, err, common.ErrFileNotFound)
+
+	_, err = s.OptimiseStrategyFromFile(t.Context(), &btrpc.OptimiseStrategyFromFileRequest{
+		StrategyFilePath: rsiOptimiserConfigPath,
+		Method:           "meow",
+	})
+	assert.ErrorContains(t, err, "unsupported optimiser method", "OptimiseStrategyFromFile should apply request overrides")
+}
+
+func TestApplyOptimiserOverrides(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{}
+	applyOptimiserOverrides(cfg, &btrpc.OptimiseStrategyFromFileRequest{})
+	require.NotNil(t, cfg.OptimiserSettings, "applyOptimiserOverrides must create optimiser settings")
+	assert.Nil(t, cfg.OptimiserSettings.WalkForward, "applyOptimiserOverrides should not create unrequested walk forward settings")
+
+	applyOptimiserOverrides(cfg, &btrpc.OptimiseStrategyFromFileRequest{
+		Method:                  config.OptimiserWalkForward,
+		Metric:                  config.OptimiserMetricCalmar,
+		Parameters:              []*btrpc.OptimiserParameterRange{{Key: "rsi-low", Minimum: 20, Maximum: 40, Step: 10}},
+		RandomIterations:        5,
+		RandomSeed:              1337,
+		MaximumParallelTasks:    2,
+		WalkForwardSearchMethod: config.OptimiserRandomSearch,
+		InSampleWindow:          durationpb.New(time.Hour),
+		OutOfSampleWindow:       durationpb.New(time.Minute),
+	})
+	assert.Equal(t, &config.OptimiserSettings{
+		Method:               config.OptimiserWalkForward,
+		Metric:               config.OptimiserMetricCalmar,
+		Parameters:           []config.ParameterRange{{Key: "rsi-low", Minimum: 20, Maximum: 40, Step: 10}},
+		RandomIterations:     5,
+		RandomSeed:           1337,
+		MaximumParallelTasks: 2,
+		WalkForward: &config.WalkForward{
+			SearchMethod:      config.OptimiserRandomSearch,
+			InSampleWindow:    time.Hour,
+			OutOfSampleWindow: time.Minute,
+		},
+	}, cfg.OptimiserSettings)
+}
@@ -0,0 +1,208 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/optimiser"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errUnexpectedStatisticsType = errors.New("unexpected statistics type")
+
+// Optimise runs the strategy once for every parameter set generated by the
+// strategy's optimiser settings and ranks the results. Each run is added to the
+// task manager, run in parallel and then cleared once it has been scored
+func (r *TaskManager) Optimise(cfg *config.Config, btCfg *config.BacktesterConfig) (*optimiser.Report, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if btCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.ValidateOptimiserSettings(); err != nil {
+		return nil, err
+	}
+	o := cfg.OptimiserSettings
+	// individual runs only contribute to the comparison report
+	runCfg := *btCfg
+	runCfg.Report.GenerateReport = false
+	runCfg.Report.OutputPath = ""
+	runCfg.Report.TemplatePath = ""
+
+	resp := &optimiser.Report{
+		Strategy: cfg.StrategySettings.Name,
+		Nickname: cfg.Nickname,
+		Method:   o.Method,
+		Metric:   o.Metric,
+	}
+	resp.StartDate, resp.EndDate = dataDates(cfg)
+
+	if o.Method != config.OptimiserWalkForward {
+		sets, err := optimiser.GenerateParameterSets(o, o.Method)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof(common.Optimiser, "Running %v %v search parameter sets", len(sets), o.Method)
+		resp.Results, err = r.runParameterSets(cfg, &runCfg, sets, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		err = optimiser.Rank(resp.Results, o.Metric)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = optimiser.Best(resp.Results); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, resp.Results[0].Error)
+		}
+		resp.GeneratedAt = time.Now()
+		return resp, nil
+	}
+
+	windows, err := optimiser.GenerateWalkForwardWindows(resp.StartDate, resp.EndDate, o.WalkForward.InSampleWindow, o.WalkForward.OutOfSampleWindow)
+	if err != nil {
+		return nil, err
+	}
+	sets, err := optimiser.GenerateParameterSets(o, o.WalkForward.SearchMethod)
+	if err != nil {
+		return nil, err
+	}
+	var tested bool
+	for i := range windows {
+		log.Infof(common.Optimiser, "Walk forward window %v/%v running %v %v search parameter sets", i+1, len(windows), len(sets), o.WalkForward.SearchMethod)
+		windows[i].InSample, err = r.runParameterSets(cfg, &runCfg, sets, windows[i].InSampleStart, windows[i].InSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		err = optimiser.Rank(windows[i].InSample, o.Metric)
+		if err != nil {
+			return nil, err
+		}
+		best, err := optimiser.Best(windows[i].InSample)
+		if err != nil {
+			log.Warnf(common.Optimiser, "Walk forward window %v skipped out-of-sample run: %v", i+1, err)
+			continue
+		}
+		outOfSample, err := r.runParameterSets(cfg, &runCfg, []map[string]float64{best.Parameters}, windows[i].OutOfSampleStart, windows[i].OutOfSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		windows[i].OutOfSample = &outOfSample[0]
+		tested = true
+	}
+	if !tested {
+		return nil, fmt.Errorf("%w in any walk forward window", optimiser.ErrNoSuccessfulRuns)
+	}
+	resp.Windows = windows
+	resp.GeneratedAt = time.Now()
+	return resp, nil
+}
+
+// runParameterSets creates a task for every parameter set, runs them and
+// scores their statistics. Failed runs are recorded against their result
+// so that one bad parameter set does not end the optimisation
+func (r *TaskManager) runParameterSets(cfg *config.Config, btCfg *config.BacktesterConfig, sets []map[string]float64, start, end time.Time) ([]optimiser.Result, error) {
+	results := make([]optimiser.Result, len(sets))
+	tasks := make([]*BackTest, len(sets))
+	ids := make([]uuid.UUID, 0, len(sets))
+	for i := range sets {
+		results[i].Parameters = sets[i]
+		runCfg, err := cloneStrategyConfig(cfg, sets[i], start, end)
+		if err != nil {
+			return nil, err
+		}
+		bt, err := r.newBacktester(runCfg, btCfg)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		err = r.AddTask(bt)
+		if err != nil {
+			return nil, err
+		}
+		tasks[i] = bt
+		ids = append(ids, bt.MetaData.ID)
+	}
+	if len(ids) == 0 {
+		return results, nil
+	}
+	maxParallel := int(cfg.OptimiserSettings.MaximumParallelTasks)
+	if err := r.RunTasks(ids, maxParallel); err != nil {
+		log.Errorf(common.Optimiser, "Optimiser tasks finished with errors: %v", err)
+	}
+	var errs error
+	for i := range tasks {
+		if tasks[i] == nil {
+			continue
+		}
+		stats, ok := tasks[i].Statistic.(*statistics.Statistic)
+		if !ok {
+			results[i].Error = fmt.Errorf("%w %T", errUnexpectedStatisticsType, tasks[i].Statistic).Error()
+		} else if score, err := optimiser.ScoreStatistics(stats); err != nil {
+			results[i].Error = err.Error()
+		} else {
+			results[i].Score = score
+		}
+		errs = gctcommon.AppendError(errs, r.ClearTask(tasks[i].MetaData.ID))
+	}
+	return results, errs
+}
+
+// cloneStrategyConfig deep copies a strategy config and applies a parameter
+// set to its custom settings. Non-zero dates override the data range
+func cloneStrategyConfig(cfg *config.Config, params map[string]float64, start, end time.Time) (*config.Config, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp := &config.Config{}
+	err = json.Unmarshal(b, resp)
+	if err != nil {
+		return nil, err
+	}
+	resp.OptimiserSettings = nil
+	if resp.StrategySettings.CustomSettings == nil {
+		resp.StrategySettings.CustomSettings = make(map[string]any, len(params))
+	}
+	for k, v := range params {
+		resp.StrategySettings.CustomSettings[k] = v
+	}
+	if start.IsZero() || end.IsZero() {
+		return resp, nil
+	}
+	switch {
+	case resp.DataSettings.DatabaseData != nil:
+		resp.DataSettings.DatabaseData.StartDate = start
+		resp.DataSettings.DatabaseData.EndDate = end
+		resp.DataSettings.DatabaseData.InclusiveEndDate = false
+	case resp.DataSettings.APIData != nil:
+		resp.DataSettings.APIData.StartDate = start
+		resp.DataSettings.APIData.EndDate = end
+		resp.DataSettings.APIData.InclusiveEndDate = false
+	}
+	return resp, nil
+}
+
+// dataDates returns the configured data range where one is set
+func dataDates(cfg *config.Config) (start, end time.Time) {
+	switch {
+	case cfg.DataSettings.DatabaseData != nil:
+		return cfg.DataSettings.DatabaseData.StartDate, cfg.DataSettings.DatabaseData.EndDate
+	case cfg.DataSettings.APIData != nil:
+		return cfg.DataSettings.APIData.StartDate, cfg.DataSettings.APIData.EndDate
+	}
+	return time.Time{}, time.Time{}
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/optimiser"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+var rsiOptimiserConfigPath = filepath.Join("..", "config", "strategyexamples", "rsi-csv-candles-optimiser.strat")
+
+// newOfflineBacktester creates a backtest with its exchanges loaded from their
+// defaults, so that runs using CSV data do not require exchange connectivity
+func newOfflineBacktester(cfg *config.Config, btCfg *config.BacktesterConfig) (*BackTest, error) {
+	bt, err := NewBacktester()
+	if err != nil {
+		return nil, err
+	}
+	for i := range cfg.CurrencySettings {
+		if _, err = bt.exchangeManager.GetExchangeByName(cfg.CurrencySettings[i].ExchangeName); err == nil {
+			continue
+		}
+		var exch gctexchange.IBotExchange
+		if exch, err = bt.exchangeManager.NewExchangeByName(cfg.CurrencySettings[i].ExchangeName); err != nil {
+			return nil, err
+		}
+		exch.SetDefaults()
+		if err = bt.exchangeManager.Add(exch); err != nil {
+			return nil, err
+		}
+	}
+	if err = bt.SetupFromConfig(cfg, btCfg.Report.TemplatePath, btCfg.Report.OutputPath, btCfg.Verbose); err != nil {
+		return nil, err
+	}
+	return bt, nil
+}
+
+func TestOptimise(t *testing.T) {
+	t.Parallel()
+	var tm *TaskManager
+	_, err := tm.Optimise(nil, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	tm = NewTaskManager()
+	_, err = tm.Optimise(nil, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	cfg, err := config.ReadStrategyConfigFromFile(rsiOptimiserConfigPath)
+	require.NoError(t, err, "ReadStrategyConfigFromFile must not error")
+	_, err = tm.Optimise(cfg, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	btCfg := &config.BacktesterConfig{}
+	cfg.DataSettings.CSVData.FullPath = filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg.OptimiserSettings.Parameters = []config.ParameterRange{
+		{Key: "rsi-period", Minimum: 10, Maximum: 20, Step: 10},
+	}
+	errRunFailed := errors.New("run failed")
+	tm.newBacktester = func(*config.Config, *config.BacktesterConfig) (*BackTest, error) {
+		return nil, errRunFailed
+	}
+	_, err = tm.Optimise(cfg, btCfg)
+	assert.ErrorIs(t, err, optimiser.ErrNoSuccessfulRuns, "Optimise should error when every run fails")
+	assert.ErrorContains(t, err, errRunFailed.Error(), "Optimise should include the run error")
+
+	tm.newBacktester = newOfflineBacktester
+	report, err := tm.Optimise(cfg, btCfg)
+	require.NoError(t, err, "Optimise must not error")
+	require.Len(t, report.Results, 2, "Optimise must return a result per parameter set")
+	for i := range report.Results {
+		assert.Empty(t, report.Results[i].Error, "Result should not error")
+		assert.NotNil(t, report.Results[i].Score, "Result should be scored")
+		assert.Equal(t, i+1, report.Results[i].Rank, "Result should be ranked")
+	}
+	tasks, err := tm.List()
+	require.NoError(t, err, "List must not error")
+	assert.Empty(t, tasks, "Optimise should clear its tasks")
+
+	cfg.OptimiserSettings = nil
+	_, err = tm.Optimise(cfg, btCfg)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+}
+
+func TestCloneStrategyConfig(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{
+			CustomSettings: map[string]any{"rsi-low": 30.0},
+		},
+		DataSettings: config.DataSettings{
+			APIData: &config.APIData{InclusiveEndDate: true},
+		},
+		OptimiserSettings: &config.OptimiserSettings{},
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	c, err := cloneStrategyConfig(cfg, map[string]float64{"rsi-low": 20, "rsi-high": 80}, start, end)
+	require.NoError(t, err, "cloneStrategyConfig must not error")
+	assert.Nil(t, c.OptimiserSettings, "cloneStrategyConfig should remove optimiser settings")
+	assert.Equal(t, 20.0, c.StrategySettings.CustomSettings["rsi-low"], "cloneStrategyConfig should override custom settings")
+	assert.Equal(t, 80.0, c.StrategySettings.CustomSettings["rsi-high"], "cloneStrategyConfig should add custom settings")
+	assert.Equal(t, 30.0, cfg.StrategySettings.CustomSettings["rsi-low"], "cloneStrategyConfig should not modify the original config")
+	assert.True(t, c.DataSettings.APIData.StartDate.Equal(start), "cloneStrategyConfig should set the start date")
+	assert.True(t, c.DataSettings.APIData.EndDate.Equal(end), "cloneStrategyConfig should set the end date")
+	assert.False(t, c.DataSettings.APIData.InclusiveEndDate, "cloneStrategyConfig should exclude the end date")
+	assert.True(t, cfg.DataSettings.APIData.InclusiveEndDate, "cloneStrategyConfig should not modify the original dates")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/tickdata"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -39,6 +41,7 @@ import (
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -513,6 +516,14 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 				continue
 			}
 
+			if cfg.CurrencySettings[i].FuturesDetails != nil &&
+				cfg.CurrencySettings[i].FuturesDetails.FundingRates != nil {
+				klineData.FundingRates, err = bt.loadFundingRates(cfg, cfg.CurrencySettings[i].FuturesDetails.FundingRates, exch, pair, a)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			err = bt.DataHolder.SetDataForCurrency(exchangeName, a, pair, klineData)
 			if err != nil {
 				return nil, err
@@ -695,18 +706,22 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		return nil, engine.ErrExchangeNotFound
 	}
 	b := exch.GetBase()
-	if cfg.DataSettings.DatabaseData == nil &&
-		cfg.DataSettings.LiveData == nil &&
-		cfg.DataSettings.APIData == nil &&
-		cfg.DataSettings.CSVData == nil {
+	var dataSources int
+	for _, set := range []bool{
+		cfg.DataSettings.DatabaseData != nil,
+		cfg.DataSettings.LiveData != nil,
+		cfg.DataSettings.APIData != nil,
+		cfg.DataSettings.CSVData != nil,
+		cfg.DataSettings.TickData != nil,
+	} {
+		if set {
+			dataSources++
+		}
+	}
+	if dataSources == 0 {
 		return nil, errNoDataSource
 	}
-	if (cfg.DataSettings.APIData != nil && cfg.DataSettings.DatabaseData != nil) ||
-		(cfg.DataSettings.APIData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.APIData != nil && cfg.DataSettings.CSVData != nil) ||
-		(cfg.DataSettings.DatabaseData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil) {
+	if dataSources > 1 {
 		return nil, errAmbiguousDataSource
 	}
 
@@ -768,6 +783,38 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if len(summary) > 0 {
 			log.Warnf(common.Setup, "%v", summary)
 		}
+	case cfg.DataSettings.TickData != nil:
+		if cfg.DataSettings.Interval <= 0 {
+			return nil, errIntervalUnset
+		}
+		resp, err = tickdata.LoadData(
+			dataType,
+			cfg.DataSettings.TickData.FullPath,
+			strings.ToLower(exch.GetName()),
+			cfg.DataSettings.Interval.Duration(),
+			fPair,
+			a,
+			isUSDTrackingPair)
+		if err != nil {
+			return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
+		}
+		resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+			resp.Item.Candles[0].Time,
+			resp.Item.Candles[len(resp.Item.Candles)-1].Time.Add(cfg.DataSettings.Interval.Duration()),
+			cfg.DataSettings.Interval,
+			0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = resp.RangeHolder.SetHasDataFromCandles(resp.Item.Candles)
+		if err != nil {
+			return nil, err
+		}
+		summary := resp.RangeHolder.DataSummary(false)
+		if len(summary) > 0 {
+			log.Warnf(common.Setup, "%v", summary)
+		}
 	case cfg.DataSettings.DatabaseData != nil:
 		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
 			cfg.DataSettings.DatabaseData.EndDate = cfg.DataSettings.DatabaseData.EndDate.Add(cfg.DataSettings.Interval.Duration())
@@ -887,6 +934,57 @@ func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a as
 		isUSDTrackingPair)
 }
 
+// loadFundingRates loads historical funding rates from a csv file when set,
+// otherwise from the API or database data source used to load candles
+func (bt *BackTest) loadFundingRates(cfg *config.Config, fr *config.FundingRates, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) ([]gctfundingrate.Rate, error) {
+	if fr == nil {
+		return nil, fmt.Errorf("%w funding rate settings", gctcommon.ErrNilPointer)
+	}
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	log.Infof(common.Setup, "Loading funding rates for %v %v %v...\n", exch.GetName(), a, fPair)
+	var rates []gctfundingrate.Rate
+	var err error
+	switch {
+	case fr.CSVFilePath != "":
+		rates, err = csv.LoadFundingRates(fr.CSVFilePath, strings.ToLower(exch.GetName()), fPair, a)
+	case cfg.DataSettings.APIData != nil:
+		rates, err = api.LoadFundingRates(context.TODO(),
+			cfg.DataSettings.APIData.StartDate,
+			cfg.DataSettings.APIData.EndDate,
+			exch,
+			fPair,
+			a)
+	case cfg.DataSettings.DatabaseData != nil:
+		err = bt.databaseManager.Start(&sync.WaitGroup{})
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			stopErr := bt.databaseManager.Stop()
+			if stopErr != nil {
+				log.Errorln(common.Setup, stopErr)
+			}
+		}()
+		rates, err = database.LoadFundingRates(
+			cfg.DataSettings.DatabaseData.StartDate,
+			cfg.DataSettings.DatabaseData.EndDate,
+			exch.GetName(),
+			fPair,
+			a)
+	default:
+		return nil, fmt.Errorf("%v %v %v %w", exch.GetName(), a, fPair, errFundingRateSourceUnset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(rates, func(i, j gctfundingrate.Rate) int {
+		return i.Time.Compare(j.Time)
+	})
+	return rates, nil
+}
+
 func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, resultLimit uint64, dataType int64) (*kline.DataFromKline, error) {
 	if cfg.DataSettings.Interval <= 0 {
 		return nil, errIntervalUnset
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"sync"
 
 	"github.com/gofrs/uuid"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
@@ -16,11 +17,12 @@ var (
 	errTaskHasNotRan        = errors.New("task hasn't ran yet")
 	errTaskIsRunning        = errors.New("task is already running")
 	errCannotClear          = errors.New("cannot clear task")
+	errNoTasksToRun         = errors.New("no tasks to run")
 )
 
 // NewTaskManager creates a run manager to allow the backtester to manage multiple strategies
 func NewTaskManager() *TaskManager {
-	return &TaskManager{}
+	return &TaskManager{newBacktester: NewBacktesterFromConfigs}
 }
 
 // AddTask adds a run to the manager
@@ -172,6 +174,55 @@ func (r *TaskManager) StartAllTasks() ([]uuid.UUID, error) {
 	return executedRuns, nil
 }
 
+// RunTasks executes the tasks matching the IDs and waits for them all to
+// finish. A maxParallel of zero or less runs all tasks at once
+func (r *TaskManager) RunTasks(ids []uuid.UUID, maxParallel int) error {
+	if r == nil {
+		return fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if len(ids) == 0 {
+		return errNoTasksToRun
+	}
+	r.m.Lock()
+	tasks := make([]*BackTest, 0, len(ids))
+	for _, id := range ids {
+		idx := slices.IndexFunc(r.tasks, func(bt *BackTest) bool { return bt.MatchesID(id) })
+		if idx == -1 {
+			r.m.Unlock()
+			return fmt.Errorf("%s %w", id, errTaskNotFound)
+		}
+		tasks = append(tasks, r.tasks[idx])
+	}
+	r.m.Unlock()
+
+	if maxParallel <= 0 || maxParallel > len(tasks) {
+		maxParallel = len(tasks)
+	}
+	var (
+		wg      sync.WaitGroup
+		errMtx  sync.Mutex
+		errs    error
+		limiter = make(chan struct{}, maxParallel)
+	)
+	for _, task := range tasks {
+		wg.Add(1)
+		limiter <- struct{}{}
+		go func(bt *BackTest) {
+			defer func() {
+				<-limiter
+				wg.Done()
+			}()
+			if err := bt.ExecuteStrategy(true); err != nil {
+				errMtx.Lock()
+				errs = gctcommon.AppendError(errs, fmt.Errorf("%v %w", bt.MetaData.ID, err))
+				errMtx.Unlock()
+			}
+		}(task)
+	}
+	wg.Wait()
+	return errs
+}
+
 // ClearTask removes a run from memory, but only if it is not running
 func (r *TaskManager) ClearTask(id uuid.UUID) error {
 	if r == nil {
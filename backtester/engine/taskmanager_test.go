@@ -6,6 +6,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
@@ -363,3 +364,44 @@ func TestClearAllRuns(t *testing.T) {
 	_, _, err = rm.ClearAllTasks()
 	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
 }
+
+func TestRunTasks(t *testing.T) {
+	t.Parallel()
+	var rm *TaskManager
+	err := rm.RunTasks(nil, 0)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	rm = NewTaskManager()
+	err = rm.RunTasks(nil, 0)
+	assert.ErrorIs(t, err, errNoTasksToRun)
+
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	err = rm.RunTasks([]uuid.UUID{id}, 0)
+	assert.ErrorIs(t, err, errTaskNotFound)
+
+	ids := make([]uuid.UUID, 3)
+	for i := range ids {
+		bt := &BackTest{
+			Strategy:   &binancecashandcarry.Strategy{},
+			EventQueue: &eventholder.Holder{},
+			DataHolder: &data.HandlerHolder{},
+			Statistic:  &statistics.Statistic{},
+			shutdown:   make(chan struct{}),
+		}
+		err = rm.AddTask(bt)
+		require.NoError(t, err, "AddTask must not error")
+		ids[i] = bt.MetaData.ID
+	}
+	err = rm.RunTasks(ids, 2)
+	assert.NoError(t, err, "RunTasks should not error")
+
+	tasks, err := rm.List()
+	require.NoError(t, err, "List must not error")
+	for i := range tasks {
+		assert.True(t, tasks[i].MetaData.Closed, "RunTasks should wait for all tasks to finish")
+	}
+
+	err = rm.RunTasks(ids, 0)
+	assert.ErrorIs(t, err, errAlreadyRan)
+}
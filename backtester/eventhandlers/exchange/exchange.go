@@ -19,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // Reset returns the exchange to initial settings
@@ -27,6 +28,7 @@ func (e *Exchange) Reset() error {
 		return gctcommon.ErrNilPointer
 	}
 	e.CurrencySettings = nil
+	e.restingOrders = nil
 	return nil
 }
 
@@ -52,6 +54,29 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 	}
 	f.Direction = o.GetDirection()
 
+	orderType := gctorder.Market
+	var limitPrice decimal.Decimal
+	if isRestingOrderType(o.GetOrderType()) && !o.IsClosingPosition() && !o.IsLiquidating() {
+		if cs.UseRealOrders {
+			setCannotPurchaseDirection(f)
+			return f, errRestingOrdersRealOrders
+		}
+		var rested bool
+		rested, limitPrice, err = e.restOrder(o, f)
+		if err != nil {
+			f.AppendReasonf("could not place %v order: %v", o.GetOrderType(), err)
+			if releaseErr := releaseReservedFunds(o, funds); releaseErr != nil {
+				err = gctcommon.AppendError(err, releaseErr)
+			}
+			setCannotPurchaseDirection(f)
+			return f, err
+		}
+		if rested {
+			return f, nil
+		}
+		orderType = submissionType(o.GetOrderType())
+	}
+
 	var price, adjustedPrice,
 		amount, adjustedAmount,
 		fee decimal.Decimal
@@ -79,6 +104,13 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			}
 			return f, nil
 		}
+	} else if ob := recordedOrderbook(o, dh); ob != nil {
+		price, amount, err = fitOrderToOrderbook(f, ob, price, amount)
+		if err != nil {
+			setCannotPurchaseDirection(f)
+			return f, err
+		}
+		adjustedPrice = price
 	} else {
 		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
 		if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
@@ -112,6 +144,14 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 	}
 
+	if limitPrice.IsPositive() {
+		if cappedPrice := capPriceToLimit(f.GetDirection(), price, limitPrice); !cappedPrice.Equal(price) {
+			f.AppendReasonf("Price capped from %v to limit price %v", price, cappedPrice)
+			price = cappedPrice
+			adjustedPrice = cappedPrice
+		}
+	}
+
 	adjustedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, allocatedFunds, f.GetDirection())
 	if !adjustedAmount.Equal(amount) {
 		f.AppendReasonf("Order size shrunk from %v to %v to remain within portfolio limits", amount, adjustedAmount)
@@ -136,20 +176,37 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 
 	fee = calculateExchangeFee(price, amount, cs.TakerFee)
 
-	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, f, om)
+	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, f, om, orderType)
 	if err != nil {
 		f.AppendReasonf("could not place order: %v", err)
 		setCannotPurchaseDirection(f)
 		return f, err
 	}
+	orderErr := setFillOrder(f, om, orderID, o.GetTime())
+	if !o.IsLiquidating() {
+		err = allocateFundsPostOrder(f, funds, nil, o.GetAmount(), allocatedFunds, amount, price, fee)
+		if err != nil {
+			return f, err
+		}
+	}
+	if orderErr != nil {
+		return nil, orderErr
+	}
+	f.AppendReason(summarisePosition(f.GetDirection(), f.Amount, f.Amount.Mul(f.PurchasePrice), f.ExchangeFee, f.Order.Pair, f.UnderlyingPair))
+	return f, nil
+}
+
+// setFillOrder populates the fill with the placed order's details from the
+// order manager
+func setFillOrder(f *fill.Fill, om *engine.OrderManager, orderID string, t time.Time) error {
 	ords := om.GetOrdersSnapshot(gctorder.UnknownStatus)
 	for i := range ords {
 		if ords[i].OrderID != orderID {
 			continue
 		}
-		ords[i].Date = o.GetTime()
-		ords[i].LastUpdated = o.GetTime()
-		ords[i].CloseTime = o.GetTime()
+		ords[i].Date = t
+		ords[i].LastUpdated = t
+		ords[i].CloseTime = t
 		f.Order = &ords[i]
 		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
 		f.Amount = decimal.NewFromFloat(ords[i].Amount)
@@ -158,17 +215,73 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		}
 		f.Total = f.PurchasePrice.Mul(f.Amount).Add(f.ExchangeFee)
 	}
-	if !o.IsLiquidating() {
-		err = allocateFundsPostOrder(f, funds, err, o.GetAmount(), allocatedFunds, amount, price, fee)
-		if err != nil {
-			return f, err
-		}
-	}
 	if f.Order == nil {
-		return nil, fmt.Errorf("placed order %v not found in order manager", orderID)
+		return fmt.Errorf("placed order %v not found in order manager", orderID)
 	}
-	f.AppendReason(summarisePosition(f.GetDirection(), f.Amount, f.Amount.Mul(f.PurchasePrice), f.ExchangeFee, f.Order.Pair, f.UnderlyingPair))
-	return f, nil
+	return nil
+}
+
+// releaseReservedFunds returns the funds reserved for a spot order which
+// could not be placed
+func releaseReservedFunds(o order.Event, funds funding.IFundReleaser) error {
+	if o.GetAssetType() != asset.Spot || !o.GetAllocatedFunds().IsPositive() {
+		return nil
+	}
+	pr, err := funds.PairReleaser()
+	if err != nil {
+		return err
+	}
+	return pr.Release(o.GetAllocatedFunds(), o.GetAllocatedFunds(), o.GetDirection())
+}
+
+// capPriceToLimit ensures a marketable limit order does not fill beyond its
+// limit price
+func capPriceToLimit(side gctorder.Side, price, limitPrice decimal.Decimal) decimal.Decimal {
+	switch side {
+	case gctorder.Buy, gctorder.Bid:
+		return decimal.Min(price, limitPrice)
+	case gctorder.Sell, gctorder.Ask:
+		return decimal.Max(price, limitPrice)
+	}
+	return price
+}
+
+// recordedOrderbook returns the recorded orderbook for the order's candle when
+// the data handler replays orderbook data
+func recordedOrderbook(o order.Event, dh data.Handler) *orderbook.Book {
+	if o.GetDirection() == gctorder.ClosePosition {
+		return nil
+	}
+	obh, ok := dh.(data.OrderbookHandler)
+	if !ok {
+		return nil
+	}
+	return obh.GetOrderbookAtTime(o.GetTime())
+}
+
+// fitOrderToOrderbook fills the order against the recorded orderbook depth
+// instead of fitting it to the candle and estimating slippage
+func fitOrderToOrderbook(f *fill.Fill, ob *orderbook.Book, price, amount decimal.Decimal) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
+	adjustedPrice, adjustedAmount, err = slippage.CalculateFillByOrderbook(ob, f.GetDirection(), amount)
+	if err != nil {
+		f.AppendReasonf("could not fill order against recorded orderbook: %v", err)
+		return price, amount, err
+	}
+	if !adjustedAmount.Equal(amount) {
+		f.AppendReasonf("Order size shrunk from %v to %v to fit recorded orderbook depth", amount, adjustedAmount)
+	}
+	if !adjustedPrice.Equal(price) {
+		f.AppendReasonf("Price adjusted walking recorded orderbook from %v to %v", price, adjustedPrice)
+	}
+	f.VolumeAdjustedPrice = adjustedPrice
+	if !price.IsZero() {
+		// Slippage is negative when the fill is worse than the close price
+		f.Slippage = adjustedPrice.Sub(price).Div(price).Mul(decimal.NewFromInt(100))
+		if f.GetDirection().IsLong() {
+			f.Slippage = f.Slippage.Neg()
+		}
+	}
+	return adjustedPrice, adjustedAmount, nil
 }
 
 func allocateFundsPostOrder(f *fill.Fill, funds funding.IFundReleaser, orderError error, orderAmount, allocatedFunds, limitReducedAmount, adjustedPrice, fee decimal.Decimal) error {
@@ -337,7 +450,7 @@ func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal
 	return amount
 }
 
-func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.Decimal, useRealOrders, useExchangeLimits bool, f fill.Event, orderManager *engine.OrderManager) (string, error) {
+func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.Decimal, useRealOrders, useExchangeLimits bool, f fill.Event, orderManager *engine.OrderManager, orderType gctorder.Type) (string, error) {
 	if f == nil {
 		return "", common.ErrNilEvent
 	}
@@ -353,7 +466,7 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.De
 		Side:             f.GetDirection(),
 		AssetType:        f.GetAssetType(),
 		Pair:             f.Pair(),
-		Type:             gctorder.Market,
+		Type:             orderType,
 		RetrieveFees:     true,
 		RetrieveFeeDelay: time.Millisecond * 500,
 	}
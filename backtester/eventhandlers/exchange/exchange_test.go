@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
@@ -26,6 +27,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -196,24 +198,24 @@ func TestPlaceOrder(t *testing.T) {
 	require.NoError(t, err, "Start must not error")
 
 	e := Exchange{}
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, nil, nil)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, nil, nil, gctorder.Market)
 	assert.ErrorIs(t, err, common.ErrNilEvent)
 	f := &fill.Fill{
 		Base: &event.Base{},
 	}
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager, gctorder.Market)
 	assert.ErrorIs(t, err, engine.ErrExchangeNameIsEmpty)
 
 	f.Exchange = testExchange
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager, gctorder.Market)
 	assert.ErrorIs(t, err, gctorder.ErrPairIsEmpty)
 
 	f.CurrencyPair = currency.NewBTCUSDT()
 	f.AssetType = asset.Spot
 	f.Direction = gctorder.Buy
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager, gctorder.Market)
 	assert.NoError(t, err, "placeOrder should not error")
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, true, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, true, true, f, bot.OrderManager, gctorder.Market)
 	assert.ErrorIs(t, err, exchange.ErrCredentialsAreEmpty)
 }
 
@@ -503,6 +505,45 @@ func TestApplySlippageToPrice(t *testing.T) {
 	assert.ErrorIs(t, err, gctorder.ErrSideIsInvalid)
 }
 
+func TestRecordedOrderbook(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	ob := &orderbook.Book{Asks: orderbook.Levels{{Price: 101, Amount: 1}}}
+	d := kline.NewDataFromKline()
+	o := &order.Order{Base: &event.Base{Time: tt}, Direction: gctorder.Buy}
+	assert.Nil(t, recordedOrderbook(o, d), "recordedOrderbook should return nil without recorded orderbooks")
+
+	d.Orderbooks = map[int64]*orderbook.Book{tt.Unix(): ob}
+	assert.Equal(t, ob, recordedOrderbook(o, d))
+
+	o.Direction = gctorder.ClosePosition
+	assert.Nil(t, recordedOrderbook(o, d), "recordedOrderbook should return nil when closing a position")
+}
+
+func TestFitOrderToOrderbook(t *testing.T) {
+	t.Parallel()
+	ob := &orderbook.Book{
+		Bids: orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks: orderbook.Levels{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}},
+	}
+	f := &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy}
+	price, amount, err := fitOrderToOrderbook(f, ob, decimal.NewFromInt(100), decimal.NewFromInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, "102", price.String(), "price should be the average of the asks walked")
+	assert.Equal(t, "2", amount.String())
+	assert.Equal(t, "-2", f.Slippage.String(), "slippage should be negative when buying above the close price")
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Sell}
+	price, amount, err = fitOrderToOrderbook(f, ob, decimal.NewFromInt(100), decimal.NewFromInt(3))
+	require.NoError(t, err)
+	assert.Equal(t, "98.5", price.String())
+	assert.Equal(t, "2", amount.String(), "amount should shrink to the recorded depth")
+	assert.Equal(t, "-1.5", f.Slippage.String(), "slippage should be negative when selling below the close price")
+
+	_, _, err = fitOrderToOrderbook(f, &orderbook.Book{}, decimal.NewFromInt(100), decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, slippage.ErrNoLiquidity)
+}
+
 func TestReduceAmountToFitPortfolioLimit(t *testing.T) {
 	t.Parallel()
 	initialPrice := decimal.NewFromInt(100)
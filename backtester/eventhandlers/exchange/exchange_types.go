@@ -2,8 +2,10 @@ package exchange
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
@@ -23,6 +25,13 @@ var (
 	errNilCurrencySettings     = errors.New("received nil currency settings")
 	errInvalidDirection        = errors.New("received invalid order direction")
 	errNoCurrencySettingsFound = errors.New("no currency settings found")
+	errRestingOrdersSpotOnly   = errors.New("resting orders are only supported for spot assets")
+	errRestingOrdersRealOrders = errors.New("resting orders are not supported when placing real orders")
+	errUnsupportedOrderType    = errors.New("unsupported order type")
+	errInvalidLimitPrice       = errors.New("limit price must be greater than zero")
+	errInvalidTriggerPrice     = errors.New("trigger price must be greater than zero")
+	errExpiryTimeUnset         = errors.New("expiry time must be set for good till time orders")
+	errPostOnlyWouldTake       = errors.New("post only order would immediately match")
 )
 
 // ExecutionHandler interface dictates what functions are required to submit an order
@@ -30,12 +39,39 @@ type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(asset.Item, currency.Pair, *Settings)
 	GetCurrencySettings(string, asset.Item, currency.Pair) (Settings, error)
 	ExecuteOrder(order.Event, data.Handler, *engine.OrderManager, funding.IFundReleaser) (fill.Event, error)
+	MatchRestingOrders(data.Event, *engine.OrderManager, funding.IFundReleaser) ([]fill.Event, error)
+	CancelRestingOrders(common.Event, funding.IFundReleaser) error
 	Reset() error
 }
 
 // Exchange contains all the currency settings
 type Exchange struct {
 	CurrencySettings []Settings
+	restingOrders    []*RestingOrder
+}
+
+// RestingOrder is a limit, stop or take profit order waiting on the
+// simulated exchange. It is matched against the candles following its
+// placement and keeps its funds reserved until it is filled, cancelled or
+// expires
+type RestingOrder struct {
+	ID           string
+	Exchange     string
+	Asset        asset.Item
+	Pair         currency.Pair
+	Side         gctorder.Side
+	Type         gctorder.Type
+	TimeInForce  gctorder.TimeInForce
+	LimitPrice   decimal.Decimal
+	TriggerPrice decimal.Decimal
+	ExpiryTime   time.Time
+	Amount       decimal.Decimal
+	Remaining    decimal.Decimal
+	// Reserved is the amount of funding still reserved for the order. It is
+	// quote currency for buy orders and base currency for sell orders
+	Reserved  decimal.Decimal
+	Triggered bool
+	PlacedAt  time.Time
 }
 
 // Settings allow the eventhandler to size an order within the limitations set by the config file
@@ -0,0 +1,447 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// isRestingOrderType returns whether the order type may rest on the
+// simulated exchange rather than be filled at market
+func isRestingOrderType(t gctorder.Type) bool {
+	return t != gctorder.UnknownType && t != gctorder.Market
+}
+
+// submissionType returns the order type submitted to the order manager, which
+// only accepts market and limit orders
+func submissionType(t gctorder.Type) gctorder.Type {
+	if t.Is(gctorder.Limit) {
+		return gctorder.Limit
+	}
+	return gctorder.Market
+}
+
+// newRestingOrder validates the order event's resting order details
+func newRestingOrder(o order.Event) (*RestingOrder, error) {
+	if o.GetAssetType() != asset.Spot {
+		return nil, fmt.Errorf("%w %v", errRestingOrdersSpotOnly, o.GetAssetType())
+	}
+	switch o.GetOrderType() {
+	case gctorder.Limit, gctorder.Stop, gctorder.StopMarket, gctorder.StopLimit, gctorder.TakeProfit, gctorder.TakeProfitMarket:
+	default:
+		return nil, fmt.Errorf("%w %v", errUnsupportedOrderType, o.GetOrderType())
+	}
+	switch o.GetDirection() {
+	case gctorder.Buy, gctorder.Bid, gctorder.Sell, gctorder.Ask:
+	default:
+		return nil, fmt.Errorf("%w: %v", errInvalidDirection, o.GetDirection())
+	}
+	ro := &RestingOrder{
+		Exchange:     o.GetExchange(),
+		Asset:        o.GetAssetType(),
+		Pair:         o.Pair(),
+		Side:         o.GetDirection(),
+		Type:         o.GetOrderType(),
+		TimeInForce:  o.GetTimeInForce(),
+		LimitPrice:   o.GetLimitPrice(),
+		TriggerPrice: o.GetTriggerPrice(),
+		ExpiryTime:   o.GetExpiryTime(),
+		Amount:       o.GetAmount(),
+		Remaining:    o.GetAmount(),
+		Reserved:     o.GetAllocatedFunds(),
+		PlacedAt:     o.GetTime(),
+	}
+	if ro.isLimit() && !ro.LimitPrice.IsPositive() {
+		return nil, errInvalidLimitPrice
+	}
+	if ro.hasTrigger() && !ro.TriggerPrice.IsPositive() {
+		return nil, errInvalidTriggerPrice
+	}
+	if ro.TimeInForce == gctorder.UnknownTIF {
+		ro.TimeInForce = gctorder.GoodTillCancel
+	}
+	if !ro.TimeInForce.IsValid() || ro.TimeInForce.Is(gctorder.GoodTillCrossing) {
+		return nil, fmt.Errorf("%w %v", gctorder.ErrUnsupportedTimeInForce, ro.TimeInForce)
+	}
+	if ro.TimeInForce.Is(gctorder.GoodTillTime) && ro.ExpiryTime.IsZero() {
+		return nil, errExpiryTimeUnset
+	}
+	if !ro.Amount.IsPositive() {
+		return nil, gctorder.ErrAmountIsInvalid
+	}
+	return ro, nil
+}
+
+// isBuy returns whether the order buys the base currency
+func (r *RestingOrder) isBuy() bool {
+	return r.Side == gctorder.Buy || r.Side == gctorder.Bid
+}
+
+// isLimit returns whether the order has a limit price
+func (r *RestingOrder) isLimit() bool {
+	return r.Type.Is(gctorder.Limit)
+}
+
+// hasTrigger returns whether the order waits for its trigger price
+func (r *RestingOrder) hasTrigger() bool {
+	return r.Type.Is(gctorder.Stop) || r.Type.Is(gctorder.TakeProfit)
+}
+
+// triggersOnRise returns whether the order triggers when the price rises to
+// its trigger price. Buy stops and sell take profits trigger on a rise, sell
+// stops and buy take profits trigger on a fall
+func (r *RestingOrder) triggersOnRise() bool {
+	return r.Type.Is(gctorder.Stop) == r.isBuy()
+}
+
+// isTriggeredAt returns whether the price has reached the trigger price
+func (r *RestingOrder) isTriggeredAt(price decimal.Decimal) bool {
+	if r.triggersOnRise() {
+		return price.GreaterThanOrEqual(r.TriggerPrice)
+	}
+	return price.LessThanOrEqual(r.TriggerPrice)
+}
+
+// isMarketableAt returns whether the limit price would immediately match the
+// price
+func (r *RestingOrder) isMarketableAt(price decimal.Decimal) bool {
+	if r.isBuy() {
+		return price.LessThanOrEqual(r.LimitPrice)
+	}
+	return price.GreaterThanOrEqual(r.LimitPrice)
+}
+
+// match returns the price the order fills at against a candle and whether
+// it provided liquidity. Triggered orders fill at their trigger price, or at
+// the open price when the candle opened beyond it. Stop limit orders which
+// are not marketable once triggered rest as limit orders from the next candle
+func (r *RestingOrder) match(open, high, low decimal.Decimal) (price decimal.Decimal, isMaker, ok bool) {
+	if r.hasTrigger() && !r.Triggered {
+		switch {
+		case r.triggersOnRise() && high.GreaterThanOrEqual(r.TriggerPrice):
+			price = decimal.Max(r.TriggerPrice, open)
+		case !r.triggersOnRise() && low.LessThanOrEqual(r.TriggerPrice):
+			price = decimal.Min(r.TriggerPrice, open)
+		default:
+			return decimal.Zero, false, false
+		}
+		r.Triggered = true
+		if !r.isLimit() || r.isMarketableAt(price) {
+			return price, false, true
+		}
+		return decimal.Zero, false, false
+	}
+	if !r.isLimit() {
+		return decimal.Zero, false, false
+	}
+	if (r.isBuy() && low.LessThanOrEqual(r.LimitPrice)) ||
+		(!r.isBuy() && high.GreaterThanOrEqual(r.LimitPrice)) {
+		return r.LimitPrice, true, true
+	}
+	return decimal.Zero, false, false
+}
+
+// hasExpired returns whether the order's time in force has lapsed
+func (r *RestingOrder) hasExpired(t time.Time) bool {
+	switch {
+	case r.TimeInForce.Is(gctorder.GoodTillTime):
+		return !t.Before(r.ExpiryTime)
+	case r.TimeInForce.Is(gctorder.GoodTillDay):
+		return !t.UTC().Truncate(time.Hour * 24).Equal(r.PlacedAt.UTC().Truncate(time.Hour * 24))
+	}
+	return false
+}
+
+// isSingleCandle returns whether the order is cancelled after it has been
+// matched against a single candle
+func (r *RestingOrder) isSingleCandle() bool {
+	return r.TimeInForce.Is(gctorder.ImmediateOrCancel) || r.TimeInForce.Is(gctorder.FillOrKill)
+}
+
+// releaseFunds returns the order's remaining reserved funds to available
+func (r *RestingOrder) releaseFunds(pr funding.IPairReleaser) error {
+	if !r.Reserved.IsPositive() {
+		return nil
+	}
+	if err := pr.Release(r.Reserved, r.Reserved, r.Side); err != nil {
+		return err
+	}
+	r.Reserved = decimal.Zero
+	return nil
+}
+
+// restOrder places the order on the simulated exchange when it cannot be
+// filled immediately. Orders which are marketable at the close price are
+// returned to be filled at market, with limitPrice set when the fill must
+// not exceed a limit
+func (e *Exchange) restOrder(o order.Event, f *fill.Fill) (rested bool, limitPrice decimal.Decimal, err error) {
+	ro, err := newRestingOrder(o)
+	if err != nil {
+		return false, decimal.Zero, err
+	}
+	closePrice := o.GetClosePrice()
+	if ro.hasTrigger() {
+		if !ro.isTriggeredAt(closePrice) {
+			return true, decimal.Zero, e.addRestingOrder(ro, f)
+		}
+		ro.Triggered = true
+		if !ro.isLimit() {
+			f.AppendReasonf("%v order triggered at close price %v with trigger price %v", ro.Type, closePrice, ro.TriggerPrice)
+			return false, decimal.Zero, nil
+		}
+	}
+	if !ro.isMarketableAt(closePrice) {
+		return true, decimal.Zero, e.addRestingOrder(ro, f)
+	}
+	if ro.TimeInForce.Is(gctorder.PostOnly) {
+		return false, decimal.Zero, fmt.Errorf("%w at close price %v with limit price %v", errPostOnlyWouldTake, closePrice, ro.LimitPrice)
+	}
+	f.AppendReasonf("%v order with limit price %v is marketable at close price %v", ro.Type, ro.LimitPrice, closePrice)
+	return false, ro.LimitPrice, nil
+}
+
+// addRestingOrder stores the resting order. Its reserved funds are retained
+// until it is filled, cancelled or expires
+func (e *Exchange) addRestingOrder(ro *RestingOrder, f *fill.Fill) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	ro.ID = id.String()
+	e.restingOrders = append(e.restingOrders, ro)
+	f.SetDirection(gctorder.DoNothing)
+	f.AppendReasonf("Placed resting %v %v order %v for %v with limit price %v trigger price %v time in force %v",
+		ro.Side,
+		ro.Type,
+		ro.ID,
+		ro.Amount,
+		ro.LimitPrice,
+		ro.TriggerPrice,
+		ro.TimeInForce)
+	return nil
+}
+
+// MatchRestingOrders matches the resting orders for the data event's
+// exchange, asset and pair against its candle. Orders placed on an earlier
+// candle fill when the candle's range reaches their price, limited by the
+// candle's volume unless volume fitting is skipped. Limit orders pay the
+// maker fee and triggered orders the taker fee. A fill event is returned for
+// every fill, cancellation and expiry
+func (e *Exchange) MatchRestingOrders(ev data.Event, om *engine.OrderManager, funds funding.IFundReleaser) ([]fill.Event, error) {
+	if ev == nil {
+		return nil, common.ErrNilEvent
+	}
+	if funds == nil {
+		return nil, fmt.Errorf("%w: funding", gctcommon.ErrNilPointer)
+	}
+	if !e.hasRestingOrders(ev) {
+		return nil, nil
+	}
+	cs, err := e.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return nil, err
+	}
+	pr, err := funds.PairReleaser()
+	if err != nil {
+		return nil, err
+	}
+	volume := ev.GetVolume()
+	fitVolume := !cs.SkipCandleVolumeFitting && volume.IsPositive()
+	var fills []fill.Event
+	var errs error
+	remaining := make([]*RestingOrder, 0, len(e.restingOrders))
+	for _, ro := range e.restingOrders {
+		if !ro.isFor(ev) || !ev.GetTime().After(ro.PlacedAt) {
+			remaining = append(remaining, ro)
+			continue
+		}
+		if ro.hasExpired(ev.GetTime()) {
+			f := newRestingOrderFill(ro, ev)
+			f.SetDirection(gctorder.DoNothing)
+			f.AppendReasonf("Resting %v %v order %v expired with %v unfilled", ro.Side, ro.Type, ro.ID, ro.Remaining)
+			errs = gctcommon.AppendError(errs, ro.releaseFunds(pr))
+			fills = append(fills, f)
+			continue
+		}
+		if price, isMaker, ok := ro.match(ev.GetOpenPrice(), ev.GetHighPrice(), ev.GetLowPrice()); ok {
+			feeRate := cs.TakerFee
+			if isMaker {
+				feeRate = cs.MakerFee
+			}
+			maxAmount := ro.Remaining
+			if fitVolume {
+				maxAmount = decimal.Min(maxAmount, volume.Mul(decimal.NewFromFloat(0.99999999)))
+			}
+			f, err := e.fillRestingOrder(ro, ev, price, maxAmount, feeRate, &cs, om, pr)
+			if err != nil {
+				errs = gctcommon.AppendError(errs, fmt.Errorf("resting order %v: %w", ro.ID, err))
+				ro.Remaining = decimal.Zero
+			}
+			if f != nil {
+				volume = volume.Sub(f.Amount)
+				fills = append(fills, f)
+			}
+		}
+		switch {
+		case !ro.Remaining.IsPositive():
+		case ro.isSingleCandle(), ro.Triggered && !ro.isLimit():
+			// the remainder of immediate and triggered market orders
+			// cannot rest on the exchange
+			f := newRestingOrderFill(ro, ev)
+			f.SetDirection(gctorder.DoNothing)
+			f.AppendReasonf("Resting %v %v order %v cancelled with %v unfilled", ro.Side, ro.Type, ro.ID, ro.Remaining)
+			fills = append(fills, f)
+		default:
+			remaining = append(remaining, ro)
+			continue
+		}
+		errs = gctcommon.AppendError(errs, ro.releaseFunds(pr))
+	}
+	e.restingOrders = remaining
+	return fills, errs
+}
+
+// fillRestingOrder fills up to maxAmount of the resting order at the price
+// and settles the order's reserved funds. No fill is returned when nothing
+// can be filled
+func (e *Exchange) fillRestingOrder(ro *RestingOrder, ev data.Event, price, maxAmount, feeRate decimal.Decimal, cs *Settings, om *engine.OrderManager, pr funding.IPairReleaser) (*fill.Fill, error) {
+	f := newRestingOrderFill(ro, ev)
+	amount := maxAmount
+	var fundsExhausted bool
+	if ro.isBuy() {
+		// the reserved quote funds must cover the order and its fee
+		if affordable := ro.Reserved.Div(price.Mul(decimal.NewFromInt(1).Add(feeRate))); affordable.LessThan(amount) {
+			f.AppendReasonf("Order size shrunk from %v to %v to remain within reserved funds", amount, affordable)
+			amount = affordable
+			fundsExhausted = true
+		}
+	} else if ro.Reserved.LessThan(amount) {
+		amount = ro.Reserved
+		fundsExhausted = true
+	}
+	if cs.CanUseExchangeLimits {
+		amount = cs.Limits.ConformToDecimalAmount(amount)
+	}
+	if !amount.IsPositive() || (ro.TimeInForce.Is(gctorder.FillOrKill) && amount.LessThan(ro.Remaining)) {
+		return nil, nil
+	}
+	if !amount.Equal(ro.Remaining) {
+		f.AppendReasonf("Partially filled %v of remaining %v", amount, ro.Remaining)
+	}
+	f.Amount = amount
+	fee := calculateExchangeFee(price, amount, feeRate)
+	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, false, cs.CanUseExchangeLimits, f, om, submissionType(ro.Type))
+	if err != nil {
+		f.AppendReasonf("could not place order: %v", err)
+		setCannotPurchaseDirection(f)
+		return f, err
+	}
+	if err := setFillOrder(f, om, orderID, ev.GetTime()); err != nil {
+		return f, err
+	}
+	ro.Remaining = ro.Remaining.Sub(amount)
+	if fundsExhausted {
+		ro.Remaining = decimal.Zero
+	}
+	f.AppendReason(summarisePosition(f.GetDirection(), f.Amount, f.Amount.Mul(f.PurchasePrice), f.ExchangeFee, f.Order.Pair, f.UnderlyingPair))
+	if ro.isBuy() {
+		// guards against decimal rounding exceeding the reserved funds
+		cost := decimal.Min(amount.Mul(price).Add(fee), ro.Reserved)
+		if err := pr.Release(cost, decimal.Zero, ro.Side); err != nil {
+			return f, err
+		}
+		ro.Reserved = ro.Reserved.Sub(cost)
+		return f, pr.IncreaseAvailable(amount, ro.Side)
+	}
+	if err := pr.Release(amount, decimal.Zero, ro.Side); err != nil {
+		return f, err
+	}
+	ro.Reserved = ro.Reserved.Sub(amount)
+	return f, pr.IncreaseAvailable(amount.Mul(price).Sub(fee), ro.Side)
+}
+
+// CancelRestingOrders cancels all resting orders for the event's exchange,
+// asset and pair, releasing their reserved funds
+func (e *Exchange) CancelRestingOrders(ev common.Event, funds funding.IFundReleaser) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if funds == nil {
+		return fmt.Errorf("%w: funding", gctcommon.ErrNilPointer)
+	}
+	if !e.hasRestingOrders(ev) {
+		return nil
+	}
+	pr, err := funds.PairReleaser()
+	if err != nil {
+		return err
+	}
+	var errs error
+	remaining := make([]*RestingOrder, 0, len(e.restingOrders))
+	for _, ro := range e.restingOrders {
+		if !ro.isFor(ev) {
+			remaining = append(remaining, ro)
+			continue
+		}
+		errs = gctcommon.AppendError(errs, ro.releaseFunds(pr))
+		ev.AppendReasonf("Cancelled resting %v %v order %v with %v unfilled", ro.Side, ro.Type, ro.ID, ro.Remaining)
+	}
+	e.restingOrders = remaining
+	return errs
+}
+
+// GetRestingOrders returns copies of the resting orders for an exchange,
+// asset and pair
+func (e *Exchange) GetRestingOrders(ev common.Event) []RestingOrder {
+	var resp []RestingOrder
+	for _, ro := range e.restingOrders {
+		if ro.isFor(ev) {
+			resp = append(resp, *ro)
+		}
+	}
+	return resp
+}
+
+func (e *Exchange) hasRestingOrders(ev common.Event) bool {
+	for _, ro := range e.restingOrders {
+		if ro.isFor(ev) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFor returns whether the order belongs to the event's exchange, asset and
+// pair
+func (r *RestingOrder) isFor(ev common.Event) bool {
+	return r.Asset == ev.GetAssetType() &&
+		r.Pair.Equal(ev.Pair()) &&
+		strings.EqualFold(r.Exchange, ev.GetExchange())
+}
+
+// newRestingOrderFill creates a fill event for the resting order at the data
+// event's offset
+func newRestingOrderFill(ro *RestingOrder, ev data.Event) *fill.Fill {
+	b := *ev.GetBase()
+	b.Reasons = nil
+	return &fill.Fill{
+		Base:           &b,
+		Direction:      ro.Side,
+		Amount:         ro.Remaining,
+		ClosePrice:     ev.GetClosePrice(),
+		RestingOrderID: ro.ID,
+	}
+}
@@ -0,0 +1,368 @@
+package exchange
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	evkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var restingOrderTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// setupRestingOrderTest returns an exchange with fee settings for the test
+// pair, a running order manager and spot funding of 1000 USDT and 10 BTC
+func setupRestingOrderTest(t *testing.T) (*Exchange, *engine.OrderManager, *funding.SpotPair) {
+	t.Helper()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	var wg sync.WaitGroup
+	om, err := engine.SetupOrderManager(em, &engine.CommunicationManager{}, &wg, &gctconfig.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	require.NoError(t, om.Start(), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, om.Stop()) })
+
+	b := &binance.Exchange{}
+	b.Name = testExchange
+	e := &Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, currency.NewBTCUSDT(), &Settings{
+		Exchange: b,
+		Pair:     currency.NewBTCUSDT(),
+		Asset:    asset.Spot,
+		MakerFee: decimal.NewFromFloat(0.001),
+		TakerFee: decimal.NewFromFloat(0.002),
+	})
+
+	base, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(10), decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	quote, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	pair, err := funding.CreatePair(base, quote)
+	require.NoError(t, err, "CreatePair must not error")
+	return e, om, pair
+}
+
+func restingOrderEvent(offset int64) *event.Base {
+	return &event.Base{
+		Offset:       offset,
+		Exchange:     testExchange,
+		Time:         restingOrderTime.Add(time.Duration(offset) * time.Hour),
+		Interval:     gctkline.OneHour,
+		CurrencyPair: currency.NewBTCUSDT(),
+		AssetType:    asset.Spot,
+	}
+}
+
+func restingOrderCandle(offset int64, o, h, l, c, v float64) *evkline.Kline {
+	return &evkline.Kline{
+		Base:   restingOrderEvent(offset),
+		Open:   decimal.NewFromFloat(o),
+		High:   decimal.NewFromFloat(h),
+		Low:    decimal.NewFromFloat(l),
+		Close:  decimal.NewFromFloat(c),
+		Volume: decimal.NewFromFloat(v),
+	}
+}
+
+// placeRestingOrder reserves the order's funds and executes it as the
+// portfolio would
+func placeRestingOrder(t *testing.T, e *Exchange, om *engine.OrderManager, pair *funding.SpotPair, o *order.Order) fill.Event {
+	t.Helper()
+	require.NoError(t, pair.Reserve(o.AllocatedFunds, o.Direction), "Reserve must not error")
+	f, err := e.ExecuteOrder(o, nil, om, pair.FundReleaser())
+	require.NoError(t, err, "ExecuteOrder must not error")
+	return f
+}
+
+func TestNewRestingOrder(t *testing.T) {
+	t.Parallel()
+	o := &order.Order{
+		Base:           restingOrderEvent(0),
+		Direction:      gctorder.Buy,
+		OrderType:      gctorder.TrailingStop,
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: decimal.NewFromInt(100),
+	}
+	_, err := newRestingOrder(o)
+	assert.ErrorIs(t, err, errUnsupportedOrderType)
+
+	o.OrderType = gctorder.Limit
+	_, err = newRestingOrder(o)
+	assert.ErrorIs(t, err, errInvalidLimitPrice)
+
+	o.OrderType = gctorder.Stop
+	_, err = newRestingOrder(o)
+	assert.ErrorIs(t, err, errInvalidTriggerPrice)
+
+	o.TriggerPrice = decimal.NewFromInt(110)
+	o.TimeInForce = gctorder.GoodTillCrossing
+	_, err = newRestingOrder(o)
+	assert.ErrorIs(t, err, gctorder.ErrUnsupportedTimeInForce)
+
+	o.TimeInForce = gctorder.GoodTillTime
+	_, err = newRestingOrder(o)
+	assert.ErrorIs(t, err, errExpiryTimeUnset)
+
+	o.TimeInForce = gctorder.UnknownTIF
+	o.Direction = gctorder.Long
+	_, err = newRestingOrder(o)
+	assert.ErrorIs(t, err, errInvalidDirection)
+
+	o.Direction = gctorder.Buy
+	ro, err := newRestingOrder(o)
+	require.NoError(t, err)
+	assert.Equal(t, gctorder.GoodTillCancel, ro.TimeInForce, "time in force should default to good till cancel")
+	assert.Equal(t, o.AllocatedFunds, ro.Reserved)
+
+	o.AssetType = asset.Futures
+	_, err = newRestingOrder(o)
+	assert.ErrorIs(t, err, errRestingOrdersSpotOnly)
+}
+
+func TestRestingOrderMatch(t *testing.T) {
+	t.Parallel()
+	p := decimal.NewFromInt
+	for _, tc := range []struct {
+		name      string
+		ro        RestingOrder
+		open      int64
+		high      int64
+		low       int64
+		price     int64
+		isMaker   bool
+		ok        bool
+		triggered bool
+	}{
+		{name: "limit buy untouched", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.Limit, LimitPrice: p(90)}, open: 100, high: 105, low: 91},
+		{name: "limit buy touched", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.Limit, LimitPrice: p(90)}, open: 100, high: 105, low: 90, price: 90, isMaker: true, ok: true},
+		{name: "limit sell touched", ro: RestingOrder{Side: gctorder.Sell, Type: gctorder.Limit, LimitPrice: p(110)}, open: 100, high: 111, low: 95, price: 110, isMaker: true, ok: true},
+		{name: "stop sell triggered", ro: RestingOrder{Side: gctorder.Sell, Type: gctorder.Stop, TriggerPrice: p(95)}, open: 100, high: 101, low: 90, price: 95, ok: true, triggered: true},
+		{name: "stop sell gapped", ro: RestingOrder{Side: gctorder.Sell, Type: gctorder.Stop, TriggerPrice: p(95)}, open: 92, high: 93, low: 90, price: 92, ok: true, triggered: true},
+		{name: "stop buy triggered", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.Stop, TriggerPrice: p(105)}, open: 100, high: 106, low: 99, price: 105, ok: true, triggered: true},
+		{name: "take profit sell triggered", ro: RestingOrder{Side: gctorder.Sell, Type: gctorder.TakeProfit, TriggerPrice: p(110)}, open: 100, high: 112, low: 99, price: 110, ok: true, triggered: true},
+		{name: "take profit sell untouched by fall", ro: RestingOrder{Side: gctorder.Sell, Type: gctorder.TakeProfit, TriggerPrice: p(110)}, open: 100, high: 101, low: 80},
+		{name: "take profit buy triggered", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.TakeProfit, TriggerPrice: p(90)}, open: 100, high: 101, low: 89, price: 90, ok: true, triggered: true},
+		{name: "stop limit buy marketable", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.StopLimit, TriggerPrice: p(105), LimitPrice: p(107)}, open: 100, high: 106, low: 99, price: 105, ok: true, triggered: true},
+		{name: "stop limit buy gapped beyond limit", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.StopLimit, TriggerPrice: p(105), LimitPrice: p(107)}, open: 110, high: 112, low: 109, triggered: true},
+		{name: "triggered stop limit rests", ro: RestingOrder{Side: gctorder.Buy, Type: gctorder.StopLimit, TriggerPrice: p(105), LimitPrice: p(107), Triggered: true}, open: 110, high: 112, low: 106, price: 107, isMaker: true, ok: true, triggered: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ro := tc.ro
+			price, isMaker, ok := ro.match(p(tc.open), p(tc.high), p(tc.low))
+			assert.Equal(t, tc.ok, ok, "ok should be correct")
+			assert.Equal(t, tc.isMaker, isMaker, "isMaker should be correct")
+			assert.True(t, p(tc.price).Equal(price), "price should be correct")
+			assert.Equal(t, tc.triggered, ro.Triggered, "Triggered should be correct")
+		})
+	}
+}
+
+func TestRestingOrderHasExpired(t *testing.T) {
+	t.Parallel()
+	ro := RestingOrder{TimeInForce: gctorder.GoodTillCancel, PlacedAt: restingOrderTime}
+	assert.False(t, ro.hasExpired(restingOrderTime.AddDate(1, 0, 0)))
+	ro.TimeInForce = gctorder.GoodTillDay
+	assert.False(t, ro.hasExpired(restingOrderTime.Add(time.Hour)))
+	assert.True(t, ro.hasExpired(restingOrderTime.Add(time.Hour*24)))
+	ro.TimeInForce = gctorder.GoodTillTime
+	ro.ExpiryTime = restingOrderTime.Add(time.Hour * 2)
+	assert.False(t, ro.hasExpired(restingOrderTime.Add(time.Hour)))
+	assert.True(t, ro.hasExpired(restingOrderTime.Add(time.Hour*2)))
+}
+
+func TestExecuteOrderRestingOrder(t *testing.T) {
+	t.Parallel()
+	e, om, pair := setupRestingOrderTest(t)
+	cs, err := e.GetCurrencySettings(testExchange, asset.Spot, currency.NewBTCUSDT())
+	require.NoError(t, err)
+	cs.SkipCandleVolumeFitting = true
+	e.SetExchangeAssetCurrencySettings(asset.Spot, currency.NewBTCUSDT(), &cs)
+	o := &order.Order{
+		Base:           restingOrderEvent(0),
+		Direction:      gctorder.Buy,
+		OrderType:      gctorder.Limit,
+		LimitPrice:     decimal.NewFromInt(90),
+		ClosePrice:     decimal.NewFromInt(100),
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: decimal.NewFromInt(100),
+	}
+	f := placeRestingOrder(t, e, om, pair, o)
+	assert.Equal(t, gctorder.DoNothing, f.GetDirection(), "a resting order should not be filled when placed")
+	require.Len(t, e.GetRestingOrders(o), 1)
+	assert.True(t, pair.QuoteAvailable().Equal(decimal.NewFromInt(900)), "funds should remain reserved")
+
+	o.Base = restingOrderEvent(0)
+	o.LimitPrice = decimal.NewFromInt(110)
+	o.TimeInForce = gctorder.PostOnly
+	require.NoError(t, pair.Reserve(o.AllocatedFunds, o.Direction))
+	f, err = e.ExecuteOrder(o, nil, om, pair.FundReleaser())
+	assert.ErrorIs(t, err, errPostOnlyWouldTake)
+	assert.Equal(t, gctorder.CouldNotBuy, f.GetDirection())
+	assert.True(t, pair.QuoteAvailable().Equal(decimal.NewFromInt(900)), "funds should be released when the order is rejected")
+
+	o.Base = restingOrderEvent(0)
+	o.Direction = gctorder.Buy
+	o.TimeInForce = gctorder.GoodTillCancel
+	o.AllocatedFunds = decimal.NewFromFloat(100.2)
+	f = placeRestingOrder(t, e, om, pair, o)
+	assert.Equal(t, gctorder.Buy, f.GetDirection(), "a marketable limit order should fill immediately")
+	require.NotNil(t, f.GetOrder())
+	assert.Equal(t, gctorder.Limit, f.GetOrder().Type)
+	assert.Len(t, e.GetRestingOrders(o), 1)
+
+	cs.UseRealOrders = true
+	e.SetExchangeAssetCurrencySettings(asset.Spot, currency.NewBTCUSDT(), &cs)
+	o.Base = restingOrderEvent(0)
+	_, err = e.ExecuteOrder(o, nil, om, pair.FundReleaser())
+	assert.ErrorIs(t, err, errRestingOrdersRealOrders)
+}
+
+func TestMatchRestingOrders(t *testing.T) {
+	t.Parallel()
+	e, om, pair := setupRestingOrderTest(t)
+	_, err := e.MatchRestingOrders(nil, om, pair.FundReleaser())
+	assert.ErrorIs(t, err, common.ErrNilEvent)
+	_, err = e.MatchRestingOrders(restingOrderCandle(1, 100, 100, 100, 100, 1), om, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	buy := &order.Order{
+		Base:           restingOrderEvent(0),
+		Direction:      gctorder.Buy,
+		OrderType:      gctorder.Limit,
+		LimitPrice:     decimal.NewFromInt(90),
+		ClosePrice:     decimal.NewFromInt(100),
+		Amount:         decimal.NewFromInt(2),
+		AllocatedFunds: decimal.NewFromFloat(180.36),
+	}
+	placeRestingOrder(t, e, om, pair, buy)
+	stop := &order.Order{
+		Base:           restingOrderEvent(0),
+		Direction:      gctorder.Sell,
+		OrderType:      gctorder.Stop,
+		TriggerPrice:   decimal.NewFromInt(80),
+		ClosePrice:     decimal.NewFromInt(100),
+		Amount:         decimal.NewFromInt(5),
+		AllocatedFunds: decimal.NewFromInt(5),
+	}
+	placeRestingOrder(t, e, om, pair, stop)
+
+	fills, err := e.MatchRestingOrders(restingOrderCandle(0, 100, 100, 80, 100, 10), om, pair.FundReleaser())
+	require.NoError(t, err)
+	assert.Empty(t, fills, "orders should not match the candle they were placed on")
+
+	fills, err = e.MatchRestingOrders(restingOrderCandle(1, 100, 101, 91, 95, 10), om, pair.FundReleaser())
+	require.NoError(t, err)
+	assert.Empty(t, fills, "orders should not match a candle which does not reach their price")
+
+	fills, err = e.MatchRestingOrders(restingOrderCandle(2, 95, 96, 89, 92, 1), om, pair.FundReleaser())
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	f := fills[0]
+	assert.Equal(t, gctorder.Buy, f.GetDirection())
+	assert.Equal(t, int64(2), f.GetOffset())
+	assert.NotEmpty(t, f.GetRestingOrderID())
+	assert.True(t, f.GetPurchasePrice().Equal(decimal.NewFromInt(90)), "limit orders should fill at their limit price")
+	assert.True(t, f.GetAmount().Equal(decimal.NewFromFloat(0.99999999)), "fills should be limited by the candle volume")
+	assert.True(t, f.GetExchangeFee().Equal(decimal.NewFromFloat(0.0899999991)), "limit orders should pay the maker fee")
+	require.Len(t, e.GetRestingOrders(buy), 2, "a partially filled order should continue to rest")
+	assert.True(t, pair.BaseAvailable().Equal(decimal.NewFromFloat(5.99999999)))
+
+	fills, err = e.MatchRestingOrders(restingOrderCandle(3, 92, 92, 75, 78, 100), om, pair.FundReleaser())
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+	assert.Equal(t, gctorder.Buy, fills[0].GetDirection())
+	assert.True(t, fills[0].GetAmount().Equal(decimal.NewFromFloat(1.00000001)), "the remainder should be filled")
+	assert.Equal(t, gctorder.Sell, fills[1].GetDirection())
+	assert.True(t, fills[1].GetPurchasePrice().Equal(decimal.NewFromInt(80)), "stop orders should fill at their trigger price")
+	assert.True(t, fills[1].GetExchangeFee().Equal(decimal.NewFromFloat(0.8)), "stop orders should pay the taker fee")
+	assert.Empty(t, e.GetRestingOrders(buy), "filled orders should be removed")
+	assert.True(t, pair.QuoteAvailable().Equal(decimal.NewFromFloat(1219.02)), "leftover reserved funds should be released")
+	assert.True(t, pair.BaseAvailable().Equal(decimal.NewFromInt(7)))
+
+	gtt := &order.Order{
+		Base:           restingOrderEvent(3),
+		Direction:      gctorder.Sell,
+		OrderType:      gctorder.Limit,
+		LimitPrice:     decimal.NewFromInt(120),
+		TimeInForce:    gctorder.GoodTillTime,
+		ExpiryTime:     restingOrderTime.Add(time.Hour * 5),
+		ClosePrice:     decimal.NewFromInt(78),
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: decimal.NewFromInt(1),
+	}
+	placeRestingOrder(t, e, om, pair, gtt)
+	fills, err = e.MatchRestingOrders(restingOrderCandle(4, 78, 80, 76, 79, 100), om, pair.FundReleaser())
+	require.NoError(t, err)
+	assert.Empty(t, fills)
+	fills, err = e.MatchRestingOrders(restingOrderCandle(5, 79, 130, 79, 125, 100), om, pair.FundReleaser())
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, gctorder.DoNothing, fills[0].GetDirection(), "expired orders should not fill")
+	assert.Empty(t, e.GetRestingOrders(gtt))
+	assert.True(t, pair.BaseAvailable().Equal(decimal.NewFromInt(7)), "expired order funds should be released")
+
+	ioc := &order.Order{
+		Base:           restingOrderEvent(5),
+		Direction:      gctorder.Sell,
+		OrderType:      gctorder.Limit,
+		LimitPrice:     decimal.NewFromInt(150),
+		TimeInForce:    gctorder.ImmediateOrCancel,
+		ClosePrice:     decimal.NewFromInt(125),
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: decimal.NewFromInt(1),
+	}
+	placeRestingOrder(t, e, om, pair, ioc)
+	fills, err = e.MatchRestingOrders(restingOrderCandle(6, 125, 130, 120, 125, 100), om, pair.FundReleaser())
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, gctorder.DoNothing, fills[0].GetDirection(), "unmatched immediate or cancel orders should be cancelled")
+	assert.Empty(t, e.GetRestingOrders(ioc))
+	assert.True(t, pair.BaseAvailable().Equal(decimal.NewFromInt(7)))
+}
+
+func TestCancelRestingOrders(t *testing.T) {
+	t.Parallel()
+	e, om, pair := setupRestingOrderTest(t)
+	ev := &signal.Signal{Base: restingOrderEvent(0), CancelRestingOrders: true}
+	assert.ErrorIs(t, e.CancelRestingOrders(nil, pair.FundReleaser()), common.ErrNilEvent)
+	assert.ErrorIs(t, e.CancelRestingOrders(ev, nil), gctcommon.ErrNilPointer)
+	assert.NoError(t, e.CancelRestingOrders(ev, pair.FundReleaser()), "CancelRestingOrders should not error without orders")
+
+	placeRestingOrder(t, e, om, pair, &order.Order{
+		Base:           restingOrderEvent(0),
+		Direction:      gctorder.Sell,
+		OrderType:      gctorder.Limit,
+		LimitPrice:     decimal.NewFromInt(110),
+		ClosePrice:     decimal.NewFromInt(100),
+		Amount:         decimal.NewFromInt(4),
+		AllocatedFunds: decimal.NewFromInt(4),
+	})
+	require.True(t, pair.BaseAvailable().Equal(decimal.NewFromInt(6)))
+	require.NoError(t, e.CancelRestingOrders(ev, pair.FundReleaser()))
+	assert.Empty(t, e.GetRestingOrders(ev))
+	assert.True(t, pair.BaseAvailable().Equal(decimal.NewFromInt(10)), "cancelled order funds should be released")
+	assert.Len(t, ev.Reasons, 1)
+}
@@ -1,9 +1,11 @@
 package slippage
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
@@ -41,3 +43,34 @@ func CalculateSlippageByOrderbook(ob *orderbook.Book, side gctorder.Side, alloca
 	amount = decimal.NewFromFloat(result.Amount * (1 - feeRate.InexactFloat64()))
 	return
 }
+
+// CalculateFillByOrderbook walks the orderbook to fill an amount of the base
+// currency. Buys walk the asks and sells walk the bids. It returns the
+// average fill price and the amount filled, which is less than the amount
+// requested when the orderbook does not have enough depth
+func CalculateFillByOrderbook(ob *orderbook.Book, side gctorder.Side, amount decimal.Decimal) (price, filled decimal.Decimal, err error) {
+	if ob == nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w orderbook", gctcommon.ErrNilPointer)
+	}
+	var levels orderbook.Levels
+	switch {
+	case side.IsLong():
+		levels = ob.Asks
+	case side.IsShort():
+		levels = ob.Bids
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%v %w", side, gctorder.ErrSideIsInvalid)
+	}
+	amountFloat := amount.InexactFloat64()
+	nominal, remaining := levels.FindNominalAmount(amountFloat)
+	filledFloat := amountFloat - remaining
+	if filledFloat <= 0 {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w %v %v %v", ErrNoLiquidity, ob.Exchange, ob.Pair, side)
+	}
+	if remaining == 0 {
+		filled = amount
+	} else {
+		filled = decimal.NewFromFloat(filledFloat)
+	}
+	return decimal.NewFromFloat(nominal / filledFloat), filled, nil
+}
@@ -6,10 +6,12 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 func TestRandomSlippage(t *testing.T) {
@@ -35,3 +37,29 @@ func TestCalculateSlippageByOrderbook(t *testing.T) {
 	orderSize := price.Mul(amount).Add(price.Mul(amount).Mul(feeRate))
 	assert.True(t, orderSize.LessThan(amountOfFunds), "order size should be less than funds")
 }
+
+func TestCalculateFillByOrderbook(t *testing.T) {
+	t.Parallel()
+	_, _, err := CalculateFillByOrderbook(nil, gctorder.Buy, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	ob := &orderbook.Book{
+		Bids: orderbook.Levels{{Price: 99, Amount: 1}, {Price: 97, Amount: 1}},
+		Asks: orderbook.Levels{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}},
+	}
+	_, _, err = CalculateFillByOrderbook(ob, gctorder.UnknownSide, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, gctorder.ErrSideIsInvalid)
+
+	price, filled, err := CalculateFillByOrderbook(ob, gctorder.Buy, decimal.NewFromFloat(1.5))
+	require.NoError(t, err)
+	assert.InDelta(t, 152.5/1.5, price.InexactFloat64(), 1e-9, "price should be the average of the asks walked")
+	assert.Equal(t, "1.5", filled.String())
+
+	price, filled, err = CalculateFillByOrderbook(ob, gctorder.Sell, decimal.NewFromInt(5))
+	require.NoError(t, err)
+	assert.Equal(t, "98", price.String())
+	assert.Equal(t, "2", filled.String(), "filled should be limited to the orderbook depth")
+
+	_, _, err = CalculateFillByOrderbook(&orderbook.Book{}, gctorder.Buy, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, ErrNoLiquidity)
+}
@@ -79,7 +79,14 @@ func (p *Portfolio) OnSignal(ev signal.Event, exchangeSettings *exchange.Setting
 		return cannotPurchase(ev, o)
 	}
 
-	o.OrderType = gctorder.Market
+	o.OrderType = ev.GetOrderType()
+	if o.OrderType == gctorder.UnknownType {
+		o.OrderType = gctorder.Market
+	}
+	o.LimitPrice = ev.GetLimitPrice()
+	o.TriggerPrice = ev.GetTriggerPrice()
+	o.TimeInForce = ev.GetTimeInForce()
+	o.ExpiryTime = ev.GetExpiryTime()
 	o.BuyLimit = ev.GetBuyLimit()
 	o.SellLimit = ev.GetSellLimit()
 	var sizingFunds decimal.Decimal
@@ -219,7 +226,7 @@ func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, origi
 		gctorder.Bid,
 		gctorder.Short,
 		gctorder.Long:
-		sizedOrder.AllocatedFunds = sizedOrder.Amount.Mul(sizedOrder.ClosePrice).Add(estFee)
+		sizedOrder.AllocatedFunds = sizedOrder.Amount.Mul(sizedOrder.GetOrderPrice()).Add(estFee)
 	case gctorder.Sell,
 		gctorder.Ask,
 		gctorder.ClosePosition:
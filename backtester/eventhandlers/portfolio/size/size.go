@@ -66,7 +66,7 @@ func (s *Size) SizeOrder(o order.Event, amountAvailable decimal.Decimal, cs *exc
 		return retOrder, estFee, nil
 	}
 
-	amount, estFee, err := s.calculateAmount(retOrder.Direction, retOrder.ClosePrice, amountAvailable, cs, o)
+	amount, estFee, err := s.calculateAmount(retOrder.Direction, retOrder.GetOrderPrice(), amountAvailable, cs, o)
 	if err != nil {
 		return nil, decimal.Zero, err
 	}
@@ -129,6 +129,12 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 		c.UnrealisedPNL = last.PNL.GetUnrealisedPNL().PNL
 		c.RealisedPNL = last.PNL.GetRealisedPNL().PNL
 	}
+	if c.Asset.IsFutures() {
+		c.FundingPNL = decimal.Zero
+		for i := range c.Events {
+			c.FundingPNL = c.FundingPNL.Add(c.Events[i].FundingPNL)
+		}
+	}
 	
 	// Calculate spot trade PnL if this is a spot asset
 	if c.Asset == asset.Spot {
@@ -221,54 +227,56 @@ func (c *CurrencyPairStatistic) calculateSpotTradePNL() {
 	tradeNumber := 0
 	
 	for i := range c.Events {
-		// Look for fill events which represent executed orders
-		if c.Events[i].FillEvent == nil {
-			continue
+		// Look for fill events which represent executed orders.
+		// Resting orders are matched before any order placed at the same offset
+		fills := c.Events[i].RestingOrderFills
+		if c.Events[i].FillEvent != nil {
+			fills = append(fills[:len(fills):len(fills)], c.Events[i].FillEvent)
 		}
+		for _, fillEvent := range fills {
+			orderSide := fillEvent.GetDirection()
+			orderPrice := fillEvent.GetPurchasePrice()
+			orderAmount := fillEvent.GetAmount()
+			orderFee := fillEvent.GetExchangeFee()
 		
-		fillEvent := c.Events[i].FillEvent
-		orderSide := fillEvent.GetDirection()
-		orderPrice := fillEvent.GetPurchasePrice()
-		orderAmount := fillEvent.GetAmount()
-		orderFee := fillEvent.GetExchangeFee()
-		
-		if orderSide == gctorder.Buy {
-			// Add to pending buys
-			pendingBuys = append(pendingBuys, pendingBuy{
-				time:   c.Events[i].Time,
-				price:  orderPrice,
-				amount: orderAmount,
-				fee:    orderFee,
-			})
-		} else if orderSide == gctorder.Sell && len(pendingBuys) > 0 {
-			// Match with oldest buy (FIFO)
-			buy := pendingBuys[0]
-			pendingBuys = pendingBuys[1:]
-			tradeNumber++
+			if orderSide == gctorder.Buy {
+				// Add to pending buys
+				pendingBuys = append(pendingBuys, pendingBuy{
+					time:   c.Events[i].Time,
+					price:  orderPrice,
+					amount: orderAmount,
+					fee:    orderFee,
+				})
+			} else if orderSide == gctorder.Sell && len(pendingBuys) > 0 {
+				// Match with oldest buy (FIFO)
+				buy := pendingBuys[0]
+				pendingBuys = pendingBuys[1:]
+				tradeNumber++
 			
-			// Calculate P&L
-			buyTotal := buy.price.Mul(buy.amount)
-			sellTotal := orderPrice.Mul(orderAmount)
-			grossPNL := sellTotal.Sub(buyTotal)
-			feeTotal := buy.fee.Add(orderFee)
-			netPNL := grossPNL.Sub(feeTotal)
+				// Calculate P&L
+				buyTotal := buy.price.Mul(buy.amount)
+				sellTotal := orderPrice.Mul(orderAmount)
+				grossPNL := sellTotal.Sub(buyTotal)
+				feeTotal := buy.fee.Add(orderFee)
+				netPNL := grossPNL.Sub(feeTotal)
 			
-			trade := SpotTradePNL{
-				TradeNumber: tradeNumber,
-				BuyTime:     buy.time,
-				BuyPrice:    buy.price,
-				BuyAmount:   buy.amount,
-				BuyFee:      buy.fee,
-				SellTime:    c.Events[i].Time,
-				SellPrice:   orderPrice,
-				SellAmount:  orderAmount,
-				SellFee:     orderFee,
-				GrossPNL:    grossPNL,
-				NetPNL:      netPNL,
-				FeeTotal:    feeTotal,
-			}
+				trade := SpotTradePNL{
+					TradeNumber: tradeNumber,
+					BuyTime:     buy.time,
+					BuyPrice:    buy.price,
+					BuyAmount:   buy.amount,
+					BuyFee:      buy.fee,
+					SellTime:    c.Events[i].Time,
+					SellPrice:   orderPrice,
+					SellAmount:  orderAmount,
+					SellFee:     orderFee,
+					GrossPNL:    grossPNL,
+					NetPNL:      netPNL,
+					FeeTotal:    feeTotal,
+				}
 			
-			c.SpotTrades = append(c.SpotTrades, trade)
+				c.SpotTrades = append(c.SpotTrades, trade)
+			}
 		}
 	}
 }
@@ -76,6 +76,14 @@ func (s *Statistic) PrintAllEventsChronologically() {
 	var results []eventOutputHolder
 	for _, currencyStatistic := range s.ExchangeAssetPairStatistics {
 		for i := range currencyStatistic.Events {
+			for _, f := range currencyStatistic.Events[i].RestingOrderFills {
+				result, err := s.CreateLog(f)
+				if err != nil {
+					errs = gctcommon.AppendError(errs, err)
+					continue
+				}
+				results = addEventOutputToTime(results, f.GetTime(), result)
+			}
 			var result string
 			var tt time.Time
 			var err error
@@ -228,6 +236,7 @@ func (c *CurrencyPairStatistic) PrintResults(e string, a asset.Item, p currency.
 		log.Infof(common.CurrencyStatistics, "%s Lowest Unrealised PNL: %s at %v", sep, convert.DecimalToHumanFriendlyString(c.LowestUnrealisedPNL.Value, 8, ".", ","), c.LowestUnrealisedPNL.Time)
 		log.Infof(common.CurrencyStatistics, "%s Highest Realised PNL: %s at %v", sep, convert.DecimalToHumanFriendlyString(c.HighestRealisedPNL.Value, 8, ".", ","), c.HighestRealisedPNL.Time)
 		log.Infof(common.CurrencyStatistics, "%s Lowest Realised PNL: %s at %v", sep, convert.DecimalToHumanFriendlyString(c.LowestRealisedPNL.Value, 8, ".", ","), c.LowestRealisedPNL.Time)
+		log.Infof(common.CurrencyStatistics, "%s Funding PNL: %s", sep, convert.DecimalToHumanFriendlyString(c.FundingPNL, 8, ".", ","))
 		log.Infof(common.CurrencyStatistics, "%s Highest committed funds: %s %s at %v", sep, convert.DecimalToHumanFriendlyString(c.HighestCommittedFunds.Value, 8, ".", ","), c.UnderlyingPair.Quote, c.HighestCommittedFunds.Time)
 	} else {
 		log.Infof(common.CurrencyStatistics, "%s Buy orders: %s", sep, convert.IntToHumanFriendlyString(c.BuyOrders, ","))
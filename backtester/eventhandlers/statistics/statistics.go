@@ -120,6 +120,10 @@ func applyEventAtOffset(ev common.Event, data *DataAtOffset) error {
 		}
 		data.OrderEvent = t
 	case fill.Event:
+		if t.GetRestingOrderID() != "" {
+			data.RestingOrderFills = append(data.RestingOrderFills, t)
+			break
+		}
 		if data.FillEvent != nil {
 			return fmt.Errorf("fill event %w %v %v %v %v", ErrAlreadyProcessed, ev.GetExchange(), ev.GetAssetType(), ev.Pair(), ev.GetOffset())
 		}
@@ -183,6 +187,36 @@ func (s *Statistic) AddPNLForTime(pnl *portfolio.PNLSummary) error {
 	return fmt.Errorf("%v %v %v %w %v", pnl.Exchange, pnl.Asset, pnl.Pair, errNoDataAtOffset, pnl.Offset)
 }
 
+// AddFundingPNLForTime adds a funding rate payment to the statistics
+// at the time period of the event
+func (s *Statistic) AddFundingPNLForTime(e common.Event, amount decimal.Decimal) error {
+	if e == nil {
+		return common.ErrNilEvent
+	}
+	if s.ExchangeAssetPairStatistics == nil {
+		return errExchangeAssetPairStatsUnset
+	}
+	exch := e.GetExchange()
+	a := e.GetAssetType()
+	p := e.Pair()
+	lookup := s.ExchangeAssetPairStatistics[key.ExchangePairAsset{
+		Exchange: exch,
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+		Asset:    a,
+	}]
+	if lookup == nil {
+		return fmt.Errorf("%w for %v %v %v to set funding pnl", errCurrencyStatisticsUnset, exch, a, p)
+	}
+	for i := len(lookup.Events) - 1; i >= 0; i-- {
+		if lookup.Events[i].Offset == e.GetOffset() {
+			lookup.Events[i].FundingPNL = lookup.Events[i].FundingPNL.Add(amount)
+			return nil
+		}
+	}
+	return fmt.Errorf("%v %v %v %w %v", exch, a, p, errNoDataAtOffset, e.GetOffset())
+}
+
 // AddComplianceSnapshotForTime adds the compliance snapshot to the statistics at the time period
 func (s *Statistic) AddComplianceSnapshotForTime(c *compliance.Snapshot, e common.Event) error {
 	if c == nil {
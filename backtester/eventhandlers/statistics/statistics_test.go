@@ -6,6 +6,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
@@ -225,6 +226,29 @@ func TestAddFillEventForTime(t *testing.T) {
 		Slippage:            eleet,
 	})
 	assert.NoError(t, err)
+
+	err = s.SetEventForOffset(&fill.Fill{Base: b, Direction: gctorder.Buy})
+	assert.ErrorIs(t, err, ErrAlreadyProcessed)
+
+	for range 2 {
+		err = s.SetEventForOffset(&fill.Fill{
+			Base:           b,
+			Direction:      gctorder.Sell,
+			Amount:         eleet,
+			PurchasePrice:  eleet,
+			RestingOrderID: "1337",
+		})
+		assert.NoError(t, err, "resting order fills should be added alongside the fill event")
+	}
+	ev := s.ExchangeAssetPairStatistics[key.ExchangePairAsset{
+		Exchange: exch,
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+		Asset:    a,
+	}].Events
+	if assert.Len(t, ev, 1) {
+		assert.Len(t, ev[0].RestingOrderFills, 2)
+	}
 }
 
 func TestAddHoldingsForTime(t *testing.T) {
@@ -899,3 +923,56 @@ func TestAddPNLForTime(t *testing.T) {
 	err = s.AddPNLForTime(sum)
 	assert.NoError(t, err)
 }
+
+func TestAddFundingPNLForTime(t *testing.T) {
+	t.Parallel()
+	s := &Statistic{}
+	err := s.AddFundingPNLForTime(nil, decimal.Zero)
+	assert.ErrorIs(t, err, common.ErrNilEvent)
+
+	tt := time.Now().Add(-gctkline.OneDay.Duration() * 7)
+	ev := &kline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: currency.NewBTCUSDT(),
+			AssetType:    asset.PerpetualContract,
+			Offset:       1,
+		},
+		Open:   eleet,
+		Close:  eleet,
+		Low:    eleet,
+		High:   eleet,
+		Volume: eleet,
+	}
+	err = s.AddFundingPNLForTime(ev, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, errExchangeAssetPairStatsUnset)
+
+	s.ExchangeAssetPairStatistics = make(map[key.ExchangePairAsset]*CurrencyPairStatistic)
+	err = s.AddFundingPNLForTime(ev, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, errCurrencyStatisticsUnset)
+
+	err = s.SetEventForOffset(ev)
+	require.NoError(t, err, "SetEventForOffset must not error")
+
+	ev.Offset = 2
+	err = s.AddFundingPNLForTime(ev, decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, errNoDataAtOffset)
+
+	ev.Offset = 1
+	err = s.AddFundingPNLForTime(ev, decimal.NewFromInt(1))
+	assert.NoError(t, err)
+	err = s.AddFundingPNLForTime(ev, decimal.NewFromFloat(-0.25))
+	assert.NoError(t, err)
+
+	stats := s.ExchangeAssetPairStatistics[key.ExchangePairAsset{
+		Exchange: testExchange,
+		Base:     currency.BTC.Item,
+		Quote:    currency.USDT.Item,
+		Asset:    asset.PerpetualContract,
+	}]
+	require.NotNil(t, stats)
+	require.Len(t, stats.Events, 1)
+	assert.Equal(t, "0.75", stats.Events[0].FundingPNL.String(), "AddFundingPNLForTime should accumulate payments at the same offset")
+}
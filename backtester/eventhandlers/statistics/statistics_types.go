@@ -79,6 +79,7 @@ type Handler interface {
 	Reset() error
 	Serialise() (string, error)
 	AddPNLForTime(*portfolio.PNLSummary) error
+	AddFundingPNLForTime(common.Event, decimal.Decimal) error
 	CreateLog(common.Event) (string, error)
 }
 
@@ -133,7 +134,13 @@ type DataAtOffset struct {
 	SignalEvent        signal.Event
 	OrderEvent         order.Event
 	FillEvent          fill.Event
-	PNL                portfolio.IPNL
+	// RestingOrderFills holds fills from resting orders matched
+	// against the offset's candle
+	RestingOrderFills []fill.Event
+	PNL               portfolio.IPNL
+	// FundingPNL holds the funding rate payments applied to an
+	// open perpetual futures position at the offset
+	FundingPNL decimal.Decimal
 }
 
 // CurrencyPairStatistic Holds all events and statistics relevant to an exchange, asset type and currency pair
@@ -164,6 +171,7 @@ type CurrencyPairStatistic struct {
 	StrategyMovement             decimal.Decimal `json:"strategy-movement"`
 	UnrealisedPNL                decimal.Decimal `json:"unrealised-pnl"`
 	RealisedPNL                  decimal.Decimal `json:"realised-pnl"`
+	FundingPNL                   decimal.Decimal `json:"funding-pnl"`
 	CompoundAnnualGrowthRate     decimal.Decimal `json:"compound-annual-growth-rate"`
 	TotalAssetValue              decimal.Decimal `json:"total-asset-value"`
 	TotalFees                    decimal.Decimal `json:"total-fees"`
@@ -77,3 +77,9 @@ func (f *Fill) GetFillDependentEvent() signal.Event {
 func (f *Fill) IsLiquidated() bool {
 	return f.Liquidated
 }
+
+// GetRestingOrderID returns the ID of the resting order
+// the fill matched, if any
+func (f *Fill) GetRestingOrderID() string {
+	return f.RestingOrderID
+}
@@ -127,3 +127,11 @@ func TestIsLiquidated(t *testing.T) {
 		t.Error("expected true")
 	}
 }
+
+func TestGetRestingOrderID(t *testing.T) {
+	t.Parallel()
+	f := Fill{RestingOrderID: "1337"}
+	if f.GetRestingOrderID() != "1337" {
+		t.Error("expected 1337")
+	}
+}
@@ -22,6 +22,9 @@ type Fill struct {
 	Order               *order.Detail   `json:"-"`
 	FillDependentEvent  signal.Event
 	Liquidated          bool
+	// RestingOrderID is set when the fill matched a resting order
+	// placed on a previous candle
+	RestingOrderID string
 }
 
 // Event holds all functions required to handle a fill event
@@ -41,4 +44,5 @@ type Event interface {
 	GetOrder() *order.Detail
 	GetFillDependentEvent() signal.Event
 	IsLiquidated() bool
+	GetRestingOrderID() string
 }
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -103,3 +105,41 @@ func (o *Order) IsLiquidating() bool {
 func (o *Order) GetClosePrice() decimal.Decimal {
 	return o.ClosePrice
 }
+
+// GetOrderType returns the order type
+func (o *Order) GetOrderType() order.Type {
+	return o.OrderType
+}
+
+// GetLimitPrice returns the limit price
+func (o *Order) GetLimitPrice() decimal.Decimal {
+	return o.LimitPrice
+}
+
+// GetTriggerPrice returns the trigger price
+func (o *Order) GetTriggerPrice() decimal.Decimal {
+	return o.TriggerPrice
+}
+
+// GetTimeInForce returns the time in force
+func (o *Order) GetTimeInForce() order.TimeInForce {
+	return o.TimeInForce
+}
+
+// GetExpiryTime returns the expiry time of a GoodTillTime order
+func (o *Order) GetExpiryTime() time.Time {
+	return o.ExpiryTime
+}
+
+// GetOrderPrice returns the price the order is expected to fill at.
+// Limit and stop limit orders use the limit price, stop and take profit
+// orders use the trigger price and market orders use the close price
+func (o *Order) GetOrderPrice() decimal.Decimal {
+	switch {
+	case o.OrderType.Is(order.Limit) && o.LimitPrice.IsPositive():
+		return o.LimitPrice
+	case (o.OrderType.Is(order.Stop) || o.OrderType.Is(order.TakeProfit)) && o.TriggerPrice.IsPositive():
+		return o.TriggerPrice
+	}
+	return o.ClosePrice
+}
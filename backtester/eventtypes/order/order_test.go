@@ -2,8 +2,10 @@ package order
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -170,3 +172,39 @@ func TestIsClosingPosition(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", k.IsClosingPosition(), true)
 	}
 }
+
+func TestRestingOrderDetails(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	o := Order{
+		OrderType:    gctorder.StopLimit,
+		LimitPrice:   decimal.NewFromInt(1337),
+		TriggerPrice: decimal.NewFromInt(1336),
+		TimeInForce:  gctorder.GoodTillTime,
+		ExpiryTime:   tt,
+	}
+	assert.Equal(t, gctorder.StopLimit, o.GetOrderType())
+	assert.Equal(t, decimal.NewFromInt(1337), o.GetLimitPrice())
+	assert.Equal(t, decimal.NewFromInt(1336), o.GetTriggerPrice())
+	assert.Equal(t, gctorder.GoodTillTime, o.GetTimeInForce())
+	assert.Equal(t, tt, o.GetExpiryTime())
+}
+
+func TestGetOrderPrice(t *testing.T) {
+	t.Parallel()
+	o := Order{
+		OrderType:    gctorder.Market,
+		ClosePrice:   decimal.NewFromInt(100),
+		LimitPrice:   decimal.NewFromInt(90),
+		TriggerPrice: decimal.NewFromInt(110),
+	}
+	assert.Equal(t, decimal.NewFromInt(100), o.GetOrderPrice(), "market orders should use the close price")
+	o.OrderType = gctorder.Limit
+	assert.Equal(t, decimal.NewFromInt(90), o.GetOrderPrice(), "limit orders should use the limit price")
+	o.OrderType = gctorder.StopLimit
+	assert.Equal(t, decimal.NewFromInt(90), o.GetOrderPrice(), "stop limit orders should use the limit price")
+	o.OrderType = gctorder.Stop
+	assert.Equal(t, decimal.NewFromInt(110), o.GetOrderPrice(), "stop orders should use the trigger price")
+	o.OrderType = gctorder.TakeProfit
+	assert.Equal(t, decimal.NewFromInt(110), o.GetOrderPrice(), "take profit orders should use the trigger price")
+}
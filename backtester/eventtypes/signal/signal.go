@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -114,6 +116,37 @@ func (s *Signal) MatchOrderAmount() bool {
 	return s.MatchesOrderAmount
 }
 
+// GetOrderType returns the order type
+func (s *Signal) GetOrderType() order.Type {
+	return s.OrderType
+}
+
+// GetLimitPrice returns the limit price
+func (s *Signal) GetLimitPrice() decimal.Decimal {
+	return s.LimitPrice
+}
+
+// GetTriggerPrice returns the trigger price
+func (s *Signal) GetTriggerPrice() decimal.Decimal {
+	return s.TriggerPrice
+}
+
+// GetTimeInForce returns the time in force
+func (s *Signal) GetTimeInForce() order.TimeInForce {
+	return s.TimeInForce
+}
+
+// GetExpiryTime returns the expiry time of a GoodTillTime order
+func (s *Signal) GetExpiryTime() time.Time {
+	return s.ExpiryTime
+}
+
+// IsCancellingRestingOrders returns whether the signal cancels
+// all resting orders for its exchange, asset and pair
+func (s *Signal) IsCancellingRestingOrders() bool {
+	return s.CancelRestingOrders
+}
+
 // ToKline is used to convert a signal event
 // to a data event for the purpose of closing all positions
 // function CloseAllPositions is builds signal data, but
@@ -2,8 +2,10 @@ package signal
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -201,3 +203,22 @@ func TestToKline(t *testing.T) {
 		t.Errorf("expected  '%v' received '%v'", "kline event", "signal event")
 	}
 }
+
+func TestRestingOrderDetails(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	s := Signal{
+		OrderType:           gctorder.StopLimit,
+		LimitPrice:          decimal.NewFromInt(1337),
+		TriggerPrice:        decimal.NewFromInt(1336),
+		TimeInForce:         gctorder.GoodTillTime,
+		ExpiryTime:          tt,
+		CancelRestingOrders: true,
+	}
+	assert.Equal(t, gctorder.StopLimit, s.GetOrderType())
+	assert.Equal(t, decimal.NewFromInt(1337), s.GetLimitPrice())
+	assert.Equal(t, decimal.NewFromInt(1336), s.GetTriggerPrice())
+	assert.Equal(t, gctorder.GoodTillTime, s.GetTimeInForce())
+	assert.Equal(t, tt, s.GetExpiryTime())
+	assert.True(t, s.IsCancellingRestingOrders())
+}
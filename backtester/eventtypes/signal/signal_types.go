@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -28,6 +30,12 @@ type Event interface {
 	GetCollateralCurrency() currency.Code
 	SetAmount(decimal.Decimal)
 	MatchOrderAmount() bool
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetTimeInForce() order.TimeInForce
+	GetExpiryTime() time.Time
+	IsCancellingRestingOrders() bool
 	IsNil() bool
 }
 
@@ -66,4 +74,21 @@ type Signal struct {
 	// MatchOrderAmount flags to other event handlers
 	// that the order amount must match the set Amount property
 	MatchesOrderAmount bool
+	// OrderType allows a strategy to rest an order on the simulated
+	// exchange. Supported types are Limit, Stop, StopLimit and TakeProfit.
+	// Orders are placed at market when unset
+	OrderType order.Type
+	// LimitPrice is the price of Limit and StopLimit orders
+	LimitPrice decimal.Decimal
+	// TriggerPrice is the price at which Stop, StopLimit and
+	// TakeProfit orders are triggered
+	TriggerPrice decimal.Decimal
+	// TimeInForce determines how long a resting order remains on the
+	// simulated exchange. GoodTillCancel is used when unset
+	TimeInForce order.TimeInForce
+	// ExpiryTime cancels a GoodTillTime order once reached
+	ExpiryTime time.Time
+	// CancelRestingOrders cancels all resting orders for the exchange,
+	// asset and pair before the signal is processed
+	CancelRestingOrders bool
 }
@@ -120,7 +120,12 @@ func (f *FundManager) CreateSnapshot(t time.Time) error {
 				Time: t,
 			}
 		}
-		iss.Available = f.items[i].available
+		// spot funds reserved by resting orders are still held
+		held := f.items[i].available
+		if f.items[i].asset == asset.Spot {
+			held = held.Add(f.items[i].reserved)
+		}
+		iss.Available = held
 		if !f.disableUSDTracking {
 			if f.items[i].trackingCandles == nil {
 				continue
@@ -137,7 +142,7 @@ func (f *FundManager) CreateSnapshot(t time.Time) error {
 				}
 			}
 			iss.USDClosePrice = usdClosePrice
-			iss.USDValue = usdClosePrice.Mul(f.items[i].available)
+			iss.USDValue = usdClosePrice.Mul(held)
 		}
 
 		f.items[i].snapshot[t.UnixNano()] = iss
@@ -401,6 +401,8 @@ func TestCreateSnapshot(t *testing.T) {
 	})
 	err = f.CreateSnapshot(dfk.Item.Candles[0].Time)
 	assert.NoError(t, err)
+	snap := f.items[1].snapshot[dfk.Item.Candles[0].Time.UnixNano()]
+	assert.True(t, snap.Available.Equal(decimal.NewFromInt(2674)), "spot funds reserved by resting orders should be held")
 }
 
 func TestAddUSDTrackingData(t *testing.T) {
@@ -22,7 +22,7 @@ import (
 
 var (
 	singleTaskStrategyPath, templatePath, outputPath, btConfigDir, strategyPluginPath, pprofURL string
-	printLogo, generateReport, darkReport, colourOutput, logSubHeader, enablePProf              bool
+	printLogo, generateReport, darkReport, colourOutput, logSubHeader, enablePProf, optimise    bool
 )
 
 func main() {
@@ -176,15 +176,32 @@ func main() {
 			fmt.Printf("Could not read strategy config. Error: %v\n", err)
 			os.Exit(1)
 		}
-		var bt *backtest.BackTest
-		bt, err = backtest.NewBacktesterFromConfigs(cfg, &config.BacktesterConfig{
+		singleTaskCfg := &config.BacktesterConfig{
 			Report: config.Report{
 				GenerateReport: generateReport,
 				TemplatePath:   btCfg.Report.TemplatePath,
 				OutputPath:     btCfg.Report.OutputPath,
 				DarkMode:       darkReport,
 			},
-		})
+		}
+		if optimise {
+			report, err := backtest.NewTaskManager().Optimise(cfg, singleTaskCfg)
+			if err != nil {
+				fmt.Printf("Could not optimise strategy. Error: %v\n", err)
+				os.Exit(1)
+			}
+			report.PrintResults()
+			if generateReport {
+				_, err = report.Save(btCfg.Report.OutputPath)
+				if err != nil {
+					fmt.Printf("Could not save optimiser report. Error: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			return
+		}
+		var bt *backtest.BackTest
+		bt, err = backtest.NewBacktesterFromConfigs(cfg, singleTaskCfg)
 		if err != nil {
 			fmt.Printf("Could not execute strategy. Error: %v\n", err)
 			os.Exit(1)
@@ -285,6 +302,11 @@ func parseFlags(wd string) map[string]bool {
 		"singlerunstrategypath",
 		"",
 		fmt.Sprintf("path to a strategy file. Will execute strategy and exit, instead of creating a GRPC server. Example %v", defaultStrategy))
+	flag.BoolVar(
+		&optimise,
+		"optimise",
+		false,
+		"runs the singlerunstrategypath strategy once per parameter set defined in its optimiser-settings and ranks the results")
 	flag.StringVar(
 		&btConfigDir,
 		"backtesterconfigpath",
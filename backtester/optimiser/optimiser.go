@@ -0,0 +1,360 @@
+package optimiser
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// GenerateParameterSets creates the parameter sets to run for a grid or
+// random search
+func GenerateParameterSets(o *config.OptimiserSettings, method string) ([]map[string]float64, error) {
+	if o == nil {
+		return nil, fmt.Errorf("%w optimiser settings", gctcommon.ErrNilPointer)
+	}
+	switch method {
+	case config.OptimiserGridSearch:
+		return GenerateGrid(o.Parameters)
+	case config.OptimiserRandomSearch:
+		return GenerateRandom(o.Parameters, o.RandomIterations, o.RandomSeed)
+	default:
+		return nil, fmt.Errorf("unsupported search method '%v'", method)
+	}
+}
+
+// GenerateGrid returns every combination of the parameter ranges
+func GenerateGrid(params []config.ParameterRange) ([]map[string]float64, error) {
+	if len(params) == 0 {
+		return nil, errNoParameters
+	}
+	values := make([][]float64, len(params))
+	total := 1
+	for i := range params {
+		v, err := rangeValues(&params[i])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+		total *= len(v)
+		if total > MaximumRuns {
+			return nil, fmt.Errorf("%w %v", errTooManyRuns, MaximumRuns)
+		}
+	}
+	sets := make([]map[string]float64, 0, total)
+	indexes := make([]int, len(params))
+	for {
+		set := make(map[string]float64, len(params))
+		for i := range params {
+			set[params[i].Key] = values[i][indexes[i]]
+		}
+		sets = append(sets, set)
+		// increment the last parameter first, carrying over like an odometer
+		i := len(indexes) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(values[i]) {
+				break
+			}
+			indexes[i] = 0
+		}
+		if i < 0 {
+			return sets, nil
+		}
+	}
+}
+
+// GenerateRandom returns randomly sampled parameter sets. Parameters with a step
+// are snapped to it. A zero seed will use the current time
+func GenerateRandom(params []config.ParameterRange, iterations, seed int64) ([]map[string]float64, error) {
+	if len(params) == 0 {
+		return nil, errNoParameters
+	}
+	if iterations <= 0 {
+		return nil, errInvalidIterations
+	}
+	if iterations > MaximumRuns {
+		return nil, fmt.Errorf("%w %v", errTooManyRuns, MaximumRuns)
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewPCG(uint64(seed), uint64(seed))) //nolint:gosec // Not used for security
+	sets := make([]map[string]float64, iterations)
+	for i := range sets {
+		sets[i] = make(map[string]float64, len(params))
+		for j := range params {
+			p := &params[j]
+			minimum := decimal.NewFromFloat(p.Minimum)
+			maximum := decimal.NewFromFloat(p.Maximum)
+			if p.Step <= 0 {
+				sets[i][p.Key] = minimum.Add(maximum.Sub(minimum).Mul(decimal.NewFromFloat(r.Float64()))).InexactFloat64()
+				continue
+			}
+			step := decimal.NewFromFloat(p.Step)
+			steps := maximum.Sub(minimum).Div(step).Floor().IntPart()
+			sets[i][p.Key] = minimum.Add(step.Mul(decimal.NewFromInt(r.Int64N(steps + 1)))).InexactFloat64()
+		}
+	}
+	return sets, nil
+}
+
+// rangeValues returns every stepped value between the minimum and maximum inclusive
+func rangeValues(p *config.ParameterRange) ([]float64, error) {
+	if p.Maximum < p.Minimum || p.Step < 0 {
+		return nil, fmt.Errorf("invalid range for %v minimum %v maximum %v step %v", p.Key, p.Minimum, p.Maximum, p.Step)
+	}
+	if p.Minimum == p.Maximum {
+		return []float64{p.Minimum}, nil
+	}
+	if p.Step == 0 {
+		return []float64{p.Minimum, p.Maximum}, nil
+	}
+	minimum := decimal.NewFromFloat(p.Minimum)
+	maximum := decimal.NewFromFloat(p.Maximum)
+	step := decimal.NewFromFloat(p.Step)
+	steps := maximum.Sub(minimum).Div(step).Floor().IntPart()
+	if steps >= MaximumRuns {
+		return nil, fmt.Errorf("%w %v %v", errTooManyRuns, p.Key, MaximumRuns)
+	}
+	resp := make([]float64, 0, steps+1)
+	for v := minimum; v.LessThanOrEqual(maximum); v = v.Add(step) {
+		resp = append(resp, v.InexactFloat64())
+	}
+	return resp, nil
+}
+
+// GenerateWalkForwardWindows splits a date range into rolling in-sample and
+// out-of-sample windows. Each window moves forward by the out-of-sample length
+// so that every out-of-sample range is tested once
+func GenerateWalkForwardWindows(start, end time.Time, inSample, outOfSample time.Duration) ([]Window, error) {
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return nil, fmt.Errorf("%w start %v end %v", errInvalidWindow, start, end)
+	}
+	if inSample <= 0 || outOfSample <= 0 {
+		return nil, fmt.Errorf("%w in-sample %v out-of-sample %v", errInvalidWindow, inSample, outOfSample)
+	}
+	var windows []Window
+	for windowStart := start; ; windowStart = windowStart.Add(outOfSample) {
+		inSampleEnd := windowStart.Add(inSample)
+		outOfSampleEnd := inSampleEnd.Add(outOfSample)
+		if outOfSampleEnd.After(end) {
+			break
+		}
+		windows = append(windows, Window{
+			InSampleStart:    windowStart,
+			InSampleEnd:      inSampleEnd,
+			OutOfSampleStart: inSampleEnd,
+			OutOfSampleEnd:   outOfSampleEnd,
+		})
+	}
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("%w start %v end %v", errNoWindows, start, end)
+	}
+	return windows, nil
+}
+
+// ScoreStatistics extracts the ranking metrics from a completed run. USD tracked
+// totals are preferred, otherwise ratios are averaged across all currencies and
+// the worst drawdown is used
+func ScoreStatistics(s *statistics.Statistic) (*Score, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w statistics", gctcommon.ErrNilPointer)
+	}
+	if s.FundingStatistics != nil &&
+		s.FundingStatistics.TotalUSDStatistics != nil &&
+		s.FundingStatistics.TotalUSDStatistics.ArithmeticRatios != nil {
+		t := s.FundingStatistics.TotalUSDStatistics
+		return &Score{
+			SharpeRatio:      t.ArithmeticRatios.SharpeRatio,
+			SortinoRatio:     t.ArithmeticRatios.SortinoRatio,
+			CalmarRatio:      t.ArithmeticRatios.CalmarRatio,
+			MaxDrawdown:      t.MaxDrawdown.DrawdownPercent.Abs(),
+			StrategyMovement: t.HoldingValueDifference,
+		}, nil
+	}
+	resp := &Score{}
+	var count int64
+	for _, c := range s.ExchangeAssetPairStatistics {
+		if c == nil || c.ArithmeticRatios == nil {
+			continue
+		}
+		count++
+		resp.SharpeRatio = resp.SharpeRatio.Add(c.ArithmeticRatios.SharpeRatio)
+		resp.SortinoRatio = resp.SortinoRatio.Add(c.ArithmeticRatios.SortinoRatio)
+		resp.CalmarRatio = resp.CalmarRatio.Add(c.ArithmeticRatios.CalmarRatio)
+		resp.StrategyMovement = resp.StrategyMovement.Add(c.StrategyMovement)
+		if dd := c.MaxDrawdown.DrawdownPercent.Abs(); dd.GreaterThan(resp.MaxDrawdown) {
+			resp.MaxDrawdown = dd
+		}
+	}
+	if count == 0 {
+		return nil, errNoStatistics
+	}
+	divisor := decimal.NewFromInt(count)
+	resp.SharpeRatio = resp.SharpeRatio.Div(divisor)
+	resp.SortinoRatio = resp.SortinoRatio.Div(divisor)
+	resp.CalmarRatio = resp.CalmarRatio.Div(divisor)
+	resp.StrategyMovement = resp.StrategyMovement.Div(divisor)
+	return resp, nil
+}
+
+// Value returns the score's value for a metric
+func (s *Score) Value(metric string) (decimal.Decimal, error) {
+	if s == nil {
+		return decimal.Zero, fmt.Errorf("%w score", gctcommon.ErrNilPointer)
+	}
+	switch metric {
+	case config.OptimiserMetricSharpe:
+		return s.SharpeRatio, nil
+	case config.OptimiserMetricSortino:
+		return s.SortinoRatio, nil
+	case config.OptimiserMetricCalmar:
+		return s.CalmarRatio, nil
+	case config.OptimiserMetricMaxDrawdown:
+		return s.MaxDrawdown, nil
+	default:
+		return decimal.Zero, fmt.Errorf("%w '%v'", errUnsupportedMetric, metric)
+	}
+}
+
+// Rank sorts results from best to worst by the metric and sets their rank.
+// A smaller drawdown is better, all other metrics prefer larger values.
+// Runs without a score are placed last and are not ranked
+func Rank(results []Result, metric string) error {
+	switch metric {
+	case config.OptimiserMetricSharpe, config.OptimiserMetricSortino, config.OptimiserMetricCalmar, config.OptimiserMetricMaxDrawdown:
+	default:
+		return fmt.Errorf("%w '%v'", errUnsupportedMetric, metric)
+	}
+	slices.SortStableFunc(results, func(a, b Result) int {
+		switch {
+		case a.Score == nil && b.Score == nil:
+			return 0
+		case a.Score == nil:
+			return 1
+		case b.Score == nil:
+			return -1
+		}
+		aVal, _ := a.Score.Value(metric)
+		bVal, _ := b.Score.Value(metric)
+		if metric == config.OptimiserMetricMaxDrawdown {
+			return aVal.Cmp(bVal)
+		}
+		return bVal.Cmp(aVal)
+	})
+	for i := range results {
+		if results[i].Score == nil {
+			results[i].Rank = 0
+			continue
+		}
+		results[i].Rank = i + 1
+	}
+	return nil
+}
+
+// Best returns the highest ranked result. Results must be ranked first
+func Best(results []Result) (*Result, error) {
+	if len(results) == 0 || results[0].Score == nil {
+		return nil, ErrNoSuccessfulRuns
+	}
+	return &results[0], nil
+}
+
+// Save writes the report as JSON to the output directory and returns the
+// file's path
+func (r *Report) Save(outputPath string) (string, error) {
+	if r == nil {
+		return "", fmt.Errorf("%w report", gctcommon.ErrNilPointer)
+	}
+	if outputPath == "" {
+		return "", errReportOutputPathRequired
+	}
+	fn := r.Nickname
+	if fn != "" {
+		fn += "-"
+	}
+	fn += r.Strategy + "-optimiser-" + r.GeneratedAt.Format("2006-01-02-15-04-05")
+	fileName, err := common.GenerateFileName(fn, "json")
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(outputPath, fileName)
+	err = file.Write(path, data)
+	if err != nil {
+		return "", err
+	}
+	log.Infof(common.Optimiser, "Successfully saved optimiser report to %v", path)
+	return path, nil
+}
+
+// PrintResults logs the ranked results and any walk forward windows
+func (r *Report) PrintResults() {
+	if r == nil {
+		return
+	}
+	log.Infof(common.Optimiser, "------------------Optimiser Results------------------------")
+	log.Infof(common.Optimiser, "Strategy: %v Method: %v Metric: %v", r.Strategy, r.Method, r.Metric)
+	for i := range r.Results {
+		printResult(&r.Results[i])
+	}
+	for i := range r.Windows {
+		w := &r.Windows[i]
+		log.Infof(common.Optimiser, "Window %v in-sample %v - %v out-of-sample %v - %v",
+			i+1,
+			w.InSampleStart.Format(time.DateTime),
+			w.InSampleEnd.Format(time.DateTime),
+			w.OutOfSampleStart.Format(time.DateTime),
+			w.OutOfSampleEnd.Format(time.DateTime))
+		if w.OutOfSample != nil {
+			printResult(w.OutOfSample)
+		}
+	}
+}
+
+func printResult(res *Result) {
+	if res.Score == nil {
+		log.Warnf(common.Optimiser, "Unranked %v %v", formatParameters(res.Parameters), res.Error)
+		return
+	}
+	log.Infof(common.Optimiser, "Rank %v %v Sharpe: %v Sortino: %v Calmar: %v Max drawdown: %v%% Strategy movement: %v%%",
+		res.Rank,
+		formatParameters(res.Parameters),
+		res.Score.SharpeRatio.Round(4),
+		res.Score.SortinoRatio.Round(4),
+		res.Score.CalmarRatio.Round(4),
+		res.Score.MaxDrawdown.Round(2),
+		res.Score.StrategyMovement.Round(2))
+}
+
+// formatParameters outputs parameters in a consistent key order
+func formatParameters(params map[string]float64) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	var sb strings.Builder
+	for i := range keys {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+		fmt.Fprintf(&sb, "%v=%v", keys[i], params[keys[i]])
+	}
+	return sb.String()
+}
@@ -0,0 +1,245 @@
+package optimiser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestGenerateParameterSets(t *testing.T) {
+	t.Parallel()
+	_, err := GenerateParameterSets(nil, config.OptimiserGridSearch)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	o := &config.OptimiserSettings{
+		Parameters:       []config.ParameterRange{{Key: "a", Minimum: 1, Maximum: 3, Step: 1}},
+		RandomIterations: 5,
+		RandomSeed:       1337,
+	}
+	_, err = GenerateParameterSets(o, "meow")
+	assert.Error(t, err, "GenerateParameterSets should error on an unsupported method")
+
+	sets, err := GenerateParameterSets(o, config.OptimiserGridSearch)
+	require.NoError(t, err, "GenerateParameterSets must not error")
+	assert.Len(t, sets, 3)
+
+	sets, err = GenerateParameterSets(o, config.OptimiserRandomSearch)
+	require.NoError(t, err, "GenerateParameterSets must not error")
+	assert.Len(t, sets, 5)
+}
+
+func TestGenerateGrid(t *testing.T) {
+	t.Parallel()
+	_, err := GenerateGrid(nil)
+	assert.ErrorIs(t, err, errNoParameters)
+
+	sets, err := GenerateGrid([]config.ParameterRange{
+		{Key: "a", Minimum: 0.1, Maximum: 0.3, Step: 0.1},
+		{Key: "b", Minimum: 10, Maximum: 20},
+		{Key: "c", Minimum: 5, Maximum: 5, Step: 1},
+	})
+	require.NoError(t, err, "GenerateGrid must not error")
+	require.Len(t, sets, 6, "GenerateGrid must return every combination")
+	assert.Equal(t, map[string]float64{"a": 0.1, "b": 10, "c": 5}, sets[0])
+	assert.Equal(t, map[string]float64{"a": 0.1, "b": 20, "c": 5}, sets[1])
+	assert.Equal(t, map[string]float64{"a": 0.3, "b": 20, "c": 5}, sets[5], "decimal stepping should reach the maximum")
+
+	_, err = GenerateGrid([]config.ParameterRange{{Key: "a", Minimum: 2, Maximum: 1}})
+	assert.Error(t, err, "GenerateGrid should error on an invalid range")
+
+	_, err = GenerateGrid([]config.ParameterRange{{Key: "a", Minimum: 0, Maximum: MaximumRuns, Step: 1}})
+	assert.ErrorIs(t, err, errTooManyRuns)
+
+	_, err = GenerateGrid([]config.ParameterRange{
+		{Key: "a", Minimum: 0, Maximum: 999, Step: 1},
+		{Key: "b", Minimum: 0, Maximum: 99, Step: 1},
+	})
+	assert.ErrorIs(t, err, errTooManyRuns)
+}
+
+func TestGenerateRandom(t *testing.T) {
+	t.Parallel()
+	_, err := GenerateRandom(nil, 1, 0)
+	assert.ErrorIs(t, err, errNoParameters)
+
+	params := []config.ParameterRange{
+		{Key: "a", Minimum: 10, Maximum: 20, Step: 2.5},
+		{Key: "b", Minimum: -1, Maximum: 1},
+	}
+	_, err = GenerateRandom(params, 0, 0)
+	assert.ErrorIs(t, err, errInvalidIterations)
+
+	_, err = GenerateRandom(params, MaximumRuns+1, 0)
+	assert.ErrorIs(t, err, errTooManyRuns)
+
+	sets, err := GenerateRandom(params, 50, 1337)
+	require.NoError(t, err, "GenerateRandom must not error")
+	require.Len(t, sets, 50)
+	for i := range sets {
+		assert.Contains(t, []float64{10, 12.5, 15, 17.5, 20}, sets[i]["a"], "stepped parameters should snap to the step")
+		assert.GreaterOrEqual(t, sets[i]["b"], -1.0)
+		assert.LessOrEqual(t, sets[i]["b"], 1.0)
+	}
+
+	repeat, err := GenerateRandom(params, 50, 1337)
+	require.NoError(t, err, "GenerateRandom must not error")
+	assert.Equal(t, sets, repeat, "the same seed should generate the same parameter sets")
+}
+
+func TestGenerateWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := time.Hour * 24
+	_, err := GenerateWalkForwardWindows(time.Time{}, start, day, day)
+	assert.ErrorIs(t, err, errInvalidWindow)
+
+	_, err = GenerateWalkForwardWindows(start, start.Add(day), 0, day)
+	assert.ErrorIs(t, err, errInvalidWindow)
+
+	_, err = GenerateWalkForwardWindows(start, start.Add(day), day, day)
+	assert.ErrorIs(t, err, errNoWindows)
+
+	windows, err := GenerateWalkForwardWindows(start, start.Add(day*10), day*4, day*2)
+	require.NoError(t, err, "GenerateWalkForwardWindows must not error")
+	require.Len(t, windows, 3)
+	for i := range windows {
+		assert.Equal(t, start.Add(day*2*time.Duration(i)), windows[i].InSampleStart)
+		assert.Equal(t, windows[i].InSampleEnd, windows[i].OutOfSampleStart, "out-of-sample should start where in-sample ends")
+		assert.Equal(t, day*2, windows[i].OutOfSampleEnd.Sub(windows[i].OutOfSampleStart))
+	}
+	assert.Equal(t, start.Add(day*10), windows[2].OutOfSampleEnd)
+}
+
+func TestScoreStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := ScoreStatistics(nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s := &statistics.Statistic{}
+	_, err = ScoreStatistics(s)
+	assert.ErrorIs(t, err, errNoStatistics)
+
+	s.ExchangeAssetPairStatistics = map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic{
+		{Exchange: "a", Base: currency.BTC.Item, Quote: currency.USD.Item, Asset: asset.Spot}: {
+			ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(1), SortinoRatio: decimal.NewFromInt(2), CalmarRatio: decimal.NewFromInt(3)},
+			MaxDrawdown:      statistics.Swing{DrawdownPercent: decimal.NewFromInt(-10)},
+			StrategyMovement: decimal.NewFromInt(4),
+		},
+		{Exchange: "b", Base: currency.BTC.Item, Quote: currency.USD.Item, Asset: asset.Spot}: {
+			ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(3), SortinoRatio: decimal.NewFromInt(4), CalmarRatio: decimal.NewFromInt(5)},
+			MaxDrawdown:      statistics.Swing{DrawdownPercent: decimal.NewFromInt(-20)},
+			StrategyMovement: decimal.NewFromInt(6),
+		},
+	}
+	score, err := ScoreStatistics(s)
+	require.NoError(t, err, "ScoreStatistics must not error")
+	assert.True(t, score.SharpeRatio.Equal(decimal.NewFromInt(2)), "ScoreStatistics should average currency ratios")
+	assert.True(t, score.SortinoRatio.Equal(decimal.NewFromInt(3)), "ScoreStatistics should average currency ratios")
+	assert.True(t, score.CalmarRatio.Equal(decimal.NewFromInt(4)), "ScoreStatistics should average currency ratios")
+	assert.True(t, score.StrategyMovement.Equal(decimal.NewFromInt(5)), "ScoreStatistics should average strategy movement")
+	assert.True(t, score.MaxDrawdown.Equal(decimal.NewFromInt(20)), "ScoreStatistics should use the worst drawdown")
+
+	s.FundingStatistics = &statistics.FundingStatistics{
+		TotalUSDStatistics: &statistics.TotalFundingStatistics{
+			ArithmeticRatios:       &statistics.Ratios{SharpeRatio: decimal.NewFromInt(7)},
+			MaxDrawdown:            statistics.Swing{DrawdownPercent: decimal.NewFromInt(-5)},
+			HoldingValueDifference: decimal.NewFromInt(8),
+		},
+	}
+	score, err = ScoreStatistics(s)
+	require.NoError(t, err, "ScoreStatistics must not error")
+	assert.True(t, score.SharpeRatio.Equal(decimal.NewFromInt(7)), "ScoreStatistics should prefer USD totals")
+	assert.True(t, score.MaxDrawdown.Equal(decimal.NewFromInt(5)), "ScoreStatistics should prefer USD totals")
+	assert.True(t, score.StrategyMovement.Equal(decimal.NewFromInt(8)), "ScoreStatistics should prefer USD totals")
+}
+
+func TestScoreValue(t *testing.T) {
+	t.Parallel()
+	var s *Score
+	_, err := s.Value(config.OptimiserMetricSharpe)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s = &Score{
+		SharpeRatio:  decimal.NewFromInt(1),
+		SortinoRatio: decimal.NewFromInt(2),
+		CalmarRatio:  decimal.NewFromInt(3),
+		MaxDrawdown:  decimal.NewFromInt(4),
+	}
+	for metric, expected := range map[string]int64{
+		config.OptimiserMetricSharpe:      1,
+		config.OptimiserMetricSortino:     2,
+		config.OptimiserMetricCalmar:      3,
+		config.OptimiserMetricMaxDrawdown: 4,
+	} {
+		v, err := s.Value(metric)
+		require.NoErrorf(t, err, "Value must not error for %v", metric)
+		assert.Truef(t, v.Equal(decimal.NewFromInt(expected)), "Value should return the %v", metric)
+	}
+	_, err = s.Value("meow")
+	assert.ErrorIs(t, err, errUnsupportedMetric)
+}
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+	err := Rank(nil, "meow")
+	assert.ErrorIs(t, err, errUnsupportedMetric)
+
+	results := []Result{
+		{Parameters: map[string]float64{"a": 1}, Score: &Score{SharpeRatio: decimal.NewFromInt(1), MaxDrawdown: decimal.NewFromInt(5)}},
+		{Parameters: map[string]float64{"a": 2}, Error: "bad"},
+		{Parameters: map[string]float64{"a": 3}, Score: &Score{SharpeRatio: decimal.NewFromInt(3), MaxDrawdown: decimal.NewFromInt(10)}},
+	}
+	err = Rank(results, config.OptimiserMetricSharpe)
+	require.NoError(t, err, "Rank must not error")
+	assert.Equal(t, 3.0, results[0].Parameters["a"], "Rank should order the highest sharpe first")
+	assert.Equal(t, 1, results[0].Rank)
+	assert.Equal(t, 2, results[1].Rank)
+	assert.Zero(t, results[2].Rank, "Rank should not rank failed runs")
+	assert.Equal(t, "bad", results[2].Error, "Rank should place failed runs last")
+
+	err = Rank(results, config.OptimiserMetricMaxDrawdown)
+	require.NoError(t, err, "Rank must not error")
+	assert.Equal(t, 1.0, results[0].Parameters["a"], "Rank should order the smallest drawdown first")
+
+	best, err := Best(results)
+	require.NoError(t, err, "Best must not error")
+	assert.Equal(t, 1.0, best.Parameters["a"])
+
+	_, err = Best(results[2:])
+	assert.ErrorIs(t, err, ErrNoSuccessfulRuns)
+}
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+	var r *Report
+	_, err := r.Save("")
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	r = &Report{
+		Strategy:    "rsi",
+		Nickname:    "test",
+		Method:      config.OptimiserGridSearch,
+		Metric:      config.OptimiserMetricSharpe,
+		GeneratedAt: time.Now(),
+		Results:     []Result{{Rank: 1, Parameters: map[string]float64{"a": 1}, Score: &Score{}}},
+	}
+	_, err = r.Save("")
+	assert.ErrorIs(t, err, errReportOutputPathRequired)
+
+	path, err := r.Save(t.TempDir())
+	require.NoError(t, err, "Save must not error")
+	assert.Equal(t, ".json", filepath.Ext(path))
+	_, err = os.Stat(path)
+	assert.NoError(t, err, "Save should write the report")
+}
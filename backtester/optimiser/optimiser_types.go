@@ -0,0 +1,68 @@
+package optimiser
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaximumRuns caps the amount of parameter sets a single search can generate
+// to prevent a poorly ranged grid from consuming all available resources
+const MaximumRuns = 10000
+
+// ErrNoSuccessfulRuns is returned when every parameter set failed to run or
+// be scored
+var ErrNoSuccessfulRuns = errors.New("no successful runs to select from")
+
+var (
+	errNoParameters             = errors.New("no parameters to search")
+	errTooManyRuns              = errors.New("parameter search exceeds maximum runs")
+	errInvalidIterations        = errors.New("iterations must be greater than zero")
+	errInvalidWindow            = errors.New("invalid walk forward window")
+	errNoWindows                = errors.New("date range too short to create a walk forward window")
+	errNoStatistics             = errors.New("no statistics to score")
+	errUnsupportedMetric        = errors.New("unsupported metric")
+	errReportOutputPathRequired = errors.New("report output path required")
+)
+
+// Score holds the ranking metrics extracted from a backtesting run
+type Score struct {
+	SharpeRatio      decimal.Decimal `json:"sharpe-ratio"`
+	SortinoRatio     decimal.Decimal `json:"sortino-ratio"`
+	CalmarRatio      decimal.Decimal `json:"calmar-ratio"`
+	MaxDrawdown      decimal.Decimal `json:"max-drawdown"`
+	StrategyMovement decimal.Decimal `json:"strategy-movement"`
+}
+
+// Result is a single parameter set's outcome
+type Result struct {
+	Rank       int                `json:"rank"`
+	Parameters map[string]float64 `json:"parameters"`
+	Score      *Score             `json:"score,omitempty"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// Window is a single walk forward step. Parameters are searched in-sample
+// and the best set is then run against the unseen out-of-sample range
+type Window struct {
+	InSampleStart    time.Time `json:"in-sample-start"`
+	InSampleEnd      time.Time `json:"in-sample-end"`
+	OutOfSampleStart time.Time `json:"out-of-sample-start"`
+	OutOfSampleEnd   time.Time `json:"out-of-sample-end"`
+	InSample         []Result  `json:"in-sample,omitempty"`
+	OutOfSample      *Result   `json:"out-of-sample,omitempty"`
+}
+
+// Report compares all runs of an optimisation
+type Report struct {
+	Strategy    string    `json:"strategy"`
+	Nickname    string    `json:"nickname,omitempty"`
+	Method      string    `json:"method"`
+	Metric      string    `json:"metric"`
+	StartDate   time.Time `json:"start-date"`
+	EndDate     time.Time `json:"end-date"`
+	GeneratedAt time.Time `json:"generated-at"`
+	Results     []Result  `json:"results,omitempty"`
+	Windows     []Window  `json:"windows,omitempty"`
+}
@@ -129,6 +129,46 @@ func createPNLCharts(items map[key.ExchangePairAsset]*statistics.CurrencyPairSta
 	return response, nil
 }
 
+// createFundingPNLChart shows the running total of funding rate payments
+// applied to each futures currency pair's positions
+func createFundingPNLChart(items map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic) (*Chart, error) {
+	if items == nil {
+		return nil, fmt.Errorf("%w missing currency pair statistics", gctcommon.ErrNilPointer)
+	}
+	response := &Chart{
+		AxisType: "linear",
+	}
+	for mapKey, result := range items {
+		if !mapKey.Asset.IsFutures() {
+			continue
+		}
+		fundingPNL := ChartLine{
+			Name: fmt.Sprintf("%v %v %v%v Funding PNL",
+				mapKey.Exchange,
+				mapKey.Asset,
+				mapKey.Base,
+				mapKey.Quote),
+		}
+		var total decimal.Decimal
+		var hasFunding bool
+		for i := range result.Events {
+			if !result.Events[i].FundingPNL.IsZero() {
+				hasFunding = true
+			}
+			total = total.Add(result.Events[i].FundingPNL)
+			fundingPNL.LinePlots = append(fundingPNL.LinePlots, LinePlot{
+				Value:     total.InexactFloat64(),
+				UnixMilli: result.Events[i].Time.UnixMilli(),
+			})
+		}
+		if !hasFunding {
+			continue
+		}
+		response.Data = append(response.Data, fundingPNL)
+	}
+	return response, nil
+}
+
 // createFuturesSpotDiffChart highlights the difference in futures and spot prices
 // over time
 func createFuturesSpotDiffChart(items map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic) (*Chart, error) {
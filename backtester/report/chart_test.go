@@ -151,6 +151,54 @@ func TestCreatePNLCharts(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateFundingPNLChart(t *testing.T) {
+	t.Parallel()
+	_, err := createFundingPNLChart(nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	tt := time.Now()
+	items := map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic{
+		{
+			Exchange: testExchange,
+			Base:     currency.BTC.Item,
+			Quote:    currency.USDT.Item,
+			Asset:    asset.Spot,
+		}: {
+			Events: []statistics.DataAtOffset{{Time: tt, FundingPNL: decimal.NewFromInt(1)}},
+		},
+		{
+			Exchange: testExchange,
+			Base:     currency.ETH.Item,
+			Quote:    currency.USDT.Item,
+			Asset:    asset.PerpetualContract,
+		}: {
+			Events: []statistics.DataAtOffset{{Time: tt}},
+		},
+	}
+	chart, err := createFundingPNLChart(items)
+	require.NoError(t, err, "createFundingPNLChart must not error")
+	assert.Empty(t, chart.Data, "createFundingPNLChart should ignore spot and futures without funding")
+
+	items[key.ExchangePairAsset{
+		Exchange: testExchange,
+		Base:     currency.BTC.Item,
+		Quote:    currency.USDT.Item,
+		Asset:    asset.PerpetualContract,
+	}] = &statistics.CurrencyPairStatistic{
+		Events: []statistics.DataAtOffset{
+			{Time: tt, FundingPNL: decimal.NewFromInt(-2)},
+			{Time: tt.Add(time.Hour)},
+			{Time: tt.Add(time.Hour * 2), FundingPNL: decimal.NewFromInt(3)},
+		},
+	}
+	chart, err = createFundingPNLChart(items)
+	require.NoError(t, err, "createFundingPNLChart must not error")
+	require.Len(t, chart.Data, 1)
+	require.Len(t, chart.Data[0].LinePlots, 3)
+	assert.Equal(t, -2.0, chart.Data[0].LinePlots[1].Value, "funding PNL should be cumulative")
+	assert.Equal(t, 1.0, chart.Data[0].LinePlots[2].Value, "funding PNL should be cumulative")
+}
+
 func TestCreateFuturesSpotDiffChart(t *testing.T) {
 	t.Parallel()
 	_, err := createFuturesSpotDiffChart(nil)
@@ -70,6 +70,14 @@ func (d *Data) GenerateReport() error {
 		if err != nil {
 			return err
 		}
+		var fundingPNLChart *Chart
+		fundingPNLChart, err = createFundingPNLChart(d.Statistics.ExchangeAssetPairStatistics)
+		if err != nil {
+			return err
+		}
+		if len(fundingPNLChart.Data) > 0 {
+			d.FundingPNLChart = fundingPNLChart
+		}
 	}
 	tmpl := template.Must(
 		template.ParseFiles(d.TemplatePath),
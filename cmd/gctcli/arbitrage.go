@@ -0,0 +1,63 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var arbitrageCommand = &cli.Command{
+	Name:      "arbitrage",
+	Usage:     "execute arbitrage manager commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "get",
+			Usage:  "gets the most recently detected arbitrage opportunities",
+			Action: getArbitrageOpportunities,
+		},
+		{
+			Name:   "stream",
+			Usage:  "streams arbitrage opportunities as they are detected",
+			Action: getArbitrageOpportunityStream,
+		},
+	},
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetArbitrageOpportunities(c.Context, &gctrpc.GetArbitrageOpportunitiesRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getArbitrageOpportunityStream(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetArbitrageOpportunityStream(c.Context, &gctrpc.GetArbitrageOpportunitiesRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/urfave/cli/v2"
@@ -790,6 +791,29 @@ func getConfig(c *cli.Context) error {
 	return nil
 }
 
+var reloadConfigCommand = &cli.Command{
+	Name:   "reloadconfig",
+	Usage:  "applies changes made to the config file without restarting",
+	Action: reloadConfig,
+}
+
+func reloadConfig(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReloadConfig(c.Context, &gctrpc.ReloadConfigRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getPortfolioCommand = &cli.Command{
 	Name:   "getportfolio",
 	Usage:  "gets the portfolio",
@@ -836,6 +860,99 @@ func getPortfolioSummary(c *cli.Context) error {
 	return nil
 }
 
+var getPortfolioEquityCurveCommand = &cli.Command{
+	Name:      "getportfolioequitycurve",
+	Usage:     "gets the fiat value of stored portfolio snapshots over time, optionally limited to an exchange or currency",
+	ArgsUsage: "<start> <end> <exchange> <currency>",
+	Action:    getPortfolioEquityCurve,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "start",
+			Aliases:     []string{"s"},
+			Usage:       "the start date",
+			Value:       time.Now().AddDate(0, 0, -30).Format(time.DateTime),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Aliases:     []string{"e"},
+			Usage:       "the end date",
+			Value:       time.Now().Format(time.DateTime),
+			Destination: &endTime,
+		},
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "optional exchange to limit the curve to",
+		},
+		&cli.StringFlag{
+			Name:  "currency",
+			Usage: "optional currency to limit the curve to",
+		},
+	},
+}
+
+func getPortfolioEquityCurve(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(2)
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(3)
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return common.ErrStartAfterEnd
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioEquityCurve(c.Context, &gctrpc.GetPortfolioEquityCurveRequest{
+		Exchange:  exchangeName,
+		Currency:  curr,
+		StartDate: s.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   e.Format(common.SimpleTimeFormatWithTimezone),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var addPortfolioAddressCommand = &cli.Command{
 	Name:      "addportfolioaddress",
 	Usage:     "adds an address to the portfolio",
@@ -2147,7 +2264,63 @@ var addEventCommand = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:  "action",
-			Usage: "the action for the event to perform upon trigger",
+			Usage: "the action for the event to perform upon trigger (CONSOLE_PRINT, SMS,<message>, SUBMIT_ORDER, CANCEL_ORDERS, RUN_SCRIPT or PUSH)",
+		},
+		&cli.Float64Flag{
+			Name:  "value",
+			Usage: "the threshold for SPREAD, IMBALANCE, FUNDING_RATE, OPEN_INTEREST and INDICATOR items",
+		},
+		&cli.StringFlag{
+			Name:  "indicator",
+			Usage: "the INDICATOR item indicator (SMA, EMA, RSI, MFI, ATR or MACD)",
+		},
+		&cli.Int64Flag{
+			Name:  "interval",
+			Usage: "the INDICATOR item candle interval in seconds",
+		},
+		&cli.Int64Flag{
+			Name:  "period",
+			Usage: "the INDICATOR item period",
+		},
+		&cli.StringFlag{
+			Name:  "conditions",
+			Usage: `a JSON compound condition used instead of item and condition e.g. {"operator":"AND","conditions":[{"item":"SPREAD","params":{"condition":"<","value":0.1}},{"item":"IMBALANCE","params":{"condition":">","value":0.5}}]}`,
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the SUBMIT_ORDER order side",
+		},
+		&cli.StringFlag{
+			Name:  "order_type",
+			Usage: "the SUBMIT_ORDER order type (MARKET or LIMIT), defaults to MARKET",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the SUBMIT_ORDER order amount",
+		},
+		&cli.Float64Flag{
+			Name:  "order_price",
+			Usage: "the SUBMIT_ORDER limit order price",
+		},
+		&cli.StringFlag{
+			Name:  "script",
+			Usage: "the RUN_SCRIPT gctscript file name",
+		},
+		&cli.StringFlag{
+			Name:  "relayer",
+			Usage: "the PUSH comms relayer e.g. slack",
+		},
+		&cli.StringFlag{
+			Name:  "message",
+			Usage: "the PUSH message, defaults to the event trigger message",
+		},
+		&cli.BoolFlag{
+			Name:  "repeat",
+			Usage: "keeps the event armed after it triggers",
+		},
+		&cli.Int64Flag{
+			Name:  "cooldown",
+			Usage: "the minimum number of seconds between triggers of a repeating event",
 		},
 	},
 }
@@ -2174,15 +2347,23 @@ func addEvent(c *cli.Context) error {
 		return errors.New("exchange name is required")
 	}
 
+	var conditions *gctrpc.EventCondition
+	if c.IsSet("conditions") {
+		conditions = new(gctrpc.EventCondition)
+		if err := json.Unmarshal([]byte(c.String("conditions")), conditions); err != nil {
+			return fmt.Errorf("invalid conditions: %w", err)
+		}
+	}
+
 	if c.IsSet("item") {
 		item = c.String("item")
-	} else {
+	} else if conditions == nil {
 		return errors.New("item is required")
 	}
 
 	if c.IsSet("condition") {
 		condition = c.String("condition")
-	} else {
+	} else if conditions == nil {
 		return errors.New("condition is required")
 	}
 
@@ -2248,7 +2429,12 @@ func addEvent(c *cli.Context) error {
 			CheckBids:       checkBids,
 			CheckAsks:       checkAsks,
 			OrderbookAmount: orderbookAmount,
+			Value:           c.Float64("value"),
+			Indicator:       c.String("indicator"),
+			Interval:        int64(time.Duration(c.Int64("interval")) * time.Second),
+			Period:          c.Int64("period"),
 		},
+		Conditions: conditions,
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: p.Delimiter,
 			Base:      p.Base.String(),
@@ -2256,6 +2442,17 @@ func addEvent(c *cli.Context) error {
 		},
 		AssetType: assetType,
 		Action:    action,
+		ActionParams: &gctrpc.EventActionParams{
+			Side:      c.String("side"),
+			OrderType: c.String("order_type"),
+			Amount:    c.Float64("amount"),
+			Price:     c.Float64("order_price"),
+			Script:    c.String("script"),
+			Relayer:   c.String("relayer"),
+			Message:   c.String("message"),
+		},
+		Repeat:   c.Bool("repeat"),
+		Cooldown: int64(time.Duration(c.Int64("cooldown")) * time.Second),
 	})
 	if err != nil {
 		return err
@@ -2547,6 +2744,10 @@ var withdrawCryptocurrencyFundsCommand = &cli.Command{
 			Name:  "chain",
 			Usage: "chain to use for the withdrawal",
 		},
+		&cli.StringFlag{
+			Name:  "totp",
+			Usage: "TOTP code confirming the withdrawal, required when the withdrawal policy is enabled",
+		},
 	},
 }
 
@@ -2630,6 +2831,7 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 			Fee:         fee,
 			Description: description,
 			Chain:       chain,
+			TotpCode:    c.String("totp"),
 		},
 	)
 	if err != nil {
@@ -2665,6 +2867,10 @@ var withdrawFiatFundsCommand = &cli.Command{
 			Name:  "description",
 			Usage: "description to submit with request",
 		},
+		&cli.StringFlag{
+			Name:  "totp",
+			Usage: "TOTP code confirming the withdrawal, required when the withdrawal policy is enabled",
+		},
 	},
 }
 
@@ -2723,6 +2929,7 @@ func withdrawFiatFunds(c *cli.Context) error {
 			Amount:        amount,
 			Description:   description,
 			BankAccountId: bankAccountID,
+			TotpCode:      c.String("totp"),
 		},
 	)
 	if err != nil {
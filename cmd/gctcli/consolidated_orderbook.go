@@ -0,0 +1,352 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errInvalidConsolidatedSource = errors.New("sources must be formatted as exchange:pair")
+
+var consolidatedOrderbookCommonFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "sources",
+		Usage: "comma separated exchange:pair sources sharing a base currency e.g. binance:BTC-USDT,kraken:BTC-EUR",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type of the currency pairs",
+		Value: "spot",
+	},
+	&cli.StringFlag{
+		Name:  "quote",
+		Usage: "the fiat currency to normalise prices to, defaults to the quote of the first source",
+	},
+}
+
+var consolidatedOrderbookCommand = &cli.Command{
+	Name:      "consolidated",
+	Usage:     "consolidates the orderbooks of a base currency across exchanges",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "gets the consolidated orderbook tagged by venue",
+			ArgsUsage: "<sources> <asset> <quote> <maxlevels>",
+			Action:    getConsolidatedOrderbook,
+			Flags: append(consolidatedOrderbookCommonFlags, &cli.Int64Flag{
+				Name:  "maxlevels",
+				Usage: "the maximum number of levels returned for each side, zero for all",
+			}),
+		},
+		{
+			Name:      "stream",
+			Usage:     "streams the consolidated orderbook as its venues update",
+			ArgsUsage: "<sources> <asset> <quote> <maxlevels>",
+			Action:    getConsolidatedOrderbookStream,
+			Flags: append(consolidatedOrderbookCommonFlags, &cli.Int64Flag{
+				Name:  "maxlevels",
+				Usage: "the maximum number of levels displayed for each side",
+				Value: 10,
+			}),
+		},
+		{
+			Name:      "simulate",
+			Usage:     "simulates an order across the consolidated orderbook",
+			ArgsUsage: "<sources> <asset> <quote> <side> <amount>",
+			Action:    simulateConsolidatedOrder,
+			Flags: append(consolidatedOrderbookCommonFlags,
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the quote amount to spend when buying or the base amount to sell",
+				},
+			),
+		},
+		{
+			Name:      "whalebomb",
+			Usage:     "finds the amount required to reach a price target across the consolidated orderbook",
+			ArgsUsage: "<sources> <asset> <quote> <side> <price>",
+			Action:    consolidatedWhaleBomb,
+			Flags: append(consolidatedOrderbookCommonFlags,
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the price target in the consolidated quote currency",
+				},
+			),
+		},
+	},
+}
+
+// consolidatedOrderbookArgs parses the sources, asset and quote which are
+// common to all consolidated orderbook commands
+func consolidatedOrderbookArgs(c *cli.Context) (sources []*gctrpc.ConsolidatedOrderbookSource, assetType, quote string, err error) {
+	var rawSources string
+	if c.IsSet("sources") {
+		rawSources = c.String("sources")
+	} else {
+		rawSources = c.Args().First()
+	}
+	if rawSources == "" {
+		return nil, "", "", errInvalidConsolidatedSource
+	}
+	for _, s := range strings.Split(rawSources, ",") {
+		exchangeName, pair, ok := strings.Cut(strings.TrimSpace(s), ":")
+		if !ok || exchangeName == "" || !validPair(pair) {
+			return nil, "", "", fmt.Errorf("%w: %q", errInvalidConsolidatedSource, s)
+		}
+		p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+		if err != nil {
+			return nil, "", "", err
+		}
+		sources = append(sources, &gctrpc.ConsolidatedOrderbookSource{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+		})
+	}
+
+	if c.IsSet("asset") || c.Args().Get(1) == "" {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	if !validAsset(assetType) {
+		return nil, "", "", errInvalidAsset
+	}
+
+	if c.IsSet("quote") {
+		quote = c.String("quote")
+	} else {
+		quote = c.Args().Get(2)
+	}
+	return sources, assetType, quote, nil
+}
+
+// consolidatedOrderbookMaxLevels parses the max levels flag or argument
+func consolidatedOrderbookMaxLevels(c *cli.Context) (int64, error) {
+	if c.IsSet("maxlevels") || c.Args().Get(3) == "" {
+		return c.Int64("maxlevels"), nil
+	}
+	return strconv.ParseInt(c.Args().Get(3), 10, 64)
+}
+
+func getConsolidatedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	sources, assetType, quote, err := consolidatedOrderbookArgs(c)
+	if err != nil {
+		return err
+	}
+	maxLevels, err := consolidatedOrderbookMaxLevels(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConsolidatedOrderbook(c.Context, &gctrpc.GetConsolidatedOrderbookRequest{
+		Sources:   sources,
+		AssetType: assetType,
+		Quote:     quote,
+		MaxLevels: maxLevels,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConsolidatedOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	sources, assetType, quote, err := consolidatedOrderbookArgs(c)
+	if err != nil {
+		return err
+	}
+	maxLevels, err := consolidatedOrderbookMaxLevels(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConsolidatedOrderbookStream(c.Context, &gctrpc.GetConsolidatedOrderbookRequest{
+		Sources:   sources,
+		AssetType: assetType,
+		Quote:     quote,
+		MaxLevels: maxLevels,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		if resp.Error != "" {
+			fmt.Printf("%s\n", resp.Error)
+			continue
+		}
+		renderConsolidatedOrderbook(resp)
+	}
+}
+
+func renderConsolidatedOrderbook(resp *gctrpc.ConsolidatedOrderbookResponse) {
+	upperBase := strings.ToUpper(resp.Pair.Base)
+	upperQuote := strings.ToUpper(resp.Pair.Quote)
+	printFmt := "%s%.8f\t\t%.8f\t\t%s\n"
+	fmt.Printf("%sConsolidated orderbook stream for %v %v - Last updated %v\n",
+		whiteText, resp.AssetType, upperBase+"-"+upperQuote, time.UnixMicro(resp.LastUpdated).Format(common.SimpleTimeFormatWithTimezone))
+	for i := range resp.Venues {
+		if resp.Venues[i].Error != "" {
+			fmt.Printf("%s%s %s unavailable: %s\n", grayText, resp.Venues[i].Exchange, resp.Venues[i].CurrencyPair, resp.Venues[i].Error)
+		}
+	}
+
+	fmt.Printf("%sPrice(%v)\t\tAmount(%s)\t\tVenue\n", grayText, upperQuote, upperBase)
+	for i := len(resp.Asks) - 1; i >= 0; i-- {
+		fmt.Printf(printFmt, redText, resp.Asks[i].Price, resp.Asks[i].Amount, resp.Asks[i].Exchange)
+	}
+	fmt.Println()
+	for i := range resp.Bids {
+		fmt.Printf(printFmt, greenText, resp.Bids[i].Price, resp.Bids[i].Amount, resp.Bids[i].Exchange)
+	}
+	fmt.Println(defaultText)
+}
+
+// consolidatedOrderArgs parses the side and value arguments of the simulate
+// and whalebomb commands
+func consolidatedOrderArgs(c *cli.Context, valueFlag string) (side string, value float64, err error) {
+	if c.IsSet("side") {
+		side = c.String("side")
+	} else {
+		side = c.Args().Get(3)
+	}
+	if side == "" {
+		return "", 0, errors.New("order side must be set")
+	}
+
+	if c.IsSet(valueFlag) {
+		value = c.Float64(valueFlag)
+	} else if c.Args().Get(4) != "" {
+		value, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	return side, value, nil
+}
+
+func simulateConsolidatedOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	sources, assetType, quote, err := consolidatedOrderbookArgs(c)
+	if err != nil {
+		return err
+	}
+	side, amount, err := consolidatedOrderArgs(c, "amount")
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SimulateConsolidatedOrder(c.Context, &gctrpc.SimulateConsolidatedOrderRequest{
+		Sources:   sources,
+		AssetType: assetType,
+		Quote:     quote,
+		Side:      side,
+		Amount:    amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func consolidatedWhaleBomb(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	sources, assetType, quote, err := consolidatedOrderbookArgs(c)
+	if err != nil {
+		return err
+	}
+	side, price, err := consolidatedOrderArgs(c, "price")
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ConsolidatedWhaleBomb(c.Context, &gctrpc.ConsolidatedWhaleBombRequest{
+		Sources:     sources,
+		AssetType:   assetType,
+		Quote:       quote,
+		Side:        side,
+		PriceTarget: price,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -14,7 +14,7 @@ import (
 
 var dataHistoryCommands = &cli.Command{
 	Name:      "datahistory",
-	Usage:     "manage data history jobs to retrieve historic trade or candle data over time",
+	Usage:     "manage data history jobs to retrieve historic trade, candle, funding rate, open interest or orderbook data over time",
 	ArgsUsage: "<command> <args>",
 	Subcommands: []*cli.Command{
 		{
@@ -147,6 +147,24 @@ var dataHistoryJobCommands = &cli.Command{
 			Flags:  append(baseJobSubCommands, secondaryValidationJobSubCommands...),
 			Action: upsertDataHistoryJob,
 		},
+		{
+			Name:   "savefundingrates",
+			Usage:  "will fetch historical funding rates of a perpetual contract from an exchange and save them to the database, collecting new rates as they occur until the end date",
+			Flags:  append(baseJobSubCommands, requestSize50Flag),
+			Action: upsertDataHistoryJob,
+		},
+		{
+			Name:   "saveopeninterest",
+			Usage:  "will save the open interest of a futures contract to the database every interval until the end date. open interest cannot be backfilled",
+			Flags:  baseJobSubCommands,
+			Action: upsertDataHistoryJob,
+		},
+		{
+			Name:   "saveorderbooksnapshots",
+			Usage:  "will save the top levels of an orderbook to the database every interval until the end date. orderbooks cannot be backfilled",
+			Flags:  append(baseJobSubCommands, orderbookSnapshotJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
 	},
 }
 
@@ -283,6 +301,13 @@ var (
 			Usage: "if true, when the intolerance percentage is exceeded, then the comparison API candle will replace the database candle",
 		},
 	}
+	orderbookSnapshotJobSubCommands = []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "orderbook_depth",
+			Usage: "the number of price levels of each side of the orderbook to save per snapshot",
+			Value: 20,
+		},
+	}
 	secondaryValidationJobSubCommands = []cli.Flag{
 		&cli.StringFlag{
 			Name:  "secondary_exchange",
@@ -461,12 +486,19 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		dataType = 4
 	case "secondaryvalidatecandles":
 		dataType = 5
+	case "savefundingrates":
+		dataType = 6
+	case "saveopeninterest":
+		dataType = 7
+	case "saveorderbooksnapshots":
+		dataType = 8
 	default:
 		return errors.New("unrecognised command, cannot set data type")
 	}
 
 	var conversionInterval time.Duration
 	var overwriteExistingData bool
+	var orderbookDepth uint64
 
 	switch dataType {
 	case 0, 1:
@@ -486,6 +518,8 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		if c.IsSet("comparison_decimal_places") {
 			comparisonDecimalPlaces = c.Uint64("comparison_decimal_places")
 		}
+	case 8:
+		orderbookDepth = c.Uint64("orderbook_depth")
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -519,6 +553,7 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		SecondaryExchangeName:    secondaryExchange,
 		IssueTolerancePercentage: intolerancePercentage,
 		ReplaceOnIssue:           replaceOnIssue,
+		OrderbookDepth:           orderbookDepth,
 	}
 
 	result, err := client.UpsertDataHistoryJob(c.Context, request)
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var executionAlgorithmIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the execution algorithm id",
+	},
+}
+
+var executionAlgorithmCommand = &cli.Command{
+	Name:      "executionalgorithms",
+	Usage:     "execute execution algorithm management command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "start",
+			Usage:     "starts working a parent order with a TWAP, VWAP, ICEBERG or POV execution algorithm",
+			ArgsUsage: "<type> <exchange> <pair> <asset> <side> <amount>",
+			Action:    startExecutionAlgorithm,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the execution algorithm (TWAP, VWAP, ICEBERG or POV)",
+				},
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to submit child orders to",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the parent order",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the limit price for child orders, market orders are used if not set. required for ICEBERG",
+				},
+				&cli.Int64Flag{
+					Name:  "duration",
+					Usage: "the duration in seconds to work TWAP and VWAP orders over",
+				},
+				&cli.Int64Flag{
+					Name:  "slices",
+					Usage: "the number of TWAP child orders",
+				},
+				&cli.Int64Flag{
+					Name:  "interval",
+					Usage: "the VWAP slice and candle interval in seconds",
+				},
+				&cli.Int64Flag{
+					Name:  "profile_days",
+					Usage: "the number of previous days of candles used to build the VWAP volume profile",
+				},
+				&cli.Float64Flag{
+					Name:  "clip_size",
+					Usage: "the ICEBERG visible amount",
+				},
+				&cli.Float64Flag{
+					Name:  "clip_variance",
+					Usage: "randomises each ICEBERG clip by up to this fraction e.g. 0.2",
+				},
+				&cli.Float64Flag{
+					Name:  "participation_rate",
+					Usage: "the POV fraction of traded volume to participate with e.g. 0.1",
+				},
+			},
+		},
+		{
+			Name:      "pause",
+			Usage:     "pauses an execution algorithm and cancels its open child orders",
+			ArgsUsage: "<id>",
+			Flags:     executionAlgorithmIDFlags,
+			Action:    pauseExecutionAlgorithm,
+		},
+		{
+			Name:      "resume",
+			Usage:     "resumes a paused execution algorithm",
+			ArgsUsage: "<id>",
+			Flags:     executionAlgorithmIDFlags,
+			Action:    resumeExecutionAlgorithm,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels an execution algorithm and its open child orders",
+			ArgsUsage: "<id>",
+			Flags:     executionAlgorithmIDFlags,
+			Action:    cancelExecutionAlgorithm,
+		},
+		{
+			Name:      "status",
+			Usage:     "gets the progress of an execution algorithm or all execution algorithms if no id is supplied",
+			ArgsUsage: "<id>",
+			Action:    getExecutionAlgorithms,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the execution algorithm id",
+				},
+				&cli.BoolFlag{
+					Name:  "all",
+					Usage: "includes completed, cancelled and failed execution algorithms",
+				},
+			},
+		},
+	},
+}
+
+func startExecutionAlgorithm(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var algoType string
+	if c.IsSet("type") {
+		algoType = c.String("type")
+	} else {
+		algoType = c.Args().First()
+	}
+
+	if algoType == "" {
+		return errors.New("execution algorithm type must be set")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(4)
+	}
+
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.StartExecutionAlgorithm(c.Context, &gctrpc.StartExecutionAlgorithmRequest{
+		Type:     algoType,
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:              orderSide,
+		Amount:            amount,
+		Price:             c.Float64("price"),
+		Duration:          int64(time.Duration(c.Int64("duration")) * time.Second),
+		Slices:            c.Int64("slices"),
+		Interval:          int64(time.Duration(c.Int64("interval")) * time.Second),
+		ProfileDays:       c.Int64("profile_days"),
+		ClipSize:          c.Float64("clip_size"),
+		ClipVariance:      c.Float64("clip_variance"),
+		ParticipationRate: c.Float64("participation_rate"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func pauseExecutionAlgorithm(c *cli.Context) error {
+	return executionAlgorithmAction(c, func(client gctrpc.GoCryptoTraderServiceClient, req *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error) {
+		return client.PauseExecutionAlgorithm(c.Context, req)
+	})
+}
+
+func resumeExecutionAlgorithm(c *cli.Context) error {
+	return executionAlgorithmAction(c, func(client gctrpc.GoCryptoTraderServiceClient, req *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error) {
+		return client.ResumeExecutionAlgorithm(c.Context, req)
+	})
+}
+
+func cancelExecutionAlgorithm(c *cli.Context) error {
+	return executionAlgorithmAction(c, func(client gctrpc.GoCryptoTraderServiceClient, req *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error) {
+		return client.CancelExecutionAlgorithm(c.Context, req)
+	})
+}
+
+func executionAlgorithmAction(c *cli.Context, fn func(gctrpc.GoCryptoTraderServiceClient, *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error)) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	result, err := fn(gctrpc.NewGoCryptoTraderServiceClient(conn), &gctrpc.GenericExecutionAlgorithmRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutionAlgorithms(c *cli.Context) error {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetExecutionAlgorithms(c.Context, &gctrpc.GetExecutionAlgorithmsRequest{
+		Id:              id,
+		IncludeInactive: c.Bool("all"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
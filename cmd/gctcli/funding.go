@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var fundingScanCommand = &cli.Command{
+	Name:      "fundingscan",
+	Usage:     "execute funding rate scanner commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "gets the latest perpetual funding rates and ranked carry opportunities, optionally limited to an underlying currency",
+			ArgsUsage: "<base> <quote>",
+			Action:    getFundingRateScan,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "base",
+					Usage: "optional underlying base currency to limit the results to",
+				},
+				&cli.StringFlag{
+					Name:  "quote",
+					Usage: "optional underlying quote currency to limit the results to",
+				},
+			},
+		},
+		{
+			Name:      "history",
+			Usage:     "gets the stored carry opportunities between the start and end dates, optionally limited to an underlying currency",
+			ArgsUsage: "<start> <end> <base> <quote>",
+			Action:    getFundingOpportunityHistory,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "start",
+					Aliases:     []string{"s"},
+					Usage:       "the start date",
+					Value:       time.Now().AddDate(0, 0, -7).Format(time.DateTime),
+					Destination: &startTime,
+				},
+				&cli.StringFlag{
+					Name:        "end",
+					Aliases:     []string{"e"},
+					Usage:       "the end date",
+					Value:       time.Now().Format(time.DateTime),
+					Destination: &endTime,
+				},
+				&cli.StringFlag{
+					Name:  "base",
+					Usage: "optional underlying base currency to limit the results to",
+				},
+				&cli.StringFlag{
+					Name:  "quote",
+					Usage: "optional underlying quote currency to limit the results to",
+				},
+			},
+		},
+	},
+}
+
+func getFundingRateScan(c *cli.Context) error {
+	var base string
+	if c.IsSet("base") {
+		base = c.String("base")
+	} else {
+		base = c.Args().Get(0)
+	}
+
+	var quote string
+	if c.IsSet("quote") {
+		quote = c.String("quote")
+	} else {
+		quote = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFundingRateScan(c.Context, &gctrpc.GetFundingRateScanRequest{
+		Base:  base,
+		Quote: quote,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFundingOpportunityHistory(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	var base string
+	if c.IsSet("base") {
+		base = c.String("base")
+	} else {
+		base = c.Args().Get(2)
+	}
+
+	var quote string
+	if c.IsSet("quote") {
+		quote = c.String("quote")
+	} else {
+		quote = c.Args().Get(3)
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return common.ErrStartAfterEnd
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFundingOpportunityHistory(c.Context, &gctrpc.GetFundingOpportunityHistoryRequest{
+		StartDate: s.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   e.Format(common.SimpleTimeFormatWithTimezone),
+		Base:      base,
+		Quote:     quote,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var ledgerCommand = &cli.Command{
+	Name:      "ledger",
+	Usage:     "execute ledger manager commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "pnl",
+			Usage:     "gets the cost basis and realised and unrealised PnL of spot holdings",
+			ArgsUsage: "<currencies>",
+			Action:    getLedgerPnL,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "currencies",
+					Usage: "optional comma separated currencies to limit the holdings returned e.g. btc,eth",
+				},
+			},
+		},
+		{
+			Name:      "transfer",
+			Usage:     "records a deposit or withdrawal made outside of the bot against the ledger",
+			ArgsUsage: "<exchange> <type> <currency> <amount> <price> <fee> <fee_currency>",
+			Action:    addLedgerTransfer,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange the transfer was made to or from",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the transfer type (DEPOSIT OR WITHDRAWAL)",
+				},
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "the currency transferred",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount transferred",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the fiat value of a single unit of a deposit, defaults to the market price",
+				},
+				&cli.Float64Flag{
+					Name:  "fee",
+					Usage: "the fee charged on the transfer",
+				},
+				&cli.StringFlag{
+					Name:  "fee_currency",
+					Usage: "the currency the fee was charged in, defaults to the currency transferred",
+				},
+			},
+		},
+	},
+}
+
+func getLedgerPnL(c *cli.Context) error {
+	var currencies string
+	if c.IsSet("currencies") {
+		currencies = c.String("currencies")
+	} else {
+		currencies = c.Args().First()
+	}
+
+	var codes []string
+	if currencies != "" {
+		codes = strings.Split(currencies, ",")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLedgerPnL(c.Context, &gctrpc.GetLedgerPnLRequest{
+		Currencies: codes,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func addLedgerTransfer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var transferType string
+	if c.IsSet("type") {
+		transferType = c.String("type")
+	} else {
+		transferType = c.Args().Get(1)
+	}
+	if transferType == "" {
+		return errors.New("transfer type must be set")
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(2)
+	}
+	if curr == "" {
+		return errors.New("currency must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var fee float64
+	if c.IsSet("fee") {
+		fee = c.Float64("fee")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		fee, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var feeCurrency string
+	if c.IsSet("fee_currency") {
+		feeCurrency = c.String("fee_currency")
+	} else {
+		feeCurrency = c.Args().Get(6)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.AddLedgerTransfer(c.Context, &gctrpc.AddLedgerTransferRequest{
+		Exchange:    exchangeName,
+		Type:        transferType,
+		Currency:    curr,
+		Amount:      amount,
+		Price:       price,
+		Fee:         fee,
+		FeeCurrency: feeCurrency,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -25,6 +25,7 @@ var (
 	host          string
 	username      string
 	password      string
+	rpcToken      string
 	pairDelimiter string
 	certPath      string
 	timeout       time.Duration
@@ -49,12 +50,16 @@ func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
 		return nil, nil, err
 	}
 
+	var rpcCreds credentials.PerRPCCredentials = auth.BasicAuth{
+		Username: username,
+		Password: password,
+	}
+	if rpcToken != "" {
+		rpcCreds = auth.BearerAuth{Token: rpcToken}
+	}
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: username,
-			Password: password,
-		}),
+		grpc.WithPerRPCCredentials(rpcCreds),
 	}
 
 	var cancel context.CancelFunc
@@ -97,6 +102,11 @@ func main() {
 			Usage:       "the gRPC password",
 			Destination: &password,
 		},
+		&cli.StringFlag{
+			Name:        "rpctoken",
+			Usage:       "the gRPC bearer token, used instead of the username and password when set",
+			Destination: &rpcToken,
+		},
 		&cli.StringFlag{
 			Name:        "delimiter",
 			Value:       "-",
@@ -176,8 +186,10 @@ func main() {
 		getAccountInfoStreamCommand,
 		updateAccountInfoCommand,
 		getConfigCommand,
+		reloadConfigCommand,
 		getPortfolioCommand,
 		getPortfolioSummaryCommand,
+		getPortfolioEquityCurveCommand,
 		addPortfolioAddressCommand,
 		removePortfolioAddressCommand,
 		getForexProvidersCommand,
@@ -187,6 +199,10 @@ func main() {
 		getOrderCommand,
 		submitOrderCommand,
 		simulateOrderCommand,
+		routeOrderCommand,
+		arbitrageCommand,
+		fundingScanCommand,
+		ledgerCommand,
 		cancelOrderCommand,
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
@@ -200,6 +216,7 @@ func main() {
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
 		withdrawalRequestCommand,
+		pendingWithdrawalCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
 		exchangePairManagerCommand,
@@ -220,6 +237,11 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		syntheticOrderCommand,
+		executionAlgorithmCommand,
+		riskCommand,
+		rpcTokenCommand,
+		hashRPCPasswordCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
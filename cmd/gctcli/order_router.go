@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var routeOrderCommand = &cli.Command{
+	Name:      "routeorder",
+	Usage:     "splits an order across every enabled exchange holding funds for it",
+	ArgsUsage: "<pair> <side> <type> <amount> <price> <client_id> <asset>",
+	Action:    routeOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type (MARKET OR LIMIT)",
+			Value: "market",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the base amount for the order",
+		},
+		&cli.Float64Flag{
+			Name:  "price",
+			Usage: "the limit price which caps the orderbook levels routed to",
+		},
+		&cli.StringFlag{
+			Name:  "client_id",
+			Usage: "the optional client order ID applied to each child order",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+	},
+}
+
+func routeOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(1)
+	}
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var orderType string
+	if c.IsSet("type") || c.Args().Get(2) == "" {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(2)
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	// price is optional for market orders
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var clientID string
+	if c.IsSet("client_id") {
+		clientID = c.String("client_id")
+	} else {
+		clientID = c.Args().Get(5)
+	}
+
+	var assetType string
+	if c.IsSet("asset") || c.Args().Get(6) == "" {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(6)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RouteOrder(c.Context, &gctrpc.RouteOrderRequest{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType: assetType,
+		Side:      orderSide,
+		OrderType: orderType,
+		Amount:    amount,
+		Price:     price,
+		ClientId:  clientID,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -51,6 +51,7 @@ var orderbookCommand = &cli.Command{
 		getOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
 		whaleBombCommand,
+		consolidatedOrderbookCommand,
 	},
 }
 
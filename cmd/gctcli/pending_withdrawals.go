@@ -0,0 +1,144 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var pendingWithdrawalCommand = &cli.Command{
+	Name:      "pendingwithdrawal",
+	Usage:     "manages withdrawals awaiting approval from a second principal",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the withdrawals awaiting approval",
+			Action: getPendingWithdrawals,
+		},
+		{
+			Name:      "approve",
+			Usage:     "approves a withdrawal, submitting it to its exchange",
+			ArgsUsage: "<id> <totp>",
+			Action:    approveWithdrawal,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the ID of the withdrawal to approve",
+				},
+				&cli.StringFlag{
+					Name:  "totp",
+					Usage: "TOTP code confirming the approval",
+				},
+			},
+		},
+		{
+			Name:      "reject",
+			Usage:     "rejects a withdrawal",
+			ArgsUsage: "<id> <reason>",
+			Action:    rejectWithdrawal,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the ID of the withdrawal to reject",
+				},
+				&cli.StringFlag{
+					Name:  "reason",
+					Usage: "the reason the withdrawal is rejected, included in its notification",
+				},
+			},
+		},
+	},
+}
+
+func getPendingWithdrawals(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPendingWithdrawals(c.Context, &gctrpc.GetPendingWithdrawalsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func approveWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	var code string
+	if c.IsSet("totp") {
+		code = c.String("totp")
+	} else {
+		code = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveWithdrawal(c.Context, &gctrpc.ApproveWithdrawalRequest{
+		Id:       id,
+		TotpCode: code,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func rejectWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	var reason string
+	if c.IsSet("reason") {
+		reason = c.String("reason")
+	} else {
+		reason = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectWithdrawal(c.Context, &gctrpc.RejectWithdrawalRequest{
+		Id:     id,
+		Reason: reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
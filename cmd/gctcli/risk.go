@@ -0,0 +1,91 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var riskCommand = &cli.Command{
+	Name:      "risk",
+	Usage:     "execute pre-trade risk management command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "status",
+			Usage:  "gets the pre-trade risk checks, kill switch state and recent risk violations",
+			Action: getRiskStatus,
+		},
+		{
+			Name:      "killswitch",
+			Usage:     "activates or deactivates the kill switch",
+			ArgsUsage: "<command> <args>",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "activate",
+					Usage:     "cancels all orders tracked by the order manager and blocks new order submissions",
+					ArgsUsage: "<reason>",
+					Action:    activateKillSwitch,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "reason",
+							Usage: "the reason the kill switch was activated",
+						},
+					},
+				},
+				{
+					Name:   "deactivate",
+					Usage:  "allows orders to be submitted again",
+					Action: deactivateKillSwitch,
+				},
+			},
+		},
+	},
+}
+
+func getRiskStatus(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRiskStatus(c.Context, &gctrpc.GetRiskStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func activateKillSwitch(c *cli.Context) error {
+	var reason string
+	if c.IsSet("reason") {
+		reason = c.String("reason")
+	} else {
+		reason = c.Args().First()
+	}
+	return setKillSwitch(c, &gctrpc.SetKillSwitchRequest{Active: true, Reason: reason})
+}
+
+func deactivateKillSwitch(c *cli.Context) error {
+	return setKillSwitch(c, &gctrpc.SetKillSwitchRequest{})
+}
+
+func setKillSwitch(c *cli.Context, req *gctrpc.SetKillSwitchRequest) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SetKillSwitch(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
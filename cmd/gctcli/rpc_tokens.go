@@ -0,0 +1,190 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var rpcTokenCommand = &cli.Command{
+	Name:      "rpctoken",
+	Usage:     "manages gRPC bearer tokens",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "create",
+			Usage:     "creates a gRPC bearer token, which is only displayed once",
+			ArgsUsage: "<name> <scopes> <expires>",
+			Action:    createRPCToken,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Usage: "a unique name for the token, which identifies its caller in the audit log",
+				},
+				&cli.StringFlag{
+					Name:  "scopes",
+					Usage: "comma separated scopes granted to the token, from read, trade, withdraw and admin",
+					Value: "read",
+				},
+				&cli.StringFlag{
+					Name:  "expires",
+					Usage: "the date the token expires",
+					Value: time.Now().AddDate(0, 0, 30).Format(time.DateTime),
+				},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "lists the gRPC bearer tokens",
+			Action: getRPCTokens,
+		},
+		{
+			Name:      "revoke",
+			Usage:     "revokes a gRPC bearer token",
+			ArgsUsage: "<id>",
+			Action:    revokeRPCToken,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the ID of the token to revoke",
+				},
+			},
+		},
+	},
+}
+
+var hashRPCPasswordCommand = &cli.Command{
+	Name:      "hashrpcpassword",
+	Usage:     "hashes a password for a gRPC principal in the remoteControl config section, without connecting to the server",
+	ArgsUsage: "<password>",
+	Action:    hashRPCPassword,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "password",
+			Usage: "the password to hash",
+		},
+	},
+}
+
+func createRPCToken(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var name string
+	if c.IsSet("name") {
+		name = c.String("name")
+	} else {
+		name = c.Args().First()
+	}
+	if name == "" {
+		return errors.New("token name must be set")
+	}
+
+	scopes := c.String("scopes")
+	if !c.IsSet("scopes") && c.Args().Get(1) != "" {
+		scopes = c.Args().Get(1)
+	}
+
+	expiry := c.String("expires")
+	if !c.IsSet("expires") && c.Args().Get(2) != "" {
+		expiry = c.Args().Get(2)
+	}
+	expires, err := time.ParseInLocation(time.DateTime, expiry, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for expires: %v", err)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CreateRPCToken(c.Context, &gctrpc.CreateRPCTokenRequest{
+		Name:    name,
+		Scopes:  strings.Split(scopes, ","),
+		Expires: expires.Format(common.SimpleTimeFormatWithTimezone),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getRPCTokens(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRPCTokens(c.Context, &gctrpc.GetRPCTokensRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func revokeRPCToken(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RevokeRPCToken(c.Context, &gctrpc.RevokeRPCTokenRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func hashRPCPassword(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var password string
+	if c.IsSet("password") {
+		password = c.String("password")
+	} else {
+		password = c.Args().First()
+	}
+	if password == "" {
+		return errors.New("password must be set")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(hash))
+	return nil
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var syntheticOrderCommand = &cli.Command{
+	Name:      "syntheticorders",
+	Usage:     "execute synthetic order management command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "add",
+			Usage:     "adds an OCO, bracket or trailing stop order emulated by the synthetic order manager",
+			ArgsUsage: "<type> <exchange> <pair> <asset> <side> <amount>",
+			Action:    addSyntheticOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the synthetic order type (OCO, BRACKET or TRAILING_STOP)",
+				},
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to submit child orders to",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the side of the closing order for OCO and trailing stops, or the entry order for brackets (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount for the order",
+				},
+				&cli.Float64Flag{
+					Name:  "entry_price",
+					Usage: "the bracket entry limit price, a market order is used if not set",
+				},
+				&cli.Float64Flag{
+					Name:  "take_profit",
+					Usage: "the take profit limit price for OCO and bracket orders",
+				},
+				&cli.Float64Flag{
+					Name:  "stop_loss",
+					Usage: "the stop loss trigger price for OCO and bracket orders",
+				},
+				&cli.Float64Flag{
+					Name:  "trailing_distance",
+					Usage: "the distance the trailing stop follows the market by",
+				},
+				&cli.Float64Flag{
+					Name:  "trailing_percent",
+					Usage: "the percentage the trailing stop follows the market by",
+				},
+			},
+		},
+		{
+			Name:   "get",
+			Usage:  "gets synthetic orders",
+			Action: getSyntheticOrders,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "all",
+					Usage: "includes completed, cancelled and failed synthetic orders",
+				},
+			},
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a synthetic order and any of its open child orders",
+			ArgsUsage: "<id>",
+			Action:    cancelSyntheticOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the synthetic order id",
+				},
+			},
+		},
+	},
+}
+
+func addSyntheticOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().First()
+	}
+
+	if orderType == "" {
+		return errors.New("synthetic order type must be set")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(4)
+	}
+
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.AddSyntheticOrder(c.Context, &gctrpc.AddSyntheticOrderRequest{
+		Type:     orderType,
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:             orderSide,
+		Amount:           amount,
+		EntryPrice:       c.Float64("entry_price"),
+		TakeProfitPrice:  c.Float64("take_profit"),
+		StopLossPrice:    c.Float64("stop_loss"),
+		TrailingDistance: c.Float64("trailing_distance"),
+		TrailingPercent:  c.Float64("trailing_percent"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getSyntheticOrders(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetSyntheticOrders(c.Context, &gctrpc.GetSyntheticOrdersRequest{
+		IncludeInactive: c.Bool("all"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelSyntheticOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CancelSyntheticOrder(c.Context, &gctrpc.CancelSyntheticOrderRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -14,6 +14,9 @@ import (
 const defaultSleepTime = time.Second * 30
 
 func containsOTP(cfg *config.Config) bool {
+	if cfg.WithdrawalPolicy.TOTPSecret != "" {
+		return true
+	}
 	for x := range cfg.Exchanges {
 		if cfg.Exchanges[x].API.Credentials.OTPSecret != "" {
 			return true
@@ -67,10 +70,18 @@ func main() {
 	log.Println("Loaded config file.")
 
 	if !containsOTP(&cfg) {
-		log.Fatal("No exchanges with OTP code stored. Exiting.")
+		log.Fatal("No exchanges or withdrawal policy with OTP code stored. Exiting.")
 	}
 
 	for {
+		if cfg.WithdrawalPolicy.TOTPSecret != "" {
+			code, err = totp.GenerateCode(cfg.WithdrawalPolicy.TOTPSecret, time.Now())
+			if err != nil {
+				log.Printf("Withdrawal policy: Failed to generate OTP code. Err: %s\n", err)
+			} else {
+				log.Printf("Withdrawal policy: %s\n", code)
+			}
+		}
 		for x := range cfg.Exchanges {
 			if cfg.Exchanges[x].API.Credentials.OTPSecret != "" {
 				code, err = totp.GenerateCode(cfg.Exchanges[x].API.Credentials.OTPSecret, time.Now())
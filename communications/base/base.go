@@ -17,6 +17,9 @@ type Base struct {
 type Event struct {
 	Type    string
 	Message string
+	// Relayer restricts the event to the named comms relayer, all enabled
+	// relayers receive the event when unset
+	Relayer string
 }
 
 // CommsStatus stores the status of a comms relayer
@@ -2,6 +2,7 @@ package base
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -37,9 +38,13 @@ func (c IComm) Setup() {
 	}
 }
 
-// PushEvent pushes triggered events to all enabled communication links
+// PushEvent pushes triggered events to all enabled communication links, or
+// only the named relayer when the event sets one
 func (c IComm) PushEvent(event Event) {
 	for i := range c {
+		if event.Relayer != "" && !strings.EqualFold(event.Relayer, c[i].GetName()) {
+			continue
+		}
 		if c[i].IsEnabled() && c[i].IsConnected() {
 			err := c[i].PushEvent(event)
 			if err != nil {
@@ -151,3 +151,17 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPushEventToRelayer(t *testing.T) {
+	t.Parallel()
+	target := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic := IComm{target}
+	ic.PushEvent(Event{Relayer: "someOtherProvider"})
+	if target.PushEventCalled {
+		t.Fatal("provider should not receive events for another relayer")
+	}
+	ic.PushEvent(Event{Relayer: "SOMETESTPROVIDER"})
+	if !target.PushEventCalled {
+		t.Fatal("provider should receive events for its relayer")
+	}
+}
@@ -1330,6 +1330,18 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckEventManagerConfig ensures the event manager config is valid
+func (c *Config) CheckEventManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.EventManager.Delay <= 0 {
+		c.EventManager.Delay = defaultEventManagerDelay
+	}
+	if c.EventManager.Enabled == nil { // default on, when being upgraded
+		c.EventManager.Enabled = convert.BoolPtr(true)
+	}
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1644,6 +1656,7 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckEventManagerConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -1654,10 +1667,7 @@ func (c *Config) CheckConfig() error {
 		return err
 	}
 
-	if c.GlobalHTTPTimeout <= 0 {
-		log.Warnf(log.ConfigMgr, "Global HTTP Timeout value not set, defaulting to %v.\n", defaultHTTPTimeout)
-		c.GlobalHTTPTimeout = defaultHTTPTimeout
-	}
+	c.CheckGlobalHTTPTimeout()
 
 	if c.NTPClient.Level != 0 {
 		c.CheckNTPConfig()
@@ -1666,6 +1676,14 @@ func (c *Config) CheckConfig() error {
 	return nil
 }
 
+// CheckGlobalHTTPTimeout sets the global HTTP timeout to its default when unset
+func (c *Config) CheckGlobalHTTPTimeout() {
+	if c.GlobalHTTPTimeout <= 0 {
+		log.Warnf(log.ConfigMgr, "Global HTTP Timeout value not set, defaulting to %v.\n", defaultHTTPTimeout)
+		c.GlobalHTTPTimeout = defaultHTTPTimeout
+	}
+}
+
 // LoadConfig loads your configuration file into your configuration object
 func (c *Config) LoadConfig(configPath string, dryrun bool) error {
 	err := c.ReadConfigFromFile(configPath, dryrun)
@@ -2051,3 +2051,18 @@ func TestCheckSyncManagerConfig(t *testing.T) {
 		t.Errorf("received %v expected %v", c.SyncManagerConfig.NumWorkers, DefaultSyncerWorkers)
 	}
 }
+
+func TestCheckEventManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckEventManagerConfig()
+	require.NotNil(t, c.EventManager.Enabled, "Enabled must be set")
+	assert.True(t, *c.EventManager.Enabled, "Enabled should default to true")
+	assert.Equal(t, defaultEventManagerDelay, c.EventManager.Delay, "Delay should be set to the default")
+
+	c.EventManager.Enabled = convert.BoolPtr(false)
+	c.EventManager.Delay = time.Second
+	c.CheckEventManagerConfig()
+	assert.False(t, *c.EventManager.Enabled, "Enabled should not be changed")
+	assert.Equal(t, time.Second, c.EventManager.Delay, "Delay should not be changed")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -37,6 +38,7 @@ const (
 	DefaultAPIClientID                   = "ClientID"
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
+	defaultEventManagerDelay             = time.Millisecond * 500
 	defaultMaxJobsPerCycle               = 5
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
@@ -89,27 +91,40 @@ var (
 // Config is the overarching object that holds all the information for
 // prestart management of Portfolio, Communications, Webserver and Enabled Exchanges
 type Config struct {
-	Name                 string                    `json:"name"`
-	Version              int                       `json:"version"`
-	DataDirectory        string                    `json:"dataDirectory"`
-	EncryptConfig        int                       `json:"encryptConfig"`
-	GlobalHTTPTimeout    time.Duration             `json:"globalHTTPTimeout"`
-	Database             database.Config           `json:"database"`
-	Logging              log.Config                `json:"logging"`
-	SyncManagerConfig    SyncManagerConfig         `json:"syncManager"`
-	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
-	OrderManager         OrderManager              `json:"orderManager"`
-	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
-	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
-	Profiler             Profiler                  `json:"profiler"`
-	NTPClient            NTPClientConfig           `json:"ntpclient"`
-	GCTScript            gctscript.Config          `json:"gctscript"`
-	Currency             currency.Config           `json:"currencyConfig"`
-	Communications       base.CommunicationsConfig `json:"communications"`
-	RemoteControl        RemoteControlConfig       `json:"remoteControl"`
-	Portfolio            *portfolio.Base           `json:"portfolioAddresses"`
-	Exchanges            []Exchange                `json:"exchanges"`
-	BankAccounts         []banking.Account         `json:"bankAccounts"`
+	Name                      string                    `json:"name"`
+	Version                   int                       `json:"version"`
+	DataDirectory             string                    `json:"dataDirectory"`
+	EncryptConfig             int                       `json:"encryptConfig"`
+	GlobalHTTPTimeout         time.Duration             `json:"globalHTTPTimeout"`
+	Database                  database.Config           `json:"database"`
+	Logging                   log.Config                `json:"logging"`
+	SyncManagerConfig         SyncManagerConfig         `json:"syncManager"`
+	ConnectionMonitor         ConnectionMonitorConfig   `json:"connectionMonitor"`
+	OrderManager              OrderManager              `json:"orderManager"`
+	EventManager              EventManager              `json:"eventManager"`
+	DataHistoryManager        DataHistoryManager        `json:"dataHistoryManager"`
+	CurrencyStateManager      CurrencyStateManager      `json:"currencyStateManager"`
+	SyntheticOrderManager     SyntheticOrderManager     `json:"syntheticOrderManager"`
+	ExecutionAlgorithmManager ExecutionAlgorithmManager `json:"executionAlgorithmManager"`
+	TickRecorder              TickRecorder              `json:"tickRecorder"`
+	PaperTrading              PaperTrading              `json:"paperTrading"`
+	MetricsManager            MetricsManager            `json:"metricsManager"`
+	ArbitrageManager          ArbitrageManager          `json:"arbitrageManager"`
+	LedgerManager             LedgerManager             `json:"ledgerManager"`
+	ConfigReloader            ConfigReloader            `json:"configReloader"`
+	Profiler                  Profiler                  `json:"profiler"`
+	NTPClient                 NTPClientConfig           `json:"ntpclient"`
+	GCTScript                 gctscript.Config          `json:"gctscript"`
+	Currency                  currency.Config           `json:"currencyConfig"`
+	Communications            base.CommunicationsConfig `json:"communications"`
+	RemoteControl             RemoteControlConfig       `json:"remoteControl"`
+	Portfolio                 *portfolio.Base           `json:"portfolioAddresses"`
+	PortfolioSnapshots        PortfolioSnapshots        `json:"portfolioSnapshots"`
+	WithdrawalPolicy          WithdrawalPolicy          `json:"withdrawalPolicy"`
+	TransferManager           TransferManager           `json:"transferManager"`
+	FundingRateScanner        FundingRateScanner        `json:"fundingRateScanner"`
+	Exchanges                 []Exchange                `json:"exchanges"`
+	BankAccounts              []banking.Account         `json:"bankAccounts"`
 
 	// Deprecated config settings, will be removed at a future date
 	Webserver           *WebserverConfig      `json:"webserver,omitempty"`
@@ -134,6 +149,234 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     bool          `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	PersistOrders                 bool          `json:"persistOrders"`
+	Risk                          OrderRisk     `json:"risk"`
+}
+
+// OrderRisk holds the pre-trade risk rules the order manager enforces before
+// an order is submitted to an exchange
+type OrderRisk struct {
+	Enabled bool `json:"enabled"`
+	// Limits apply to every order unless overridden by a matching rule
+	Limits RiskLimits `json:"limits"`
+	// Rules override the default limits for an exchange, or for an
+	// exchange asset and pair when those are set. Non-zero limits in the
+	// most specific matching rule take precedence
+	Rules []RiskRule `json:"rules,omitempty"`
+}
+
+// RiskRule scopes a set of risk limits to an exchange and optionally an asset
+// and pair
+type RiskRule struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset,omitempty"`
+	Pair     currency.Pair `json:"pair"`
+	RiskLimits
+}
+
+// RiskLimits defines the pre-trade risk limits applied to an order. A zero
+// value disables the limit
+type RiskLimits struct {
+	// MaxOrderNotional is the maximum quote value of a single order
+	MaxOrderNotional float64 `json:"maxOrderNotional,omitempty"`
+	// MaxPairNotional is the maximum quote value of all open orders for an
+	// exchange asset pair including the new order
+	MaxPairNotional float64 `json:"maxPairNotional,omitempty"`
+	// MaxOpenOrders is the maximum number of open orders on an exchange
+	MaxOpenOrders int64 `json:"maxOpenOrders,omitempty"`
+	// MaxPosition is the maximum absolute futures position size after the
+	// order is filled
+	MaxPosition float64 `json:"maxPosition,omitempty"`
+	// MaxDailyLoss is the maximum realised futures loss on an exchange since
+	// the start of the UTC day. Reduce only orders are still accepted
+	MaxDailyLoss float64 `json:"maxDailyLoss,omitempty"`
+	// PriceBandPercent is the maximum percentage a limit price can deviate
+	// from the last cached ticker price
+	PriceBandPercent float64 `json:"priceBandPercent,omitempty"`
+	// MaxOrdersPerInterval is the maximum number of orders submitted to an
+	// exchange within OrderRateInterval
+	MaxOrdersPerInterval int64         `json:"maxOrdersPerInterval,omitempty"`
+	OrderRateInterval    time.Duration `json:"orderRateInterval,omitempty"`
+}
+
+// SyntheticOrderManager holds settings used for the synthetic order manager
+// which emulates OCO, bracket and trailing stop orders
+type SyntheticOrderManager struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// ExecutionAlgorithmManager holds settings used for the execution algorithm
+// manager which works parent orders with TWAP, VWAP, iceberg and POV
+// algorithms
+type ExecutionAlgorithmManager struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// TickRecorder holds settings used for the tick recorder which writes
+// websocket orderbook and trade updates to disk for replay in the backtester
+type TickRecorder struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// Directory defaults to the tickdata folder in the data directory
+	Directory        string        `json:"directory"`
+	SnapshotInterval int           `json:"snapshotInterval"`
+	MaxDepth         int           `json:"maxDepth"`
+	FlushInterval    time.Duration `json:"flushInterval"`
+}
+
+// MetricsManager holds settings used for the metrics manager which serves
+// engine, request and websocket metrics in the Prometheus text format
+type MetricsManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// ListenAddress defaults to localhost:9091
+	ListenAddress string `json:"listenAddress"`
+}
+
+// ArbitrageManager holds settings used for the arbitrage manager which scans
+// spot orderbooks for cross-exchange and triangular arbitrage opportunities
+type ArbitrageManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// MinimumNetEdge is the minimum percentage return after taker fees for an
+	// opportunity to be published
+	MinimumNetEdge float64 `json:"minimumNetEdge"`
+	// MaximumNotional caps the amount of each starting currency sized into an
+	// opportunity, keyed by currency code. Opportunities starting in a
+	// currency without a cap are sized by orderbook liquidity alone
+	MaximumNotional      map[string]float64 `json:"maximumNotional,omitempty"`
+	DisableCrossExchange bool               `json:"disableCrossExchange"`
+	DisableTriangular    bool               `json:"disableTriangular"`
+	// RequireTransfers skips cross-exchange opportunities whose base currency
+	// cannot be withdrawn from the buying exchange and deposited onto the
+	// selling exchange
+	RequireTransfers bool `json:"requireTransfers"`
+	// Cooldown is the minimum time between publishing the same opportunity
+	Cooldown      time.Duration `json:"cooldown"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// Script is an optional gctscript run for each published opportunity
+	Script string `json:"script,omitempty"`
+	// AutoExecute submits the legs of each published opportunity as market
+	// orders through the order manager. Only opportunities starting in a
+	// currency with a MaximumNotional cap are executed
+	AutoExecute bool `json:"autoExecute"`
+}
+
+// PortfolioSnapshots holds settings used by the portfolio manager to store
+// periodic snapshots of exchange and watched address holdings in the database
+type PortfolioSnapshots struct {
+	Enabled bool `json:"enabled"`
+	// FiatCurrency is the currency holdings are valued in. Defaults to USD
+	FiatCurrency string `json:"fiatCurrency"`
+	// Interval is the minimum time between snapshots. Defaults to one hour
+	Interval time.Duration `json:"interval"`
+}
+
+// WithdrawalPolicy holds the rules the withdraw manager enforces before a
+// withdrawal request is submitted to an exchange
+type WithdrawalPolicy struct {
+	Enabled bool `json:"enabled"`
+	// TOTPSecret is the secret withdrawal requests and approvals are confirmed
+	// with a TOTP code from, which cmd/gen_otp can generate
+	TOTPSecret string `json:"totpSecret,omitempty"`
+	// AddressCoolingOffPeriod is how long an address must have been
+	// whitelisted before cryptocurrency can be withdrawn to it
+	AddressCoolingOffPeriod time.Duration `json:"addressCoolingOffPeriod"`
+	// ApprovalExpiry is how long a withdrawal awaits approval before it is
+	// rejected. Defaults to one day
+	ApprovalExpiry time.Duration `json:"approvalExpiry"`
+	// Limits cap withdrawals per currency. Currencies without limits are
+	// not capped
+	Limits []WithdrawalLimit `json:"limits,omitempty"`
+}
+
+// WithdrawalLimit defines the withdrawal caps for a currency. A zero value
+// disables the cap
+type WithdrawalLimit struct {
+	Currency currency.Code `json:"currency"`
+	// DailyLimit is the maximum amount withdrawn over the last day
+	DailyLimit float64 `json:"dailyLimit,omitempty"`
+	// WeeklyLimit is the maximum amount withdrawn over the last week
+	WeeklyLimit float64 `json:"weeklyLimit,omitempty"`
+	// ApprovalThreshold is the amount above which a withdrawal must be
+	// approved by a second gRPC principal before it is submitted
+	ApprovalThreshold float64 `json:"approvalThreshold,omitempty"`
+}
+
+// LedgerManager holds settings used for the ledger manager which tracks the
+// cost basis and PnL of spot holdings
+type LedgerManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// Method is the lot matching method, one of FIFO, LIFO or AVERAGE_COST.
+	// Defaults to FIFO
+	Method string `json:"method"`
+	// FiatCurrency is the currency PnL is reported in. Defaults to USD
+	FiatCurrency  string        `json:"fiatCurrency"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// PersistEntries writes ledger entries to the database and replays them
+	// on startup
+	PersistEntries bool `json:"persistEntries"`
+}
+
+// TransferManager holds settings used for the transfer manager which tracks
+// the lifecycle of withdrawals and deposits
+type TransferManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// CheckInterval is how often exchanges are polled for transfer updates
+	CheckInterval time.Duration `json:"checkInterval"`
+	// TrackingPeriod is how long a submitted withdrawal is tracked before it
+	// is abandoned. Defaults to one week
+	TrackingPeriod time.Duration `json:"trackingPeriod"`
+}
+
+// FundingRateScanner holds settings used for the funding rate scanner which
+// collects perpetual funding rates across exchanges and ranks carry
+// opportunities
+type FundingRateScanner struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// CheckInterval is how often funding rates are collected. Defaults to five
+	// minutes
+	CheckInterval time.Duration `json:"checkInterval"`
+	// MinimumNetRate is the minimum annualised percentage carry after fees for
+	// an opportunity to be ranked
+	MinimumNetRate float64 `json:"minimumNetRate"`
+	// HoldingPeriod is the period the fees of opening and closing both legs
+	// are amortised over when annualising them. Defaults to 30 days
+	HoldingPeriod        time.Duration `json:"holdingPeriod"`
+	DisableCrossExchange bool          `json:"disableCrossExchange"`
+	DisableSpotPerpetual bool          `json:"disableSpotPerpetual"`
+}
+
+// ConfigReloader holds settings used for the config reloader which applies
+// changes made to the config file while the engine is running
+type ConfigReloader struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// CheckInterval is how often the config file is checked for changes
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// PaperTrading holds settings used to simulate order and account management
+// against live market data instead of trading on the exchange
+type PaperTrading struct {
+	Enabled  bool                  `json:"enabled"`
+	Balances []PaperTradingBalance `json:"balances,omitempty"`
+}
+
+// PaperTradingBalance defines a starting balance for a simulated exchange
+// account
+type PaperTradingBalance struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -152,6 +395,13 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// EventManager defines the event manager settings
+type EventManager struct {
+	Enabled *bool `json:"enabled"`
+	// Delay is how often the conditions of armed events are checked
+	Delay time.Duration `json:"delay"`
+}
+
 // SyncManagerConfig stores the currency pair synchronization manager config
 type SyncManagerConfig struct {
 	Enabled                 bool                 `json:"enabled"`
@@ -259,12 +509,38 @@ type WebsocketRPCConfig struct {
 type RemoteControlConfig struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	// Principals are additional gRPC users whose access is limited to their
+	// scopes. The username and password above have full access
+	Principals []RPCPrincipal `json:"principals,omitempty"`
+	// Tokens are gRPC bearer tokens, which are created and revoked via gRPC
+	Tokens []RPCToken `json:"tokens,omitempty"`
 
 	GRPC          GRPCConfig           `json:"gRPC"`
 	DeprecatedRPC DepcrecatedRPCConfig `json:"deprecatedRPC"`
 	WebsocketRPC  WebsocketRPCConfig   `json:"websocketRPC"`
 }
 
+// RPCPrincipal defines a named gRPC user authenticated by basic auth
+type RPCPrincipal struct {
+	Username string `json:"username"`
+	// PasswordHash is a bcrypt hash of the user's password
+	PasswordHash string   `json:"passwordHash"`
+	Scopes       []string `json:"scopes"`
+}
+
+// RPCToken defines a gRPC bearer token. Only a hash of the token is stored
+type RPCToken struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"createdAt"`
+	Expires   time.Time `json:"expires"`
+	// CreatedBy is the user who created the token, which the token acts on
+	// behalf of
+	CreatedBy string `json:"createdBy,omitempty"`
+}
+
 // WebserverConfig stores the old webserver config
 type WebserverConfig struct {
 	Enabled                      bool   `json:"enabled"`
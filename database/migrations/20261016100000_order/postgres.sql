@@ -0,0 +1,41 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "order"
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    date TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueexchangeorderid
+        unique(exchange_name_id, order_id)
+);
+
+CREATE TABLE IF NOT EXISTS order_event
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    order_id uuid NOT NULL REFERENCES "order"(id) ON DELETE CASCADE,
+    event varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose Down
+DROP TABLE order_event;
+DROP TABLE "order";
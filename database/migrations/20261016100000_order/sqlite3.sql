@@ -0,0 +1,43 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "order"
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    side text NOT NULL,
+    type text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    average_executed_price real NOT NULL,
+    fee real NOT NULL,
+    cost real NOT NULL,
+    date timestamp NOT NULL,
+    last_updated timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(exchange_name_id, order_id)
+);
+
+CREATE TABLE IF NOT EXISTS order_event
+(
+    id integer NOT NULL primary key,
+    order_id text NOT NULL,
+    event text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    average_executed_price real NOT NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    FOREIGN KEY(order_id) REFERENCES "order"(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE order_event;
+DROP TABLE "order";
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_rule
+(
+    id bigint PRIMARY KEY NOT NULL,
+    exchange varchar NOT NULL,
+    definition text NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose Down
+DROP TABLE event_rule;
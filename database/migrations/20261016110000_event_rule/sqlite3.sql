@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_rule
+(
+    id integer NOT NULL primary key,
+    exchange text NOT NULL,
+    definition text NOT NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL default CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE event_rule;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    rate REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquefundingrate
+        unique(exchange_name_id, base, quote, asset, timestamp) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE funding_rate;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ledger_entry
+(
+    id varchar PRIMARY KEY NOT NULL,
+    exchange varchar NOT NULL,
+    type varchar NOT NULL,
+    base varchar NOT NULL,
+    quote varchar NOT NULL,
+    side varchar NOT NULL,
+    order_id varchar NOT NULL,
+    trade_id varchar NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_currency varchar NOT NULL,
+    fiat varchar NOT NULL,
+    fiat_rate DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX ledger_entry_timestamp_idx ON ledger_entry(timestamp);
+-- +goose Down
+DROP TABLE ledger_entry;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ledger_entry
+(
+    id text NOT NULL primary key,
+    exchange text NOT NULL,
+    type text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    side text NOT NULL,
+    order_id text NOT NULL,
+    trade_id text NOT NULL,
+    amount real NOT NULL,
+    price real NOT NULL,
+    fee real NOT NULL,
+    fee_currency text NOT NULL,
+    fiat text NOT NULL,
+    fiat_rate real NOT NULL,
+    timestamp timestamp NOT NULL
+);
+CREATE INDEX ledger_entry_timestamp_idx ON ledger_entry(timestamp);
+
+-- +goose Down
+DROP TABLE ledger_entry;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    fiat varchar NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX portfolio_snapshot_timestamp_idx ON portfolio_snapshot(timestamp);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshot_balance
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    portfolio_snapshot_id uuid NOT NULL REFERENCES portfolio_snapshot(id) ON DELETE CASCADE,
+    exchange varchar NOT NULL,
+    address varchar NOT NULL,
+    currency varchar NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    value DOUBLE PRECISION NOT NULL
+);
+-- +goose Down
+DROP TABLE portfolio_snapshot_balance;
+DROP TABLE portfolio_snapshot;
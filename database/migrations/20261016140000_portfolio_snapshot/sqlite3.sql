@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_snapshot
+(
+    id text NOT NULL primary key,
+    fiat text NOT NULL,
+    value real NOT NULL,
+    timestamp timestamp NOT NULL
+);
+CREATE INDEX portfolio_snapshot_timestamp_idx ON portfolio_snapshot(timestamp);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshot_balance
+(
+    id integer NOT NULL primary key,
+    portfolio_snapshot_id text NOT NULL,
+    exchange text NOT NULL,
+    address text NOT NULL,
+    currency text NOT NULL,
+    amount real NOT NULL,
+    price real NOT NULL,
+    value real NOT NULL,
+    FOREIGN KEY(portfolio_snapshot_id) REFERENCES portfolio_snapshot(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE portfolio_snapshot_balance;
+DROP TABLE portfolio_snapshot;
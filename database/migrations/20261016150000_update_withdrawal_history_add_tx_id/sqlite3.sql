@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE withdrawal_history ADD COLUMN tx_id text NOT NULL DEFAULT '';
+ALTER TABLE withdrawal_history ADD COLUMN confirmations integer NOT NULL DEFAULT 0;
+-- +goose Down
+ALTER TABLE withdrawal_history DROP COLUMN confirmations;
+ALTER TABLE withdrawal_history DROP COLUMN tx_id;
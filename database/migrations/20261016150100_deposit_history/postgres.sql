@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS deposit_history
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    exchange_id text NOT NULL,
+    status varchar(255) NOT NULL,
+    currency varchar(30) NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    address text NOT NULL,
+    tx_id text NOT NULL,
+    chain varchar(255) NOT NULL,
+    confirmations bigint NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquedeposit
+        unique(exchange_name_id, exchange_id)
+);
+-- +goose Down
+DROP TABLE deposit_history;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS deposit_history
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    exchange_id text NOT NULL,
+    status text NOT NULL,
+    currency text NOT NULL,
+    amount REAL NOT NULL,
+    fee REAL NOT NULL,
+    address text NOT NULL,
+    tx_id text NOT NULL,
+    chain text NOT NULL,
+    confirmations integer NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT uniquedeposit
+        unique(exchange_name_id, exchange_id) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE deposit_history;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_opportunity
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    type varchar(30) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    long_exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    long_asset varchar NOT NULL,
+    long_rate DOUBLE PRECISION NOT NULL,
+    short_exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    short_asset varchar NOT NULL,
+    short_rate DOUBLE PRECISION NOT NULL,
+    gross_rate DOUBLE PRECISION NOT NULL,
+    fee_rate DOUBLE PRECISION NOT NULL,
+    net_rate DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquefundingopportunity
+        unique(type, base, quote, long_exchange_name_id, long_asset, short_exchange_name_id, short_asset, timestamp)
+);
+-- +goose Down
+DROP TABLE funding_opportunity;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_opportunity
+(
+    id text not null primary key,
+    type text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    long_exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    long_asset TEXT NOT NULL,
+    long_rate REAL NOT NULL,
+    short_exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    short_asset TEXT NOT NULL,
+    short_rate REAL NOT NULL,
+    gross_rate REAL NOT NULL,
+    fee_rate REAL NOT NULL,
+    net_rate REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquefundingopportunity
+        unique(type, base, quote, long_exchange_name_id, long_asset, short_exchange_name_id, short_asset, timestamp) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE funding_opportunity;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE datahistoryjob
+    ADD orderbook_depth integer;
+
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP orderbook_depth;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    side TEXT NOT NULL,
+    level INTEGER NOT NULL,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniqueorderbooksnapshot
+        unique(exchange_name_id, base, quote, asset, side, level, timestamp) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE orderbook_snapshot;
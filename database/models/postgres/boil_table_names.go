@@ -4,29 +4,51 @@
 package postgres
 
 var TableNames = struct {
-	AuditEvent              string
-	Candle                  string
-	Datahistoryjob          string
-	Datahistoryjobrelations string
-	Datahistoryjobresult    string
-	Exchange                string
-	Script                  string
-	ScriptExecution         string
-	Trade                   string
-	WithdrawalCrypto        string
-	WithdrawalFiat          string
-	WithdrawalHistory       string
+	AuditEvent               string
+	Candle                   string
+	Datahistoryjob           string
+	Datahistoryjobrelations  string
+	Datahistoryjobresult     string
+	DepositHistory           string
+	EventRule                string
+	Exchange                 string
+	FundingOpportunity       string
+	FundingRate              string
+	LedgerEntry              string
+	OpenInterest             string
+	Order                    string
+	OrderEvent               string
+	OrderbookSnapshot        string
+	PortfolioSnapshot        string
+	PortfolioSnapshotBalance string
+	Script                   string
+	ScriptExecution          string
+	Trade                    string
+	WithdrawalCrypto         string
+	WithdrawalFiat           string
+	WithdrawalHistory        string
 }{
-	AuditEvent:              "audit_event",
-	Candle:                  "candle",
-	Datahistoryjob:          "datahistoryjob",
-	Datahistoryjobrelations: "datahistoryjobrelations",
-	Datahistoryjobresult:    "datahistoryjobresult",
-	Exchange:                "exchange",
-	Script:                  "script",
-	ScriptExecution:         "script_execution",
-	Trade:                   "trade",
-	WithdrawalCrypto:        "withdrawal_crypto",
-	WithdrawalFiat:          "withdrawal_fiat",
-	WithdrawalHistory:       "withdrawal_history",
+	AuditEvent:               "audit_event",
+	Candle:                   "candle",
+	Datahistoryjob:           "datahistoryjob",
+	Datahistoryjobrelations:  "datahistoryjobrelations",
+	Datahistoryjobresult:     "datahistoryjobresult",
+	DepositHistory:           "deposit_history",
+	EventRule:                "event_rule",
+	Exchange:                 "exchange",
+	FundingOpportunity:       "funding_opportunity",
+	FundingRate:              "funding_rate",
+	LedgerEntry:              "ledger_entry",
+	OpenInterest:             "open_interest",
+	Order:                    "order",
+	OrderEvent:               "order_event",
+	OrderbookSnapshot:        "orderbook_snapshot",
+	PortfolioSnapshot:        "portfolio_snapshot",
+	PortfolioSnapshotBalance: "portfolio_snapshot_balance",
+	Script:                   "script",
+	ScriptExecution:          "script_execution",
+	Trade:                    "trade",
+	WithdrawalCrypto:         "withdrawal_crypto",
+	WithdrawalFiat:           "withdrawal_fiat",
+	WithdrawalHistory:        "withdrawal_history",
 }
@@ -50,26 +50,47 @@ var ExchangeWhere = struct {
 
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
-	ExchangeNameCandles              string
-	ExchangeNameDatahistoryjobs      string
-	SecondaryExchangeDatahistoryjobs string
-	ExchangeNameTrades               string
-	ExchangeNameWithdrawalHistories  string
+	ExchangeNameCandles                   string
+	ExchangeNameDatahistoryjobs           string
+	SecondaryExchangeDatahistoryjobs      string
+	ExchangeNameDepositHistories          string
+	LongExchangeNameFundingOpportunities  string
+	ShortExchangeNameFundingOpportunities string
+	ExchangeNameFundingRates              string
+	ExchangeNameOpenInterests             string
+	ExchangeNameOrders                    string
+	ExchangeNameOrderbookSnapshots        string
+	ExchangeNameTrades                    string
+	ExchangeNameWithdrawalHistories       string
 }{
-	ExchangeNameCandles:              "ExchangeNameCandles",
-	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
-	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
-	ExchangeNameTrades:               "ExchangeNameTrades",
-	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
+	ExchangeNameCandles:                   "ExchangeNameCandles",
+	ExchangeNameDatahistoryjobs:           "ExchangeNameDatahistoryjobs",
+	SecondaryExchangeDatahistoryjobs:      "SecondaryExchangeDatahistoryjobs",
+	ExchangeNameDepositHistories:          "ExchangeNameDepositHistories",
+	LongExchangeNameFundingOpportunities:  "LongExchangeNameFundingOpportunities",
+	ShortExchangeNameFundingOpportunities: "ShortExchangeNameFundingOpportunities",
+	ExchangeNameFundingRates:              "ExchangeNameFundingRates",
+	ExchangeNameOpenInterests:             "ExchangeNameOpenInterests",
+	ExchangeNameOrders:                    "ExchangeNameOrders",
+	ExchangeNameOrderbookSnapshots:        "ExchangeNameOrderbookSnapshots",
+	ExchangeNameTrades:                    "ExchangeNameTrades",
+	ExchangeNameWithdrawalHistories:       "ExchangeNameWithdrawalHistories",
 }
 
 // exchangeR is where relationships are stored.
 type exchangeR struct {
-	ExchangeNameCandles              CandleSlice
-	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
-	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
-	ExchangeNameTrades               TradeSlice
-	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
+	ExchangeNameCandles                   CandleSlice
+	ExchangeNameDatahistoryjobs           DatahistoryjobSlice
+	SecondaryExchangeDatahistoryjobs      DatahistoryjobSlice
+	ExchangeNameDepositHistories          DepositHistorySlice
+	LongExchangeNameFundingOpportunities  FundingOpportunitySlice
+	ShortExchangeNameFundingOpportunities FundingOpportunitySlice
+	ExchangeNameFundingRates              FundingRateSlice
+	ExchangeNameOpenInterests             OpenInterestSlice
+	ExchangeNameOrders                    OrderSlice
+	ExchangeNameOrderbookSnapshots        OrderbookSnapshotSlice
+	ExchangeNameTrades                    TradeSlice
+	ExchangeNameWithdrawalHistories       WithdrawalHistorySlice
 }
 
 // NewStruct creates a new relationship struct
@@ -425,6 +446,153 @@ func (o *Exchange) SecondaryExchangeDatahistoryjobs(mods ...qm.QueryMod) datahis
 	return query
 }
 
+// ExchangeNameDepositHistories retrieves all the deposit_history's DepositHistories with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameDepositHistories(mods ...qm.QueryMod) depositHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deposit_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := DepositHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"deposit_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"deposit_history\".*"})
+	}
+
+	return query
+}
+
+// LongExchangeNameFundingOpportunities retrieves all the funding_opportunity's FundingOpportunities with an executor via long_exchange_name_id column.
+func (o *Exchange) LongExchangeNameFundingOpportunities(mods ...qm.QueryMod) fundingOpportunityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_opportunity\".\"long_exchange_name_id\"=?", o.ID),
+	)
+
+	query := FundingOpportunities(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_opportunity\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_opportunity\".*"})
+	}
+
+	return query
+}
+
+// ShortExchangeNameFundingOpportunities retrieves all the funding_opportunity's FundingOpportunities with an executor via short_exchange_name_id column.
+func (o *Exchange) ShortExchangeNameFundingOpportunities(mods ...qm.QueryMod) fundingOpportunityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_opportunity\".\"short_exchange_name_id\"=?", o.ID),
+	)
+
+	query := FundingOpportunities(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_opportunity\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_opportunity\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameFundingRates retrieves all the funding_rate's FundingRates with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameFundingRates(mods ...qm.QueryMod) fundingRateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_rate\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_rate\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameOpenInterests retrieves all the open_interest's OpenInterests with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameOpenInterests(mods ...qm.QueryMod) openInterestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"open_interest\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := OpenInterests(queryMods...)
+	queries.SetFrom(query.Query, "\"open_interest\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"open_interest\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameOrders retrieves all the order's Orders with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameOrders(mods ...qm.QueryMod) orderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"order\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := Orders(queryMods...)
+	queries.SetFrom(query.Query, "\"order\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"order\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameOrderbookSnapshots retrieves all the orderbook_snapshot's OrderbookSnapshots with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameOrderbookSnapshots(mods ...qm.QueryMod) orderbookSnapshotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"orderbook_snapshot\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := OrderbookSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"orderbook_snapshot\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"orderbook_snapshot\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameTrades retrieves all the trade's Trades with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	var queryMods []qm.QueryMod
@@ -752,9 +920,9 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 	return nil
 }
 
-// LoadExchangeNameTrades allows an eager lookup of values, cached into the
+// LoadExchangeNameDepositHistories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameDepositHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -791,29 +959,29 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 		return nil
 	}
 
-	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`deposit_history`), qm.WhereIn(`deposit_history.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load trade")
+		return errors.Wrap(err, "failed to eager load deposit_history")
 	}
 
-	var resultSlice []*Trade
+	var resultSlice []*DepositHistory
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice trade")
+		return errors.Wrap(err, "failed to bind eager loaded slice deposit_history")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on trade")
+		return errors.Wrap(err, "failed to close results in eager load on deposit_history")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposit_history")
 	}
 
-	if len(tradeAfterSelectHooks) != 0 {
+	if len(depositHistoryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -821,10 +989,10 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.ExchangeNameTrades = resultSlice
+		object.R.ExchangeNameDepositHistories = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tradeR{}
+				foreign.R = &depositHistoryR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -834,9 +1002,9 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameTrades = append(local.R.ExchangeNameTrades, foreign)
+				local.R.ExchangeNameDepositHistories = append(local.R.ExchangeNameDepositHistories, foreign)
 				if foreign.R == nil {
-					foreign.R = &tradeR{}
+					foreign.R = &depositHistoryR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -847,9 +1015,9 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// LoadLongExchangeNameFundingOpportunities allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadLongExchangeNameFundingOpportunities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -886,29 +1054,29 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_opportunity`), qm.WhereIn(`funding_opportunity.long_exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load withdrawal_history")
+		return errors.Wrap(err, "failed to eager load funding_opportunity")
 	}
 
-	var resultSlice []*WithdrawalHistory
+	var resultSlice []*FundingOpportunity
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_opportunity")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+		return errors.Wrap(err, "failed to close results in eager load on funding_opportunity")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_opportunity")
 	}
 
-	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+	if len(fundingOpportunityAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -916,24 +1084,24 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		}
 	}
 	if singular {
-		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		object.R.LongExchangeNameFundingOpportunities = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &withdrawalHistoryR{}
+				foreign.R = &fundingOpportunityR{}
 			}
-			foreign.R.ExchangeName = object
+			foreign.R.LongExchangeName = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+			if local.ID == foreign.LongExchangeNameID {
+				local.R.LongExchangeNameFundingOpportunities = append(local.R.LongExchangeNameFundingOpportunities, foreign)
 				if foreign.R == nil {
-					foreign.R = &withdrawalHistoryR{}
+					foreign.R = &fundingOpportunityR{}
 				}
-				foreign.R.ExchangeName = local
+				foreign.R.LongExchangeName = local
 				break
 			}
 		}
@@ -942,129 +1110,970 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	return nil
 }
 
-// AddExchangeNameCandles adds the given related objects to the existing relationships
-// of the exchange, optionally inserting them as new records.
-// Appends related to o.R.ExchangeNameCandles.
-// Sets related.R.ExchangeName appropriately.
-func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ExchangeNameID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"candle\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
-				strmangle.WhereClause("\"", "\"", 2, candlePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
-			}
-
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
-			}
+// LoadShortExchangeNameFundingOpportunities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadShortExchangeNameFundingOpportunities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
 
-			rel.ExchangeNameID = o.ID
-		}
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameCandles: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.ExchangeNameCandles = append(o.R.ExchangeNameCandles, related...)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &candleR{
-				ExchangeName: o,
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
-		} else {
-			rel.R.ExchangeName = o
+
+			args = append(args, obj.ID)
 		}
 	}
-	return nil
-}
 
-// AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
-// of the exchange, optionally inserting them as new records.
-// Appends related to o.R.ExchangeNameDatahistoryjobs.
-// Sets related.R.ExchangeName appropriately.
-func (o *Exchange) AddExchangeNameDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ExchangeNameID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"datahistoryjob\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
-				strmangle.WhereClause("\"", "\"", 2, datahistoryjobPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+	if len(args) == 0 {
+		return nil
+	}
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
-			}
+	query := NewQuery(qm.From(`funding_opportunity`), qm.WhereIn(`funding_opportunity.short_exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
-			}
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load funding_opportunity")
+	}
 
-			rel.ExchangeNameID = o.ID
-		}
+	var resultSlice []*FundingOpportunity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_opportunity")
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameDatahistoryjobs: related,
-		}
-	} else {
-		o.R.ExchangeNameDatahistoryjobs = append(o.R.ExchangeNameDatahistoryjobs, related...)
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on funding_opportunity")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_opportunity")
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &datahistoryjobR{
-				ExchangeName: o,
+	if len(fundingOpportunityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
 			}
-		} else {
-			rel.R.ExchangeName = o
+		}
+	}
+	if singular {
+		object.R.ShortExchangeNameFundingOpportunities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fundingOpportunityR{}
+			}
+			foreign.R.ShortExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ShortExchangeNameID {
+				local.R.ShortExchangeNameFundingOpportunities = append(local.R.ShortExchangeNameFundingOpportunities, foreign)
+				if foreign.R == nil {
+					foreign.R = &fundingOpportunityR{}
+				}
+				foreign.R.ShortExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameFundingRates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameFundingRates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load funding_rate")
+	}
+
+	var resultSlice []*FundingRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_rate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on funding_rate")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
+	}
+
+	if len(fundingRateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameFundingRates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fundingRateR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameFundingRates = append(local.R.ExchangeNameFundingRates, foreign)
+				if foreign.R == nil {
+					foreign.R = &fundingRateR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOpenInterests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameOpenInterests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`open_interest`), qm.WhereIn(`open_interest.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load open_interest")
+	}
+
+	var resultSlice []*OpenInterest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice open_interest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on open_interest")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for open_interest")
+	}
+
+	if len(openInterestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameOpenInterests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &openInterestR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOpenInterests = append(local.R.ExchangeNameOpenInterests, foreign)
+				if foreign.R == nil {
+					foreign.R = &openInterestR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOrders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameOrders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`order`), qm.WhereIn(`order.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load order")
+	}
+
+	var resultSlice []*Order
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice order")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on order")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order")
+	}
+
+	if len(orderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameOrders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOrders = append(local.R.ExchangeNameOrders, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOrderbookSnapshots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameOrderbookSnapshots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`orderbook_snapshot`), qm.WhereIn(`orderbook_snapshot.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load orderbook_snapshot")
+	}
+
+	var resultSlice []*OrderbookSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice orderbook_snapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on orderbook_snapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderbook_snapshot")
+	}
+
+	if len(orderbookSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameOrderbookSnapshots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &orderbookSnapshotR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOrderbookSnapshots = append(local.R.ExchangeNameOrderbookSnapshots, foreign)
+				if foreign.R == nil {
+					foreign.R = &orderbookSnapshotR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTrades allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load trade")
+	}
+
+	var resultSlice []*Trade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice trade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on trade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+	}
+
+	if len(tradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameTrades = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tradeR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTrades = append(local.R.ExchangeNameTrades, foreign)
+				if foreign.R == nil {
+					foreign.R = &tradeR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_history")
+	}
+
+	var resultSlice []*WithdrawalHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+	}
+
+	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddExchangeNameCandles adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameCandles.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, candlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameCandles: related,
+		}
+	} else {
+		o.R.ExchangeNameCandles = append(o.R.ExchangeNameCandles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &candleR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDatahistoryjobs.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"datahistoryjob\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, datahistoryjobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDatahistoryjobs: related,
+		}
+	} else {
+		o.R.ExchangeNameDatahistoryjobs = append(o.R.ExchangeNameDatahistoryjobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &datahistoryjobR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddSecondaryExchangeDatahistoryjobs adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.SecondaryExchangeDatahistoryjobs.
+// Sets related.R.SecondaryExchange appropriately.
+func (o *Exchange) AddSecondaryExchangeDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SecondaryExchangeID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"datahistoryjob\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"secondary_exchange_id"}),
+				strmangle.WhereClause("\"", "\"", 2, datahistoryjobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SecondaryExchangeID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			SecondaryExchangeDatahistoryjobs: related,
+		}
+	} else {
+		o.R.SecondaryExchangeDatahistoryjobs = append(o.R.SecondaryExchangeDatahistoryjobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &datahistoryjobR{
+				SecondaryExchange: o,
+			}
+		} else {
+			rel.R.SecondaryExchange = o
+		}
+	}
+	return nil
+}
+
+// SetSecondaryExchangeDatahistoryjobs removes all previously related items of the
+// exchange replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SecondaryExchange's SecondaryExchangeDatahistoryjobs accordingly.
+// Replaces o.R.SecondaryExchangeDatahistoryjobs with related.
+// Sets related.R.SecondaryExchange's SecondaryExchangeDatahistoryjobs accordingly.
+func (o *Exchange) SetSecondaryExchangeDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	query := "update \"datahistoryjob\" set \"secondary_exchange_id\" = null where \"secondary_exchange_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SecondaryExchangeDatahistoryjobs {
+			queries.SetScanner(&rel.SecondaryExchangeID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SecondaryExchange = nil
+		}
+
+		o.R.SecondaryExchangeDatahistoryjobs = nil
+	}
+	return o.AddSecondaryExchangeDatahistoryjobs(ctx, exec, insert, related...)
+}
+
+// RemoveSecondaryExchangeDatahistoryjobs relationships from objects passed in.
+// Removes related items from R.SecondaryExchangeDatahistoryjobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.SecondaryExchange.
+func (o *Exchange) RemoveSecondaryExchangeDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, related ...*Datahistoryjob) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SecondaryExchangeID, nil)
+		if rel.R != nil {
+			rel.R.SecondaryExchange = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("secondary_exchange_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SecondaryExchangeDatahistoryjobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SecondaryExchangeDatahistoryjobs)
+			if ln > 1 && i < ln-1 {
+				o.R.SecondaryExchangeDatahistoryjobs[i] = o.R.SecondaryExchangeDatahistoryjobs[ln-1]
+			}
+			o.R.SecondaryExchangeDatahistoryjobs = o.R.SecondaryExchangeDatahistoryjobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddExchangeNameDepositHistories adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDepositHistories.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameDepositHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DepositHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deposit_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, depositHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDepositHistories: related,
+		}
+	} else {
+		o.R.ExchangeNameDepositHistories = append(o.R.ExchangeNameDepositHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &depositHistoryR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
 		}
 	}
 	return nil
 }
 
-// AddSecondaryExchangeDatahistoryjobs adds the given related objects to the existing relationships
+// AddLongExchangeNameFundingOpportunities adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
-// Appends related to o.R.SecondaryExchangeDatahistoryjobs.
-// Sets related.R.SecondaryExchange appropriately.
-func (o *Exchange) AddSecondaryExchangeDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+// Appends related to o.R.LongExchangeNameFundingOpportunities.
+// Sets related.R.LongExchangeName appropriately.
+func (o *Exchange) AddLongExchangeNameFundingOpportunities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingOpportunity) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.SecondaryExchangeID, o.ID)
+			rel.LongExchangeNameID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"datahistoryjob\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"secondary_exchange_id"}),
-				strmangle.WhereClause("\"", "\"", 2, datahistoryjobPrimaryKeyColumns),
+				"UPDATE \"funding_opportunity\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"long_exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingOpportunityPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1077,97 +2086,292 @@ func (o *Exchange) AddSecondaryExchangeDatahistoryjobs(ctx context.Context, exec
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.SecondaryExchangeID, o.ID)
+			rel.LongExchangeNameID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &exchangeR{
-			SecondaryExchangeDatahistoryjobs: related,
+			LongExchangeNameFundingOpportunities: related,
 		}
 	} else {
-		o.R.SecondaryExchangeDatahistoryjobs = append(o.R.SecondaryExchangeDatahistoryjobs, related...)
+		o.R.LongExchangeNameFundingOpportunities = append(o.R.LongExchangeNameFundingOpportunities, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &datahistoryjobR{
-				SecondaryExchange: o,
+			rel.R = &fundingOpportunityR{
+				LongExchangeName: o,
 			}
 		} else {
-			rel.R.SecondaryExchange = o
+			rel.R.LongExchangeName = o
 		}
 	}
 	return nil
 }
 
-// SetSecondaryExchangeDatahistoryjobs removes all previously related items of the
-// exchange replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.SecondaryExchange's SecondaryExchangeDatahistoryjobs accordingly.
-// Replaces o.R.SecondaryExchangeDatahistoryjobs with related.
-// Sets related.R.SecondaryExchange's SecondaryExchangeDatahistoryjobs accordingly.
-func (o *Exchange) SetSecondaryExchangeDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
-	query := "update \"datahistoryjob\" set \"secondary_exchange_id\" = null where \"secondary_exchange_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
+// AddShortExchangeNameFundingOpportunities adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ShortExchangeNameFundingOpportunities.
+// Sets related.R.ShortExchangeName appropriately.
+func (o *Exchange) AddShortExchangeNameFundingOpportunities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingOpportunity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ShortExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_opportunity\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"short_exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingOpportunityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ShortExchangeNameID = o.ID
+		}
 	}
 
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+	if o.R == nil {
+		o.R = &exchangeR{
+			ShortExchangeNameFundingOpportunities: related,
+		}
+	} else {
+		o.R.ShortExchangeNameFundingOpportunities = append(o.R.ShortExchangeNameFundingOpportunities, related...)
 	}
 
-	if o.R != nil {
-		for _, rel := range o.R.SecondaryExchangeDatahistoryjobs {
-			queries.SetScanner(&rel.SecondaryExchangeID, nil)
-			if rel.R == nil {
-				continue
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingOpportunityR{
+				ShortExchangeName: o,
 			}
+		} else {
+			rel.R.ShortExchangeName = o
+		}
+	}
+	return nil
+}
 
-			rel.R.SecondaryExchange = nil
+// AddExchangeNameFundingRates adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameFundingRates.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameFundingRates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingRate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_rate\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingRatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
 		}
+	}
 
-		o.R.SecondaryExchangeDatahistoryjobs = nil
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRates: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRates = append(o.R.ExchangeNameFundingRates, related...)
 	}
-	return o.AddSecondaryExchangeDatahistoryjobs(ctx, exec, insert, related...)
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingRateR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
 }
 
-// RemoveSecondaryExchangeDatahistoryjobs relationships from objects passed in.
-// Removes related items from R.SecondaryExchangeDatahistoryjobs (uses pointer comparison, removal does not keep order)
-// Sets related.R.SecondaryExchange.
-func (o *Exchange) RemoveSecondaryExchangeDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, related ...*Datahistoryjob) error {
+// AddExchangeNameOpenInterests adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameOpenInterests.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameOpenInterests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OpenInterest) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.SecondaryExchangeID, nil)
-		if rel.R != nil {
-			rel.R.SecondaryExchange = nil
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"open_interest\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, openInterestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("secondary_exchange_id")); err != nil {
-			return err
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOpenInterests: related,
+		}
+	} else {
+		o.R.ExchangeNameOpenInterests = append(o.R.ExchangeNameOpenInterests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &openInterestR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameOrders adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameOrders.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameOrders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Order) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"order\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &exchangeR{
+			ExchangeNameOrders: related,
+		}
+	} else {
+		o.R.ExchangeNameOrders = append(o.R.ExchangeNameOrders, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.SecondaryExchangeDatahistoryjobs {
-			if rel != ri {
-				continue
+		if rel.R == nil {
+			rel.R = &orderR{
+				ExchangeName: o,
 			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
 
-			ln := len(o.R.SecondaryExchangeDatahistoryjobs)
-			if ln > 1 && i < ln-1 {
-				o.R.SecondaryExchangeDatahistoryjobs[i] = o.R.SecondaryExchangeDatahistoryjobs[ln-1]
+// AddExchangeNameOrderbookSnapshots adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameOrderbookSnapshots.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameOrderbookSnapshots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderbookSnapshot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-			o.R.SecondaryExchangeDatahistoryjobs = o.R.SecondaryExchangeDatahistoryjobs[:ln-1]
-			break
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"orderbook_snapshot\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderbookSnapshotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOrderbookSnapshots: related,
 		}
+	} else {
+		o.R.ExchangeNameOrderbookSnapshots = append(o.R.ExchangeNameOrderbookSnapshots, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderbookSnapshotR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
 	return nil
 }
 
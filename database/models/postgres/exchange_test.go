@@ -727,14 +727,14 @@ func testExchangeToManySecondaryExchangeDatahistoryjobs(t *testing.T) {
 	}
 }
 
-func testExchangeToManyExchangeNameTrades(t *testing.T) {
+func testExchangeToManyExchangeNameDepositHistories(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c Trade
+	var b, c DepositHistory
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
@@ -745,10 +745,10 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, depositHistoryDBTypes, false, depositHistoryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, depositHistoryDBTypes, false, depositHistoryColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -762,7 +762,7 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.ExchangeNameTrades().All(ctx, tx)
+	check, err := a.ExchangeNameDepositHistories().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -785,18 +785,18 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 	}
 
 	slice := ExchangeSlice{&a}
-	if err = a.L.LoadExchangeNameTrades(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+	if err = a.L.LoadExchangeNameDepositHistories(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameTrades); got != 2 {
+	if got := len(a.R.ExchangeNameDepositHistories); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.ExchangeNameTrades = nil
-	if err = a.L.LoadExchangeNameTrades(ctx, tx, true, &a, nil); err != nil {
+	a.R.ExchangeNameDepositHistories = nil
+	if err = a.L.LoadExchangeNameDepositHistories(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameTrades); got != 2 {
+	if got := len(a.R.ExchangeNameDepositHistories); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -805,14 +805,14 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 	}
 }
 
-func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
+func testExchangeToManyLongExchangeNameFundingOpportunities(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c WithdrawalHistory
+	var b, c FundingOpportunity
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
@@ -823,10 +823,166 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, fundingOpportunityDBTypes, false, fundingOpportunityColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, fundingOpportunityDBTypes, false, fundingOpportunityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.LongExchangeNameID = a.ID
+	c.LongExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.LongExchangeNameFundingOpportunities().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.LongExchangeNameID == b.LongExchangeNameID {
+			bFound = true
+		}
+		if v.LongExchangeNameID == c.LongExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadLongExchangeNameFundingOpportunities(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LongExchangeNameFundingOpportunities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.LongExchangeNameFundingOpportunities = nil
+	if err = a.L.LoadLongExchangeNameFundingOpportunities(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LongExchangeNameFundingOpportunities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyShortExchangeNameFundingOpportunities(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c FundingOpportunity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, fundingOpportunityDBTypes, false, fundingOpportunityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, fundingOpportunityDBTypes, false, fundingOpportunityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ShortExchangeNameID = a.ID
+	c.ShortExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ShortExchangeNameFundingOpportunities().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ShortExchangeNameID == b.ShortExchangeNameID {
+			bFound = true
+		}
+		if v.ShortExchangeNameID == c.ShortExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadShortExchangeNameFundingOpportunities(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ShortExchangeNameFundingOpportunities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ShortExchangeNameFundingOpportunities = nil
+	if err = a.L.LoadShortExchangeNameFundingOpportunities(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ShortExchangeNameFundingOpportunities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameFundingRates(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c FundingRate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, fundingRateDBTypes, false, fundingRateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, fundingRateDBTypes, false, fundingRateColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -840,7 +996,7 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.ExchangeNameWithdrawalHistories().All(ctx, tx)
+	check, err := a.ExchangeNameFundingRates().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -863,27 +1019,968 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 	}
 
 	slice := ExchangeSlice{&a}
-	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+	if err = a.L.LoadExchangeNameFundingRates(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+	if got := len(a.R.ExchangeNameFundingRates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameFundingRates = nil
+	if err = a.L.LoadExchangeNameFundingRates(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameFundingRates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameOpenInterests(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c OpenInterest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameOpenInterests().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameOpenInterests(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameOpenInterests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameOpenInterests = nil
+	if err = a.L.LoadExchangeNameOpenInterests(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameOpenInterests); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
-
-	a.R.ExchangeNameWithdrawalHistories = nil
-	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, true, &a, nil); err != nil {
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameOrders(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c Order
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, orderDBTypes, false, orderColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderDBTypes, false, orderColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameOrders().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameOrders(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameOrders); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameOrders = nil
+	if err = a.L.LoadExchangeNameOrders(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameOrders); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameOrderbookSnapshots(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c OrderbookSnapshot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameOrderbookSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameOrderbookSnapshots(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameOrderbookSnapshots); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameOrderbookSnapshots = nil
+	if err = a.L.LoadExchangeNameOrderbookSnapshots(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameOrderbookSnapshots); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameTrades(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c Trade
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameTrades().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameTrades(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameTrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameTrades = nil
+	if err = a.L.LoadExchangeNameTrades(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameTrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c WithdrawalHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameWithdrawalHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameWithdrawalHistories = nil
+	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Candle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Candle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Candle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameCandles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameCandles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameCandles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameCandles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Datahistoryjob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameDatahistoryjobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameDatahistoryjobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameDatahistoryjobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameDatahistoryjobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Datahistoryjob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SecondaryExchangeID) {
+			t.Error("foreign key was wrong value", a.ID, first.SecondaryExchangeID)
+		}
+		if !queries.Equal(a.ID, second.SecondaryExchangeID) {
+			t.Error("foreign key was wrong value", a.ID, second.SecondaryExchangeID)
+		}
+
+		if first.R.SecondaryExchange != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SecondaryExchange != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SecondaryExchangeDatahistoryjobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SecondaryExchangeDatahistoryjobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SecondaryExchangeID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SecondaryExchangeID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SecondaryExchangeID) {
+		t.Error("foreign key was wrong value", a.ID, d.SecondaryExchangeID)
+	}
+	if !queries.Equal(a.ID, e.SecondaryExchangeID) {
+		t.Error("foreign key was wrong value", a.ID, e.SecondaryExchangeID)
+	}
+
+	if b.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SecondaryExchange != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SecondaryExchange != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SecondaryExchangeDatahistoryjobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SecondaryExchangeDatahistoryjobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSecondaryExchangeDatahistoryjobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SecondaryExchangeID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SecondaryExchangeID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SecondaryExchange != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SecondaryExchange != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SecondaryExchangeDatahistoryjobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SecondaryExchangeDatahistoryjobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SecondaryExchangeDatahistoryjobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testExchangeToManyAddOpExchangeNameDepositHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e DepositHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DepositHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, depositHistoryDBTypes, false, strmangle.SetComplement(depositHistoryPrimaryKeyColumns, depositHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DepositHistory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameDepositHistories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameDepositHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameDepositHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameDepositHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testExchangeToManyAddOpLongExchangeNameFundingOpportunities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e FundingOpportunity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingOpportunity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingOpportunityDBTypes, false, strmangle.SetComplement(fundingOpportunityPrimaryKeyColumns, fundingOpportunityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+
+	foreignersSplitByInsertion := [][]*FundingOpportunity{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLongExchangeNameFundingOpportunities(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.LongExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.LongExchangeNameID)
+		}
+		if a.ID != second.LongExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.LongExchangeNameID)
+		}
+
+		if first.R.LongExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.LongExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.LongExchangeNameFundingOpportunities[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.LongExchangeNameFundingOpportunities[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.LongExchangeNameFundingOpportunities().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
+func testExchangeToManyAddOpShortExchangeNameFundingOpportunities(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -891,15 +1988,15 @@ func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Candle
+	var b, c, d, e FundingOpportunity
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Candle{&b, &c, &d, &e}
+	foreigners := []*FundingOpportunity{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, fundingOpportunityDBTypes, false, strmangle.SetComplement(fundingOpportunityPrimaryKeyColumns, fundingOpportunityColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -914,13 +2011,13 @@ func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Candle{
+	foreignersSplitByInsertion := [][]*FundingOpportunity{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddExchangeNameCandles(ctx, tx, i != 0, x...)
+		err = a.AddShortExchangeNameFundingOpportunities(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -928,28 +2025,28 @@ func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.ExchangeNameID {
-			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		if a.ID != first.ShortExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ShortExchangeNameID)
 		}
-		if a.ID != second.ExchangeNameID {
-			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		if a.ID != second.ShortExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ShortExchangeNameID)
 		}
 
-		if first.R.ExchangeName != &a {
+		if first.R.ShortExchangeName != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.ExchangeName != &a {
+		if second.R.ShortExchangeName != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.ExchangeNameCandles[i*2] != first {
+		if a.R.ShortExchangeNameFundingOpportunities[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ExchangeNameCandles[i*2+1] != second {
+		if a.R.ShortExchangeNameFundingOpportunities[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ExchangeNameCandles().Count(ctx, tx)
+		count, err := a.ShortExchangeNameFundingOpportunities().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -958,7 +2055,7 @@ func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
 		}
 	}
 }
-func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
+func testExchangeToManyAddOpExchangeNameFundingRates(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -966,15 +2063,15 @@ func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e FundingRate
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*FundingRate{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, fundingRateDBTypes, false, strmangle.SetComplement(fundingRatePrimaryKeyColumns, fundingRateColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -989,13 +2086,13 @@ func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Datahistoryjob{
+	foreignersSplitByInsertion := [][]*FundingRate{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddExchangeNameDatahistoryjobs(ctx, tx, i != 0, x...)
+		err = a.AddExchangeNameFundingRates(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1017,14 +2114,14 @@ func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.ExchangeNameDatahistoryjobs[i*2] != first {
+		if a.R.ExchangeNameFundingRates[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ExchangeNameDatahistoryjobs[i*2+1] != second {
+		if a.R.ExchangeNameFundingRates[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ExchangeNameDatahistoryjobs().Count(ctx, tx)
+		count, err := a.ExchangeNameFundingRates().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1033,7 +2130,7 @@ func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
 		}
 	}
 }
-func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+func testExchangeToManyAddOpExchangeNameOpenInterests(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1041,15 +2138,15 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e OpenInterest
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*OpenInterest{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1064,13 +2161,13 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Datahistoryjob{
+	foreignersSplitByInsertion := [][]*OpenInterest{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, i != 0, x...)
+		err = a.AddExchangeNameOpenInterests(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1078,28 +2175,28 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.SecondaryExchangeID) {
-			t.Error("foreign key was wrong value", a.ID, first.SecondaryExchangeID)
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
 		}
-		if !queries.Equal(a.ID, second.SecondaryExchangeID) {
-			t.Error("foreign key was wrong value", a.ID, second.SecondaryExchangeID)
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
 		}
 
-		if first.R.SecondaryExchange != &a {
+		if first.R.ExchangeName != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.SecondaryExchange != &a {
+		if second.R.ExchangeName != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SecondaryExchangeDatahistoryjobs[i*2] != first {
+		if a.R.ExchangeNameOpenInterests[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SecondaryExchangeDatahistoryjobs[i*2+1] != second {
+		if a.R.ExchangeNameOpenInterests[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+		count, err := a.ExchangeNameOpenInterests().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1108,8 +2205,7 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		}
 	}
 }
-
-func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+func testExchangeToManyAddOpExchangeNameOrders(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1117,20 +2213,20 @@ func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e Order
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*Order{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, orderDBTypes, false, strmangle.SetComplement(orderPrimaryKeyColumns, orderColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
 
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -1140,67 +2236,51 @@ func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, false, &b, &c)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*Order{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameOrders(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, true, &d, &e)
-	if err != nil {
-		t.Fatal(err)
-	}
+		first := x[0]
+		second := x[1]
 
-	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
 
-	if !queries.IsValuerNil(b.SecondaryExchangeID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.SecondaryExchangeID) {
-		t.Error("want c's foreign key value to be nil")
-	}
-	if !queries.Equal(a.ID, d.SecondaryExchangeID) {
-		t.Error("foreign key was wrong value", a.ID, d.SecondaryExchangeID)
-	}
-	if !queries.Equal(a.ID, e.SecondaryExchangeID) {
-		t.Error("foreign key was wrong value", a.ID, e.SecondaryExchangeID)
-	}
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	if b.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.SecondaryExchange != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
-	if e.R.SecondaryExchange != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
+		if a.R.ExchangeNameOrders[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameOrders[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
 
-	if a.R.SecondaryExchangeDatahistoryjobs[0] != &d {
-		t.Error("relationship struct slice not set to correct value")
-	}
-	if a.R.SecondaryExchangeDatahistoryjobs[1] != &e {
-		t.Error("relationship struct slice not set to correct value")
+		count, err := a.ExchangeNameOrders().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+func testExchangeToManyAddOpExchangeNameOrderbookSnapshots(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1208,15 +2288,15 @@ func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e OrderbookSnapshot
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*OrderbookSnapshot{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, orderbookSnapshotDBTypes, false, strmangle.SetComplement(orderbookSnapshotPrimaryKeyColumns, orderbookSnapshotColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1224,66 +2304,57 @@ func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, true, foreigners...)
-	if err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if count != 4 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.RemoveSecondaryExchangeDatahistoryjobs(ctx, tx, foreigners[:2]...)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*OrderbookSnapshot{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameOrderbookSnapshots(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	if !queries.IsValuerNil(b.SecondaryExchangeID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.SecondaryExchangeID) {
-		t.Error("want c's foreign key value to be nil")
-	}
+		first := x[0]
+		second := x[1]
 
-	if b.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.SecondaryExchange != &a {
-		t.Error("relationship to a should have been preserved")
-	}
-	if e.R.SecondaryExchange != &a {
-		t.Error("relationship to a should have been preserved")
-	}
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
 
-	if len(a.R.SecondaryExchangeDatahistoryjobs) != 2 {
-		t.Error("should have preserved two relationships")
-	}
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.SecondaryExchangeDatahistoryjobs[1] != &d {
-		t.Error("relationship to d should have been preserved")
-	}
-	if a.R.SecondaryExchangeDatahistoryjobs[0] != &e {
-		t.Error("relationship to e should have been preserved")
+		if a.R.ExchangeNameOrderbookSnapshots[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameOrderbookSnapshots[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameOrderbookSnapshots().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
 func testExchangeToManyAddOpExchangeNameTrades(t *testing.T) {
 	var err error
 
@@ -34,6 +34,8 @@ type WithdrawalHistory struct {
 	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	ExchangeNameID string      `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	TXID           string      `boil:"tx_id" json:"tx_id" toml:"tx_id" yaml:"tx_id"`
+	Confirmations  int64       `boil:"confirmations" json:"confirmations" toml:"confirmations" yaml:"confirmations"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +52,8 @@ var WithdrawalHistoryColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	ExchangeNameID string
+	TXID           string
+	Confirmations  string
 }{
 	ID:             "id",
 	ExchangeID:     "exchange_id",
@@ -61,26 +65,12 @@ var WithdrawalHistoryColumns = struct {
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	ExchangeNameID: "exchange_name_id",
+	TXID:           "tx_id",
+	Confirmations:  "confirmations",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-
 var WithdrawalHistoryWhere = struct {
 	ID             whereHelperstring
 	ExchangeID     whereHelperstring
@@ -92,6 +82,8 @@ var WithdrawalHistoryWhere = struct {
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
 	ExchangeNameID whereHelperstring
+	TXID           whereHelperstring
+	Confirmations  whereHelperint64
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeID:     whereHelperstring{field: "\"withdrawal_history\".\"exchange_id\""},
@@ -103,6 +95,8 @@ var WithdrawalHistoryWhere = struct {
 	CreatedAt:      whereHelpertime_Time{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelpertime_Time{field: "\"withdrawal_history\".\"updated_at\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
+	TXID:           whereHelperstring{field: "\"withdrawal_history\".\"tx_id\""},
+	Confirmations:  whereHelperint64{field: "\"withdrawal_history\".\"confirmations\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
@@ -132,9 +126,9 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "exchange_name_id"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "exchange_name_id", "tx_id", "confirmations"}
 	withdrawalHistoryColumnsWithoutDefault = []string{"exchange_id", "status", "currency", "amount", "description", "withdraw_type", "exchange_name_id"}
-	withdrawalHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	withdrawalHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at", "tx_id", "confirmations"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
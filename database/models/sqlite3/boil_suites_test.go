@@ -16,7 +16,18 @@ func TestParent(t *testing.T) {
 	t.Run("Candles", testCandles)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
+	t.Run("DepositHistories", testDepositHistories)
+	t.Run("EventRules", testEventRules)
 	t.Run("Exchanges", testExchanges)
+	t.Run("FundingOpportunities", testFundingOpportunities)
+	t.Run("FundingRates", testFundingRates)
+	t.Run("LedgerEntries", testLedgerEntries)
+	t.Run("OpenInterests", testOpenInterests)
+	t.Run("Orders", testOrders)
+	t.Run("OrderEvents", testOrderEvents)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshots)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshots)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalances)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
@@ -30,7 +41,18 @@ func TestDelete(t *testing.T) {
 	t.Run("Candles", testCandlesDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
+	t.Run("DepositHistories", testDepositHistoriesDelete)
+	t.Run("EventRules", testEventRulesDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("FundingOpportunities", testFundingOpportunitiesDelete)
+	t.Run("FundingRates", testFundingRatesDelete)
+	t.Run("LedgerEntries", testLedgerEntriesDelete)
+	t.Run("OpenInterests", testOpenInterestsDelete)
+	t.Run("Orders", testOrdersDelete)
+	t.Run("OrderEvents", testOrderEventsDelete)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsDelete)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsDelete)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
@@ -44,7 +66,18 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("Candles", testCandlesQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
+	t.Run("DepositHistories", testDepositHistoriesQueryDeleteAll)
+	t.Run("EventRules", testEventRulesQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("FundingOpportunities", testFundingOpportunitiesQueryDeleteAll)
+	t.Run("FundingRates", testFundingRatesQueryDeleteAll)
+	t.Run("LedgerEntries", testLedgerEntriesQueryDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsQueryDeleteAll)
+	t.Run("Orders", testOrdersQueryDeleteAll)
+	t.Run("OrderEvents", testOrderEventsQueryDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsQueryDeleteAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsQueryDeleteAll)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
@@ -58,7 +91,18 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("Candles", testCandlesSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
+	t.Run("DepositHistories", testDepositHistoriesSliceDeleteAll)
+	t.Run("EventRules", testEventRulesSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("FundingOpportunities", testFundingOpportunitiesSliceDeleteAll)
+	t.Run("FundingRates", testFundingRatesSliceDeleteAll)
+	t.Run("LedgerEntries", testLedgerEntriesSliceDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsSliceDeleteAll)
+	t.Run("Orders", testOrdersSliceDeleteAll)
+	t.Run("OrderEvents", testOrderEventsSliceDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceDeleteAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSliceDeleteAll)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
@@ -72,7 +116,18 @@ func TestExists(t *testing.T) {
 	t.Run("Candles", testCandlesExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
+	t.Run("DepositHistories", testDepositHistoriesExists)
+	t.Run("EventRules", testEventRulesExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("FundingOpportunities", testFundingOpportunitiesExists)
+	t.Run("FundingRates", testFundingRatesExists)
+	t.Run("LedgerEntries", testLedgerEntriesExists)
+	t.Run("OpenInterests", testOpenInterestsExists)
+	t.Run("Orders", testOrdersExists)
+	t.Run("OrderEvents", testOrderEventsExists)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsExists)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsExists)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
@@ -86,7 +141,18 @@ func TestFind(t *testing.T) {
 	t.Run("Candles", testCandlesFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
+	t.Run("DepositHistories", testDepositHistoriesFind)
+	t.Run("EventRules", testEventRulesFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("FundingOpportunities", testFundingOpportunitiesFind)
+	t.Run("FundingRates", testFundingRatesFind)
+	t.Run("LedgerEntries", testLedgerEntriesFind)
+	t.Run("OpenInterests", testOpenInterestsFind)
+	t.Run("Orders", testOrdersFind)
+	t.Run("OrderEvents", testOrderEventsFind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsFind)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsFind)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
@@ -100,7 +166,18 @@ func TestBind(t *testing.T) {
 	t.Run("Candles", testCandlesBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
+	t.Run("DepositHistories", testDepositHistoriesBind)
+	t.Run("EventRules", testEventRulesBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("FundingOpportunities", testFundingOpportunitiesBind)
+	t.Run("FundingRates", testFundingRatesBind)
+	t.Run("LedgerEntries", testLedgerEntriesBind)
+	t.Run("OpenInterests", testOpenInterestsBind)
+	t.Run("Orders", testOrdersBind)
+	t.Run("OrderEvents", testOrderEventsBind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsBind)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsBind)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
@@ -114,7 +191,18 @@ func TestOne(t *testing.T) {
 	t.Run("Candles", testCandlesOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
+	t.Run("DepositHistories", testDepositHistoriesOne)
+	t.Run("EventRules", testEventRulesOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("FundingOpportunities", testFundingOpportunitiesOne)
+	t.Run("FundingRates", testFundingRatesOne)
+	t.Run("LedgerEntries", testLedgerEntriesOne)
+	t.Run("OpenInterests", testOpenInterestsOne)
+	t.Run("Orders", testOrdersOne)
+	t.Run("OrderEvents", testOrderEventsOne)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsOne)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsOne)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
@@ -128,7 +216,18 @@ func TestAll(t *testing.T) {
 	t.Run("Candles", testCandlesAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
+	t.Run("DepositHistories", testDepositHistoriesAll)
+	t.Run("EventRules", testEventRulesAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("FundingOpportunities", testFundingOpportunitiesAll)
+	t.Run("FundingRates", testFundingRatesAll)
+	t.Run("LedgerEntries", testLedgerEntriesAll)
+	t.Run("OpenInterests", testOpenInterestsAll)
+	t.Run("Orders", testOrdersAll)
+	t.Run("OrderEvents", testOrderEventsAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsAll)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
@@ -142,7 +241,18 @@ func TestCount(t *testing.T) {
 	t.Run("Candles", testCandlesCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
+	t.Run("DepositHistories", testDepositHistoriesCount)
+	t.Run("EventRules", testEventRulesCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("FundingOpportunities", testFundingOpportunitiesCount)
+	t.Run("FundingRates", testFundingRatesCount)
+	t.Run("LedgerEntries", testLedgerEntriesCount)
+	t.Run("OpenInterests", testOpenInterestsCount)
+	t.Run("Orders", testOrdersCount)
+	t.Run("OrderEvents", testOrderEventsCount)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsCount)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsCount)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
@@ -156,7 +266,18 @@ func TestHooks(t *testing.T) {
 	t.Run("Candles", testCandlesHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
+	t.Run("DepositHistories", testDepositHistoriesHooks)
+	t.Run("EventRules", testEventRulesHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("FundingOpportunities", testFundingOpportunitiesHooks)
+	t.Run("FundingRates", testFundingRatesHooks)
+	t.Run("LedgerEntries", testLedgerEntriesHooks)
+	t.Run("OpenInterests", testOpenInterestsHooks)
+	t.Run("Orders", testOrdersHooks)
+	t.Run("OrderEvents", testOrderEventsHooks)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsHooks)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsHooks)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
@@ -174,8 +295,30 @@ func TestInsert(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
+	t.Run("DepositHistories", testDepositHistoriesInsert)
+	t.Run("DepositHistories", testDepositHistoriesInsertWhitelist)
+	t.Run("EventRules", testEventRulesInsert)
+	t.Run("EventRules", testEventRulesInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("FundingOpportunities", testFundingOpportunitiesInsert)
+	t.Run("FundingOpportunities", testFundingOpportunitiesInsertWhitelist)
+	t.Run("FundingRates", testFundingRatesInsert)
+	t.Run("FundingRates", testFundingRatesInsertWhitelist)
+	t.Run("LedgerEntries", testLedgerEntriesInsert)
+	t.Run("LedgerEntries", testLedgerEntriesInsertWhitelist)
+	t.Run("OpenInterests", testOpenInterestsInsert)
+	t.Run("OpenInterests", testOpenInterestsInsertWhitelist)
+	t.Run("Orders", testOrdersInsert)
+	t.Run("Orders", testOrdersInsertWhitelist)
+	t.Run("OrderEvents", testOrderEventsInsert)
+	t.Run("OrderEvents", testOrderEventsInsertWhitelist)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsert)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsertWhitelist)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsInsert)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsInsertWhitelist)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesInsert)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
@@ -199,6 +342,15 @@ func TestToOne(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("DepositHistoryToExchangeUsingExchangeName", testDepositHistoryToOneExchangeUsingExchangeName)
+	t.Run("FundingOpportunityToExchangeUsingShortExchangeName", testFundingOpportunityToOneExchangeUsingShortExchangeName)
+	t.Run("FundingOpportunityToExchangeUsingLongExchangeName", testFundingOpportunityToOneExchangeUsingLongExchangeName)
+	t.Run("FundingRateToExchangeUsingExchangeName", testFundingRateToOneExchangeUsingExchangeName)
+	t.Run("OpenInterestToExchangeUsingExchangeName", testOpenInterestToOneExchangeUsingExchangeName)
+	t.Run("OrderToExchangeUsingExchangeName", testOrderToOneExchangeUsingExchangeName)
+	t.Run("OrderEventToOrderUsingOrder", testOrderEventToOneOrderUsingOrder)
+	t.Run("OrderbookSnapshotToExchangeUsingExchangeName", testOrderbookSnapshotToOneExchangeUsingExchangeName)
+	t.Run("PortfolioSnapshotBalanceToPortfolioSnapshotUsingPortfolioSnapshot", testPortfolioSnapshotBalanceToOnePortfolioSnapshotUsingPortfolioSnapshot)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -210,6 +362,13 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToDepositHistoryUsingExchangeNameDepositHistory", testExchangeOneToOneDepositHistoryUsingExchangeNameDepositHistory)
+	t.Run("ExchangeToFundingOpportunityUsingShortExchangeNameFundingOpportunity", testExchangeOneToOneFundingOpportunityUsingShortExchangeNameFundingOpportunity)
+	t.Run("ExchangeToFundingOpportunityUsingLongExchangeNameFundingOpportunity", testExchangeOneToOneFundingOpportunityUsingLongExchangeNameFundingOpportunity)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneFundingRateUsingExchangeNameFundingRate)
+	t.Run("ExchangeToOpenInterestUsingExchangeNameOpenInterest", testExchangeOneToOneOpenInterestUsingExchangeNameOpenInterest)
+	t.Run("ExchangeToOrderUsingExchangeNameOrder", testExchangeOneToOneOrderUsingExchangeNameOrder)
+	t.Run("ExchangeToOrderbookSnapshotUsingExchangeNameOrderbookSnapshot", testExchangeOneToOneOrderbookSnapshotUsingExchangeNameOrderbookSnapshot)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneTradeUsingExchangeNameTrade)
 }
 
@@ -224,6 +383,8 @@ func TestToMany(t *testing.T) {
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
+	t.Run("OrderToOrderEvents", testOrderToManyOrderEvents)
+	t.Run("PortfolioSnapshotToPortfolioSnapshotBalances", testPortfolioSnapshotToManyPortfolioSnapshotBalances)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiats)
@@ -238,6 +399,15 @@ func TestToOneSet(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("DepositHistoryToExchangeUsingExchangeNameDepositHistory", testDepositHistoryToOneSetOpExchangeUsingExchangeName)
+	t.Run("FundingOpportunityToExchangeUsingShortExchangeNameFundingOpportunity", testFundingOpportunityToOneSetOpExchangeUsingShortExchangeName)
+	t.Run("FundingOpportunityToExchangeUsingLongExchangeNameFundingOpportunity", testFundingOpportunityToOneSetOpExchangeUsingLongExchangeName)
+	t.Run("FundingRateToExchangeUsingExchangeNameFundingRate", testFundingRateToOneSetOpExchangeUsingExchangeName)
+	t.Run("OpenInterestToExchangeUsingExchangeNameOpenInterest", testOpenInterestToOneSetOpExchangeUsingExchangeName)
+	t.Run("OrderToExchangeUsingExchangeNameOrder", testOrderToOneSetOpExchangeUsingExchangeName)
+	t.Run("OrderEventToOrderUsingOrderEvents", testOrderEventToOneSetOpOrderUsingOrder)
+	t.Run("OrderbookSnapshotToExchangeUsingExchangeNameOrderbookSnapshot", testOrderbookSnapshotToOneSetOpExchangeUsingExchangeName)
+	t.Run("PortfolioSnapshotBalanceToPortfolioSnapshotUsingPortfolioSnapshotBalances", testPortfolioSnapshotBalanceToOneSetOpPortfolioSnapshotUsingPortfolioSnapshot)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -257,6 +427,13 @@ func TestToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneSetOpCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToDepositHistoryUsingExchangeNameDepositHistory", testExchangeOneToOneSetOpDepositHistoryUsingExchangeNameDepositHistory)
+	t.Run("ExchangeToFundingOpportunityUsingShortExchangeNameFundingOpportunity", testExchangeOneToOneSetOpFundingOpportunityUsingShortExchangeNameFundingOpportunity)
+	t.Run("ExchangeToFundingOpportunityUsingLongExchangeNameFundingOpportunity", testExchangeOneToOneSetOpFundingOpportunityUsingLongExchangeNameFundingOpportunity)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneSetOpFundingRateUsingExchangeNameFundingRate)
+	t.Run("ExchangeToOpenInterestUsingExchangeNameOpenInterest", testExchangeOneToOneSetOpOpenInterestUsingExchangeNameOpenInterest)
+	t.Run("ExchangeToOrderUsingExchangeNameOrder", testExchangeOneToOneSetOpOrderUsingExchangeNameOrder)
+	t.Run("ExchangeToOrderbookSnapshotUsingExchangeNameOrderbookSnapshot", testExchangeOneToOneSetOpOrderbookSnapshotUsingExchangeNameOrderbookSnapshot)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneSetOpTradeUsingExchangeNameTrade)
 }
 
@@ -275,6 +452,8 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
+	t.Run("OrderToOrderEvents", testOrderToManyAddOpOrderEvents)
+	t.Run("PortfolioSnapshotToPortfolioSnapshotBalances", testPortfolioSnapshotToManyAddOpPortfolioSnapshotBalances)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiats)
@@ -305,7 +484,18 @@ func TestReload(t *testing.T) {
 	t.Run("Candles", testCandlesReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
+	t.Run("DepositHistories", testDepositHistoriesReload)
+	t.Run("EventRules", testEventRulesReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("FundingOpportunities", testFundingOpportunitiesReload)
+	t.Run("FundingRates", testFundingRatesReload)
+	t.Run("LedgerEntries", testLedgerEntriesReload)
+	t.Run("OpenInterests", testOpenInterestsReload)
+	t.Run("Orders", testOrdersReload)
+	t.Run("OrderEvents", testOrderEventsReload)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReload)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsReload)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
@@ -319,7 +509,18 @@ func TestReloadAll(t *testing.T) {
 	t.Run("Candles", testCandlesReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
+	t.Run("DepositHistories", testDepositHistoriesReloadAll)
+	t.Run("EventRules", testEventRulesReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("FundingOpportunities", testFundingOpportunitiesReloadAll)
+	t.Run("FundingRates", testFundingRatesReloadAll)
+	t.Run("LedgerEntries", testLedgerEntriesReloadAll)
+	t.Run("OpenInterests", testOpenInterestsReloadAll)
+	t.Run("Orders", testOrdersReloadAll)
+	t.Run("OrderEvents", testOrderEventsReloadAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReloadAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsReloadAll)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
@@ -333,7 +534,18 @@ func TestSelect(t *testing.T) {
 	t.Run("Candles", testCandlesSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
+	t.Run("DepositHistories", testDepositHistoriesSelect)
+	t.Run("EventRules", testEventRulesSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("FundingOpportunities", testFundingOpportunitiesSelect)
+	t.Run("FundingRates", testFundingRatesSelect)
+	t.Run("LedgerEntries", testLedgerEntriesSelect)
+	t.Run("OpenInterests", testOpenInterestsSelect)
+	t.Run("Orders", testOrdersSelect)
+	t.Run("OrderEvents", testOrderEventsSelect)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSelect)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSelect)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
@@ -347,7 +559,18 @@ func TestUpdate(t *testing.T) {
 	t.Run("Candles", testCandlesUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
+	t.Run("DepositHistories", testDepositHistoriesUpdate)
+	t.Run("EventRules", testEventRulesUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("FundingOpportunities", testFundingOpportunitiesUpdate)
+	t.Run("FundingRates", testFundingRatesUpdate)
+	t.Run("LedgerEntries", testLedgerEntriesUpdate)
+	t.Run("OpenInterests", testOpenInterestsUpdate)
+	t.Run("Orders", testOrdersUpdate)
+	t.Run("OrderEvents", testOrderEventsUpdate)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsUpdate)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsUpdate)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
@@ -361,7 +584,18 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("Candles", testCandlesSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
+	t.Run("DepositHistories", testDepositHistoriesSliceUpdateAll)
+	t.Run("EventRules", testEventRulesSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("FundingOpportunities", testFundingOpportunitiesSliceUpdateAll)
+	t.Run("FundingRates", testFundingRatesSliceUpdateAll)
+	t.Run("LedgerEntries", testLedgerEntriesSliceUpdateAll)
+	t.Run("OpenInterests", testOpenInterestsSliceUpdateAll)
+	t.Run("Orders", testOrdersSliceUpdateAll)
+	t.Run("OrderEvents", testOrderEventsSliceUpdateAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceUpdateAll)
+	t.Run("PortfolioSnapshots", testPortfolioSnapshotsSliceUpdateAll)
+	t.Run("PortfolioSnapshotBalances", testPortfolioSnapshotBalancesSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
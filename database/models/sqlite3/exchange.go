@@ -49,26 +49,47 @@ var ExchangeWhere = struct {
 
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
-	ExchangeNameCandle               string
-	ExchangeNameTrade                string
-	ExchangeNameDatahistoryjobs      string
-	SecondaryExchangeDatahistoryjobs string
-	ExchangeNameWithdrawalHistories  string
+	ExchangeNameCandle                  string
+	ExchangeNameDepositHistory          string
+	ShortExchangeNameFundingOpportunity string
+	LongExchangeNameFundingOpportunity  string
+	ExchangeNameFundingRate             string
+	ExchangeNameOpenInterest            string
+	ExchangeNameOrder                   string
+	ExchangeNameOrderbookSnapshot       string
+	ExchangeNameTrade                   string
+	ExchangeNameDatahistoryjobs         string
+	SecondaryExchangeDatahistoryjobs    string
+	ExchangeNameWithdrawalHistories     string
 }{
-	ExchangeNameCandle:               "ExchangeNameCandle",
-	ExchangeNameTrade:                "ExchangeNameTrade",
-	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
-	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
-	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
+	ExchangeNameCandle:                  "ExchangeNameCandle",
+	ExchangeNameDepositHistory:          "ExchangeNameDepositHistory",
+	ShortExchangeNameFundingOpportunity: "ShortExchangeNameFundingOpportunity",
+	LongExchangeNameFundingOpportunity:  "LongExchangeNameFundingOpportunity",
+	ExchangeNameFundingRate:             "ExchangeNameFundingRate",
+	ExchangeNameOpenInterest:            "ExchangeNameOpenInterest",
+	ExchangeNameOrder:                   "ExchangeNameOrder",
+	ExchangeNameOrderbookSnapshot:       "ExchangeNameOrderbookSnapshot",
+	ExchangeNameTrade:                   "ExchangeNameTrade",
+	ExchangeNameDatahistoryjobs:         "ExchangeNameDatahistoryjobs",
+	SecondaryExchangeDatahistoryjobs:    "SecondaryExchangeDatahistoryjobs",
+	ExchangeNameWithdrawalHistories:     "ExchangeNameWithdrawalHistories",
 }
 
 // exchangeR is where relationships are stored.
 type exchangeR struct {
-	ExchangeNameCandle               *Candle
-	ExchangeNameTrade                *Trade
-	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
-	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
-	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
+	ExchangeNameCandle                  *Candle
+	ExchangeNameDepositHistory          *DepositHistory
+	ShortExchangeNameFundingOpportunity *FundingOpportunity
+	LongExchangeNameFundingOpportunity  *FundingOpportunity
+	ExchangeNameFundingRate             *FundingRate
+	ExchangeNameOpenInterest            *OpenInterest
+	ExchangeNameOrder                   *Order
+	ExchangeNameOrderbookSnapshot       *OrderbookSnapshot
+	ExchangeNameTrade                   *Trade
+	ExchangeNameDatahistoryjobs         DatahistoryjobSlice
+	SecondaryExchangeDatahistoryjobs    DatahistoryjobSlice
+	ExchangeNameWithdrawalHistories     WithdrawalHistorySlice
 }
 
 // NewStruct creates a new relationship struct
@@ -375,6 +396,104 @@ func (o *Exchange) ExchangeNameCandle(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameDepositHistory pointed to by the foreign key.
+func (o *Exchange) ExchangeNameDepositHistory(mods ...qm.QueryMod) depositHistoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := DepositHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"deposit_history\"")
+
+	return query
+}
+
+// ShortExchangeNameFundingOpportunity pointed to by the foreign key.
+func (o *Exchange) ShortExchangeNameFundingOpportunity(mods ...qm.QueryMod) fundingOpportunityQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"short_exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FundingOpportunities(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_opportunity\"")
+
+	return query
+}
+
+// LongExchangeNameFundingOpportunity pointed to by the foreign key.
+func (o *Exchange) LongExchangeNameFundingOpportunity(mods ...qm.QueryMod) fundingOpportunityQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"long_exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FundingOpportunities(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_opportunity\"")
+
+	return query
+}
+
+// ExchangeNameFundingRate pointed to by the foreign key.
+func (o *Exchange) ExchangeNameFundingRate(mods ...qm.QueryMod) fundingRateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	return query
+}
+
+// ExchangeNameOpenInterest pointed to by the foreign key.
+func (o *Exchange) ExchangeNameOpenInterest(mods ...qm.QueryMod) openInterestQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := OpenInterests(queryMods...)
+	queries.SetFrom(query.Query, "\"open_interest\"")
+
+	return query
+}
+
+// ExchangeNameOrder pointed to by the foreign key.
+func (o *Exchange) ExchangeNameOrder(mods ...qm.QueryMod) orderQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Orders(queryMods...)
+	queries.SetFrom(query.Query, "\"order\"")
+
+	return query
+}
+
+// ExchangeNameOrderbookSnapshot pointed to by the foreign key.
+func (o *Exchange) ExchangeNameOrderbookSnapshot(mods ...qm.QueryMod) orderbookSnapshotQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := OrderbookSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"orderbook_snapshot\"")
+
+	return query
+}
+
 // ExchangeNameTrade pointed to by the foreign key.
 func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	queryMods := []qm.QueryMod{
@@ -550,9 +669,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadExchangeNameTrade allows an eager lookup of values, cached into the
+// LoadExchangeNameDepositHistory allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
-func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameDepositHistory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -589,26 +708,26 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 		return nil
 	}
 
-	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`deposit_history`), qm.WhereIn(`deposit_history.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Trade")
+		return errors.Wrap(err, "failed to eager load DepositHistory")
 	}
 
-	var resultSlice []*Trade
+	var resultSlice []*DepositHistory
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Trade")
+		return errors.Wrap(err, "failed to bind eager loaded slice DepositHistory")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for trade")
+		return errors.Wrap(err, "failed to close results of eager load for deposit_history")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deposit_history")
 	}
 
 	if len(exchangeAfterSelectHooks) != 0 {
@@ -625,9 +744,9 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.ExchangeNameTrade = foreign
+		object.R.ExchangeNameDepositHistory = foreign
 		if foreign.R == nil {
-			foreign.R = &tradeR{}
+			foreign.R = &depositHistoryR{}
 		}
 		foreign.R.ExchangeName = object
 	}
@@ -635,9 +754,9 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameTrade = foreign
+				local.R.ExchangeNameDepositHistory = foreign
 				if foreign.R == nil {
-					foreign.R = &tradeR{}
+					foreign.R = &depositHistoryR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -648,9 +767,9 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+// LoadShortExchangeNameFundingOpportunity allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadShortExchangeNameFundingOpportunity(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -687,54 +806,57 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 		return nil
 	}
 
-	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_opportunity`), qm.WhereIn(`funding_opportunity.short_exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load datahistoryjob")
+		return errors.Wrap(err, "failed to eager load FundingOpportunity")
 	}
 
-	var resultSlice []*Datahistoryjob
+	var resultSlice []*FundingOpportunity
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+		return errors.Wrap(err, "failed to bind eager loaded slice FundingOpportunity")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+		return errors.Wrap(err, "failed to close results of eager load for funding_opportunity")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_opportunity")
 	}
 
-	if len(datahistoryjobAfterSelectHooks) != 0 {
+	if len(exchangeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ExchangeNameDatahistoryjobs = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &datahistoryjobR{}
-			}
-			foreign.R.ExchangeName = object
+		foreign := resultSlice[0]
+		object.R.ShortExchangeNameFundingOpportunity = foreign
+		if foreign.R == nil {
+			foreign.R = &fundingOpportunityR{}
 		}
-		return nil
+		foreign.R.ShortExchangeName = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameDatahistoryjobs = append(local.R.ExchangeNameDatahistoryjobs, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ShortExchangeNameID {
+				local.R.ShortExchangeNameFundingOpportunity = foreign
 				if foreign.R == nil {
-					foreign.R = &datahistoryjobR{}
+					foreign.R = &fundingOpportunityR{}
 				}
-				foreign.R.ExchangeName = local
+				foreign.R.ShortExchangeName = local
 				break
 			}
 		}
@@ -743,9 +865,9 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 	return nil
 }
 
-// LoadSecondaryExchangeDatahistoryjobs allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+// LoadLongExchangeNameFundingOpportunity allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadLongExchangeNameFundingOpportunity(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -769,7 +891,7 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -782,54 +904,57 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 		return nil
 	}
 
-	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.secondary_exchange_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_opportunity`), qm.WhereIn(`funding_opportunity.long_exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load datahistoryjob")
+		return errors.Wrap(err, "failed to eager load FundingOpportunity")
 	}
 
-	var resultSlice []*Datahistoryjob
+	var resultSlice []*FundingOpportunity
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+		return errors.Wrap(err, "failed to bind eager loaded slice FundingOpportunity")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+		return errors.Wrap(err, "failed to close results of eager load for funding_opportunity")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_opportunity")
 	}
 
-	if len(datahistoryjobAfterSelectHooks) != 0 {
+	if len(exchangeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.SecondaryExchangeDatahistoryjobs = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &datahistoryjobR{}
-			}
-			foreign.R.SecondaryExchange = object
+		foreign := resultSlice[0]
+		object.R.LongExchangeNameFundingOpportunity = foreign
+		if foreign.R == nil {
+			foreign.R = &fundingOpportunityR{}
 		}
-		return nil
+		foreign.R.LongExchangeName = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.SecondaryExchangeID) {
-				local.R.SecondaryExchangeDatahistoryjobs = append(local.R.SecondaryExchangeDatahistoryjobs, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.LongExchangeNameID {
+				local.R.LongExchangeNameFundingOpportunity = foreign
 				if foreign.R == nil {
-					foreign.R = &datahistoryjobR{}
+					foreign.R = &fundingOpportunityR{}
 				}
-				foreign.R.SecondaryExchange = local
+				foreign.R.LongExchangeName = local
 				break
 			}
 		}
@@ -838,9 +963,9 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 	return nil
 }
 
-// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+// LoadExchangeNameFundingRate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameFundingRate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -877,52 +1002,55 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load withdrawal_history")
+		return errors.Wrap(err, "failed to eager load FundingRate")
 	}
 
-	var resultSlice []*WithdrawalHistory
+	var resultSlice []*FundingRate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+		return errors.Wrap(err, "failed to bind eager loaded slice FundingRate")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+		return errors.Wrap(err, "failed to close results of eager load for funding_rate")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
 	}
 
-	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+	if len(exchangeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ExchangeNameWithdrawalHistories = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &withdrawalHistoryR{}
-			}
-			foreign.R.ExchangeName = object
+		foreign := resultSlice[0]
+		object.R.ExchangeNameFundingRate = foreign
+		if foreign.R == nil {
+			foreign.R = &fundingRateR{}
 		}
-		return nil
+		foreign.R.ExchangeName = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				local.R.ExchangeNameFundingRate = foreign
 				if foreign.R == nil {
-					foreign.R = &withdrawalHistoryR{}
+					foreign.R = &fundingRateR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -933,49 +1061,1083 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	return nil
 }
 
-// SetExchangeNameCandle of the exchange to the related item.
-// Sets o.R.ExchangeNameCandle to related.
-// Adds o to related.R.ExchangeName.
-func (o *Exchange) SetExchangeNameCandle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Candle) error {
-	var err error
+// LoadExchangeNameOpenInterest allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameOpenInterest(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
 
-	if insert {
-		related.ExchangeNameID = o.ID
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"candle\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
-			strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.ID}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
-		}
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
 
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+			args = append(args, obj.ID)
 		}
+	}
 
-		related.ExchangeNameID = o.ID
+	if len(args) == 0 {
+		return nil
+	}
 
+	query := NewQuery(qm.From(`open_interest`), qm.WhereIn(`open_interest.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameCandle: related,
-		}
-	} else {
-		o.R.ExchangeNameCandle = related
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OpenInterest")
 	}
 
-	if related.R == nil {
-		related.R = &candleR{
+	var resultSlice []*OpenInterest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OpenInterest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for open_interest")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for open_interest")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameOpenInterest = foreign
+		if foreign.R == nil {
+			foreign.R = &openInterestR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOpenInterest = foreign
+				if foreign.R == nil {
+					foreign.R = &openInterestR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOrder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameOrder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`order`), qm.WhereIn(`order.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Order")
+	}
+
+	var resultSlice []*Order
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Order")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for order")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for order")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameOrder = foreign
+		if foreign.R == nil {
+			foreign.R = &orderR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOrder = foreign
+				if foreign.R == nil {
+					foreign.R = &orderR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOrderbookSnapshot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameOrderbookSnapshot(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`orderbook_snapshot`), qm.WhereIn(`orderbook_snapshot.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OrderbookSnapshot")
+	}
+
+	var resultSlice []*OrderbookSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OrderbookSnapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orderbook_snapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderbook_snapshot")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameOrderbookSnapshot = foreign
+		if foreign.R == nil {
+			foreign.R = &orderbookSnapshotR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOrderbookSnapshot = foreign
+				if foreign.R == nil {
+					foreign.R = &orderbookSnapshotR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTrade allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Trade")
+	}
+
+	var resultSlice []*Trade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Trade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for trade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameTrade = foreign
+		if foreign.R == nil {
+			foreign.R = &tradeR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTrade = foreign
+				if foreign.R == nil {
+					foreign.R = &tradeR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(datahistoryjobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameDatahistoryjobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datahistoryjobR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameDatahistoryjobs = append(local.R.ExchangeNameDatahistoryjobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSecondaryExchangeDatahistoryjobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.secondary_exchange_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(datahistoryjobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SecondaryExchangeDatahistoryjobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datahistoryjobR{}
+			}
+			foreign.R.SecondaryExchange = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SecondaryExchangeID) {
+				local.R.SecondaryExchangeDatahistoryjobs = append(local.R.SecondaryExchangeDatahistoryjobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.SecondaryExchange = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_history")
+	}
+
+	var resultSlice []*WithdrawalHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+	}
+
+	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeNameCandle of the exchange to the related item.
+// Sets o.R.ExchangeNameCandle to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameCandle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Candle) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"candle\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameCandle: related,
+		}
+	} else {
+		o.R.ExchangeNameCandle = related
+	}
+
+	if related.R == nil {
+		related.R = &candleR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameDepositHistory of the exchange to the related item.
+// Sets o.R.ExchangeNameDepositHistory to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameDepositHistory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DepositHistory) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"deposit_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, depositHistoryPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDepositHistory: related,
+		}
+	} else {
+		o.R.ExchangeNameDepositHistory = related
+	}
+
+	if related.R == nil {
+		related.R = &depositHistoryR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetShortExchangeNameFundingOpportunity of the exchange to the related item.
+// Sets o.R.ShortExchangeNameFundingOpportunity to related.
+// Adds o to related.R.ShortExchangeName.
+func (o *Exchange) SetShortExchangeNameFundingOpportunity(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FundingOpportunity) error {
+	var err error
+
+	if insert {
+		related.ShortExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"funding_opportunity\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"short_exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fundingOpportunityPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ShortExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ShortExchangeNameFundingOpportunity: related,
+		}
+	} else {
+		o.R.ShortExchangeNameFundingOpportunity = related
+	}
+
+	if related.R == nil {
+		related.R = &fundingOpportunityR{
+			ShortExchangeName: o,
+		}
+	} else {
+		related.R.ShortExchangeName = o
+	}
+	return nil
+}
+
+// SetLongExchangeNameFundingOpportunity of the exchange to the related item.
+// Sets o.R.LongExchangeNameFundingOpportunity to related.
+// Adds o to related.R.LongExchangeName.
+func (o *Exchange) SetLongExchangeNameFundingOpportunity(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FundingOpportunity) error {
+	var err error
+
+	if insert {
+		related.LongExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"funding_opportunity\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"long_exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fundingOpportunityPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.LongExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			LongExchangeNameFundingOpportunity: related,
+		}
+	} else {
+		o.R.LongExchangeNameFundingOpportunity = related
+	}
+
+	if related.R == nil {
+		related.R = &fundingOpportunityR{
+			LongExchangeName: o,
+		}
+	} else {
+		related.R.LongExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameFundingRate of the exchange to the related item.
+// Sets o.R.ExchangeNameFundingRate to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameFundingRate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FundingRate) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"funding_rate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fundingRatePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRate: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRate = related
+	}
+
+	if related.R == nil {
+		related.R = &fundingRateR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameOpenInterest of the exchange to the related item.
+// Sets o.R.ExchangeNameOpenInterest to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameOpenInterest(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OpenInterest) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"open_interest\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, openInterestPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOpenInterest: related,
+		}
+	} else {
+		o.R.ExchangeNameOpenInterest = related
+	}
+
+	if related.R == nil {
+		related.R = &openInterestR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameOrder of the exchange to the related item.
+// Sets o.R.ExchangeNameOrder to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameOrder(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Order) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"order\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, orderPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOrder: related,
+		}
+	} else {
+		o.R.ExchangeNameOrder = related
+	}
+
+	if related.R == nil {
+		related.R = &orderR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameOrderbookSnapshot of the exchange to the related item.
+// Sets o.R.ExchangeNameOrderbookSnapshot to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameOrderbookSnapshot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OrderbookSnapshot) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"orderbook_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, orderbookSnapshotPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOrderbookSnapshot: related,
+		}
+	} else {
+		o.R.ExchangeNameOrderbookSnapshot = related
+	}
+
+	if related.R == nil {
+		related.R = &orderbookSnapshotR{
 			ExchangeName: o,
 		}
 	} else {
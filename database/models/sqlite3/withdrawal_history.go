@@ -33,6 +33,8 @@ type WithdrawalHistory struct {
 	WithdrawType   int64       `boil:"withdraw_type" json:"withdraw_type" toml:"withdraw_type" yaml:"withdraw_type"`
 	CreatedAt      string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	TXID           string      `boil:"tx_id" json:"tx_id" toml:"tx_id" yaml:"tx_id"`
+	Confirmations  int64       `boil:"confirmations" json:"confirmations" toml:"confirmations" yaml:"confirmations"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +51,8 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType   string
 	CreatedAt      string
 	UpdatedAt      string
+	TXID           string
+	Confirmations  string
 }{
 	ID:             "id",
 	ExchangeNameID: "exchange_name_id",
@@ -60,6 +64,8 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType:   "withdraw_type",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	TXID:           "tx_id",
+	Confirmations:  "confirmations",
 }
 
 // Generated where
@@ -75,6 +81,8 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType   whereHelperint64
 	CreatedAt      whereHelperstring
 	UpdatedAt      whereHelperstring
+	TXID           whereHelperstring
+	Confirmations  whereHelperint64
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
@@ -86,6 +94,8 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType:   whereHelperint64{field: "\"withdrawal_history\".\"withdraw_type\""},
 	CreatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"updated_at\""},
+	TXID:           whereHelperstring{field: "\"withdrawal_history\".\"tx_id\""},
+	Confirmations:  whereHelperint64{field: "\"withdrawal_history\".\"confirmations\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
@@ -115,9 +125,9 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "tx_id", "confirmations"}
 	withdrawalHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type"}
-	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at"}
+	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at", "tx_id", "confirmations"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
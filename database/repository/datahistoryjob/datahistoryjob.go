@@ -267,6 +267,7 @@ func upsertSqlite(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) erro
 			DecimalPlaceComparison:   null.Int64{Int64: int64(jobs[i].DecimalPlaceComparison), Valid: jobs[i].DecimalPlaceComparison > 0}, //nolint:gosec // TODO: Make uint64
 			ReplaceOnIssue:           null.Int64{Int64: replaceOnIssue, Valid: replaceOnIssue == 1},
 			IssueTolerancePercentage: null.Float64{Float64: jobs[i].IssueTolerancePercentage, Valid: jobs[i].IssueTolerancePercentage > 0},
+			OrderbookDepth:           null.Int64{Int64: int64(jobs[i].OrderbookDepth), Valid: jobs[i].OrderbookDepth > 0}, //nolint:gosec // TODO: Make uint64
 		}
 		if secondaryExch != nil {
 			tempEvent.SecondaryExchangeID = null.String{String: secondaryExch.ID, Valid: true}
@@ -318,6 +319,7 @@ func upsertPostgres(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) er
 			DecimalPlaceComparison:   null.Int{Int: int(jobs[i].DecimalPlaceComparison), Valid: jobs[i].DecimalPlaceComparison > 0}, //nolint:gosec // TODO: Make uint64
 			ReplaceOnIssue:           null.Bool{Bool: jobs[i].ReplaceOnIssue, Valid: jobs[i].ReplaceOnIssue},
 			IssueTolerancePercentage: null.Float64{Float64: jobs[i].IssueTolerancePercentage, Valid: jobs[i].IssueTolerancePercentage > 0},
+			OrderbookDepth:           null.Int{Int: int(jobs[i].OrderbookDepth), Valid: jobs[i].OrderbookDepth > 0}, //nolint:gosec // TODO: Make uint64
 		}
 		if secondaryExch != nil {
 			tempEvent.SecondaryExchangeID = null.String{String: secondaryExch.ID, Valid: true}
@@ -726,6 +728,7 @@ func (db *DBService) createSQLiteDataHistoryJobResponse(result *sqlite3.Datahist
 		SecondarySourceExchangeName: secondaryExchangeName,
 		IssueTolerancePercentage:    result.IssueTolerancePercentage.Float64,
 		ReplaceOnIssue:              result.ReplaceOnIssue.Int64 == 1,
+		OrderbookDepth:              uint64(result.OrderbookDepth.Int64), //nolint:gosec // TODO: Make uint64
 		Results:                     jobResults,
 	}, nil
 }
@@ -805,5 +808,6 @@ func (db *DBService) createPostgresDataHistoryJobResponse(result *postgres.Datah
 		SecondarySourceExchangeName: secondaryExchangeName,
 		IssueTolerancePercentage:    result.IssueTolerancePercentage.Float64,
 		ReplaceOnIssue:              result.ReplaceOnIssue.Bool,
+		OrderbookDepth:              uint64(result.OrderbookDepth.Int), //nolint:gosec // TODO: Make uint64
 	}, nil
 }
@@ -146,6 +146,7 @@ func TestDataHistoryJob(t *testing.T) {
 				}
 				if i == 19 {
 					j.Status = 1
+					j.OrderbookDepth = 25
 				}
 				jerberoos = append(jerberoos, j)
 			}
@@ -158,6 +159,7 @@ func TestDataHistoryJob(t *testing.T) {
 			resp, err := db.GetByNickName("TestDataHistoryJob19")
 			require.NoError(t, err)
 			assert.True(t, strings.EqualFold("TestDataHistoryJob19", resp.Nickname))
+			assert.Equal(t, uint64(25), resp.OrderbookDepth)
 
 			results, err := db.GetAllIncompleteJobsAndResults()
 			require.NoError(t, err)
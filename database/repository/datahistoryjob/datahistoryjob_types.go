@@ -37,6 +37,7 @@ type DataHistoryJob struct {
 	SecondarySourceExchangeName string
 	IssueTolerancePercentage    float64
 	ReplaceOnIssue              bool
+	OrderbookDepth              uint64
 }
 
 // DBService is a service which allows the interaction with
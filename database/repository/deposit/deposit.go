@@ -0,0 +1,234 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves deposits to the database. A deposit which already exists for
+// the exchange and exchange ID is overwritten
+func Insert(deposits ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	for i := range deposits {
+		if deposits[i].ExchangeNameID == "" && deposits[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(deposits[i].Exchange)
+			if err != nil {
+				return err
+			}
+			deposits[i].ExchangeNameID = exchangeUUID.String()
+		} else if deposits[i].ExchangeNameID == "" && deposits[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, deposits...)
+	} else {
+		err = insertPostgres(ctx, tx, deposits...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, deposits ...Data) error {
+	for i := range deposits {
+		if deposits[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			deposits[i].ID = freshUUID.String()
+		}
+		tempDeposit := sqlite3.DepositHistory{
+			ID:             deposits[i].ID,
+			ExchangeNameID: deposits[i].ExchangeNameID,
+			ExchangeID:     deposits[i].ExchangeID,
+			Status:         deposits[i].Status,
+			Currency:       strings.ToUpper(deposits[i].Currency),
+			Amount:         deposits[i].Amount,
+			Fee:            deposits[i].Fee,
+			Address:        deposits[i].Address,
+			TXID:           deposits[i].TxID,
+			Chain:          deposits[i].Chain,
+			Confirmations:  deposits[i].Confirmations,
+			CreatedAt:      deposits[i].CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:      deposits[i].UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		err := tempDeposit.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, deposits ...Data) error {
+	for i := range deposits {
+		if deposits[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			deposits[i].ID = freshUUID.String()
+		}
+		tempDeposit := postgres.DepositHistory{
+			ID:             deposits[i].ID,
+			ExchangeNameID: deposits[i].ExchangeNameID,
+			ExchangeID:     deposits[i].ExchangeID,
+			Status:         deposits[i].Status,
+			Currency:       strings.ToUpper(deposits[i].Currency),
+			Amount:         deposits[i].Amount,
+			Fee:            deposits[i].Fee,
+			Address:        deposits[i].Address,
+			TXID:           deposits[i].TxID,
+			Chain:          deposits[i].Chain,
+			Confirmations:  deposits[i].Confirmations,
+			CreatedAt:      deposits[i].CreatedAt.UTC(),
+			UpdatedAt:      deposits[i].UpdatedAt.UTC(),
+		}
+		err := tempDeposit.Upsert(ctx, tx, true, []string{"exchange_name_id", "exchange_id"}, boil.Whitelist("status", "tx_id", "confirmations", "updated_at"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns the deposits to an exchange created between the start
+// and end dates ordered by time
+func GetInRange(exchangeName string, startDate, endDate time.Time) (deposits []Data, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		deposits, err = getInRangeSQLite(exchangeName, startDate, endDate)
+		if err != nil {
+			return deposits, fmt.Errorf("deposit.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		deposits, err = getInRangePostgres(exchangeName, startDate, endDate)
+		if err != nil {
+			return deposits, fmt.Errorf("deposit.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return deposits, nil
+}
+
+func getInRangeSQLite(exchangeName string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sqlite3.DepositHistories(
+		qm.Where("exchange_name_id = ? AND created_at BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("created_at"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	deposits := make([]Data, len(result))
+	for i := range result {
+		createdAt, err := time.Parse(time.RFC3339, result[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		updatedAt, err := time.Parse(time.RFC3339, result[i].UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		deposits[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			ExchangeID:     result[i].ExchangeID,
+			Status:         result[i].Status,
+			Currency:       result[i].Currency,
+			Amount:         result[i].Amount,
+			Fee:            result[i].Fee,
+			Address:        result[i].Address,
+			TxID:           result[i].TXID,
+			Chain:          result[i].Chain,
+			Confirmations:  result[i].Confirmations,
+			CreatedAt:      createdAt,
+			UpdatedAt:      updatedAt,
+		}
+	}
+	return deposits, nil
+}
+
+func getInRangePostgres(exchangeName string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := postgres.DepositHistories(
+		qm.Where("exchange_name_id = ? AND created_at BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("created_at"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	deposits := make([]Data, len(result))
+	for i := range result {
+		deposits[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			ExchangeID:     result[i].ExchangeID,
+			Status:         result[i].Status,
+			Currency:       result[i].Currency,
+			Amount:         result[i].Amount,
+			Fee:            result[i].Fee,
+			Address:        result[i].Address,
+			TxID:           result[i].TXID,
+			Chain:          result[i].Chain,
+			Confirmations:  result[i].Confirmations,
+			CreatedAt:      result[i].CreatedAt.UTC(),
+			UpdatedAt:      result[i].UpdatedAt.UTC(),
+		}
+	}
+	return deposits, nil
+}
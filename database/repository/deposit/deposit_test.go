@@ -0,0 +1,121 @@
+package deposit
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestDeposits(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+			require.NoError(t, exchange.InsertMany(testExchanges))
+
+			assert.ErrorIs(t, Insert(Data{}), errExchangeNameUnset)
+
+			firstTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			deposits := make([]Data, 3)
+			for i := range deposits {
+				deposits[i] = Data{
+					Exchange:   testExchanges[0].Name,
+					ExchangeID: fmt.Sprintf("deposit-%d", i),
+					Status:     withdraw.StatusConfirming,
+					Currency:   currency.BTC.String(),
+					Amount:     float64(i + 1),
+					Address:    "1337",
+					TxID:       fmt.Sprintf("0x%d", i),
+					Chain:      "BTC",
+					CreatedAt:  firstTime.Add(time.Hour * time.Duration(i)),
+					UpdatedAt:  firstTime.Add(time.Hour * time.Duration(i)),
+				}
+			}
+			require.NoError(t, Insert(deposits...))
+
+			deposits[1].ID = ""
+			deposits[1].Status = withdraw.StatusCompleted
+			deposits[1].Confirmations = 6
+			deposits[1].UpdatedAt = firstTime.Add(time.Hour * 4)
+			require.NoError(t, Insert(deposits[1]), "inserting an existing deposit must not error")
+
+			resp, err := GetInRange(testExchanges[0].Name, firstTime, firstTime.Add(time.Hour))
+			require.NoError(t, err)
+			require.Len(t, resp, 2, "existing deposits should be replaced rather than duplicated")
+			assert.Equal(t, "deposit-0", resp[0].ExchangeID)
+			assert.Equal(t, withdraw.StatusCompleted, resp[1].Status, "the deposit status should be updated")
+			assert.Equal(t, int64(6), resp[1].Confirmations, "the deposit confirmations should be updated")
+			assert.Equal(t, firstTime.Add(time.Hour*4), resp[1].UpdatedAt, "the deposit update time should be updated")
+			assert.Equal(t, "0x1", resp[1].TxID)
+
+			resp, err = GetInRange(testExchanges[0].Name, firstTime.Add(time.Hour*24), firstTime.Add(time.Hour*48))
+			require.NoError(t, err)
+			assert.Empty(t, resp)
+
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package deposit
+
+import (
+	"errors"
+	"time"
+)
+
+var errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+
+// Data defines a deposit to an exchange account in its simplest db friendly
+// form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	// ExchangeID is the exchange's identifier of the deposit, or its
+	// transaction hash when the exchange does not provide one
+	ExchangeID    string
+	Status        string
+	Currency      string
+	Amount        float64
+	Fee           float64
+	Address       string
+	TxID          string
+	Chain         string
+	Confirmations int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,206 @@
+package eventrule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates event rules in the database by their ID
+func (db *DBService) Upsert(rules ...*Details) error {
+	if len(rules) == 0 {
+		return errNoRules
+	}
+	for i := range rules {
+		if rules[i] == nil {
+			return errNoRules
+		}
+		if rules[i].ID <= 0 {
+			return errInvalidRuleID
+		}
+		if rules[i].Exchange == "" {
+			return errExchangeNameNil
+		}
+		if rules[i].Definition == "" {
+			return errDefinitionUnset
+		}
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, rules...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, rules...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes an event rule by its ID
+func (db *DBService) Delete(id int64) error {
+	if id <= 0 {
+		return errInvalidRuleID
+	}
+	var err error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		_, err = sqlite3.EventRules(qm.Where("id = ?", id)).DeleteAll(context.TODO(), db.sql)
+	case database.DBPostgreSQL:
+		_, err = postgres.EventRules(qm.Where("id = ?", id)).DeleteAll(context.TODO(), db.sql)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	return err
+}
+
+// GetAll returns all stored event rules ordered by ID
+func (db *DBService) GetAll() ([]Details, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getAllSQLite()
+	case database.DBPostgreSQL:
+		return db.getAllPostgres()
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, rules ...*Details) error {
+	for i := range rules {
+		now := time.Now().UTC().Format(time.RFC3339)
+		existing, err := sqlite3.FindEventRule(ctx, tx, rules[i].ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if existing == nil {
+			tempRule := &sqlite3.EventRule{
+				ID:         rules[i].ID,
+				Exchange:   rules[i].Exchange,
+				Definition: rules[i].Definition,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
+			if err = tempRule.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+			continue
+		}
+		existing.Exchange = rules[i].Exchange
+		existing.Definition = rules[i].Definition
+		existing.UpdatedAt = now
+		if _, err = existing.Update(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, rules ...*Details) error {
+	for i := range rules {
+		now := time.Now().UTC()
+		tempRule := &postgres.EventRule{
+			ID:         rules[i].ID,
+			Exchange:   rules[i].Exchange,
+			Definition: rules[i].Definition,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		err := tempRule.Upsert(ctx, tx, true, []string{"id"}, boil.Whitelist("exchange", "definition", "updated_at"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getAllSQLite() ([]Details, error) {
+	results, err := sqlite3.EventRules(qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		createdAt, err := time.Parse(time.RFC3339, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		updatedAt, err := time.Parse(time.RFC3339, results[i].UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Details{
+			ID:         results[i].ID,
+			Exchange:   results[i].Exchange,
+			Definition: results[i].Definition,
+			CreatedAt:  createdAt,
+			UpdatedAt:  updatedAt,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getAllPostgres() ([]Details, error) {
+	results, err := postgres.EventRules(qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		resp[i] = Details{
+			ID:         results[i].ID,
+			Exchange:   results[i].Exchange,
+			Definition: results[i].Definition,
+			CreatedAt:  results[i].CreatedAt,
+			UpdatedAt:  results[i].UpdatedAt,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,129 @@
+package eventrule
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestUpsertValidation(t *testing.T) {
+	t.Parallel()
+	db := &DBService{}
+	err := db.Upsert()
+	assert.ErrorIs(t, err, errNoRules)
+
+	err = db.Upsert(nil)
+	assert.ErrorIs(t, err, errNoRules)
+
+	err = db.Upsert(&Details{})
+	assert.ErrorIs(t, err, errInvalidRuleID)
+
+	err = db.Upsert(&Details{ID: 1})
+	assert.ErrorIs(t, err, errExchangeNameNil)
+
+	err = db.Upsert(&Details{ID: 1, Exchange: "one"})
+	assert.ErrorIs(t, err, errDefinitionUnset)
+
+	err = db.Delete(0)
+	assert.ErrorIs(t, err, errInvalidRuleID)
+}
+
+func TestEventRules(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			rules := []*Details{
+				{ID: 1, Exchange: "one", Definition: `{"Item":"PRICE"}`},
+				{ID: 2, Exchange: "two", Definition: `{"Item":"SPREAD"}`},
+			}
+			require.NoError(t, db.Upsert(rules...))
+
+			rules[0].Definition = `{"Item":"IMBALANCE"}`
+			require.NoError(t, db.Upsert(rules[0]))
+
+			results, err := db.GetAll()
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.Equal(t, int64(1), results[0].ID)
+			assert.Equal(t, `{"Item":"IMBALANCE"}`, results[0].Definition)
+			assert.Equal(t, "two", results[1].Exchange)
+			assert.False(t, results[0].CreatedAt.IsZero())
+
+			require.NoError(t, db.Delete(1))
+			results, err = db.GetAll()
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, int64(2), results[0].ID)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package eventrule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNoRules         = errors.New("no event rules provided")
+	errInvalidRuleID   = errors.New("invalid event rule id")
+	errExchangeNameNil = errors.New("exchange name not set")
+	errDefinitionUnset = errors.New("event rule definition not set")
+)
+
+// Details is a DTO for event manager rules stored in the database. The
+// definition holds the serialised rule so new rule fields do not require
+// schema changes
+type Details struct {
+	ID         int64
+	Exchange   string
+	Definition string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using event rule database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Details) error
+	Delete(int64) error
+	GetAll() ([]Details, error)
+}
@@ -0,0 +1,264 @@
+package fundingopportunity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves funding opportunities to the database. An opportunity which
+// already exists for the type, legs and timestamp is overwritten
+func Insert(opportunities ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	for i := range opportunities {
+		if opportunities[i].Type == "" {
+			return errTypeUnset
+		}
+		var err error
+		opportunities[i].LongExchangeNameID, err = exchangeNameID(opportunities[i].LongExchange, opportunities[i].LongExchangeNameID)
+		if err != nil {
+			return err
+		}
+		opportunities[i].ShortExchangeNameID, err = exchangeNameID(opportunities[i].ShortExchange, opportunities[i].ShortExchangeNameID)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, opportunities...)
+	} else {
+		err = insertPostgres(ctx, tx, opportunities...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// exchangeNameID returns the exchange uuid of a leg, looking it up by name
+// when it is not set
+func exchangeNameID(exchangeName, exchangeNameID string) (string, error) {
+	if exchangeNameID != "" {
+		return exchangeNameID, nil
+	}
+	if exchangeName == "" {
+		return "", errExchangeNameUnset
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return "", err
+	}
+	return exchangeUUID.String(), nil
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, opportunities ...Data) error {
+	for i := range opportunities {
+		if opportunities[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			opportunities[i].ID = freshUUID.String()
+		}
+		tempOpportunity := sqlite3.FundingOpportunity{
+			ID:                  opportunities[i].ID,
+			Type:                opportunities[i].Type,
+			Base:                strings.ToUpper(opportunities[i].Base),
+			Quote:               strings.ToUpper(opportunities[i].Quote),
+			LongExchangeNameID:  opportunities[i].LongExchangeNameID,
+			LongAsset:           strings.ToLower(opportunities[i].LongAsset),
+			LongRate:            opportunities[i].LongRate,
+			ShortExchangeNameID: opportunities[i].ShortExchangeNameID,
+			ShortAsset:          strings.ToLower(opportunities[i].ShortAsset),
+			ShortRate:           opportunities[i].ShortRate,
+			GrossRate:           opportunities[i].GrossRate,
+			FeeRate:             opportunities[i].FeeRate,
+			NetRate:             opportunities[i].NetRate,
+			Timestamp:           opportunities[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempOpportunity.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, opportunities ...Data) error {
+	for i := range opportunities {
+		if opportunities[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			opportunities[i].ID = freshUUID.String()
+		}
+		tempOpportunity := postgres.FundingOpportunity{
+			ID:                  opportunities[i].ID,
+			Type:                opportunities[i].Type,
+			Base:                strings.ToUpper(opportunities[i].Base),
+			Quote:               strings.ToUpper(opportunities[i].Quote),
+			LongExchangeNameID:  opportunities[i].LongExchangeNameID,
+			LongAsset:           strings.ToLower(opportunities[i].LongAsset),
+			LongRate:            opportunities[i].LongRate,
+			ShortExchangeNameID: opportunities[i].ShortExchangeNameID,
+			ShortAsset:          strings.ToLower(opportunities[i].ShortAsset),
+			ShortRate:           opportunities[i].ShortRate,
+			GrossRate:           opportunities[i].GrossRate,
+			FeeRate:             opportunities[i].FeeRate,
+			NetRate:             opportunities[i].NetRate,
+			Timestamp:           opportunities[i].Timestamp.UTC(),
+		}
+		err := tempOpportunity.Upsert(ctx, tx, true,
+			[]string{"type", "base", "quote", "long_exchange_name_id", "long_asset", "short_exchange_name_id", "short_asset", "timestamp"},
+			boil.Whitelist("long_rate", "short_rate", "gross_rate", "fee_rate", "net_rate"),
+			boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all funding opportunities detected between the start and
+// end dates ordered by time and then by net rate, highest first
+func GetInRange(startDate, endDate time.Time) (opportunities []Data, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		opportunities, err = getInRangeSQLite(startDate, endDate)
+		if err != nil {
+			return opportunities, fmt.Errorf("fundingopportunity.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		opportunities, err = getInRangePostgres(startDate, endDate)
+		if err != nil {
+			return opportunities, fmt.Errorf("fundingopportunity.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return opportunities, nil
+}
+
+func getInRangeSQLite(startDate, endDate time.Time) ([]Data, error) {
+	result, err := sqlite3.FundingOpportunities(
+		qm.Load(sqlite3.FundingOpportunityRels.LongExchangeName),
+		qm.Load(sqlite3.FundingOpportunityRels.ShortExchangeName),
+		qm.Where("timestamp BETWEEN ? AND ?",
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp, net_rate DESC"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	opportunities := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		opportunities[i] = Data{
+			ID:                  result[i].ID,
+			Type:                result[i].Type,
+			Base:                strings.ToUpper(result[i].Base),
+			Quote:               strings.ToUpper(result[i].Quote),
+			LongExchangeNameID:  result[i].LongExchangeNameID,
+			LongAsset:           strings.ToLower(result[i].LongAsset),
+			LongRate:            result[i].LongRate,
+			ShortExchangeNameID: result[i].ShortExchangeNameID,
+			ShortAsset:          strings.ToLower(result[i].ShortAsset),
+			ShortRate:           result[i].ShortRate,
+			GrossRate:           result[i].GrossRate,
+			FeeRate:             result[i].FeeRate,
+			NetRate:             result[i].NetRate,
+			Timestamp:           ts,
+		}
+		if result[i].R != nil {
+			if result[i].R.LongExchangeName != nil {
+				opportunities[i].LongExchange = strings.ToLower(result[i].R.LongExchangeName.Name)
+			}
+			if result[i].R.ShortExchangeName != nil {
+				opportunities[i].ShortExchange = strings.ToLower(result[i].R.ShortExchangeName.Name)
+			}
+		}
+	}
+	return opportunities, nil
+}
+
+func getInRangePostgres(startDate, endDate time.Time) ([]Data, error) {
+	result, err := postgres.FundingOpportunities(
+		qm.Load(postgres.FundingOpportunityRels.LongExchangeName),
+		qm.Load(postgres.FundingOpportunityRels.ShortExchangeName),
+		qm.Where("timestamp BETWEEN ? AND ?",
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp, net_rate DESC"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	opportunities := make([]Data, len(result))
+	for i := range result {
+		opportunities[i] = Data{
+			ID:                  result[i].ID,
+			Type:                result[i].Type,
+			Base:                strings.ToUpper(result[i].Base),
+			Quote:               strings.ToUpper(result[i].Quote),
+			LongExchangeNameID:  result[i].LongExchangeNameID,
+			LongAsset:           strings.ToLower(result[i].LongAsset),
+			LongRate:            result[i].LongRate,
+			ShortExchangeNameID: result[i].ShortExchangeNameID,
+			ShortAsset:          strings.ToLower(result[i].ShortAsset),
+			ShortRate:           result[i].ShortRate,
+			GrossRate:           result[i].GrossRate,
+			FeeRate:             result[i].FeeRate,
+			NetRate:             result[i].NetRate,
+			Timestamp:           result[i].Timestamp.UTC(),
+		}
+		if result[i].R != nil {
+			if result[i].R.LongExchangeName != nil {
+				opportunities[i].LongExchange = strings.ToLower(result[i].R.LongExchangeName.Name)
+			}
+			if result[i].R.ShortExchangeName != nil {
+				opportunities[i].ShortExchange = strings.ToLower(result[i].R.ShortExchangeName.Name)
+			}
+		}
+	}
+	return opportunities, nil
+}
@@ -0,0 +1,142 @@
+package fundingopportunity
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestFundingOpportunities(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+			require.NoError(t, exchange.InsertMany(testExchanges))
+
+			assert.ErrorIs(t, Insert(Data{}), errTypeUnset)
+			assert.ErrorIs(t, Insert(Data{Type: "CROSS_EXCHANGE", LongExchange: testExchanges[0].Name}), errExchangeNameUnset)
+
+			firstTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			opportunities := make([]Data, 3)
+			for i := range opportunities {
+				opportunities[i] = Data{
+					Type:          "CROSS_EXCHANGE",
+					Base:          "btc",
+					Quote:         "usdt",
+					LongExchange:  testExchanges[0].Name,
+					LongAsset:     "USDTMARGINEDFUTURES",
+					LongRate:      5,
+					ShortExchange: testExchanges[1].Name,
+					ShortAsset:    "perpetualswap",
+					ShortRate:     float64(10 + i),
+					GrossRate:     float64(5 + i),
+					FeeRate:       1,
+					NetRate:       float64(4 + i),
+					Timestamp:     firstTime.Add(time.Hour * time.Duration(i)),
+				}
+			}
+			opportunities = append(opportunities, Data{
+				Type:          "SPOT_PERPETUAL",
+				Base:          "BTC",
+				Quote:         "USDT",
+				LongExchange:  testExchanges[1].Name,
+				LongAsset:     "spot",
+				ShortExchange: testExchanges[1].Name,
+				ShortAsset:    "perpetualswap",
+				ShortRate:     10,
+				GrossRate:     10,
+				FeeRate:       2,
+				NetRate:       8,
+				Timestamp:     firstTime,
+			})
+			require.NoError(t, Insert(opportunities...))
+
+			opportunities[0].ID = ""
+			opportunities[0].NetRate = 3
+			require.NoError(t, Insert(opportunities[0]), "inserting an existing opportunity must not error")
+
+			resp, err := GetInRange(firstTime, firstTime.Add(time.Hour))
+			require.NoError(t, err)
+			require.Len(t, resp, 3, "existing opportunities should be replaced rather than duplicated")
+			assert.Equal(t, "SPOT_PERPETUAL", resp[0].Type, "opportunities at the same time should be ordered by net rate")
+			assert.Equal(t, testExchanges[1].Name, resp[0].LongExchange)
+			assert.Equal(t, "spot", resp[0].LongAsset)
+			assert.Equal(t, 3.0, resp[1].NetRate, "the opportunity net rate should be updated")
+			assert.Equal(t, testExchanges[0].Name, resp[1].LongExchange)
+			assert.Equal(t, testExchanges[1].Name, resp[1].ShortExchange)
+			assert.Equal(t, "BTC", resp[1].Base)
+			assert.Equal(t, "usdtmarginedfutures", resp[1].LongAsset)
+			assert.Equal(t, firstTime.Add(time.Hour), resp[2].Timestamp)
+
+			resp, err = GetInRange(firstTime.Add(time.Hour*24), firstTime.Add(time.Hour*48))
+			require.NoError(t, err)
+			assert.Empty(t, resp)
+
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
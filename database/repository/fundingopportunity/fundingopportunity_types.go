@@ -0,0 +1,33 @@
+package fundingopportunity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+	errTypeUnset         = errors.New("opportunity type not set, cannot insert")
+)
+
+// Data defines a funding rate carry opportunity in its simplest db friendly
+// form. Rates are annualised percentages. The long leg pays LongRate and the
+// short leg receives ShortRate; a spot leg has a rate of zero
+type Data struct {
+	ID                  string
+	Type                string
+	Base                string
+	Quote               string
+	LongExchange        string
+	LongExchangeNameID  string
+	LongAsset           string
+	LongRate            float64
+	ShortExchange       string
+	ShortExchangeNameID string
+	ShortAsset          string
+	ShortRate           float64
+	GrossRate           float64
+	FeeRate             float64
+	NetRate             float64
+	Timestamp           time.Time
+}
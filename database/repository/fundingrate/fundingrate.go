@@ -0,0 +1,206 @@
+package fundingrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves funding rates to the database. A funding rate which already
+// exists for the exchange, asset, pair and timestamp is overwritten
+func Insert(rates ...Data) error {
+	for i := range rates {
+		if rates[i].ExchangeNameID == "" && rates[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(rates[i].Exchange)
+			if err != nil {
+				return err
+			}
+			rates[i].ExchangeNameID = exchangeUUID.String()
+		} else if rates[i].ExchangeNameID == "" && rates[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, rates...)
+	} else {
+		err = insertPostgres(ctx, tx, rates...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, rates ...Data) error {
+	for i := range rates {
+		if rates[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rates[i].ID = freshUUID.String()
+		}
+		tempRate := sqlite3.FundingRate{
+			ID:             rates[i].ID,
+			ExchangeNameID: rates[i].ExchangeNameID,
+			Base:           strings.ToUpper(rates[i].Base),
+			Quote:          strings.ToUpper(rates[i].Quote),
+			Asset:          strings.ToLower(rates[i].AssetType),
+			Rate:           rates[i].Rate,
+			Timestamp:      rates[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempRate.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, rates ...Data) error {
+	for i := range rates {
+		if rates[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rates[i].ID = freshUUID.String()
+		}
+		tempRate := postgres.FundingRate{
+			ID:             rates[i].ID,
+			ExchangeNameID: rates[i].ExchangeNameID,
+			Base:           strings.ToUpper(rates[i].Base),
+			Quote:          strings.ToUpper(rates[i].Quote),
+			Asset:          strings.ToLower(rates[i].AssetType),
+			Rate:           rates[i].Rate,
+			Timestamp:      rates[i].Timestamp.UTC(),
+		}
+		err := tempRate.Upsert(ctx, tx, true, []string{"exchange_name_id", "base", "quote", "asset", "timestamp"}, boil.Whitelist("rate"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all funding rates for an exchange, asset and pair
+// between the start and end dates ordered by time
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (rates []Data, err error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		rates, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return rates, fmt.Errorf("fundingrate.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		rates, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return rates, fmt.Errorf("fundingrate.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return rates, nil
+}
+
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sqlite3.FundingRates(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		rates[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Rate:           result[i].Rate,
+			Timestamp:      ts,
+		}
+	}
+	return rates, nil
+}
+
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := postgres.FundingRates(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Data, len(result))
+	for i := range result {
+		rates[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Rate:           result[i].Rate,
+			Timestamp:      result[i].Timestamp.UTC(),
+		}
+	}
+	return rates, nil
+}
@@ -0,0 +1,21 @@
+package fundingrate
+
+import (
+	"errors"
+	"time"
+)
+
+var errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+
+// Data defines a historical funding rate in its simplest
+// db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Rate           float64
+	Timestamp      time.Time
+}
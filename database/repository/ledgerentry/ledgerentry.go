@@ -0,0 +1,218 @@
+package ledgerentry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert writes ledger entries to the database. Entries are immutable once
+// written
+func (db *DBService) Insert(entries ...*Details) error {
+	if len(entries) == 0 {
+		return errNoEntries
+	}
+	for i := range entries {
+		if entries[i] == nil {
+			return errNoEntries
+		}
+		if entries[i].ID == "" {
+			return errInvalidEntryID
+		}
+		if entries[i].Exchange == "" {
+			return errExchangeNameNil
+		}
+		if entries[i].Timestamp.IsZero() {
+			return errTimestampUnset
+		}
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, entries...)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, entries...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetAll returns all stored ledger entries ordered by timestamp
+func (db *DBService) GetAll() ([]Details, error) {
+	var resp []Details
+	var err error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		resp, err = db.getAllSQLite()
+	case database.DBPostgreSQL:
+		resp, err = db.getAllPostgres()
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(resp, func(a, b Details) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return resp, nil
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, entries ...*Details) error {
+	for i := range entries {
+		tempEntry := &sqlite3.LedgerEntry{
+			ID:          entries[i].ID,
+			Exchange:    entries[i].Exchange,
+			Type:        entries[i].Type,
+			Base:        entries[i].Base,
+			Quote:       entries[i].Quote,
+			Side:        entries[i].Side,
+			OrderID:     entries[i].OrderID,
+			TradeID:     entries[i].TradeID,
+			Amount:      entries[i].Amount,
+			Price:       entries[i].Price,
+			Fee:         entries[i].Fee,
+			FeeCurrency: entries[i].FeeCurrency,
+			Fiat:        entries[i].Fiat,
+			FiatRate:    entries[i].FiatRate,
+			Timestamp:   entries[i].Timestamp.UTC().Format(time.RFC3339Nano),
+		}
+		if err := tempEntry.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, entries ...*Details) error {
+	for i := range entries {
+		tempEntry := &postgres.LedgerEntry{
+			ID:          entries[i].ID,
+			Exchange:    entries[i].Exchange,
+			Type:        entries[i].Type,
+			Base:        entries[i].Base,
+			Quote:       entries[i].Quote,
+			Side:        entries[i].Side,
+			OrderID:     entries[i].OrderID,
+			TradeID:     entries[i].TradeID,
+			Amount:      entries[i].Amount,
+			Price:       entries[i].Price,
+			Fee:         entries[i].Fee,
+			FeeCurrency: entries[i].FeeCurrency,
+			Fiat:        entries[i].Fiat,
+			FiatRate:    entries[i].FiatRate,
+			Timestamp:   entries[i].Timestamp.UTC(),
+		}
+		if err := tempEntry.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getAllSQLite() ([]Details, error) {
+	results, err := sqlite3.LedgerEntries().All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339Nano, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Details{
+			ID:          results[i].ID,
+			Exchange:    results[i].Exchange,
+			Type:        results[i].Type,
+			Base:        results[i].Base,
+			Quote:       results[i].Quote,
+			Side:        results[i].Side,
+			OrderID:     results[i].OrderID,
+			TradeID:     results[i].TradeID,
+			Amount:      results[i].Amount,
+			Price:       results[i].Price,
+			Fee:         results[i].Fee,
+			FeeCurrency: results[i].FeeCurrency,
+			Fiat:        results[i].Fiat,
+			FiatRate:    results[i].FiatRate,
+			Timestamp:   ts,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getAllPostgres() ([]Details, error) {
+	results, err := postgres.LedgerEntries().All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		resp[i] = Details{
+			ID:          results[i].ID,
+			Exchange:    results[i].Exchange,
+			Type:        results[i].Type,
+			Base:        results[i].Base,
+			Quote:       results[i].Quote,
+			Side:        results[i].Side,
+			OrderID:     results[i].OrderID,
+			TradeID:     results[i].TradeID,
+			Amount:      results[i].Amount,
+			Price:       results[i].Price,
+			Fee:         results[i].Fee,
+			FeeCurrency: results[i].FeeCurrency,
+			Fiat:        results[i].Fiat,
+			FiatRate:    results[i].FiatRate,
+			Timestamp:   results[i].Timestamp,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,144 @@
+package ledgerentry
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestInsertValidation(t *testing.T) {
+	t.Parallel()
+	db := &DBService{}
+	err := db.Insert()
+	assert.ErrorIs(t, err, errNoEntries)
+
+	err = db.Insert(nil)
+	assert.ErrorIs(t, err, errNoEntries)
+
+	err = db.Insert(&Details{})
+	assert.ErrorIs(t, err, errInvalidEntryID)
+
+	err = db.Insert(&Details{ID: "1"})
+	assert.ErrorIs(t, err, errExchangeNameNil)
+
+	err = db.Insert(&Details{ID: "1", Exchange: "one"})
+	assert.ErrorIs(t, err, errTimestampUnset)
+}
+
+func TestLedgerEntries(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			tt := time.Now().Truncate(time.Millisecond)
+			entries := []*Details{
+				{
+					ID:        "2",
+					Exchange:  "one",
+					Type:      "TRADE",
+					Base:      "BTC",
+					Quote:     "USDT",
+					Side:      "SELL",
+					OrderID:   "1337",
+					Amount:    0.5,
+					Price:     110,
+					Fiat:      "USD",
+					FiatRate:  1,
+					Timestamp: tt.Add(time.Millisecond * 500),
+				},
+				{
+					ID:        "1",
+					Exchange:  "two",
+					Type:      "DEPOSIT",
+					Base:      "BTC",
+					Amount:    1,
+					Price:     100,
+					Fee:       0.001,
+					Fiat:      "USD",
+					Timestamp: tt,
+				},
+			}
+			require.NoError(t, db.Insert(entries...))
+			assert.Error(t, db.Insert(entries[0]), "Insert should error on an existing entry")
+
+			results, err := db.GetAll()
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.Equal(t, "1", results[0].ID, "entries should be ordered by timestamp")
+			assert.True(t, tt.Equal(results[0].Timestamp))
+			assert.Equal(t, 0.001, results[0].Fee)
+			assert.Equal(t, "1337", results[1].OrderID)
+			assert.Equal(t, "USDT", results[1].Quote)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package ledgerentry
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNoEntries       = errors.New("no ledger entries provided")
+	errInvalidEntryID  = errors.New("invalid ledger entry id")
+	errExchangeNameNil = errors.New("exchange name not set")
+	errTimestampUnset  = errors.New("ledger entry timestamp not set")
+)
+
+// Details is a DTO for spot ledger entries stored in the database. Base
+// holds the currency of deposits and withdrawals, which have no quote
+type Details struct {
+	ID          string
+	Exchange    string
+	Type        string
+	Base        string
+	Quote       string
+	Side        string
+	OrderID     string
+	TradeID     string
+	Amount      float64
+	Price       float64
+	Fee         float64
+	FeeCurrency string
+	Fiat        string
+	FiatRate    float64
+	Timestamp   time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using ledger entry database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(...*Details) error
+	GetAll() ([]Details, error)
+}
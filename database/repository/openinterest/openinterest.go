@@ -0,0 +1,206 @@
+package openinterest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves open interest to the database. Open interest which already
+// exists for the exchange, asset, pair and timestamp is overwritten
+func Insert(openInterest ...Data) error {
+	for i := range openInterest {
+		if openInterest[i].ExchangeNameID == "" && openInterest[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(openInterest[i].Exchange)
+			if err != nil {
+				return err
+			}
+			openInterest[i].ExchangeNameID = exchangeUUID.String()
+		} else if openInterest[i].ExchangeNameID == "" && openInterest[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, openInterest...)
+	} else {
+		err = insertPostgres(ctx, tx, openInterest...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, openInterest ...Data) error {
+	for i := range openInterest {
+		if openInterest[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			openInterest[i].ID = freshUUID.String()
+		}
+		tempOpenInterest := sqlite3.OpenInterest{
+			ID:             openInterest[i].ID,
+			ExchangeNameID: openInterest[i].ExchangeNameID,
+			Base:           strings.ToUpper(openInterest[i].Base),
+			Quote:          strings.ToUpper(openInterest[i].Quote),
+			Asset:          strings.ToLower(openInterest[i].AssetType),
+			OpenInterest:   openInterest[i].OpenInterest,
+			Timestamp:      openInterest[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempOpenInterest.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, openInterest ...Data) error {
+	for i := range openInterest {
+		if openInterest[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			openInterest[i].ID = freshUUID.String()
+		}
+		tempOpenInterest := postgres.OpenInterest{
+			ID:             openInterest[i].ID,
+			ExchangeNameID: openInterest[i].ExchangeNameID,
+			Base:           strings.ToUpper(openInterest[i].Base),
+			Quote:          strings.ToUpper(openInterest[i].Quote),
+			Asset:          strings.ToLower(openInterest[i].AssetType),
+			OpenInterest:   openInterest[i].OpenInterest,
+			Timestamp:      openInterest[i].Timestamp.UTC(),
+		}
+		err := tempOpenInterest.Upsert(ctx, tx, true, []string{"exchange_name_id", "base", "quote", "asset", "timestamp"}, boil.Whitelist("open_interest"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all open interest for an exchange, asset and pair
+// between the start and end dates ordered by time
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (openInterest []Data, err error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		openInterest, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return openInterest, fmt.Errorf("openinterest.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		openInterest, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return openInterest, fmt.Errorf("openinterest.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return openInterest, nil
+}
+
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sqlite3.OpenInterests(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	openInterest := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		openInterest[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			OpenInterest:   result[i].OpenInterest,
+			Timestamp:      ts,
+		}
+	}
+	return openInterest, nil
+}
+
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := postgres.OpenInterests(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	openInterest := make([]Data, len(result))
+	for i := range result {
+		openInterest[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			OpenInterest:   result[i].OpenInterest,
+			Timestamp:      result[i].Timestamp.UTC(),
+		}
+	}
+	return openInterest, nil
+}
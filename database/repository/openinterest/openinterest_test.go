@@ -0,0 +1,128 @@
+package openinterest
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestInsertValidation(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, Insert(Data{}), errExchangeNameUnset)
+}
+
+func TestOpenInterest(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+			require.NoError(t, exchange.InsertMany(testExchanges))
+
+			firstTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			openInterest := make([]Data, 6)
+			for i := range openInterest {
+				openInterest[i] = Data{
+					Exchange:     testExchanges[0].Name,
+					Base:         currency.BTC.String(),
+					Quote:        currency.USDT.String(),
+					AssetType:    asset.USDTMarginedFutures.String(),
+					OpenInterest: 1000 * float64(i+1),
+					Timestamp:    firstTime.Add(time.Hour * time.Duration(i)),
+				}
+			}
+			require.NoError(t, Insert(openInterest...))
+
+			openInterest[1].ID = ""
+			openInterest[1].OpenInterest = 1337
+			require.NoError(t, Insert(openInterest[1]), "inserting existing open interest must not error")
+
+			resp, err := GetInRange(
+				testExchanges[0].Name,
+				asset.USDTMarginedFutures.String(),
+				currency.BTC.String(),
+				currency.USDT.String(),
+				firstTime,
+				firstTime.Add(time.Hour*2))
+			require.NoError(t, err)
+			require.Len(t, resp, 3, "existing open interest should be replaced rather than duplicated")
+			assert.Equal(t, firstTime, resp[0].Timestamp)
+			assert.Equal(t, 1337.0, resp[1].OpenInterest, "the open interest should be updated")
+			assert.Equal(t, asset.USDTMarginedFutures.String(), resp[2].AssetType)
+
+			resp, err = GetInRange(
+				testExchanges[0].Name,
+				asset.Spot.String(),
+				currency.BTC.String(),
+				currency.USDT.String(),
+				firstTime,
+				firstTime.Add(time.Hour*48))
+			require.NoError(t, err)
+			assert.Empty(t, resp)
+
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
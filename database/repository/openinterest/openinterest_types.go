@@ -0,0 +1,21 @@
+package openinterest
+
+import (
+	"errors"
+	"time"
+)
+
+var errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+
+// Data defines the open interest of a contract at a point in time in its
+// simplest db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	OpenInterest   float64
+	Timestamp      time.Time
+}
@@ -0,0 +1,468 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates orders in the database. Orders are matched by
+// exchange and exchange order ID. An event is recorded for every new order and
+// whenever the status, amounts or price of an existing order changes
+func (db *DBService) Upsert(orders ...*Details) error {
+	if len(orders) == 0 {
+		return errNoOrders
+	}
+	for i := range orders {
+		if orders[i] == nil {
+			return errNoOrders
+		}
+		if orders[i].Exchange == "" {
+			return errExchangeNameNil
+		}
+		if orders[i].OrderID == "" {
+			return errInvalidOrderID
+		}
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns an order by its internal ID
+func (db *DBService) GetByID(id string) (*Details, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByIDSQLite(id)
+	case database.DBPostgreSQL:
+		return db.getByIDPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetByStatus returns all orders which match any of the supplied statuses
+func (db *DBService) GetByStatus(statuses ...string) ([]Details, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByStatusSQLite(statuses)
+	case database.DBPostgreSQL:
+		return db.getByStatusPostgres(statuses)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetEvents returns the audit trail of an order by its internal ID
+func (db *DBService) GetEvents(id string) ([]Event, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getEventsSQLite(id)
+	case database.DBPostgreSQL:
+		return db.getEventsPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	for i := range orders {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+		}
+		existing, err := sqlite3.Orders(
+			qm.Where("exchange_name_id = ? AND order_id = ?", exch.ID, orders[i].OrderID)).One(ctx, tx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		event := EventInserted
+		tempOrder := existing
+		if tempOrder == nil {
+			if orders[i].ID == "" {
+				var newID uuid.UUID
+				newID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				orders[i].ID = newID.String()
+			}
+			tempOrder = &sqlite3.Order{
+				ID:             orders[i].ID,
+				ExchangeNameID: exch.ID,
+				OrderID:        orders[i].OrderID,
+			}
+		} else {
+			event = EventUpdated
+			orders[i].ID = existing.ID
+			if existing.Status == orders[i].Status &&
+				existing.Price == orders[i].Price &&
+				existing.Amount == orders[i].Amount &&
+				existing.ExecutedAmount == orders[i].ExecutedAmount {
+				event = ""
+			}
+		}
+		tempOrder.Asset = strings.ToLower(orders[i].Asset)
+		tempOrder.Base = strings.ToUpper(orders[i].Base)
+		tempOrder.Quote = strings.ToUpper(orders[i].Quote)
+		tempOrder.Side = orders[i].Side
+		tempOrder.Type = orders[i].Type
+		tempOrder.Status = orders[i].Status
+		tempOrder.Price = orders[i].Price
+		tempOrder.Amount = orders[i].Amount
+		tempOrder.ExecutedAmount = orders[i].ExecutedAmount
+		tempOrder.RemainingAmount = orders[i].RemainingAmount
+		tempOrder.AverageExecutedPrice = orders[i].AverageExecutedPrice
+		tempOrder.Fee = orders[i].Fee
+		tempOrder.Cost = orders[i].Cost
+		tempOrder.Date = orders[i].Date.UTC().Format(time.RFC3339)
+		tempOrder.LastUpdated = orders[i].LastUpdated.UTC().Format(time.RFC3339)
+		tempOrder.ClientOrderID.String = orders[i].ClientOrderID
+		tempOrder.ClientOrderID.Valid = orders[i].ClientOrderID != ""
+
+		if existing == nil {
+			err = tempOrder.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempOrder.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+		if event == "" {
+			continue
+		}
+		err = tempOrder.AddOrderEvents(ctx, tx, true, &sqlite3.OrderEvent{
+			Event:                event,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			CreatedAt:            time.Now().UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	for i := range orders {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+		}
+		existing, err := postgres.Orders(
+			qm.Where("exchange_name_id = ? AND order_id = ?", exch.ID, orders[i].OrderID)).One(ctx, tx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		event := EventInserted
+		tempOrder := existing
+		if tempOrder == nil {
+			if orders[i].ID == "" {
+				var newID uuid.UUID
+				newID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				orders[i].ID = newID.String()
+			}
+			tempOrder = &postgres.Order{
+				ID:             orders[i].ID,
+				ExchangeNameID: exch.ID,
+				OrderID:        orders[i].OrderID,
+			}
+		} else {
+			event = EventUpdated
+			orders[i].ID = existing.ID
+			if existing.Status == orders[i].Status &&
+				existing.Price == orders[i].Price &&
+				existing.Amount == orders[i].Amount &&
+				existing.ExecutedAmount == orders[i].ExecutedAmount {
+				event = ""
+			}
+		}
+		tempOrder.Asset = strings.ToLower(orders[i].Asset)
+		tempOrder.Base = strings.ToUpper(orders[i].Base)
+		tempOrder.Quote = strings.ToUpper(orders[i].Quote)
+		tempOrder.Side = orders[i].Side
+		tempOrder.Type = orders[i].Type
+		tempOrder.Status = orders[i].Status
+		tempOrder.Price = orders[i].Price
+		tempOrder.Amount = orders[i].Amount
+		tempOrder.ExecutedAmount = orders[i].ExecutedAmount
+		tempOrder.RemainingAmount = orders[i].RemainingAmount
+		tempOrder.AverageExecutedPrice = orders[i].AverageExecutedPrice
+		tempOrder.Fee = orders[i].Fee
+		tempOrder.Cost = orders[i].Cost
+		tempOrder.Date = orders[i].Date.UTC()
+		tempOrder.LastUpdated = orders[i].LastUpdated.UTC()
+		tempOrder.ClientOrderID.String = orders[i].ClientOrderID
+		tempOrder.ClientOrderID.Valid = orders[i].ClientOrderID != ""
+
+		if existing == nil {
+			err = tempOrder.Insert(ctx, tx, boil.Infer())
+		} else {
+			_, err = tempOrder.Update(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+		if event == "" {
+			continue
+		}
+		err = tempOrder.AddOrderEvents(ctx, tx, true, &postgres.OrderEvent{
+			Event:                event,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			CreatedAt:            time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getByIDSQLite(id string) (*Details, error) {
+	result, err := sqlite3.Orders(
+		qm.Where("id = ?", id),
+		qm.Load(sqlite3.OrderRels.ExchangeName)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createSQLiteOrderResponse(result)
+}
+
+func (db *DBService) getByIDPostgres(id string) (*Details, error) {
+	result, err := postgres.Orders(
+		qm.Where("id = ?", id),
+		qm.Load(postgres.OrderRels.ExchangeName)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createPostgresOrderResponse(result), nil
+}
+
+func (db *DBService) getByStatusSQLite(statuses []string) ([]Details, error) {
+	results, err := sqlite3.Orders(
+		qm.WhereIn("status in ?", stringsToInterfaces(statuses)...),
+		qm.Load(sqlite3.OrderRels.ExchangeName),
+		qm.OrderBy("date")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		var o *Details
+		o, err = createSQLiteOrderResponse(results[i])
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = *o
+	}
+	return resp, nil
+}
+
+func (db *DBService) getByStatusPostgres(statuses []string) ([]Details, error) {
+	results, err := postgres.Orders(
+		qm.WhereIn("status in ?", stringsToInterfaces(statuses)...),
+		qm.Load(postgres.OrderRels.ExchangeName),
+		qm.OrderBy("date")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		resp[i] = *createPostgresOrderResponse(results[i])
+	}
+	return resp, nil
+}
+
+func (db *DBService) getEventsSQLite(id string) ([]Event, error) {
+	results, err := sqlite3.OrderEvents(
+		qm.Where("order_id = ?", id),
+		qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		var ts time.Time
+		ts, err = time.Parse(time.RFC3339, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Event{
+			ID:                   results[i].ID,
+			OrderID:              results[i].OrderID,
+			Event:                results[i].Event,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			CreatedAt:            ts,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getEventsPostgres(id string) ([]Event, error) {
+	results, err := postgres.OrderEvents(
+		qm.Where("order_id = ?", id),
+		qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		resp[i] = Event{
+			ID:                   results[i].ID,
+			OrderID:              results[i].OrderID,
+			Event:                results[i].Event,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			CreatedAt:            results[i].CreatedAt,
+		}
+	}
+	return resp, nil
+}
+
+func createSQLiteOrderResponse(result *sqlite3.Order) (*Details, error) {
+	date, err := time.Parse(time.RFC3339, result.Date)
+	if err != nil {
+		return nil, err
+	}
+	lastUpdated, err := time.Parse(time.RFC3339, result.LastUpdated)
+	if err != nil {
+		return nil, err
+	}
+	var exchangeName string
+	if result.R != nil && result.R.ExchangeName != nil {
+		exchangeName = result.R.ExchangeName.Name
+	}
+	return &Details{
+		ID:                   result.ID,
+		Exchange:             exchangeName,
+		OrderID:              result.OrderID,
+		ClientOrderID:        result.ClientOrderID.String,
+		Asset:                result.Asset,
+		Base:                 result.Base,
+		Quote:                result.Quote,
+		Side:                 result.Side,
+		Type:                 result.Type,
+		Status:               result.Status,
+		Price:                result.Price,
+		Amount:               result.Amount,
+		ExecutedAmount:       result.ExecutedAmount,
+		RemainingAmount:      result.RemainingAmount,
+		AverageExecutedPrice: result.AverageExecutedPrice,
+		Fee:                  result.Fee,
+		Cost:                 result.Cost,
+		Date:                 date,
+		LastUpdated:          lastUpdated,
+	}, nil
+}
+
+func createPostgresOrderResponse(result *postgres.Order) *Details {
+	var exchangeName string
+	if result.R != nil && result.R.ExchangeName != nil {
+		exchangeName = result.R.ExchangeName.Name
+	}
+	return &Details{
+		ID:                   result.ID,
+		Exchange:             exchangeName,
+		OrderID:              result.OrderID,
+		ClientOrderID:        result.ClientOrderID.String,
+		Asset:                result.Asset,
+		Base:                 result.Base,
+		Quote:                result.Quote,
+		Side:                 result.Side,
+		Type:                 result.Type,
+		Status:               result.Status,
+		Price:                result.Price,
+		Amount:               result.Amount,
+		ExecutedAmount:       result.ExecutedAmount,
+		RemainingAmount:      result.RemainingAmount,
+		AverageExecutedPrice: result.AverageExecutedPrice,
+		Fee:                  result.Fee,
+		Cost:                 result.Cost,
+		Date:                 result.Date.UTC(),
+		LastUpdated:          result.LastUpdated.UTC(),
+	}
+}
+
+func stringsToInterfaces(s []string) []any {
+	resp := make([]any, len(s))
+	for i := range s {
+		resp[i] = s[i]
+	}
+	return resp
+}
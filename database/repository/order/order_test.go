@@ -0,0 +1,187 @@
+package order
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestUpsertValidation(t *testing.T) {
+	t.Parallel()
+	db := &DBService{}
+	err := db.Upsert()
+	assert.ErrorIs(t, err, errNoOrders)
+
+	err = db.Upsert(nil)
+	assert.ErrorIs(t, err, errNoOrders)
+
+	err = db.Upsert(&Details{})
+	assert.ErrorIs(t, err, errExchangeNameNil)
+
+	err = db.Upsert(&Details{Exchange: "one"})
+	assert.ErrorIs(t, err, errInvalidOrderID)
+}
+
+func TestOrders(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			tt := time.Now().Truncate(time.Second).UTC()
+			orders := make([]*Details, 5)
+			for i := range orders {
+				orders[i] = &Details{
+					Exchange:    testExchanges[0].Name,
+					OrderID:     fmt.Sprintf("order%v", i),
+					Asset:       "spot",
+					Base:        "btc",
+					Quote:       "usd",
+					Side:        "BUY",
+					Type:        "LIMIT",
+					Status:      "NEW",
+					Price:       1337,
+					Amount:      float64(i + 1),
+					Date:        tt,
+					LastUpdated: tt,
+				}
+			}
+			orders[0].ClientOrderID = "client"
+			err = db.Upsert(orders...)
+			require.NoError(t, err)
+			for i := range orders {
+				assert.NotEmpty(t, orders[i].ID, "Upsert should set the order ID")
+			}
+
+			resp, err := db.GetByID(orders[0].ID)
+			require.NoError(t, err)
+			assert.Equal(t, testExchanges[0].Name, resp.Exchange)
+			assert.Equal(t, "client", resp.ClientOrderID)
+			assert.Equal(t, "BTC", resp.Base)
+			assert.Equal(t, tt, resp.Date)
+
+			insertedID := orders[0].ID
+			orders[0].ID = ""
+			orders[0].Status = "FILLED"
+			orders[0].ExecutedAmount = 1
+			orders[1].LastUpdated = tt.Add(time.Second)
+			err = db.Upsert(orders[0], orders[1])
+			require.NoError(t, err)
+			assert.Equal(t, insertedID, orders[0].ID, "Upsert should keep the existing order ID")
+
+			events, err := db.GetEvents(orders[0].ID)
+			require.NoError(t, err)
+			require.Len(t, events, 2)
+			assert.Equal(t, EventInserted, events[0].Event)
+			assert.Equal(t, EventUpdated, events[1].Event)
+			assert.Equal(t, "FILLED", events[1].Status)
+
+			events, err = db.GetEvents(orders[1].ID)
+			require.NoError(t, err)
+			assert.Len(t, events, 1, "unchanged order state should not record an event")
+
+			results, err := db.GetByStatus("NEW")
+			require.NoError(t, err)
+			assert.Len(t, results, 4)
+
+			results, err = db.GetByStatus("NEW", "FILLED")
+			require.NoError(t, err)
+			assert.Len(t, results, 5)
+
+			orders[2].Exchange = "fake"
+			err = db.Upsert(orders[2])
+			assert.Error(t, err)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Order event types recorded against an order every time it is upserted
+const (
+	EventInserted = "inserted"
+	EventUpdated  = "updated"
+)
+
+var (
+	errNoOrders        = errors.New("no orders provided")
+	errInvalidOrderID  = errors.New("invalid order id")
+	errExchangeNameNil = errors.New("exchange name not set")
+)
+
+// Details is a DTO for order data stored in the database
+type Details struct {
+	ID                   string
+	Exchange             string
+	OrderID              string
+	ClientOrderID        string
+	Asset                string
+	Base                 string
+	Quote                string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Fee                  float64
+	Cost                 float64
+	Date                 time.Time
+	LastUpdated          time.Time
+}
+
+// Event is a DTO for an audit trail entry of an order's state when it was
+// written to the database
+type Event struct {
+	ID                   int64
+	OrderID              string
+	Event                string
+	Status               string
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	AverageExecutedPrice float64
+	CreatedAt            time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Details) error
+	GetByID(string) (*Details, error)
+	GetByStatus(...string) ([]Details, error)
+	GetEvents(string) ([]Event, error)
+}
@@ -0,0 +1,223 @@
+package orderbooksnapshot
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves orderbook snapshot levels to the database. A level which
+// already exists for the exchange, asset, pair, side and timestamp is
+// overwritten
+func Insert(levels ...Data) error {
+	for i := range levels {
+		levels[i].Side = strings.ToLower(levels[i].Side)
+		if levels[i].Side != Bid && levels[i].Side != Ask {
+			return fmt.Errorf("%w: %q", errInvalidSide, levels[i].Side)
+		}
+		if levels[i].ExchangeNameID == "" && levels[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(levels[i].Exchange)
+			if err != nil {
+				return err
+			}
+			levels[i].ExchangeNameID = exchangeUUID.String()
+		} else if levels[i].ExchangeNameID == "" && levels[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, levels...)
+	} else {
+		err = insertPostgres(ctx, tx, levels...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, levels ...Data) error {
+	for i := range levels {
+		if levels[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			levels[i].ID = freshUUID.String()
+		}
+		tempLevel := sqlite3.OrderbookSnapshot{
+			ID:             levels[i].ID,
+			ExchangeNameID: levels[i].ExchangeNameID,
+			Base:           strings.ToUpper(levels[i].Base),
+			Quote:          strings.ToUpper(levels[i].Quote),
+			Asset:          strings.ToLower(levels[i].AssetType),
+			Side:           levels[i].Side,
+			Level:          int64(levels[i].Level),
+			Price:          levels[i].Price,
+			Amount:         levels[i].Amount,
+			Timestamp:      levels[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempLevel.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, levels ...Data) error {
+	for i := range levels {
+		if levels[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			levels[i].ID = freshUUID.String()
+		}
+		tempLevel := postgres.OrderbookSnapshot{
+			ID:             levels[i].ID,
+			ExchangeNameID: levels[i].ExchangeNameID,
+			Base:           strings.ToUpper(levels[i].Base),
+			Quote:          strings.ToUpper(levels[i].Quote),
+			Asset:          strings.ToLower(levels[i].AssetType),
+			Side:           levels[i].Side,
+			Level:          levels[i].Level,
+			Price:          levels[i].Price,
+			Amount:         levels[i].Amount,
+			Timestamp:      levels[i].Timestamp.UTC(),
+		}
+		err := tempLevel.Upsert(ctx, tx, true, []string{"exchange_name_id", "base", "quote", "asset", "side", "level", "timestamp"}, boil.Whitelist("price", "amount"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all orderbook snapshot levels for an exchange, asset and
+// pair between the start and end dates ordered by time, side and level
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (levels []Data, err error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		levels, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return levels, fmt.Errorf("orderbooksnapshot.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		levels, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return levels, fmt.Errorf("orderbooksnapshot.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return levels, nil
+}
+
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sqlite3.OrderbookSnapshots(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp, side, level"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	levels := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		levels[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Side:           result[i].Side,
+			Level:          int(result[i].Level),
+			Price:          result[i].Price,
+			Amount:         result[i].Amount,
+			Timestamp:      ts,
+		}
+	}
+	return levels, nil
+}
+
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := postgres.OrderbookSnapshots(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exchangeUUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp, side, level"),
+	).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	levels := make([]Data, len(result))
+	for i := range result {
+		levels[i] = Data{
+			ID:             result[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           strings.ToUpper(result[i].Base),
+			Quote:          strings.ToUpper(result[i].Quote),
+			AssetType:      strings.ToLower(result[i].Asset),
+			Side:           result[i].Side,
+			Level:          result[i].Level,
+			Price:          result[i].Price,
+			Amount:         result[i].Amount,
+			Timestamp:      result[i].Timestamp.UTC(),
+		}
+	}
+	return levels, nil
+}
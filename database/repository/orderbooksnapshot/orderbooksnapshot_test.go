@@ -0,0 +1,146 @@
+package orderbooksnapshot
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestInsertValidation(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, Insert(Data{}), errInvalidSide)
+	assert.ErrorIs(t, Insert(Data{Side: Bid}), errExchangeNameUnset)
+}
+
+func TestOrderbookSnapshots(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+			require.NoError(t, exchange.InsertMany(testExchanges))
+
+			firstTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			var levels []Data
+			for i := range 2 {
+				for j := range 3 {
+					levels = append(levels, Data{
+						Exchange:  testExchanges[0].Name,
+						Base:      currency.BTC.String(),
+						Quote:     currency.USDT.String(),
+						AssetType: asset.Spot.String(),
+						Side:      Ask,
+						Level:     j,
+						Price:     100 + float64(j),
+						Amount:    1,
+						Timestamp: firstTime.Add(time.Minute * time.Duration(i)),
+					}, Data{
+						Exchange:  testExchanges[0].Name,
+						Base:      currency.BTC.String(),
+						Quote:     currency.USDT.String(),
+						AssetType: asset.Spot.String(),
+						Side:      "BID",
+						Level:     j,
+						Price:     99 - float64(j),
+						Amount:    1,
+						Timestamp: firstTime.Add(time.Minute * time.Duration(i)),
+					})
+				}
+			}
+			require.NoError(t, Insert(levels...))
+
+			levels[0].ID = ""
+			levels[0].Amount = 1337
+			require.NoError(t, Insert(levels[0]), "inserting an existing level must not error")
+
+			resp, err := GetInRange(
+				testExchanges[0].Name,
+				asset.Spot.String(),
+				currency.BTC.String(),
+				currency.USDT.String(),
+				firstTime,
+				firstTime)
+			require.NoError(t, err)
+			require.Len(t, resp, 6, "existing levels should be replaced rather than duplicated")
+			assert.Equal(t, Ask, resp[0].Side)
+			assert.Equal(t, 1337.0, resp[0].Amount, "the level should be updated")
+			assert.Equal(t, Bid, resp[3].Side, "sides should be stored in lower case")
+			assert.Equal(t, 0, resp[3].Level)
+			assert.Equal(t, 97.0, resp[5].Price)
+
+			resp, err = GetInRange(
+				testExchanges[0].Name,
+				asset.USDTMarginedFutures.String(),
+				currency.BTC.String(),
+				currency.USDT.String(),
+				firstTime,
+				firstTime.Add(time.Hour))
+			require.NoError(t, err)
+			assert.Empty(t, resp)
+
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
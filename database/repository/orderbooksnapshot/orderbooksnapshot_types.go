@@ -0,0 +1,33 @@
+package orderbooksnapshot
+
+import (
+	"errors"
+	"time"
+)
+
+// Orderbook sides
+const (
+	Bid = "bid"
+	Ask = "ask"
+)
+
+var (
+	errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+	errInvalidSide       = errors.New("orderbook side must be bid or ask, cannot insert")
+)
+
+// Data defines a single price level of an orderbook snapshot in its simplest
+// db friendly form. Level zero is the best price of its side
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Side           string
+	Level          int
+	Price          float64
+	Amount         float64
+	Timestamp      time.Time
+}
@@ -0,0 +1,241 @@
+package portfoliosnapshot
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert writes a portfolio snapshot and its balances to the database. An ID
+// is generated when one is not set
+func (db *DBService) Insert(s *Details) error {
+	if s == nil {
+		return errNilSnapshot
+	}
+	if s.Fiat == "" {
+		return errFiatUnset
+	}
+	if s.Timestamp.IsZero() {
+		return errTimestampUnset
+	}
+	for i := range s.Balances {
+		if s.Balances[i].Exchange == "" && s.Balances[i].Address == "" {
+			return errBalanceSourceNil
+		}
+		if s.Balances[i].Currency == "" {
+			return errCurrencyUnset
+		}
+	}
+	if s.ID == "" {
+		newID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		s.ID = newID.String()
+	} else if _, err := uuid.FromString(s.ID); err != nil {
+		return fmt.Errorf("%w: %w", errSnapshotIDInvalid, err)
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, s)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, s)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns the portfolio snapshots and their balances taken between
+// the start and end dates inclusive, ordered by timestamp
+func (db *DBService) GetInRange(start, end time.Time) ([]Details, error) {
+	if end.Before(start) {
+		return nil, errInvalidDateRange
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(start, end)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(start, end)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, s *Details) error {
+	tempSnapshot := &sqlite3.PortfolioSnapshot{
+		ID:        s.ID,
+		Fiat:      s.Fiat,
+		Value:     s.Value,
+		Timestamp: s.Timestamp.UTC().Format(time.RFC3339),
+	}
+	if err := tempSnapshot.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+	if len(s.Balances) == 0 {
+		return nil
+	}
+	balances := make([]*sqlite3.PortfolioSnapshotBalance, len(s.Balances))
+	for i := range s.Balances {
+		balances[i] = &sqlite3.PortfolioSnapshotBalance{
+			Exchange: s.Balances[i].Exchange,
+			Address:  s.Balances[i].Address,
+			Currency: s.Balances[i].Currency,
+			Amount:   s.Balances[i].Amount,
+			Price:    s.Balances[i].Price,
+			Value:    s.Balances[i].Value,
+		}
+	}
+	return tempSnapshot.AddPortfolioSnapshotBalances(ctx, tx, true, balances...)
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, s *Details) error {
+	tempSnapshot := &postgres.PortfolioSnapshot{
+		ID:        s.ID,
+		Fiat:      s.Fiat,
+		Value:     s.Value,
+		Timestamp: s.Timestamp.UTC(),
+	}
+	if err := tempSnapshot.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+	if len(s.Balances) == 0 {
+		return nil
+	}
+	balances := make([]*postgres.PortfolioSnapshotBalance, len(s.Balances))
+	for i := range s.Balances {
+		balances[i] = &postgres.PortfolioSnapshotBalance{
+			Exchange: s.Balances[i].Exchange,
+			Address:  s.Balances[i].Address,
+			Currency: s.Balances[i].Currency,
+			Amount:   s.Balances[i].Amount,
+			Price:    s.Balances[i].Price,
+			Value:    s.Balances[i].Value,
+		}
+	}
+	return tempSnapshot.AddPortfolioSnapshotBalances(ctx, tx, true, balances...)
+}
+
+func (db *DBService) getInRangeSQLite(start, end time.Time) ([]Details, error) {
+	results, err := sqlite3.PortfolioSnapshots(
+		qm.Where("timestamp BETWEEN ? AND ?",
+			start.UTC().Format(time.RFC3339),
+			end.UTC().Format(time.RFC3339)),
+		qm.Load(sqlite3.PortfolioSnapshotRels.PortfolioSnapshotBalances, qm.OrderBy("id")),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Details{
+			ID:        results[i].ID,
+			Fiat:      results[i].Fiat,
+			Value:     results[i].Value,
+			Timestamp: ts,
+		}
+		if results[i].R == nil {
+			continue
+		}
+		balances := results[i].R.PortfolioSnapshotBalances
+		resp[i].Balances = make([]Balance, len(balances))
+		for j := range balances {
+			resp[i].Balances[j] = Balance{
+				Exchange: balances[j].Exchange,
+				Address:  balances[j].Address,
+				Currency: balances[j].Currency,
+				Amount:   balances[j].Amount,
+				Price:    balances[j].Price,
+				Value:    balances[j].Value,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(start, end time.Time) ([]Details, error) {
+	results, err := postgres.PortfolioSnapshots(
+		qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()),
+		qm.Load(postgres.PortfolioSnapshotRels.PortfolioSnapshotBalances, qm.OrderBy("id")),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		resp[i] = Details{
+			ID:        results[i].ID,
+			Fiat:      results[i].Fiat,
+			Value:     results[i].Value,
+			Timestamp: results[i].Timestamp,
+		}
+		if results[i].R == nil {
+			continue
+		}
+		balances := results[i].R.PortfolioSnapshotBalances
+		resp[i].Balances = make([]Balance, len(balances))
+		for j := range balances {
+			resp[i].Balances[j] = Balance{
+				Exchange: balances[j].Exchange,
+				Address:  balances[j].Address,
+				Currency: balances[j].Currency,
+				Amount:   balances[j].Amount,
+				Price:    balances[j].Price,
+				Value:    balances[j].Value,
+			}
+		}
+	}
+	return resp, nil
+}
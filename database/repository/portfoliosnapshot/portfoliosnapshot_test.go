@@ -0,0 +1,135 @@
+package portfoliosnapshot
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestInsertValidation(t *testing.T) {
+	t.Parallel()
+	db := &DBService{}
+	err := db.Insert(nil)
+	assert.ErrorIs(t, err, errNilSnapshot)
+
+	err = db.Insert(&Details{})
+	assert.ErrorIs(t, err, errFiatUnset)
+
+	err = db.Insert(&Details{Fiat: "USD"})
+	assert.ErrorIs(t, err, errTimestampUnset)
+
+	err = db.Insert(&Details{Fiat: "USD", Timestamp: time.Now(), Balances: []Balance{{Currency: "BTC"}}})
+	assert.ErrorIs(t, err, errBalanceSourceNil)
+
+	err = db.Insert(&Details{Fiat: "USD", Timestamp: time.Now(), Balances: []Balance{{Exchange: "one"}}})
+	assert.ErrorIs(t, err, errCurrencyUnset)
+
+	err = db.Insert(&Details{ID: "1", Fiat: "USD", Timestamp: time.Now()})
+	assert.ErrorIs(t, err, errSnapshotIDInvalid)
+
+	_, err = db.GetInRange(time.Now(), time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, errInvalidDateRange)
+}
+
+func TestPortfolioSnapshots(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+
+			tt := time.Now().Truncate(time.Second)
+			later := &Details{
+				Fiat:      "USD",
+				Value:     250,
+				Timestamp: tt.Add(time.Hour),
+				Balances: []Balance{
+					{Exchange: "one", Currency: "BTC", Amount: 1, Price: 200, Value: 200},
+					{Address: "0xdead", Currency: "ETH", Amount: 0.02, Price: 2500, Value: 50},
+				},
+			}
+			require.NoError(t, db.Insert(later))
+			assert.NotEmpty(t, later.ID, "Insert should set the snapshot ID")
+			require.NoError(t, db.Insert(&Details{Fiat: "USD", Value: 100, Timestamp: tt}))
+			require.NoError(t, db.Insert(&Details{Fiat: "USD", Value: 50, Timestamp: tt.Add(-time.Hour)}))
+
+			results, err := db.GetInRange(tt, tt.Add(time.Hour))
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.True(t, tt.Equal(results[0].Timestamp), "snapshots should be ordered by timestamp")
+			assert.Empty(t, results[0].Balances)
+			assert.Equal(t, later.ID, results[1].ID)
+			assert.Equal(t, 250.0, results[1].Value)
+			require.Len(t, results[1].Balances, 2)
+			assert.Equal(t, later.Balances, results[1].Balances)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err)
+		})
+	}
+}
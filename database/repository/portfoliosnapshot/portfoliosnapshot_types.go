@@ -0,0 +1,53 @@
+package portfoliosnapshot
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNilSnapshot       = errors.New("nil portfolio snapshot")
+	errFiatUnset         = errors.New("fiat currency not set")
+	errTimestampUnset    = errors.New("timestamp not set")
+	errBalanceSourceNil  = errors.New("balance exchange or address must be set")
+	errCurrencyUnset     = errors.New("balance currency not set")
+	errInvalidDateRange  = errors.New("end date must be after start date")
+	errSnapshotIDInvalid = errors.New("invalid snapshot id")
+)
+
+// Details is a DTO for a portfolio snapshot stored in the database
+type Details struct {
+	ID        string
+	Fiat      string
+	Value     float64
+	Timestamp time.Time
+	Balances  []Balance
+}
+
+// Balance is a DTO for the holdings of a currency on an exchange or watched
+// address at the time of a snapshot. Exchange is set for exchange holdings and
+// Address for on-chain holdings
+type Balance struct {
+	Exchange string
+	Address  string
+	Currency string
+	Amount   float64
+	Price    float64
+	Value    float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using portfolio snapshot database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(*Details) error
+	GetInRange(start, end time.Time) ([]Details, error)
+}
@@ -70,6 +70,8 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
 		WithdrawType:   int(res.RequestDetails.Type),
+		TXID:           res.Exchange.TxID,
+		Confirmations:  res.Exchange.Confirmations,
 	}
 
 	if res.RequestDetails.Description != "" {
@@ -151,6 +153,8 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
 		WithdrawType:   int64(res.RequestDetails.Type),
+		TXID:           res.Exchange.TxID,
+		Confirmations:  res.Exchange.Confirmations,
 	}
 
 	if res.RequestDetails.Description != "" {
@@ -214,6 +218,100 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 	return nil
 }
 
+// UpdateStatus updates the exchange ID and status of a stored withdrawal
+// request, such as one which was pending approval
+func UpdateStatus(id uuid.UUID, exchangeID, status string) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	var (
+		updated int64
+		err     error
+	)
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		updated, err = modelSQLite.WithdrawalHistories(qm.Where("id = ?", id.String())).UpdateAll(ctx, database.DB.SQL, modelSQLite.M{
+			modelSQLite.WithdrawalHistoryColumns.ExchangeID: exchangeID,
+			modelSQLite.WithdrawalHistoryColumns.Status:     status,
+			modelSQLite.WithdrawalHistoryColumns.UpdatedAt:  time.Now().UTC().Format(time.RFC3339),
+		})
+	} else {
+		updated, err = modelPSQL.WithdrawalHistories(qm.Where("id = ?", id.String())).UpdateAll(ctx, database.DB.SQL, modelPSQL.M{
+			modelPSQL.WithdrawalHistoryColumns.ExchangeID: exchangeID,
+			modelPSQL.WithdrawalHistoryColumns.Status:     status,
+			modelPSQL.WithdrawalHistoryColumns.UpdatedAt:  time.Now().UTC(),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return common.ErrNoResults
+	}
+	return nil
+}
+
+// UpdateLifecycle updates the lifecycle status, transaction hash and
+// confirmations of a stored withdrawal request
+func UpdateLifecycle(id uuid.UUID, status, txID string, confirmations int64) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	var (
+		updated int64
+		err     error
+	)
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		updated, err = modelSQLite.WithdrawalHistories(qm.Where("id = ?", id.String())).UpdateAll(ctx, database.DB.SQL, modelSQLite.M{
+			modelSQLite.WithdrawalHistoryColumns.Status:        status,
+			modelSQLite.WithdrawalHistoryColumns.TXID:          txID,
+			modelSQLite.WithdrawalHistoryColumns.Confirmations: confirmations,
+			modelSQLite.WithdrawalHistoryColumns.UpdatedAt:     time.Now().UTC().Format(time.RFC3339),
+		})
+	} else {
+		updated, err = modelPSQL.WithdrawalHistories(qm.Where("id = ?", id.String())).UpdateAll(ctx, database.DB.SQL, modelPSQL.M{
+			modelPSQL.WithdrawalHistoryColumns.Status:        status,
+			modelPSQL.WithdrawalHistoryColumns.TXID:          txID,
+			modelPSQL.WithdrawalHistoryColumns.Confirmations: confirmations,
+			modelPSQL.WithdrawalHistoryColumns.UpdatedAt:     time.Now().UTC(),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return common.ErrNoResults
+	}
+	return nil
+}
+
+// GetUnsettledEvents returns the withdrawal requests created since the
+// supplied time which have been submitted to an exchange but have not yet
+// completed or failed
+func GetUnsettledEvents(since time.Time) ([]*withdraw.Response, error) {
+	return getByColumns([]qm.QueryMod{
+		qm.Where("exchange_id != ''"),
+		qm.WhereIn("status NOT IN ?", withdraw.StatusCompleted, withdraw.StatusFailed, withdraw.StatusRejected, withdraw.StatusPendingApproval),
+		qm.Where("created_at >= ?", since.UTC()),
+	})
+}
+
+// GetEventsSince returns the withdrawal requests created since the supplied
+// time
+func GetEventsSince(since time.Time) ([]*withdraw.Response, error) {
+	return getByColumns([]qm.QueryMod{
+		qm.Where("created_at >= ?", since.UTC()),
+	})
+}
+
+// GetEventsByStatus returns the withdrawal requests with the supplied status
+func GetEventsByStatus(status string) ([]*withdraw.Response, error) {
+	return getByColumns(generateWhereQuery([]string{"status"}, []string{status}, 0))
+}
+
 // GetEventByUUID return requested withdraw information by ID
 func GetEventByUUID(id string) (*withdraw.Response, error) {
 	resp, err := getByColumns(generateWhereQuery([]string{"id"}, []string{id}, 1))
@@ -305,6 +403,8 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 			tempResp.ID = newUUID
 			tempResp.Exchange.ID = v[x].ExchangeID
 			tempResp.Exchange.Status = v[x].Status
+			tempResp.Exchange.TxID = v[x].TXID
+			tempResp.Exchange.Confirmations = v[x].Confirmations
 			tempResp.RequestDetails = withdraw.Request{
 				Currency:    currency.NewCode(v[x].Currency),
 				Description: v[x].Description.String,
@@ -374,6 +474,8 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 			tempResp.ID = newUUID
 			tempResp.Exchange.ID = v[x].ExchangeID
 			tempResp.Exchange.Status = v[x].Status
+			tempResp.Exchange.TxID = v[x].TXID
+			tempResp.Exchange.Confirmations = v[x].Confirmations
 			tempResp.RequestDetails = withdraw.Request{
 				Currency:    currency.NewCode(v[x].Currency),
 				Description: v[x].Description.String,
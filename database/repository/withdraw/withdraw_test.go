@@ -187,4 +187,50 @@ func withdrawHelper(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	err = UpdateStatus(withdraw.DryRunID, "", withdraw.StatusRejected)
+	assert.ErrorIs(t, err, common.ErrNoResults)
+
+	if len(v) > 0 {
+		err = UpdateStatus(v[0].ID, "1337", withdraw.StatusRejected)
+		require.NoError(t, err, "UpdateStatus must not error")
+		updated, err := GetEventByUUID(v[0].ID.String())
+		require.NoError(t, err, "GetEventByUUID must not error")
+		assert.Equal(t, "1337", updated.Exchange.ID, "UpdateStatus should set the exchange ID")
+		assert.Equal(t, withdraw.StatusRejected, updated.Exchange.Status, "UpdateStatus should set the status")
+	}
+
+	err = UpdateLifecycle(withdraw.DryRunID, withdraw.StatusCompleted, "", 0)
+	assert.ErrorIs(t, err, common.ErrNoResults)
+
+	unsettled, err := GetUnsettledEvents(time.Now().Add(-time.Hour))
+	require.NoError(t, err, "GetUnsettledEvents must not error")
+	require.Len(t, unsettled, 19, "GetUnsettledEvents must not return rejected withdrawals")
+
+	err = UpdateLifecycle(unsettled[0].ID, withdraw.StatusCompleted, "0xdeadbeef", 12)
+	require.NoError(t, err, "UpdateLifecycle must not error")
+	updated, err := GetEventByUUID(unsettled[0].ID.String())
+	require.NoError(t, err, "GetEventByUUID must not error")
+	assert.Equal(t, withdraw.StatusCompleted, updated.Exchange.Status, "UpdateLifecycle should set the status")
+	assert.Equal(t, "0xdeadbeef", updated.Exchange.TxID, "UpdateLifecycle should set the transaction hash")
+	assert.Equal(t, int64(12), updated.Exchange.Confirmations, "UpdateLifecycle should set the confirmations")
+
+	unsettled, err = GetUnsettledEvents(time.Now().Add(-time.Hour))
+	require.NoError(t, err, "GetUnsettledEvents must not error")
+	assert.Len(t, unsettled, 18, "GetUnsettledEvents should not return completed withdrawals")
+
+	_, err = GetUnsettledEvents(time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, common.ErrNoResults, "GetUnsettledEvents should not return withdrawals created before the supplied time")
+
+	events, err := GetEventsSince(time.Now().Add(-time.Hour))
+	require.NoError(t, err, "GetEventsSince must not error")
+	assert.Len(t, events, 20, "GetEventsSince should return every withdrawal created since the supplied time")
+
+	_, err = GetEventsSince(time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, common.ErrNoResults, "GetEventsSince should not return withdrawals created before the supplied time")
+
+	completed, err := GetEventsByStatus(withdraw.StatusCompleted)
+	require.NoError(t, err, "GetEventsByStatus must not error")
+	require.Len(t, completed, 1, "GetEventsByStatus must return the completed withdrawal")
+	assert.Equal(t, updated.ID, completed[0].ID, "GetEventsByStatus should return the withdrawal with the status")
 }
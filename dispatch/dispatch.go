@@ -68,6 +68,12 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueDepth returns the amount of jobs waiting to be relayed and the job
+// queue capacity
+func QueueDepth() (depth, capacity int) {
+	return dispatcher.queueDepth()
+}
+
 // start compares atomic running value, sets defaults, overrides with
 // configuration, then spawns workers
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -166,6 +172,16 @@ func (d *Dispatcher) isRunning() bool {
 	return d.running
 }
 
+// queueDepth returns the length and capacity of the job queue
+func (d *Dispatcher) queueDepth() (depth, capacity int) {
+	if d == nil {
+		return 0, 0
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return len(d.jobs), cap(d.jobs)
+}
+
 // relayer routine relays communications across the defined routes
 func (d *Dispatcher) relayer() {
 	for {
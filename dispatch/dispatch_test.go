@@ -70,6 +70,21 @@ func TestStartStop(t *testing.T) {
 	assert.False(t, d.isRunning(), "IsRunning should return false")
 }
 
+func TestQueueDepth(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	depth, capacity := d.queueDepth()
+	assert.Zero(t, depth, "queueDepth should return zero depth for a nil dispatcher")
+	assert.Zero(t, capacity, "queueDepth should return zero capacity for a nil dispatcher")
+
+	d = NewDispatcher()
+	require.NoError(t, d.start(1, 10), "start must not error")
+	depth, capacity = d.queueDepth()
+	assert.Zero(t, depth, "queueDepth should return an empty queue")
+	assert.Equal(t, 10, capacity, "queueDepth should return the jobs limit")
+	require.NoError(t, d.stop(), "stop must not error")
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	var d *Dispatcher
@@ -0,0 +1,866 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// arbitrageTolerance is the relative tolerance used when checking an
+// orderbook movement consumed the full requested amount
+const arbitrageTolerance = 1e-9
+
+// SetupArbitrageManager creates an arbitrage manager subsystem. The script
+// manager is optional and only used when a script hook is configured
+func SetupArbitrageManager(em iExchangeManager, om iOrderSubmitter, cm iCommsManager, sm iScriptManager, cfg *config.ArbitrageManager) (*ArbitrageManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cm == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w ArbitrageManager", errNilConfig)
+	}
+	if cfg.DisableCrossExchange && cfg.DisableTriangular {
+		return nil, errArbitrageModesDisabled
+	}
+	if cfg.MinimumNetEdge < 0 {
+		return nil, fmt.Errorf("%w: %v", errArbitrageEdgeInvalid, cfg.MinimumNetEdge)
+	}
+	maximumNotional := make(map[*currency.Item]float64, len(cfg.MaximumNotional))
+	for c, amount := range cfg.MaximumNotional {
+		if c == "" || amount <= 0 || math.IsNaN(amount) {
+			return nil, fmt.Errorf("%w: %q %v", errArbitrageNotionalInvalid, c, amount)
+		}
+		maximumNotional[currency.NewCode(c).Item] = amount
+	}
+	if cfg.AutoExecute && len(maximumNotional) == 0 {
+		return nil, errArbitrageAutoExecute
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultArbitrageCheckInterval
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = defaultArbitrageCooldown
+	}
+	mux := dispatch.GetNewMux(nil)
+	id, err := mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrageManager{
+		shutdown:            make(chan struct{}),
+		exchangeManager:     em,
+		orderManager:        om,
+		commsManager:        cm,
+		scriptManager:       sm,
+		minimumNetEdge:      cfg.MinimumNetEdge,
+		maximumNotional:     maximumNotional,
+		crossExchange:       !cfg.DisableCrossExchange,
+		triangular:          !cfg.DisableTriangular,
+		requireTransfers:    cfg.RequireTransfers,
+		cooldown:            cfg.Cooldown,
+		checkInterval:       cfg.CheckInterval,
+		script:              cfg.Script,
+		autoExecute:         cfg.AutoExecute,
+		verbose:             cfg.Verbose,
+		tickerSubscriptions: make(map[string]dispatch.Pipe),
+		bookSubscriptions:   make(map[string]dispatch.Pipe),
+		books:               make(map[key.ExchangePairAsset]*orderbook.Depth),
+		pending:             make(map[key.ExchangePairAsset]struct{}),
+		notify:              make(chan struct{}, 1),
+		fees:                make(map[key.ExchangePairAsset]float64),
+		published:           make(map[string]time.Time),
+		mux:                 mux,
+		routerID:            id,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ArbitrageManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *ArbitrageManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("arbitrage manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("arbitrage manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.OrderMgr, "Arbitrage manager starting...")
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *ArbitrageManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("arbitrage manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("arbitrage manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.OrderMgr, "Arbitrage manager shutting down...")
+	close(m.shutdown)
+	m.m.Lock()
+	for _, subs := range []map[string]dispatch.Pipe{m.bookSubscriptions, m.tickerSubscriptions} {
+		for exch, pipe := range subs {
+			if err := pipe.Release(); err != nil {
+				log.Errorf(log.OrderMgr, "Arbitrage manager unable to release %s subscription: %v", exch, err)
+			}
+			delete(subs, exch)
+		}
+	}
+	m.m.Unlock()
+	m.wg.Wait()
+	log.Debugln(log.OrderMgr, "Arbitrage manager shutdown.")
+	return nil
+}
+
+// GetOpportunities returns the most recently published opportunities, newest
+// first
+func (m *ArbitrageManager) GetOpportunities() ([]ArbitrageOpportunity, error) {
+	if m == nil {
+		return nil, fmt.Errorf("arbitrage manager %w", ErrNilSubsystem)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := slices.Clone(m.opportunities)
+	slices.Reverse(resp)
+	return resp, nil
+}
+
+// Subscribe returns a pipe which receives each published
+// *ArbitrageOpportunity
+func (m *ArbitrageManager) Subscribe() (dispatch.Pipe, error) {
+	if m == nil {
+		return dispatch.Pipe{}, fmt.Errorf("arbitrage manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return dispatch.Pipe{}, fmt.Errorf("arbitrage manager %w", ErrSubSystemNotStarted)
+	}
+	return m.mux.Subscribe(m.routerID)
+}
+
+// run subscribes to exchange ticker and orderbook updates as they become
+// available and scans updated orderbooks for opportunities
+func (m *ArbitrageManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	m.subscribe()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.subscribe()
+			m.prunePublished()
+		case <-m.notify:
+			m.scan(context.TODO())
+		}
+	}
+}
+
+// subscribe attempts to subscribe to ticker and orderbook updates for every
+// enabled exchange. Subscriptions can only be made once an exchange has
+// stored data so this is retried on each check
+func (m *ArbitrageManager) subscribe() {
+	if !dispatch.IsRunning() {
+		return
+	}
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Arbitrage manager unable to get exchanges: %v", err)
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, exch := range exchanges {
+		if !exch.IsEnabled() {
+			continue
+		}
+		name := exch.GetName()
+		if _, ok := m.bookSubscriptions[name]; !ok {
+			if pipe, err := orderbook.SubscribeToExchangeOrderbooks(name); err == nil {
+				m.bookSubscriptions[name] = pipe
+				m.wg.Add(1)
+				go m.listen(pipe)
+			}
+		}
+		if _, ok := m.tickerSubscriptions[name]; !ok {
+			if pipe, err := ticker.SubscribeToExchangeTickers(name); err == nil {
+				m.tickerSubscriptions[name] = pipe
+				m.wg.Add(1)
+				go m.listen(pipe)
+			}
+		}
+	}
+}
+
+// listen processes ticker and orderbook updates pushed by the dispatch
+// system. Ticker updates queue a scan of the orderbook for the same pair
+func (m *ArbitrageManager) listen(pipe dispatch.Pipe) {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			switch d := data.(type) {
+			case *orderbook.Depth:
+				m.track(d)
+			case *ticker.Price:
+				depth, err := orderbook.GetDepth(d.ExchangeName, d.Pair, d.AssetType)
+				if err != nil {
+					continue
+				}
+				m.track(depth)
+			default:
+				log.Errorln(log.OrderMgr, common.GetTypeAssertError("*orderbook.Depth or *ticker.Price", data))
+			}
+		}
+	}
+}
+
+// track stores a spot orderbook and queues it to be scanned
+func (m *ArbitrageManager) track(d *orderbook.Depth) {
+	if d.Asset() != asset.Spot {
+		return
+	}
+	k := d.Key()
+	m.m.Lock()
+	m.books[k] = d
+	m.pending[k] = struct{}{}
+	m.m.Unlock()
+	select {
+	case m.notify <- struct{}{}:
+	default:
+	}
+}
+
+// prunePublished removes opportunities whose cooldown has elapsed
+func (m *ArbitrageManager) prunePublished() {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for id, t := range m.published {
+		if time.Since(t) >= m.cooldown {
+			delete(m.published, id)
+		}
+	}
+}
+
+// scan checks every orderbook updated since the last scan for opportunities
+func (m *ArbitrageManager) scan(ctx context.Context) {
+	m.m.Lock()
+	pending := m.pending
+	m.pending = make(map[key.ExchangePairAsset]struct{})
+	m.m.Unlock()
+	for k := range pending {
+		if m.crossExchange {
+			m.scanCrossExchange(ctx, k)
+		}
+		if m.triangular {
+			m.scanTriangular(ctx, k)
+		}
+	}
+}
+
+// scanCrossExchange compares an orderbook with the same pair on every other
+// tracked exchange in both directions
+func (m *ArbitrageManager) scanCrossExchange(ctx context.Context, k key.ExchangePairAsset) {
+	m.m.RLock()
+	updated := m.books[k]
+	var others []*orderbook.Depth
+	for o, d := range m.books {
+		if o.Exchange != k.Exchange && o.Base == k.Base && o.Quote == k.Quote && o.Asset == k.Asset {
+			others = append(others, d)
+		}
+	}
+	m.m.RUnlock()
+	for _, other := range others {
+		for _, books := range [][2]*orderbook.Depth{{updated, other}, {other, updated}} {
+			opp, err := m.crossExchangeOpportunity(ctx, books[0], books[1])
+			m.handleOpportunity(ctx, opp, err)
+		}
+	}
+}
+
+// crossExchangeOpportunity sizes an opportunity which buys on one exchange
+// and sells on another
+func (m *ArbitrageManager) crossExchangeOpportunity(ctx context.Context, buy, sell *orderbook.Depth) (*ArbitrageOpportunity, error) {
+	steps, err := m.getSteps(ctx, []*orderbook.Depth{buy, sell}, buy.Pair().Quote)
+	if err != nil {
+		return nil, err
+	}
+	p := buy.Pair()
+	opp, err := m.evaluate(steps, p.Quote)
+	if err != nil {
+		return nil, err
+	}
+	opp.ID = fmt.Sprintf("%s:%s:%s>%s", CrossExchangeArbitrage, p, buy.Exchange(), sell.Exchange())
+	opp.Type = CrossExchangeArbitrage
+	opp.Transferable = m.isTransferable(buy.Exchange(), sell.Exchange(), p.Base, buy.Asset())
+	if m.requireTransfers && !opp.Transferable {
+		return nil, fmt.Errorf("%s cannot be transferred from %s to %s", p.Base, buy.Exchange(), sell.Exchange())
+	}
+	return opp, nil
+}
+
+// isTransferable returns whether a currency can be withdrawn from one exchange
+// and deposited onto another. Currencies without a known state are assumed to
+// be transferable
+func (m *ArbitrageManager) isTransferable(from, to string, c currency.Code, a asset.Item) bool {
+	fromExch, err := m.exchangeManager.GetExchangeByName(from)
+	if err != nil {
+		return false
+	}
+	toExch, err := m.exchangeManager.GetExchangeByName(to)
+	if err != nil {
+		return false
+	}
+	if err := fromExch.CanWithdraw(c, a); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return false
+	}
+	if err := toExch.CanDeposit(c, a); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return false
+	}
+	return true
+}
+
+// scanTriangular checks every cycle of three currencies on the exchange which
+// includes the orderbook
+func (m *ArbitrageManager) scanTriangular(ctx context.Context, k key.ExchangePairAsset) {
+	m.m.RLock()
+	var cycles [][3]*orderbook.Depth
+	seen := make(map[*currency.Item]struct{})
+	for o, d := range m.books {
+		if o.Exchange != k.Exchange || o.Asset != k.Asset || o == k {
+			continue
+		}
+		var shared, other *currency.Item
+		switch {
+		case o.Base == k.Base || o.Quote == k.Base:
+			shared, other = k.Base, k.Quote
+		case o.Base == k.Quote || o.Quote == k.Quote:
+			shared, other = k.Quote, k.Base
+		default:
+			continue
+		}
+		third := o.Base
+		if third == shared {
+			third = o.Quote
+		}
+		if third == other {
+			continue
+		}
+		if _, ok := seen[third]; ok {
+			continue
+		}
+		closing, ok := m.getBookLocked(k.Exchange, k.Asset, other, third)
+		if !ok {
+			continue
+		}
+		seen[third] = struct{}{}
+		cycles = append(cycles, [3]*orderbook.Depth{m.books[k], d, closing})
+	}
+	m.m.RUnlock()
+
+	for _, books := range cycles {
+		start := triangularStart(books)
+		for _, path := range triangularPaths(books, start) {
+			opp, err := m.triangularOpportunity(ctx, path, start)
+			m.handleOpportunity(ctx, opp, err)
+		}
+	}
+}
+
+// getBookLocked returns the orderbook for two currencies in either
+// orientation. The manager must be locked by the caller
+func (m *ArbitrageManager) getBookLocked(exch string, a asset.Item, x, y *currency.Item) (*orderbook.Depth, bool) {
+	if d, ok := m.books[key.ExchangePairAsset{Exchange: exch, Base: x, Quote: y, Asset: a}]; ok {
+		return d, true
+	}
+	d, ok := m.books[key.ExchangePairAsset{Exchange: exch, Base: y, Quote: x, Asset: a}]
+	return d, ok
+}
+
+// triangularStart returns the currency a cycle starts and finishes in, which
+// is the currency quoting the most of its pairs
+func triangularStart(books [3]*orderbook.Depth) currency.Code {
+	var start currency.Code
+	var startCount int
+	for _, d := range books {
+		for _, c := range []currency.Code{d.Pair().Base, d.Pair().Quote} {
+			var n int
+			for _, o := range books {
+				if o.Pair().Quote.Equal(c) {
+					n++
+				}
+			}
+			if start.IsEmpty() || n > startCount || n == startCount && c.String() < start.String() {
+				start, startCount = c, n
+			}
+		}
+	}
+	return start
+}
+
+// triangularPaths returns the orderbooks of a cycle in the order traded for
+// both directions around the cycle from the starting currency
+func triangularPaths(books [3]*orderbook.Depth, start currency.Code) [][]*orderbook.Depth {
+	var touching []*orderbook.Depth
+	var closing *orderbook.Depth
+	for _, d := range books {
+		p := d.Pair()
+		if p.Base.Equal(start) || p.Quote.Equal(start) {
+			touching = append(touching, d)
+		} else {
+			closing = d
+		}
+	}
+	if len(touching) != 2 || closing == nil {
+		return nil
+	}
+	return [][]*orderbook.Depth{
+		{touching[0], closing, touching[1]},
+		{touching[1], closing, touching[0]},
+	}
+}
+
+// triangularOpportunity sizes an opportunity which trades through a cycle of
+// orderbooks on a single exchange
+func (m *ArbitrageManager) triangularOpportunity(ctx context.Context, path []*orderbook.Depth, start currency.Code) (*ArbitrageOpportunity, error) {
+	steps, err := m.getSteps(ctx, path, start)
+	if err != nil {
+		return nil, err
+	}
+	opp, err := m.evaluate(steps, start)
+	if err != nil {
+		return nil, err
+	}
+	route := []string{start.String()}
+	held := start
+	for i := range steps {
+		if steps[i].buy {
+			held = steps[i].pair.Base
+		} else {
+			held = steps[i].pair.Quote
+		}
+		route = append(route, held.String())
+	}
+	opp.ID = fmt.Sprintf("%s:%s:%s", TriangularArbitrage, steps[0].exchange, strings.Join(route, ">"))
+	opp.Type = TriangularArbitrage
+	return opp, nil
+}
+
+// getSteps builds the conversions through the orderbooks starting with the
+// currency held, checking each pair can be traded and retrieving its fee
+func (m *ArbitrageManager) getSteps(ctx context.Context, books []*orderbook.Depth, held currency.Code) ([]arbitrageStep, error) {
+	steps := make([]arbitrageStep, len(books))
+	for i, d := range books {
+		s := arbitrageStep{exchange: d.Exchange(), pair: d.Pair(), depth: d}
+		switch {
+		case s.pair.Quote.Equal(held):
+			s.buy = true
+			held = s.pair.Base
+		case s.pair.Base.Equal(held):
+			held = s.pair.Quote
+		default:
+			return nil, fmt.Errorf("%s %s does not trade %s", s.exchange, s.pair, held)
+		}
+		exch, err := m.exchangeManager.GetExchangeByName(s.exchange)
+		if err != nil {
+			return nil, err
+		}
+		if err := exch.CanTradePair(s.pair, d.Asset()); err != nil {
+			return nil, fmt.Errorf("%s %w", s.exchange, err)
+		}
+		if s.fee, err = m.getFeeRate(ctx, d); err != nil {
+			return nil, err
+		}
+		steps[i] = s
+	}
+	return steps, nil
+}
+
+// getFeeRate returns the cached taker fee rate for an orderbook's pair
+func (m *ArbitrageManager) getFeeRate(ctx context.Context, d *orderbook.Depth) (float64, error) {
+	k := d.Key()
+	m.m.RLock()
+	fee, ok := m.fees[k]
+	m.m.RUnlock()
+	if ok {
+		return fee, nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(d.Exchange())
+	if err != nil {
+		return 0, err
+	}
+	fee, err = getTakerFeeRate(ctx, exch, d.Pair())
+	if err != nil {
+		return 0, err
+	}
+	m.m.Lock()
+	m.fees[k] = fee
+	m.m.Unlock()
+	return fee, nil
+}
+
+// evaluate finds the largest amount of the starting currency which can be
+// traded through the steps at or above the minimum net edge. The amount is
+// capped by the first orderbook's liquidity and the maximum notional of the
+// starting currency
+func (m *ArbitrageManager) evaluate(steps []arbitrageStep, start currency.Code) (*ArbitrageOpportunity, error) {
+	topReturn, err := topOfBookReturn(steps)
+	if err != nil {
+		return nil, err
+	}
+	if !m.meetsEdge((topReturn - 1) * 100) {
+		return nil, errArbitrageNoEdge
+	}
+
+	var limit float64
+	if steps[0].buy {
+		_, limit, err = steps[0].depth.TotalAskAmounts()
+	} else {
+		limit, _, err = steps[0].depth.TotalBidAmounts()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if maximum, ok := m.maximumNotional[start.Item]; ok {
+		limit = math.Min(limit, maximum)
+	}
+
+	opp, err := simulateArbitrage(steps, limit)
+	if err != nil || !m.meetsEdge(opp.NetEdge) {
+		var lower, upper float64 = 0, limit
+		opp = nil
+		for range arbitrageSizingIterations {
+			mid := (lower + upper) / 2
+			candidate, err := simulateArbitrage(steps, mid)
+			if err != nil || !m.meetsEdge(candidate.NetEdge) {
+				upper = mid
+				continue
+			}
+			opp, lower = candidate, mid
+		}
+		if opp == nil {
+			return nil, errArbitrageNoEdge
+		}
+	}
+	opp.Asset = steps[0].depth.Asset()
+	opp.Currency = start
+	opp.DetectedAt = time.Now()
+	return opp, nil
+}
+
+// meetsEdge returns whether a net edge is profitable and at or above the
+// minimum
+func (m *ArbitrageManager) meetsEdge(edge float64) bool {
+	return edge > 0 && edge >= m.minimumNetEdge
+}
+
+// topOfBookReturn returns the ratio of currency returned to currency started
+// with when trading through the steps at the best prices after fees
+func topOfBookReturn(steps []arbitrageStep) (float64, error) {
+	ratio := 1.0
+	for i := range steps {
+		if steps[i].buy {
+			ask, err := steps[i].depth.GetBestAsk()
+			if err != nil {
+				return 0, err
+			}
+			ratio /= ask
+		} else {
+			bid, err := steps[i].depth.GetBestBid()
+			if err != nil {
+				return 0, err
+			}
+			ratio *= bid
+		}
+		ratio *= 1 - steps[i].fee
+	}
+	return ratio, nil
+}
+
+// simulateArbitrage trades the amount of the starting currency through the
+// orderbook depth of each step, deducting the taker fee from the currency
+// received after each step
+func simulateArbitrage(steps []arbitrageStep, amount float64) (*ArbitrageOpportunity, error) {
+	opp := &ArbitrageOpportunity{StartAmount: amount, Legs: make([]ArbitrageLeg, len(steps))}
+	net, gross := amount, amount
+	for i := range steps {
+		s := &steps[i]
+		var movement *orderbook.Movement
+		var err error
+		if s.buy {
+			var ask float64
+			if ask, err = s.depth.GetBestAsk(); err != nil {
+				return nil, err
+			}
+			if movement, err = s.depth.LiftTheAsks(net, ask, false); err != nil {
+				return nil, err
+			}
+			// Sold is the quote spent lifting the asks
+			if movement.Sold < net*(1-arbitrageTolerance) {
+				return nil, fmt.Errorf("%s %s %w", s.exchange, s.pair, errArbitrageLiquidity)
+			}
+			opp.Legs[i] = ArbitrageLeg{Side: order.Buy, Amount: movement.Purchased}
+			net = movement.Purchased * (1 - s.fee)
+			gross /= movement.AverageOrderCost
+		} else {
+			var bid float64
+			if bid, err = s.depth.GetBestBid(); err != nil {
+				return nil, err
+			}
+			if movement, err = s.depth.HitTheBids(net, bid, false); err != nil {
+				return nil, err
+			}
+			if movement.Sold < net*(1-arbitrageTolerance) {
+				return nil, fmt.Errorf("%s %s %w", s.exchange, s.pair, errArbitrageLiquidity)
+			}
+			opp.Legs[i] = ArbitrageLeg{Side: order.Sell, Amount: movement.Sold}
+			net = movement.Purchased * (1 - s.fee)
+			gross *= movement.AverageOrderCost
+		}
+		opp.Legs[i].Exchange = s.exchange
+		opp.Legs[i].Pair = s.pair
+		opp.Legs[i].AveragePrice = movement.AverageOrderCost
+		opp.Legs[i].FeeRate = s.fee
+	}
+	opp.EndAmount = net
+	opp.Profit = net - amount
+	opp.NetEdge = opp.Profit / amount * 100
+	opp.GrossEdge = (gross - amount) / amount * 100
+	return opp, nil
+}
+
+// handleOpportunity publishes an opportunity unless it was published within
+// the cooldown
+func (m *ArbitrageManager) handleOpportunity(ctx context.Context, opp *ArbitrageOpportunity, err error) {
+	if err != nil {
+		if m.verbose && !errors.Is(err, errArbitrageNoEdge) {
+			log.Debugf(log.OrderMgr, "Arbitrage manager skipped opportunity: %v", err)
+		}
+		return
+	}
+	m.m.Lock()
+	if last, ok := m.published[opp.ID]; ok && opp.DetectedAt.Sub(last) < m.cooldown {
+		m.m.Unlock()
+		return
+	}
+	m.published[opp.ID] = opp.DetectedAt
+	m.m.Unlock()
+
+	if m.autoExecute {
+		m.execute(ctx, opp)
+	}
+
+	m.m.Lock()
+	m.opportunities = append(m.opportunities, *opp)
+	if len(m.opportunities) > maxArbitrageOpportunities {
+		m.opportunities = slices.Delete(m.opportunities, 0, len(m.opportunities)-maxArbitrageOpportunities)
+	}
+	m.m.Unlock()
+
+	if err := m.mux.Publish(opp, m.routerID); err != nil {
+		log.Errorf(log.OrderMgr, "Arbitrage manager unable to publish opportunity %s: %v", opp.ID, err)
+	}
+	msg := fmt.Sprintf("Arbitrage opportunity %s net edge %.4f%% profit %v %s on %v %s", opp.ID, opp.NetEdge, opp.Profit, opp.Currency, opp.StartAmount, opp.Currency)
+	log.Infoln(log.OrderMgr, msg)
+	m.commsManager.PushEvent(base.Event{Type: "arbitrage", Message: msg})
+	if m.script != "" {
+		if err := m.runScript(opp); err != nil {
+			log.Errorf(log.OrderMgr, "Arbitrage manager unable to run script %s: %v", m.script, err)
+		}
+	}
+}
+
+// execute submits every leg of the opportunity as a market order through the
+// order manager. Opportunities starting in a currency without a maximum
+// notional were not capped when sized so are not executed
+func (m *ArbitrageManager) execute(ctx context.Context, opp *ArbitrageOpportunity) {
+	if _, ok := m.maximumNotional[opp.Currency.Item]; !ok {
+		log.Errorf(log.OrderMgr, "Arbitrage manager unable to execute %s: %v %s", opp.ID, errArbitrageNotionalUnset, opp.Currency)
+		return
+	}
+	if !m.orderManager.IsRunning() {
+		log.Errorf(log.OrderMgr, "Arbitrage manager unable to execute %s: order manager %v", opp.ID, ErrSubSystemNotStarted)
+		return
+	}
+	if opp.Type == TriangularArbitrage {
+		m.executeInTurn(ctx, opp)
+	} else {
+		// Cross-exchange legs trade currency already held on each exchange so
+		// they are independent of each other
+		var wg sync.WaitGroup
+		for i := range opp.Legs {
+			wg.Add(1)
+			go func(leg *ArbitrageLeg) {
+				defer wg.Done()
+				_, _ = m.submitLeg(ctx, opp, leg, leg.Amount)
+			}(&opp.Legs[i])
+		}
+		wg.Wait()
+	}
+	opp.Executed = true
+}
+
+// executeInTurn submits the legs of a triangular opportunity one after
+// another. Each leg trades the currency received by the previous leg, so it is
+// sized by the amount the previous leg executed and execution stops at the
+// first leg which does not fully fill
+func (m *ArbitrageManager) executeInTurn(ctx context.Context, opp *ArbitrageOpportunity) {
+	scale := 1.0
+	for i := range opp.Legs {
+		leg := &opp.Legs[i]
+		submitted, err := m.submitLeg(ctx, opp, leg, leg.Amount*scale)
+		if err != nil {
+			return
+		}
+		executed, err := m.awaitFill(ctx, submitted)
+		if err == nil && executed < submitted.Amount*(1-arbitrageTolerance) {
+			err = fmt.Errorf("%w: executed %v of %v", errArbitrageLegUnfilled, executed, submitted.Amount)
+		}
+		if err != nil {
+			leg.Err = err
+			log.Errorf(log.OrderMgr, "Arbitrage manager stopped executing %s at %s %s %s leg: %v", opp.ID, leg.Exchange, leg.Side, leg.Pair, err)
+			return
+		}
+		scale = executed / leg.Amount
+	}
+}
+
+// submitLeg submits a leg as a market order for the amount conformed to the
+// exchange's execution limits, recording the order ID or error on the leg
+func (m *ArbitrageManager) submitLeg(ctx context.Context, opp *ArbitrageOpportunity, leg *ArbitrageLeg, amount float64) (*order.Detail, error) {
+	if exch, err := m.exchangeManager.GetExchangeByName(leg.Exchange); err == nil {
+		if limits, err := exch.GetOrderExecutionLimits(opp.Asset, leg.Pair); err == nil {
+			amount = limits.ConformToAmount(amount)
+		}
+	}
+	resp, err := m.orderManager.Submit(ctx, &order.Submit{
+		Exchange:  leg.Exchange,
+		Pair:      leg.Pair,
+		AssetType: opp.Asset,
+		Side:      leg.Side,
+		Type:      order.Market,
+		Amount:    amount,
+	})
+	if err != nil {
+		leg.Err = err
+		log.Errorf(log.OrderMgr, "Arbitrage manager unable to submit %s %s %s leg of %s: %v", leg.Exchange, leg.Side, leg.Pair, opp.ID, err)
+		return nil, err
+	}
+	leg.OrderID = resp.OrderID
+	return resp.Detail, nil
+}
+
+// awaitFill returns the amount a submitted market order executed once it is no
+// longer active. Orders still active after arbitrageFillTimeout return the
+// amount executed so far
+func (m *ArbitrageManager) awaitFill(ctx context.Context, d *order.Detail) (float64, error) {
+	timeout := time.NewTimer(arbitrageFillTimeout)
+	defer timeout.Stop()
+	poll := time.NewTicker(arbitrageFillPollInterval)
+	defer poll.Stop()
+	for !d.IsInactive() {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-m.shutdown:
+			return 0, fmt.Errorf("arbitrage manager %w", ErrSubSystemNotStarted)
+		case <-timeout.C:
+			return d.ExecutedAmount, nil
+		case <-poll.C:
+		}
+		if updated, err := m.orderManager.GetByExchangeAndID(d.Exchange, d.OrderID); err == nil {
+			d = updated
+		}
+	}
+	// Exchanges which do not report the executed amount of a filled order
+	// are assumed to have filled it in full
+	if d.Status == order.Filled && d.ExecutedAmount == 0 {
+		return d.Amount, nil
+	}
+	return d.ExecutedAmount, nil
+}
+
+// runScript runs the configured gctscript with the opportunity available to
+// it as the opportunity variable
+func (m *ArbitrageManager) runScript(opp *ArbitrageOpportunity) error {
+	if m.scriptManager == nil || !m.scriptManager.IsRunning() {
+		return errEventScriptManagerNotRun
+	}
+	vm := m.scriptManager.New()
+	if vm == nil {
+		return errEventScriptVM
+	}
+	if err := vm.Load(filepath.Join(gctscript.ScriptPath, m.script)); err != nil {
+		return err
+	}
+	legs := make([]any, len(opp.Legs))
+	for i := range opp.Legs {
+		legs[i] = map[string]any{
+			"exchange":      opp.Legs[i].Exchange,
+			"pair":          opp.Legs[i].Pair.String(),
+			"side":          opp.Legs[i].Side.String(),
+			"amount":        opp.Legs[i].Amount,
+			"average_price": opp.Legs[i].AveragePrice,
+			"fee_rate":      opp.Legs[i].FeeRate,
+			"order_id":      opp.Legs[i].OrderID,
+		}
+	}
+	if err := vm.Script.Add("opportunity", map[string]any{
+		"id":           opp.ID,
+		"type":         string(opp.Type),
+		"asset":        opp.Asset.String(),
+		"currency":     opp.Currency.String(),
+		"start_amount": opp.StartAmount,
+		"end_amount":   opp.EndAmount,
+		"profit":       opp.Profit,
+		"net_edge":     opp.NetEdge,
+		"gross_edge":   opp.GrossEdge,
+		"transferable": opp.Transferable,
+		"executed":     opp.Executed,
+		"legs":         legs,
+	}); err != nil {
+		return err
+	}
+	go vm.CompileAndRun()
+	return nil
+}
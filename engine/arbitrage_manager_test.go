@@ -0,0 +1,331 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// loadArbitrageBook loads an orderbook snapshot for an exchange and returns
+// its depth
+func loadArbitrageBook(t *testing.T, exch string, p currency.Pair, bids, asks orderbook.Levels) *orderbook.Depth {
+	t.Helper()
+	depth, err := orderbook.DeployDepth(exch, p, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}), "LoadSnapshot must not error")
+	return depth
+}
+
+// addArbitrageExchange adds an exchange with a taker fee and currency states
+func addArbitrageExchange(t *testing.T, em *ExchangeManager, name string, fee float64) *routerExchange {
+	t.Helper()
+	e := addRouterExchange(t, em, name, fee, orderbook.Levels{{Price: 100, Amount: 1}})
+	e.GetBase().States = currencystate.NewCurrencyStates()
+	return e
+}
+
+func setupArbitrageTest(t *testing.T, cfg *config.ArbitrageManager) (*ArbitrageManager, *ExchangeManager, *riskEventRecorder) {
+	t.Helper()
+	em := NewExchangeManager()
+	comms := &riskEventRecorder{}
+	om := &fakeOrderSubmitter{
+		orders:   make(map[string]*order.Detail),
+		executed: func(s *order.Submit) float64 { return s.Amount },
+	}
+	m, err := SetupArbitrageManager(em, om, comms, nil, cfg)
+	require.NoError(t, err, "SetupArbitrageManager must not error")
+	return m, em, comms
+}
+
+func TestSetupArbitrageManager(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	om := &fakeOrderSubmitter{}
+	cm := &riskEventRecorder{}
+	_, err := SetupArbitrageManager(nil, om, cm, nil, &config.ArbitrageManager{})
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupArbitrageManager(em, nil, cm, nil, &config.ArbitrageManager{})
+	assert.ErrorIs(t, err, errNilOrderManager)
+	_, err = SetupArbitrageManager(em, om, nil, nil, &config.ArbitrageManager{})
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupArbitrageManager(em, om, cm, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupArbitrageManager(em, om, cm, nil, &config.ArbitrageManager{DisableCrossExchange: true, DisableTriangular: true})
+	assert.ErrorIs(t, err, errArbitrageModesDisabled)
+	_, err = SetupArbitrageManager(em, om, cm, nil, &config.ArbitrageManager{MinimumNetEdge: -1})
+	assert.ErrorIs(t, err, errArbitrageEdgeInvalid)
+	_, err = SetupArbitrageManager(em, om, cm, nil, &config.ArbitrageManager{MaximumNotional: map[string]float64{"USDT": -1}})
+	assert.ErrorIs(t, err, errArbitrageNotionalInvalid)
+	_, err = SetupArbitrageManager(em, om, cm, nil, &config.ArbitrageManager{MaximumNotional: map[string]float64{"": 1}})
+	assert.ErrorIs(t, err, errArbitrageNotionalInvalid, "a maximum notional without a currency should error")
+	_, err = SetupArbitrageManager(em, om, cm, nil, &config.ArbitrageManager{AutoExecute: true})
+	assert.ErrorIs(t, err, errArbitrageAutoExecute, "AutoExecute without a maximum notional should error")
+
+	cfg := &config.ArbitrageManager{}
+	m, err := SetupArbitrageManager(em, om, cm, nil, cfg)
+	require.NoError(t, err, "SetupArbitrageManager must not error")
+	assert.True(t, m.crossExchange)
+	assert.True(t, m.triangular)
+	assert.Equal(t, defaultArbitrageCheckInterval, cfg.CheckInterval)
+	assert.Equal(t, defaultArbitrageCooldown, cfg.Cooldown)
+}
+
+func TestArbitrageManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ArbitrageManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+	_, err := m.GetOpportunities()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.Subscribe()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, _, _ = setupArbitrageTest(t, &config.ArbitrageManager{})
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.Subscribe()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestSimulateArbitrage(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewBTCUSDT()
+	buy := loadArbitrageBook(t, "simulateArbitrageA", cp, orderbook.Levels{{Price: 99, Amount: 10}}, orderbook.Levels{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}})
+	sell := loadArbitrageBook(t, "simulateArbitrageB", cp, orderbook.Levels{{Price: 103, Amount: 1}, {Price: 102, Amount: 1}}, orderbook.Levels{{Price: 104, Amount: 10}})
+	steps := []arbitrageStep{
+		{exchange: "simulateArbitrageA", pair: cp, depth: buy, buy: true, fee: 0.001},
+		{exchange: "simulateArbitrageB", pair: cp, depth: sell, fee: 0.001},
+	}
+
+	ratio, err := topOfBookReturn(steps)
+	require.NoError(t, err, "topOfBookReturn must not error")
+	assert.InDelta(t, 103.0/100*0.999*0.999, ratio, 1e-12)
+
+	opp, err := simulateArbitrage(steps, 201)
+	require.NoError(t, err, "simulateArbitrage must not error")
+	require.Len(t, opp.Legs, 2)
+	assert.Equal(t, order.Buy, opp.Legs[0].Side)
+	assert.InDelta(t, 2, opp.Legs[0].Amount, 1e-9, "buying should lift both ask levels")
+	assert.InDelta(t, 100.5, opp.Legs[0].AveragePrice, 1e-9)
+	assert.Equal(t, order.Sell, opp.Legs[1].Side)
+	assert.InDelta(t, 2*0.999, opp.Legs[1].Amount, 1e-9, "the amount sold should be net of the buy fee")
+	assert.InDelta(t, (103+102*0.998)*0.999, opp.EndAmount, 1e-9)
+	assert.InDelta(t, opp.EndAmount-201, opp.Profit, 1e-9)
+	assert.Greater(t, opp.GrossEdge, opp.NetEdge, "the gross edge should exclude fees")
+
+	_, err = simulateArbitrage(steps, 1000)
+	assert.ErrorIs(t, err, errArbitrageLiquidity)
+}
+
+func TestArbitrageManagerCrossExchange(t *testing.T) {
+	t.Parallel()
+	m, em, comms := setupArbitrageTest(t, &config.ArbitrageManager{MinimumNetEdge: 0.1})
+	cp := currency.NewBTCUSDT()
+	addArbitrageExchange(t, em, "arbCrossA", 0.001)
+	addArbitrageExchange(t, em, "arbCrossB", 0.001)
+	a := loadArbitrageBook(t, "arbCrossA", cp, orderbook.Levels{{Price: 99, Amount: 5}}, orderbook.Levels{{Price: 100, Amount: 1}, {Price: 102, Amount: 1}, {Price: 104, Amount: 5}})
+	b := loadArbitrageBook(t, "arbCrossB", cp, orderbook.Levels{{Price: 103, Amount: 1.5}, {Price: 101, Amount: 5}}, orderbook.Levels{{Price: 110, Amount: 5}})
+
+	m.track(a)
+	m.track(b)
+	m.scan(t.Context())
+	opps, err := m.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 1, "only buying on A and selling on B should be profitable")
+	opp := opps[0]
+	assert.Equal(t, CrossExchangeArbitrage, opp.Type)
+	assert.Equal(t, "CROSS_EXCHANGE:BTCUSDT:arbCrossA>arbCrossB", opp.ID)
+	assert.True(t, opp.Currency.Equal(currency.USDT))
+	assert.True(t, opp.Transferable, "currencies without a state should be transferable")
+	assert.GreaterOrEqual(t, opp.NetEdge, 0.1)
+	assert.InDelta(t, 0.1, opp.NetEdge, 1e-6, "sizing should extend until the net edge reaches the minimum")
+	require.Len(t, opp.Legs, 2)
+	assert.Equal(t, "arbCrossA", opp.Legs[0].Exchange)
+	assert.Equal(t, "arbCrossB", opp.Legs[1].Exchange)
+	assert.Greater(t, opp.Legs[0].Amount, 1.0, "sizing should extend beyond the best ask level")
+	assert.False(t, opp.Executed, "opportunities should not be executed unless enabled")
+	require.Len(t, comms.events, 1)
+	assert.Equal(t, "arbitrage", comms.events[0].Type)
+
+	m.track(a)
+	m.scan(t.Context())
+	opps, err = m.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	assert.Len(t, opps, 1, "opportunities should not be republished within the cooldown")
+}
+
+func TestArbitrageManagerTransfers(t *testing.T) {
+	t.Parallel()
+	m, em, _ := setupArbitrageTest(t, &config.ArbitrageManager{RequireTransfers: true, DisableTriangular: true})
+	cp := currency.NewBTCUSDT()
+	exch := addArbitrageExchange(t, em, "arbTransferA", 0)
+	addArbitrageExchange(t, em, "arbTransferB", 0)
+	a := loadArbitrageBook(t, "arbTransferA", cp, orderbook.Levels{{Price: 99, Amount: 1}}, orderbook.Levels{{Price: 100, Amount: 1}})
+	b := loadArbitrageBook(t, "arbTransferB", cp, orderbook.Levels{{Price: 105, Amount: 1}}, orderbook.Levels{{Price: 110, Amount: 1}})
+	require.NoError(t, exch.GetBase().States.Update(currency.BTC, asset.Spot, currencystate.Options{Withdraw: convert.BoolPtr(false)}), "Update must not error")
+
+	_, err := m.crossExchangeOpportunity(t.Context(), a, b)
+	assert.ErrorContains(t, err, "cannot be transferred", "opportunities which cannot be rebalanced should be skipped")
+
+	m.requireTransfers = false
+	opp, err := m.crossExchangeOpportunity(t.Context(), a, b)
+	require.NoError(t, err, "crossExchangeOpportunity must not error")
+	assert.False(t, opp.Transferable)
+
+	require.NoError(t, exch.GetBase().States.Update(currency.BTC, asset.Spot, currencystate.Options{Trade: convert.BoolPtr(false)}), "Update must not error")
+	_, err = m.crossExchangeOpportunity(t.Context(), a, b)
+	assert.Error(t, err, "pairs which cannot be traded should be skipped")
+}
+
+func TestArbitrageManagerTriangular(t *testing.T) {
+	t.Parallel()
+	m, em, _ := setupArbitrageTest(t, &config.ArbitrageManager{DisableCrossExchange: true, MaximumNotional: map[string]float64{"usdt": 500}, AutoExecute: true})
+	addArbitrageExchange(t, em, "arbTriangle", 0.001)
+	btcUSDT := loadArbitrageBook(t, "arbTriangle", currency.NewBTCUSDT(), orderbook.Levels{{Price: 99, Amount: 10}}, orderbook.Levels{{Price: 100, Amount: 10}})
+	ethBTC := loadArbitrageBook(t, "arbTriangle", currency.NewPair(currency.ETH, currency.BTC), orderbook.Levels{{Price: 0.049, Amount: 100}}, orderbook.Levels{{Price: 0.05, Amount: 100}})
+	ethUSDT := loadArbitrageBook(t, "arbTriangle", currency.NewPair(currency.ETH, currency.USDT), orderbook.Levels{{Price: 5.2, Amount: 1000}}, orderbook.Levels{{Price: 5.3, Amount: 1000}})
+
+	m.track(btcUSDT)
+	m.track(ethBTC)
+	m.track(ethUSDT)
+	m.scan(t.Context())
+	opps, err := m.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 1, "only the USDT>BTC>ETH>USDT cycle should be profitable")
+	opp := opps[0]
+	assert.Equal(t, TriangularArbitrage, opp.Type)
+	assert.Equal(t, "TRIANGULAR:arbTriangle:USDT>BTC>ETH>USDT", opp.ID)
+	assert.True(t, opp.Currency.Equal(currency.USDT))
+	assert.InDelta(t, 500, opp.StartAmount, 1e-9, "the opportunity should be capped at the maximum notional")
+	assert.InDelta(t, 500/100.0*0.999/0.05*0.999*5.2*0.999, opp.EndAmount, 1e-9)
+	require.Len(t, opp.Legs, 3)
+	assert.Equal(t, order.Buy, opp.Legs[0].Side)
+	assert.Equal(t, order.Buy, opp.Legs[1].Side)
+	assert.Equal(t, order.Sell, opp.Legs[2].Side)
+	assert.True(t, opp.Executed, "opportunities should be executed when enabled")
+	for i := range opp.Legs {
+		assert.NoError(t, opp.Legs[i].Err)
+		assert.NotEmpty(t, opp.Legs[i].OrderID, "each leg should be submitted")
+	}
+	om, ok := m.orderManager.(*fakeOrderSubmitter)
+	require.True(t, ok)
+	assert.Len(t, om.submitted, 3)
+}
+
+func TestArbitrageManagerNotionalCurrency(t *testing.T) {
+	t.Parallel()
+	m, em, _ := setupArbitrageTest(t, &config.ArbitrageManager{DisableCrossExchange: true, MaximumNotional: map[string]float64{"USDT": 500}, AutoExecute: true})
+	addArbitrageExchange(t, em, "arbNotional", 0)
+	ethBTC := loadArbitrageBook(t, "arbNotional", currency.NewPair(currency.ETH, currency.BTC), orderbook.Levels{{Price: 0.049, Amount: 100}}, orderbook.Levels{{Price: 0.05, Amount: 100}})
+	ltcBTC := loadArbitrageBook(t, "arbNotional", currency.NewPair(currency.LTC, currency.BTC), orderbook.Levels{{Price: 0.0105, Amount: 1000}}, orderbook.Levels{{Price: 0.011, Amount: 1000}})
+	ltcETH := loadArbitrageBook(t, "arbNotional", currency.NewPair(currency.LTC, currency.ETH), orderbook.Levels{{Price: 0.19, Amount: 1000}}, orderbook.Levels{{Price: 0.2, Amount: 1000}})
+	om, ok := m.orderManager.(*fakeOrderSubmitter)
+	require.True(t, ok)
+
+	m.track(ethBTC)
+	m.track(ltcBTC)
+	m.track(ltcETH)
+	m.scan(t.Context())
+	opps, err := m.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 1, "only the BTC>ETH>LTC>BTC cycle should be profitable")
+	assert.Equal(t, "TRIANGULAR:arbNotional:BTC>ETH>LTC>BTC", opps[0].ID)
+	assert.True(t, opps[0].Currency.Equal(currency.BTC))
+	assert.InDelta(t, 5, opps[0].StartAmount, 1e-9, "the USDT cap should not be applied to an amount of BTC")
+	assert.False(t, opps[0].Executed, "opportunities starting in a currency without a cap should not be executed")
+	assert.Empty(t, om.submitted)
+
+	m.maximumNotional[currency.BTC.Item] = 0.5
+	clear(m.published)
+	m.track(ethBTC)
+	m.scan(t.Context())
+	opps, err = m.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 2)
+	assert.InDelta(t, 0.5, opps[0].StartAmount, 1e-9, "the opportunity should be capped at the BTC maximum notional")
+	assert.True(t, opps[0].Executed, "opportunities starting in a capped currency should be executed")
+	assert.Len(t, om.submitted, 3)
+}
+
+func TestArbitrageManagerExecuteInTurn(t *testing.T) {
+	t.Parallel()
+	m, em, _ := setupArbitrageTest(t, &config.ArbitrageManager{DisableCrossExchange: true, MaximumNotional: map[string]float64{"USDT": 1000}, AutoExecute: true})
+	exch := addArbitrageExchange(t, em, "arbInTurn", 0)
+	require.NoError(t, exch.GetBase().LoadLimits([]order.MinMaxLevel{{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, AmountStepIncrementSize: 0.1}}), "LoadLimits must not error")
+	om, ok := m.orderManager.(*fakeOrderSubmitter)
+	require.True(t, ok)
+	ethBTC := currency.NewPair(currency.ETH, currency.BTC)
+	newOpp := func() *ArbitrageOpportunity {
+		return &ArbitrageOpportunity{
+			ID:       "inTurn",
+			Type:     TriangularArbitrage,
+			Asset:    asset.Spot,
+			Currency: currency.USDT,
+			Legs: []ArbitrageLeg{
+				{Exchange: "arbInTurn", Pair: currency.NewBTCUSDT(), Side: order.Buy, Amount: 1.25},
+				{Exchange: "arbInTurn", Pair: ethBTC, Side: order.Buy, Amount: 20},
+				{Exchange: "arbInTurn", Pair: currency.NewPair(currency.ETH, currency.USDT), Side: order.Sell, Amount: 20},
+			},
+		}
+	}
+
+	opp := newOpp()
+	m.execute(t.Context(), opp)
+	assert.True(t, opp.Executed)
+	require.Len(t, om.submitted, 3)
+	assert.InDelta(t, 1.2, om.submitted[0].Amount, 1e-9, "the first leg should conform to the amount step")
+	assert.InDelta(t, 19.2, om.submitted[1].Amount, 1e-9, "later legs should be sized by the amount the previous leg executed")
+	assert.InDelta(t, 19.2, om.submitted[2].Amount, 1e-9, "later legs should be sized by the amount the previous leg executed")
+
+	om.m.Lock()
+	om.submitted = nil
+	om.executed = func(s *order.Submit) float64 {
+		if s.Pair.Equal(ethBTC) {
+			return s.Amount / 2
+		}
+		return s.Amount
+	}
+	om.m.Unlock()
+	opp = newOpp()
+	m.execute(t.Context(), opp)
+	assert.Len(t, om.submitted, 2, "legs after one which did not fully fill should not be submitted")
+	assert.NotEmpty(t, opp.Legs[1].OrderID)
+	assert.ErrorIs(t, opp.Legs[1].Err, errArbitrageLegUnfilled)
+	assert.Empty(t, opp.Legs[2].OrderID)
+	assert.NoError(t, opp.Legs[2].Err)
+}
+
+func TestTriangularStart(t *testing.T) {
+	t.Parallel()
+	books := [3]*orderbook.Depth{
+		loadArbitrageBook(t, "triangularStart", currency.NewPair(currency.ETH, currency.BTC), orderbook.Levels{{Price: 1, Amount: 1}}, orderbook.Levels{{Price: 2, Amount: 1}}),
+		loadArbitrageBook(t, "triangularStart", currency.NewBTCUSDT(), orderbook.Levels{{Price: 1, Amount: 1}}, orderbook.Levels{{Price: 2, Amount: 1}}),
+		loadArbitrageBook(t, "triangularStart", currency.NewPair(currency.ETH, currency.USDT), orderbook.Levels{{Price: 1, Amount: 1}}, orderbook.Levels{{Price: 2, Amount: 1}}),
+	}
+	start := triangularStart(books)
+	assert.True(t, start.Equal(currency.USDT), "the currency quoting the most pairs should start the cycle")
+	paths := triangularPaths(books, start)
+	require.Len(t, paths, 2)
+	for _, path := range paths {
+		require.Len(t, path, 3)
+		assert.True(t, path[1].Pair().Equal(books[0].Pair()), "the pair without the starting currency should be traded second")
+	}
+}
@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// ArbitrageManagerName is an exported subsystem name
+const ArbitrageManagerName = "arbitrage_manager"
+
+const (
+	defaultArbitrageCheckInterval = time.Second
+	defaultArbitrageCooldown      = 10 * time.Second
+	// maxArbitrageOpportunities is the number of recently published
+	// opportunities retained
+	maxArbitrageOpportunities = 100
+	// arbitrageSizingIterations is the number of bisections used to find the
+	// largest size which meets the minimum net edge
+	arbitrageSizingIterations = 32
+	// arbitrageFillTimeout is how long a triangular leg is waited on to fill
+	// before execution stops
+	arbitrageFillTimeout      = 5 * time.Second
+	arbitrageFillPollInterval = 100 * time.Millisecond
+)
+
+// Arbitrage opportunity types
+const (
+	// CrossExchangeArbitrage buys a pair on one exchange and sells the same
+	// pair on another
+	CrossExchangeArbitrage ArbitrageType = "CROSS_EXCHANGE"
+	// TriangularArbitrage trades through three pairs on a single exchange
+	// and returns to the starting currency
+	TriangularArbitrage ArbitrageType = "TRIANGULAR"
+)
+
+var (
+	errArbitrageModesDisabled   = errors.New("cross-exchange and triangular arbitrage are both disabled")
+	errArbitrageEdgeInvalid     = errors.New("minimum net edge cannot be negative")
+	errArbitrageNotionalInvalid = errors.New("maximum notional must be a positive amount of a currency")
+	errArbitrageAutoExecute     = errors.New("auto execute requires a maximum notional")
+	errArbitrageNotionalUnset   = errors.New("no maximum notional set for currency")
+	errArbitrageLiquidity       = errors.New("insufficient orderbook liquidity")
+	errArbitrageNoEdge          = errors.New("no arbitrage edge above the minimum")
+	errArbitrageLegUnfilled     = errors.New("arbitrage leg did not fully fill")
+)
+
+// ArbitrageType defines the kind of arbitrage opportunity
+type ArbitrageType string
+
+// ArbitrageManager scans orderbooks pushed through the dispatch system for
+// cross-exchange and triangular arbitrage opportunities, publishing those
+// which exceed the minimum net edge after taker fees
+type ArbitrageManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	m               sync.RWMutex
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+	commsManager    iCommsManager
+	scriptManager   iScriptManager
+
+	minimumNetEdge   float64
+	maximumNotional  map[*currency.Item]float64
+	crossExchange    bool
+	triangular       bool
+	requireTransfers bool
+	cooldown         time.Duration
+	checkInterval    time.Duration
+	script           string
+	autoExecute      bool
+	verbose          bool
+
+	tickerSubscriptions map[string]dispatch.Pipe
+	bookSubscriptions   map[string]dispatch.Pipe
+	books               map[key.ExchangePairAsset]*orderbook.Depth
+	pending             map[key.ExchangePairAsset]struct{}
+	notify              chan struct{}
+	fees                map[key.ExchangePairAsset]float64
+	published           map[string]time.Time
+	opportunities       []ArbitrageOpportunity
+	mux                 *dispatch.Mux
+	routerID            uuid.UUID
+}
+
+// ArbitrageOpportunity is a sized arbitrage opportunity. Amounts are
+// denominated in Currency and are net of the taker fees of each leg
+type ArbitrageOpportunity struct {
+	ID    string
+	Type  ArbitrageType
+	Asset asset.Item
+	// Currency is the currency the opportunity starts and finishes in
+	Currency    currency.Code
+	StartAmount float64
+	EndAmount   float64
+	Profit      float64
+	// NetEdge is the percentage return after taker fees and GrossEdge the
+	// percentage return before them
+	NetEdge   float64
+	GrossEdge float64
+	// Transferable is whether the base currency of a cross-exchange
+	// opportunity can be moved between the exchanges to rebalance
+	Transferable bool
+	Legs         []ArbitrageLeg
+	DetectedAt   time.Time
+	// Executed is set when the legs have been submitted to the order manager.
+	// Triangular legs after one which did not fully fill are not submitted
+	Executed bool
+}
+
+// ArbitrageLeg is a single market order of an opportunity
+type ArbitrageLeg struct {
+	Exchange string
+	Pair     currency.Pair
+	Side     order.Side
+	// Amount is the base currency amount of the order
+	Amount       float64
+	AveragePrice float64
+	FeeRate      float64
+	OrderID      string
+	Err          error
+}
+
+// arbitrageStep converts one currency into another through an orderbook
+type arbitrageStep struct {
+	exchange string
+	pair     currency.Pair
+	depth    *orderbook.Depth
+	// buy is set when the currency held is the quote of the pair
+	buy bool
+	fee float64
+}
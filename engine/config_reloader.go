@@ -0,0 +1,767 @@
+package engine
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupConfigReloader creates a config reloader subsystem for the config file
+// at path. The file is read immediately so that later changes can be compared
+// against it
+func SetupConfigReloader(cfg *config.ConfigReloader, path string, keyProvider config.EncryptionKeyProvider, target iConfigReloadTarget) (*ConfigReloader, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w ConfigReloader", errNilConfig)
+	}
+	if path == "" {
+		return nil, errConfigReloadPathUnset
+	}
+	if target == nil {
+		return nil, errNilConfigReloadTarget
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultConfigReloaderCheckInterval
+	}
+	r := &ConfigReloader{
+		verbose:       cfg.Verbose,
+		path:          path,
+		checkInterval: cfg.CheckInterval,
+		keyProvider:   keyProvider,
+		target:        target,
+		shutdown:      make(chan struct{}),
+	}
+	var err error
+	if r.current, r.modTime, err = r.read(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (r *ConfigReloader) IsRunning() bool {
+	return r != nil && atomic.LoadInt32(&r.started) == 1
+}
+
+// Start begins watching the config file for changes
+func (r *ConfigReloader) Start() error {
+	if r == nil {
+		return fmt.Errorf("config reloader %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return fmt.Errorf("config reloader %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ConfigMgr, "Config reloader starting, checking %s every %s", r.path, r.checkInterval)
+	r.shutdown = make(chan struct{})
+	r.wg.Add(1)
+	go r.run()
+	return nil
+}
+
+// Stop stops watching the config file. Reloads can still be requested via
+// Reload
+func (r *ConfigReloader) Stop() error {
+	if r == nil {
+		return fmt.Errorf("config reloader %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 1, 0) {
+		return fmt.Errorf("config reloader %w", ErrSubSystemNotStarted)
+	}
+	close(r.shutdown)
+	r.wg.Wait()
+	log.Debugln(log.ConfigMgr, "Config reloader shutdown.")
+	return nil
+}
+
+func (r *ConfigReloader) run() {
+	defer r.wg.Done()
+	t := time.NewTicker(r.checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-t.C:
+			r.checkForChanges()
+		}
+	}
+}
+
+// checkForChanges reloads the config file when its modification time has
+// changed since it was last read
+func (r *ConfigReloader) checkForChanges() {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		log.Errorf(log.ConfigMgr, "Config reloader unable to check %s: %v", r.path, err)
+		return
+	}
+	r.m.Lock()
+	modified := !info.ModTime().Equal(r.modTime)
+	r.m.Unlock()
+	if !modified {
+		return
+	}
+	if _, err := r.Reload(); err != nil {
+		log.Errorf(log.ConfigMgr, "Config reloader unable to reload %s: %v", r.path, err)
+	}
+}
+
+// Reload reads the config file and applies any changes made since it was last
+// read to the running engine. If any change cannot be applied without a
+// restart, no changes are applied and the rejected changes are returned along
+// with an error
+func (r *ConfigReloader) Reload() (*ConfigReloadResult, error) {
+	if r == nil {
+		return nil, fmt.Errorf("config reloader %w", ErrNilSubsystem)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if saved := r.saved.Swap(nil); saved != nil {
+		r.current, r.modTime = saved.config, saved.modTime
+	}
+	updated, modTime, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	// The file is not read again until it changes, even if the changes are
+	// rejected, so that an invalid edit is only reported once
+	r.modTime = modTime
+
+	changes := diffConfig(r.current, updated)
+	result := &ConfigReloadResult{Rejected: changes.rejected}
+	if len(changes.rejected) != 0 {
+		return result, fmt.Errorf("%w: %s", errConfigReloadRejected, strings.Join(changes.rejected, ", "))
+	}
+	if len(changes.exchanges) == 0 && !changes.syncManager && !changes.orderManager && !changes.orderRisk && !changes.eventManager && !changes.withdrawalPolicy && !changes.remoteControl {
+		if r.verbose {
+			log.Debugf(log.ConfigMgr, "Config reloader found no changes in %s", r.path)
+		}
+		return result, nil
+	}
+
+	result.Applied, err = r.target.applyConfigChanges(changes)
+	if err != nil {
+		// Only the applied changes are kept so the failed changes are applied
+		// again on the next reload
+		advanceConfig(r.current, updated, result.Applied)
+		return result, err
+	}
+	r.current = updated
+	log.Infof(log.ConfigMgr, "Config reloaded from %s. Applied: %s", r.path, strings.Join(result.Applied, ", "))
+	return result, nil
+}
+
+// configSaved is called after the engine saves the config file, so that the
+// next reload compares against the saved file rather than reloading the
+// engine's own changes
+func (r *ConfigReloader) configSaved() error {
+	if r == nil {
+		return nil
+	}
+	c, modTime, err := r.read()
+	if err != nil {
+		return err
+	}
+	r.saved.Store(&savedConfig{config: c, modTime: modTime})
+	return nil
+}
+
+// read loads the config file and checks it for comparison with a previously
+// read config
+func (r *ConfigReloader) read() (*config.Config, time.Time, error) {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if r.keyProvider == nil && config.IsFileEncrypted(r.path) {
+		return nil, time.Time{}, errConfigReloadNoKeyProvider
+	}
+	c := &config.Config{EncryptionKeyProvider: r.keyProvider}
+	if err := c.ReadConfigFromFile(r.path, true); err != nil {
+		return nil, time.Time{}, err
+	}
+	if err := checkReloadedConfig(c); err != nil {
+		return nil, time.Time{}, err
+	}
+	return c, info.ModTime(), nil
+}
+
+// checkReloadedConfig sets the same defaults as config.CheckConfig does on
+// startup, so that a config saved by the engine with its defaults filled in
+// compares as unchanged. Checks which apply settings globally, such as the
+// logging, database, scripting and bank account settings, are not performed
+func checkReloadedConfig(c *config.Config) error {
+	if err := c.CheckExchangeConfigValues(); err != nil {
+		return err
+	}
+	c.CheckConnectionMonitorConfig()
+	c.CheckDataHistoryMonitorConfig()
+	c.CheckCurrencyStateManager()
+	c.CheckEventManagerConfig()
+	c.CheckCommunicationsConfig()
+	c.CheckClientBankAccounts()
+	c.CheckRemoteControlConfig()
+	c.CheckSyncManagerConfig()
+	if err := c.CheckCurrencyConfigValues(); err != nil {
+		return err
+	}
+	c.CheckGlobalHTTPTimeout()
+	if c.NTPClient.Level != 0 {
+		c.CheckNTPConfig()
+	}
+	return nil
+}
+
+// advanceConfig updates the parts of the current config for which updated
+// changes were applied, as listed by applyConfigChanges
+func advanceConfig(current, updated *config.Config, applied []string) {
+	for _, path := range applied {
+		switch path {
+		case "syncManager":
+			current.SyncManagerConfig = updated.SyncManagerConfig
+		case "orderManager":
+			risk := current.OrderManager.Risk
+			current.OrderManager = updated.OrderManager
+			current.OrderManager.Risk = risk
+		case "orderManager.risk":
+			current.OrderManager.Risk = updated.OrderManager.Risk
+		case "eventManager":
+			current.EventManager = updated.EventManager
+		case "withdrawalPolicy":
+			current.WithdrawalPolicy = updated.WithdrawalPolicy
+		case "remoteControl":
+			current.RemoteControl.Principals = updated.RemoteControl.Principals
+			current.RemoteControl.Tokens = updated.RemoteControl.Tokens
+		default:
+			advanceExchangeConfig(current, updated, path)
+		}
+	}
+}
+
+// advanceExchangeConfig updates the part of an exchange config in current
+// which an applied path refers to
+func advanceExchangeConfig(current, updated *config.Config, path string) {
+	rest, ok := strings.CutPrefix(path, "exchanges.")
+	if !ok {
+		return
+	}
+	for i := range current.Exchanges {
+		c, u := &current.Exchanges[i], &updated.Exchanges[i]
+		field, ok := strings.CutPrefix(rest, u.Name)
+		if !ok || (field != "" && field[0] != '.') {
+			continue
+		}
+		switch field = strings.TrimPrefix(field, "."); {
+		case field == "":
+			current.Exchanges[i] = *u
+		case field == "api":
+			c.API = u.API
+		case field == "features.enabled.websocketAPI":
+			c.Features.Enabled.Websocket = u.Features.Enabled.Websocket
+		case strings.HasPrefix(field, "currencyPairs.pairs."):
+			a, err := asset.New(strings.TrimPrefix(field, "currencyPairs.pairs."))
+			if err != nil {
+				continue
+			}
+			if ps, ok := u.CurrencyPairs.Pairs[a]; ok {
+				c.CurrencyPairs.Pairs[a] = ps
+			}
+		}
+		return
+	}
+}
+
+// diffConfig compares two configs, sorting their differences into changes
+// which can be applied to the running engine and changes which are rejected
+func diffConfig(previous, updated *config.Config) *configChanges {
+	c := &configChanges{previous: previous, updated: updated}
+	for _, path := range changedFields("", reflect.ValueOf(previous).Elem(), reflect.ValueOf(updated).Elem()) {
+		switch {
+		case path == "exchanges":
+			c.diffExchanges()
+		case strings.HasPrefix(path, "syncManager."):
+			c.syncManager = true
+		case strings.HasPrefix(path, "orderManager.risk."):
+			c.orderRisk = true
+		case path == "orderManager.persistOrders":
+			// Order persistence is set up against the database before the
+			// order manager starts
+			c.rejected = append(c.rejected, path)
+		case strings.HasPrefix(path, "orderManager."):
+			c.orderManager = true
+		case strings.HasPrefix(path, "eventManager."):
+			c.eventManager = true
+		case strings.HasPrefix(path, "withdrawalPolicy."):
+			c.withdrawalPolicy = true
+		case path == "remoteControl.principals", path == "remoteControl.tokens":
+			c.remoteControl = true
+		default:
+			c.rejected = append(c.rejected, path)
+		}
+	}
+	return c
+}
+
+// diffExchanges compares the exchange configs. Exchanges which are enabled or
+// disabled, or which are not enabled, can be changed freely as they are loaded
+// or unloaded with their new config. Exchanges which remain enabled can only
+// have their enabled pairs and assets, API credentials and websocket support
+// changed
+func (c *configChanges) diffExchanges() {
+	if len(c.previous.Exchanges) != len(c.updated.Exchanges) {
+		c.rejected = append(c.rejected, "exchanges (added or removed)")
+		return
+	}
+	for i := range c.updated.Exchanges {
+		previous, updated := &c.previous.Exchanges[i], &c.updated.Exchanges[i]
+		if !strings.EqualFold(previous.Name, updated.Name) {
+			c.rejected = append(c.rejected, "exchanges (renamed or reordered)")
+			return
+		}
+		fields := changedFields("", reflect.ValueOf(previous).Elem(), reflect.ValueOf(updated).Elem())
+		if len(fields) == 0 {
+			continue
+		}
+		change := exchangeConfigChange{
+			name:     updated.Name,
+			previous: previous,
+			updated:  updated,
+			replace:  !previous.Enabled || !updated.Enabled,
+		}
+		if !change.replace {
+			for _, f := range fields {
+				switch f {
+				case "currencyPairs.pairs":
+					var rejected []string
+					change.pairs, rejected = diffPairStores(previous.CurrencyPairs, updated.CurrencyPairs)
+					for _, r := range rejected {
+						c.rejected = append(c.rejected, "exchanges."+updated.Name+".currencyPairs.pairs."+r)
+					}
+				case "api.authenticatedSupport", "api.authenticatedWebsocketApiSupport":
+					change.credentials = true
+				case "features.enabled.websocketAPI":
+					change.websocket = true
+				default:
+					if strings.HasPrefix(f, "api.credentials.") {
+						change.credentials = true
+						continue
+					}
+					c.rejected = append(c.rejected, "exchanges."+updated.Name+"."+f)
+				}
+			}
+		}
+		c.exchanges = append(c.exchanges, change)
+	}
+}
+
+// diffPairStores returns the assets whose enabled or available pairs or enabled
+// state differ between two pairs managers, along with the assets whose other
+// settings differ
+func diffPairStores(previous, updated *currency.PairsManager) (changed asset.Items, rejected []string) {
+	assets := append(previous.GetAssetTypes(false), updated.GetAssetTypes(false)...)
+	slices.Sort(assets)
+	for _, a := range slices.Compact(assets) {
+		p, pErr := previous.Get(a)
+		u, uErr := updated.Get(a)
+		if pErr != nil || uErr != nil {
+			rejected = append(rejected, a.String()+" (added or removed)")
+			continue
+		}
+		if !reflect.DeepEqual(p.RequestFormat, u.RequestFormat) {
+			rejected = append(rejected, a.String()+".requestFormat")
+		}
+		if !reflect.DeepEqual(p.ConfigFormat, u.ConfigFormat) {
+			rejected = append(rejected, a.String()+".configFormat")
+		}
+		if p.AssetEnabled != u.AssetEnabled || !p.Enabled.Equal(u.Enabled) || !p.Available.Equal(u.Available) {
+			changed = append(changed, a)
+		}
+	}
+	return changed, rejected
+}
+
+// changedFields returns the JSON paths of the exported fields which differ
+// between two values of the same type. Structs are compared field by field and
+// any other kind, a struct with its own JSON encoding or a struct without
+// exported fields is compared as a whole
+func changedFields(path string, a, b reflect.Value) []string {
+	if a.Kind() == reflect.Pointer && !a.IsNil() && !b.IsNil() {
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() != reflect.Struct || hasJSONEncoding(a.Type()) {
+		if reflect.DeepEqual(a.Interface(), b.Interface()) {
+			return nil
+		}
+		return []string{path}
+	}
+	var changed []string
+	var exported bool
+	for i := range a.NumField() {
+		f := a.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		exported = true
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch {
+		case name == "-":
+			continue
+		case name == "" && f.Anonymous:
+			changed = append(changed, changedFields(path, a.Field(i), b.Field(i))...)
+			continue
+		case name == "":
+			name = f.Name
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+		changed = append(changed, changedFields(name, a.Field(i), b.Field(i))...)
+	}
+	if !exported && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return []string{path}
+	}
+	return changed
+}
+
+var (
+	jsonMarshalerType = reflect.TypeFor[interface{ MarshalJSON() ([]byte, error) }]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// hasJSONEncoding returns whether a type is encoded by its own marshaller
+// rather than field by field
+func hasJSONEncoding(t reflect.Type) bool {
+	p := reflect.PointerTo(t)
+	return t.Implements(jsonMarshalerType) || p.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) || p.Implements(textMarshalerType)
+}
+
+// applyConfigChanges applies changes from a reloaded config to the running
+// engine and returns the config paths which were applied
+func (bot *Engine) applyConfigChanges(c *configChanges) ([]string, error) {
+	var applied []string
+	var errs error
+	for i := range c.exchanges {
+		a, err := bot.applyExchangeConfigChange(&c.exchanges[i])
+		applied = append(applied, a...)
+		errs = common.AppendError(errs, err)
+	}
+	if c.syncManager {
+		if err := bot.applySyncManagerConfig(&c.previous.SyncManagerConfig, &c.updated.SyncManagerConfig); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("syncManager: %w", err))
+		} else {
+			applied = append(applied, "syncManager")
+		}
+	}
+	// Order manager settings are applied first as the risk config is validated
+	// against the futures tracking setting
+	if c.orderManager {
+		if err := bot.applyOrderManagerConfig(&c.previous.OrderManager, &c.updated.OrderManager); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("orderManager: %w", err))
+		} else {
+			applied = append(applied, "orderManager")
+		}
+	}
+	if c.orderRisk {
+		risk := c.updated.OrderManager.Risk
+		risk.Rules = slices.Clone(risk.Rules)
+		var err error
+		if bot.OrderManager != nil {
+			err = bot.OrderManager.UpdateRiskConfig(&risk)
+		}
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("orderManager.risk: %w", err))
+		} else {
+			bot.Config.OrderManager.Risk = risk
+			applied = append(applied, "orderManager.risk")
+		}
+	}
+	if c.eventManager {
+		if err := bot.applyEventManagerConfig(&c.previous.EventManager, &c.updated.EventManager); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("eventManager: %w", err))
+		} else {
+			applied = append(applied, "eventManager")
+		}
+	}
+	if c.withdrawalPolicy {
+		policy := c.updated.WithdrawalPolicy
+		policy.Limits = slices.Clone(policy.Limits)
+		var err error
+		if bot.WithdrawManager != nil {
+			err = bot.WithdrawManager.SetWithdrawalPolicy(&policy)
+		}
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("withdrawalPolicy: %w", err))
+		} else {
+			bot.Config.WithdrawalPolicy = policy
+			applied = append(applied, "withdrawalPolicy")
+		}
+	}
+	if c.remoteControl {
+		if err := bot.applyRemoteControlConfig(&c.updated.RemoteControl); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("remoteControl: %w", err))
+		} else {
+			applied = append(applied, "remoteControl")
+		}
+	}
+	return applied, errs
+}
+
+// applyExchangeConfigChange applies the changes to an exchange config. An
+// exchange being enabled or disabled, or one which is not running, has its
+// whole config replaced and is loaded or unloaded. A running exchange has its
+// pairs, credentials and websocket support updated in place
+func (bot *Engine) applyExchangeConfigChange(change *exchangeConfigChange) ([]string, error) {
+	exchCfg, err := bot.Config.GetExchangeConfig(change.name)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", errConfigReloadExchangeMissing, change.name, err)
+	}
+	path := "exchanges." + change.name
+	exch, err := bot.ExchangeManager.GetExchangeByName(change.name)
+	loaded := err == nil
+
+	if change.replace {
+		if loaded {
+			if err := bot.UnloadExchange(change.name); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		// The new config is copied so that the running config does not share
+		// pairs or features with the config used for later comparisons
+		var replacement config.Exchange
+		if err := cloneJSON(change.updated, &replacement); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		*exchCfg = replacement
+		if change.updated.Enabled {
+			if err := bot.LoadExchange(change.name); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		return []string{path}, nil
+	}
+	if !loaded {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var applied []string
+	var errs error
+	b := exch.GetBase()
+	if len(change.pairs) != 0 {
+		if err := storeReloadedPairs(exchCfg.CurrencyPairs, change.updated.CurrencyPairs, change.pairs); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s.currencyPairs: %w", path, err))
+		} else if err := b.SetConfigPairs(); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s.currencyPairs: %w", path, err))
+		} else {
+			for _, a := range change.pairs {
+				applied = append(applied, path+".currencyPairs.pairs."+a.String())
+			}
+		}
+	}
+
+	var reconnected bool
+	if change.credentials {
+		exchCfg.API.AuthenticatedSupport = change.updated.API.AuthenticatedSupport
+		exchCfg.API.AuthenticatedWebsocketSupport = change.updated.API.AuthenticatedWebsocketSupport
+		exchCfg.API.Credentials = change.updated.API.Credentials
+		b.API.AuthenticatedSupport = exchCfg.API.AuthenticatedSupport
+		b.API.AuthenticatedWebsocketSupport = exchCfg.API.AuthenticatedWebsocketSupport
+		creds := &exchCfg.API.Credentials
+		b.SetCredentials(creds.Key, creds.Secret, creds.ClientID, creds.Subaccount, creds.PEMKey, creds.OTPSecret)
+		if b.API.AuthenticatedSupport || b.API.AuthenticatedWebsocketSupport {
+			if err := exch.ValidateAPICredentials(context.TODO(), asset.Spot); err != nil {
+				b.API.AuthenticatedSupport = false
+				b.API.AuthenticatedWebsocketSupport = false
+				errs = common.AppendError(errs, fmt.Errorf("%s.api: error validating credentials: %w", path, err))
+			}
+		}
+		if b.Websocket != nil {
+			b.Websocket.SetCanUseAuthenticatedEndpoints(b.API.AuthenticatedWebsocketSupport)
+			// Reconnect so that authenticated connections use the new credentials
+			if b.Websocket.IsConnected() && !change.websocket {
+				if err := b.Websocket.Shutdown(); err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("%s.api: %w", path, err))
+				} else if err := b.Websocket.Connect(); err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("%s.api: %w", path, err))
+				}
+				reconnected = true
+			}
+		}
+		applied = append(applied, path+".api")
+	}
+
+	if change.websocket {
+		enable := change.updated.Features.Enabled.Websocket
+		w, err := exch.GetWebsocket()
+		if err == nil && enable != w.IsEnabled() {
+			if enable {
+				err = w.Enable()
+			} else {
+				err = w.Disable()
+			}
+		}
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s.features.enabled.websocketAPI: %w", path, err))
+		} else {
+			exchCfg.Features.Enabled.Websocket = enable
+			applied = append(applied, path+".features.enabled.websocketAPI")
+		}
+	}
+
+	if len(change.pairs) != 0 && !change.websocket && !reconnected && exch.IsWebsocketEnabled() && b.Websocket.IsConnected() {
+		if err := exch.FlushWebsocketChannels(); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+	return applied, errs
+}
+
+// storeReloadedPairs updates the pairs of the given assets from a reloaded
+// pairs manager
+func storeReloadedPairs(pm, updated *currency.PairsManager, assets asset.Items) error {
+	for _, a := range assets {
+		ps, err := updated.Get(a)
+		if err != nil {
+			return err
+		}
+		if err := pm.StorePairs(a, ps.Available, false); err != nil {
+			return err
+		}
+		if err := pm.StorePairs(a, ps.Enabled, true); err != nil {
+			return err
+		}
+		// Must happen after StorePairs, which enables the asset when pairs are enabled
+		if err := pm.SetAssetEnabled(a, ps.AssetEnabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applySyncManagerConfig updates the sync manager config, restarting the sync
+// manager when it is running so that the new config takes effect
+func (bot *Engine) applySyncManagerConfig(previous, updated *config.SyncManagerConfig) error {
+	cfg := *updated
+	if updated.PairFormatDisplay != nil {
+		pairFmt := *updated.PairFormatDisplay
+		cfg.PairFormatDisplay = &pairFmt
+	}
+	bot.Config.SyncManagerConfig = cfg
+	if previous.SynchronizeTicker != updated.SynchronizeTicker {
+		bot.Settings.EnableTickerSyncing = updated.SynchronizeTicker
+	}
+	if previous.SynchronizeOrderbook != updated.SynchronizeOrderbook {
+		bot.Settings.EnableOrderbookSyncing = updated.SynchronizeOrderbook
+	}
+	if previous.SynchronizeTrades != updated.SynchronizeTrades {
+		bot.Settings.EnableTradeSyncing = updated.SynchronizeTrades
+	}
+	if previous.SynchronizeContinuously != updated.SynchronizeContinuously {
+		bot.Settings.SyncContinuously = updated.SynchronizeContinuously
+	}
+
+	running := bot.currencyPairSyncer.IsRunning()
+	if running {
+		if err := bot.currencyPairSyncer.Stop(); err != nil {
+			return err
+		}
+	}
+	if bot.currencyPairSyncer != nil {
+		syncCfg := bot.syncManagerConfig()
+		if err := bot.currencyPairSyncer.reconfigure(&syncCfg); err != nil {
+			return err
+		}
+	}
+	if previous.Enabled != updated.Enabled {
+		bot.Settings.EnableExchangeSyncManager = updated.Enabled
+		running = updated.Enabled
+	}
+	if !running {
+		return nil
+	}
+	return bot.SetSubsystem(SyncManagerName, true)
+}
+
+// applyOrderManagerConfig updates the order manager settings other than its
+// risk config, enabling or disabling the order manager when required
+func (bot *Engine) applyOrderManagerConfig(previous, updated *config.OrderManager) error {
+	if bot.OrderManager != nil {
+		if err := bot.OrderManager.UpdateConfig(updated); err != nil {
+			return err
+		}
+	}
+	risk := bot.Config.OrderManager.Risk
+	bot.Config.OrderManager = *updated
+	bot.Config.OrderManager.Risk = risk
+
+	if previous.Enabled == updated.Enabled || updated.Enabled == bot.OrderManager.IsRunning() {
+		return nil
+	}
+	bot.Settings.EnableOrderManager = updated.Enabled
+	return bot.SetSubsystem(OrderManagerName, updated.Enabled)
+}
+
+// applyEventManagerConfig updates the event manager config, restarting the
+// event manager when it is running so that a new delay takes effect
+func (bot *Engine) applyEventManagerConfig(previous, updated *config.EventManager) error {
+	cfg := *updated
+	enabled := updated.Enabled != nil && *updated.Enabled
+	cfg.Enabled = &enabled
+	bot.Config.EventManager = cfg
+	bot.Settings.EventManagerDelay = updated.Delay
+
+	running := bot.eventManager.IsRunning()
+	if previous.Delay != updated.Delay && bot.eventManager != nil {
+		if running {
+			if err := bot.eventManager.Stop(); err != nil {
+				return err
+			}
+		}
+		if err := bot.eventManager.setSleepDelay(updated.Delay); err != nil {
+			return err
+		}
+	}
+	if (previous.Enabled != nil && *previous.Enabled) != enabled {
+		bot.Settings.EnableEventManager = enabled
+		running = enabled
+	}
+	if running == bot.eventManager.IsRunning() {
+		return nil
+	}
+	return bot.SetSubsystem(EventManagerName, running)
+}
+
+// applyRemoteControlConfig updates the gRPC principals and tokens. Credentials
+// cached by the gRPC server are cleared so that changed or removed principals
+// can no longer authenticate
+func (bot *Engine) applyRemoteControlConfig(updated *config.RemoteControlConfig) error {
+	if bot.rpcAuth != nil {
+		return bot.rpcAuth.update(updated)
+	}
+	if err := checkRPCAuthConfig(updated); err != nil {
+		return err
+	}
+	bot.Config.RemoteControl.Principals = slices.Clone(updated.Principals)
+	bot.Config.RemoteControl.Tokens = slices.Clone(updated.Tokens)
+	return nil
+}
+
+// cloneJSON copies a value through its JSON representation
+func cloneJSON(from, to any) error {
+	d, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(d, to)
+}
@@ -0,0 +1,581 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var errFakeApply = errors.New("fake apply error")
+
+type fakeConfigReloadTarget struct {
+	changes []*configChanges
+	applied []string
+	err     error
+}
+
+func (f *fakeConfigReloadTarget) applyConfigChanges(c *configChanges) ([]string, error) {
+	f.changes = append(f.changes, c)
+	if f.applied != nil {
+		return f.applied, f.err
+	}
+	return []string{"applied"}, f.err
+}
+
+// loadReloadConfig reads the test config with the checks performed by the
+// config reloader
+func loadReloadConfig(t *testing.T) *config.Config {
+	t.Helper()
+	c := &config.Config{}
+	require.NoError(t, c.ReadConfigFromFile(config.TestFile, true), "ReadConfigFromFile must not error")
+	require.NoError(t, checkReloadedConfig(c), "checkReloadedConfig must not error")
+	return c
+}
+
+// writeReloadConfig writes the test config to a temporary file after applying
+// modify and returns the path
+func writeReloadConfig(t *testing.T, modify func(*config.Config)) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	rewriteReloadConfig(t, path, modify)
+	return path
+}
+
+// rewriteReloadConfig replaces the config file at path with the test config
+// after applying modify
+func rewriteReloadConfig(t *testing.T, path string, modify func(*config.Config)) {
+	t.Helper()
+	c := loadReloadConfig(t)
+	if modify != nil {
+		modify(c)
+	}
+	d, err := json.Marshal(c)
+	require.NoError(t, err, "Marshal must not error")
+	require.NoError(t, os.WriteFile(path, d, 0o600), "WriteFile must not error")
+}
+
+func TestSetupConfigReloader(t *testing.T) {
+	t.Parallel()
+	_, err := SetupConfigReloader(nil, "", nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupConfigReloader(&config.ConfigReloader{}, "", nil, nil)
+	assert.ErrorIs(t, err, errConfigReloadPathUnset)
+
+	_, err = SetupConfigReloader(&config.ConfigReloader{}, config.TestFile, nil, nil)
+	assert.ErrorIs(t, err, errNilConfigReloadTarget)
+
+	_, err = SetupConfigReloader(&config.ConfigReloader{}, filepath.Join(t.TempDir(), "missing.json"), nil, &fakeConfigReloadTarget{})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	encrypted := filepath.Join(t.TempDir(), "encrypted.json")
+	require.NoError(t, os.WriteFile(encrypted, []byte("THORS-HAMMERdata"), 0o600), "WriteFile must not error")
+	_, err = SetupConfigReloader(&config.ConfigReloader{}, encrypted, nil, &fakeConfigReloadTarget{})
+	assert.ErrorIs(t, err, errConfigReloadNoKeyProvider)
+
+	cfg := &config.ConfigReloader{Verbose: true}
+	r, err := SetupConfigReloader(cfg, config.TestFile, nil, &fakeConfigReloadTarget{})
+	require.NoError(t, err, "SetupConfigReloader must not error")
+	assert.Equal(t, defaultConfigReloaderCheckInterval, cfg.CheckInterval, "CheckInterval should be defaulted")
+	assert.Equal(t, defaultConfigReloaderCheckInterval, r.checkInterval, "checkInterval should be set")
+	assert.True(t, r.verbose, "verbose should be set")
+	require.NotNil(t, r.current, "current must be read on setup")
+	assert.False(t, r.modTime.IsZero(), "modTime should be set on setup")
+}
+
+func TestConfigReloaderStartStop(t *testing.T) {
+	t.Parallel()
+	var r *ConfigReloader
+	assert.False(t, r.IsRunning(), "IsRunning should return false on a nil reloader")
+	assert.ErrorIs(t, r.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, r.Stop(), ErrNilSubsystem)
+
+	r, err := SetupConfigReloader(&config.ConfigReloader{}, config.TestFile, nil, &fakeConfigReloadTarget{})
+	require.NoError(t, err, "SetupConfigReloader must not error")
+	assert.ErrorIs(t, r.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, r.Start(), "Start must not error")
+	assert.True(t, r.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, r.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, r.Stop(), "Stop must not error")
+	assert.False(t, r.IsRunning(), "IsRunning should return false")
+	require.NoError(t, r.Start(), "Start must not error after Stop")
+	require.NoError(t, r.Stop(), "Stop must not error")
+}
+
+func TestConfigReloaderCheckForChanges(t *testing.T) {
+	t.Parallel()
+	path := writeReloadConfig(t, nil)
+	target := &fakeConfigReloadTarget{}
+	r, err := SetupConfigReloader(&config.ConfigReloader{CheckInterval: time.Millisecond}, path, nil, target)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+
+	rewriteReloadConfig(t, path, func(c *config.Config) { c.SyncManagerConfig.NumWorkers = 42 })
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(path, future, future), "Chtimes must not error")
+	require.NoError(t, r.Start(), "Start must not error")
+	defer func() { assert.NoError(t, r.Stop(), "Stop should not error") }()
+	assert.Eventually(t, func() bool {
+		r.m.Lock()
+		defer r.m.Unlock()
+		return len(target.changes) == 1
+	}, time.Second, time.Millisecond, "changes should be applied when the file is modified")
+}
+
+func TestConfigReloaderReload(t *testing.T) {
+	t.Parallel()
+	var r *ConfigReloader
+	_, err := r.Reload()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	path := writeReloadConfig(t, nil)
+	target := &fakeConfigReloadTarget{}
+	r, err = SetupConfigReloader(&config.ConfigReloader{}, path, nil, target)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+
+	result, err := r.Reload()
+	require.NoError(t, err, "Reload must not error")
+	assert.Empty(t, result.Applied, "Applied should be empty when the config is unchanged")
+	assert.Empty(t, target.changes, "changes should not be applied when the config is unchanged")
+
+	rewriteReloadConfig(t, path, func(c *config.Config) {
+		c.SyncManagerConfig.NumWorkers = 42
+		c.Name = "Renamed"
+	})
+	result, err = r.Reload()
+	assert.ErrorIs(t, err, errConfigReloadRejected)
+	assert.Equal(t, []string{"name"}, result.Rejected, "Rejected should list the changes requiring a restart")
+	assert.Empty(t, target.changes, "changes should not be applied when any are rejected")
+
+	rewriteReloadConfig(t, path, func(c *config.Config) { c.SyncManagerConfig.NumWorkers = 42 })
+	target.err = errFakeApply
+	_, err = r.Reload()
+	assert.ErrorIs(t, err, errFakeApply)
+	require.Len(t, target.changes, 1, "changes must be applied")
+	assert.True(t, target.changes[0].syncManager, "syncManager change should be applied")
+
+	target.err = nil
+	result, err = r.Reload()
+	require.NoError(t, err, "Reload must not error")
+	assert.Equal(t, []string{"applied"}, result.Applied, "Applied should return the applied changes")
+	require.Len(t, target.changes, 2, "failed changes must be applied again")
+	assert.Equal(t, 42, r.current.SyncManagerConfig.NumWorkers, "current should be updated after changes are applied")
+
+	_, err = r.Reload()
+	require.NoError(t, err, "Reload must not error")
+	assert.Len(t, target.changes, 2, "changes should not be applied twice")
+}
+
+func TestConfigReloaderReloadPartiallyApplied(t *testing.T) {
+	t.Parallel()
+	path := writeReloadConfig(t, nil)
+	target := &fakeConfigReloadTarget{applied: []string{"syncManager"}, err: errFakeApply}
+	r, err := SetupConfigReloader(&config.ConfigReloader{}, path, nil, target)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+
+	rewriteReloadConfig(t, path, func(c *config.Config) {
+		c.SyncManagerConfig.NumWorkers = 42
+		c.EventManager.Delay = time.Minute
+	})
+	_, err = r.Reload()
+	require.ErrorIs(t, err, errFakeApply)
+	assert.Equal(t, 42, r.current.SyncManagerConfig.NumWorkers, "current should be updated for the applied changes")
+	assert.NotEqual(t, time.Minute, r.current.EventManager.Delay, "current should not be updated for the failed changes")
+
+	target.applied, target.err = nil, nil
+	_, err = r.Reload()
+	require.NoError(t, err, "Reload must not error")
+	require.Len(t, target.changes, 2, "failed changes must be applied again")
+	assert.False(t, target.changes[1].syncManager, "applied changes should not be applied again")
+	assert.True(t, target.changes[1].eventManager, "failed changes should be applied again")
+}
+
+func TestConfigReloaderReloadAfterSave(t *testing.T) {
+	t.Parallel()
+	// The example config is saved with its exchange pairs checked, since enabled pairs which are not available are
+	// replaced at random each time it is read. The other defaults set on startup are left unset
+	example := &config.Config{}
+	require.NoError(t, example.ReadConfigFromFile("../config_example.json", true), "ReadConfigFromFile must not error")
+	require.NoError(t, example.CheckExchangeConfigValues(), "CheckExchangeConfigValues must not error")
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, example.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	target := &fakeConfigReloadTarget{}
+	r, err := SetupConfigReloader(&config.ConfigReloader{}, path, nil, target)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+
+	c := &config.Config{}
+	require.NoError(t, c.LoadConfig(path, true), "LoadConfig must not error")
+	require.NoError(t, c.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	_, err = r.Reload()
+	require.NoError(t, err, "Reload must not error after the config is saved with its defaults")
+	assert.Empty(t, target.changes, "changes should not be applied after the config is saved unchanged")
+
+	// Changes saved by the engine are taken as the config to compare against
+	c.SyncManagerConfig.NumWorkers = 42
+	require.NoError(t, c.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	require.NoError(t, r.configSaved(), "configSaved must not error")
+	_, err = r.Reload()
+	require.NoError(t, err, "Reload must not error")
+	assert.Empty(t, target.changes, "changes saved by the engine should not be applied")
+
+	rewriteModified := func(modify func(*config.Config)) {
+		t.Helper()
+		modified := &config.Config{}
+		require.NoError(t, modified.ReadConfigFromFile(path, true), "ReadConfigFromFile must not error")
+		modify(modified)
+		require.NoError(t, modified.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	}
+	rewriteModified(func(m *config.Config) { m.EventManager.Delay = time.Minute })
+	_, err = r.Reload()
+	require.NoError(t, err, "Reload must not error")
+	require.Len(t, target.changes, 1, "changes made after the save must be applied")
+	assert.True(t, target.changes[0].eventManager, "eventManager change should be applied")
+	assert.False(t, target.changes[0].syncManager, "syncManager change saved by the engine should not be applied")
+
+	assert.NoError(t, (*ConfigReloader)(nil).configSaved(), "configSaved should not error on a nil reloader")
+}
+
+func TestDiffConfig(t *testing.T) {
+	t.Parallel()
+	previous := loadReloadConfig(t)
+	c := diffConfig(previous, loadReloadConfig(t))
+	assert.Empty(t, c.rejected, "rejected should be empty for an unchanged config")
+	assert.Empty(t, c.exchanges, "exchanges should be empty for an unchanged config")
+	assert.False(t, c.syncManager, "syncManager should not be set for an unchanged config")
+	assert.False(t, c.orderManager, "orderManager should not be set for an unchanged config")
+	assert.False(t, c.orderRisk, "orderRisk should not be set for an unchanged config")
+	assert.False(t, c.eventManager, "eventManager should not be set for an unchanged config")
+	assert.False(t, c.withdrawalPolicy, "withdrawalPolicy should not be set for an unchanged config")
+	assert.False(t, c.remoteControl, "remoteControl should not be set for an unchanged config")
+
+	updated := loadReloadConfig(t)
+	updated.Name = "Renamed"
+	updated.GlobalHTTPTimeout = time.Minute
+	updated.SyncManagerConfig.SynchronizeTrades = !updated.SyncManagerConfig.SynchronizeTrades
+	updated.OrderManager.Verbose = !updated.OrderManager.Verbose
+	updated.OrderManager.PersistOrders = !updated.OrderManager.PersistOrders
+	updated.OrderManager.Risk.Limits.MaxOpenOrders = 5
+	updated.EventManager.Delay = time.Minute
+	updated.WithdrawalPolicy.AddressCoolingOffPeriod = time.Hour
+	updated.RemoteControl.Password = "changed"
+	updated.RemoteControl.Tokens = []config.RPCToken{{Name: "dashboard", Scopes: []string{RPCScopeRead}}}
+	c = diffConfig(previous, updated)
+	assert.ElementsMatch(t, []string{"name", "globalHTTPTimeout", "orderManager.persistOrders", "remoteControl.password"}, c.rejected, "rejected should list the changed paths")
+	assert.True(t, c.syncManager, "syncManager should be set")
+	assert.True(t, c.orderManager, "orderManager should be set")
+	assert.True(t, c.orderRisk, "orderRisk should be set")
+	assert.True(t, c.eventManager, "eventManager should be set")
+	assert.True(t, c.withdrawalPolicy, "withdrawalPolicy should be set")
+	assert.True(t, c.remoteControl, "remoteControl should be set")
+
+	updated = loadReloadConfig(t)
+	updated.Exchanges = updated.Exchanges[1:]
+	c = diffConfig(previous, updated)
+	assert.Equal(t, []string{"exchanges (added or removed)"}, c.rejected, "removing an exchange should be rejected")
+
+	updated = loadReloadConfig(t)
+	updated.Exchanges[0], updated.Exchanges[1] = updated.Exchanges[1], updated.Exchanges[0]
+	c = diffConfig(previous, updated)
+	assert.Equal(t, []string{"exchanges (renamed or reordered)"}, c.rejected, "reordering exchanges should be rejected")
+}
+
+func TestDiffExchanges(t *testing.T) {
+	t.Parallel()
+	previous := loadReloadConfig(t)
+	updated := loadReloadConfig(t)
+	bitstamp, err := updated.GetExchangeConfig("Bitstamp")
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	bitstamp.API.Credentials.Key = "NewKey"
+	bitstamp.Features.Enabled.Websocket = !bitstamp.Features.Enabled.Websocket
+	ps := bitstamp.CurrencyPairs.Pairs[asset.Spot]
+	require.NotNil(t, ps, "Bitstamp must have spot pairs")
+	require.NotEmpty(t, ps.Enabled, "Bitstamp must have enabled pairs")
+	ps.Enabled = ps.Enabled[1:]
+	bitstamp.HTTPTimeout = time.Minute
+
+	binance, err := updated.GetExchangeConfig("Binance")
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	binance.Enabled = false
+	binance.HTTPTimeout = time.Minute
+
+	c := diffConfig(previous, updated)
+	assert.Equal(t, []string{"exchanges.Bitstamp.httpTimeout"}, c.rejected, "only changes to a running exchange which cannot be applied should be rejected")
+	require.Len(t, c.exchanges, 2, "both exchanges must be changed")
+	for i := range c.exchanges {
+		switch c.exchanges[i].name {
+		case "Binance":
+			assert.True(t, c.exchanges[i].replace, "a disabled exchange should be replaced")
+		case "Bitstamp":
+			e := c.exchanges[i]
+			assert.False(t, e.replace, "a running exchange should not be replaced")
+			assert.True(t, e.credentials, "credentials should be set")
+			assert.True(t, e.websocket, "websocket should be set")
+			assert.Equal(t, []asset.Item{asset.Spot}, e.pairs, "pairs should list the changed asset")
+		default:
+			assert.Failf(t, "unexpected exchange change", "exchange %s", c.exchanges[i].name)
+		}
+	}
+}
+
+func TestDiffPairStores(t *testing.T) {
+	t.Parallel()
+	btcusdt, ethusdt := currency.NewBTCUSDT(), currency.NewPair(currency.ETH, currency.USDT)
+	newPairs := func() *currency.PairsManager {
+		return &currency.PairsManager{Pairs: map[asset.Item]*currency.PairStore{
+			asset.Spot: {
+				AssetEnabled:  true,
+				RequestFormat: &currency.PairFormat{Uppercase: true},
+				ConfigFormat:  &currency.PairFormat{Uppercase: true, Delimiter: "-"},
+				Available:     currency.Pairs{btcusdt, ethusdt},
+				Enabled:       currency.Pairs{btcusdt},
+			},
+			asset.Margin: {
+				RequestFormat: &currency.PairFormat{Uppercase: true},
+				ConfigFormat:  &currency.PairFormat{Uppercase: true, Delimiter: "-"},
+				Available:     currency.Pairs{btcusdt},
+			},
+		}}
+	}
+
+	changed, rejected := diffPairStores(newPairs(), newPairs())
+	assert.Empty(t, changed, "changed should be empty for unchanged pairs")
+	assert.Empty(t, rejected, "rejected should be empty for unchanged pairs")
+
+	updated := newPairs()
+	updated.Pairs[asset.Spot].Enabled = currency.Pairs{btcusdt, ethusdt}
+	updated.Pairs[asset.Margin].AssetEnabled = true
+	updated.Pairs[asset.Margin].RequestFormat = &currency.PairFormat{Delimiter: "_"}
+	changed, rejected = diffPairStores(newPairs(), updated)
+	assert.ElementsMatch(t, asset.Items{asset.Spot, asset.Margin}, changed, "changed should list the assets with changed pairs")
+	assert.Equal(t, []string{"margin.requestFormat"}, rejected, "format changes should be rejected")
+
+	updated = newPairs()
+	delete(updated.Pairs, asset.Margin)
+	_, rejected = diffPairStores(newPairs(), updated)
+	assert.Equal(t, []string{"margin (added or removed)"}, rejected, "removed assets should be rejected")
+}
+
+func TestChangedFields(t *testing.T) {
+	t.Parallel()
+	type Embedded struct {
+		Inner int `json:"inner"`
+	}
+	type nested struct {
+		Value string `json:"value"`
+	}
+	type testConfig struct {
+		Embedded
+		Name     string `json:"name,omitempty"`
+		Ignored  string `json:"-"`
+		Untagged int
+		Nested   nested        `json:"nested"`
+		Pointer  *nested       `json:"pointer"`
+		When     time.Time     `json:"when"`
+		Items    []string      `json:"items"`
+		Code     currency.Code `json:"code"`
+		private  int
+	}
+	a := testConfig{Pointer: &nested{}}
+	b := a
+	b.Pointer = &nested{}
+	assert.Empty(t, changedFields("", reflect.ValueOf(a), reflect.ValueOf(b)), "changedFields should return nothing for equal values")
+
+	b = testConfig{
+		Embedded: Embedded{Inner: 1},
+		Name:     "name",
+		Ignored:  "ignored",
+		Untagged: 1,
+		Nested:   nested{Value: "value"},
+		Pointer:  &nested{Value: "value"},
+		When:     time.Now(),
+		Items:    []string{"item"},
+		Code:     currency.BTC,
+		private:  1,
+	}
+	assert.ElementsMatch(t,
+		[]string{"inner", "name", "Untagged", "nested.value", "pointer.value", "when", "items", "code"},
+		changedFields("", reflect.ValueOf(a), reflect.ValueOf(b)),
+		"changedFields should return the JSON path of each changed field")
+
+	b.Pointer = nil
+	assert.Contains(t, changedFields("root", reflect.ValueOf(a), reflect.ValueOf(b)), "root.pointer", "changedFields should compare a nil pointer as a whole")
+}
+
+func TestApplyConfigChanges(t *testing.T) {
+	t.Parallel()
+	previous := loadReloadConfig(t)
+	updated := loadReloadConfig(t)
+	updated.SyncManagerConfig.SynchronizeTicker = !updated.SyncManagerConfig.SynchronizeTicker
+	updated.SyncManagerConfig.NumWorkers = 42
+	updated.OrderManager.CancelOrdersOnShutdown = !updated.OrderManager.CancelOrdersOnShutdown
+	updated.OrderManager.Risk.Enabled = true
+	updated.OrderManager.Risk.Rules = []config.RiskRule{{Exchange: "Bitstamp"}}
+	updated.EventManager.Delay = time.Minute
+	updated.WithdrawalPolicy.Limits = []config.WithdrawalLimit{{Currency: currency.BTC, DailyLimit: 1}}
+	updated.RemoteControl.Tokens = []config.RPCToken{{Name: "dashboard", Scopes: []string{RPCScopeRead}}}
+	bitstamp, err := updated.GetExchangeConfig("Bitstamp")
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	bitstamp.Enabled = false
+	bitstamp.HTTPTimeout = time.Minute
+
+	bot := &Engine{Config: loadReloadConfig(t), ExchangeManager: NewExchangeManager()}
+	c := diffConfig(previous, updated)
+	require.Empty(t, c.rejected, "changes must not be rejected")
+	applied, err := bot.applyConfigChanges(c)
+	require.NoError(t, err, "applyConfigChanges must not error")
+	assert.Equal(t, []string{"exchanges.Bitstamp", "syncManager", "orderManager", "orderManager.risk", "eventManager", "withdrawalPolicy", "remoteControl"}, applied, "applyConfigChanges should return the applied changes")
+
+	running, err := bot.Config.GetExchangeConfig("Bitstamp")
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	assert.False(t, running.Enabled, "Enabled should be replaced")
+	assert.Equal(t, time.Minute, running.HTTPTimeout, "HTTPTimeout should be replaced")
+	assert.NotSame(t, bitstamp.CurrencyPairs, running.CurrencyPairs, "replaced config should not share pairs with the reloaded config")
+	assert.Equal(t, 42, bot.Config.SyncManagerConfig.NumWorkers, "NumWorkers should be updated")
+	assert.Equal(t, updated.SyncManagerConfig.SynchronizeTicker, bot.Settings.EnableTickerSyncing, "EnableTickerSyncing should be updated")
+	assert.Equal(t, updated.OrderManager.CancelOrdersOnShutdown, bot.Config.OrderManager.CancelOrdersOnShutdown, "CancelOrdersOnShutdown should be updated")
+	assert.True(t, bot.Config.OrderManager.Risk.Enabled, "Risk should be updated")
+	assert.Len(t, bot.Config.OrderManager.Risk.Rules, 1, "Risk rules should be updated")
+	assert.Equal(t, time.Minute, bot.Config.EventManager.Delay, "EventManager Delay should be updated")
+	assert.Len(t, bot.Config.WithdrawalPolicy.Limits, 1, "Withdrawal limits should be updated")
+	assert.Len(t, bot.Config.RemoteControl.Tokens, 1, "gRPC tokens should be updated")
+
+	bot.Config.Exchanges = nil
+	_, err = bot.applyConfigChanges(c)
+	assert.ErrorIs(t, err, errConfigReloadExchangeMissing)
+}
+
+func TestApplyExchangeConfigChange(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: loadReloadConfig(t), ExchangeManager: NewExchangeManager()}
+	exchCfg, err := bot.Config.GetExchangeConfig("Bitstamp")
+	require.NoError(t, err, "GetExchangeConfig must not error")
+
+	updated := loadReloadConfig(t)
+	updatedCfg, err := updated.GetExchangeConfig("Bitstamp")
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	ps := updatedCfg.CurrencyPairs.Pairs[asset.Spot]
+	require.NotNil(t, ps, "Bitstamp must have spot pairs")
+	require.Greater(t, len(ps.Enabled), 1, "Bitstamp must have multiple enabled pairs")
+	ps.Enabled = ps.Enabled[:1]
+	updatedCfg.API.Credentials.Key = "NewKey"
+	change := &exchangeConfigChange{
+		name:        "Bitstamp",
+		previous:    exchCfg,
+		updated:     updatedCfg,
+		pairs:       []asset.Item{asset.Spot},
+		credentials: true,
+	}
+	_, err = bot.applyExchangeConfigChange(change)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	exch, err := bot.ExchangeManager.NewExchangeByName("Bitstamp")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Config = exchCfg
+	require.NoError(t, b.SetConfigPairs(), "SetConfigPairs must not error")
+	require.NoError(t, bot.ExchangeManager.Add(exch), "Add must not error")
+
+	applied, err := bot.applyExchangeConfigChange(change)
+	require.NoError(t, err, "applyExchangeConfigChange must not error")
+	assert.Equal(t, []string{"exchanges.Bitstamp.currencyPairs.pairs.spot", "exchanges.Bitstamp.api"}, applied, "applyExchangeConfigChange should return the applied changes")
+	enabled, err := exch.GetEnabledPairs(asset.Spot)
+	require.NoError(t, err, "GetEnabledPairs must not error")
+	assert.True(t, enabled.Equal(ps.Enabled), "enabled pairs should be updated")
+	assert.Equal(t, "NewKey", exchCfg.API.Credentials.Key, "config credentials should be updated")
+	assert.Equal(t, "NewKey", exch.GetBase().GetDefaultCredentials().Key, "exchange credentials should be updated")
+}
+
+func TestApplyOrderManagerConfig(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager(), CommunicationsManager: &CommunicationManager{}}
+	previous := &config.OrderManager{}
+	updated := &config.OrderManager{Enabled: true, Verbose: true, Risk: config.OrderRisk{Enabled: true}}
+	require.NoError(t, bot.applyOrderManagerConfig(previous, updated), "applyOrderManagerConfig must not error")
+	require.True(t, bot.OrderManager.IsRunning(), "order manager must be started when enabled")
+	t.Cleanup(func() {
+		if bot.OrderManager.IsRunning() {
+			assert.NoError(t, bot.OrderManager.Stop(), "Stop should not error")
+		}
+	})
+	assert.True(t, bot.Settings.EnableOrderManager, "EnableOrderManager should be updated")
+	assert.True(t, bot.OrderManager.verbose.Load(), "verbose should be set from the updated config")
+	assert.False(t, bot.Config.OrderManager.Risk.Enabled, "Risk should only be updated by the risk config change")
+
+	previous, updated = updated, &config.OrderManager{Enabled: true, ActivelyTrackFuturesPositions: true}
+	assert.ErrorIs(t, bot.applyOrderManagerConfig(previous, updated), errInvalidFuturesTrackingSeekDuration)
+
+	updated.FuturesTrackingSeekDuration = time.Hour
+	updated.RespectOrderHistoryLimits = true
+	updated.CancelOrdersOnShutdown = true
+	require.NoError(t, bot.applyOrderManagerConfig(previous, updated), "applyOrderManagerConfig must not error")
+	assert.False(t, bot.OrderManager.verbose.Load(), "verbose should be updated")
+	assert.True(t, bot.OrderManager.activelyTrackFuturesPositions.Load(), "activelyTrackFuturesPositions should be updated")
+	assert.Equal(t, int64(time.Hour), bot.OrderManager.futuresPositionSeekDuration.Load(), "futuresPositionSeekDuration should be updated")
+	assert.True(t, bot.OrderManager.respectOrderHistoryLimits.Load(), "respectOrderHistoryLimits should be updated")
+	assert.True(t, bot.OrderManager.cancelOrdersOnShutdown.Load(), "cancelOrdersOnShutdown should be updated")
+	assert.True(t, bot.Config.OrderManager.ActivelyTrackFuturesPositions, "config should be updated")
+
+	previous, updated = updated, &config.OrderManager{}
+	require.NoError(t, bot.applyOrderManagerConfig(previous, updated), "applyOrderManagerConfig must not error")
+	assert.False(t, bot.OrderManager.IsRunning(), "order manager should be stopped when disabled")
+	assert.False(t, bot.Settings.EnableOrderManager, "EnableOrderManager should be updated")
+}
+
+func TestApplyEventManagerConfig(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager(), CommunicationsManager: &CommunicationManager{}}
+	previous := &config.EventManager{Enabled: convert.BoolPtr(false), Delay: time.Second}
+	updated := &config.EventManager{Enabled: convert.BoolPtr(true), Delay: time.Second}
+	require.NoError(t, bot.applyEventManagerConfig(previous, updated), "applyEventManagerConfig must not error")
+	require.True(t, bot.eventManager.IsRunning(), "event manager must be started when enabled")
+	t.Cleanup(func() {
+		if bot.eventManager.IsRunning() {
+			assert.NoError(t, bot.eventManager.Stop(), "Stop should not error")
+		}
+	})
+	assert.True(t, bot.Settings.EnableEventManager, "EnableEventManager should be updated")
+	assert.Equal(t, time.Second, bot.eventManager.sleepDelay, "sleepDelay should be set from the updated config")
+	assert.NotSame(t, updated.Enabled, bot.Config.EventManager.Enabled, "running config should not share Enabled with the reloaded config")
+
+	previous, updated = updated, &config.EventManager{Enabled: convert.BoolPtr(true), Delay: time.Minute}
+	require.NoError(t, bot.applyEventManagerConfig(previous, updated), "applyEventManagerConfig must not error")
+	assert.True(t, bot.eventManager.IsRunning(), "event manager should be restarted")
+	assert.Equal(t, time.Minute, bot.eventManager.sleepDelay, "sleepDelay should be updated")
+	assert.Equal(t, time.Minute, bot.Settings.EventManagerDelay, "EventManagerDelay should be updated")
+	assert.Equal(t, time.Minute, bot.Config.EventManager.Delay, "config should be updated")
+
+	previous, updated = updated, &config.EventManager{Enabled: convert.BoolPtr(false), Delay: time.Minute}
+	require.NoError(t, bot.applyEventManagerConfig(previous, updated), "applyEventManagerConfig must not error")
+	assert.False(t, bot.eventManager.IsRunning(), "event manager should be stopped when disabled")
+	assert.False(t, bot.Settings.EnableEventManager, "EnableEventManager should be updated")
+}
+
+func TestApplyRemoteControlConfig(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{RemoteControl: config.RemoteControlConfig{Username: "admin"}}}
+	err := bot.applyRemoteControlConfig(&config.RemoteControlConfig{Username: "admin", Tokens: []config.RPCToken{{Name: "admin", Scopes: []string{RPCScopeRead}}}})
+	assert.ErrorIs(t, err, errRPCTokenNameReserved)
+
+	updated := &config.RemoteControlConfig{Username: "admin", Tokens: []config.RPCToken{{Name: "dashboard", Scopes: []string{RPCScopeRead}}}}
+	require.NoError(t, bot.applyRemoteControlConfig(updated), "applyRemoteControlConfig must not error")
+	require.Len(t, bot.Config.RemoteControl.Tokens, 1, "tokens must be updated")
+
+	bot.rpcAuth = setupRPCAuthTest(t)
+	_, err = bot.rpcAuth.authenticate(basicAuthHeader("reader", "reader"))
+	require.NoError(t, err, "authenticate must not error")
+	require.NoError(t, bot.applyRemoteControlConfig(&config.RemoteControlConfig{Username: "admin"}), "applyRemoteControlConfig must not error")
+	_, err = bot.rpcAuth.authenticate(basicAuthHeader("reader", "reader"))
+	assert.ErrorIs(t, err, errRPCCredentialsMismatch, "a removed principal should not authenticate with cached credentials")
+}
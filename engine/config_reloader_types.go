@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// ConfigReloaderName is an exported subsystem name
+const ConfigReloaderName = "config_reloader"
+
+const defaultConfigReloaderCheckInterval = 5 * time.Second
+
+var (
+	errConfigReloadRejected        = errors.New("config changes cannot be applied without a restart")
+	errConfigReloadNoKeyProvider   = errors.New("an encrypted config cannot be reloaded without an encryption key provider")
+	errConfigReloadPathUnset       = errors.New("config file path unset")
+	errNilConfigReloadTarget       = errors.New("config reload target is nil")
+	errConfigReloadExchangeMissing = errors.New("exchange config not found")
+)
+
+// iConfigReloadTarget applies reloaded config changes to the running engine
+type iConfigReloadTarget interface {
+	applyConfigChanges(*configChanges) ([]string, error)
+}
+
+// ConfigReloader watches the config file and applies changes made to it to
+// the running engine. Changes are compared against the config last read from
+// the file, so command line overrides and runtime changes to the running
+// config are not reported as changes
+type ConfigReloader struct {
+	started       int32
+	verbose       bool
+	path          string
+	checkInterval time.Duration
+	keyProvider   config.EncryptionKeyProvider
+	target        iConfigReloadTarget
+	shutdown      chan struct{}
+	wg            sync.WaitGroup
+
+	m       sync.Mutex
+	current *config.Config
+	modTime time.Time
+
+	// saved holds the config file as last saved by the engine. It is taken as
+	// the config to compare against by the next reload, so that the engine's
+	// own changes are not reported as changes to the file
+	saved atomic.Pointer[savedConfig]
+}
+
+// savedConfig is a config file read after being saved by the engine
+type savedConfig struct {
+	config  *config.Config
+	modTime time.Time
+}
+
+// ConfigReloadResult lists the changes applied by a config reload and the
+// changes which prevented it from being applied
+type ConfigReloadResult struct {
+	Applied  []string
+	Rejected []string
+}
+
+// configChanges holds the differences between the config last read from the
+// file and the updated config which can be applied to the running engine
+type configChanges struct {
+	previous         *config.Config
+	updated          *config.Config
+	exchanges        []exchangeConfigChange
+	syncManager      bool
+	orderManager     bool
+	orderRisk        bool
+	eventManager     bool
+	withdrawalPolicy bool
+	remoteControl    bool
+	rejected         []string
+}
+
+// exchangeConfigChange holds the changes to an exchange config
+type exchangeConfigChange struct {
+	name     string
+	previous *config.Exchange
+	updated  *config.Exchange
+	// replace is set when the exchange is enabled or disabled, or changes are
+	// made to an exchange which is not running, so its whole config is
+	// replaced
+	replace     bool
+	pairs       []asset.Item
+	credentials bool
+	websocket   bool
+}
@@ -12,15 +12,22 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	dbfundingrate "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -69,6 +76,9 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 		tradeSaver:                 trade.SaveTradesToDatabase,
 		candleLoader:               kline.LoadFromDatabase,
 		candleSaver:                kline.StoreInDatabase,
+		fundingRateSaver:           dbfundingrate.Insert,
+		openInterestSaver:          openinterest.Insert,
+		orderbookSnapshotSaver:     orderbooksnapshot.Insert,
 	}, nil
 }
 
@@ -196,7 +206,10 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 			if err != nil {
 				return err
 			}
-		case dataHistoryTradeDataType:
+		case dataHistoryTradeDataType,
+			dataHistoryFundingRateDataType,
+			dataHistoryOpenInterestDataType,
+			dataHistoryOrderbookSnapshotDataType:
 			for x := range jobs[i].rangeHolder.Ranges {
 				results, ok := jobs[i].Results[jobs[i].rangeHolder.Ranges[x].Start.Time.Unix()]
 				if !ok {
@@ -357,6 +370,7 @@ func (m *DataHistoryManager) runDataJob(job *DataHistoryJob, exch exchange.IBotE
 	var intervalsProcessed uint64
 	var err error
 	var result *DataHistoryJobResult
+	now := time.Now()
 ranges:
 	for i := range job.rangeHolder.Ranges {
 		skipProcessing := true
@@ -435,6 +449,19 @@ ranges:
 		if hasDataInRange {
 			continue
 		}
+		if job.DataType.isCollection() {
+			collectable, missed := collectionRangeStatus(job.DataType, &job.rangeHolder.Ranges[i], now)
+			if missed {
+				err = m.missedRange(job, &job.rangeHolder.Ranges[i])
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if !collectable {
+				continue
+			}
+		}
 		if m.verbose {
 			log.Debugf(log.DataHistory, "job %s processing range %v-%v", job.Nickname, job.rangeHolder.Ranges[i].Start, job.rangeHolder.Ranges[i].End)
 		}
@@ -450,6 +477,12 @@ ranges:
 			result, err = m.convertTradesToCandles(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
 		case dataHistoryConvertCandlesDataType:
 			result, err = m.convertCandleData(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryFundingRateDataType:
+			result, err = m.processFundingRateData(job, exch, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryOpenInterestDataType:
+			result, err = m.processOpenInterestData(job, exch, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryOrderbookSnapshotDataType:
+			result, err = m.processOrderbookSnapshotData(job, exch, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
 		default:
 			return errUnknownDataType
 		}
@@ -893,6 +926,261 @@ func (m *DataHistoryManager) convertCandleData(job *DataHistoryJob, startRange,
 	return r, err
 }
 
+// collectionRangeStatus determines whether a range of a collection data type
+// can be processed. Funding rate ranges are backfilled once they have elapsed,
+// whereas open interest and orderbook snapshots can only be taken while their
+// range is current, so an elapsed snapshot range has been missed
+func collectionRangeStatus(dataType dataHistoryDataType, r *kline.IntervalRange, now time.Time) (collectable, missed bool) {
+	if now.Before(r.Start.Time) {
+		return false, false
+	}
+	elapsed := !now.Before(r.End.Time)
+	if dataType.isSnapshot() {
+		return !elapsed, elapsed
+	}
+	return elapsed, false
+}
+
+// missedRange flags a snapshot range which elapsed before data could be
+// collected so that the job can complete without retrying it
+func (m *DataHistoryManager) missedRange(job *DataHistoryJob, r *kline.IntervalRange) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	lookup := job.Results[r.Start.Time.Unix()]
+	for i := range lookup {
+		lookup[i].Status = dataHistoryIntervalIssuesFound
+	}
+	job.Results[r.Start.Time.Unix()] = append(lookup, DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: r.Start.Time,
+		IntervalEndDate:   r.End.Time,
+		Status:            dataHistoryIntervalIssuesFound,
+		Result: fmt.Sprintf("%s cannot be backfilled, range %v - %v elapsed before it was collected. ",
+			job.DataType,
+			r.Start.Time.Format(common.SimpleTimeFormatWithTimezone),
+			r.End.Time.Format(common.SimpleTimeFormatWithTimezone)),
+		Date: time.Now(),
+	})
+	if m.verbose {
+		log.Debugf(log.DataHistory, "job %s missed range %v-%v", job.Nickname, r.Start, r.End)
+	}
+	return nil
+}
+
+func (m *DataHistoryManager) processFundingRateData(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	rates, err := exch.GetHistoricalFundingRates(context.TODO(), &fundingrate.HistoricalRatesRequest{
+		Asset:     job.Asset,
+		Pair:      job.Pair,
+		StartDate: startRange,
+		EndDate:   endRange,
+	})
+	if err != nil {
+		r.Result += "could not get funding rates: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	data := make([]dbfundingrate.Data, 0, len(rates.FundingRates))
+	for i := range rates.FundingRates {
+		if rates.FundingRates[i].Time.Before(startRange) || !rates.FundingRates[i].Time.Before(endRange) {
+			continue
+		}
+		data = append(data, dbfundingrate.Data{
+			Exchange:  job.Exchange,
+			Base:      job.Pair.Base.String(),
+			Quote:     job.Pair.Quote.String(),
+			AssetType: job.Asset.String(),
+			Rate:      rates.FundingRates[i].Rate.InexactFloat64(),
+			Timestamp: rates.FundingRates[i].Time,
+		})
+	}
+	if len(data) == 0 {
+		r.Status = dataHistoryStatusFailed
+		r.Result += fmt.Sprintf("missing data from %v - %v. ",
+			startRange.Format(common.SimpleTimeFormatWithTimezone),
+			endRange.Format(common.SimpleTimeFormatWithTimezone))
+		return r, nil
+	}
+	saveInBatches(m, "funding rates", data, m.fundingRateSaver, r)
+	return r, nil
+}
+
+func (m *DataHistoryManager) processOpenInterestData(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	openInterest, err := exch.GetOpenInterest(context.TODO(), key.PairAsset{
+		Base:  job.Pair.Base.Item,
+		Quote: job.Pair.Quote.Item,
+		Asset: job.Asset,
+	})
+	if err != nil {
+		r.Result += "could not get open interest: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	if len(openInterest) == 0 {
+		r.Result += "no open interest returned. "
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	saveInBatches(m, "open interest", []openinterest.Data{
+		{
+			Exchange:     job.Exchange,
+			Base:         job.Pair.Base.String(),
+			Quote:        job.Pair.Quote.String(),
+			AssetType:    job.Asset.String(),
+			OpenInterest: openInterest[0].OpenInterest,
+			Timestamp:    r.Date,
+		},
+	}, m.openInterestSaver, r)
+	return r, nil
+}
+
+func (m *DataHistoryManager) processOrderbookSnapshotData(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	book, err := exch.UpdateOrderbook(context.TODO(), job.Pair, job.Asset)
+	if err != nil {
+		r.Result += "could not get orderbook: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	levels, err := orderbookSnapshotLevels(job, book, r.Date)
+	if err != nil {
+		r.Result += "could not snapshot orderbook: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	if len(levels) == 0 {
+		r.Result += "orderbook is empty. "
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	saveInBatches(m, "orderbook levels", levels, m.orderbookSnapshotSaver, r)
+	return r, nil
+}
+
+// orderbookSnapshotLevels converts the top levels of each side of an orderbook
+// to database levels, limited to the job's orderbook depth
+func orderbookSnapshotLevels(job *DataHistoryJob, book *orderbook.Book, timestamp time.Time) ([]orderbooksnapshot.Data, error) {
+	if book == nil {
+		return nil, errNilOrderbook
+	}
+	bids := book.Bids[:min(uint64(len(book.Bids)), job.OrderbookDepth)]
+	asks := book.Asks[:min(uint64(len(book.Asks)), job.OrderbookDepth)]
+	levels := make([]orderbooksnapshot.Data, 0, len(bids)+len(asks))
+	for _, side := range []struct {
+		name   string
+		levels orderbook.Levels
+	}{
+		{name: orderbooksnapshot.Bid, levels: bids},
+		{name: orderbooksnapshot.Ask, levels: asks},
+	} {
+		for i := range side.levels {
+			levels = append(levels, orderbooksnapshot.Data{
+				Exchange:  job.Exchange,
+				Base:      job.Pair.Base.String(),
+				Quote:     job.Pair.Quote.String(),
+				AssetType: job.Asset.String(),
+				Side:      side.name,
+				Level:     i,
+				Price:     side.levels[i].Price,
+				Amount:    side.levels[i].Amount,
+				Timestamp: timestamp,
+			})
+		}
+	}
+	return levels, nil
+}
+
+// saveInBatches saves data in batches no larger than the maximum result
+// insertions, recording any failures to save in the job result
+func saveInBatches[T any](m *DataHistoryManager, name string, data []T, saver func(...T) error, r *DataHistoryJobResult) {
+	if m.maxResultInsertions <= 0 {
+		m.maxResultInsertions = defaultMaxResultInsertions
+	}
+	for i := 0; i < len(data); i += int(m.maxResultInsertions) {
+		end := min(i+int(m.maxResultInsertions), len(data))
+		if m.verbose {
+			log.Debugf(log.DataHistory, "Saving %v %s. Range %v-%v/%v", end-i, name, i, end, len(data))
+		}
+		if err := saver(data[i:end]...); err != nil {
+			r.Result += "could not save results: " + err.Error() + ". "
+			r.Status = dataHistoryStatusFailed
+		}
+	}
+}
+
 func (m *DataHistoryManager) validateCandles(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
 	if !m.IsRunning() {
 		return nil, ErrSubSystemNotStarted
@@ -1266,9 +1554,41 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 		}
 	}
 
+	switch job.DataType {
+	case dataHistoryFundingRateDataType:
+		if !job.Asset.IsFutures() {
+			return fmt.Errorf("job %s %w %s", job.Nickname, futures.ErrNotFuturesAsset, job.Asset)
+		}
+		if !b.Features.Supports.FuturesCapabilities.FundingRates {
+			return fmt.Errorf("job %s funding rates %w for exchange %s", job.Nickname, common.ErrFunctionNotSupported, job.Exchange)
+		}
+	case dataHistoryOpenInterestDataType:
+		if !job.Asset.IsFutures() {
+			return fmt.Errorf("job %s %w %s", job.Nickname, futures.ErrNotFuturesAsset, job.Asset)
+		}
+		if !b.Features.Supports.FuturesCapabilities.OpenInterest.Supported {
+			return fmt.Errorf("job %s open interest %w for exchange %s", job.Nickname, common.ErrFunctionNotSupported, job.Exchange)
+		}
+	case dataHistoryOrderbookSnapshotDataType:
+		if job.OrderbookDepth == 0 {
+			log.Warnf(log.DataHistory, "job %s has unset orderbook depth, defaulting to %v levels per side", job.Nickname, defaultDataHistoryOrderbookDepth)
+			job.OrderbookDepth = defaultDataHistoryOrderbookDepth
+		}
+	}
+	if job.DataType.isCollection() && job.Interval <= 0 {
+		return fmt.Errorf("job interval %s %s %w %s", job.Nickname, job.Interval.Word(), kline.ErrUnsupportedInterval, job.Exchange)
+	}
+	if job.DataType.isSnapshot() && job.RequestSizeLimit != 1 {
+		// each range holds a single snapshot so that it can be taken
+		// while the range is current
+		job.RequestSizeLimit = 1
+	}
+
 	job.StartDate = job.StartDate.Round(job.Interval.Duration())
 	job.EndDate = job.EndDate.Round(job.Interval.Duration())
-	if err := common.StartEndTimeCheck(job.StartDate, job.EndDate); err != nil {
+	if err := common.StartEndTimeCheck(job.StartDate, job.EndDate); err != nil &&
+		(!job.DataType.isCollection() || !errors.Is(err, common.ErrStartAfterTimeNow)) {
+		// collection jobs may be scheduled to start in the future
 		return fmt.Errorf("job %s %w start: %v end %v", job.Nickname, err, job.StartDate, job.EndDate)
 	}
 
@@ -1524,6 +1844,7 @@ func (m *DataHistoryManager) convertDBModelToJob(dbModel *datahistoryjob.DataHis
 		SecondaryExchangeSource:  dbModel.SecondarySourceExchangeName,
 		IssueTolerancePercentage: dbModel.IssueTolerancePercentage,
 		ReplaceOnIssue:           dbModel.ReplaceOnIssue,
+		OrderbookDepth:           dbModel.OrderbookDepth,
 		PrerequisiteJobNickname:  dbModel.PrerequisiteJobNickname,
 	}
 	if resp.PrerequisiteJobNickname != "" {
@@ -1610,6 +1931,7 @@ func (m *DataHistoryManager) convertJobToDBModel(job *DataHistoryJob) *datahisto
 		SecondarySourceExchangeName: job.SecondaryExchangeSource,
 		IssueTolerancePercentage:    job.IssueTolerancePercentage,
 		ReplaceOnIssue:              job.ReplaceOnIssue,
+		OrderbookDepth:              job.OrderbookDepth,
 	}
 	if job.ID != uuid.Nil {
 		model.ID = job.ID.String()
@@ -10,18 +10,26 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	dbfundingrate "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
@@ -422,6 +430,68 @@ func TestValidateJob(t *testing.T) {
 	assert.ErrorIs(t, err, errExchangeNameUnset)
 }
 
+func TestValidateCollectionJob(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs[asset.PerpetualSwap] = &currency.PairStore{
+		Available:    currency.Pairs{currency.NewBTCUSDT()},
+		Enabled:      currency.Pairs{currency.NewBTCUSDT()},
+		AssetEnabled: true,
+	}
+
+	dhj := &DataHistoryJob{
+		Nickname:  "TestValidateCollectionJob",
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewBTCUSDT(),
+		DataType:  dataHistoryFundingRateDataType,
+		StartDate: time.Now().Add(-time.Hour * 24),
+		EndDate:   time.Now().Add(time.Hour * 24),
+	}
+	err = m.validateJob(dhj)
+	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
+
+	dhj.Asset = asset.PerpetualSwap
+	err = m.validateJob(dhj)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+
+	b.Features.Supports.FuturesCapabilities.FundingRates = true
+	err = m.validateJob(dhj)
+	assert.ErrorIs(t, err, kline.ErrUnsupportedInterval)
+
+	dhj.Interval = kline.EightHour
+	err = m.validateJob(dhj)
+	require.NoError(t, err, "validateJob must not error for an end date in the future")
+	assert.Equal(t, defaultDataHistoryRequestSizeLimit, dhj.RequestSizeLimit, "funding rate requests should not be limited to a single interval")
+
+	dhj.DataType = dataHistoryOpenInterestDataType
+	dhj.Interval = kline.OneHour
+	err = m.validateJob(dhj)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+
+	b.Features.Supports.FuturesCapabilities.OpenInterest.Supported = true
+	err = m.validateJob(dhj)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), dhj.RequestSizeLimit, "snapshot ranges should hold a single interval")
+
+	dhj.DataType = dataHistoryOrderbookSnapshotDataType
+	dhj.Asset = asset.Spot
+	err = m.validateJob(dhj)
+	require.NoError(t, err)
+	assert.Equal(t, defaultDataHistoryOrderbookDepth, dhj.OrderbookDepth)
+
+	dhj.StartDate = time.Now().Add(time.Hour * 2)
+	err = m.validateJob(dhj)
+	assert.NoError(t, err, "collection jobs should be able to start in the future")
+
+	dhj.DataType = dataHistoryConvertTradesDataType
+	err = m.validateJob(dhj)
+	assert.ErrorIs(t, err, common.ErrStartAfterTimeNow)
+}
+
 func TestGetAllJobStatusBetween(t *testing.T) {
 	t.Parallel()
 	m, _ := createDHM(t)
@@ -1315,6 +1385,224 @@ func dataHistoryCandleLoader(exch string, cp currency.Pair, a asset.Item, interv
 	}, nil
 }
 
+func TestCollectionRangeStatus(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	past := &kline.IntervalRange{
+		Start: kline.CreateIntervalTime(now.Add(-time.Hour * 2)),
+		End:   kline.CreateIntervalTime(now.Add(-time.Hour)),
+	}
+	current := &kline.IntervalRange{
+		Start: kline.CreateIntervalTime(now.Add(-time.Minute)),
+		End:   kline.CreateIntervalTime(now.Add(time.Minute)),
+	}
+	future := &kline.IntervalRange{
+		Start: kline.CreateIntervalTime(now.Add(time.Hour)),
+		End:   kline.CreateIntervalTime(now.Add(time.Hour * 2)),
+	}
+	for _, tc := range []struct {
+		dataType    dataHistoryDataType
+		r           *kline.IntervalRange
+		collectable bool
+		missed      bool
+	}{
+		{dataHistoryFundingRateDataType, past, true, false},
+		{dataHistoryFundingRateDataType, current, false, false},
+		{dataHistoryFundingRateDataType, future, false, false},
+		{dataHistoryOpenInterestDataType, past, false, true},
+		{dataHistoryOpenInterestDataType, current, true, false},
+		{dataHistoryOpenInterestDataType, future, false, false},
+		{dataHistoryOrderbookSnapshotDataType, past, false, true},
+		{dataHistoryOrderbookSnapshotDataType, current, true, false},
+		{dataHistoryOrderbookSnapshotDataType, future, false, false},
+	} {
+		collectable, missed := collectionRangeStatus(tc.dataType, tc.r, now)
+		assert.Equalf(t, tc.collectable, collectable, "%s collectable should be correct for range %v", tc.dataType, tc.r.Start.Time)
+		assert.Equalf(t, tc.missed, missed, "%s missed should be correct for range %v", tc.dataType, tc.r.Start.Time)
+	}
+}
+
+func TestProcessFundingRateData(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.processFundingRateData(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	j := &DataHistoryJob{
+		Exchange: testExchange,
+		Asset:    asset.PerpetualSwap,
+		Pair:     currency.NewBTCUSDT(),
+		Interval: kline.EightHour,
+	}
+	_, err = m.processFundingRateData(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+	_, err = m.processFundingRateData(j, exch, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	start := time.Now().Add(-time.Hour * 24).Truncate(kline.EightHour.Duration())
+	end := start.Add(kline.EightHour.Duration() * 2)
+	r, err := m.processFundingRateData(j, exch, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "an unsupported exchange should fail the result")
+
+	var saved []dbfundingrate.Data
+	m.fundingRateSaver = func(rates ...dbfundingrate.Data) error {
+		saved = append(saved, rates...)
+		return nil
+	}
+	r, err = m.processFundingRateData(j, dhmExchange{IBotExchange: exch}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusComplete, r.Status)
+	require.Len(t, saved, 2, "rates outside of the range must not be saved")
+	assert.Equal(t, start, saved[0].Timestamp)
+	assert.Equal(t, 0.0001, saved[0].Rate)
+	assert.Equal(t, asset.PerpetualSwap.String(), saved[1].AssetType)
+
+	r, err = m.processFundingRateData(j, dhmExchange{IBotExchange: exch}, start.Add(time.Hour), start.Add(time.Hour*2))
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "a range without funding rates should fail the result")
+
+	m.fundingRateSaver = func(...dbfundingrate.Data) error {
+		return errDataHistoryTest
+	}
+	r, err = m.processFundingRateData(j, dhmExchange{IBotExchange: exch}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "failing to save should fail the result")
+}
+
+func TestProcessOpenInterestData(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.processOpenInterestData(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	j := &DataHistoryJob{
+		Exchange: testExchange,
+		Asset:    asset.PerpetualSwap,
+		Pair:     currency.NewBTCUSDT(),
+		Interval: kline.OneHour,
+	}
+	_, err = m.processOpenInterestData(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+	_, err = m.processOpenInterestData(j, exch, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	start := time.Now().Truncate(time.Hour)
+	end := start.Add(time.Hour)
+	r, err := m.processOpenInterestData(j, exch, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "an unsupported exchange should fail the result")
+
+	var saved []openinterest.Data
+	m.openInterestSaver = func(oi ...openinterest.Data) error {
+		saved = append(saved, oi...)
+		return nil
+	}
+	r, err = m.processOpenInterestData(j, dhmExchange{IBotExchange: exch}, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusComplete, r.Status)
+	require.Len(t, saved, 1)
+	assert.Equal(t, 1337.0, saved[0].OpenInterest)
+	assert.Equal(t, r.Date, saved[0].Timestamp, "open interest should be stored at the time it was taken")
+}
+
+func TestProcessOrderbookSnapshotData(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.processOrderbookSnapshotData(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	j := &DataHistoryJob{
+		Exchange:       testExchange,
+		Asset:          asset.Spot,
+		Pair:           currency.NewBTCUSDT(),
+		Interval:       kline.OneMin,
+		OrderbookDepth: 2,
+	}
+	_, err = m.processOrderbookSnapshotData(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+	_, err = m.processOrderbookSnapshotData(j, exch, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	var saved []orderbooksnapshot.Data
+	m.orderbookSnapshotSaver = func(levels ...orderbooksnapshot.Data) error {
+		saved = append(saved, levels...)
+		return nil
+	}
+	start := time.Now().Truncate(time.Minute)
+	r, err := m.processOrderbookSnapshotData(j, dhmExchange{IBotExchange: exch}, start, start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusComplete, r.Status)
+	require.Len(t, saved, 4, "only the orderbook depth of each side should be saved")
+	assert.Equal(t, orderbooksnapshot.Bid, saved[0].Side)
+	assert.Equal(t, 99.0, saved[0].Price)
+	assert.Equal(t, 1, saved[1].Level)
+	assert.Equal(t, orderbooksnapshot.Ask, saved[3].Side)
+	assert.Equal(t, 102.0, saved[3].Price)
+
+	_, err = orderbookSnapshotLevels(j, nil, start)
+	assert.ErrorIs(t, err, errNilOrderbook)
+}
+
+func TestRunDataJobCollection(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+	var snapshots int
+	m.orderbookSnapshotSaver = func(...orderbooksnapshot.Data) error {
+		snapshots++
+		return nil
+	}
+
+	now := time.Now().Truncate(time.Hour)
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	j := &DataHistoryJob{
+		ID:               id,
+		Nickname:         "TestRunDataJobCollection",
+		Exchange:         testExchange,
+		Asset:            asset.Spot,
+		Pair:             currency.NewBTCUSDT(),
+		StartDate:        now.Add(-time.Hour * 2),
+		EndDate:          now.Add(time.Hour * 2),
+		Interval:         kline.OneHour,
+		RunBatchLimit:    3,
+		MaxRetryAttempts: 3,
+		DataType:         dataHistoryOrderbookSnapshotDataType,
+		OrderbookDepth:   1,
+		Status:           dataHistoryStatusActive,
+		Results:          make(map[int64][]DataHistoryJobResult),
+	}
+	j.rangeHolder, err = kline.CalculateCandleDateRanges(j.StartDate, j.EndDate, j.Interval, 1)
+	require.NoError(t, err)
+	require.Len(t, j.rangeHolder.Ranges, 4)
+
+	require.NoError(t, m.runDataJob(j, dhmExchange{IBotExchange: exch}))
+	assert.Equal(t, 1, snapshots, "only the current range should be snapshotted")
+	for i, status := range []dataHistoryStatus{dataHistoryIntervalIssuesFound, dataHistoryIntervalIssuesFound, dataHistoryStatusComplete} {
+		results := j.Results[j.rangeHolder.Ranges[i].Start.Time.Unix()]
+		require.Lenf(t, results, 1, "range %d should have a result", i)
+		assert.Equalf(t, status, results[0].Status, "range %d should have the correct status", i)
+	}
+	assert.Empty(t, j.Results[j.rangeHolder.Ranges[3].Start.Time.Unix()], "a future range should not be processed")
+	assert.Equal(t, dataHistoryStatusActive, j.Status, "the job should remain active until its end date")
+
+	require.NoError(t, m.runDataJob(j, dhmExchange{IBotExchange: exch}))
+	assert.Equal(t, 1, snapshots, "a completed range should not be snapshotted again")
+}
+
+var errDataHistoryTest = errors.New("data history test error")
+
 func dataHistoryTradeSaver(...trade.Data) error {
 	return nil
 }
@@ -1386,3 +1674,43 @@ func (f dhmExchange) GetHistoricTrades(_ context.Context, p currency.Pair, a ass
 		},
 	}, nil
 }
+
+func (f dhmExchange) GetHistoricalFundingRates(_ context.Context, r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	fundingTime := r.StartDate.Truncate(kline.EightHour.Duration())
+	return &fundingrate.HistoricalRates{
+		Exchange: testExchange,
+		Asset:    r.Asset,
+		Pair:     r.Pair,
+		FundingRates: []fundingrate.Rate{
+			{Time: fundingTime.Add(-kline.EightHour.Duration()), Rate: decimal.NewFromFloat(0.0003)},
+			{Time: fundingTime, Rate: decimal.NewFromFloat(0.0001)},
+			{Time: fundingTime.Add(kline.EightHour.Duration()), Rate: decimal.NewFromFloat(-0.0002)},
+			{Time: fundingTime.Add(kline.EightHour.Duration() * 2), Rate: decimal.NewFromFloat(0.0003)},
+		},
+	}, nil
+}
+
+func (f dhmExchange) GetOpenInterest(_ context.Context, k ...key.PairAsset) ([]futures.OpenInterest, error) {
+	return []futures.OpenInterest{
+		{
+			Key: key.ExchangePairAsset{
+				Exchange: testExchange,
+				Base:     k[0].Base,
+				Quote:    k[0].Quote,
+				Asset:    k[0].Asset,
+			},
+			OpenInterest: 1337,
+		},
+	}, nil
+}
+
+func (f dhmExchange) UpdateOrderbook(_ context.Context, p currency.Pair, a asset.Item) (*orderbook.Book, error) {
+	return &orderbook.Book{
+		Exchange:    testExchange,
+		Pair:        p,
+		Asset:       a,
+		Bids:        orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}, {Price: 97, Amount: 3}},
+		Asks:        orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}, {Price: 103, Amount: 3}},
+		LastUpdated: time.Now(),
+	}, nil
+}
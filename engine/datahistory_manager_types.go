@@ -9,6 +9,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	dbfundingrate "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
@@ -29,6 +32,9 @@ const (
 	dataHistoryConvertCandlesDataType
 	dataHistoryCandleValidationDataType
 	dataHistoryCandleValidationSecondarySourceType
+	dataHistoryFundingRateDataType
+	dataHistoryOpenInterestDataType
+	dataHistoryOrderbookSnapshotDataType
 )
 
 // DataHistoryJob status descriptors
@@ -81,13 +87,31 @@ func (d dataHistoryDataType) String() string {
 		return "conversion validation"
 	case 5:
 		return "conversion validation secondary source"
+	case 6:
+		return "funding rates"
+	case 7:
+		return "open interest"
+	case 8:
+		return "orderbook snapshots"
 	}
 	return ""
 }
 
 // Valid ensures the value set is legitimate
 func (d dataHistoryDataType) Valid() bool {
-	return int64(d) >= 0 && int64(d) <= 5
+	return int64(d) >= 0 && int64(d) <= 8
+}
+
+// isCollection returns whether the data type is collected from an exchange as
+// its ranges elapse rather than only over a historical period
+func (d dataHistoryDataType) isCollection() bool {
+	return d == dataHistoryFundingRateDataType || d.isSnapshot()
+}
+
+// isSnapshot returns whether the data type can only be collected while its
+// range is current, as exchanges do not provide its history
+func (d dataHistoryDataType) isSnapshot() bool {
+	return d == dataHistoryOpenInterestDataType || d == dataHistoryOrderbookSnapshotDataType
 }
 
 var (
@@ -106,6 +130,7 @@ var (
 	errNilResult                  = errors.New("received nil job result")
 	errJobMustBeActiveOrPaused    = errors.New("job must be active or paused to be set as a prerequisite")
 	errNilCandles                 = errors.New("received nil candles")
+	errNilOrderbook               = errors.New("received nil orderbook")
 )
 
 const (
@@ -120,10 +145,12 @@ const (
 	defaultDataHistoryTicker                  = time.Minute
 	defaultDataHistoryTradeRequestSize uint64 = 10
 	defaultDecimalPlaceComparison      uint64 = 3
+	defaultDataHistoryOrderbookDepth   uint64 = 20
 )
 
 // DataHistoryManager is responsible for synchronising,
-// retrieving and saving candle and trade data from loaded jobs
+// retrieving and saving candle, trade, funding rate, open interest
+// and orderbook data from loaded jobs
 type DataHistoryManager struct {
 	exchangeManager            iExchangeManager
 	databaseConnectionInstance database.IDatabase
@@ -140,6 +167,9 @@ type DataHistoryManager struct {
 	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
 	tradeSaver                 func(...trade.Data) error
 	candleSaver                func(*kline.Item, bool) (uint64, error)
+	fundingRateSaver           func(...dbfundingrate.Data) error
+	openInterestSaver          func(...openinterest.Data) error
+	orderbookSnapshotSaver     func(...orderbooksnapshot.Data) error
 }
 
 // DataHistoryJob used to gather candle/trade history and save
@@ -167,6 +197,9 @@ type DataHistoryJob struct {
 	SecondaryExchangeSource  string
 	IssueTolerancePercentage float64
 	ReplaceOnIssue           bool
+	// OrderbookDepth is the number of price levels of each side stored
+	// per orderbook snapshot
+	OrderbookDepth uint64
 	// Prerequisites mean this job is paused until the prerequisite job is completed
 	PrerequisiteJobID       uuid.UUID
 	PrerequisiteJobNickname string
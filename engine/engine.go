@@ -21,6 +21,8 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/papertrading"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -32,27 +34,37 @@ import (
 // Engine contains configuration, portfolio manager, exchange & ticker data and is the
 // overarching type across this code base.
 type Engine struct {
-	Config                  *config.Config
-	apiServer               *apiServerManager
-	CommunicationsManager   *CommunicationManager
-	connectionManager       *connectionManager
-	currencyPairSyncer      *SyncManager
-	DatabaseManager         *DatabaseConnectionManager
-	DepositAddressManager   *DepositAddressManager
-	eventManager            *eventManager
-	ExchangeManager         *ExchangeManager
-	ntpManager              *ntpManager
-	OrderManager            *OrderManager
-	portfolioManager        *portfolioManager
-	gctScriptManager        *gctscript.GctScriptManager
-	WebsocketRoutineManager *WebsocketRoutineManager
-	WithdrawManager         *WithdrawManager
-	dataHistoryManager      *DataHistoryManager
-	currencyStateManager    *CurrencyStateManager
-	Settings                Settings
-	uptime                  time.Time
-	GRPCShutdownSignal      chan struct{}
-	ServicesWG              sync.WaitGroup
+	Config                    *config.Config
+	apiServer                 *apiServerManager
+	CommunicationsManager     *CommunicationManager
+	connectionManager         *connectionManager
+	currencyPairSyncer        *SyncManager
+	DatabaseManager           *DatabaseConnectionManager
+	DepositAddressManager     *DepositAddressManager
+	eventManager              *eventManager
+	ExchangeManager           *ExchangeManager
+	ntpManager                *ntpManager
+	OrderManager              *OrderManager
+	portfolioManager          *portfolioManager
+	gctScriptManager          *gctscript.GctScriptManager
+	WebsocketRoutineManager   *WebsocketRoutineManager
+	WithdrawManager           *WithdrawManager
+	dataHistoryManager        *DataHistoryManager
+	currencyStateManager      *CurrencyStateManager
+	syntheticOrderManager     *SyntheticOrderManager
+	executionAlgorithmManager *ExecutionAlgorithmManager
+	tickRecorder              *TickRecorder
+	metricsManager            *MetricsManager
+	arbitrageManager          *ArbitrageManager
+	ledgerManager             *LedgerManager
+	configReloader            *ConfigReloader
+	transferManager           *TransferManager
+	fundingRateScanner        *FundingRateScanner
+	rpcAuth                   *rpcAuth
+	Settings                  Settings
+	uptime                    time.Time
+	GRPCShutdownSignal        chan struct{}
+	ServicesWG                sync.WaitGroup
 }
 
 // Bot is a happy global engine to allow various areas of the application
@@ -166,6 +178,17 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 
 	flagSet.WithBool("coinmarketcap", &b.Settings.EnableCoinmarketcapAnalysis, b.Config.Currency.CryptocurrencyProvider.Enabled)
 	flagSet.WithBool("ordermanager", &b.Settings.EnableOrderManager, b.Config.OrderManager.Enabled)
+	flagSet.WithBool("eventmanager", &b.Settings.EnableEventManager, b.Config.EventManager.Enabled != nil && *b.Config.EventManager.Enabled)
+	flagSet.WithBool("syntheticordermanager", &b.Settings.EnableSyntheticOrderManager, b.Config.SyntheticOrderManager.Enabled)
+	flagSet.WithBool("executionalgorithmmanager", &b.Settings.EnableExecutionAlgorithmManager, b.Config.ExecutionAlgorithmManager.Enabled)
+	flagSet.WithBool("tickrecorder", &b.Settings.EnableTickRecorder, b.Config.TickRecorder.Enabled)
+	flagSet.WithBool("papertrading", &b.Settings.EnablePaperTrading, b.Config.PaperTrading.Enabled)
+	flagSet.WithBool("metricsmanager", &b.Settings.EnableMetricsManager, b.Config.MetricsManager.Enabled)
+	flagSet.WithBool("arbitragemanager", &b.Settings.EnableArbitrageManager, b.Config.ArbitrageManager.Enabled)
+	flagSet.WithBool("ledgermanager", &b.Settings.EnableLedgerManager, b.Config.LedgerManager.Enabled)
+	flagSet.WithBool("configreloader", &b.Settings.EnableConfigReloader, b.Config.ConfigReloader.Enabled)
+	flagSet.WithBool("transfermanager", &b.Settings.EnableTransferManager, b.Config.TransferManager.Enabled)
+	flagSet.WithBool("fundingratescanner", &b.Settings.EnableFundingRateScanner, b.Config.FundingRateScanner.Enabled)
 
 	flagSet.WithBool("currencyconverter", &b.Settings.EnableCurrencyConverter, b.Config.Currency.ForexProviders.IsEnabled("currencyconverter"))
 
@@ -210,6 +233,9 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 		withdraw.CacheSize = b.Settings.WithdrawCacheSize
 	}
 
+	if !flagSet["eventmanagerdelay"] {
+		b.Settings.EventManagerDelay = b.Config.EventManager.Delay
+	}
 	if b.Settings.EnableEventManager && b.Settings.EventManagerDelay <= 0 {
 		b.Settings.EventManagerDelay = EventSleepDelay
 	}
@@ -372,6 +398,19 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	// The metrics manager is started before exchanges are loaded so that their
+	// requesters and websockets pick up its reporters
+	if bot.Settings.EnableMetricsManager {
+		if m, err := SetupMetricsManager(&bot.Config.MetricsManager, bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to setup: %s", err)
+		} else {
+			bot.metricsManager = m
+			if err = bot.metricsManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Metrics manager unable to start: %s", err)
+			}
+		}
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
@@ -404,7 +443,15 @@ func (bot *Engine) Start() error {
 	}
 
 	if bot.Settings.EnableGRPC {
-		go StartRPCServer(bot)
+		if a, err := newRPCAuth(&bot.Config.RemoteControl); err != nil {
+			gctlog.Errorf(gctlog.GRPCSys, "gRPC server could not set up authentication: %s\n", err)
+		} else {
+			if !bot.Settings.EnableDryRun {
+				a.save = bot.saveConfig
+			}
+			bot.rpcAuth = a
+			go StartRPCServer(bot)
+		}
 	}
 
 	if bot.Settings.EnablePortfolioManager {
@@ -413,6 +460,11 @@ func (bot *Engine) Start() error {
 				gctlog.Errorf(gctlog.Global, "portfolio manager unable to setup: %s", err)
 			} else {
 				bot.portfolioManager = p
+				if bot.Config.PortfolioSnapshots.Enabled {
+					if err := bot.portfolioManager.SetupSnapshotPersistence(bot.DatabaseManager, &bot.Config.PortfolioSnapshots); err != nil {
+						gctlog.Errorf(gctlog.Global, "portfolio manager unable to setup snapshot persistence: %s", err)
+					}
+				}
 				if err := bot.portfolioManager.Start(&bot.ServicesWG); err != nil {
 					gctlog.Errorf(gctlog.Global, "portfolio manager unable to start: %s", err)
 				}
@@ -436,6 +488,15 @@ func (bot *Engine) Start() error {
 	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun); err != nil {
 		return err
 	} else { //nolint:revive // TODO: revive false positive, see https://github.com/mgechev/revive/pull/832 for more information
+		if err := w.SetWithdrawalPolicy(&bot.Config.WithdrawalPolicy); err != nil {
+			return err
+		}
+		w.SetCommunicationsManager(bot.CommunicationsManager)
+		if bot.DatabaseManager.IsRunning() {
+			if err := w.loadWithdrawalHistory(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Withdraw manager unable to load withdrawal history: %v", err)
+			}
+		}
 		bot.WithdrawManager = w
 	}
 
@@ -479,32 +540,33 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if bot.Config.OrderManager.PersistOrders {
+				if err = bot.OrderManager.SetupOrderPersistence(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to setup order persistence: %s", err)
+				}
+			}
 			if err = bot.OrderManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
 		}
 	}
 
-	if bot.Settings.EnableExchangeSyncManager {
-		cfg := bot.Config.SyncManagerConfig
-		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
-		cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
-		cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
-		cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
-		cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
-
-		if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
-			bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
-			cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
-		}
-		if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
-			bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
-			cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
-		}
-		if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
-			bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
-			cfg.NumWorkers = bot.Settings.SyncWorkersCount
+	if bot.Settings.EnableSyntheticOrderManager {
+		if s, err := SetupSyntheticOrderManager(
+			bot.OrderManager,
+			bot.CommunicationsManager,
+			&bot.Config.SyntheticOrderManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Synthetic order manager unable to setup: %s", err)
+		} else {
+			bot.syntheticOrderManager = s
+			if err = bot.syntheticOrderManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Synthetic order manager unable to start: %s", err)
+			}
 		}
+	}
+
+	if bot.Settings.EnableExchangeSyncManager {
+		cfg := bot.syncManagerConfig()
 		if s, err := SetupSyncManager(
 			&cfg,
 			bot.ExchangeManager,
@@ -522,17 +584,6 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if bot.Settings.EnableEventManager {
-		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
-			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
-		} else {
-			bot.eventManager = e
-			if err = bot.eventManager.Start(); err != nil {
-				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
-			}
-		}
-	}
-
 	if bot.Settings.EnableWebsocketRoutine {
 		if w, err := setupWebsocketRoutineManager(bot.ExchangeManager, bot.OrderManager, bot.currencyPairSyncer, &bot.Config.Currency, bot.Settings.Verbose); err != nil {
 			gctlog.Errorf(gctlog.Global, "Unable to initialise websocket routine manager. Err: %s", err)
@@ -544,6 +595,95 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableExecutionAlgorithmManager {
+		if e, err := SetupExecutionAlgorithmManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			&bot.Config.ExecutionAlgorithmManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution algorithm manager unable to setup: %s", err)
+		} else {
+			bot.executionAlgorithmManager = e
+			if bot.WebsocketRoutineManager != nil {
+				// POV algorithms participate against websocket trade volume
+				if err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(e.websocketDataHandler, false); err != nil {
+					gctlog.Errorf(gctlog.Global, "Execution algorithm manager unable to register websocket data handler: %s", err)
+				}
+			}
+			if err = bot.executionAlgorithmManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Execution algorithm manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableTickRecorder {
+		if r, err := SetupTickRecorder(&bot.Config.TickRecorder, bot.Settings.DataDir); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tick recorder unable to setup: %s", err)
+		} else {
+			bot.tickRecorder = r
+			if bot.WebsocketRoutineManager != nil {
+				if err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(r.websocketDataHandler, false); err != nil {
+					gctlog.Errorf(gctlog.Global, "Tick recorder unable to register websocket data handler: %s", err)
+				}
+			} else {
+				gctlog.Warnln(gctlog.Global, "Tick recorder enabled without the websocket routine manager, nothing will be recorded")
+			}
+			if err = bot.tickRecorder.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Tick recorder unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableLedgerManager {
+		if l, err := SetupLedgerManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			&bot.Config.LedgerManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Ledger manager unable to setup: %s", err)
+		} else {
+			bot.ledgerManager = l
+			if bot.WebsocketRoutineManager != nil {
+				if err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(l.websocketDataHandler, false); err != nil {
+					gctlog.Errorf(gctlog.Global, "Ledger manager unable to register websocket data handler: %s", err)
+				}
+			}
+			if bot.Config.LedgerManager.PersistEntries {
+				if err = bot.ledgerManager.SetupLedgerPersistence(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Ledger manager unable to setup entry persistence: %s", err)
+				}
+			}
+			bot.WithdrawManager.SetLedgerManager(l)
+			if err = bot.ledgerManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Ledger manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableTransferManager {
+		if t, err := SetupTransferManager(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			&bot.Config.TransferManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to setup: %s", err)
+		} else {
+			bot.transferManager = t
+			bot.WithdrawManager.SetTransferManager(t)
+			if err = bot.transferManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Transfer manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableFundingRateScanner {
+		if f, err := SetupFundingRateScanner(bot.ExchangeManager, &bot.Config.FundingRateScanner); err != nil {
+			gctlog.Errorf(gctlog.Global, "Funding rate scanner unable to setup: %s", err)
+		} else {
+			bot.fundingRateScanner = f
+			if err = bot.fundingRateScanner.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Funding rate scanner unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableGCTScriptManager {
 		if g, err := gctscript.NewManager(&bot.Config.GCTScript); err != nil {
 			gctlog.Errorf(gctlog.Global, "failed to create script manager. Err: %s", err)
@@ -555,6 +695,40 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableArbitrageManager {
+		// set up after the gctscript manager which runs the optional script hook
+		if a, err := SetupArbitrageManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.CommunicationsManager,
+			bot.gctScriptManager,
+			&bot.Config.ArbitrageManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage manager unable to setup: %s", err)
+		} else {
+			bot.arbitrageManager = a
+			if err = bot.arbitrageManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Arbitrage manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableEventManager {
+		// set up after the order and gctscript managers which event actions use
+		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.OrderManager, bot.gctScriptManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
+		} else {
+			bot.eventManager = e
+			if bot.DatabaseManager.IsRunning() {
+				if err = bot.eventManager.SetupEventPersistence(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Event manager unable to setup event persistence: %s", err)
+				}
+			}
+			if err = bot.eventManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableCurrencyStateManager {
 		if c, err := SetupCurrencyStateManager(
 			bot.Config.CurrencyStateManager.Delay,
@@ -575,6 +749,32 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableConfigReloader {
+		// set up last as reloaded changes are applied to the other subsystems
+		if filePath, err := config.GetAndMigrateDefaultPath(bot.Settings.ConfigFile); err != nil {
+			gctlog.Errorf(gctlog.Global, "Config reloader unable to setup: %s", err)
+		} else if r, err := SetupConfigReloader(&bot.Config.ConfigReloader, filePath, bot.Config.EncryptionKeyProvider, bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Config reloader unable to setup: %s", err)
+		} else {
+			bot.configReloader = r
+			if err = bot.configReloader.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Config reloader unable to start: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// saveConfig saves the running config to the config file. The config reloader
+// is told of the save so that the engine's own changes are not reloaded
+func (bot *Engine) saveConfig() error {
+	if err := bot.Config.SaveConfigToFile(bot.Settings.ConfigFile); err != nil {
+		return err
+	}
+	if err := bot.configReloader.configSaved(); err != nil {
+		gctlog.Errorf(gctlog.ConfigMgr, "Config reloader unable to read saved config: %v", err)
+	}
 	return nil
 }
 
@@ -585,6 +785,12 @@ func (bot *Engine) Stop() {
 
 	gctlog.Debugln(gctlog.Global, "Engine shutting down..")
 
+	if bot.configReloader.IsRunning() {
+		if err := bot.configReloader.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Config reloader unable to stop. Error: %v", err)
+		}
+	}
+
 	if len(bot.portfolioManager.GetAddresses()) != 0 {
 		bot.Config.Portfolio = bot.portfolioManager.GetPortfolio()
 	}
@@ -594,6 +800,46 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.arbitrageManager.IsRunning() {
+		if err := bot.arbitrageManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.fundingRateScanner.IsRunning() {
+		if err := bot.fundingRateScanner.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Funding rate scanner unable to stop. Error: %v", err)
+		}
+	}
+	if bot.transferManager.IsRunning() {
+		if err := bot.transferManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.ledgerManager.IsRunning() {
+		if err := bot.ledgerManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Ledger manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.tickRecorder.IsRunning() {
+		if err := bot.tickRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tick recorder unable to stop. Error: %v", err)
+		}
+	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.executionAlgorithmManager.IsRunning() {
+		if err := bot.executionAlgorithmManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution algorithm manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.syntheticOrderManager.IsRunning() {
+		if err := bot.syntheticOrderManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Synthetic order manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -673,7 +919,7 @@ func (bot *Engine) Stop() {
 	}
 
 	if !bot.Settings.EnableDryRun {
-		err = bot.Config.SaveConfigToFile(bot.Settings.ConfigFile)
+		err = bot.saveConfig()
 		if err != nil {
 			gctlog.Errorln(gctlog.Global, "Unable to save config.")
 		} else {
@@ -720,6 +966,42 @@ func (bot *Engine) GetExchanges() []exchange.IBotExchange {
 	return exch
 }
 
+// syncManagerConfig returns the sync manager config with any command line
+// overrides applied
+func (bot *Engine) syncManagerConfig() config.SyncManagerConfig {
+	cfg := bot.Config.SyncManagerConfig
+	cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
+	cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
+	cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
+	cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
+	cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
+
+	if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
+		bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
+		cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
+	}
+	if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
+		bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
+		cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
+	}
+	if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
+		bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
+		cfg.NumWorkers = bot.Settings.SyncWorkersCount
+	}
+	return cfg
+}
+
+// syncStatuses returns the sync item states of the currency pair syncer
+func (bot *Engine) syncStatuses() []syncStatus {
+	return bot.currencyPairSyncer.syncStatuses()
+}
+
+// orderStatusCounts returns the order counts of the order manager per
+// exchange and status
+func (bot *Engine) orderStatusCounts() map[string]map[order.Status]int {
+	return bot.OrderManager.orderStatusCounts()
+}
+
 // LoadExchange loads an exchange by name. Optional wait group can be added for
 // external synchronization.
 func (bot *Engine) LoadExchange(name string) error {
@@ -809,6 +1091,14 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if bot.Settings.EnablePaperTrading {
+		exch, err = papertrading.New(exch, &bot.Config.PaperTrading)
+		if err != nil {
+			return err
+		}
+		gctlog.Warnf(gctlog.ExchangeSys, "%s paper trading enabled, orders will be simulated against live orderbooks", exch.GetName())
+	}
+
 	err = bot.ExchangeManager.Add(exch)
 	if err != nil {
 		return err
@@ -10,9 +10,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/papertrading"
 )
 
 // blockedCIExchanges are exchanges that are not able to be tested on CI
@@ -266,6 +269,35 @@ func TestDryRunParamInteraction(t *testing.T) {
 	}
 }
 
+func TestLoadExchangePaperTrading(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{
+		ExchangeManager: NewExchangeManager(),
+		Config: &config.Config{
+			Exchanges: []config.Exchange{{Name: testExchange, WebsocketTrafficTimeout: time.Second}},
+			PaperTrading: config.PaperTrading{
+				Balances: []config.PaperTradingBalance{{Exchange: testExchange, Asset: asset.Spot, Currency: currency.USDT, Amount: 1337}},
+			},
+		},
+	}
+	bot.Settings.EnablePaperTrading = true
+	assert.NoError(t, bot.LoadExchange(testExchange), "LoadExchange should not error")
+
+	exch, err := bot.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	require.IsType(t, &papertrading.Exchange{}, exch, "LoadExchange must wrap the exchange in the paper trading simulator")
+
+	h, err := exch.UpdateAccountInfo(t.Context(), asset.Spot)
+	require.NoError(t, err, "UpdateAccountInfo must not error")
+	require.Len(t, h.Accounts, 1, "UpdateAccountInfo must return one account")
+	require.Len(t, h.Accounts[0].Currencies, 1, "UpdateAccountInfo must return the starting balance")
+	assert.Equal(t, 1337.0, h.Accounts[0].Currencies[0].Total, "UpdateAccountInfo should return the starting balance")
+
+	bot.Config.PaperTrading.Balances[0].Amount = -1
+	require.NoError(t, bot.UnloadExchange(testExchange), "UnloadExchange must not error")
+	assert.ErrorContains(t, bot.LoadExchange(testExchange), "invalid starting balance", "LoadExchange should error on an invalid starting balance")
+}
+
 func TestFlagSetWith(t *testing.T) {
 	var isRunning bool
 	flags := make(FlagSet)
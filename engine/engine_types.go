@@ -31,36 +31,46 @@ type Settings struct {
 
 // CoreSettings defines settings related to core engine operations
 type CoreSettings struct {
-	EnableDryRun                bool
-	EnableAllExchanges          bool
-	EnableAllPairs              bool
-	EnableCoinmarketcapAnalysis bool
-	EnablePortfolioManager      bool
-	EnableDataHistoryManager    bool
-	PortfolioManagerDelay       time.Duration
-	EnableGRPC                  bool
-	EnableGRPCProxy             bool
-	EnableGRPCShutdown          bool
-	EnableWebsocketRPC          bool
-	EnableDeprecatedRPC         bool
-	EnableCommsRelayer          bool
-	EnableExchangeSyncManager   bool
-	EnableDepositAddressManager bool
-	EnableEventManager          bool
-	EnableOrderManager          bool
-	EnableConnectivityMonitor   bool
-	EnableDatabaseManager       bool
-	EnableGCTScriptManager      bool
-	EnableNTPClient             bool
-	EnableWebsocketRoutine      bool
-	EnableCurrencyStateManager  bool
-	EventManagerDelay           time.Duration
-	EnableFuturesTracking       bool
-	Verbose                     bool
-	EnableDispatcher            bool
-	DispatchMaxWorkerAmount     int
-	DispatchJobsLimit           int
-	Exchanges                   string
+	EnableDryRun                    bool
+	EnableAllExchanges              bool
+	EnableAllPairs                  bool
+	EnableCoinmarketcapAnalysis     bool
+	EnablePortfolioManager          bool
+	EnableDataHistoryManager        bool
+	PortfolioManagerDelay           time.Duration
+	EnableGRPC                      bool
+	EnableGRPCProxy                 bool
+	EnableGRPCShutdown              bool
+	EnableWebsocketRPC              bool
+	EnableDeprecatedRPC             bool
+	EnableCommsRelayer              bool
+	EnableExchangeSyncManager       bool
+	EnableDepositAddressManager     bool
+	EnableEventManager              bool
+	EnableOrderManager              bool
+	EnableConnectivityMonitor       bool
+	EnableDatabaseManager           bool
+	EnableGCTScriptManager          bool
+	EnableNTPClient                 bool
+	EnableWebsocketRoutine          bool
+	EnableCurrencyStateManager      bool
+	EnableSyntheticOrderManager     bool
+	EnableExecutionAlgorithmManager bool
+	EnableTickRecorder              bool
+	EnablePaperTrading              bool
+	EnableMetricsManager            bool
+	EnableArbitrageManager          bool
+	EnableLedgerManager             bool
+	EnableConfigReloader            bool
+	EnableTransferManager           bool
+	EnableFundingRateScanner        bool
+	EventManagerDelay               time.Duration
+	EnableFuturesTracking           bool
+	Verbose                         bool
+	EnableDispatcher                bool
+	DispatchMaxWorkerAmount         int
+	DispatchJobsLimit               int
+	Exchanges                       string
 }
 
 // ExchangeSyncerSettings defines settings for the exchange pair synchronisation
@@ -1,8 +1,8 @@
 package engine
 
 import (
-	"errors"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -10,14 +10,17 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-// setupEventManager loads and validates the communications manager config
-func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
+// setupEventManager loads and validates the communications manager config.
+// The order and script managers are optional and are only required by the
+// actions which use them
+func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, orderManager iEventOrderManager, scriptManager iScriptManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
 	if comManager == nil {
 		return nil, errNilComManager
 	}
@@ -30,12 +33,35 @@ func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManage
 	return &eventManager{
 		comms:           comManager,
 		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		scriptManager:   scriptManager,
 		verbose:         verbose,
 		sleepDelay:      sleepDelay,
 		shutdown:        make(chan struct{}),
+		remoteValues:    make(map[string]remoteValue),
 	}, nil
 }
 
+// SetupEventPersistence stores events in the database so they survive a
+// restart. Stored events are loaded when the subsystem starts
+func (m *eventManager) SetupEventPersistence(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("event manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	repo, err := eventrule.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.repository = repo
+	return nil
+}
+
 // Start runs the subsystem
 func (m *eventManager) Start() error {
 	if m == nil {
@@ -45,7 +71,9 @@ func (m *eventManager) Start() error {
 		return fmt.Errorf("event manager %w", ErrSubSystemAlreadyStarted)
 	}
 	log.Debugf(log.EventMgr, "Event Manager started. SleepDelay: %v\n", m.sleepDelay.String())
+	m.loadPersistedEvents()
 	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
 	go m.run()
 	return nil
 }
@@ -67,75 +95,142 @@ func (m *eventManager) Stop() error {
 		return fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
 	close(m.shutdown)
+	m.wg.Wait()
+	return nil
+}
+
+// setSleepDelay sets the delay between event condition checks of a stopped
+// event manager
+func (m *eventManager) setSleepDelay(sleepDelay time.Duration) error {
+	if m == nil {
+		return fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("event manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if sleepDelay <= 0 {
+		sleepDelay = EventSleepDelay
+	}
+	m.sleepDelay = sleepDelay
 	return nil
 }
 
 func (m *eventManager) run() {
+	defer m.wg.Done()
 	t := time.NewTicker(m.sleepDelay)
-	select {
-	case <-m.shutdown:
-		return
-	case <-t.C:
-		total, executed := m.getEventCounter()
-		if total > 0 && executed != total {
-			m.m.Lock()
-			for i := range m.events {
-				m.executeEvent(i)
-			}
-			m.m.Unlock()
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.processEvents(time.Now())
 		}
 	}
 }
 
-func (m *eventManager) executeEvent(i int) {
-	if !m.events[i].Executed {
+// processEvents checks the conditions of all armed events and triggers the
+// events whose conditions are met. Conditions are checked without holding the
+// lock as they may call exchange APIs
+func (m *eventManager) processEvents(now time.Time) {
+	m.m.Lock()
+	armed := make([]Event, 0, len(m.events))
+	for i := range m.events {
+		if m.events[i].isArmed(now) {
+			armed = append(armed, m.events[i])
+		}
+	}
+	m.m.Unlock()
+
+	for i := range armed {
 		if m.verbose {
-			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", m.events[i].String())
+			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", armed[i].String())
 		}
-		err := m.checkEventCondition(&m.events[i])
-		if err != nil {
-			msg := fmt.Sprintf(
-				"Events: ID: %d triggered on %s successfully [%v]\n", m.events[i].ID,
-				m.events[i].Exchange, m.events[i].String(),
-			)
-			log.Infoln(log.EventMgr, msg)
-			m.comms.PushEvent(base.Event{Type: "event", Message: msg})
-			m.events[i].Executed = true
-		} else if m.verbose {
-			log.Debugf(log.EventMgr, "%v", err)
+		if err := m.checkEventCondition(&armed[i]); err != nil {
+			if m.verbose {
+				log.Debugf(log.EventMgr, "Events: ID: %d %v", armed[i].ID, err)
+			}
+			continue
 		}
+		m.executeEvent(armed[i].ID, now)
+	}
+}
+
+// executeEvent records the trigger of an event and performs its action
+func (m *eventManager) executeEvent(id int64, now time.Time) {
+	m.m.Lock()
+	idx := slices.IndexFunc(m.events, func(e Event) bool { return e.ID == id })
+	if idx == -1 || !m.events[idx].isArmed(now) {
+		// removed or triggered while its condition was being checked
+		m.m.Unlock()
+		return
+	}
+	e := &m.events[idx]
+	e.TriggerCount++
+	e.LastTriggered = now
+	e.Executed = !e.Repeat
+	evt := *e
+	m.persistEvent(e)
+	m.m.Unlock()
+
+	msg := fmt.Sprintf("Events: ID: %d triggered on %s successfully [%v]", evt.ID, evt.Exchange, evt.String())
+	log.Infoln(log.EventMgr, msg)
+	if err := m.executeAction(&evt, msg); err != nil {
+		errMsg := fmt.Sprintf("Events: ID: %d %s action failed: %v", evt.ID, evt.Action, err)
+		log.Errorln(log.EventMgr, errMsg)
+		m.comms.PushEvent(base.Event{Type: eventType, Message: errMsg})
+	}
+}
+
+// isArmed returns whether an event is waiting to be triggered
+func (e *Event) isArmed(now time.Time) bool {
+	if e.Executed {
+		return false
 	}
+	return e.Cooldown <= 0 || e.LastTriggered.IsZero() || now.Sub(e.LastTriggered) >= e.Cooldown
 }
 
 // Add adds an event to the Events chain and returns an index/eventID
 // and an error
 func (m *eventManager) Add(exchange, item string, condition EventConditionParams, p currency.Pair, a asset.Item, action string) (int64, error) {
+	return m.AddEvent(&Event{
+		Exchange:  exchange,
+		Item:      item,
+		Condition: condition,
+		Pair:      p,
+		Asset:     a,
+		Action:    action,
+	})
+}
+
+// AddEvent validates and adds an event with compound conditions, action
+// parameters or repeat settings and returns its ID
+func (m *eventManager) AddEvent(e *Event) (int64, error) {
 	if m == nil {
 		return 0, fmt.Errorf("event manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return 0, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
-	err := m.isValidEvent(exchange, item, condition, action)
-	if err != nil {
-		return 0, err
+	if e == nil {
+		return 0, errNilEvent
 	}
-	evt := Event{
-		Exchange:  exchange,
-		Item:      item,
-		Condition: condition,
-		Pair:      p,
-		Asset:     a,
-		Action:    action,
-		Executed:  false,
+	evt := *e
+	evt.Item = strings.ToUpper(evt.Item)
+	evt.Action = strings.ToUpper(evt.Action)
+	if err := m.isValidEvent(&evt); err != nil {
+		return 0, err
 	}
+	evt.TriggerCount = 0
+	evt.LastTriggered = time.Time{}
+	evt.Executed = false
+
 	m.m.Lock()
-	if len(m.events) > 0 {
-		evt.ID = int64(len(m.events) + 1)
-	}
+	defer m.m.Unlock()
+	m.nextID++
+	evt.ID = m.nextID
 	m.events = append(m.events, evt)
-	m.m.Unlock()
-
+	m.persistEvent(&evt)
 	return evt.ID, nil
 }
 
@@ -149,12 +244,30 @@ func (m *eventManager) Remove(eventID int64) bool {
 	for i := range m.events {
 		if m.events[i].ID == eventID {
 			m.events = slices.Delete(m.events, i, i+1)
+			if m.repository != nil {
+				if err := m.repository.Delete(eventID); err != nil {
+					log.Errorf(log.EventMgr, "Unable to delete persisted event %d: %v", eventID, err)
+				}
+			}
 			return true
 		}
 	}
 	return false
 }
 
+// GetEvents returns a copy of all events
+func (m *eventManager) GetEvents() ([]Event, error) {
+	if m == nil {
+		return nil, fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	return slices.Clone(m.events), nil
+}
+
 // getEventCounter displays the amount of total events on the chain and the
 // events that have been executed.
 func (m *eventManager) getEventCounter() (total, executed int) {
@@ -172,64 +285,134 @@ func (m *eventManager) getEventCounter() (total, executed int) {
 	return total, executed
 }
 
-// checkEventCondition will check the event structure to see if there is a condition
-// met
-func (m *eventManager) checkEventCondition(e *Event) error {
-	if m == nil {
-		return fmt.Errorf("event manager %w", ErrNilSubsystem)
-	}
-	if atomic.LoadInt32(&m.started) == 0 {
-		return fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+// persistEvent writes an event through to the database if event persistence
+// is enabled. Failures are logged as the in-memory events remain the source
+// of truth
+func (m *eventManager) persistEvent(e *Event) {
+	if m.repository == nil {
+		return
 	}
-	if e == nil {
-		return errNilEvent
+	evt := *e
+	// pairs without a delimiter cannot be unmarshalled
+	evt.Pair.Delimiter = currency.DashDelimiter
+	definition, err := json.Marshal(&evt)
+	if err != nil {
+		log.Errorf(log.EventMgr, "Unable to marshal event %d: %v", e.ID, err)
+		return
 	}
-	if e.Item == ItemPrice {
-		return e.processTicker()
+	err = m.repository.Upsert(&eventrule.Details{
+		ID:         e.ID,
+		Exchange:   e.Exchange,
+		Definition: string(definition),
+	})
+	if err != nil {
+		log.Errorf(log.EventMgr, "Unable to persist event %d: %v", e.ID, err)
 	}
-	return e.processOrderbook()
 }
 
-// isValidEvent checks the actions to be taken and returns an error if incorrect
-func (m *eventManager) isValidEvent(exchange, item string, condition EventConditionParams, action string) error {
-	exchange = strings.ToUpper(exchange)
-	item = strings.ToUpper(item)
-	action = strings.ToUpper(action)
-
-	if !m.isValidExchange(exchange) {
-		return errExchangeDisabled
+// loadPersistedEvents loads all stored events and continues ID assignment
+// after the highest stored ID
+func (m *eventManager) loadPersistedEvents() {
+	if m.repository == nil {
+		return
 	}
-
-	if !isValidItem(item) {
-		return errInvalidItem
+	stored, err := m.repository.GetAll()
+	if err != nil {
+		log.Errorf(log.EventMgr, "Unable to load persisted events: %v", err)
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range stored {
+		var e Event
+		if err = json.Unmarshal([]byte(stored[i].Definition), &e); err != nil {
+			log.Errorf(log.EventMgr, "Unable to load persisted event %d: %v", stored[i].ID, err)
+			continue
+		}
+		e.ID = stored[i].ID
+		if slices.ContainsFunc(m.events, func(evt Event) bool { return evt.ID == e.ID }) {
+			continue
+		}
+		m.events = append(m.events, e)
+		m.nextID = max(m.nextID, e.ID)
+	}
+	if len(m.events) > 0 {
+		log.Infof(log.EventMgr, "Loaded %d persisted events", len(m.events))
 	}
+}
 
-	if !isValidCondition(condition.Condition) {
-		return errInvalidCondition
+// isValidEvent checks the conditions and actions of an event and returns an
+// error if incorrect
+func (m *eventManager) isValidEvent(e *Event) error {
+	if !m.isValidExchange(e.Exchange) {
+		return errExchangeDisabled
 	}
 
-	if item == ItemPrice {
-		if condition.Price <= 0 {
-			return errInvalidCondition
+	if e.Conditions != nil {
+		if err := validateEventCondition(e.Conditions); err != nil {
+			return err
 		}
+	} else if err := validateConditionParams(e.Item, &e.Condition); err != nil {
+		return err
 	}
 
-	if item == ItemOrderbook {
-		if condition.OrderbookAmount <= 0 {
-			return errInvalidCondition
-		}
+	if e.Cooldown < 0 {
+		return errInvalidCooldown
 	}
 
+	return m.isValidAction(e.Action, &e.ActionParams)
+}
+
+// isValidAction validates an action and its parameters
+func (m *eventManager) isValidAction(action string, params *EventActionParams) error {
 	if strings.Contains(action, ",") {
 		a := strings.Split(action, ",")
-
 		if a[0] != ActionSMSNotify {
 			return errInvalidAction
 		}
-	} else if action != ActionConsolePrint && action != ActionTest {
+		return nil
+	}
+	switch action {
+	case ActionConsolePrint, ActionTest:
+		return nil
+	case ActionSubmitOrder:
+		if m.orderManager == nil || !m.orderManager.IsRunning() {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventOrderManagerNotSetup)
+		}
+		if _, err := order.StringToOrderSide(params.Side); err != nil {
+			return fmt.Errorf("%w: %w", errInvalidAction, err)
+		}
+		oType, err := eventOrderType(params.OrderType)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errInvalidAction, err)
+		}
+		if params.Amount <= 0 {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventOrderAmount)
+		}
+		if oType == order.Limit && params.Price <= 0 {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventOrderPrice)
+		}
+	case ActionCancelOrders:
+		if m.orderManager == nil || !m.orderManager.IsRunning() {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventOrderManagerNotSetup)
+		}
+	case ActionRunScript:
+		if params.Script == "" {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventScriptUnset)
+		}
+		if !filepath.IsLocal(params.Script) {
+			return fmt.Errorf("%w: %w %q", errInvalidAction, errEventScriptPath, params.Script)
+		}
+		if m.scriptManager == nil || !m.scriptManager.IsRunning() {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventScriptManagerNotRun)
+		}
+	case ActionPush:
+		if params.Relayer == "" {
+			return fmt.Errorf("%w: %w", errInvalidAction, errEventRelayerUnset)
+		}
+	default:
 		return errInvalidAction
 	}
-
 	return nil
 }
 
@@ -252,7 +435,7 @@ func isValidCondition(condition string) bool {
 func isValidItem(item string) bool {
 	item = strings.ToUpper(item)
 	switch item {
-	case ItemPrice, ItemOrderbook:
+	case ItemPrice, ItemOrderbook, ItemSpread, ItemImbalance, ItemFundingRate, ItemOpenInterest, ItemIndicator:
 		return true
 	}
 	return false
@@ -260,77 +443,14 @@ func isValidItem(item string) bool {
 
 // String turns the structure event into a string
 func (e *Event) String() string {
+	if e.Conditions != nil {
+		return fmt.Sprintf(
+			"If the %s [%s] on %s meets the following %s then %s.", e.Pair.String(),
+			strings.ToUpper(e.Asset.String()), e.Exchange, e.Conditions.String(), e.Action,
+		)
+	}
 	return fmt.Sprintf(
 		"If the %s [%s] %s on %s meets the following %v then %s.", e.Pair.String(),
 		strings.ToUpper(e.Asset.String()), e.Item, e.Exchange, e.Condition, e.Action,
 	)
 }
-
-func (e *Event) processTicker() error {
-	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
-	if err != nil {
-		return fmt.Errorf("failed to get ticker. Err: %w", err)
-	}
-
-	if t.Last == 0 {
-		return errTickerLastPriceZero
-	}
-	return e.shouldProcessEvent(t.Last, e.Condition.Price)
-}
-
-func (e *Event) shouldProcessEvent(actual, threshold float64) error {
-	switch e.Condition.Condition {
-	case ConditionGreaterThan:
-		if actual > threshold {
-			return nil
-		}
-	case ConditionGreaterThanOrEqual:
-		if actual >= threshold {
-			return nil
-		}
-	case ConditionLessThan:
-		if actual < threshold {
-			return nil
-		}
-	case ConditionLessThanOrEqual:
-		if actual <= threshold {
-			return nil
-		}
-	case ConditionIsEqual:
-		if actual == threshold {
-			return nil
-		}
-	}
-	return errors.New("does not meet conditions")
-}
-
-func (e *Event) processOrderbook() error {
-	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
-	if err != nil {
-		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
-	}
-	if !e.Condition.CheckBids && !e.Condition.CheckAsks {
-		return nil
-	}
-
-	if e.Condition.CheckBids {
-		for x := range ob.Bids {
-			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
-			if err == nil {
-				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
-			}
-		}
-	}
-
-	if e.Condition.CheckAsks {
-		for x := range ob.Asks {
-			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
-			if err == nil {
-				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
-			}
-		}
-	}
-	return err
-}
@@ -0,0 +1,496 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// validateEventCondition recursively validates a compound condition
+func validateEventCondition(c *EventCondition) error {
+	if c.Operator == "" {
+		c.Item = strings.ToUpper(c.Item)
+		if len(c.Conditions) > 0 {
+			return errInvalidConditionOperator
+		}
+		return validateConditionParams(c.Item, &c.Params)
+	}
+	c.Operator = strings.ToUpper(c.Operator)
+	if c.Operator != ConditionAnd && c.Operator != ConditionOr {
+		return errInvalidConditionOperator
+	}
+	if len(c.Conditions) == 0 {
+		return errCompoundConditionEmpty
+	}
+	for i := range c.Conditions {
+		if err := validateEventCondition(&c.Conditions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateConditionParams validates the parameters of a single item
+// comparison
+func validateConditionParams(item string, params *EventConditionParams) error {
+	if !isValidItem(item) {
+		return errInvalidItem
+	}
+
+	if !isValidCondition(params.Condition) {
+		return errInvalidCondition
+	}
+
+	switch strings.ToUpper(item) {
+	case ItemPrice:
+		if params.Price <= 0 {
+			return errInvalidCondition
+		}
+	case ItemOrderbook:
+		if params.OrderbookAmount <= 0 {
+			return errInvalidCondition
+		}
+	case ItemIndicator:
+		params.Indicator = strings.ToUpper(params.Indicator)
+		switch params.Indicator {
+		case IndicatorSMA, IndicatorEMA, IndicatorRSI, IndicatorMFI, IndicatorATR:
+			if params.Period <= 0 {
+				return errInvalidIndicatorPeriod
+			}
+		case IndicatorMACD:
+		default:
+			return fmt.Errorf("%w %q", errInvalidIndicator, params.Indicator)
+		}
+		if params.Interval <= 0 {
+			return errInvalidIndicatorInterval
+		}
+	}
+	return nil
+}
+
+// String returns a readable representation of a compound condition
+func (c *EventCondition) String() string {
+	if c.Operator == "" {
+		if c.Item == ItemIndicator {
+			return fmt.Sprintf("%s(%s %s %d) %s %v", c.Item, c.Params.Indicator, c.Params.Interval.Short(), c.Params.Period, c.Params.Condition, c.Params.Value)
+		}
+		return fmt.Sprintf("%s %s %v", c.Item, c.Params.Condition, conditionThreshold(c.Item, &c.Params))
+	}
+	conditions := make([]string, len(c.Conditions))
+	for i := range c.Conditions {
+		conditions[i] = c.Conditions[i].String()
+	}
+	return "(" + strings.Join(conditions, " "+c.Operator+" ") + ")"
+}
+
+// checkEventCondition will check the event structure to see if there is a condition
+// met
+func (m *eventManager) checkEventCondition(e *Event) error {
+	if m == nil {
+		return fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	if e == nil {
+		return errNilEvent
+	}
+	cond := e.Conditions
+	if cond == nil {
+		cond = &EventCondition{Item: e.Item, Params: e.Condition}
+	}
+	met, err := m.evaluateCondition(context.TODO(), e, cond)
+	if err != nil {
+		return err
+	}
+	if !met {
+		return errEventConditionNotMet
+	}
+	return nil
+}
+
+// evaluateCondition recursively evaluates a compound condition. An OR
+// condition is met when any branch is met even if other branches error
+func (m *eventManager) evaluateCondition(ctx context.Context, e *Event, c *EventCondition) (bool, error) {
+	switch c.Operator {
+	case "":
+		return m.evaluateItem(ctx, e, c.Item, &c.Params)
+	case ConditionAnd:
+		for i := range c.Conditions {
+			met, err := m.evaluateCondition(ctx, e, &c.Conditions[i])
+			if err != nil || !met {
+				return false, err
+			}
+		}
+		return true, nil
+	case ConditionOr:
+		var errs error
+		for i := range c.Conditions {
+			met, err := m.evaluateCondition(ctx, e, &c.Conditions[i])
+			if err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			if met {
+				return true, nil
+			}
+		}
+		return false, errs
+	default:
+		return false, errInvalidConditionOperator
+	}
+}
+
+// evaluateItem compares the current value of an item against its threshold
+func (m *eventManager) evaluateItem(ctx context.Context, e *Event, item string, params *EventConditionParams) (bool, error) {
+	if item == ItemOrderbook {
+		return e.processOrderbook(params)
+	}
+	var actual float64
+	var err error
+	switch item {
+	case ItemPrice:
+		actual, err = e.processTicker()
+	case ItemSpread:
+		actual, err = getEventDepthValue(e, (*orderbook.Depth).GetSpreadPercentage)
+	case ItemImbalance:
+		actual, err = getEventDepthValue(e, (*orderbook.Depth).GetImbalance)
+	case ItemFundingRate:
+		actual, err = m.getRemoteValue(e, item, remoteDataRefresh, func() (float64, error) {
+			return m.getFundingRate(ctx, e)
+		})
+	case ItemOpenInterest:
+		actual, err = m.getRemoteValue(e, item, remoteDataRefresh, func() (float64, error) {
+			return m.getOpenInterest(ctx, e)
+		})
+	case ItemIndicator:
+		cacheKey := fmt.Sprintf("%s-%s-%d", params.Indicator, params.Interval.Short(), params.Period)
+		actual, err = m.getRemoteValue(e, cacheKey, min(params.Interval.Duration(), remoteDataRefresh), func() (float64, error) {
+			return m.getIndicatorValue(ctx, e, params)
+		})
+	default:
+		return false, errInvalidItem
+	}
+	if err != nil {
+		return false, err
+	}
+	return compareEventValue(params.Condition, actual, conditionThreshold(item, params)), nil
+}
+
+// conditionThreshold returns the threshold an item is compared against
+func conditionThreshold(item string, params *EventConditionParams) float64 {
+	switch item {
+	case ItemPrice:
+		return params.Price
+	case ItemOrderbook:
+		return params.OrderbookAmount
+	default:
+		return params.Value
+	}
+}
+
+// compareEventValue returns whether the actual value meets the condition
+func compareEventValue(condition string, actual, threshold float64) bool {
+	switch condition {
+	case ConditionGreaterThan:
+		return actual > threshold
+	case ConditionGreaterThanOrEqual:
+		return actual >= threshold
+	case ConditionLessThan:
+		return actual < threshold
+	case ConditionLessThanOrEqual:
+		return actual <= threshold
+	case ConditionIsEqual:
+		return actual == threshold
+	}
+	return false
+}
+
+func (e *Event) processTicker() (float64, error) {
+	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ticker. Err: %w", err)
+	}
+	if t.Last == 0 {
+		return 0, errTickerLastPriceZero
+	}
+	return t.Last, nil
+}
+
+// processOrderbook returns whether the quote value of any orderbook level on
+// the checked sides meets the condition. Both sides are checked when neither
+// is selected
+func (e *Event) processOrderbook(params *EventConditionParams) (bool, error) {
+	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return false, fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
+	}
+	checkBids, checkAsks := params.CheckBids, params.CheckAsks
+	if !checkBids && !checkAsks {
+		checkBids, checkAsks = true, true
+	}
+	var met bool
+	if checkBids {
+		for x := range ob.Bids {
+			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
+			if compareEventValue(params.Condition, subtotal, params.OrderbookAmount) {
+				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
+				met = true
+			}
+		}
+	}
+	if checkAsks {
+		for x := range ob.Asks {
+			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
+			if compareEventValue(params.Condition, subtotal, params.OrderbookAmount) {
+				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
+				met = true
+			}
+		}
+	}
+	return met, nil
+}
+
+// getEventDepthValue returns a value derived from the orderbook depth
+func getEventDepthValue(e *Event, fn func(*orderbook.Depth) (float64, error)) (float64, error) {
+	depth, err := orderbook.GetDepth(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return 0, fmt.Errorf("events: Failed to get orderbook depth. Err: %w", err)
+	}
+	return fn(depth)
+}
+
+// getRemoteValue returns a cached value fetched from an exchange, refreshing
+// it once it is older than maxAge so events do not exhaust exchange rate
+// limits
+func (m *eventManager) getRemoteValue(e *Event, item string, maxAge time.Duration, fetch func() (float64, error)) (float64, error) {
+	cacheKey := e.Exchange + "-" + e.Asset.String() + "-" + e.Pair.String() + "-" + item
+	m.remoteMtx.Lock()
+	cached, ok := m.remoteValues[cacheKey]
+	m.remoteMtx.Unlock()
+	if ok && time.Since(cached.fetched) < maxAge {
+		return cached.value, nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return 0, err
+	}
+	m.remoteMtx.Lock()
+	m.remoteValues[cacheKey] = remoteValue{value: value, fetched: time.Now()}
+	m.remoteMtx.Unlock()
+	return value, nil
+}
+
+func (m *eventManager) getFundingRate(ctx context.Context, e *Event) (float64, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return 0, err
+	}
+	rates, err := exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{
+		Asset: e.Asset,
+		Pair:  e.Pair,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(rates) == 0 {
+		return 0, errNoFundingRate
+	}
+	return rates[0].LatestRate.Rate.InexactFloat64(), nil
+}
+
+func (m *eventManager) getOpenInterest(ctx context.Context, e *Event) (float64, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return 0, err
+	}
+	oi, err := exch.GetOpenInterest(ctx, key.PairAsset{
+		Base:  e.Pair.Base.Item,
+		Quote: e.Pair.Quote.Item,
+		Asset: e.Asset,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(oi) == 0 {
+		return 0, errNoOpenInterest
+	}
+	return oi[0].OpenInterest, nil
+}
+
+// getIndicatorValue returns the latest value of a technical indicator
+// calculated from exchange candles
+func (m *eventManager) getIndicatorValue(ctx context.Context, e *Event, params *EventConditionParams) (float64, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return 0, err
+	}
+	period := params.Period
+	if params.Indicator == IndicatorMACD {
+		period = macdSlowPeriod + macdSignalPeriod
+	}
+	end := time.Now().Truncate(params.Interval.Duration())
+	start := end.Add(-params.Interval.Duration() * time.Duration(period*indicatorCandleMultiplier))
+	candles, err := exch.GetHistoricCandles(ctx, e.Pair, e.Asset, params.Interval, start, end)
+	if err != nil {
+		return 0, err
+	}
+	var values []float64
+	switch params.Indicator {
+	case IndicatorSMA:
+		values, err = candles.GetSimpleMovingAverageOnClose(params.Period)
+	case IndicatorEMA:
+		values, err = candles.GetExponentialMovingAverageOnClose(params.Period)
+	case IndicatorRSI:
+		values, err = candles.GetRelativeStrengthIndexOnClose(params.Period)
+	case IndicatorMFI:
+		values, err = candles.GetMoneyFlowIndex(params.Period)
+	case IndicatorATR:
+		values, err = candles.GetAverageTrueRange(params.Period)
+	case IndicatorMACD:
+		macd, macdErr := candles.GetMovingAverageConvergenceDivergenceOnClose(macdFastPeriod, macdSlowPeriod, macdSignalPeriod)
+		if macdErr != nil {
+			return 0, macdErr
+		}
+		values = macd.Histogram
+	default:
+		return 0, fmt.Errorf("%w %q", errInvalidIndicator, params.Indicator)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, errNoIndicatorValues
+	}
+	return values[len(values)-1], nil
+}
+
+// executeAction performs the action of a triggered event
+func (m *eventManager) executeAction(e *Event, msg string) error {
+	ctx := context.TODO()
+	switch e.Action {
+	case ActionSubmitOrder:
+		return m.submitEventOrder(ctx, e)
+	case ActionCancelOrders:
+		return m.cancelEventOrders(ctx, e)
+	case ActionRunScript:
+		return m.runEventScript(e)
+	case ActionPush:
+		if e.ActionParams.Message != "" {
+			msg = e.ActionParams.Message
+		}
+		m.comms.PushEvent(base.Event{Type: eventType, Message: msg, Relayer: e.ActionParams.Relayer})
+	default:
+		m.comms.PushEvent(base.Event{Type: eventType, Message: msg})
+	}
+	return nil
+}
+
+// eventOrderType parses the order type of a SUBMIT_ORDER action, only market
+// and limit orders are supported
+func eventOrderType(oType string) (order.Type, error) {
+	if oType == "" {
+		return order.Market, nil
+	}
+	t, err := order.StringToOrderType(oType)
+	if err != nil {
+		return order.UnknownType, err
+	}
+	if t != order.Market && t != order.Limit {
+		return order.UnknownType, fmt.Errorf("%w %v", order.ErrUnsupportedOrderType, t)
+	}
+	return t, nil
+}
+
+func (m *eventManager) submitEventOrder(ctx context.Context, e *Event) error {
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return errEventOrderManagerNotSetup
+	}
+	side, err := order.StringToOrderSide(e.ActionParams.Side)
+	if err != nil {
+		return err
+	}
+	oType, err := eventOrderType(e.ActionParams.OrderType)
+	if err != nil {
+		return err
+	}
+	resp, err := m.orderManager.Submit(ctx, &order.Submit{
+		Exchange:  e.Exchange,
+		Pair:      e.Pair,
+		AssetType: e.Asset,
+		Side:      side,
+		Type:      oType,
+		Amount:    e.ActionParams.Amount,
+		Price:     e.ActionParams.Price,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof(log.EventMgr, "Events: ID: %d submitted %s %s order %s", e.ID, side, oType, resp.OrderID)
+	return nil
+}
+
+// cancelEventOrders cancels all active orders tracked by the order manager for
+// the event exchange, pair and asset
+func (m *eventManager) cancelEventOrders(ctx context.Context, e *Event) error {
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return errEventOrderManagerNotSetup
+	}
+	active, err := m.orderManager.GetOrdersActive(&order.Filter{
+		Exchange:  e.Exchange,
+		Pair:      e.Pair,
+		AssetType: e.Asset,
+	})
+	if err != nil {
+		return err
+	}
+	var errs error
+	for i := range active {
+		err = m.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  active[i].Exchange,
+			OrderID:   active[i].OrderID,
+			Pair:      active[i].Pair,
+			AssetType: active[i].AssetType,
+		})
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("order %v: %w", active[i].OrderID, err))
+		}
+	}
+	if errs == nil {
+		log.Infof(log.EventMgr, "Events: ID: %d cancelled %d orders", e.ID, len(active))
+	}
+	return errs
+}
+
+func (m *eventManager) runEventScript(e *Event) error {
+	if m.scriptManager == nil || !m.scriptManager.IsRunning() {
+		return errEventScriptManagerNotRun
+	}
+	// persisted events are not validated when they are loaded
+	if !filepath.IsLocal(e.ActionParams.Script) {
+		return fmt.Errorf("%w %q", errEventScriptPath, e.ActionParams.Script)
+	}
+	vm := m.scriptManager.New()
+	if vm == nil {
+		return errEventScriptVM
+	}
+	if err := vm.Load(filepath.Join(gctscript.ScriptPath, e.ActionParams.Script)); err != nil {
+		return err
+	}
+	go vm.CompileAndRun()
+	log.Infof(log.EventMgr, "Events: ID: %d executed script %s (%s)", e.ID, vm.ShortName(), vm.ID)
+	return nil
+}
@@ -1,27 +1,175 @@
 package engine
 
 import (
+	"context"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 )
 
+const eventTestExchangeName = "eventtest"
+
+// eventTestExchange serves funding rates, open interest and candles for event
+// conditions without exchange connectivity
+type eventTestExchange struct {
+	exchange.IBotExchange
+}
+
+func (eventTestExchange) GetLatestFundingRates(context.Context, *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	return []fundingrate.LatestRateResponse{{LatestRate: fundingrate.Rate{Rate: decimal.NewFromFloat(0.0001)}}}, nil
+}
+
+func (eventTestExchange) GetOpenInterest(context.Context, ...key.PairAsset) ([]futures.OpenInterest, error) {
+	return []futures.OpenInterest{{OpenInterest: 1337}}, nil
+}
+
+func (eventTestExchange) GetHistoricCandles(_ context.Context, p currency.Pair, a asset.Item, interval kline.Interval, start, _ time.Time) (*kline.Item, error) {
+	item := &kline.Item{Exchange: eventTestExchangeName, Pair: p, Asset: a, Interval: interval}
+	for i := range 10 {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   start.Add(interval.Duration() * time.Duration(i)),
+			Open:   float64(i + 1),
+			High:   float64(i + 1),
+			Low:    float64(i + 1),
+			Close:  float64(i + 1),
+			Volume: 1,
+		})
+	}
+	return item, nil
+}
+
+// eventOrderManager records orders submitted and cancelled by event actions
+type eventOrderManager struct {
+	m         sync.Mutex
+	submitted []*order.Submit
+	cancelled []*order.Cancel
+	active    []order.Detail
+}
+
+func (*eventOrderManager) IsRunning() bool { return true }
+
+// eventScriptManager is a running script manager which cannot create VMs
+type eventScriptManager struct{}
+
+func (eventScriptManager) IsRunning() bool { return true }
+
+func (eventScriptManager) New() *gctscript.VM { return nil }
+
+func (o *eventOrderManager) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.submitted = append(o.submitted, s)
+	return &OrderSubmitResponse{Detail: &order.Detail{OrderID: "1337"}}, nil
+}
+
+func (o *eventOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.cancelled = append(o.cancelled, c)
+	return nil
+}
+
+func (o *eventOrderManager) GetOrdersActive(*order.Filter) ([]order.Detail, error) {
+	return o.active, nil
+}
+
+// eventRuleRepository is an in memory event rule database service to test
+// event persistence without a database. see tests in the database folder
+type eventRuleRepository struct {
+	m     sync.Mutex
+	rules map[int64]eventrule.Details
+}
+
+func (r *eventRuleRepository) Upsert(rules ...*eventrule.Details) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, rule := range rules {
+		r.rules[rule.ID] = *rule
+	}
+	return nil
+}
+
+func (r *eventRuleRepository) Delete(id int64) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	delete(r.rules, id)
+	return nil
+}
+
+func (r *eventRuleRepository) GetAll() ([]eventrule.Details, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	resp := make([]eventrule.Details, 0, len(r.rules))
+	for _, rule := range r.rules {
+		resp = append(resp, rule)
+	}
+	return resp, nil
+}
+
+// setupEventRuleTest returns an event manager with an offline exchange which
+// has a ticker and orderbook loaded for btcusdPair
+func setupEventRuleTest(t *testing.T, om iEventOrderManager) (*eventManager, *riskEventRecorder) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err)
+	exch.SetDefaults()
+	exch.GetBase().Name = eventTestExchangeName
+	require.NoError(t, em.Add(eventTestExchange{IBotExchange: exch}))
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: eventTestExchangeName,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Last:         1337,
+		LastUpdated:  time.Now(),
+	}))
+	depth, err := orderbook.DeployDepth(eventTestExchangeName, btcusdPair, asset.Spot)
+	require.NoError(t, err)
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{
+		Bids:         []orderbook.Level{{Price: 99, Amount: 3}, {Price: 98, Amount: 1}},
+		Asks:         []orderbook.Level{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+		LastUpdated:  time.Now(),
+		LastPushed:   time.Now(),
+		RestSnapshot: true,
+	}))
+
+	recorder := &riskEventRecorder{}
+	m, err := setupEventManager(recorder, em, om, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+	t.Cleanup(func() { assert.NoError(t, m.Stop()) })
+	return m, recorder
+}
+
 func TestSetupEventManager(t *testing.T) {
 	t.Parallel()
-	_, err := setupEventManager(nil, nil, 0, false)
+	_, err := setupEventManager(nil, nil, nil, nil, 0, false)
 	assert.ErrorIs(t, err, errNilComManager)
 
-	_, err = setupEventManager(&CommunicationManager{}, nil, 0, false)
+	_, err = setupEventManager(&CommunicationManager{}, nil, nil, nil, 0, false)
 	assert.ErrorIs(t, err, errNilExchangeManager)
 
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	require.NoError(t, err)
 
 	if m == nil { //nolint:staticcheck,nolintlint // SA5011 Ignore the nil warnings
@@ -33,7 +181,7 @@ func TestSetupEventManager(t *testing.T) {
 }
 
 func TestEventManagerStart(t *testing.T) {
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -49,7 +197,7 @@ func TestEventManagerStart(t *testing.T) {
 
 func TestEventManagerIsRunning(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -70,7 +218,7 @@ func TestEventManagerIsRunning(t *testing.T) {
 
 func TestEventManagerStop(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -90,7 +238,7 @@ func TestEventManagerStop(t *testing.T) {
 func TestEventManagerAdd(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	_, err = m.Add("", "", EventConditionParams{}, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
@@ -132,7 +280,7 @@ func TestEventManagerAdd(t *testing.T) {
 func TestEventManagerRemove(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	if m.Remove(0) {
@@ -169,7 +317,7 @@ func TestEventManagerRemove(t *testing.T) {
 func TestGetEventCounter(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	total, executed := m.getEventCounter()
@@ -208,7 +356,7 @@ func TestGetEventCounter(t *testing.T) {
 
 func TestCheckEventCondition(t *testing.T) {
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	require.NoError(t, err, "setupEventManager must not error")
 
 	m.m.Lock()
@@ -274,3 +422,320 @@ func TestCheckEventCondition(t *testing.T) {
 	assert.NoError(t, err, "checkEventCondition should not error")
 	m.m.Unlock()
 }
+
+func TestEventManagerAddEvent(t *testing.T) {
+	t.Parallel()
+	m, _ := setupEventRuleTest(t, nil)
+
+	_, err := m.AddEvent(nil)
+	assert.ErrorIs(t, err, errNilEvent)
+
+	evt := &Event{
+		Exchange: eventTestExchangeName,
+		Pair:     btcusdPair,
+		Asset:    asset.Spot,
+		Action:   ActionTest,
+		Conditions: &EventCondition{
+			Operator: "xor",
+			Conditions: []EventCondition{
+				{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionLessThan, Value: 1}},
+			},
+		},
+	}
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errInvalidConditionOperator)
+
+	evt.Conditions = &EventCondition{Operator: ConditionAnd}
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errCompoundConditionEmpty)
+
+	evt.Conditions = &EventCondition{Item: ItemSpread, Conditions: []EventCondition{{Item: ItemSpread}}}
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errInvalidConditionOperator)
+
+	evt.Conditions = &EventCondition{
+		Operator: "and",
+		Conditions: []EventCondition{
+			{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionLessThan, Value: 1}},
+			{Item: "indicator", Params: EventConditionParams{Condition: ConditionGreaterThan, Indicator: "meow", Interval: kline.OneHour}},
+		},
+	}
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errInvalidIndicator)
+
+	evt.Conditions.Conditions[1].Params.Indicator = "sma"
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errInvalidIndicatorPeriod)
+
+	evt.Conditions.Conditions[1].Params.Period = 5
+	evt.Conditions.Conditions[1].Params.Interval = 0
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errInvalidIndicatorInterval)
+
+	evt.Conditions.Conditions[1].Params.Interval = kline.OneHour
+	evt.Cooldown = -1
+	_, err = m.AddEvent(evt)
+	assert.ErrorIs(t, err, errInvalidCooldown)
+
+	evt.Cooldown = time.Minute
+	id, err := m.AddEvent(evt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+	assert.Equal(t, ConditionAnd, evt.Conditions.Operator, "operators should be normalised")
+
+	id, err = m.Add(eventTestExchangeName, ItemPrice, EventConditionParams{Condition: ConditionGreaterThan, Price: 1}, btcusdPair, asset.Spot, ActionConsolePrint)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), id, "event IDs should be unique")
+
+	evt.Conditions = nil
+	evt.Item = ItemFundingRate
+	evt.Condition = EventConditionParams{Condition: ConditionGreaterThan}
+	for _, tc := range []struct {
+		action string
+		params EventActionParams
+		err    error
+	}{
+		{ActionSubmitOrder, EventActionParams{}, errEventOrderManagerNotSetup},
+		{ActionCancelOrders, EventActionParams{}, errEventOrderManagerNotSetup},
+		{ActionRunScript, EventActionParams{}, errEventScriptUnset},
+		{ActionRunScript, EventActionParams{Script: "../meow.gct"}, errEventScriptPath},
+		{ActionRunScript, EventActionParams{Script: "scripts/../../meow.gct"}, errEventScriptPath},
+		{ActionRunScript, EventActionParams{Script: "/etc/meow.gct"}, errEventScriptPath},
+		{ActionRunScript, EventActionParams{Script: "meow.gct"}, errEventScriptManagerNotRun},
+		{ActionPush, EventActionParams{}, errEventRelayerUnset},
+		{"MEOW", EventActionParams{}, errInvalidAction},
+		{ActionPush, EventActionParams{Relayer: "slack"}, nil},
+	} {
+		evt.Action = tc.action
+		evt.ActionParams = tc.params
+		_, err = m.AddEvent(evt)
+		assert.ErrorIs(t, err, tc.err, tc.action)
+	}
+
+	m.orderManager = &eventOrderManager{}
+	evt.Action = ActionSubmitOrder
+	for _, tc := range []struct {
+		params EventActionParams
+		err    error
+	}{
+		{EventActionParams{Side: "meow"}, order.ErrSideIsInvalid},
+		{EventActionParams{Side: "buy", OrderType: "stop"}, order.ErrUnsupportedOrderType},
+		{EventActionParams{Side: "buy"}, errEventOrderAmount},
+		{EventActionParams{Side: "buy", OrderType: "limit", Amount: 1}, errEventOrderPrice},
+		{EventActionParams{Side: "buy", OrderType: "limit", Amount: 1, Price: 1337}, nil},
+	} {
+		evt.ActionParams = tc.params
+		_, err = m.AddEvent(evt)
+		assert.ErrorIs(t, err, tc.err)
+	}
+}
+
+func TestEvaluateEventCondition(t *testing.T) {
+	t.Parallel()
+	m, _ := setupEventRuleTest(t, nil)
+	e := &Event{Exchange: eventTestExchangeName, Pair: btcusdPair, Asset: asset.Spot}
+	for _, tc := range []struct {
+		name string
+		cond EventCondition
+		met  bool
+	}{
+		{"price", EventCondition{Item: ItemPrice, Params: EventConditionParams{Condition: ConditionIsEqual, Price: 1337}}, true},
+		{"orderbook bids", EventCondition{Item: ItemOrderbook, Params: EventConditionParams{Condition: ConditionGreaterThan, OrderbookAmount: 250, CheckBids: true}}, true},
+		{"orderbook asks", EventCondition{Item: ItemOrderbook, Params: EventConditionParams{Condition: ConditionGreaterThan, OrderbookAmount: 250, CheckAsks: true}}, false},
+		{"spread", EventCondition{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionLessThan, Value: 2}}, true},
+		{"imbalance", EventCondition{Item: ItemImbalance, Params: EventConditionParams{Condition: ConditionIsEqual, Value: 0.5}}, true},
+		{"funding rate", EventCondition{Item: ItemFundingRate, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.001}}, false},
+		{"open interest", EventCondition{Item: ItemOpenInterest, Params: EventConditionParams{Condition: ConditionGreaterThanOrEqual, Value: 1337}}, true},
+		{"sma", EventCondition{Item: ItemIndicator, Params: EventConditionParams{Condition: ConditionIsEqual, Value: 9, Indicator: IndicatorSMA, Interval: kline.OneHour, Period: 3}}, true},
+		{"and", EventCondition{Operator: ConditionAnd, Conditions: []EventCondition{
+			{Item: ItemPrice, Params: EventConditionParams{Condition: ConditionGreaterThan, Price: 1}},
+			{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 2}},
+		}}, false},
+		{"or", EventCondition{Operator: ConditionOr, Conditions: []EventCondition{
+			{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 2}},
+			{Operator: ConditionAnd, Conditions: []EventCondition{
+				{Item: ItemPrice, Params: EventConditionParams{Condition: ConditionGreaterThan, Price: 1}},
+				{Item: ItemImbalance, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0}},
+			}},
+		}}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			met, err := m.evaluateCondition(t.Context(), e, &tc.cond)
+			require.NoError(t, err)
+			assert.Equal(t, tc.met, met)
+		})
+	}
+
+	missing := &Event{Exchange: eventTestExchangeName, Pair: currency.NewPair(currency.LTC, currency.USD), Asset: asset.Spot}
+	_, err := m.evaluateCondition(t.Context(), missing, &EventCondition{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionLessThan, Value: 1}})
+	assert.ErrorIs(t, err, orderbook.ErrOrderbookNotFound)
+
+	met, err := m.evaluateCondition(t.Context(), missing, &EventCondition{Operator: ConditionOr, Conditions: []EventCondition{
+		{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionLessThan, Value: 1}},
+		{Item: ItemOpenInterest, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 1}},
+	}})
+	require.NoError(t, err, "OR conditions must ignore errors when another branch is met")
+	assert.True(t, met)
+}
+
+func TestProcessEvents(t *testing.T) {
+	t.Parallel()
+	m, recorder := setupEventRuleTest(t, nil)
+	cond := EventConditionParams{Condition: ConditionGreaterThan, Price: 1}
+	once, err := m.Add(eventTestExchangeName, ItemPrice, cond, btcusdPair, asset.Spot, ActionTest)
+	require.NoError(t, err)
+	repeat, err := m.AddEvent(&Event{
+		Exchange:  eventTestExchangeName,
+		Item:      ItemPrice,
+		Condition: cond,
+		Pair:      btcusdPair,
+		Asset:     asset.Spot,
+		Action:    ActionTest,
+		Repeat:    true,
+		Cooldown:  time.Minute,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	m.processEvents(now)
+	m.processEvents(now.Add(time.Second))
+	m.processEvents(now.Add(time.Minute))
+
+	events, err := m.GetEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, once, events[0].ID)
+	assert.True(t, events[0].Executed, "non repeating events should be executed once triggered")
+	assert.Equal(t, int64(1), events[0].TriggerCount)
+	assert.Equal(t, repeat, events[1].ID)
+	assert.False(t, events[1].Executed, "repeating events should remain armed")
+	assert.Equal(t, int64(2), events[1].TriggerCount, "repeating events should respect their cooldown")
+	assert.Equal(t, now.Add(time.Minute), events[1].LastTriggered)
+
+	recorder.m.Lock()
+	assert.Len(t, recorder.events, 3)
+	recorder.m.Unlock()
+}
+
+func TestExecuteEventActions(t *testing.T) {
+	t.Parallel()
+	om := &eventOrderManager{active: []order.Detail{
+		{Exchange: eventTestExchangeName, OrderID: "1", Pair: btcusdPair, AssetType: asset.Spot},
+		{Exchange: eventTestExchangeName, OrderID: "2", Pair: btcusdPair, AssetType: asset.Spot},
+	}}
+	m, recorder := setupEventRuleTest(t, om)
+	e := &Event{
+		Exchange:     eventTestExchangeName,
+		Pair:         btcusdPair,
+		Asset:        asset.Spot,
+		Action:       ActionSubmitOrder,
+		ActionParams: EventActionParams{Side: "sell", Amount: 1},
+	}
+	require.NoError(t, m.executeAction(e, "triggered"))
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, order.Sell, om.submitted[0].Side)
+	assert.Equal(t, order.Market, om.submitted[0].Type)
+
+	e.Action = ActionCancelOrders
+	require.NoError(t, m.executeAction(e, "triggered"))
+	assert.Len(t, om.cancelled, 2)
+
+	e.Action = ActionPush
+	e.ActionParams = EventActionParams{Relayer: "telegram", Message: "meow"}
+	require.NoError(t, m.executeAction(e, "triggered"))
+	recorder.m.Lock()
+	require.Len(t, recorder.events, 1)
+	assert.Equal(t, "telegram", recorder.events[0].Relayer)
+	assert.Equal(t, "meow", recorder.events[0].Message)
+	recorder.m.Unlock()
+
+	e.Action = ActionRunScript
+	assert.ErrorIs(t, m.executeAction(e, "triggered"), errEventScriptManagerNotRun)
+
+	m.scriptManager = eventScriptManager{}
+	e.ActionParams = EventActionParams{Script: "../meow.gct"}
+	assert.ErrorIs(t, m.executeAction(e, "triggered"), errEventScriptPath, "persisted events should not run scripts outside the script path")
+	e.ActionParams = EventActionParams{Script: "meow.gct"}
+	assert.ErrorIs(t, m.executeAction(e, "triggered"), errEventScriptVM)
+}
+
+func TestSetupEventPersistence(t *testing.T) {
+	t.Parallel()
+	var m *eventManager
+	err := m.SetupEventPersistence(nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = &eventManager{started: 1}
+	err = m.SetupEventPersistence(nil)
+	assert.ErrorIs(t, err, ErrSubSystemAlreadyStarted)
+
+	m.started = 0
+	err = m.SetupEventPersistence(nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	err = m.SetupEventPersistence(&DatabaseConnectionManager{})
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+}
+
+func TestSetSleepDelay(t *testing.T) {
+	t.Parallel()
+	var m *eventManager
+	assert.ErrorIs(t, m.setSleepDelay(time.Second), ErrNilSubsystem)
+
+	m = &eventManager{started: 1}
+	assert.ErrorIs(t, m.setSleepDelay(time.Second), ErrSubSystemAlreadyStarted)
+
+	m.started = 0
+	require.NoError(t, m.setSleepDelay(time.Second), "setSleepDelay must not error")
+	assert.Equal(t, time.Second, m.sleepDelay, "sleepDelay should be set")
+	require.NoError(t, m.setSleepDelay(0), "setSleepDelay must not error")
+	assert.Equal(t, EventSleepDelay, m.sleepDelay, "sleepDelay should default when not positive")
+}
+
+func TestEventPersistence(t *testing.T) {
+	t.Parallel()
+	m, _ := setupEventRuleTest(t, nil)
+	repo := &eventRuleRepository{rules: make(map[int64]eventrule.Details)}
+	m.repository = repo
+
+	id, err := m.AddEvent(&Event{
+		Exchange:  eventTestExchangeName,
+		Item:      ItemIndicator,
+		Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 70, Indicator: IndicatorRSI, Interval: kline.FifteenMin, Period: 14},
+		Pair:      btcusdPair,
+		Asset:     asset.Spot,
+		Action:    ActionTest,
+		Repeat:    true,
+		Cooldown:  time.Hour,
+	})
+	require.NoError(t, err)
+	require.Contains(t, repo.rules, id, "AddEvent must write the event through to the repository")
+
+	em, ok := m.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	restarted, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+	restarted.repository = repo
+	require.NoError(t, restarted.Start())
+	defer func() { assert.NoError(t, restarted.Stop()) }()
+
+	events, err := restarted.GetEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1, "persisted events must be loaded on start")
+	assert.Equal(t, id, events[0].ID)
+	assert.Equal(t, kline.FifteenMin, events[0].Condition.Interval)
+	assert.Equal(t, time.Hour, events[0].Cooldown)
+	assert.True(t, events[0].Pair.Equal(btcusdPair))
+
+	nextID, err := restarted.Add(eventTestExchangeName, ItemPrice, EventConditionParams{Condition: ConditionGreaterThan, Price: 1}, btcusdPair, asset.Spot, ActionTest)
+	require.NoError(t, err)
+	assert.Equal(t, id+1, nextID, "IDs must continue after persisted events")
+
+	assert.True(t, restarted.Remove(id))
+	assert.NotContains(t, repo.rules, id, "Remove must delete the persisted event")
+
+	var stored Event
+	require.NoError(t, json.Unmarshal([]byte(repo.rules[nextID].Definition), &stored))
+	assert.Equal(t, ItemPrice, stored.Item)
+}
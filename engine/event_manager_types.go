@@ -1,18 +1,31 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 )
 
+// EventManagerName is an exported subsystem name
+const EventManagerName = "event_manager"
+
 // Event const vars
 const (
-	ItemPrice     = "PRICE"
-	ItemOrderbook = "ORDERBOOK"
+	ItemPrice        = "PRICE"
+	ItemOrderbook    = "ORDERBOOK"
+	ItemSpread       = "SPREAD"
+	ItemImbalance    = "IMBALANCE"
+	ItemFundingRate  = "FUNDING_RATE"
+	ItemOpenInterest = "OPEN_INTEREST"
+	ItemIndicator    = "INDICATOR"
 
 	ConditionGreaterThan        = ">"
 	ConditionGreaterThanOrEqual = ">="
@@ -20,23 +33,68 @@ const (
 	ConditionLessThanOrEqual    = "<="
 	ConditionIsEqual            = "=="
 
+	ConditionAnd = "AND"
+	ConditionOr  = "OR"
+
+	IndicatorSMA  = "SMA"
+	IndicatorEMA  = "EMA"
+	IndicatorRSI  = "RSI"
+	IndicatorMFI  = "MFI"
+	IndicatorATR  = "ATR"
+	IndicatorMACD = "MACD"
+
 	ActionSMSNotify    = "SMS"
 	ActionConsolePrint = "CONSOLE_PRINT"
 	ActionTest         = "ACTION_TEST"
+	ActionSubmitOrder  = "SUBMIT_ORDER"
+	ActionCancelOrders = "CANCEL_ORDERS"
+	ActionRunScript    = "RUN_SCRIPT"
+	ActionPush         = "PUSH"
 
 	defaultSleepDelay = time.Millisecond * 500
+
+	eventType = "event"
+	// remoteDataRefresh is the maximum age of funding rate, open interest and
+	// indicator values fetched from an exchange before they are requested
+	// again
+	remoteDataRefresh = time.Minute
+	// indicatorCandleMultiplier sets the number of candles requested for
+	// indicator conditions as a multiple of the indicator period so moving
+	// averages have enough history to settle
+	indicatorCandleMultiplier = 3
+	macdFastPeriod            = 12
+	macdSlowPeriod            = 26
+	macdSignalPeriod          = 9
 )
 
 // vars related to events package
 var (
-	EventSleepDelay        = defaultSleepDelay
-	errInvalidItem         = errors.New("invalid item")
-	errInvalidCondition    = errors.New("invalid conditional option")
-	errInvalidAction       = errors.New("invalid action")
-	errExchangeDisabled    = errors.New("desired exchange is disabled")
-	errNilEvent            = errors.New("nil event received")
-	errNilComManager       = errors.New("nil communications manager received")
-	errTickerLastPriceZero = errors.New("ticker last price is 0")
+	EventSleepDelay              = defaultSleepDelay
+	errInvalidItem               = errors.New("invalid item")
+	errInvalidCondition          = errors.New("invalid conditional option")
+	errInvalidAction             = errors.New("invalid action")
+	errExchangeDisabled          = errors.New("desired exchange is disabled")
+	errNilEvent                  = errors.New("nil event received")
+	errNilComManager             = errors.New("nil communications manager received")
+	errTickerLastPriceZero       = errors.New("ticker last price is 0")
+	errEventConditionNotMet      = errors.New("does not meet conditions")
+	errInvalidConditionOperator  = errors.New("compound condition operator must be AND or OR")
+	errCompoundConditionEmpty    = errors.New("compound condition requires at least one condition")
+	errInvalidIndicator          = errors.New("invalid indicator")
+	errInvalidIndicatorPeriod    = errors.New("indicator period must be greater than zero")
+	errInvalidIndicatorInterval  = errors.New("indicator interval must be set")
+	errNoIndicatorValues         = errors.New("indicator returned no values")
+	errNoFundingRate             = errors.New("no funding rate returned")
+	errNoOpenInterest            = errors.New("no open interest returned")
+	errInvalidCooldown           = errors.New("cooldown cannot be negative")
+	errEventOrderManagerNotSetup = errors.New("order manager is not running")
+	errEventScriptManagerNotRun  = errors.New("gctscript manager is not running")
+	errEventScriptUnset          = errors.New("script name must be set")
+	errEventScriptPath           = errors.New("script must be located within the script path")
+	errEventScriptVM             = errors.New("unable to create VM instance")
+	errEventRelayerUnset         = errors.New("comms relayer must be set")
+	errEventOrderAmount          = errors.New("order amount must be greater than zero")
+	errEventOrderPrice           = errors.New("limit order price must be greater than zero")
 )
 
 // EventConditionParams holds the event condition variables
@@ -47,6 +105,42 @@ type EventConditionParams struct {
 	CheckBids       bool
 	CheckAsks       bool
 	OrderbookAmount float64
+
+	// Value is the threshold for the SPREAD, IMBALANCE, FUNDING_RATE,
+	// OPEN_INTEREST and INDICATOR items
+	Value float64
+	// Indicator, Interval and Period configure INDICATOR items. MACD
+	// compares its histogram and uses the standard 12, 26 and 9 periods
+	Indicator string
+	Interval  kline.Interval
+	Period    int64
+}
+
+// EventCondition is a node in a compound event condition. A leaf compares Item
+// against Params, a branch combines its Conditions with the AND or OR
+// Operator
+type EventCondition struct {
+	Item       string
+	Params     EventConditionParams
+	Operator   string
+	Conditions []EventCondition
+}
+
+// EventActionParams holds the parameters for actions which need more than the
+// action name
+type EventActionParams struct {
+	// Side, OrderType, Amount and Price configure SUBMIT_ORDER actions.
+	// OrderType defaults to a market order
+	Side      string
+	OrderType string
+	Amount    float64
+	Price     float64
+	// Script is the gctscript file name to run for RUN_SCRIPT actions
+	Script string
+	// Relayer is the comms relayer name for PUSH actions
+	Relayer string
+	// Message replaces the default trigger message for PUSH actions
+	Message string
 }
 
 // Event struct holds the event variables
@@ -55,10 +149,41 @@ type Event struct {
 	Exchange  string
 	Item      string
 	Condition EventConditionParams
-	Pair      currency.Pair
-	Asset     asset.Item
-	Action    string
-	Executed  bool
+	// Conditions is evaluated instead of Item and Condition when set
+	Conditions   *EventCondition
+	Pair         currency.Pair
+	Asset        asset.Item
+	Action       string
+	ActionParams EventActionParams
+	// Repeat keeps the event armed after it triggers. Cooldown is the
+	// minimum time between triggers
+	Repeat        bool
+	Cooldown      time.Duration
+	TriggerCount  int64
+	LastTriggered time.Time
+	Executed      bool
+}
+
+// iEventOrderManager defines the order manager functions used by event
+// actions
+type iEventOrderManager interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+	GetOrdersActive(*order.Filter) ([]order.Detail, error)
+}
+
+// iScriptManager defines the gctscript manager functions used by event
+// actions
+type iScriptManager interface {
+	IsRunning() bool
+	New() *gctscript.VM
+}
+
+// remoteValue is a condition value fetched from an exchange
+type remoteValue struct {
+	value   float64
+	fetched time.Time
 }
 
 // eventManager holds communication manager data
@@ -66,9 +191,16 @@ type eventManager struct {
 	started         int32
 	comms           iCommsManager
 	events          []Event
+	nextID          int64
 	verbose         bool
 	sleepDelay      time.Duration
 	exchangeManager iExchangeManager
+	orderManager    iEventOrderManager
+	scriptManager   iScriptManager
+	repository      eventrule.IDBService
 	shutdown        chan struct{}
+	wg              sync.WaitGroup
 	m               sync.Mutex
+	remoteMtx       sync.Mutex
+	remoteValues    map[string]remoteValue
 }
@@ -0,0 +1,733 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupExecutionAlgorithmManager creates an execution algorithm manager
+// subsystem
+func SetupExecutionAlgorithmManager(em iExchangeManager, om iOrderSubmitter, cfg *config.ExecutionAlgorithmManager) (*ExecutionAlgorithmManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w ExecutionAlgorithmManager", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultExecutionAlgorithmCheckInterval
+	}
+	return &ExecutionAlgorithmManager{
+		shutdown:        make(chan struct{}),
+		algorithms:      make(map[uuid.UUID]*executionAlgorithm),
+		exchangeManager: em,
+		orderManager:    om,
+		checkInterval:   cfg.CheckInterval,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ExecutionAlgorithmManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *ExecutionAlgorithmManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("execution algorithm manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("execution algorithm manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.OrderMgr, "Execution algorithm manager starting...")
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Running algorithms stop submitting
+// child orders but any open child orders are left on the exchange
+func (m *ExecutionAlgorithmManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("execution algorithm manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("execution algorithm manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.OrderMgr, "Execution algorithm manager shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.OrderMgr, "Execution algorithm manager shutdown.")
+	return nil
+}
+
+func (m *ExecutionAlgorithmManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.process(time.Now())
+		}
+	}
+}
+
+// process works all running algorithms
+func (m *ExecutionAlgorithmManager) process(now time.Time) {
+	for _, a := range m.getAlgorithms(AlgoStatusRunning) {
+		m.step(context.TODO(), a, now)
+	}
+}
+
+// Add validates a parent order and starts working it with the requested
+// execution algorithm. The first child order is submitted immediately
+func (m *ExecutionAlgorithmManager) Add(ctx context.Context, req *ExecutionAlgorithmRequest) (*ExecutionAlgorithm, error) {
+	if m == nil {
+		return nil, fmt.Errorf("execution algorithm manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("execution algorithm manager %w", ErrSubSystemNotStarted)
+	}
+	if !m.orderManager.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if req != nil && req.Type == AlgoVWAP && req.ProfileDays == 0 {
+		req.ProfileDays = defaultVWAPProfileDays
+	}
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(req.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	a := &executionAlgorithm{
+		ExecutionAlgorithm: ExecutionAlgorithm{
+			ID:                        id,
+			ExecutionAlgorithmRequest: *req,
+			Status:                    AlgoStatusRunning,
+			StartTime:                 now,
+			CreatedAt:                 now,
+			UpdatedAt:                 now,
+		},
+		children: make(map[string]*algoChildOrder),
+	}
+	a.Exchange = exch.GetName()
+	if limits, err := exch.GetOrderExecutionLimits(a.Asset, a.Pair); err == nil {
+		a.limits = &limits
+	}
+	a.ArrivalPrice = getArrivalPrice(ctx, exch, a)
+	switch a.Type {
+	case AlgoTWAP:
+		a.schedule, err = cumulativeSchedule(slices.Repeat([]float64{1}, a.Slices))
+		a.EndTime = a.StartTime.Add(a.Duration)
+	case AlgoVWAP:
+		a.schedule, err = vwapSchedule(ctx, exch, a)
+		a.EndTime = a.StartTime.Add(a.Duration)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m.m.Lock()
+	m.algorithms[id] = a
+	m.m.Unlock()
+	log.Infof(log.OrderMgr, "Execution algorithm %s %v started for %s %s %s %s %v arrival price %v",
+		a.Type, a.ID, a.Exchange, a.Asset, a.Pair, a.Side, a.Amount, a.ArrivalPrice)
+	m.step(ctx, a, now)
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.Status == AlgoStatusFailed {
+		return nil, fmt.Errorf("execution algorithm %v failed: %s", id, a.Error)
+	}
+	return a.snapshot(), nil
+}
+
+// Pause cancels the open child orders of a running algorithm and stops it
+// from submitting more until it is resumed
+func (m *ExecutionAlgorithmManager) Pause(ctx context.Context, id uuid.UUID) error {
+	a, err := m.getAlgorithm(id)
+	if err != nil {
+		return err
+	}
+	a.m.Lock()
+	if a.Status != AlgoStatusRunning {
+		a.m.Unlock()
+		return fmt.Errorf("%w %v status %v", errAlgoNotRunning, id, a.Status)
+	}
+	if a.busy {
+		a.m.Unlock()
+		return fmt.Errorf("%w %v", errAlgoBusy, id)
+	}
+	m.updateFills(a)
+	a.pausedAt = time.Now()
+	m.setStatus(a, AlgoStatusPaused)
+	open := a.openChildIDs()
+	a.m.Unlock()
+	return m.cancelChildren(ctx, a, open)
+}
+
+// Resume restarts a paused algorithm. The TWAP and VWAP schedules are shifted
+// by the time spent paused
+func (m *ExecutionAlgorithmManager) Resume(id uuid.UUID) error {
+	a, err := m.getAlgorithm(id)
+	if err != nil {
+		return err
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.Status != AlgoStatusPaused {
+		return fmt.Errorf("%w %v status %v", errAlgoNotPaused, id, a.Status)
+	}
+	paused := time.Since(a.pausedAt)
+	a.StartTime = a.StartTime.Add(paused)
+	if !a.EndTime.IsZero() {
+		a.EndTime = a.EndTime.Add(paused)
+	}
+	m.setStatus(a, AlgoStatusRunning)
+	return nil
+}
+
+// Cancel stops an algorithm and cancels its open child orders
+func (m *ExecutionAlgorithmManager) Cancel(ctx context.Context, id uuid.UUID) error {
+	a, err := m.getAlgorithm(id)
+	if err != nil {
+		return err
+	}
+	a.m.Lock()
+	if !a.isActive() {
+		a.m.Unlock()
+		return fmt.Errorf("%w %v status %v", errAlgoInactive, id, a.Status)
+	}
+	if a.busy {
+		a.m.Unlock()
+		return fmt.Errorf("%w %v", errAlgoBusy, id)
+	}
+	m.updateFills(a)
+	m.setStatus(a, AlgoStatusCancelled)
+	open := a.openChildIDs()
+	a.m.Unlock()
+	return m.cancelChildren(ctx, a, open)
+}
+
+// GetAlgorithm returns the progress of an algorithm
+func (m *ExecutionAlgorithmManager) GetAlgorithm(id uuid.UUID) (*ExecutionAlgorithm, error) {
+	a, err := m.getAlgorithm(id)
+	if err != nil {
+		return nil, err
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.snapshot(), nil
+}
+
+// GetAlgorithms returns the progress of all algorithms. Completed, cancelled
+// and failed algorithms are only included when includeInactive is set
+func (m *ExecutionAlgorithmManager) GetAlgorithms(includeInactive bool) ([]ExecutionAlgorithm, error) {
+	if m == nil {
+		return nil, fmt.Errorf("execution algorithm manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("execution algorithm manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]ExecutionAlgorithm, 0, len(m.algorithms))
+	for _, a := range m.algorithms {
+		a.m.Lock()
+		if includeInactive || a.isActive() {
+			resp = append(resp, *a.snapshot())
+		}
+		a.m.Unlock()
+	}
+	return resp, nil
+}
+
+// websocketDataHandler accumulates the market volume for POV algorithms from
+// the exchange trade streams
+func (m *ExecutionAlgorithmManager) websocketDataHandler(_ string, data any) error {
+	switch d := data.(type) {
+	case trade.Data:
+		m.processTrades(d)
+	case []trade.Data:
+		m.processTrades(d...)
+	}
+	return nil
+}
+
+func (m *ExecutionAlgorithmManager) processTrades(trades ...trade.Data) {
+	if !m.IsRunning() {
+		return
+	}
+	for _, a := range m.getAlgorithms(AlgoStatusRunning) {
+		if a.Type != AlgoPOV {
+			continue
+		}
+		a.m.Lock()
+		for i := range trades {
+			if a.Status == AlgoStatusRunning &&
+				strings.EqualFold(trades[i].Exchange, a.Exchange) &&
+				trades[i].AssetType == a.Asset &&
+				trades[i].CurrencyPair.Equal(a.Pair) &&
+				!trades[i].Timestamp.Before(a.StartTime) {
+				a.MarketVolume += trades[i].Amount
+			}
+		}
+		a.m.Unlock()
+	}
+}
+
+// step updates the fills of an algorithm and submits the next child order if
+// one is due. The algorithm is unlocked while the child order is submitted so
+// the trade stream and RPC requests are not held up by the exchange
+func (m *ExecutionAlgorithmManager) step(ctx context.Context, a *executionAlgorithm, now time.Time) {
+	a.m.Lock()
+	s, err := m.nextChildOrder(a, now)
+	if err != nil {
+		open := m.fail(a, err)
+		a.m.Unlock()
+		m.cancelFailed(ctx, a, open)
+		return
+	}
+	if s == nil {
+		a.m.Unlock()
+		return
+	}
+	a.busy = true
+	a.m.Unlock()
+
+	resp, err := m.orderManager.Submit(ctx, s)
+
+	a.m.Lock()
+	a.busy = false
+	if err != nil {
+		open := m.fail(a, err)
+		a.m.Unlock()
+		m.cancelFailed(ctx, a, open)
+		return
+	}
+	defer a.m.Unlock()
+	a.children[resp.OrderID] = &algoChildOrder{amount: s.Amount}
+	a.ChildOrderIDs = append(a.ChildOrderIDs, resp.OrderID)
+	a.SubmittedAmount = decimal.NewFromFloat(a.SubmittedAmount).Add(decimal.NewFromFloat(s.Amount)).InexactFloat64()
+	a.UpdatedAt = now
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Execution algorithm %s %v submitted child order %v amount %v submitted %v/%v",
+			a.Type, a.ID, resp.OrderID, s.Amount, a.SubmittedAmount, a.Amount)
+	}
+}
+
+// nextChildOrder updates the fills of an algorithm and returns the child
+// order which is due, or nil when nothing is due. It must be called with the
+// algorithm locked
+func (m *ExecutionAlgorithmManager) nextChildOrder(a *executionAlgorithm, now time.Time) (*order.Submit, error) {
+	if a.Status != AlgoStatusRunning || a.busy {
+		return nil, nil
+	}
+	m.updateFills(a)
+	if a.Amount-a.FilledAmount <= a.Amount*1e-9 {
+		m.setStatus(a, AlgoStatusCompleted)
+		return nil, nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(a.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	oType := order.Market
+	if a.Price > 0 {
+		oType = order.Limit
+	}
+	open := a.openChildren()
+	submitted := decimal.NewFromFloat(a.SubmittedAmount)
+	unsubmitted := decimal.NewFromFloat(a.Amount).Sub(submitted)
+	if open == 0 && !canSubmit(exch, a, unsubmitted, oType) {
+		// Nothing is left working and the remainder can never be submitted
+		a.Error = errAlgoRemainingBelowMinimumSize.Error()
+		m.setStatus(a, AlgoStatusCompleted)
+		return nil, nil
+	}
+	due := decimal.NewFromFloat(math.Min(a.target(now, open), a.Amount)).Sub(submitted)
+	amount := a.limits.ConformToDecimalAmount(due).InexactFloat64()
+	if amount <= 0 {
+		return nil, nil
+	}
+	err = exch.CheckOrderExecutionLimits(a.Asset, a.Pair, a.Price, amount, oType)
+	if errors.Is(err, order.ErrAmountBelowMin) {
+		// Carry the amount over until enough is due to meet the minimum
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &order.Submit{
+		Exchange:  a.Exchange,
+		Pair:      a.Pair,
+		AssetType: a.Asset,
+		Side:      a.Side,
+		Type:      oType,
+		Amount:    amount,
+		Price:     a.Price,
+	}, nil
+}
+
+// updateFills refreshes child order fills from the order manager and
+// recalculates the average price and slippage. Unfilled amounts of inactive
+// child orders are returned to the algorithm to be worked again
+func (m *ExecutionAlgorithmManager) updateFills(a *executionAlgorithm) {
+	var filled, cost, pricedAmount float64
+	for id, c := range a.children {
+		if !c.done {
+			d, err := m.orderManager.GetByExchangeAndID(a.Exchange, id)
+			if err == nil {
+				c.executed = d.ExecutedAmount
+				if d.Status == order.Filled && c.executed == 0 {
+					c.executed = c.amount
+				}
+				switch {
+				case d.AverageExecutedPrice > 0:
+					c.price = d.AverageExecutedPrice
+				case d.Price > 0:
+					c.price = d.Price
+				}
+				if d.IsInactive() {
+					c.done = true
+					if c.executed < c.amount {
+						unfilled := decimal.NewFromFloat(c.amount).Sub(decimal.NewFromFloat(c.executed))
+						a.SubmittedAmount = decimal.NewFromFloat(a.SubmittedAmount).Sub(unfilled).InexactFloat64()
+					}
+				}
+			} else if !errors.Is(err, ErrOrderNotFound) {
+				log.Errorf(log.OrderMgr, "Execution algorithm %v unable to retrieve child order %v: %v", a.ID, id, err)
+			}
+		}
+		filled += c.executed
+		if c.price > 0 {
+			cost += c.executed * c.price
+			pricedAmount += c.executed
+		}
+	}
+	a.FilledAmount = filled
+	if pricedAmount > 0 {
+		a.AveragePrice = cost / pricedAmount
+	}
+	if a.ArrivalPrice > 0 && a.AveragePrice > 0 {
+		a.Slippage = (a.AveragePrice - a.ArrivalPrice) / a.ArrivalPrice * 10000
+		if a.Side.IsShort() {
+			a.Slippage = -a.Slippage
+		}
+	}
+}
+
+// cancelChildren cancels the supplied open child orders of an algorithm. It
+// must be called without the algorithm locked
+func (m *ExecutionAlgorithmManager) cancelChildren(ctx context.Context, a *executionAlgorithm, ids []string) error {
+	var errs error
+	for _, id := range ids {
+		err := m.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  a.Exchange,
+			OrderID:   id,
+			Pair:      a.Pair,
+			AssetType: a.Asset,
+		})
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("execution algorithm %v child order %v: %w", a.ID, id, err))
+		}
+	}
+	return errs
+}
+
+// fail marks an algorithm failed and returns its open child orders, which are
+// cancelled with cancelFailed once the algorithm is unlocked
+func (m *ExecutionAlgorithmManager) fail(a *executionAlgorithm, err error) []string {
+	a.Error = err.Error()
+	m.setStatus(a, AlgoStatusFailed)
+	return a.openChildIDs()
+}
+
+// cancelFailed cancels the open child orders of a failed algorithm
+func (m *ExecutionAlgorithmManager) cancelFailed(ctx context.Context, a *executionAlgorithm, ids []string) {
+	if err := m.cancelChildren(ctx, a, ids); err != nil {
+		log.Errorln(log.OrderMgr, err)
+	}
+}
+
+func (m *ExecutionAlgorithmManager) setStatus(a *executionAlgorithm, s AlgoStatus) {
+	a.Status = s
+	a.UpdatedAt = time.Now()
+	msg := fmt.Sprintf("Execution algorithm %s %v %s filled %v/%v average price %v slippage %.2f bps",
+		a.Type, a.ID, strings.ToLower(string(s)), a.FilledAmount, a.Amount, a.AveragePrice, a.Slippage)
+	if a.Error != "" {
+		msg += " error=" + a.Error
+	}
+	if s == AlgoStatusFailed {
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		log.Infoln(log.OrderMgr, msg)
+	}
+}
+
+func (m *ExecutionAlgorithmManager) getAlgorithm(id uuid.UUID) (*executionAlgorithm, error) {
+	if m == nil {
+		return nil, fmt.Errorf("execution algorithm manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("execution algorithm manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	a, ok := m.algorithms[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errAlgoNotFound, id)
+	}
+	return a, nil
+}
+
+// getAlgorithms returns all algorithms with the supplied status
+func (m *ExecutionAlgorithmManager) getAlgorithms(s AlgoStatus) []*executionAlgorithm {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]*executionAlgorithm, 0, len(m.algorithms))
+	for _, a := range m.algorithms {
+		a.m.Lock()
+		if a.Status == s {
+			resp = append(resp, a)
+		}
+		a.m.Unlock()
+	}
+	return resp
+}
+
+// target returns the cumulative amount which should have been submitted by
+// the supplied time
+func (a *executionAlgorithm) target(now time.Time, open int) float64 {
+	switch a.Type {
+	case AlgoTWAP, AlgoVWAP:
+		sliceDuration := a.EndTime.Sub(a.StartTime) / time.Duration(len(a.schedule))
+		i := int(now.Sub(a.StartTime) / sliceDuration)
+		i = max(0, min(i, len(a.schedule)-1))
+		return a.Amount * a.schedule[i]
+	case AlgoPOV:
+		if a.ParticipationRate >= 1 {
+			return a.Amount
+		}
+		// The trade stream includes the algorithm's own fills, so the target
+		// is sized against the volume traded by everyone else for the fills
+		// to make up the participation rate of the total volume
+		others := math.Max(a.MarketVolume-a.FilledAmount, 0)
+		return others * a.ParticipationRate / (1 - a.ParticipationRate)
+	case AlgoIceberg:
+		if open > 0 {
+			return a.SubmittedAmount
+		}
+		clip := a.ClipSize
+		if a.ClipVariance > 0 {
+			clip *= 1 + a.ClipVariance*(2*rand.Float64()-1) //nolint:gosec // Clip randomisation does not need to be cryptographically secure
+		}
+		if a.limits != nil {
+			clip = math.Max(clip, a.limits.MinimumBaseAmount)
+		}
+		return a.SubmittedAmount + clip
+	}
+	return 0
+}
+
+// openChildIDs returns the IDs of the child orders which are still open
+func (a *executionAlgorithm) openChildIDs() []string {
+	ids := make([]string, 0, len(a.children))
+	for id, c := range a.children {
+		if !c.done {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (a *executionAlgorithm) openChildren() int {
+	var open int
+	for _, c := range a.children {
+		if !c.done {
+			open++
+		}
+	}
+	return open
+}
+
+// snapshot returns a copy of the algorithm which is safe to share
+func (a *executionAlgorithm) snapshot() *ExecutionAlgorithm {
+	resp := a.ExecutionAlgorithm
+	resp.ChildOrderIDs = slices.Clone(a.ChildOrderIDs)
+	return &resp
+}
+
+// isActive returns whether the algorithm is still working its parent order
+func (a *ExecutionAlgorithm) isActive() bool {
+	return a.Status == AlgoStatusRunning || a.Status == AlgoStatusPaused
+}
+
+// canSubmit returns whether the amount can be submitted as a child order
+func canSubmit(exch exchange.IBotExchange, a *executionAlgorithm, amount decimal.Decimal, oType order.Type) bool {
+	conformed := a.limits.ConformToDecimalAmount(amount).InexactFloat64()
+	return conformed > 0 && !errors.Is(exch.CheckOrderExecutionLimits(a.Asset, a.Pair, a.Price, conformed, oType), order.ErrAmountBelowMin)
+}
+
+// getArrivalPrice returns the last traded price when the algorithm starts,
+// which slippage is measured against
+func getArrivalPrice(ctx context.Context, exch exchange.IBotExchange, a *executionAlgorithm) float64 {
+	t, err := ticker.GetTicker(exch.GetName(), a.Pair, a.Asset)
+	if err != nil {
+		t, err = exch.UpdateTicker(ctx, a.Pair, a.Asset)
+	}
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Execution algorithm %v unable to get arrival price, slippage will not be calculated: %v", a.ID, err)
+		return 0
+	}
+	if t.Last > 0 {
+		return t.Last
+	}
+	return (t.Bid + t.Ask) / 2
+}
+
+// vwapSchedule builds the VWAP schedule from the candle volume traded at the
+// same time of day over the previous profile days
+func vwapSchedule(ctx context.Context, exch exchange.IBotExchange, a *executionAlgorithm) ([]float64, error) {
+	interval := a.Interval.Duration()
+	end := a.StartTime.Truncate(interval)
+	start := end.Add(-time.Duration(a.ProfileDays) * kline.OneDay.Duration())
+	candles, err := exch.GetHistoricCandlesExtended(ctx, a.Pair, a.Asset, a.Interval, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("VWAP volume profile: %w", err)
+	}
+	return cumulativeSchedule(volumeProfile(candles.Candles, a.StartTime, interval, int(a.Duration/interval)))
+}
+
+// volumeProfile sums candle volume into slices by their time of day offset
+// from the start time
+func volumeProfile(candles []kline.Candle, start time.Time, interval time.Duration, sliceCount int) []float64 {
+	day := kline.OneDay.Duration()
+	weights := make([]float64, sliceCount)
+	for i := range candles {
+		offset := candles[i].Time.Sub(start) % day
+		if offset < 0 {
+			offset += day
+		}
+		if s := int(offset / interval); s < sliceCount {
+			weights[s] += candles[i].Volume
+		}
+	}
+	return weights
+}
+
+// cumulativeSchedule converts slice weights into the cumulative fraction of
+// the amount due by the end of each slice
+func cumulativeSchedule(weights []float64) ([]float64, error) {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return nil, errAlgoNoVolumeProfile
+	}
+	schedule := make([]float64, len(weights))
+	var cumulative float64
+	for i, w := range weights {
+		cumulative += w
+		schedule[i] = cumulative / total
+	}
+	schedule[len(schedule)-1] = 1
+	return schedule, nil
+}
+
+// validate checks the execution algorithm request parameters
+func (r *ExecutionAlgorithmRequest) validate() error {
+	if r == nil {
+		return errNilExecutionAlgorithmRequest
+	}
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair.IsEmpty() {
+		return errCurrencyPairUnset
+	}
+	if !r.Asset.IsValid() {
+		return errAssetTypeUnset
+	}
+	if !order.IsValidOrderSubmissionSide(r.Side) {
+		return fmt.Errorf("%w %v", order.ErrSideIsInvalid, r.Side)
+	}
+	if r.Amount <= 0 || math.IsNaN(r.Amount) {
+		return errAlgoAmountInvalid
+	}
+	if r.Price < 0 {
+		return errAlgoPriceInvalid
+	}
+	switch r.Type {
+	case AlgoTWAP:
+		if r.Duration <= 0 {
+			return errAlgoDurationInvalid
+		}
+		if r.Slices <= 0 {
+			return errAlgoSlicesInvalid
+		}
+	case AlgoVWAP:
+		if r.Duration <= 0 {
+			return errAlgoDurationInvalid
+		}
+		if r.Duration > kline.OneDay.Duration() {
+			return errAlgoVWAPDurationTooLong
+		}
+		if r.Interval <= 0 || r.Interval.Duration() > r.Duration {
+			return errAlgoIntervalInvalid
+		}
+		if r.ProfileDays <= 0 || r.ProfileDays > maxVWAPProfileDays {
+			return fmt.Errorf("%w %d, must be between 1 and %d", errAlgoProfileDaysInvalid, r.ProfileDays, maxVWAPProfileDays)
+		}
+	case AlgoIceberg:
+		if r.Price <= 0 {
+			return errAlgoIcebergPriceRequired
+		}
+		if r.ClipSize <= 0 || r.ClipSize > r.Amount {
+			return errAlgoClipSizeInvalid
+		}
+		if r.ClipVariance < 0 || r.ClipVariance >= 1 {
+			return errAlgoClipVarianceInvalid
+		}
+	case AlgoPOV:
+		if r.ParticipationRate <= 0 || r.ParticipationRate > 1 {
+			return errAlgoParticipationRateInvalid
+		}
+	default:
+		return fmt.Errorf("%w %q", errAlgoTypeInvalid, r.Type)
+	}
+	return nil
+}
@@ -0,0 +1,470 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// executionAlgoExchange overrides the network calls used by the execution
+// algorithm manager
+type executionAlgoExchange struct {
+	exchange.IBotExchange
+	candles []kline.Candle
+}
+
+func (e *executionAlgoExchange) GetHistoricCandlesExtended(_ context.Context, p currency.Pair, a asset.Item, i kline.Interval, _, _ time.Time) (*kline.Item, error) {
+	return &kline.Item{Exchange: e.GetName(), Pair: p, Asset: a, Interval: i, Candles: e.candles}, nil
+}
+
+func (e *executionAlgoExchange) UpdateTicker(context.Context, currency.Pair, asset.Item) (*ticker.Price, error) {
+	return &ticker.Price{Last: 100}, nil
+}
+
+// blockingOrderSubmitter holds child order submissions until released to
+// simulate a slow exchange
+type blockingOrderSubmitter struct {
+	*fakeOrderSubmitter
+	submitting chan struct{}
+	release    chan struct{}
+}
+
+func (s *blockingOrderSubmitter) Submit(ctx context.Context, sub *order.Submit) (*OrderSubmitResponse, error) {
+	s.submitting <- struct{}{}
+	<-s.release
+	return s.fakeOrderSubmitter.Submit(ctx, sub)
+}
+
+func (s *fakeOrderSubmitter) fill(id string, executed, price float64) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	d := s.orders[id]
+	d.ExecutedAmount = executed
+	d.AverageExecutedPrice = price
+	d.Status = order.PartiallyFilled
+	if executed >= d.Amount {
+		d.Status = order.Filled
+	}
+}
+
+func setupExecutionAlgorithmManagerTest(t *testing.T) (*ExecutionAlgorithmManager, *fakeOrderSubmitter, *executionAlgoExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	err = b.LoadLimits([]order.MinMaxLevel{{
+		Pair:                    currency.NewBTCUSDT(),
+		Asset:                   asset.Spot,
+		MinimumBaseAmount:       0.1,
+		AmountStepIncrementSize: 0.01,
+	}})
+	require.NoError(t, err)
+	fe := &executionAlgoExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fe))
+
+	om := &fakeOrderSubmitter{orders: make(map[string]*order.Detail)}
+	m, err := SetupExecutionAlgorithmManager(em, om, &config.ExecutionAlgorithmManager{CheckInterval: time.Hour})
+	require.NoError(t, err)
+	m.started = 1
+	return m, om, fe
+}
+
+func executionAlgoTestRequest(t AlgoType) *ExecutionAlgorithmRequest {
+	return &ExecutionAlgorithmRequest{
+		Type:     t,
+		Exchange: fakeExchangeName,
+		Pair:     currency.NewBTCUSDT(),
+		Asset:    asset.Spot,
+		Side:     order.Buy,
+		Amount:   1,
+	}
+}
+
+func TestSetupExecutionAlgorithmManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupExecutionAlgorithmManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupExecutionAlgorithmManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager)
+
+	_, err = SetupExecutionAlgorithmManager(NewExchangeManager(), &fakeOrderSubmitter{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	m, err := SetupExecutionAlgorithmManager(NewExchangeManager(), &fakeOrderSubmitter{}, &config.ExecutionAlgorithmManager{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultExecutionAlgorithmCheckInterval, m.checkInterval)
+}
+
+func TestExecutionAlgorithmManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ExecutionAlgorithmManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+	_, err := m.Add(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.GetAlgorithms(false)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = SetupExecutionAlgorithmManager(NewExchangeManager(), &fakeOrderSubmitter{}, &config.ExecutionAlgorithmManager{})
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.GetAlgorithm(uuid.Nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	_, err = m.GetAlgorithm(uuid.Nil)
+	assert.ErrorIs(t, err, errAlgoNotFound)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+}
+
+func TestExecutionAlgorithmRequestValidate(t *testing.T) {
+	t.Parallel()
+	var r *ExecutionAlgorithmRequest
+	assert.ErrorIs(t, r.validate(), errNilExecutionAlgorithmRequest)
+
+	r = &ExecutionAlgorithmRequest{}
+	assert.ErrorIs(t, r.validate(), errExchangeNameUnset)
+	r = executionAlgoTestRequest("")
+	r.Amount = 0
+	assert.ErrorIs(t, r.validate(), errAlgoAmountInvalid)
+	r.Amount = 1
+	r.Price = -1
+	assert.ErrorIs(t, r.validate(), errAlgoPriceInvalid)
+	r.Price = 0
+	assert.ErrorIs(t, r.validate(), errAlgoTypeInvalid)
+
+	r.Type = AlgoTWAP
+	assert.ErrorIs(t, r.validate(), errAlgoDurationInvalid)
+	r.Duration = time.Hour
+	assert.ErrorIs(t, r.validate(), errAlgoSlicesInvalid)
+	r.Slices = 10
+	assert.NoError(t, r.validate())
+
+	r.Type = AlgoVWAP
+	assert.ErrorIs(t, r.validate(), errAlgoIntervalInvalid)
+	r.Interval = kline.OneDay
+	assert.ErrorIs(t, r.validate(), errAlgoIntervalInvalid, "interval cannot exceed the duration")
+	r.Interval = kline.FifteenMin
+	assert.ErrorIs(t, r.validate(), errAlgoProfileDaysInvalid)
+	r.ProfileDays = 1
+	assert.NoError(t, r.validate())
+	r.Duration = kline.OneDay.Duration() * 2
+	assert.ErrorIs(t, r.validate(), errAlgoVWAPDurationTooLong)
+
+	r.Type = AlgoIceberg
+	assert.ErrorIs(t, r.validate(), errAlgoIcebergPriceRequired)
+	r.Price = 100
+	assert.ErrorIs(t, r.validate(), errAlgoClipSizeInvalid)
+	r.ClipSize = 0.2
+	r.ClipVariance = 1
+	assert.ErrorIs(t, r.validate(), errAlgoClipVarianceInvalid)
+	r.ClipVariance = 0.5
+	assert.NoError(t, r.validate())
+
+	r.Type = AlgoPOV
+	assert.ErrorIs(t, r.validate(), errAlgoParticipationRateInvalid)
+	r.ParticipationRate = 0.1
+	assert.NoError(t, r.validate())
+}
+
+func TestTWAP(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupExecutionAlgorithmManagerTest(t)
+	req := executionAlgoTestRequest(AlgoTWAP)
+	req.Duration = 4 * time.Minute
+	req.Slices = 4
+	a, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, a.ArrivalPrice)
+	require.Len(t, om.submitted, 1, "first slice must be submitted immediately")
+	assert.Equal(t, order.Market, om.submitted[0].Type)
+	assert.Equal(t, 0.25, om.submitted[0].Amount)
+
+	m.process(a.StartTime.Add(30 * time.Second))
+	require.Len(t, om.submitted, 1, "no slice is due until the next interval")
+
+	om.fill(a.ChildOrderIDs[0], 0.25, 100)
+	m.process(a.StartTime.Add(time.Minute))
+	require.Len(t, om.submitted, 2)
+	assert.Equal(t, 0.25, om.submitted[1].Amount)
+
+	m.process(a.StartTime.Add(10 * time.Minute))
+	require.Len(t, om.submitted, 3)
+	assert.Equal(t, 0.5, om.submitted[2].Amount, "final slice must catch up to the full amount")
+
+	a, err = m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	om.fill(a.ChildOrderIDs[1], 0.25, 102)
+	om.fill(a.ChildOrderIDs[2], 0.5, 103)
+	m.process(a.StartTime.Add(11 * time.Minute))
+	a, err = m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, AlgoStatusCompleted, a.Status)
+	assert.Equal(t, 1.0, a.FilledAmount)
+	assert.InDelta(t, 102.0, a.AveragePrice, 1e-9)
+	assert.InDelta(t, 200.0, a.Slippage, 1e-6, "buying above arrival must be positive slippage")
+}
+
+func TestVWAP(t *testing.T) {
+	t.Parallel()
+	m, om, fe := setupExecutionAlgorithmManagerTest(t)
+	req := executionAlgoTestRequest(AlgoVWAP)
+	req.Duration = time.Hour
+	req.Interval = kline.FifteenMin
+	_, err := m.Add(t.Context(), req)
+	assert.ErrorIs(t, err, errAlgoNoVolumeProfile)
+
+	now := time.Now()
+	yesterday := now.Add(-kline.OneDay.Duration())
+	fe.candles = []kline.Candle{
+		{Time: yesterday.Add(time.Minute), Volume: 3},
+		{Time: yesterday.Add(16 * time.Minute), Volume: 1},
+		{Time: yesterday.Add(50 * time.Minute), Volume: 4},
+		{Time: yesterday.Add(2 * time.Hour), Volume: 100},
+	}
+	a, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, 0.37, om.submitted[0].Amount, "first slice must be weighted by its volume and conformed to the step size")
+
+	m.process(a.StartTime.Add(20 * time.Minute))
+	require.Len(t, om.submitted, 2)
+	assert.Equal(t, 0.13, om.submitted[1].Amount)
+
+	m.process(a.StartTime.Add(35 * time.Minute))
+	require.Len(t, om.submitted, 2, "slice without volume must not submit")
+}
+
+func TestVolumeProfile(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	candles := []kline.Candle{
+		{Time: start.Add(-24 * time.Hour), Volume: 1},
+		{Time: start.Add(-47 * time.Hour), Volume: 2},
+		{Time: start.Add(-23 * time.Hour), Volume: 5},
+		{Time: start.Add(-25 * time.Hour), Volume: 100},
+	}
+	assert.Equal(t, []float64{1, 7}, volumeProfile(candles, start, time.Hour, 2))
+
+	s, err := cumulativeSchedule([]float64{1, 0, 3})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.25, 0.25, 1}, s)
+	_, err = cumulativeSchedule([]float64{0, 0})
+	assert.ErrorIs(t, err, errAlgoNoVolumeProfile)
+}
+
+func TestIceberg(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupExecutionAlgorithmManagerTest(t)
+	req := executionAlgoTestRequest(AlgoIceberg)
+	req.Side = order.Sell
+	req.Price = 101
+	req.ClipSize = 0.4
+	a, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, order.Limit, om.submitted[0].Type)
+	assert.Equal(t, 0.4, om.submitted[0].Amount)
+
+	m.process(time.Now())
+	require.Len(t, om.submitted, 1, "only one clip can rest at a time")
+
+	for i, amount := range []float64{0.4, 0.2} {
+		a, err = m.GetAlgorithm(a.ID)
+		require.NoError(t, err)
+		om.fill(a.ChildOrderIDs[i], om.submitted[i].Amount, 101)
+		m.process(time.Now())
+		require.Len(t, om.submitted, i+2)
+		assert.Equal(t, amount, om.submitted[i+1].Amount)
+	}
+	a, err = m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	om.fill(a.ChildOrderIDs[2], 0.2, 101)
+	m.process(time.Now())
+	a, err = m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, AlgoStatusCompleted, a.Status)
+	assert.InDelta(t, -100.0, a.Slippage, 1e-6, "selling above arrival must be negative slippage")
+
+	ice := &executionAlgorithm{ExecutionAlgorithm: ExecutionAlgorithm{ExecutionAlgorithmRequest: ExecutionAlgorithmRequest{
+		Type:         AlgoIceberg,
+		ClipSize:     1,
+		ClipVariance: 0.5,
+	}}}
+	for range 100 {
+		clip := ice.target(time.Now(), 0)
+		require.GreaterOrEqual(t, clip, 0.5)
+		require.LessOrEqual(t, clip, 1.5)
+	}
+}
+
+func TestPOV(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupExecutionAlgorithmManagerTest(t)
+	req := executionAlgoTestRequest(AlgoPOV)
+	req.ParticipationRate = 0.1
+	a, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Empty(t, om.submitted, "no volume has traded yet")
+
+	now := time.Now()
+	require.NoError(t, m.websocketDataHandler(fakeExchangeName, []trade.Data{
+		{Exchange: fakeExchangeName, CurrencyPair: currency.NewBTCUSDT(), AssetType: asset.Spot, Amount: 3, Timestamp: now},
+		{Exchange: fakeExchangeName, CurrencyPair: currency.NewBTCUSD(), AssetType: asset.Spot, Amount: 50, Timestamp: now},
+		{Exchange: fakeExchangeName, CurrencyPair: currency.NewBTCUSDT(), AssetType: asset.Spot, Amount: 50, Timestamp: a.StartTime.Add(-time.Minute)},
+	}))
+	require.NoError(t, m.websocketDataHandler(fakeExchangeName, trade.Data{
+		Exchange: fakeExchangeName, CurrencyPair: currency.NewBTCUSDT(), AssetType: asset.Spot, Amount: 2, Timestamp: now,
+	}))
+	m.process(now)
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, 0.55, om.submitted[0].Amount, "only matching trades since the start must count towards volume")
+
+	a, err = m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, a.MarketVolume)
+
+	// The child fill is published on the trade stream along with volume from
+	// other traders, and must not count towards the volume participated in
+	om.fill(a.ChildOrderIDs[0], 0.55, 100)
+	require.NoError(t, m.websocketDataHandler(fakeExchangeName, []trade.Data{
+		{Exchange: fakeExchangeName, CurrencyPair: currency.NewBTCUSDT(), AssetType: asset.Spot, Amount: 0.55, Timestamp: now},
+		{Exchange: fakeExchangeName, CurrencyPair: currency.NewBTCUSDT(), AssetType: asset.Spot, Amount: 2.45, Timestamp: now},
+	}))
+	m.process(now)
+	require.Len(t, om.submitted, 2)
+	assert.InDelta(t, 0.27, om.submitted[1].Amount, 1e-9, "fills must make up the participation rate of the total volume")
+}
+
+func TestExecutionAlgorithmPauseResumeCancel(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupExecutionAlgorithmManagerTest(t)
+	assert.ErrorIs(t, m.Pause(t.Context(), uuid.Nil), errAlgoNotFound)
+
+	req := executionAlgoTestRequest(AlgoTWAP)
+	req.Duration = 4 * time.Minute
+	req.Slices = 4
+	a, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, om.submitted, 1)
+
+	assert.ErrorIs(t, m.Resume(a.ID), errAlgoNotPaused)
+	om.fill(a.ChildOrderIDs[0], 0.1, 100)
+	require.NoError(t, m.Pause(t.Context(), a.ID))
+	assert.Equal(t, a.ChildOrderIDs, om.cancelled, "open child orders must be cancelled on pause")
+	assert.ErrorIs(t, m.Pause(t.Context(), a.ID), errAlgoNotRunning)
+
+	m.process(a.StartTime.Add(time.Hour))
+	require.Len(t, om.submitted, 1, "paused algorithms must not submit")
+
+	require.NoError(t, m.Resume(a.ID))
+	resumed, err := m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	assert.True(t, resumed.StartTime.After(a.StartTime), "schedule must be shifted by the pause")
+	m.process(resumed.StartTime)
+	resumed, err = m.GetAlgorithm(a.ID)
+	require.NoError(t, err)
+	require.Len(t, om.submitted, 2)
+	assert.Equal(t, 0.15, om.submitted[1].Amount, "unfilled amount of cancelled child must be worked again")
+	assert.Equal(t, 0.1, resumed.FilledAmount)
+
+	require.NoError(t, m.Cancel(t.Context(), a.ID))
+	assert.Contains(t, om.cancelled, resumed.ChildOrderIDs[1])
+	assert.ErrorIs(t, m.Cancel(t.Context(), a.ID), errAlgoInactive)
+
+	algos, err := m.GetAlgorithms(false)
+	require.NoError(t, err)
+	assert.Empty(t, algos)
+	algos, err = m.GetAlgorithms(true)
+	require.NoError(t, err)
+	require.Len(t, algos, 1)
+	assert.Equal(t, AlgoStatusCancelled, algos[0].Status)
+}
+
+func TestExecutionAlgorithmLimits(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupExecutionAlgorithmManagerTest(t)
+	req := executionAlgoTestRequest(AlgoTWAP)
+	req.Duration = time.Minute
+	req.Slices = 1
+	req.Amount = 0.05
+	a, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Empty(t, om.submitted)
+	assert.Equal(t, AlgoStatusCompleted, a.Status)
+	assert.Equal(t, errAlgoRemainingBelowMinimumSize.Error(), a.Error)
+
+	req = executionAlgoTestRequest(AlgoTWAP)
+	req.Duration = 10 * time.Minute
+	req.Slices = 20
+	a, err = m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Empty(t, om.submitted, "slice below the minimum amount must be carried over")
+	m.process(a.StartTime.Add(time.Minute))
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, 0.15, om.submitted[0].Amount)
+
+	om.submitErr = errors.New("exchange offline")
+	_, err = m.Add(t.Context(), executionAlgoTestRequest(AlgoIceberg))
+	assert.ErrorIs(t, err, errAlgoIcebergPriceRequired)
+	req = executionAlgoTestRequest(AlgoTWAP)
+	req.Duration = time.Minute
+	req.Slices = 1
+	_, err = m.Add(t.Context(), req)
+	assert.ErrorContains(t, err, om.submitErr.Error())
+}
+
+func TestExecutionAlgorithmSubmitUnlocked(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupExecutionAlgorithmManagerTest(t)
+	bs := &blockingOrderSubmitter{fakeOrderSubmitter: om, submitting: make(chan struct{}), release: make(chan struct{})}
+	m.orderManager = bs
+	req := executionAlgoTestRequest(AlgoPOV)
+	req.ParticipationRate = 1
+	added := make(chan error)
+	go func() {
+		_, err := m.Add(t.Context(), req)
+		added <- err
+	}()
+	<-bs.submitting
+
+	algos, err := m.GetAlgorithms(false)
+	require.NoError(t, err, "GetAlgorithms must not block while a child order is submitted")
+	require.Len(t, algos, 1, "GetAlgorithms must return the algorithm")
+	id := algos[0].ID
+	m.processTrades(trade.Data{Exchange: fakeExchangeName, CurrencyPair: req.Pair, AssetType: req.Asset, Amount: 2, Timestamp: time.Now()})
+	a, err := m.GetAlgorithm(id)
+	require.NoError(t, err, "GetAlgorithm must not error")
+	assert.Equal(t, 2.0, a.MarketVolume, "Trades should be processed while a child order is submitted")
+	assert.ErrorIs(t, m.Pause(t.Context(), id), errAlgoBusy, "Pause should error while a child order is submitted")
+	assert.ErrorIs(t, m.Cancel(t.Context(), id), errAlgoBusy, "Cancel should error while a child order is submitted")
+	m.process(time.Now())
+
+	close(bs.release)
+	require.NoError(t, <-added, "Add must not error")
+	a, err = m.GetAlgorithm(id)
+	require.NoError(t, err, "GetAlgorithm must not error")
+	assert.Len(t, a.ChildOrderIDs, 1, "Only one child order should be submitted while the algorithm is busy")
+	assert.Equal(t, 1.0, a.SubmittedAmount, "SubmittedAmount should be recorded after the child order is submitted")
+	require.NoError(t, m.Cancel(t.Context(), id), "Cancel must not error once the child order is submitted")
+}
@@ -0,0 +1,174 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ExecutionAlgorithmManagerName is an exported subsystem name
+const ExecutionAlgorithmManagerName = "execution_algorithms"
+
+const (
+	defaultExecutionAlgorithmCheckInterval = time.Second
+	defaultVWAPProfileDays                 = 1
+	maxVWAPProfileDays                     = 30
+)
+
+// Execution algorithm types which can work a parent order
+const (
+	// AlgoTWAP slices the parent order into equal child orders spread evenly
+	// over the duration
+	AlgoTWAP AlgoType = "TWAP"
+	// AlgoVWAP slices the parent order over the duration weighted by the
+	// historic candle volume profile for the same time of day
+	AlgoVWAP AlgoType = "VWAP"
+	// AlgoIceberg rests one randomised clip of the parent order at a time at
+	// the limit price
+	AlgoIceberg AlgoType = "ICEBERG"
+	// AlgoPOV participates at a fixed rate of the trade volume published by
+	// the exchange trade stream
+	AlgoPOV AlgoType = "POV"
+)
+
+// Execution algorithm statuses
+const (
+	// AlgoStatusRunning is an algorithm which is actively submitting child
+	// orders
+	AlgoStatusRunning AlgoStatus = "RUNNING"
+	// AlgoStatusPaused is an algorithm whose open child orders have been
+	// cancelled and which will not submit more until resumed
+	AlgoStatusPaused AlgoStatus = "PAUSED"
+	// AlgoStatusCompleted is an algorithm which has worked its parent order
+	AlgoStatusCompleted AlgoStatus = "COMPLETED"
+	// AlgoStatusCancelled is an algorithm which was cancelled before its
+	// parent order was worked
+	AlgoStatusCancelled AlgoStatus = "CANCELLED"
+	// AlgoStatusFailed is an algorithm which could not submit a child order
+	AlgoStatusFailed AlgoStatus = "FAILED"
+)
+
+var (
+	errNilExecutionAlgorithmRequest  = errors.New("nil execution algorithm request received")
+	errAlgoTypeInvalid               = errors.New("invalid execution algorithm type")
+	errAlgoNotFound                  = errors.New("execution algorithm not found")
+	errAlgoAmountInvalid             = errors.New("execution algorithm amount must be greater than zero")
+	errAlgoPriceInvalid              = errors.New("execution algorithm limit price cannot be negative")
+	errAlgoDurationInvalid           = errors.New("execution algorithm duration must be greater than zero")
+	errAlgoSlicesInvalid             = errors.New("execution algorithm slices must be greater than zero")
+	errAlgoIntervalInvalid           = errors.New("execution algorithm interval must be greater than zero and no larger than the duration")
+	errAlgoVWAPDurationTooLong       = errors.New("VWAP duration cannot exceed one day")
+	errAlgoProfileDaysInvalid        = errors.New("VWAP profile days out of range")
+	errAlgoIcebergPriceRequired      = errors.New("iceberg orders require a limit price")
+	errAlgoClipSizeInvalid           = errors.New("iceberg clip size must be greater than zero and no larger than the amount")
+	errAlgoClipVarianceInvalid       = errors.New("iceberg clip variance must be between 0 and 1")
+	errAlgoParticipationRateInvalid  = errors.New("POV participation rate must be greater than 0 and no larger than 1")
+	errAlgoNotRunning                = errors.New("execution algorithm is not running")
+	errAlgoNotPaused                 = errors.New("execution algorithm is not paused")
+	errAlgoInactive                  = errors.New("execution algorithm is no longer active")
+	errAlgoBusy                      = errors.New("execution algorithm child order is being submitted")
+	errAlgoNoVolumeProfile           = errors.New("no candle volume returned to build a VWAP profile")
+	errAlgoRemainingBelowMinimumSize = errors.New("remaining amount is below the exchange minimum order size")
+)
+
+// AlgoType defines which execution algorithm works a parent order
+type AlgoType string
+
+// AlgoStatus defines the state of an execution algorithm
+type AlgoStatus string
+
+// ExecutionAlgorithmManager slices large parent orders into child orders over
+// time using TWAP, VWAP, iceberg and POV execution algorithms and tracks their
+// progress against the arrival price
+type ExecutionAlgorithmManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	m               sync.RWMutex
+	algorithms      map[uuid.UUID]*executionAlgorithm
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+	checkInterval   time.Duration
+	verbose         bool
+}
+
+// ExecutionAlgorithmRequest holds the parameters of a parent order and the
+// algorithm used to work it. A zero Price submits market child orders
+type ExecutionAlgorithmRequest struct {
+	Type     AlgoType
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Side     order.Side
+	Amount   float64
+	Price    float64
+	// Duration is the time over which TWAP and VWAP orders are worked
+	Duration time.Duration
+	// Slices is the number of TWAP child orders
+	Slices int
+	// Interval is the VWAP slice and candle interval
+	Interval kline.Interval
+	// ProfileDays is the number of previous days of candles used to build
+	// the VWAP volume profile
+	ProfileDays int
+	// ClipSize is the iceberg visible amount
+	ClipSize float64
+	// ClipVariance randomises each iceberg clip by up to this fraction
+	ClipVariance float64
+	// ParticipationRate is the POV fraction of market volume to trade
+	ParticipationRate float64
+}
+
+// ExecutionAlgorithm holds the progress of a parent order worked by an
+// execution algorithm
+type ExecutionAlgorithm struct {
+	ID uuid.UUID
+	ExecutionAlgorithmRequest
+	Status          AlgoStatus
+	ArrivalPrice    float64
+	SubmittedAmount float64
+	FilledAmount    float64
+	AveragePrice    float64
+	// Slippage is the cost in basis points of the average price versus the
+	// arrival price, positive values are worse than arrival
+	Slippage float64
+	// MarketVolume is the POV volume traded on the exchange since the start,
+	// including the algorithm's own fills
+	MarketVolume  float64
+	ChildOrderIDs []string
+	Error         string
+	StartTime     time.Time
+	EndTime       time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// executionAlgorithm guards an execution algorithm and its internal schedule
+// while child orders are worked
+type executionAlgorithm struct {
+	m sync.Mutex
+	// busy is set while a child order is submitted without holding the lock
+	// so the algorithm is not stepped, paused or cancelled at the same time
+	busy bool
+	ExecutionAlgorithm
+	// schedule holds the cumulative fraction of the amount due by the end of
+	// each TWAP or VWAP slice
+	schedule []float64
+	children map[string]*algoChildOrder
+	limits   *order.MinMaxLevel
+	pausedAt time.Time
+}
+
+// algoChildOrder tracks the fills of a child order
+type algoChildOrder struct {
+	amount   float64
+	executed float64
+	price    float64
+	done     bool
+}
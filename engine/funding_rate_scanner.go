@@ -0,0 +1,503 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fundingopportunity"
+	dbfundingrate "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupFundingRateScanner creates a funding rate scanner subsystem
+func SetupFundingRateScanner(em iExchangeManager, cfg *config.FundingRateScanner) (*FundingRateScanner, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w FundingRateScanner", errNilConfig)
+	}
+	if cfg.DisableCrossExchange && cfg.DisableSpotPerpetual {
+		return nil, errFundingModesDisabled
+	}
+	if cfg.MinimumNetRate < 0 {
+		return nil, fmt.Errorf("%w: %v", errFundingNetRateInvalid, cfg.MinimumNetRate)
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultFundingRateCheckInterval
+	}
+	if cfg.HoldingPeriod <= 0 {
+		cfg.HoldingPeriod = defaultFundingHoldingPeriod
+	}
+	return &FundingRateScanner{
+		shutdown:         make(chan struct{}),
+		exchangeManager:  em,
+		checkInterval:    cfg.CheckInterval,
+		holdingPeriod:    cfg.HoldingPeriod,
+		minimumNetRate:   cfg.MinimumNetRate,
+		crossExchange:    !cfg.DisableCrossExchange,
+		spotPerpetual:    !cfg.DisableSpotPerpetual,
+		verbose:          cfg.Verbose,
+		contracts:        make(map[key.ExchangePairAsset]*futures.Contract),
+		contractsUpdated: make(map[key.ExchangeAsset]time.Time),
+		fees:             make(map[key.ExchangePairAsset]float64),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *FundingRateScanner) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *FundingRateScanner) Start() error {
+	if m == nil {
+		return fmt.Errorf("funding rate scanner %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("funding rate scanner %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.ExchangeSys, "Funding rate scanner starting...")
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *FundingRateScanner) Stop() error {
+	if m == nil {
+		return fmt.Errorf("funding rate scanner %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("funding rate scanner %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.ExchangeSys, "Funding rate scanner shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.ExchangeSys, "Funding rate scanner shutdown.")
+	return nil
+}
+
+// GetScan returns the funding rates and ranked opportunities of the most
+// recent scan. Rates are ordered by annualised rate and opportunities by net
+// rate, highest first
+func (m *FundingRateScanner) GetScan() (*FundingRateScan, error) {
+	if m == nil {
+		return nil, fmt.Errorf("funding rate scanner %w", ErrNilSubsystem)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	if m.lastScan.IsZero() {
+		return nil, errFundingRateScanNotStarted
+	}
+	return &FundingRateScan{
+		Rates:         slices.Clone(m.rates),
+		Opportunities: slices.Clone(m.opportunities),
+		Time:          m.lastScan,
+	}, nil
+}
+
+// run scans funding rates on start and then every check interval
+func (m *FundingRateScanner) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	m.scan(context.TODO())
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.scan(context.TODO())
+		}
+	}
+}
+
+// scan collects the latest funding rates from every enabled exchange, ranks
+// the opportunities between them and stores both. The contract and fee caches
+// are only accessed by the scanning routine
+func (m *FundingRateScanner) scan(ctx context.Context) {
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Funding rate scanner unable to get exchanges: %v", err)
+		return
+	}
+	enabled := make(map[string]exchange.IBotExchange, len(exchanges))
+	var rates []PerpetualFundingRate
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		enabled[exchanges[i].GetName()] = exchanges[i]
+		rates = append(rates, m.collectRates(ctx, exchanges[i])...)
+	}
+	slices.SortStableFunc(rates, func(a, b PerpetualFundingRate) int {
+		return cmp.Compare(b.AnnualisedRate, a.AnnualisedRate)
+	})
+	now := time.Now()
+	var opportunities []FundingOpportunity
+	if m.crossExchange {
+		opportunities = append(opportunities, m.crossExchangeOpportunities(rates, now)...)
+	}
+	if m.spotPerpetual {
+		opportunities = append(opportunities, m.spotPerpetualOpportunities(ctx, enabled, rates, now)...)
+	}
+	slices.SortStableFunc(opportunities, func(a, b FundingOpportunity) int {
+		return cmp.Compare(b.NetRate, a.NetRate)
+	})
+
+	m.m.Lock()
+	m.rates = rates
+	m.opportunities = opportunities
+	m.lastScan = now
+	m.m.Unlock()
+
+	if m.verbose {
+		log.Debugf(log.ExchangeSys, "Funding rate scanner collected %d funding rates and ranked %d opportunities", len(rates), len(opportunities))
+	}
+	m.store(rates, opportunities)
+}
+
+// collectRates returns the latest funding rates of the enabled perpetual
+// contracts of an exchange
+func (m *FundingRateScanner) collectRates(ctx context.Context, exch exchange.IBotExchange) []PerpetualFundingRate {
+	feat := exch.GetSupportedFeatures()
+	if !feat.FuturesCapabilities.FundingRates {
+		return nil
+	}
+	exchName := exch.GetName()
+	var rates []PerpetualFundingRate
+	for _, a := range exch.GetAssetTypes(true) {
+		if !a.IsFutures() {
+			continue
+		}
+		m.updateContracts(ctx, exch, a)
+		latest, err := m.getLatestRates(ctx, exch, a, feat.FuturesCapabilities.FundingRateBatching[a], feat.RESTCapabilities.PredictedFundingRate)
+		if err != nil {
+			m.logRateError(exchName, a, err)
+			continue
+		}
+		for i := range latest {
+			rate, err := m.convertRate(ctx, exch, &latest[i])
+			if err != nil {
+				log.Errorf(log.ExchangeSys, "Funding rate scanner unable to convert %s %s %s funding rate: %v", exchName, a, latest[i].Pair, err)
+				continue
+			}
+			rates = append(rates, *rate)
+		}
+	}
+	return rates
+}
+
+// getLatestRates returns the latest funding rates of an asset. Exchanges which
+// do not batch funding rates are requested for each enabled perpetual pair
+func (m *FundingRateScanner) getLatestRates(ctx context.Context, exch exchange.IBotExchange, a asset.Item, batched, predicted bool) ([]fundingrate.LatestRateResponse, error) {
+	if batched {
+		return exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{
+			Asset:                a,
+			IncludePredictedRate: predicted,
+		})
+	}
+	pairs, err := exch.GetEnabledPairs(a)
+	if err != nil {
+		return nil, err
+	}
+	var resp []fundingrate.LatestRateResponse
+	for i := range pairs {
+		isPerp, err := exch.IsPerpetualFutureCurrency(a, pairs[i])
+		if err != nil {
+			return nil, err
+		}
+		if !isPerp {
+			continue
+		}
+		latest, err := exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{
+			Asset:                a,
+			Pair:                 pairs[i],
+			IncludePredictedRate: predicted,
+		})
+		if err != nil {
+			m.logRateError(exch.GetName(), a, fmt.Errorf("%v: %w", pairs[i], err))
+			continue
+		}
+		resp = append(resp, latest...)
+	}
+	return resp, nil
+}
+
+// updateContracts refreshes the futures contract details of an exchange asset
+// when they have not been retrieved within the refresh interval
+func (m *FundingRateScanner) updateContracts(ctx context.Context, exch exchange.IBotExchange, a asset.Item) {
+	k := key.ExchangeAsset{Exchange: exch.GetName(), Asset: a}
+	if time.Since(m.contractsUpdated[k]) < fundingContractRefreshInterval {
+		return
+	}
+	m.contractsUpdated[k] = time.Now()
+	contracts, err := exch.GetFuturesContractDetails(ctx, a)
+	if err != nil {
+		m.logRateError(k.Exchange, a, err)
+		return
+	}
+	for i := range contracts {
+		m.contracts[key.ExchangePairAsset{
+			Exchange: k.Exchange,
+			Base:     contracts[i].Name.Base.Item,
+			Quote:    contracts[i].Name.Quote.Item,
+			Asset:    a,
+		}] = &contracts[i]
+	}
+}
+
+// convertRate annualises an exchange funding rate and matches it to its
+// underlying using the exchange's futures contract details. Contracts without
+// details are matched on their own base and quote currencies
+func (m *FundingRateScanner) convertRate(ctx context.Context, exch exchange.IBotExchange, latest *fundingrate.LatestRateResponse) (*PerpetualFundingRate, error) {
+	k := key.ExchangePairAsset{
+		Exchange: exch.GetName(),
+		Base:     latest.Pair.Base.Item,
+		Quote:    latest.Pair.Quote.Item,
+		Asset:    latest.Asset,
+	}
+	fee, err := m.getTakerFee(ctx, exch, k, latest.Pair)
+	if err != nil {
+		return nil, err
+	}
+	interval := fundingInterval(latest)
+	rate := &PerpetualFundingRate{
+		Exchange:       k.Exchange,
+		Asset:          latest.Asset,
+		Pair:           latest.Pair,
+		Underlying:     latest.Pair,
+		Rate:           latest.LatestRate.Rate.InexactFloat64(),
+		PredictedRate:  latest.PredictedUpcomingRate.Rate.InexactFloat64(),
+		Interval:       interval,
+		TakerFee:       fee,
+		RateTime:       latest.LatestRate.Time,
+		TimeOfNextRate: latest.TimeOfNextRate,
+		TimeChecked:    latest.TimeChecked,
+	}
+	if contract, ok := m.contracts[k]; ok {
+		if !contract.Underlying.IsEmpty() {
+			rate.Underlying = contract.Underlying
+		}
+		rate.SettlementType = contract.SettlementType
+	}
+	rate.AnnualisedRate = annualiseFundingRate(rate.Rate, interval)
+	rate.AnnualisedPredictedRate = annualiseFundingRate(rate.PredictedRate, interval)
+	return rate, nil
+}
+
+// getTakerFee returns the cached taker fee rate of a pair
+func (m *FundingRateScanner) getTakerFee(ctx context.Context, exch exchange.IBotExchange, k key.ExchangePairAsset, pair currency.Pair) (float64, error) {
+	if fee, ok := m.fees[k]; ok {
+		return fee, nil
+	}
+	fee, err := getTakerFeeRate(ctx, exch, pair)
+	if err != nil {
+		return 0, err
+	}
+	m.fees[k] = fee
+	return fee, nil
+}
+
+// crossExchangeOpportunities ranks going long the perpetual contract with the
+// lower funding rate and short the identical contract with the higher rate on
+// another exchange
+func (m *FundingRateScanner) crossExchangeOpportunities(rates []PerpetualFundingRate, detectedAt time.Time) []FundingOpportunity {
+	var opportunities []FundingOpportunity
+	for i := range rates {
+		for j := range rates {
+			if rates[i].Exchange == rates[j].Exchange ||
+				rates[i].AnnualisedRate <= rates[j].AnnualisedRate ||
+				!matchingContracts(&rates[i], &rates[j]) {
+				continue
+			}
+			short, long := &rates[i], &rates[j]
+			opp := FundingOpportunity{
+				Type:       CrossExchangeFunding,
+				Underlying: short.Underlying,
+				Long:       fundingLeg(long.Exchange, long.Asset, long.Pair, long.AnnualisedRate, long.TakerFee),
+				Short:      fundingLeg(short.Exchange, short.Asset, short.Pair, short.AnnualisedRate, short.TakerFee),
+				GrossRate:  short.AnnualisedRate - long.AnnualisedRate,
+				FeeRate:    m.annualisedFeeRate(long.TakerFee, short.TakerFee),
+				DetectedAt: detectedAt,
+			}
+			opp.NetRate = opp.GrossRate - opp.FeeRate
+			if opp.NetRate <= 0 || opp.NetRate < m.minimumNetRate {
+				continue
+			}
+			opportunities = append(opportunities, opp)
+		}
+	}
+	return opportunities
+}
+
+// spotPerpetualOpportunities ranks going long spot and short a perpetual
+// contract with a positive funding rate on the same exchange
+func (m *FundingRateScanner) spotPerpetualOpportunities(ctx context.Context, exchanges map[string]exchange.IBotExchange, rates []PerpetualFundingRate, detectedAt time.Time) []FundingOpportunity {
+	var opportunities []FundingOpportunity
+	for i := range rates {
+		if rates[i].AnnualisedRate <= 0 {
+			continue
+		}
+		exch, ok := exchanges[rates[i].Exchange]
+		if !ok || !exch.GetAssetTypes(true).Contains(asset.Spot) {
+			continue
+		}
+		pairs, err := exch.GetEnabledPairs(asset.Spot)
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "Funding rate scanner unable to get %s spot pairs: %v", rates[i].Exchange, err)
+			continue
+		}
+		idx := slices.IndexFunc(pairs, rates[i].Underlying.Equal)
+		if idx == -1 {
+			continue
+		}
+		spotFee, err := m.getTakerFee(ctx, exch, key.ExchangePairAsset{
+			Exchange: rates[i].Exchange,
+			Base:     pairs[idx].Base.Item,
+			Quote:    pairs[idx].Quote.Item,
+			Asset:    asset.Spot,
+		}, pairs[idx])
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "Funding rate scanner unable to get %s %s spot fee: %v", rates[i].Exchange, pairs[idx], err)
+			continue
+		}
+		opp := FundingOpportunity{
+			Type:       SpotPerpetualFunding,
+			Underlying: rates[i].Underlying,
+			Long:       fundingLeg(rates[i].Exchange, asset.Spot, pairs[idx], 0, spotFee),
+			Short:      fundingLeg(rates[i].Exchange, rates[i].Asset, rates[i].Pair, rates[i].AnnualisedRate, rates[i].TakerFee),
+			GrossRate:  rates[i].AnnualisedRate,
+			FeeRate:    m.annualisedFeeRate(spotFee, rates[i].TakerFee),
+			DetectedAt: detectedAt,
+		}
+		opp.NetRate = opp.GrossRate - opp.FeeRate
+		if opp.NetRate <= 0 || opp.NetRate < m.minimumNetRate {
+			continue
+		}
+		opportunities = append(opportunities, opp)
+	}
+	return opportunities
+}
+
+// annualisedFeeRate returns the annualised percentage cost of opening and
+// closing both legs with taker orders over the holding period
+func (m *FundingRateScanner) annualisedFeeRate(longFee, shortFee float64) float64 {
+	return 2 * (longFee + shortFee) * float64(fundingYear) / float64(m.holdingPeriod) * 100
+}
+
+// store saves the collected funding rates and ranked opportunities to the
+// database when it is enabled
+func (m *FundingRateScanner) store(rates []PerpetualFundingRate, opportunities []FundingOpportunity) {
+	data := make([]dbfundingrate.Data, 0, len(rates))
+	for i := range rates {
+		if rates[i].RateTime.IsZero() {
+			continue
+		}
+		data = append(data, dbfundingrate.Data{
+			Exchange:  rates[i].Exchange,
+			Base:      rates[i].Pair.Base.String(),
+			Quote:     rates[i].Pair.Quote.String(),
+			AssetType: rates[i].Asset.String(),
+			Rate:      rates[i].Rate,
+			Timestamp: rates[i].RateTime,
+		})
+	}
+	if len(data) > 0 {
+		if err := dbfundingrate.Insert(data...); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+			log.Errorf(log.ExchangeSys, "Funding rate scanner unable to store funding rates: %v", err)
+		}
+	}
+	if len(opportunities) == 0 {
+		return
+	}
+	opps := make([]fundingopportunity.Data, len(opportunities))
+	for i := range opportunities {
+		opps[i] = fundingopportunity.Data{
+			Type:          string(opportunities[i].Type),
+			Base:          opportunities[i].Underlying.Base.String(),
+			Quote:         opportunities[i].Underlying.Quote.String(),
+			LongExchange:  opportunities[i].Long.Exchange,
+			LongAsset:     opportunities[i].Long.Asset.String(),
+			LongRate:      opportunities[i].Long.AnnualisedRate,
+			ShortExchange: opportunities[i].Short.Exchange,
+			ShortAsset:    opportunities[i].Short.Asset.String(),
+			ShortRate:     opportunities[i].Short.AnnualisedRate,
+			GrossRate:     opportunities[i].GrossRate,
+			FeeRate:       opportunities[i].FeeRate,
+			NetRate:       opportunities[i].NetRate,
+			Timestamp:     opportunities[i].DetectedAt,
+		}
+	}
+	if err := fundingopportunity.Insert(opps...); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.ExchangeSys, "Funding rate scanner unable to store funding opportunities: %v", err)
+	}
+}
+
+// logRateError logs a failure to retrieve funding details. Exchanges which do
+// not support the request are only logged when verbose
+func (m *FundingRateScanner) logRateError(exchName string, a asset.Item, err error) {
+	if errors.Is(err, common.ErrFunctionNotSupported) ||
+		errors.Is(err, common.ErrNotYetImplemented) ||
+		errors.Is(err, futures.ErrNotPerpetualFuture) {
+		if m.verbose {
+			log.Debugf(log.ExchangeSys, "Funding rate scanner skipping %s %s: %v", exchName, a, err)
+		}
+		return
+	}
+	log.Errorf(log.ExchangeSys, "Funding rate scanner unable to get %s %s funding details: %v", exchName, a, err)
+}
+
+// fundingInterval infers the funding interval of a rate from the time between
+// it and the next rate, falling back to the most common interval
+func fundingInterval(latest *fundingrate.LatestRateResponse) time.Duration {
+	if latest.LatestRate.Time.IsZero() || latest.TimeOfNextRate.IsZero() {
+		return defaultFundingInterval
+	}
+	interval := latest.TimeOfNextRate.Sub(latest.LatestRate.Time).Round(time.Minute)
+	if interval <= 0 || interval > maximumFundingInterval {
+		return defaultFundingInterval
+	}
+	return interval
+}
+
+// annualiseFundingRate converts a funding rate per interval to an annualised
+// percentage
+func annualiseFundingRate(rate float64, interval time.Duration) float64 {
+	return rate * float64(fundingYear) / float64(interval) * 100
+}
+
+// matchingContracts returns whether two perpetual contracts share an
+// underlying and settlement type
+func matchingContracts(a, b *PerpetualFundingRate) bool {
+	return a.SettlementType == b.SettlementType &&
+		strings.EqualFold(a.Underlying.Base.String(), b.Underlying.Base.String()) &&
+		strings.EqualFold(a.Underlying.Quote.String(), b.Underlying.Quote.String())
+}
+
+func fundingLeg(exchName string, a asset.Item, pair currency.Pair, annualisedRate, takerFee float64) FundingLeg {
+	return FundingLeg{
+		Exchange:       exchName,
+		Asset:          a,
+		Pair:           pair,
+		AnnualisedRate: annualisedRate,
+		TakerFee:       takerFee,
+	}
+}
@@ -0,0 +1,265 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+)
+
+// fundingTestExchange returns preset funding rates, contracts and fees
+// without an exchange connection
+type fundingTestExchange struct {
+	exchange.IBotExchange
+	batched   bool
+	assets    asset.Items
+	pairs     map[asset.Item]currency.Pairs
+	rates     []fundingrate.LatestRateResponse
+	contracts []futures.Contract
+	fee       float64
+	requests  int
+}
+
+func (e *fundingTestExchange) IsEnabled() bool { return true }
+
+func (e *fundingTestExchange) GetAssetTypes(bool) asset.Items { return e.assets }
+
+func (e *fundingTestExchange) GetEnabledPairs(a asset.Item) (currency.Pairs, error) {
+	return e.pairs[a], nil
+}
+
+func (e *fundingTestExchange) IsPerpetualFutureCurrency(asset.Item, currency.Pair) (bool, error) {
+	return true, nil
+}
+
+func (e *fundingTestExchange) GetSupportedFeatures() exchange.FeaturesSupported {
+	return exchange.FeaturesSupported{
+		FuturesCapabilities: exchange.FuturesCapabilities{
+			FundingRates:        true,
+			FundingRateBatching: map[asset.Item]bool{asset.USDTMarginedFutures: e.batched},
+		},
+	}
+}
+
+func (e *fundingTestExchange) GetLatestFundingRates(_ context.Context, r *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	e.requests++
+	if r.Pair.IsEmpty() {
+		return e.rates, nil
+	}
+	for i := range e.rates {
+		if e.rates[i].Pair.Equal(r.Pair) {
+			return e.rates[i : i+1], nil
+		}
+	}
+	return nil, futures.ErrNotPerpetualFuture
+}
+
+func (e *fundingTestExchange) GetFuturesContractDetails(context.Context, asset.Item) ([]futures.Contract, error) {
+	return e.contracts, nil
+}
+
+func (e *fundingTestExchange) GetFeeByType(context.Context, *exchange.FeeBuilder) (float64, error) {
+	return e.fee, nil
+}
+
+func newFundingTestExchange(t *testing.T, em *ExchangeManager, name string) *fundingTestExchange {
+	t.Helper()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().Name = name
+	e := &fundingTestExchange{IBotExchange: exch, fee: 0.0005}
+	require.NoError(t, em.Add(e), "Add must not error")
+	return e
+}
+
+func TestSetupFundingRateScanner(t *testing.T) {
+	t.Parallel()
+	_, err := SetupFundingRateScanner(nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupFundingRateScanner(NewExchangeManager(), nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupFundingRateScanner(NewExchangeManager(), &config.FundingRateScanner{DisableCrossExchange: true, DisableSpotPerpetual: true})
+	assert.ErrorIs(t, err, errFundingModesDisabled)
+
+	_, err = SetupFundingRateScanner(NewExchangeManager(), &config.FundingRateScanner{MinimumNetRate: -1})
+	assert.ErrorIs(t, err, errFundingNetRateInvalid)
+
+	cfg := &config.FundingRateScanner{}
+	m, err := SetupFundingRateScanner(NewExchangeManager(), cfg)
+	require.NoError(t, err, "SetupFundingRateScanner must not error")
+	assert.Equal(t, defaultFundingRateCheckInterval, cfg.CheckInterval, "CheckInterval should be defaulted")
+	assert.Equal(t, defaultFundingHoldingPeriod, cfg.HoldingPeriod, "HoldingPeriod should be defaulted")
+	assert.True(t, m.crossExchange, "crossExchange should be enabled")
+	assert.True(t, m.spotPerpetual, "spotPerpetual should be enabled")
+}
+
+func TestFundingRateScannerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *FundingRateScanner
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil scanner")
+	_, err := m.GetScan()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = SetupFundingRateScanner(NewExchangeManager(), &config.FundingRateScanner{})
+	require.NoError(t, err, "SetupFundingRateScanner must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+}
+
+func TestFundingInterval(t *testing.T) {
+	t.Parallel()
+	tn := time.Now().Truncate(time.Hour)
+	for _, tc := range []struct {
+		name     string
+		rateTime time.Time
+		next     time.Time
+		expected time.Duration
+	}{
+		{name: "no rate time", next: tn, expected: defaultFundingInterval},
+		{name: "no next rate time", rateTime: tn, expected: defaultFundingInterval},
+		{name: "same time", rateTime: tn, next: tn, expected: defaultFundingInterval},
+		{name: "beyond maximum", rateTime: tn, next: tn.Add(maximumFundingInterval * 2), expected: defaultFundingInterval},
+		{name: "hourly", rateTime: tn, next: tn.Add(time.Hour + time.Second), expected: time.Hour},
+		{name: "four hourly", rateTime: tn, next: tn.Add(time.Hour * 4), expected: time.Hour * 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, fundingInterval(&fundingrate.LatestRateResponse{
+				LatestRate:     fundingrate.Rate{Time: tc.rateTime},
+				TimeOfNextRate: tc.next,
+			}))
+		})
+	}
+	assert.InDelta(t, 10.95, annualiseFundingRate(0.0001, time.Hour*8), 1e-9, "annualiseFundingRate should return an annual percentage")
+}
+
+func TestFundingRateScannerScan(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	tn := time.Now().Truncate(time.Hour)
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	btcPerp := currency.NewPairWithDelimiter("BTC", "PERP", "-")
+
+	one := newFundingTestExchange(t, em, fakeExchangeName)
+	one.batched = true
+	one.assets = asset.Items{asset.Spot, asset.USDTMarginedFutures}
+	one.pairs = map[asset.Item]currency.Pairs{asset.Spot: {btcusdt}}
+	one.rates = []fundingrate.LatestRateResponse{
+		{
+			Exchange:              fakeExchangeName,
+			Asset:                 asset.USDTMarginedFutures,
+			Pair:                  btcusdt,
+			LatestRate:            fundingrate.Rate{Time: tn, Rate: decimal.NewFromFloat(0.0001)},
+			PredictedUpcomingRate: fundingrate.Rate{Rate: decimal.NewFromFloat(0.0002)},
+			TimeOfNextRate:        tn.Add(time.Hour * 8),
+		},
+		{
+			Exchange:   fakeExchangeName,
+			Asset:      asset.USDTMarginedFutures,
+			Pair:       currency.NewPair(currency.ETH, currency.USDT),
+			LatestRate: fundingrate.Rate{Time: tn, Rate: decimal.NewFromFloat(-0.0001)},
+		},
+	}
+	one.contracts = []futures.Contract{{Name: btcusdt, Underlying: btcusdt, SettlementType: futures.Linear}}
+
+	two := newFundingTestExchange(t, em, "fakeTwo")
+	two.assets = asset.Items{asset.USDTMarginedFutures}
+	two.pairs = map[asset.Item]currency.Pairs{asset.USDTMarginedFutures: {btcPerp}}
+	two.rates = []fundingrate.LatestRateResponse{
+		{
+			Exchange:       "fakeTwo",
+			Asset:          asset.USDTMarginedFutures,
+			Pair:           btcPerp,
+			LatestRate:     fundingrate.Rate{Time: tn, Rate: decimal.NewFromFloat(0.0003)},
+			TimeOfNextRate: tn.Add(time.Hour * 4),
+		},
+	}
+	two.contracts = []futures.Contract{{Name: btcPerp, Underlying: btcusdt, SettlementType: futures.Linear}}
+
+	m, err := SetupFundingRateScanner(em, &config.FundingRateScanner{})
+	require.NoError(t, err, "SetupFundingRateScanner must not error")
+	_, err = m.GetScan()
+	assert.ErrorIs(t, err, errFundingRateScanNotStarted)
+
+	m.scan(t.Context())
+	scan, err := m.GetScan()
+	require.NoError(t, err, "GetScan must not error")
+	assert.False(t, scan.Time.IsZero(), "scan time should be set")
+	require.Len(t, scan.Rates, 3, "every perpetual funding rate must be collected")
+	assert.Equal(t, 1, two.requests, "exchanges without batching should be requested for each enabled pair")
+
+	assert.Equal(t, "fakeTwo", scan.Rates[0].Exchange, "rates should be ordered by annualised rate")
+	assert.True(t, scan.Rates[0].Underlying.Equal(btcusdt), "the underlying should be taken from the contract")
+	assert.InDelta(t, 65.7, scan.Rates[0].AnnualisedRate, 1e-9)
+
+	rate := scan.Rates[1]
+	assert.Equal(t, time.Hour*8, rate.Interval)
+	assert.InDelta(t, 10.95, rate.AnnualisedRate, 1e-9)
+	assert.InDelta(t, 21.9, rate.AnnualisedPredictedRate, 1e-9)
+	assert.Equal(t, futures.Linear, rate.SettlementType, "the settlement type should be taken from the contract")
+	assert.Equal(t, 0.0005, rate.TakerFee)
+	assert.Equal(t, defaultFundingInterval, scan.Rates[2].Interval, "the default interval should be used without a next rate time")
+
+	feeRate := 2 * (0.0005 + 0.0005) * 365 / 30 * 100
+	require.Len(t, scan.Opportunities, 2, "one cross-exchange and one spot-perpetual opportunity must be ranked")
+	cross := scan.Opportunities[0]
+	assert.Equal(t, CrossExchangeFunding, cross.Type)
+	assert.Equal(t, fakeExchangeName, cross.Long.Exchange, "the lower funding rate should be held long")
+	assert.Equal(t, "fakeTwo", cross.Short.Exchange, "the higher funding rate should be held short")
+	assert.InDelta(t, 65.7-10.95, cross.GrossRate, 1e-9)
+	assert.InDelta(t, feeRate, cross.FeeRate, 1e-9)
+	assert.InDelta(t, 65.7-10.95-feeRate, cross.NetRate, 1e-9)
+
+	spot := scan.Opportunities[1]
+	assert.Equal(t, SpotPerpetualFunding, spot.Type)
+	assert.Equal(t, asset.Spot, spot.Long.Asset)
+	assert.Equal(t, fakeExchangeName, spot.Short.Exchange, "only exchanges with the spot pair enabled should be ranked")
+	assert.InDelta(t, 10.95-feeRate, spot.NetRate, 1e-9)
+
+	m.minimumNetRate = 20
+	m.scan(t.Context())
+	scan, err = m.GetScan()
+	require.NoError(t, err, "GetScan must not error")
+	require.Len(t, scan.Opportunities, 1, "opportunities below the minimum net rate must not be ranked")
+	assert.Equal(t, CrossExchangeFunding, scan.Opportunities[0].Type)
+
+	m.minimumNetRate = 0
+	m.holdingPeriod = time.Hour * 24 * 3
+	m.scan(t.Context())
+	scan, err = m.GetScan()
+	require.NoError(t, err, "GetScan must not error")
+	require.Len(t, scan.Opportunities, 1, "opportunities with a negative net rate must not be ranked")
+	assert.Equal(t, CrossExchangeFunding, scan.Opportunities[0].Type)
+
+	m.crossExchange = false
+	m.scan(t.Context())
+	scan, err = m.GetScan()
+	require.NoError(t, err, "GetScan must not error")
+	assert.Empty(t, scan.Opportunities, "cross-exchange opportunities should not be ranked when disabled")
+}
+
+func TestFundingRateScannerLogRateError(t *testing.T) {
+	t.Parallel()
+	m := &FundingRateScanner{verbose: true}
+	m.logRateError(fakeExchangeName, asset.USDTMarginedFutures, common.ErrFunctionNotSupported)
+	m.logRateError(fakeExchangeName, asset.USDTMarginedFutures, errFundingModesDisabled)
+}
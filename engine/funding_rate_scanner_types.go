@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+)
+
+// FundingRateScannerName is an exported subsystem name
+const FundingRateScannerName = "funding_rate_scanner"
+
+const (
+	defaultFundingRateCheckInterval = time.Minute * 5
+	defaultFundingHoldingPeriod     = time.Hour * 24 * 30
+	// defaultFundingInterval is used to annualise a funding rate when the
+	// exchange does not provide enough detail to infer its interval
+	defaultFundingInterval = time.Hour * 8
+	// maximumFundingInterval is the longest funding interval which is
+	// inferred from the time between funding rates
+	maximumFundingInterval = time.Hour * 24
+	// fundingContractRefreshInterval is how often futures contract details are
+	// retrieved from an exchange
+	fundingContractRefreshInterval = time.Hour
+	fundingYear                    = time.Hour * 24 * 365
+)
+
+// Funding opportunity types
+const (
+	// CrossExchangeFunding is long the perpetual contract with the lower
+	// funding rate and short the one with the higher rate on another exchange
+	CrossExchangeFunding FundingOpportunityType = "CROSS_EXCHANGE"
+	// SpotPerpetualFunding is long spot and short the perpetual contract on
+	// the same exchange, receiving a positive funding rate
+	SpotPerpetualFunding FundingOpportunityType = "SPOT_PERPETUAL"
+)
+
+var (
+	errFundingModesDisabled      = errors.New("cross-exchange and spot-perpetual funding opportunities are both disabled")
+	errFundingNetRateInvalid     = errors.New("minimum net rate cannot be negative")
+	errFundingRateScanNotStarted = errors.New("funding rate scanner has not completed a scan")
+)
+
+// FundingOpportunityType defines the kind of funding opportunity
+type FundingOpportunityType string
+
+// FundingRateScanner periodically collects the latest funding rates of
+// perpetual contracts across enabled exchanges and ranks cross-exchange and
+// spot-perpetual carry opportunities net of fees
+type FundingRateScanner struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	m               sync.RWMutex
+	exchangeManager iExchangeManager
+
+	checkInterval  time.Duration
+	holdingPeriod  time.Duration
+	minimumNetRate float64
+	crossExchange  bool
+	spotPerpetual  bool
+	verbose        bool
+
+	// contracts holds the futures contract details of each exchange asset
+	// and contract name, refreshed per exchange asset
+	contracts        map[key.ExchangePairAsset]*futures.Contract
+	contractsUpdated map[key.ExchangeAsset]time.Time
+	fees             map[key.ExchangePairAsset]float64
+	rates            []PerpetualFundingRate
+	opportunities    []FundingOpportunity
+	lastScan         time.Time
+}
+
+// FundingRateScan holds the results of a funding rate scan
+type FundingRateScan struct {
+	Rates         []PerpetualFundingRate
+	Opportunities []FundingOpportunity
+	Time          time.Time
+}
+
+// PerpetualFundingRate is the latest funding rate of a perpetual contract.
+// Rates are per funding interval and annualised rates are percentages
+type PerpetualFundingRate struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// Underlying and SettlementType are used to match identical contracts
+	// across exchanges
+	Underlying              currency.Pair
+	SettlementType          futures.ContractSettlementType
+	Rate                    float64
+	PredictedRate           float64
+	Interval                time.Duration
+	AnnualisedRate          float64
+	AnnualisedPredictedRate float64
+	TakerFee                float64
+	RateTime                time.Time
+	TimeOfNextRate          time.Time
+	TimeChecked             time.Time
+}
+
+// FundingOpportunity is a carry opportunity between two legs. Rates are
+// annualised percentages. FeeRate is the taker fee of opening and closing
+// both legs amortised over the holding period
+type FundingOpportunity struct {
+	Type       FundingOpportunityType
+	Underlying currency.Pair
+	Long       FundingLeg
+	Short      FundingLeg
+	GrossRate  float64
+	FeeRate    float64
+	NetRate    float64
+	DetectedAt time.Time
+}
+
+// FundingLeg is a single position of a funding opportunity. The rate of a
+// spot leg is zero
+type FundingLeg struct {
+	Exchange       string
+	Asset          asset.Item
+	Pair           currency.Pair
+	AnnualisedRate float64
+	TakerFee       float64
+}
@@ -14,6 +14,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -52,6 +53,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okx"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/poloniex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/yobit"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -63,6 +65,7 @@ var (
 	errCertTypeInvalid     = errors.New("gRPC TLS certificate type is invalid")
 	errSubsystemNotFound   = errors.New("subsystem not found")
 	errGRPCManagementFault = errors.New("cannot manage GRPC subsystem via GRPC. Please manually change your config")
+	errNoFiatPrice         = errors.New("no market price available")
 )
 
 // GetSubsystemsStatus returns the status of various subsystems
@@ -71,6 +74,7 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		CommunicationsManagerName:     bot.CommunicationsManager.IsRunning(),
 		ConnectionManagerName:         bot.connectionManager.IsRunning(),
 		OrderManagerName:              bot.OrderManager.IsRunning(),
+		EventManagerName:              bot.eventManager.IsRunning(),
 		PortfolioManagerName:          bot.portfolioManager.IsRunning(),
 		NTPManagerName:                bot.ntpManager.IsRunning(),
 		DatabaseConnectionManagerName: bot.DatabaseManager.IsRunning(),
@@ -83,6 +87,15 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		SyntheticOrderManagerName:     bot.syntheticOrderManager.IsRunning(),
+		ExecutionAlgorithmManagerName: bot.executionAlgorithmManager.IsRunning(),
+		TickRecorderName:              bot.tickRecorder.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
+		ArbitrageManagerName:          bot.arbitrageManager.IsRunning(),
+		LedgerManagerName:             bot.ledgerManager.IsRunning(),
+		ConfigReloaderName:            bot.configReloader.IsRunning(),
+		TransferManagerName:           bot.transferManager.IsRunning(),
+		FundingRateScannerName:        bot.fundingRateScanner.IsRunning(),
 	}
 }
 
@@ -137,6 +150,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				bot.WithdrawManager.SetCommunicationsManager(bot.CommunicationsManager)
 			}
 			return bot.CommunicationsManager.Start()
 		}
@@ -159,10 +173,163 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				if bot.Config.OrderManager.PersistOrders {
+					err = bot.OrderManager.SetupOrderPersistence(bot.DatabaseManager)
+					if err != nil {
+						return err
+					}
+				}
 			}
 			return bot.OrderManager.Start()
 		}
 		return bot.OrderManager.Stop()
+	case EventManagerName:
+		if enable {
+			if bot.eventManager == nil {
+				bot.eventManager, err = setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.OrderManager, bot.gctScriptManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun)
+				if err != nil {
+					return err
+				}
+				if bot.DatabaseManager.IsRunning() {
+					err = bot.eventManager.SetupEventPersistence(bot.DatabaseManager)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return bot.eventManager.Start()
+		}
+		return bot.eventManager.Stop()
+	case SyntheticOrderManagerName:
+		if enable {
+			if bot.syntheticOrderManager == nil {
+				bot.syntheticOrderManager, err = SetupSyntheticOrderManager(bot.OrderManager, bot.CommunicationsManager, &bot.Config.SyntheticOrderManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.syntheticOrderManager.Start()
+		}
+		return bot.syntheticOrderManager.Stop()
+	case ExecutionAlgorithmManagerName:
+		if enable {
+			if bot.executionAlgorithmManager == nil {
+				bot.executionAlgorithmManager, err = SetupExecutionAlgorithmManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.ExecutionAlgorithmManager)
+				if err != nil {
+					return err
+				}
+				if bot.WebsocketRoutineManager != nil {
+					err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.executionAlgorithmManager.websocketDataHandler, false)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return bot.executionAlgorithmManager.Start()
+		}
+		return bot.executionAlgorithmManager.Stop()
+	case TickRecorderName:
+		if enable {
+			if bot.tickRecorder == nil {
+				bot.tickRecorder, err = SetupTickRecorder(&bot.Config.TickRecorder, bot.Settings.DataDir)
+				if err != nil {
+					return err
+				}
+				if bot.WebsocketRoutineManager != nil {
+					err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.tickRecorder.websocketDataHandler, false)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return bot.tickRecorder.Start()
+		}
+		return bot.tickRecorder.Stop()
+	case MetricsManagerName:
+		if enable {
+			if bot.metricsManager == nil {
+				bot.metricsManager, err = SetupMetricsManager(&bot.Config.MetricsManager, bot)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.metricsManager.Start()
+		}
+		return bot.metricsManager.Stop()
+	case ArbitrageManagerName:
+		if enable {
+			if bot.arbitrageManager == nil {
+				bot.arbitrageManager, err = SetupArbitrageManager(bot.ExchangeManager, bot.OrderManager, bot.CommunicationsManager, bot.gctScriptManager, &bot.Config.ArbitrageManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.arbitrageManager.Start()
+		}
+		return bot.arbitrageManager.Stop()
+	case LedgerManagerName:
+		if enable {
+			if bot.ledgerManager == nil {
+				bot.ledgerManager, err = SetupLedgerManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.LedgerManager)
+				if err != nil {
+					return err
+				}
+				if bot.WebsocketRoutineManager != nil {
+					err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.ledgerManager.websocketDataHandler, false)
+					if err != nil {
+						return err
+					}
+				}
+				if bot.Config.LedgerManager.PersistEntries {
+					err = bot.ledgerManager.SetupLedgerPersistence(bot.DatabaseManager)
+					if err != nil {
+						return err
+					}
+				}
+				bot.WithdrawManager.SetLedgerManager(bot.ledgerManager)
+			}
+			return bot.ledgerManager.Start()
+		}
+		return bot.ledgerManager.Stop()
+	case TransferManagerName:
+		if enable {
+			if bot.transferManager == nil {
+				bot.transferManager, err = SetupTransferManager(bot.ExchangeManager, bot.CommunicationsManager, &bot.Config.TransferManager)
+				if err != nil {
+					return err
+				}
+				bot.WithdrawManager.SetTransferManager(bot.transferManager)
+			}
+			return bot.transferManager.Start()
+		}
+		return bot.transferManager.Stop()
+	case FundingRateScannerName:
+		if enable {
+			if bot.fundingRateScanner == nil {
+				bot.fundingRateScanner, err = SetupFundingRateScanner(bot.ExchangeManager, &bot.Config.FundingRateScanner)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.fundingRateScanner.Start()
+		}
+		return bot.fundingRateScanner.Stop()
+	case ConfigReloaderName:
+		if enable {
+			if bot.configReloader == nil {
+				var filePath string
+				filePath, err = config.GetAndMigrateDefaultPath(bot.Settings.ConfigFile)
+				if err != nil {
+					return err
+				}
+				bot.configReloader, err = SetupConfigReloader(&bot.Config.ConfigReloader, filePath, bot.Config.EncryptionKeyProvider, bot)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.configReloader.Start()
+		}
+		return bot.configReloader.Stop()
 	case PortfolioManagerName:
 		if enable {
 			if bot.portfolioManager == nil {
@@ -170,6 +337,12 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				if bot.Config.PortfolioSnapshots.Enabled {
+					err = bot.portfolioManager.SetupSnapshotPersistence(bot.DatabaseManager, &bot.Config.PortfolioSnapshots)
+					if err != nil {
+						return err
+					}
+				}
 			}
 			return bot.portfolioManager.Start(&bot.ServicesWG)
 		}
@@ -201,25 +374,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 	case SyncManagerName:
 		if enable {
 			if bot.currencyPairSyncer == nil {
-				cfg := bot.Config.SyncManagerConfig
-				cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
-				cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
-				cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
-				cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
-				cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
-
-				if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
-					bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
-					cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
-				}
-				if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
-					bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
-					cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
-				}
-				if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
-					bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
-					cfg.NumWorkers = bot.Settings.SyncWorkersCount
-				}
+				cfg := bot.syncManagerConfig()
 				bot.currencyPairSyncer, err = SetupSyncManager(
 					&cfg,
 					bot.ExchangeManager,
@@ -1037,3 +1192,59 @@ func NewExchangeByNameWithDefaults(ctx context.Context, name string) (exchange.I
 	}
 	return exch, nil
 }
+
+// getFiatPrice returns the value of a single unit of a currency in a fiat
+// currency. Cryptocurrencies are valued from the last price of a spot ticker
+// quoted in a fiat or stable currency, preferring the supplied exchange
+func getFiatPrice(em iExchangeManager, exchName string, c, fiat currency.Code) (float64, error) {
+	if c.IsFiatCurrency() || c.IsStableCurrency() {
+		return getFiatRate(c, fiat)
+	}
+	exchanges, err := em.GetExchanges()
+	if err != nil {
+		return 0, err
+	}
+	slices.SortStableFunc(exchanges, func(a, b exchange.IBotExchange) int {
+		aPreferred, bPreferred := strings.EqualFold(a.GetName(), exchName), strings.EqualFold(b.GetName(), exchName)
+		switch {
+		case aPreferred && !bPreferred:
+			return -1
+		case bPreferred && !aPreferred:
+			return 1
+		}
+		return 0
+	})
+	for _, exch := range exchanges {
+		pairs, err := exch.GetEnabledPairs(asset.Spot)
+		if err != nil {
+			continue
+		}
+		for _, p := range pairs {
+			if !p.Base.Equal(c) || (!p.Quote.IsFiatCurrency() && !p.Quote.IsStableCurrency()) {
+				continue
+			}
+			t, err := ticker.GetTicker(exch.GetName(), p, asset.Spot)
+			if err != nil || t.Last <= 0 {
+				continue
+			}
+			rate, err := getFiatRate(p.Quote, fiat)
+			if err != nil {
+				continue
+			}
+			return t.Last * rate, nil
+		}
+	}
+	return 0, fmt.Errorf("%w for %s in %s", errNoFiatPrice, c, fiat)
+}
+
+// getFiatRate returns the value of a single unit of a fiat currency in another
+// fiat currency. Stable currencies are valued as USD
+func getFiatRate(c, fiat currency.Code) (float64, error) {
+	if c.IsStableCurrency() {
+		c = currency.USD
+	}
+	if c.Equal(fiat) {
+		return 1, nil
+	}
+	return currency.ConvertFiat(1, c, fiat)
+}
@@ -100,8 +100,8 @@ func CreateTestBot(tb testing.TB) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 15, len(m))
+	if len(m) != 25 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 25, len(m))
 	}
 }
 
@@ -145,6 +145,66 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  nil,
 			DisableError: nil,
 		},
+		{
+			Subsystem:    EventManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    SyntheticOrderManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    ExecutionAlgorithmManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    TickRecorderName,
+			Engine:       &Engine{Config: &config.Config{TickRecorder: config.TickRecorder{Directory: t.TempDir()}}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    MetricsManagerName,
+			Engine:       &Engine{Config: &config.Config{MetricsManager: config.MetricsManager{ListenAddress: "localhost:0"}}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    ArbitrageManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    LedgerManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    TransferManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    FundingRateScannerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    ConfigReloaderName,
+			Engine:       &Engine{Config: &config.Config{}, Settings: Settings{ConfigFile: config.TestFile}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
 		{
 			Subsystem:    PortfolioManagerName,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -0,0 +1,461 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/ledgerentry"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+// SetupLedgerManager creates a ledger manager subsystem
+func SetupLedgerManager(em iExchangeManager, om iLedgerOrderManager, cfg *config.LedgerManager) (*LedgerManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w LedgerManager", errNilConfig)
+	}
+	method := ledger.FIFO
+	if cfg.Method != "" {
+		var err error
+		if method, err = ledger.StringToMethod(cfg.Method); err != nil {
+			return nil, err
+		}
+	}
+	fiat := currency.USD
+	if cfg.FiatCurrency != "" {
+		fiat = currency.NewCode(cfg.FiatCurrency)
+	}
+	l, err := ledger.New(method, fiat)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultLedgerCheckInterval
+	}
+	return &LedgerManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		orderManager:    om,
+		ledger:          l,
+		checkInterval:   cfg.CheckInterval,
+		verbose:         cfg.Verbose,
+		booked:          make(map[string]bookedOrder),
+	}, nil
+}
+
+// SetupLedgerPersistence writes ledger entries to the database. Stored entries
+// are replayed into the ledger when the subsystem starts
+func (m *LedgerManager) SetupLedgerPersistence(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("ledger manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	repo, err := ledgerentry.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.repository = repo
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *LedgerManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *LedgerManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("ledger manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Ledger manager starting. Method: %s Fiat: %s\n", m.ledger.GetMethod(), m.ledger.GetFiat())
+	m.loadPersistedEntries()
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *LedgerManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("ledger manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.PortfolioMgr, "Ledger manager shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.PortfolioMgr, "Ledger manager shutdown.")
+	return nil
+}
+
+// GetPnL returns the realised and unrealised PnL of the spot holdings in the
+// ledger, valued at current market prices. Supplying currencies limits the
+// assets returned
+func (m *LedgerManager) GetPnL(codes ...currency.Code) (*ledger.PnL, error) {
+	if m == nil {
+		return nil, fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("ledger manager %w", ErrSubSystemNotStarted)
+	}
+	return m.ledger.GetPnL(func(c currency.Code) (float64, error) {
+		return m.getFiatPrice("", c)
+	}, codes...)
+}
+
+// RecordTransfer records a deposit or withdrawal against the ledger. Deposits
+// without a price are valued at the current market price
+func (m *LedgerManager) RecordTransfer(e *ledger.Entry) error {
+	if m == nil {
+		return fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("ledger manager %w", ErrSubSystemNotStarted)
+	}
+	if e == nil {
+		return fmt.Errorf("%w ledger entry", common.ErrNilPointer)
+	}
+	if e.Type != ledger.Deposit && e.Type != ledger.Withdrawal {
+		return fmt.Errorf("%w: %q", errLedgerTransferType, e.Type)
+	}
+	if e.ID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		e.ID = id.String()
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if e.Type == ledger.Deposit && e.Price == 0 {
+		price, err := m.getFiatPrice(e.Exchange, e.Currency)
+		if err != nil {
+			return err
+		}
+		e.Price = price
+	}
+	return m.record(e)
+}
+
+// websocketDataHandler records spot fills received from exchange websocket
+// fill feeds
+func (m *LedgerManager) websocketDataHandler(_ string, data any) error {
+	switch d := data.(type) {
+	case fill.Data:
+		m.processFills(d)
+	case []fill.Data:
+		m.processFills(d...)
+	}
+	return nil
+}
+
+func (m *LedgerManager) processFills(fills ...fill.Data) {
+	if !m.IsRunning() {
+		return
+	}
+	for i := range fills {
+		if fills[i].AssetType != asset.Spot {
+			continue
+		}
+		id := fills[i].ID
+		if fills[i].TradeID != "" {
+			id = strings.ToLower(fills[i].Exchange) + "-" + fills[i].TradeID
+		}
+		if id == "" {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				log.Errorf(log.PortfolioMgr, "Ledger manager unable to record %s fill: %v", fills[i].Exchange, err)
+				continue
+			}
+			id = newID.String()
+		}
+		e := &ledger.Entry{
+			ID:       id,
+			Exchange: fills[i].Exchange,
+			Type:     ledger.Trade,
+			Pair:     fills[i].CurrencyPair,
+			Side:     fills[i].Side,
+			OrderID:  fills[i].OrderID,
+			TradeID:  fills[i].TradeID,
+			Amount:   fills[i].Amount,
+			Price:    fills[i].Price,
+			Time:     fills[i].Timestamp,
+		}
+		if err := m.recordTrade(e); err != nil {
+			log.Errorf(log.PortfolioMgr, "Ledger manager unable to record %s %s fill %s: %v", fills[i].Exchange, fills[i].CurrencyPair, id, err)
+		}
+	}
+}
+
+func (m *LedgerManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.checkOrders()
+		}
+	}
+}
+
+// checkOrders records the fills of spot orders tracked by the order manager
+// since they were last checked. Exchanges with an enabled fill feed are
+// skipped as their fills are recorded as they are received
+func (m *LedgerManager) checkOrders() {
+	if !m.orderManager.IsRunning() {
+		return
+	}
+	orders := m.orderManager.GetOrdersSnapshot(order.AnyStatus)
+	for i := range orders {
+		if orders[i].AssetType != asset.Spot || orders[i].ExecutedAmount <= 0 {
+			continue
+		}
+		exch, err := m.exchangeManager.GetExchangeByName(orders[i].Exchange)
+		if err != nil || exch.GetBase().IsFillsFeedEnabled() {
+			continue
+		}
+		e, err := m.orderFill(&orders[i])
+		if err != nil {
+			log.Errorf(log.PortfolioMgr, "Ledger manager unable to record %s order %s fill: %v", orders[i].Exchange, orders[i].OrderID, err)
+			continue
+		}
+		if e == nil {
+			continue
+		}
+		if err := m.recordTrade(e); err != nil {
+			log.Errorf(log.PortfolioMgr, "Ledger manager unable to record %s order %s fill: %v", orders[i].Exchange, orders[i].OrderID, err)
+		}
+	}
+}
+
+// orderFill returns an entry for the amount an order has executed since it
+// was last recorded, or nil when nothing further has executed
+func (m *LedgerManager) orderFill(od *order.Detail) (*ledger.Entry, error) {
+	m.m.Lock()
+	b := m.booked[bookedOrderKey(od.Exchange, od.OrderID)]
+	m.m.Unlock()
+	amount := od.ExecutedAmount - b.amount
+	if amount <= 0 {
+		return nil, nil
+	}
+	avg := od.AverageExecutedPrice
+	if avg <= 0 {
+		avg = od.Price
+	}
+	if avg <= 0 {
+		return nil, errLedgerNoExecutedPrice
+	}
+	price := (avg*od.ExecutedAmount - b.cost) / amount
+	if price <= 0 {
+		price = avg
+	}
+	fee := max(od.Fee-b.fee, 0)
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	t := od.LastUpdated
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return &ledger.Entry{
+		ID:          id.String(),
+		Exchange:    od.Exchange,
+		Type:        ledger.Trade,
+		Pair:        od.Pair,
+		Side:        od.Side,
+		OrderID:     od.OrderID,
+		Amount:      amount,
+		Price:       price,
+		Fee:         fee,
+		FeeCurrency: od.FeeAsset,
+		Time:        t,
+	}, nil
+}
+
+// recordTrade values the quote currency of a trade in the ledger fiat
+// currency and records it
+func (m *LedgerManager) recordTrade(e *ledger.Entry) error {
+	rate, err := m.getFiatPrice(e.Exchange, e.Pair.Quote)
+	if err != nil {
+		return err
+	}
+	e.FiatRate = rate
+	return m.record(e)
+}
+
+// record processes an entry, writing it to the database when persistence is
+// enabled
+func (m *LedgerManager) record(e *ledger.Entry) error {
+	if err := m.ledger.Process(e); err != nil {
+		if errors.Is(err, ledger.ErrDuplicateEntry) {
+			return nil
+		}
+		return err
+	}
+	m.book(e)
+	if m.verbose {
+		log.Debugf(log.PortfolioMgr, "Ledger manager recorded %s %s entry %s %s %s %v @ %v", e.Exchange, e.Type, e.Pair, e.Currency, e.Side, e.Amount, e.Price)
+	}
+	if m.repository == nil {
+		return nil
+	}
+	if err := m.repository.Insert(ledgerEntryToDBDetails(e, m.ledger.GetFiat())); err != nil {
+		log.Errorf(log.PortfolioMgr, "Unable to persist %s ledger entry %s: %v", e.Exchange, e.ID, err)
+	}
+	return nil
+}
+
+// book tracks the amount recorded against an order so that order manager
+// updates only record what has executed since
+func (m *LedgerManager) book(e *ledger.Entry) {
+	if e.Type != ledger.Trade || e.OrderID == "" {
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	k := bookedOrderKey(e.Exchange, e.OrderID)
+	b := m.booked[k]
+	b.amount += e.Amount
+	b.cost += e.Amount * e.Price
+	b.fee += e.Fee
+	m.booked[k] = b
+}
+
+// loadPersistedEntries replays stored entries into the ledger. Entries stored
+// in a different fiat currency are converted to the ledger fiat currency
+func (m *LedgerManager) loadPersistedEntries() {
+	if m.repository == nil {
+		return
+	}
+	stored, err := m.repository.GetAll()
+	if err != nil {
+		log.Errorf(log.PortfolioMgr, "Unable to load persisted ledger entries: %v", err)
+		return
+	}
+	var loaded int
+	for i := range stored {
+		e, err := dbDetailsToLedgerEntry(&stored[i])
+		if err != nil {
+			log.Errorf(log.PortfolioMgr, "Unable to load persisted %s ledger entry %s: %v", stored[i].Exchange, stored[i].ID, err)
+			continue
+		}
+		if fiat := currency.NewCode(stored[i].Fiat); !fiat.Equal(m.ledger.GetFiat()) {
+			rate, err := getFiatRate(fiat, m.ledger.GetFiat())
+			if err != nil {
+				log.Errorf(log.PortfolioMgr, "Unable to convert persisted %s ledger entry %s from %s: %v", stored[i].Exchange, stored[i].ID, fiat, err)
+				continue
+			}
+			e.FiatRate *= rate
+			if e.Type == ledger.Deposit {
+				e.Price *= rate
+			}
+		}
+		if err := m.ledger.Process(e); err != nil {
+			if !errors.Is(err, ledger.ErrDuplicateEntry) {
+				log.Errorf(log.PortfolioMgr, "Unable to load persisted %s ledger entry %s: %v", stored[i].Exchange, stored[i].ID, err)
+			}
+			continue
+		}
+		m.book(e)
+		loaded++
+	}
+	if loaded > 0 {
+		log.Infof(log.PortfolioMgr, "Loaded %d persisted ledger entries", loaded)
+	}
+}
+
+// getFiatPrice returns the value of a single unit of a currency in the ledger
+// fiat currency, preferring the prices of the supplied exchange
+func (m *LedgerManager) getFiatPrice(exchName string, c currency.Code) (float64, error) {
+	return getFiatPrice(m.exchangeManager, exchName, c, m.ledger.GetFiat())
+}
+
+func bookedOrderKey(exch, orderID string) string {
+	return strings.ToLower(exch) + "-" + orderID
+}
+
+// ledgerEntryToDBDetails converts a ledger entry to its database form
+func ledgerEntryToDBDetails(e *ledger.Entry, fiat currency.Code) *ledgerentry.Details {
+	d := &ledgerentry.Details{
+		ID:          e.ID,
+		Exchange:    e.Exchange,
+		Type:        string(e.Type),
+		OrderID:     e.OrderID,
+		TradeID:     e.TradeID,
+		Amount:      e.Amount,
+		Price:       e.Price,
+		Fee:         e.Fee,
+		FeeCurrency: e.FeeCurrency.String(),
+		Fiat:        fiat.String(),
+		FiatRate:    e.FiatRate,
+		Timestamp:   e.Time,
+	}
+	if e.Type == ledger.Trade {
+		d.Base = e.Pair.Base.String()
+		d.Quote = e.Pair.Quote.String()
+		d.Side = e.Side.String()
+	} else {
+		d.Base = e.Currency.String()
+	}
+	return d
+}
+
+// dbDetailsToLedgerEntry converts a persisted ledger entry back to a ledger
+// entry
+func dbDetailsToLedgerEntry(d *ledgerentry.Details) (*ledger.Entry, error) {
+	e := &ledger.Entry{
+		ID:          d.ID,
+		Exchange:    d.Exchange,
+		Type:        ledger.EntryType(d.Type),
+		OrderID:     d.OrderID,
+		TradeID:     d.TradeID,
+		Amount:      d.Amount,
+		Price:       d.Price,
+		Fee:         d.Fee,
+		FeeCurrency: currency.NewCode(d.FeeCurrency),
+		FiatRate:    d.FiatRate,
+		Time:        d.Timestamp,
+	}
+	if e.Type != ledger.Trade {
+		e.Currency = currency.NewCode(d.Base)
+		return e, nil
+	}
+	e.Pair = currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote))
+	var err error
+	if e.Side, err = order.StringToOrderSide(d.Side); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,234 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/ledgerentry"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+type ledgerOrderManager struct {
+	orders []order.Detail
+}
+
+func (l *ledgerOrderManager) IsRunning() bool {
+	return true
+}
+
+func (l *ledgerOrderManager) GetOrdersSnapshot(order.Status) []order.Detail {
+	return l.orders
+}
+
+// setupLedgerTest returns a running ledger manager with an exchange which
+// has a BTCUSDT ticker at the supplied last price
+func setupLedgerTest(t *testing.T, exchName string, last float64) (*LedgerManager, *ledgerOrderManager) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = exchName
+	b.Enabled = true
+	b.Features.Enabled.FillsFeed = false
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled:  true,
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+			Available:     currency.Pairs{cp},
+			Enabled:       currency.Pairs{cp},
+		},
+	}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: exchName,
+		Pair:         cp,
+		AssetType:    asset.Spot,
+		Last:         last,
+		LastUpdated:  time.Now(),
+	}), "ProcessTicker must not error")
+
+	om := &ledgerOrderManager{}
+	m, err := SetupLedgerManager(em, om, &config.LedgerManager{})
+	require.NoError(t, err, "SetupLedgerManager must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	})
+	return m, om
+}
+
+func TestSetupLedgerManager(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	om := &ledgerOrderManager{}
+	_, err := SetupLedgerManager(nil, om, &config.LedgerManager{})
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupLedgerManager(em, nil, &config.LedgerManager{})
+	assert.ErrorIs(t, err, errNilOrderManager)
+	_, err = SetupLedgerManager(em, om, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupLedgerManager(em, om, &config.LedgerManager{Method: "HIFO"})
+	assert.ErrorIs(t, err, ledger.ErrInvalidMethod)
+	_, err = SetupLedgerManager(em, om, &config.LedgerManager{FiatCurrency: "BTC"})
+	assert.Error(t, err, "SetupLedgerManager should error on a non-fiat currency")
+
+	cfg := &config.LedgerManager{Method: "lifo", FiatCurrency: "eur"}
+	m, err := SetupLedgerManager(em, om, cfg)
+	require.NoError(t, err, "SetupLedgerManager must not error")
+	assert.Equal(t, ledger.LIFO, m.ledger.GetMethod())
+	assert.True(t, m.ledger.GetFiat().Equal(currency.EUR), "fiat currency should be EUR")
+	assert.Equal(t, defaultLedgerCheckInterval, cfg.CheckInterval)
+}
+
+func TestLedgerManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *LedgerManager
+	assert.False(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.SetupLedgerPersistence(nil), ErrNilSubsystem)
+
+	m, err := SetupLedgerManager(NewExchangeManager(), &ledgerOrderManager{}, &config.LedgerManager{})
+	require.NoError(t, err, "SetupLedgerManager must not error")
+	assert.ErrorIs(t, m.SetupLedgerPersistence(nil), errNilDatabaseConnectionManager)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	_, err = m.GetPnL()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	assert.ErrorIs(t, m.SetupLedgerPersistence(&DatabaseConnectionManager{}), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestLedgerManagerCheckOrders(t *testing.T) {
+	t.Parallel()
+	m, om := setupLedgerTest(t, "ledgerOrders", 150)
+	om.orders = []order.Detail{
+		{Exchange: "ledgerOrders", OrderID: "1", AssetType: asset.Spot, Pair: currency.NewBTCUSDT(), Side: order.Buy, Price: 100, ExecutedAmount: 0.5, AverageExecutedPrice: 100, Fee: 0.5, FeeAsset: currency.USDT},
+		{Exchange: "ledgerOrders", OrderID: "2", AssetType: asset.Futures, Pair: currency.NewBTCUSDT(), Side: order.Buy, Price: 100, ExecutedAmount: 1},
+		{Exchange: "ledgerOrders", OrderID: "3", AssetType: asset.Spot, Pair: currency.NewBTCUSDT(), Side: order.Buy, Price: 100},
+	}
+	m.checkOrders()
+	h := m.ledger.GetHoldings(currency.BTC)
+	require.Len(t, h, 1)
+	assert.Equal(t, 0.5, h[0].Amount)
+	assert.Equal(t, 50.5, h[0].CostBasis)
+
+	m.checkOrders()
+	h = m.ledger.GetHoldings(currency.BTC)
+	require.Len(t, h, 1)
+	assert.Equal(t, 0.5, h[0].Amount, "an unchanged order should not be recorded again")
+
+	om.orders[0].ExecutedAmount = 1
+	om.orders[0].AverageExecutedPrice = 110
+	om.orders[0].Fee = 1
+	m.checkOrders()
+	h = m.ledger.GetHoldings(currency.BTC)
+	require.Len(t, h, 1)
+	assert.Equal(t, 1.0, h[0].Amount)
+	assert.InDelta(t, 111, h[0].CostBasis, 1e-9, "second fill should be booked at the price of the amount executed since")
+	require.Len(t, h[0].Lots, 2)
+	assert.InDelta(t, 60.5, h[0].Lots[1].Cost, 1e-9)
+}
+
+func TestLedgerManagerWebsocketFills(t *testing.T) {
+	t.Parallel()
+	m, _ := setupLedgerTest(t, "ledgerFills", 150)
+	fills := []fill.Data{
+		{Exchange: "ledgerFills", AssetType: asset.Spot, CurrencyPair: currency.NewBTCUSDT(), Side: order.Buy, TradeID: "1", Price: 100, Amount: 2, Timestamp: time.Now()},
+		{Exchange: "ledgerFills", AssetType: asset.Spot, CurrencyPair: currency.NewBTCUSDT(), Side: order.Sell, TradeID: "2", Price: 130, Amount: 1, Timestamp: time.Now()},
+		{Exchange: "ledgerFills", AssetType: asset.Margin, CurrencyPair: currency.NewBTCUSDT(), Side: order.Sell, TradeID: "3", Price: 130, Amount: 1, Timestamp: time.Now()},
+	}
+	require.NoError(t, m.websocketDataHandler("ledgerFills", fills), "websocketDataHandler must not error")
+	require.NoError(t, m.websocketDataHandler("ledgerFills", fills[0]), "websocketDataHandler must not error")
+	require.NoError(t, m.websocketDataHandler("ledgerFills", "unhandled"), "websocketDataHandler must not error")
+
+	h := m.ledger.GetHoldings(currency.BTC)
+	require.Len(t, h, 1)
+	assert.Equal(t, 1.0, h[0].Amount, "duplicate and non-spot fills should be ignored")
+	assert.Equal(t, 30.0, h[0].Realised)
+}
+
+func TestLedgerManagerRecordTransfer(t *testing.T) {
+	t.Parallel()
+	var m *LedgerManager
+	assert.ErrorIs(t, m.RecordTransfer(&ledger.Entry{}), ErrNilSubsystem)
+
+	m, _ = setupLedgerTest(t, "ledgerTransfers", 150)
+	assert.ErrorIs(t, m.RecordTransfer(&ledger.Entry{Type: ledger.Trade}), errLedgerTransferType)
+	assert.ErrorIs(t, m.RecordTransfer(&ledger.Entry{Exchange: "ledgerTransfers", Type: ledger.Deposit, Currency: currency.ETH, Amount: 1}), errNoFiatPrice)
+
+	e := &ledger.Entry{Exchange: "ledgerTransfers", Type: ledger.Deposit, Currency: currency.BTC, Amount: 2}
+	require.NoError(t, m.RecordTransfer(e), "RecordTransfer must not error")
+	assert.NotEmpty(t, e.ID, "RecordTransfer should set an entry ID")
+	assert.Equal(t, 150.0, e.Price, "deposit without a price should be valued at the market price")
+
+	require.NoError(t, m.RecordTransfer(&ledger.Entry{Exchange: "ledgerTransfers", Type: ledger.Withdrawal, Currency: currency.BTC, Amount: 1, Fee: 0.1}), "RecordTransfer must not error")
+	h := m.ledger.GetHoldings(currency.BTC)
+	require.Len(t, h, 1)
+	assert.InDelta(t, 0.9, h[0].Amount, 1e-9)
+	assert.InDelta(t, -15, h[0].Realised, 1e-9, "withdrawal fee should be realised as a loss")
+}
+
+func TestLedgerManagerGetPnL(t *testing.T) {
+	t.Parallel()
+	m, _ := setupLedgerTest(t, "ledgerPnL", 150)
+	require.NoError(t, m.websocketDataHandler("ledgerPnL", fill.Data{
+		Exchange: "ledgerPnL", AssetType: asset.Spot, CurrencyPair: currency.NewBTCUSDT(), Side: order.Buy, TradeID: "1", Price: 100, Amount: 2, Timestamp: time.Now(),
+	}), "websocketDataHandler must not error")
+
+	pnl, err := m.GetPnL()
+	require.NoError(t, err, "GetPnL must not error")
+	assert.True(t, pnl.Fiat.Equal(currency.USD), "fiat currency should be USD")
+	require.Len(t, pnl.Assets, 2, "both legs of the trade should be tracked")
+	assert.Equal(t, 300.0, pnl.Assets[0].MarketValue)
+	assert.Equal(t, 100.0, pnl.Unrealised)
+	assert.Equal(t, 100.0, pnl.Total)
+
+	pnl, err = m.GetPnL(currency.USDT)
+	require.NoError(t, err, "GetPnL must not error")
+	require.Len(t, pnl.Assets, 1)
+	assert.True(t, pnl.Assets[0].Currency.Equal(currency.USDT), "only the requested currency should be returned")
+}
+
+func TestLedgerEntryDBConversion(t *testing.T) {
+	t.Parallel()
+	tm := time.Now().UTC()
+	trade := &ledger.Entry{
+		ID: "1", Exchange: "test", Type: ledger.Trade, Pair: currency.NewBTCUSDT(), Side: order.Sell, OrderID: "o", TradeID: "t",
+		Amount: 1, Price: 100, Fee: 0.1, FeeCurrency: currency.USDT, FiatRate: 1, Time: tm,
+	}
+	d := ledgerEntryToDBDetails(trade, currency.USD)
+	assert.Equal(t, "BTC", d.Base)
+	assert.Equal(t, "USDT", d.Quote)
+	assert.Equal(t, "SELL", d.Side)
+	assert.Equal(t, "USD", d.Fiat)
+	e, err := dbDetailsToLedgerEntry(d)
+	require.NoError(t, err, "dbDetailsToLedgerEntry must not error")
+	assert.Equal(t, trade, e)
+
+	deposit := &ledger.Entry{ID: "2", Exchange: "test", Type: ledger.Deposit, Currency: currency.ETH, Amount: 1, Price: 2000, Time: tm}
+	d = ledgerEntryToDBDetails(deposit, currency.USD)
+	assert.Equal(t, "ETH", d.Base)
+	assert.Empty(t, d.Quote)
+	e, err = dbDetailsToLedgerEntry(d)
+	require.NoError(t, err, "dbDetailsToLedgerEntry must not error")
+	assert.Equal(t, deposit, e)
+
+	_, err = dbDetailsToLedgerEntry(&ledgerentry.Details{Type: string(ledger.Trade), Base: "BTC", Quote: "USDT", Side: "sideways"})
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+}
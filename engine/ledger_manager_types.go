@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/ledgerentry"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+// LedgerManagerName is an exported subsystem name
+const LedgerManagerName = "ledger_manager"
+
+const defaultLedgerCheckInterval = 10 * time.Second
+
+var (
+	errLedgerNoExecutedPrice = errors.New("order has no executed or limit price")
+	errLedgerTransferType    = errors.New("ledger transfers must be deposits or withdrawals")
+)
+
+// iLedgerOrderManager defines the order manager functions used to find spot
+// order fills
+type iLedgerOrderManager interface {
+	IsRunning() bool
+	GetOrdersSnapshot(order.Status) []order.Detail
+}
+
+// LedgerManager maintains a cost-basis ledger of spot holdings, fed by
+// websocket fills, order manager updates and transfers, and reports their
+// realised and unrealised PnL
+type LedgerManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	m               sync.Mutex
+	exchangeManager iExchangeManager
+	orderManager    iLedgerOrderManager
+	repository      ledgerentry.IDBService
+	ledger          *ledger.Ledger
+	checkInterval   time.Duration
+	verbose         bool
+	// booked holds the fills of each order recorded from order manager
+	// updates, keyed by exchange and order ID
+	booked map[string]bookedOrder
+}
+
+// bookedOrder is the executed amount, quote cost and fee of an order which
+// has been recorded in the ledger
+type bookedOrder struct {
+	amount float64
+	cost   float64
+	fee    float64
+}
@@ -0,0 +1,417 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMetricsManager creates a metrics manager subsystem
+func SetupMetricsManager(cfg *config.MetricsManager, source iMetricsSource) (*MetricsManager, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w MetricsManager", errNilConfig)
+	}
+	if source == nil {
+		return nil, errNilBot
+	}
+	if cfg.ListenAddress == "" {
+		cfg.ListenAddress = defaultMetricsListenAddress
+	}
+	return &MetricsManager{
+		verbose:        cfg.Verbose,
+		listenAddress:  cfg.ListenAddress,
+		source:         source,
+		requests:       make(map[restEndpoint]*restStats),
+		restEndpoints:  make(map[string]int),
+		rateLimitWaits: make(map[string]*durationStats),
+		websockets:     make(map[string]*websocketStats),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MetricsManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem, setting the global request and websocket
+// reporters and serving metrics on the listen address
+func (m *MetricsManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("metrics manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("metrics manager %w", ErrSubSystemAlreadyStarted)
+	}
+	l, err := net.Listen("tcp", m.listenAddress)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
+	// Store the bound address so an ephemeral port can be discovered
+	m.listenAddress = l.Addr().String()
+	router := http.NewServeMux()
+	router.HandleFunc(metricsPath, m.serveMetrics)
+	m.server = &http.Server{
+		Handler:           router,
+		ReadHeaderTimeout: time.Minute,
+	}
+	request.SetupGlobalReporter(&requestReporter{m: m})
+	websocket.SetupGlobalReporter(&websocketReporter{m: m})
+	log.Debugf(log.Global, "Metrics manager starting, serving on http://%s%s", m.listenAddress, metricsPath)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := m.server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Metrics manager server error: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *MetricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("metrics manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("metrics manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.Global, "Metrics manager shutting down...")
+	request.SetupGlobalReporter(nil)
+	websocket.SetupGlobalReporter(nil)
+	err := m.server.Shutdown(context.Background())
+	m.wg.Wait()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	log.Debugln(log.Global, "Metrics manager shutdown.")
+	return nil
+}
+
+// serveMetrics writes the current metrics to an HTTP response
+func (m *MetricsManager) serveMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", metricsContentType)
+	if err := m.writeMetrics(w); err != nil {
+		log.Errorf(log.Global, "Metrics manager unable to write metrics: %v", err)
+	}
+}
+
+// writeMetrics writes every metric family in the Prometheus text format
+func (m *MetricsManager) writeMetrics(w io.Writer) error {
+	families := append(m.reportedMetrics(), m.engineMetrics()...)
+	var b bytes.Buffer
+	for _, f := range families {
+		f.write(&b)
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// reportedMetrics returns the metric families aggregated from reporter events
+func (m *MetricsManager) reportedMetrics() []*metricFamily {
+	restLatency := newMetricFamily("rest_request_duration_seconds", "REST request latency per exchange endpoint", "summary")
+	restErrors := newMetricFamily("rest_request_errors_total", "Failed REST requests per exchange endpoint and status code, status code none when no response was received", "counter")
+	rateLimitWait := newMetricFamily("rate_limiter_wait_seconds", "Time spent waiting on the REST rate limiter per exchange", "summary")
+	wsMessages := newMetricFamily("websocket_messages_received_total", "Websocket messages received per exchange", "counter")
+	wsReconnects := newMetricFamily("websocket_reconnects_total", "Websocket reconnections per exchange", "counter")
+	wsTimeouts := newMetricFamily("websocket_traffic_timeouts_total", "Websocket connections reset after no traffic was received within the traffic timeout per exchange", "counter")
+	wsLatency := newMetricFamily("websocket_request_duration_seconds", "Websocket request and response latency per exchange", "summary")
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	for k, s := range m.requests {
+		labels := []string{"exchange", k.exchange, "method", k.method, "endpoint", k.endpoint}
+		restLatency.addDuration(&s.latency, labels...)
+		for code, count := range s.errors {
+			status := "none"
+			if code != 0 {
+				status = strconv.Itoa(code)
+			}
+			restErrors.add("", float64(count), append(labels, "status_code", status)...)
+		}
+	}
+	for exch, s := range m.rateLimitWaits {
+		rateLimitWait.addDuration(s, "exchange", exch)
+	}
+	for exch, s := range m.websockets {
+		wsMessages.add("", float64(s.messages), "exchange", exch)
+		wsReconnects.add("", float64(s.reconnects), "exchange", exch)
+		wsTimeouts.add("", float64(s.trafficTimeouts), "exchange", exch)
+		wsLatency.addDuration(&s.latency, "exchange", exch)
+	}
+	return []*metricFamily{restLatency, restErrors, rateLimitWait, wsMessages, wsReconnects, wsTimeouts, wsLatency}
+}
+
+// engineMetrics returns the metric families read from engine state
+func (m *MetricsManager) engineMetrics() []*metricFamily {
+	wsConnected := newMetricFamily("websocket_connected", "Whether an enabled exchange websocket is connected", "gauge")
+	for _, exch := range m.source.GetExchanges() {
+		ws, err := exch.GetWebsocket()
+		if err != nil || ws == nil || !ws.IsEnabled() {
+			continue
+		}
+		connected := 0.0
+		if ws.IsConnected() {
+			connected = 1
+		}
+		wsConnected.add("", connected, "exchange", exch.GetName())
+	}
+
+	staleness := newMetricFamily("sync_staleness_seconds", "Seconds since a synced item was last updated per exchange, asset and pair", "gauge")
+	now := time.Now()
+	for _, s := range m.source.syncStatuses() {
+		if !s.HaveData {
+			continue
+		}
+		staleness.add("", now.Sub(s.LastUpdated).Seconds(),
+			"exchange", s.Key.Exchange,
+			"asset", s.Key.Asset.String(),
+			"pair", s.Key.Pair().String(),
+			"item", strings.ToLower(s.Item.String()))
+	}
+
+	orders := newMetricFamily("orders", "Orders held by the order manager per exchange and status", "gauge")
+	for exch, counts := range m.source.orderStatusCounts() {
+		for status, count := range counts {
+			orders.add("", float64(count), "exchange", exch, "status", status.String())
+		}
+	}
+
+	depth, capacity := dispatch.QueueDepth()
+	dispatchDepth := newMetricFamily("dispatch_queue_depth", "Jobs waiting to be relayed by the dispatch system", "gauge")
+	dispatchDepth.add("", float64(depth))
+	dispatchCapacity := newMetricFamily("dispatch_queue_capacity", "Capacity of the dispatch system job queue", "gauge")
+	dispatchCapacity.add("", float64(capacity))
+
+	mismatches := newMetricFamily("orderbook_checksum_mismatches_total", "Orderbook checksum verification failures per exchange", "counter")
+	resyncs := newMetricFamily("orderbook_resyncs_total", "Orderbook resyncs per exchange", "counter")
+	resyncFailures := newMetricFamily("orderbook_resync_failures_total", "Failed orderbook resyncs per exchange", "counter")
+	for exch, s := range orderbook.GetAllDesyncStats() {
+		mismatches.add("", float64(s.ChecksumMismatches), "exchange", exch)
+		resyncs.add("", float64(s.Resyncs), "exchange", exch)
+		resyncFailures.add("", float64(s.ResyncFailures), "exchange", exch)
+	}
+
+	return []*metricFamily{wsConnected, staleness, orders, dispatchDepth, dispatchCapacity, mismatches, resyncs, resyncFailures}
+}
+
+// getWebsocketStats returns the stats for an exchange websocket, creating them
+// if needed
+// NOTE: This requires locking.
+func (m *MetricsManager) getWebsocketStats(exch string) *websocketStats {
+	s, ok := m.websockets[exch]
+	if !ok {
+		s = &websocketStats{}
+		m.websockets[exch] = s
+	}
+	return s
+}
+
+// getRESTStats returns the stats for an exchange endpoint, creating them if
+// needed. Once an exchange has maxRESTEndpoints endpoints further endpoints
+// share the otherRESTEndpoint stats
+// NOTE: This requires locking.
+func (m *MetricsManager) getRESTStats(exch, method, path string) *restStats {
+	k := restEndpoint{exchange: exch, method: method, endpoint: endpointFromPath(path)}
+	if s, ok := m.requests[k]; ok {
+		return s
+	}
+	if m.restEndpoints[exch] >= maxRESTEndpoints {
+		k.endpoint = otherRESTEndpoint
+		if s, ok := m.requests[k]; ok {
+			return s
+		}
+	} else {
+		m.restEndpoints[exch]++
+	}
+	s := &restStats{errors: make(map[int]uint64)}
+	m.requests[k] = s
+	return s
+}
+
+// Latency records the latency of a REST request
+func (r *requestReporter) Latency(name, method, path string, t time.Duration) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	r.m.getRESTStats(name, method, path).latency.observe(t)
+	r.m.m.Unlock()
+}
+
+// RequestError records a failed REST request
+func (r *requestReporter) RequestError(name, method, path string, statusCode int) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	r.m.getRESTStats(name, method, path).errors[statusCode]++
+	r.m.m.Unlock()
+}
+
+// RateLimitWait records the time a REST request waited on the rate limiter
+func (r *requestReporter) RateLimitWait(name string, t time.Duration) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	s, ok := r.m.rateLimitWaits[name]
+	if !ok {
+		s = &durationStats{}
+		r.m.rateLimitWaits[name] = s
+	}
+	s.observe(t)
+	r.m.m.Unlock()
+}
+
+// Latency records the latency of a websocket request
+func (r *websocketReporter) Latency(name string, _ []byte, t time.Duration) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	r.m.getWebsocketStats(name).latency.observe(t)
+	r.m.m.Unlock()
+}
+
+// MessageReceived records a received websocket message
+func (r *websocketReporter) MessageReceived(name string) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	r.m.getWebsocketStats(name).messages++
+	r.m.m.Unlock()
+}
+
+// Reconnected records a websocket reconnection
+func (r *websocketReporter) Reconnected(name string) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	r.m.getWebsocketStats(name).reconnects++
+	r.m.m.Unlock()
+}
+
+// TrafficTimeout records a websocket traffic timeout
+func (r *websocketReporter) TrafficTimeout(name string) {
+	if !r.m.IsRunning() {
+		return
+	}
+	r.m.m.Lock()
+	r.m.getWebsocketStats(name).trafficTimeouts++
+	r.m.m.Unlock()
+}
+
+func (d *durationStats) observe(t time.Duration) {
+	d.count++
+	d.sum += t
+}
+
+// endpointFromPath returns the host and path of a request URL, dropping the
+// scheme and query and replacing path parameters so that requests to the same
+// endpoint share labels
+func endpointFromPath(path string) string {
+	var host string
+	endpoint, _, _ := strings.Cut(path, "?")
+	if u, err := url.Parse(path); err == nil && u.Host != "" {
+		host, endpoint = u.Host, u.Path
+	}
+	segments := strings.Split(endpoint, "/")
+	for i := range segments {
+		if isPathParam(segments[i]) {
+			segments[i] = pathParamLabel
+		}
+	}
+	return host + strings.Join(segments, "/")
+}
+
+// isPathParam returns whether a path segment looks like an identifier, such as
+// an order ID, timestamp or dated contract, rather than part of the endpoint.
+// API version segments are kept
+func isPathParam(segment string) bool {
+	if !strings.ContainsAny(segment, "0123456789") {
+		return false
+	}
+	version := strings.TrimPrefix(strings.ToLower(segment), "v")
+	_, err := strconv.ParseFloat(version, 64)
+	return err != nil || len(version) > 3
+}
+
+func newMetricFamily(name, help, kind string) *metricFamily {
+	return &metricFamily{name: metricsPrefix + name, help: help, kind: kind}
+}
+
+// add adds a sample, labels are supplied as alternating names and values
+func (f *metricFamily) add(suffix string, value float64, labels ...string) {
+	f.samples = append(f.samples, metricSample{suffix: suffix, labels: formatLabels(labels), value: value})
+}
+
+// addDuration adds the sum and count samples of a summary
+func (f *metricFamily) addDuration(d *durationStats, labels ...string) {
+	f.add("_sum", d.sum.Seconds(), labels...)
+	f.add("_count", float64(d.count), labels...)
+}
+
+// write writes the family in the Prometheus text format, families without
+// samples are omitted
+func (f *metricFamily) write(b *bytes.Buffer) {
+	if len(f.samples) == 0 {
+		return
+	}
+	slices.SortFunc(f.samples, func(a, b metricSample) int {
+		if c := strings.Compare(a.labels, b.labels); c != 0 {
+			return c
+		}
+		return strings.Compare(a.suffix, b.suffix)
+	})
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind)
+	for i := range f.samples {
+		b.WriteString(f.name)
+		b.WriteString(f.samples[i].suffix)
+		b.WriteString(f.samples[i].labels)
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatFloat(f.samples[i].value, 'g', -1, 64))
+		b.WriteByte('\n')
+	}
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels formats alternating label names and values as a label set
+func formatLabels(labels []string) string {
+	if len(labels) < 2 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i+1 < len(labels); i += 2 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(labels[i])
+		sb.WriteString(`="`)
+		sb.WriteString(labelValueReplacer.Replace(labels[i+1]))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
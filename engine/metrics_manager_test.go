@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+type fakeMetricsSource struct {
+	statuses []syncStatus
+	counts   map[string]map[order.Status]int
+}
+
+func (f *fakeMetricsSource) GetExchanges() []exchange.IBotExchange { return nil }
+
+func (f *fakeMetricsSource) syncStatuses() []syncStatus { return f.statuses }
+
+func (f *fakeMetricsSource) orderStatusCounts() map[string]map[order.Status]int { return f.counts }
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMetricsManager(nil, &fakeMetricsSource{})
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupMetricsManager(&config.MetricsManager{}, nil)
+	assert.ErrorIs(t, err, errNilBot)
+
+	m, err := SetupMetricsManager(&config.MetricsManager{}, &fakeMetricsSource{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.Equal(t, defaultMetricsListenAddress, m.listenAddress, "SetupMetricsManager should default the listen address")
+}
+
+func TestMetricsManagerStartStop(t *testing.T) {
+	var m *MetricsManager
+	assert.False(t, m.IsRunning(), "IsRunning should return false for a nil manager")
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+
+	m, err := SetupMetricsManager(&config.MetricsManager{ListenAddress: "localhost:0"}, &fakeMetricsSource{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+
+	// Requesters created before the manager starts report to it while it runs
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }))
+	defer target.Close()
+	requester, err := request.New("metricsTest", new(http.Client), request.WithLimiter(request.NewBasicRateLimit(time.Second, 100, 1)))
+	require.NoError(t, err, "request.New must not error")
+	send := func() {
+		t.Helper()
+		err := requester.SendPayload(t.Context(), request.Unset, func() (*request.Item, error) {
+			return &request.Item{Method: http.MethodGet, Path: target.URL}, nil
+		}, request.UnauthenticatedRequest)
+		require.NoError(t, err, "SendPayload must not error")
+	}
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://"+m.listenAddress+metricsPath, http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "Do must not error")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	require.NoError(t, resp.Body.Close(), "Close must not error")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, metricsContentType, resp.Header.Get("Content-Type"))
+	assert.Contains(t, string(body), "gct_dispatch_queue_capacity ", "metrics should be served")
+
+	send()
+	m.m.Lock()
+	assert.Len(t, m.requests, 1, "existing requesters should report to the started manager")
+	m.m.Unlock()
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+	send()
+	m.m.Lock()
+	for _, stats := range m.requests {
+		assert.Equal(t, uint64(1), stats.latency.count, "requests should not be reported once the manager has stopped")
+	}
+	m.m.Unlock()
+}
+
+func TestMetricsManagerReporters(t *testing.T) {
+	t.Parallel()
+	m, err := SetupMetricsManager(&config.MetricsManager{}, &fakeMetricsSource{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	rr := &requestReporter{m: m}
+	wr := &websocketReporter{m: m}
+
+	rr.Latency("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd?limit=1", time.Second)
+	wr.MessageReceived("Bitstamp")
+	m.m.Lock()
+	assert.Empty(t, m.requests, "events should not be recorded when the manager is not running")
+	assert.Empty(t, m.websockets, "events should not be recorded when the manager is not running")
+	m.m.Unlock()
+
+	atomic.StoreInt32(&m.started, 1)
+	rr.Latency("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd?limit=1", time.Second)
+	rr.Latency("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd?limit=2", time.Second*2)
+	rr.RequestError("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd", 0)
+	rr.RequestError("Bitstamp", http.MethodGet, "https://www.bitstamp.net/api/v2/ticker/btcusd", http.StatusTooManyRequests)
+	rr.RateLimitWait("Bitstamp", time.Millisecond*500)
+	wr.Latency("Bitstamp", nil, time.Millisecond*250)
+	wr.MessageReceived("Bitstamp")
+	wr.MessageReceived("Bitstamp")
+	wr.Reconnected("Bitstamp")
+	wr.TrafficTimeout("Bitstamp")
+
+	var b bytes.Buffer
+	require.NoError(t, m.writeMetrics(&b), "writeMetrics must not error")
+	out := b.String()
+	for _, line := range []string{
+		"# TYPE gct_rest_request_duration_seconds summary\n",
+		`gct_rest_request_duration_seconds_count{exchange="Bitstamp",method="GET",endpoint="www.bitstamp.net/api/v2/ticker/btcusd"} 2` + "\n",
+		`gct_rest_request_duration_seconds_sum{exchange="Bitstamp",method="GET",endpoint="www.bitstamp.net/api/v2/ticker/btcusd"} 3` + "\n",
+		`gct_rest_request_errors_total{exchange="Bitstamp",method="GET",endpoint="www.bitstamp.net/api/v2/ticker/btcusd",status_code="429"} 1` + "\n",
+		`gct_rest_request_errors_total{exchange="Bitstamp",method="GET",endpoint="www.bitstamp.net/api/v2/ticker/btcusd",status_code="none"} 1` + "\n",
+		`gct_rate_limiter_wait_seconds_sum{exchange="Bitstamp"} 0.5` + "\n",
+		`gct_websocket_messages_received_total{exchange="Bitstamp"} 2` + "\n",
+		`gct_websocket_reconnects_total{exchange="Bitstamp"} 1` + "\n",
+		`gct_websocket_traffic_timeouts_total{exchange="Bitstamp"} 1` + "\n",
+		`gct_websocket_request_duration_seconds_sum{exchange="Bitstamp"} 0.25` + "\n",
+	} {
+		assert.Contains(t, out, line, "writeMetrics should write the reported metrics")
+	}
+}
+
+func TestMetricsManagerEngineMetrics(t *testing.T) {
+	t.Parallel()
+	k := key.ExchangePairAsset{Exchange: "Bitstamp", Base: currency.BTC.Item, Quote: currency.USD.Item, Asset: asset.Spot}
+	m, err := SetupMetricsManager(&config.MetricsManager{}, &fakeMetricsSource{
+		statuses: []syncStatus{
+			{Key: k, Item: SyncItemTicker, LastUpdated: time.Now().Add(-time.Minute), HaveData: true},
+			{Key: k, Item: SyncItemOrderbook},
+		},
+		counts: map[string]map[order.Status]int{"bitstamp": {order.New: 2, order.Filled: 1}},
+	})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+
+	rec := httptest.NewRecorder()
+	m.serveMetrics(rec, httptest.NewRequest(http.MethodGet, metricsPath, http.NoBody))
+	assert.Equal(t, metricsContentType, rec.Header().Get("Content-Type"))
+	out := rec.Body.String()
+	assert.Contains(t, out, `gct_sync_staleness_seconds{exchange="Bitstamp",asset="spot",pair="BTCUSD",item="ticker"} 6`, "serveMetrics should write sync staleness")
+	assert.NotContains(t, out, `item="orderbook"`, "serveMetrics should not write staleness for items without data")
+	assert.Contains(t, out, `gct_orders{exchange="bitstamp",status="NEW"} 2`+"\n", "serveMetrics should write order counts")
+	assert.Contains(t, out, `gct_orders{exchange="bitstamp",status="FILLED"} 1`+"\n", "serveMetrics should write order counts")
+	assert.Contains(t, out, "gct_dispatch_queue_depth ", "serveMetrics should write the dispatch queue depth")
+	assert.NotContains(t, out, "gct_rest_request_duration_seconds", "serveMetrics should omit families without samples")
+}
+
+func TestFormatLabels(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, formatLabels(nil), "formatLabels should return an empty string without labels")
+	assert.Equal(t, `{a="1",b="\"q\" \\ \n"}`, formatLabels([]string{"a", "1", "b", "\"q\" \\ \n"}), "formatLabels should escape label values")
+}
+
+func TestEndpointFromPath(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "api.exchange.com/v1/orders", endpointFromPath("https://api.exchange.com/v1/orders?id=1"))
+	assert.Equal(t, "/v1/orders", endpointFromPath("/v1/orders?id=1"))
+	assert.Equal(t, "api.exchange.com/api/v3/orders/{param}/fills", endpointFromPath("https://api.exchange.com/api/v3/orders/1f2e3d4c-5b6a/fills"), "endpointFromPath should replace identifiers")
+	assert.Equal(t, "/v2.1/ticker/{param}", endpointFromPath("/v2.1/ticker/BTC-USD-230929"), "endpointFromPath should keep versions and replace dated contracts")
+	assert.Equal(t, "/0/private/{param}", endpointFromPath("/0/private/1700000000"), "endpointFromPath should replace timestamps")
+}
+
+func TestGetRESTStatsEndpointCap(t *testing.T) {
+	t.Parallel()
+	m, err := SetupMetricsManager(&config.MetricsManager{}, &fakeMetricsSource{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	for i := range maxRESTEndpoints + 10 {
+		m.getRESTStats("meow", http.MethodGet, "/v1/"+strings.Repeat("a", i+1)).latency.observe(time.Second)
+	}
+	assert.Len(t, m.requests, maxRESTEndpoints+1, "getRESTStats should cap the endpoints tracked per exchange")
+	other := m.requests[restEndpoint{exchange: "meow", method: http.MethodGet, endpoint: otherRESTEndpoint}]
+	require.NotNil(t, other, "getRESTStats must track endpoints above the cap")
+	assert.Equal(t, uint64(10), other.latency.count, "getRESTStats should count endpoints above the cap together")
+	m.getRESTStats("purr", http.MethodGet, "/v1/a")
+	assert.Len(t, m.requests, maxRESTEndpoints+2, "getRESTStats should cap endpoints per exchange")
+}
@@ -0,0 +1,107 @@
+package engine
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MetricsManagerName is an exported subsystem name
+const MetricsManagerName = "metrics_manager"
+
+const (
+	defaultMetricsListenAddress = "localhost:9091"
+	metricsPath                 = "/metrics"
+	metricsContentType          = "text/plain; version=0.0.4; charset=utf-8"
+	metricsPrefix               = "gct_"
+	// maxRESTEndpoints caps the distinct endpoints tracked per exchange,
+	// further endpoints are counted under otherRESTEndpoint
+	maxRESTEndpoints  = 256
+	otherRESTEndpoint = "other"
+	// pathParamLabel replaces path segments which carry request parameters
+	pathParamLabel = "{param}"
+)
+
+// iMetricsSource defines the engine state which is read on each scrape
+type iMetricsSource interface {
+	GetExchanges() []exchange.IBotExchange
+	syncStatuses() []syncStatus
+	orderStatusCounts() map[string]map[order.Status]int
+}
+
+// MetricsManager aggregates the latency, error and connection events reported
+// by exchange requesters and websockets, and serves them alongside engine
+// state on an HTTP metrics endpoint in the Prometheus text format
+type MetricsManager struct {
+	started       int32
+	verbose       bool
+	listenAddress string
+	source        iMetricsSource
+	server        *http.Server
+	wg            sync.WaitGroup
+
+	m              sync.Mutex
+	requests       map[restEndpoint]*restStats
+	restEndpoints  map[string]int
+	rateLimitWaits map[string]*durationStats
+	websockets     map[string]*websocketStats
+}
+
+// restEndpoint identifies a REST endpoint of an exchange
+type restEndpoint struct {
+	exchange string
+	method   string
+	endpoint string
+}
+
+// restStats holds the latency and failures of a REST endpoint
+type restStats struct {
+	latency durationStats
+	// errors is keyed by HTTP status code, zero when no response was received
+	errors map[int]uint64
+}
+
+// websocketStats holds the events reported by an exchange websocket
+type websocketStats struct {
+	messages        uint64
+	reconnects      uint64
+	trafficTimeouts uint64
+	latency         durationStats
+}
+
+// durationStats accumulates observed durations which are exposed as a summary
+// without quantiles
+type durationStats struct {
+	count uint64
+	sum   time.Duration
+}
+
+// requestReporter adapts the metrics manager to the request package reporter
+// interfaces
+type requestReporter struct {
+	m *MetricsManager
+}
+
+// websocketReporter adapts the metrics manager to the websocket package
+// reporter interfaces
+type websocketReporter struct {
+	m *MetricsManager
+}
+
+// metricFamily is a set of samples which share a name, help text and type
+type metricFamily struct {
+	name    string
+	help    string
+	kind    string
+	samples []metricSample
+}
+
+// metricSample is a single metric value with its formatted labels
+type metricSample struct {
+	suffix string
+	labels string
+	value  float64
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -43,11 +44,13 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 		return nil, errInvalidFuturesTrackingSeekDuration
 	}
 
+	risk, err := newRiskManager(&cfg.Risk, cfg.ActivelyTrackFuturesPositions)
+	if err != nil {
+		return nil, err
+	}
+
 	om := &OrderManager{
-		shutdown:                      make(chan struct{}),
-		activelyTrackFuturesPositions: cfg.ActivelyTrackFuturesPositions,
-		futuresPositionSeekDuration:   cfg.FuturesTrackingSeekDuration,
-		respectOrderHistoryLimits:     cfg.RespectOrderHistoryLimits,
+		shutdown: make(chan struct{}),
 		orderStore: store{
 			Orders:                    make(map[string][]*order.Detail),
 			exchangeManager:           exchangeManager,
@@ -55,14 +58,66 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			wg:                        wg,
 			futuresPositionController: futures.SetupPositionController(),
 		},
-		verbose: cfg.Verbose,
-		cfg: orderManagerConfig{
-			CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
-		},
+		risk: risk,
 	}
+	om.storeSettings(cfg)
 	return om, nil
 }
 
+// UpdateConfig updates the settings of the order manager while it is running.
+// The risk config is validated against the new futures tracking setting but is
+// only updated via UpdateRiskConfig. Order persistence is only set up before
+// the order manager starts, so PersistOrders is not updated
+func (m *OrderManager) UpdateConfig(cfg *config.OrderManager) error {
+	if m == nil || m.risk == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w OrderManager", errNilConfig)
+	}
+	if cfg.ActivelyTrackFuturesPositions && cfg.FuturesTrackingSeekDuration <= 0 {
+		return errInvalidFuturesTrackingSeekDuration
+	}
+	if err := validateRiskConfig(&cfg.Risk, cfg.ActivelyTrackFuturesPositions); err != nil {
+		return err
+	}
+	m.storeSettings(cfg)
+	log.Infoln(log.OrderMgr, "Order manager config updated")
+	return nil
+}
+
+// storeSettings stores the order manager settings which can be changed while
+// it is running
+func (m *OrderManager) storeSettings(cfg *config.OrderManager) {
+	m.verbose.Store(cfg.Verbose)
+	m.activelyTrackFuturesPositions.Store(cfg.ActivelyTrackFuturesPositions)
+	m.futuresPositionSeekDuration.Store(int64(cfg.FuturesTrackingSeekDuration))
+	m.respectOrderHistoryLimits.Store(cfg.RespectOrderHistoryLimits)
+	m.cancelOrdersOnShutdown.Store(cfg.CancelOrdersOnShutdown)
+}
+
+// SetupOrderPersistence enables writing all tracked orders through to the
+// database. Persisted orders which are still active are loaded back into the
+// order store when the subsystem starts and are then reconciled against the
+// exchange
+func (m *OrderManager) SetupOrderPersistence(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	repo, err := dborder.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.orderRepository = repo
+	return nil
+}
+
 // IsRunning safely checks whether the subsystem is running
 func (m *OrderManager) IsRunning() bool {
 	return m != nil && atomic.LoadInt32(&m.started) == 1
@@ -73,6 +128,12 @@ func (m *OrderManager) Start() error {
 	if m == nil {
 		return fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	// Persisted orders are loaded before the manager is marked as started so
+	// they are in the order store for any submission or cancellation
+	m.loadPersistedOrders()
 	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
 		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
 	}
@@ -99,7 +160,7 @@ func (m *OrderManager) Stop() error {
 
 // gracefulShutdown cancels all orders (if enabled) before shutting down
 func (m *OrderManager) gracefulShutdown() {
-	if !m.cfg.CancelOrdersOnShutdown {
+	if !m.cancelOrdersOnShutdown.Load() {
 		return
 	}
 	log.Debugln(log.OrderMgr, "Cancelling any open orders...")
@@ -221,6 +282,7 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		err = fmt.Errorf("%v - Failed to update existing order when cancelled: %w", cancel.Exchange, err)
 		return err
 	}
+	m.persistOrder(od)
 
 	msg := fmt.Sprintf("Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.OrderID)
@@ -257,7 +319,7 @@ func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair
 	if !item.IsFutures() {
 		return nil, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
-	if !m.activelyTrackFuturesPositions {
+	if !m.activelyTrackFuturesPositions.Load() {
 		return nil, errFuturesTrackingDisabled
 	}
 	return m.orderStore.futuresPositionController.GetOpenPosition(exch, item, pair)
@@ -272,7 +334,7 @@ func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error)
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	if !m.activelyTrackFuturesPositions {
+	if !m.activelyTrackFuturesPositions.Load() {
 		return nil, errFuturesTrackingDisabled
 	}
 	return m.orderStore.futuresPositionController.GetAllOpenPositions()
@@ -338,6 +400,7 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	if err != nil {
 		return order.Detail{}, err
 	}
+	m.persistOrder(&upsertResponse.OrderDetails)
 
 	return upsertResponse.OrderDetails, nil
 }
@@ -348,6 +411,10 @@ func (m *OrderManager) validate(exch exchange.IBotExchange, newOrder *order.Subm
 		return errNilOrder
 	}
 
+	if m.risk.isKillSwitchActive() {
+		return ErrKillSwitchActive
+	}
+
 	if newOrder.Exchange == "" {
 		return ErrExchangeNameIsEmpty
 	}
@@ -374,7 +441,7 @@ func (m *OrderManager) validate(exch exchange.IBotExchange, newOrder *order.Subm
 			return errors.New("order pair not found in allowed list")
 		}
 	}
-	return nil
+	return m.checkRisk(exch, newOrder, "")
 }
 
 // Modify depends on the order.Modify.ID and order.Modify.Exchange fields to uniquely
@@ -413,6 +480,12 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	if err != nil {
 		return nil, err
 	}
+	if m.risk.isKillSwitchActive() {
+		return nil, ErrKillSwitchActive
+	}
+	if err = m.checkRisk(exch, modifiedOrder(det, mod), det.OrderID); err != nil {
+		return nil, err
+	}
 	res, err := exch.ModifyOrder(ctx, mod)
 	if err != nil {
 		message := fmt.Sprintf(
@@ -432,6 +505,11 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	// XXX: This comes with a race condition, because [request -> changes] are not
 	// atomic.
 	err = m.orderStore.modifyExisting(mod.OrderID, res)
+	if err == nil {
+		if modified, getErr := m.orderStore.getByExchangeAndID(mod.Exchange, res.OrderID); getErr == nil {
+			m.persistOrder(modified)
+		}
+	}
 
 	// Notify observers.
 	var message string
@@ -555,6 +633,15 @@ func (m *OrderManager) GetOrdersSnapshot(s order.Status) []order.Detail {
 	return os
 }
 
+// orderStatusCounts returns the amount of stored orders per exchange and
+// status
+func (m *OrderManager) orderStatusCounts() map[string]map[order.Status]int {
+	if !m.IsRunning() {
+		return nil
+	}
+	return m.orderStore.statusCounts()
+}
+
 // GetOrdersFiltered returns a snapshot of all orders in the order store.
 // Filtering is applied based on the order.Filter unless entries are empty
 func (m *OrderManager) GetOrdersFiltered(f *order.Filter) ([]order.Detail, error) {
@@ -602,6 +689,7 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 		// Non-fatal error: Unable to store order, but error does not need to be returned to caller
 		log.Errorf(log.OrderMgr, "Unable to add %v order %v to orderStore: %s", detail.Exchange, detail.OrderID, err)
 	}
+	m.persistOrder(detail)
 
 	msg := fmt.Sprintf("Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v quoteAmount=%v side=%v type=%v for time %v.",
 		detail.Exchange,
@@ -640,7 +728,7 @@ func (m *OrderManager) processOrders() {
 		if !exchanges[x].IsRESTAuthenticationSupported() {
 			continue
 		}
-		if m.verbose {
+		if m.verbose.Load() {
 			log.Debugf(log.OrderMgr,
 				"Processing orders for exchange %v",
 				exchanges[x].GetName())
@@ -659,7 +747,7 @@ func (m *OrderManager) processOrders() {
 			}
 
 			if len(pairs) == 0 {
-				if m.verbose {
+				if m.verbose.Load() {
 					log.Debugf(log.OrderMgr,
 						"No pairs enabled for %s and asset type %s, skipping...",
 						exchanges[x].GetName(),
@@ -709,7 +797,7 @@ func (m *OrderManager) processOrders() {
 			}
 
 			supportedFeatures := exchanges[x].GetSupportedFeatures()
-			if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
+			if m.activelyTrackFuturesPositions.Load() && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
 				var positions []futures.PositionResponse
 				var sd time.Time
 				sd, err = m.orderStore.futuresPositionController.LastUpdated()
@@ -718,13 +806,13 @@ func (m *OrderManager) processOrders() {
 					return
 				}
 				if sd.IsZero() {
-					sd = time.Now().Add(-m.futuresPositionSeekDuration)
+					sd = time.Now().Add(-time.Duration(m.futuresPositionSeekDuration.Load()))
 				}
 				positions, err = exchanges[x].GetFuturesPositionOrders(context.TODO(), &futures.PositionsRequest{
 					Asset:                     enabledAssets[y],
 					Pairs:                     pairs,
 					StartDate:                 sd,
-					RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
+					RespectOrderHistoryLimits: m.respectOrderHistoryLimits.Load(),
 				})
 				if err != nil {
 					if !errors.Is(err, common.ErrNotYetImplemented) {
@@ -745,14 +833,14 @@ func (m *OrderManager) processOrders() {
 		}
 	}
 	wg.Wait()
-	if m.verbose {
+	if m.verbose.Load() {
 		log.Debugf(log.OrderMgr, "Finished processing orders")
 	}
 }
 
 // processFuturesPositions ensures any open position found is kept up to date in the order manager
 func (m *OrderManager) processFuturesPositions(exch exchange.IBotExchange, position *futures.PositionResponse) error {
-	if !m.activelyTrackFuturesPositions {
+	if !m.activelyTrackFuturesPositions.Load() {
 		return errFuturesTrackingDisabled
 	}
 	if exch == nil {
@@ -859,7 +947,11 @@ func (m *OrderManager) Add(o *order.Detail) error {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 
-	return m.orderStore.add(o)
+	if err := m.orderStore.add(o); err != nil {
+		return err
+	}
+	m.persistOrder(o)
+	return nil
 }
 
 // GetByExchangeAndID returns a copy of an order from an exchange if it matches the ID
@@ -881,7 +973,13 @@ func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.updateExisting(od)
+	if err := m.orderStore.updateExisting(od); err != nil {
+		return err
+	}
+	if updated, err := m.orderStore.getByExchangeAndID(od.Exchange, od.OrderID); err == nil {
+		m.persistOrder(updated)
+	}
+	return nil
 }
 
 // UpsertOrder updates an existing order or adds a new one to the orderstore
@@ -915,6 +1013,8 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 		return nil, err
 	}
 
+	m.persistOrder(&upsertResponse.OrderDetails)
+
 	status := "updated"
 	if upsertResponse.IsNewOrder {
 		status = "added"
@@ -931,6 +1031,122 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	return upsertResponse, nil
 }
 
+// persistOrder writes an order through to the database if order persistence is
+// enabled. Failures are logged as the order store remains the source of truth
+func (m *OrderManager) persistOrder(od *order.Detail) {
+	if m.orderRepository == nil || od == nil {
+		return
+	}
+	err := m.orderRepository.Upsert(orderDetailToDBDetails(od))
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Unable to persist %v order %v: %v", od.Exchange, od.OrderID, err)
+	}
+}
+
+// loadPersistedOrders loads all active orders from the database into the
+// order store so they can be reconciled against exchange state on the next
+// processOrders cycle
+func (m *OrderManager) loadPersistedOrders() {
+	if m.orderRepository == nil {
+		return
+	}
+	statuses := []string{order.UnknownStatus.String()}
+	for _, s := range []order.Status{order.Active, order.Open, order.PartiallyFilled, order.New, order.PendingCancel, order.Hidden, order.AutoDeleverage, order.Pending} {
+		statuses = append(statuses, s.String())
+	}
+	persisted, err := m.orderRepository.GetByStatus(statuses...)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Unable to load persisted orders: %v", err)
+		return
+	}
+	var loaded int
+	for i := range persisted {
+		var od *order.Detail
+		od, err = dbDetailsToOrderDetail(&persisted[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Unable to load persisted %v order %v: %v", persisted[i].Exchange, persisted[i].OrderID, err)
+			continue
+		}
+		err = m.orderStore.add(od)
+		if err != nil {
+			if !errors.Is(err, ErrOrdersAlreadyExists) {
+				log.Errorf(log.OrderMgr, "Unable to add persisted %v order %v to orderStore: %v", od.Exchange, od.OrderID, err)
+			}
+			continue
+		}
+		loaded++
+	}
+	if loaded > 0 {
+		log.Infof(log.OrderMgr, "Loaded %d persisted active orders", loaded)
+	}
+}
+
+// orderDetailToDBDetails converts an order detail to its database
+// representation
+func orderDetailToDBDetails(od *order.Detail) *dborder.Details {
+	d := &dborder.Details{
+		Exchange:             od.Exchange,
+		OrderID:              od.OrderID,
+		ClientOrderID:        od.ClientOrderID,
+		Asset:                od.AssetType.String(),
+		Base:                 od.Pair.Base.String(),
+		Quote:                od.Pair.Quote.String(),
+		Side:                 od.Side.String(),
+		Type:                 od.Type.String(),
+		Status:               od.Status.String(),
+		Price:                od.Price,
+		Amount:               od.Amount,
+		ExecutedAmount:       od.ExecutedAmount,
+		RemainingAmount:      od.RemainingAmount,
+		AverageExecutedPrice: od.AverageExecutedPrice,
+		Fee:                  od.Fee,
+		Cost:                 od.Cost,
+		Date:                 od.Date,
+		LastUpdated:          od.LastUpdated,
+	}
+	if !od.InternalOrderID.IsNil() {
+		d.ID = od.InternalOrderID.String()
+	}
+	return d
+}
+
+// dbDetailsToOrderDetail converts a persisted order back to an order detail.
+// Unrecognised sides, types and statuses are left unknown to be resolved when
+// the order is reconciled against the exchange
+func dbDetailsToOrderDetail(d *dborder.Details) (*order.Detail, error) {
+	a, err := asset.New(d.Asset)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.FromString(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	side, _ := order.StringToOrderSide(d.Side)
+	oType, _ := order.StringToOrderType(d.Type)
+	status, _ := order.StringToOrderStatus(d.Status)
+	return &order.Detail{
+		Exchange:             d.Exchange,
+		InternalOrderID:      id,
+		OrderID:              d.OrderID,
+		ClientOrderID:        d.ClientOrderID,
+		AssetType:            a,
+		Pair:                 currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote)),
+		Side:                 side,
+		Type:                 oType,
+		Status:               status,
+		Price:                d.Price,
+		Amount:               d.Amount,
+		ExecutedAmount:       d.ExecutedAmount,
+		RemainingAmount:      d.RemainingAmount,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		Fee:                  d.Fee,
+		Cost:                 d.Cost,
+		Date:                 d.Date,
+		LastUpdated:          d.LastUpdated,
+	}, nil
+}
+
 // get returns a copy of all orders for all exchanges.
 func (s *store) get() map[string][]*order.Detail {
 	orders := make(map[string][]*order.Detail)
@@ -942,6 +1158,21 @@ func (s *store) get() map[string][]*order.Detail {
 	return orders
 }
 
+// statusCounts returns the amount of orders per exchange and status
+func (s *store) statusCounts() map[string]map[order.Status]int {
+	counts := make(map[string]map[order.Status]int)
+	s.m.Lock()
+	defer s.m.Unlock()
+	for exch, orders := range s.Orders {
+		c := make(map[order.Status]int)
+		for _, o := range orders {
+			c[o.Status]++
+		}
+		counts[exch] = c
+	}
+	return counts
+}
+
 // getByExchangeAndID returns a specific order by exchange and id
 func (s *store) getByExchangeAndID(exchange, id string) (*order.Detail, error) {
 	s.m.Lock()
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -15,6 +16,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
@@ -180,6 +183,33 @@ func TestSetupOrderManager(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOrderManagerUpdateConfig(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.UpdateConfig(&config.OrderManager{}), ErrNilSubsystem)
+
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	assert.ErrorIs(t, m.UpdateConfig(nil), errNilConfig)
+	assert.ErrorIs(t, m.UpdateConfig(&config.OrderManager{ActivelyTrackFuturesPositions: true}), errInvalidFuturesTrackingSeekDuration)
+	assert.ErrorIs(t, m.UpdateConfig(&config.OrderManager{Risk: config.OrderRisk{Limits: config.RiskLimits{MaxPosition: 1}}}), errRiskFuturesTrackingNeeded)
+
+	require.NoError(t, m.UpdateConfig(&config.OrderManager{
+		Verbose:                       true,
+		ActivelyTrackFuturesPositions: true,
+		FuturesTrackingSeekDuration:   time.Hour,
+		RespectOrderHistoryLimits:     true,
+		CancelOrdersOnShutdown:        true,
+		Risk:                          config.OrderRisk{Limits: config.RiskLimits{MaxPosition: 1}},
+	}), "UpdateConfig must not error")
+	assert.True(t, m.verbose.Load(), "verbose should be updated")
+	assert.True(t, m.activelyTrackFuturesPositions.Load(), "activelyTrackFuturesPositions should be updated")
+	assert.Equal(t, int64(time.Hour), m.futuresPositionSeekDuration.Load(), "futuresPositionSeekDuration should be updated")
+	assert.True(t, m.respectOrderHistoryLimits.Load(), "respectOrderHistoryLimits should be updated")
+	assert.True(t, m.cancelOrdersOnShutdown.Load(), "cancelOrdersOnShutdown should be updated")
+}
+
 func TestOrderManagerStart(t *testing.T) {
 	var m *OrderManager
 	err := m.Start()
@@ -1402,7 +1432,7 @@ func TestGetAllOpenFuturesPositions(t *testing.T) {
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started = 1
-	o.activelyTrackFuturesPositions = true
+	o.activelyTrackFuturesPositions.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
 	_, err = o.GetAllOpenFuturesPositions()
 	assert.ErrorIs(t, err, futures.ErrNoPositionsFound)
@@ -1615,3 +1645,172 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+// orderRepository is an in memory order database service to test order
+// persistence without a database. see tests in the database folder
+type orderRepository struct {
+	dborder.IDBService
+	m      sync.Mutex
+	orders map[string]dborder.Details
+}
+
+func (r *orderRepository) Upsert(orders ...*dborder.Details) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, o := range orders {
+		r.orders[o.Exchange+o.OrderID] = *o
+	}
+	return nil
+}
+
+func (r *orderRepository) GetByStatus(statuses ...string) ([]dborder.Details, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var resp []dborder.Details
+	for _, o := range r.orders {
+		if slices.Contains(statuses, o.Status) {
+			resp = append(resp, o)
+		}
+	}
+	return resp, nil
+}
+
+func (r *orderRepository) get(exch, id string) (dborder.Details, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	o, ok := r.orders[exch+id]
+	return o, ok
+}
+
+// newPersistenceOrderManager returns a started order manager backed by an in
+// memory order repository which does not require exchange connectivity
+func newPersistenceOrderManager(t *testing.T) (*OrderManager, *orderRepository) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	repo := &orderRepository{orders: make(map[string]dborder.Details)}
+	m.orderRepository = repo
+	return m, repo
+}
+
+func TestSetupOrderPersistence(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.SetupOrderPersistence(nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = &OrderManager{started: 1}
+	err = m.SetupOrderPersistence(nil)
+	assert.ErrorIs(t, err, ErrSubSystemAlreadyStarted)
+
+	m.started = 0
+	err = m.SetupOrderPersistence(nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	err = m.SetupOrderPersistence(&DatabaseConnectionManager{})
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+}
+
+func TestOrderPersistence(t *testing.T) {
+	t.Parallel()
+	m, repo := newPersistenceOrderManager(t)
+
+	_, err := m.UpsertOrder(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "persisted",
+		Pair:      currency.NewBTCUSD(),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.New,
+		Price:     1337,
+		Amount:    1,
+	})
+	require.NoError(t, err)
+	stored, ok := repo.get(testExchange, "persisted")
+	require.True(t, ok, "UpsertOrder must write the order through to the repository")
+	assert.Equal(t, order.New.String(), stored.Status)
+	assert.NotEmpty(t, stored.ID, "persisted order should carry the internal order ID")
+
+	err = m.UpdateExistingOrder(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "persisted",
+		Status:         order.PartiallyFilled,
+		ExecutedAmount: 0.5,
+	})
+	require.NoError(t, err)
+	stored, _ = repo.get(testExchange, "persisted")
+	assert.Equal(t, order.PartiallyFilled.String(), stored.Status)
+	assert.Equal(t, 0.5, stored.ExecutedAmount)
+
+	_, err = m.processSubmittedOrder(&order.SubmitResponse{
+		Exchange:  testExchange,
+		OrderID:   "submitted",
+		Pair:      currency.NewBTCUSD(),
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Market,
+		Status:    order.New,
+		Amount:    1,
+	})
+	require.NoError(t, err)
+	_, ok = repo.get(testExchange, "submitted")
+	assert.True(t, ok, "processSubmittedOrder must write the order through to the repository")
+}
+
+func TestLoadPersistedOrders(t *testing.T) {
+	t.Parallel()
+	m, repo := newPersistenceOrderManager(t)
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	repo.orders = map[string]dborder.Details{
+		"active": {
+			ID:       id.String(),
+			Exchange: testExchange,
+			OrderID:  "active",
+			Asset:    asset.Spot.String(),
+			Base:     "BTC",
+			Quote:    "USD",
+			Side:     order.Buy.String(),
+			Type:     order.Limit.String(),
+			Status:   order.Active.String(),
+			Amount:   1,
+		},
+		"filled": {
+			ID:       id.String(),
+			Exchange: testExchange,
+			OrderID:  "filled",
+			Asset:    asset.Spot.String(),
+			Status:   order.Filled.String(),
+		},
+		"badasset": {
+			ID:       id.String(),
+			Exchange: testExchange,
+			OrderID:  "badasset",
+			Asset:    "meow",
+			Status:   order.Active.String(),
+		},
+	}
+	m.started = 0
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "active")
+	require.NoError(t, err)
+	assert.Equal(t, id, od.InternalOrderID, "rehydrated order should keep its internal order ID")
+	assert.Equal(t, order.Active, od.Status)
+	assert.True(t, od.Pair.Equal(currency.NewBTCUSD()))
+
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "filled")
+	assert.ErrorIs(t, err, ErrOrderNotFound, "inactive orders should not be rehydrated")
+
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "badasset")
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+}
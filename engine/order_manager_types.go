@@ -3,9 +3,11 @@ package engine
 import (
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -32,7 +34,6 @@ var (
 type orderManagerConfig struct {
 	EnforceLimitConfig     bool
 	AllowMarketOrders      bool
-	CancelOrdersOnShutdown bool
 	LimitAmount            float64
 	AllowedPairs           currency.Pairs
 	AllowedExchanges       []string
@@ -41,15 +42,19 @@ type orderManagerConfig struct {
 
 // OrderManager processes and stores orders across enabled exchanges
 type OrderManager struct {
-	started                       int32
-	processingOrders              int32
-	shutdown                      chan struct{}
-	orderStore                    store
-	cfg                           orderManagerConfig
-	verbose                       bool
-	activelyTrackFuturesPositions bool
-	futuresPositionSeekDuration   time.Duration
-	respectOrderHistoryLimits     bool
+	started          int32
+	processingOrders int32
+	shutdown         chan struct{}
+	orderStore       store
+	cfg              orderManagerConfig
+	// The settings below can be changed while the order manager is running
+	verbose                       atomic.Bool
+	activelyTrackFuturesPositions atomic.Bool
+	futuresPositionSeekDuration   atomic.Int64
+	respectOrderHistoryLimits     atomic.Bool
+	cancelOrdersOnShutdown        atomic.Bool
+	orderRepository               dborder.IDBService
+	risk                          *riskManager
 }
 
 // store holds all orders by exchange
@@ -0,0 +1,584 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// newRiskManager sets up the default pre-trade risk checks for the provided
+// config
+func newRiskManager(cfg *config.OrderRisk, trackingFutures bool) (*riskManager, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w OrderRisk", errNilConfig)
+	}
+	if err := validateRiskConfig(cfg, trackingFutures); err != nil {
+		return nil, err
+	}
+	r := &riskManager{
+		cfg: *cfg,
+		checks: []PreTradeRiskCheck{
+			orderNotionalCheck{},
+			pairNotionalCheck{},
+			openOrdersCheck{},
+			positionCheck{},
+			dailyLossCheck{},
+			priceBandCheck{},
+		},
+		rate: &orderRateCheck{orders: make(map[string][]time.Time)},
+	}
+	r.cfg.Rules = slices.Clone(cfg.Rules)
+	return r, nil
+}
+
+// validateRiskConfig ensures all risk limits and rules can be applied
+func validateRiskConfig(cfg *config.OrderRisk, trackingFutures bool) error {
+	if err := validateRiskLimits(&cfg.Limits, trackingFutures); err != nil {
+		return fmt.Errorf("default limits %w", err)
+	}
+	for i := range cfg.Rules {
+		if cfg.Rules[i].Exchange == "" {
+			return fmt.Errorf("rule %d %w", i, errRiskRuleExchangeUnset)
+		}
+		if !cfg.Rules[i].Pair.IsEmpty() && cfg.Rules[i].Asset == asset.Empty {
+			return fmt.Errorf("rule %d %s %w", i, cfg.Rules[i].Exchange, errRiskRuleAssetUnset)
+		}
+		if err := validateRiskLimits(&cfg.Rules[i].RiskLimits, trackingFutures); err != nil {
+			return fmt.Errorf("rule %d %s %w", i, cfg.Rules[i].Exchange, err)
+		}
+	}
+	return nil
+}
+
+func validateRiskLimits(l *config.RiskLimits, trackingFutures bool) error {
+	if l.MaxOrderNotional < 0 ||
+		l.MaxPairNotional < 0 ||
+		l.MaxOpenOrders < 0 ||
+		l.MaxPosition < 0 ||
+		l.MaxDailyLoss < 0 ||
+		l.PriceBandPercent < 0 ||
+		l.MaxOrdersPerInterval < 0 ||
+		l.OrderRateInterval < 0 {
+		return errRiskLimitNegative
+	}
+	if l.MaxOrdersPerInterval > 0 && l.OrderRateInterval == 0 {
+		return errOrderRateIntervalUnset
+	}
+	if !trackingFutures && (l.MaxPosition > 0 || l.MaxDailyLoss > 0) {
+		return errRiskFuturesTrackingNeeded
+	}
+	return nil
+}
+
+// update replaces the risk config, order rate history is retained
+func (r *riskManager) update(cfg *config.OrderRisk, trackingFutures bool) error {
+	if cfg == nil {
+		return fmt.Errorf("%w OrderRisk", errNilConfig)
+	}
+	if err := validateRiskConfig(cfg, trackingFutures); err != nil {
+		return err
+	}
+	r.m.Lock()
+	r.cfg = *cfg
+	r.cfg.Rules = slices.Clone(cfg.Rules)
+	r.m.Unlock()
+	return nil
+}
+
+// addCheck registers an additional pre-trade risk check
+func (r *riskManager) addCheck(c PreTradeRiskCheck) error {
+	if c == nil {
+		return errNilRiskCheck
+	}
+	name := c.Name()
+	if name == "" {
+		return errRiskCheckNameUnset
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if strings.EqualFold(r.rate.Name(), name) {
+		return fmt.Errorf("%w %s", errRiskCheckAlreadyAdded, name)
+	}
+	for i := range r.checks {
+		if strings.EqualFold(r.checks[i].Name(), name) {
+			return fmt.Errorf("%w %s", errRiskCheckAlreadyAdded, name)
+		}
+	}
+	r.checks = append(r.checks, c)
+	return nil
+}
+
+// limitsFor returns the risk limits for an exchange asset pair. Non-zero limits
+// of an exchange rule override the defaults and are in turn overridden by a
+// matching pair rule
+func (r *riskManager) limitsFor(exch string, a asset.Item, p currency.Pair) (limits config.RiskLimits, enabled bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	limits = r.cfg.Limits
+	var pairRule *config.RiskRule
+	for i := range r.cfg.Rules {
+		if !strings.EqualFold(r.cfg.Rules[i].Exchange, exch) {
+			continue
+		}
+		if r.cfg.Rules[i].Pair.IsEmpty() {
+			mergeRiskLimits(&limits, &r.cfg.Rules[i].RiskLimits)
+			continue
+		}
+		if r.cfg.Rules[i].Asset == a && r.cfg.Rules[i].Pair.Equal(p) {
+			pairRule = &r.cfg.Rules[i]
+		}
+	}
+	if pairRule != nil {
+		mergeRiskLimits(&limits, &pairRule.RiskLimits)
+	}
+	return limits, r.cfg.Enabled
+}
+
+func mergeRiskLimits(dst, src *config.RiskLimits) {
+	if src.MaxOrderNotional > 0 {
+		dst.MaxOrderNotional = src.MaxOrderNotional
+	}
+	if src.MaxPairNotional > 0 {
+		dst.MaxPairNotional = src.MaxPairNotional
+	}
+	if src.MaxOpenOrders > 0 {
+		dst.MaxOpenOrders = src.MaxOpenOrders
+	}
+	if src.MaxPosition > 0 {
+		dst.MaxPosition = src.MaxPosition
+	}
+	if src.MaxDailyLoss > 0 {
+		dst.MaxDailyLoss = src.MaxDailyLoss
+	}
+	if src.PriceBandPercent > 0 {
+		dst.PriceBandPercent = src.PriceBandPercent
+	}
+	if src.MaxOrdersPerInterval > 0 {
+		dst.MaxOrdersPerInterval = src.MaxOrdersPerInterval
+		dst.OrderRateInterval = src.OrderRateInterval
+	}
+}
+
+// check runs all pre-trade risk checks against an order and returns the name
+// of the check which rejected it. The order rate is only recorded once every
+// other check has passed
+func (r *riskManager) check(req *RiskCheckRequest) (string, error) {
+	r.m.RLock()
+	checks := r.checks
+	r.m.RUnlock()
+	for i := range checks {
+		if err := checks[i].Check(req); err != nil {
+			return checks[i].Name(), err
+		}
+	}
+	if err := r.rate.Check(req); err != nil {
+		return r.rate.Name(), err
+	}
+	return "", nil
+}
+
+func (r *riskManager) addViolation(v *RiskViolation) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.violations = append(r.violations, *v)
+	if len(r.violations) > maxStoredViolations {
+		r.violations = r.violations[len(r.violations)-maxStoredViolations:]
+	}
+}
+
+func (r *riskManager) isKillSwitchActive() bool {
+	if r == nil {
+		return false
+	}
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.killSwitch
+}
+
+func (r *riskManager) setKillSwitch(active bool, reason string) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.killSwitch == active {
+		if active {
+			return errKillSwitchActive
+		}
+		return errKillSwitchOff
+	}
+	r.killSwitch = active
+	if active {
+		r.killSwitchReason = reason
+		r.killSwitchActivatedAt = time.Now()
+	} else {
+		r.killSwitchReason = ""
+		r.killSwitchActivatedAt = time.Time{}
+	}
+	return nil
+}
+
+func (r *riskManager) status() *RiskStatus {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	s := &RiskStatus{
+		Enabled:               r.cfg.Enabled,
+		Checks:                make([]string, 0, len(r.checks)+1),
+		KillSwitchActive:      r.killSwitch,
+		KillSwitchReason:      r.killSwitchReason,
+		KillSwitchActivatedAt: r.killSwitchActivatedAt,
+		Violations:            make([]RiskViolation, len(r.violations)),
+	}
+	for i := range r.checks {
+		s.Checks = append(s.Checks, r.checks[i].Name())
+	}
+	s.Checks = append(s.Checks, r.rate.Name())
+	copy(s.Violations, r.violations)
+	return s
+}
+
+// AddRiskCheck registers an additional pre-trade risk check which is run
+// against every order when pre-trade risk checks are enabled
+func (m *OrderManager) AddRiskCheck(c PreTradeRiskCheck) error {
+	if m == nil || m.risk == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	return m.risk.addCheck(c)
+}
+
+// UpdateRiskConfig applies new pre-trade risk limits without restarting the
+// order manager
+func (m *OrderManager) UpdateRiskConfig(cfg *config.OrderRisk) error {
+	if m == nil || m.risk == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if err := m.risk.update(cfg, m.activelyTrackFuturesPositions.Load()); err != nil {
+		return err
+	}
+	log.Infoln(log.OrderMgr, "Order manager pre-trade risk config updated")
+	return nil
+}
+
+// ActivateKillSwitch blocks all new order submissions and cancels every order
+// tracked by the order manager
+func (m *OrderManager) ActivateKillSwitch(ctx context.Context, reason string) error {
+	if m == nil || m.risk == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if err := m.risk.setKillSwitch(true, reason); err != nil {
+		return err
+	}
+	m.reportRiskEvent(killSwitchAuditID, "Kill switch activated: "+reason)
+
+	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	m.CancelAllOrders(ctx, exchanges)
+	return nil
+}
+
+// DeactivateKillSwitch allows orders to be submitted again
+func (m *OrderManager) DeactivateKillSwitch() error {
+	if m == nil || m.risk == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if err := m.risk.setKillSwitch(false, ""); err != nil {
+		return err
+	}
+	m.reportRiskEvent(killSwitchAuditID, "Kill switch deactivated")
+	return nil
+}
+
+// IsKillSwitchActive returns whether new order submissions are blocked
+func (m *OrderManager) IsKillSwitchActive() bool {
+	return m != nil && m.risk.isKillSwitchActive()
+}
+
+// GetRiskStatus returns the state of the pre-trade risk checks, the kill
+// switch and the most recent risk violations
+func (m *OrderManager) GetRiskStatus() (*RiskStatus, error) {
+	if m == nil || m.risk == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	return m.risk.status(), nil
+}
+
+// checkRisk runs the pre-trade risk checks against an order and reports any
+// violation. An order being replaced by a modification is excluded from the
+// open orders
+func (m *OrderManager) checkRisk(exch exchange.IBotExchange, newOrder *order.Submit, replacingOrderID string) error {
+	if m.risk == nil {
+		return nil
+	}
+	limits, enabled := m.risk.limitsFor(exch.GetName(), newOrder.AssetType, newOrder.Pair)
+	if !enabled {
+		return nil
+	}
+	req := &RiskCheckRequest{
+		Order:      newOrder,
+		Limits:     limits,
+		OpenOrders: m.orderStore.getActiveOrders(&order.Filter{Exchange: exch.GetName()}),
+		Time:       time.Now(),
+	}
+	if replacingOrderID != "" {
+		req.OpenOrders = slices.DeleteFunc(req.OpenOrders, func(d order.Detail) bool { return d.OrderID == replacingOrderID })
+	}
+	if tick, err := exch.GetCachedTicker(newOrder.Pair, newOrder.AssetType); err == nil {
+		req.ReferencePrice = tick.Last
+	}
+	if newOrder.AssetType.IsFutures() && m.activelyTrackFuturesPositions.Load() {
+		pos, err := m.orderStore.futuresPositionController.GetOpenPosition(exch.GetName(), newOrder.AssetType, newOrder.Pair)
+		if err != nil && !errors.Is(err, futures.ErrPositionNotFound) {
+			return err
+		}
+		req.Position = pos
+		req.DailyRealisedPNL, err = m.orderStore.futuresPositionController.GetRealisedPNLSince(exch.GetName(), req.Time.UTC().Truncate(24*time.Hour))
+		if err != nil {
+			return err
+		}
+	}
+
+	name, err := m.risk.check(req)
+	if err == nil {
+		return nil
+	}
+	v := &RiskViolation{
+		Time:     req.Time,
+		Exchange: exch.GetName(),
+		Asset:    newOrder.AssetType,
+		Pair:     newOrder.Pair,
+		Check:    name,
+		Message:  err.Error(),
+	}
+	m.risk.addViolation(v)
+	m.reportRiskEvent(v.Exchange, fmt.Sprintf("Exchange %s %s %s %s order of %v rejected by %s risk check: %v",
+		v.Exchange, v.Asset, v.Pair, newOrder.Side, newOrder.Amount, name, err))
+	return fmt.Errorf("%w %s: %w", ErrRiskCheckFailed, name, err)
+}
+
+// modifiedOrder returns an order as it will rest once a modification is
+// applied so that it can be risk checked. The amount is what remains to be
+// executed
+func modifiedOrder(det *order.Detail, mod *order.Modify) *order.Submit {
+	return &order.Submit{
+		Exchange:      det.Exchange,
+		Type:          det.Type,
+		Side:          det.Side,
+		Pair:          det.Pair,
+		AssetType:     det.AssetType,
+		TimeInForce:   det.TimeInForce,
+		ReduceOnly:    det.ReduceOnly,
+		Price:         mod.Price,
+		Amount:        mod.Amount - det.ExecutedAmount,
+		ClientOrderID: det.ClientOrderID,
+	}
+}
+
+// reportRiskEvent logs a risk event and sends it to the communications manager
+// and the audit log
+func (m *OrderManager) reportRiskEvent(id, msg string) {
+	log.Warnln(log.OrderMgr, msg)
+	if m.orderStore.commsManager != nil {
+		m.orderStore.commsManager.PushEvent(base.Event{Type: riskEventType, Message: msg})
+	}
+	audit.Event(id, riskEventType, msg)
+}
+
+// orderPrice returns the order price, or the reference price for orders
+// without one
+func orderPrice(req *RiskCheckRequest) float64 {
+	if req.Order.Price > 0 {
+		return req.Order.Price
+	}
+	return req.ReferencePrice
+}
+
+// orderAmount returns the base amount of an order, converting quote amounts
+// using the order price
+func orderAmount(req *RiskCheckRequest) (float64, error) {
+	if req.Order.Amount > 0 || req.Order.QuoteAmount == 0 {
+		return req.Order.Amount, nil
+	}
+	price := orderPrice(req)
+	if price <= 0 {
+		return 0, errNoReferencePrice
+	}
+	return req.Order.QuoteAmount / price, nil
+}
+
+// orderNotional returns the quote value of an order
+func orderNotional(req *RiskCheckRequest) (float64, error) {
+	if req.Order.Amount == 0 && req.Order.QuoteAmount > 0 {
+		return req.Order.QuoteAmount, nil
+	}
+	price := orderPrice(req)
+	if price <= 0 {
+		return 0, errNoReferencePrice
+	}
+	return req.Order.Amount * price, nil
+}
+
+// Name returns the name of the check
+func (orderNotionalCheck) Name() string { return "max_order_notional" }
+
+// Check rejects orders with a quote value above the maximum
+func (orderNotionalCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.MaxOrderNotional == 0 {
+		return nil
+	}
+	notional, err := orderNotional(req)
+	if err != nil {
+		return err
+	}
+	if notional > req.Limits.MaxOrderNotional {
+		return fmt.Errorf("%w %v > %v", errMaxOrderNotionalExceeded, notional, req.Limits.MaxOrderNotional)
+	}
+	return nil
+}
+
+// Name returns the name of the check
+func (pairNotionalCheck) Name() string { return "max_pair_notional" }
+
+// Check rejects orders which would take the quote value of open orders for
+// the pair above the maximum
+func (pairNotionalCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.MaxPairNotional == 0 {
+		return nil
+	}
+	notional, err := orderNotional(req)
+	if err != nil {
+		return err
+	}
+	for i := range req.OpenOrders {
+		if req.OpenOrders[i].AssetType != req.Order.AssetType || !req.OpenOrders[i].Pair.Equal(req.Order.Pair) {
+			continue
+		}
+		price := req.OpenOrders[i].Price
+		if price == 0 {
+			price = req.ReferencePrice
+		}
+		notional += (req.OpenOrders[i].Amount - req.OpenOrders[i].ExecutedAmount) * price
+	}
+	if notional > req.Limits.MaxPairNotional {
+		return fmt.Errorf("%w %v > %v", errMaxPairNotionalExceeded, notional, req.Limits.MaxPairNotional)
+	}
+	return nil
+}
+
+// Name returns the name of the check
+func (openOrdersCheck) Name() string { return "max_open_orders" }
+
+// Check rejects orders once the exchange has the maximum number of open orders
+func (openOrdersCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.MaxOpenOrders == 0 {
+		return nil
+	}
+	if int64(len(req.OpenOrders)) >= req.Limits.MaxOpenOrders {
+		return fmt.Errorf("%w %v", errMaxOpenOrdersExceeded, req.Limits.MaxOpenOrders)
+	}
+	return nil
+}
+
+// Name returns the name of the check
+func (positionCheck) Name() string { return "max_position" }
+
+// Check rejects futures orders which would increase the absolute position size
+// above the maximum
+func (positionCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.MaxPosition == 0 || !req.Order.AssetType.IsFutures() {
+		return nil
+	}
+	amount, err := orderAmount(req)
+	if err != nil {
+		return err
+	}
+	var current float64
+	if req.Position != nil {
+		current = req.Position.LatestSize.InexactFloat64()
+		if req.Position.LatestDirection.IsShort() {
+			current = -current
+		}
+	}
+	next := current + amount
+	if req.Order.Side.IsShort() {
+		next = current - amount
+	}
+	if math.Abs(next) > req.Limits.MaxPosition && math.Abs(next) > math.Abs(current) {
+		return fmt.Errorf("%w %v > %v", errMaxPositionExceeded, math.Abs(next), req.Limits.MaxPosition)
+	}
+	return nil
+}
+
+// Name returns the name of the check
+func (dailyLossCheck) Name() string { return "max_daily_loss" }
+
+// Check rejects orders which are not reduce only once the realised loss since
+// the start of the UTC day reaches the maximum
+func (dailyLossCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.MaxDailyLoss == 0 || req.Order.ReduceOnly {
+		return nil
+	}
+	if loss := -req.DailyRealisedPNL.InexactFloat64(); loss >= req.Limits.MaxDailyLoss {
+		return fmt.Errorf("%w %v >= %v", errMaxDailyLossExceeded, loss, req.Limits.MaxDailyLoss)
+	}
+	return nil
+}
+
+// Name returns the name of the check
+func (priceBandCheck) Name() string { return "price_band" }
+
+// Check rejects orders priced further from the cached ticker price than the
+// allowed percentage
+func (priceBandCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.PriceBandPercent == 0 || req.Order.Price == 0 {
+		return nil
+	}
+	if req.ReferencePrice <= 0 {
+		return errNoReferencePrice
+	}
+	deviation := math.Abs(req.Order.Price-req.ReferencePrice) / req.ReferencePrice * 100
+	if deviation > req.Limits.PriceBandPercent {
+		return fmt.Errorf("%w price %v deviates %.2f%% from %v, maximum %v%%",
+			errPriceBandExceeded, req.Order.Price, deviation, req.ReferencePrice, req.Limits.PriceBandPercent)
+	}
+	return nil
+}
+
+// Name returns the name of the check
+func (c *orderRateCheck) Name() string { return "order_rate" }
+
+// Check rejects orders once the maximum number of orders has been submitted to
+// the exchange within the interval, accepted orders are recorded
+func (c *orderRateCheck) Check(req *RiskCheckRequest) error {
+	if req.Limits.MaxOrdersPerInterval == 0 {
+		return nil
+	}
+	exch := strings.ToLower(req.Order.Exchange)
+	cutoff := req.Time.Add(-req.Limits.OrderRateInterval)
+	c.m.Lock()
+	defer c.m.Unlock()
+	recent := c.orders[exch]
+	i := 0
+	for i < len(recent) && !recent[i].After(cutoff) {
+		i++
+	}
+	recent = recent[i:]
+	if int64(len(recent)) >= req.Limits.MaxOrdersPerInterval {
+		c.orders[exch] = recent
+		return fmt.Errorf("%w %v per %v", errOrderRateExceeded, req.Limits.MaxOrdersPerInterval, req.Limits.OrderRateInterval)
+	}
+	c.orders[exch] = append(recent, req.Time)
+	return nil
+}
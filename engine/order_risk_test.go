@@ -0,0 +1,428 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// riskEventRecorder captures events pushed to the communications manager
+type riskEventRecorder struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (r *riskEventRecorder) PushEvent(evt base.Event) {
+	r.m.Lock()
+	r.events = append(r.events, evt)
+	r.m.Unlock()
+}
+
+// rejectAllCheck is a pluggable risk check which rejects every order
+type rejectAllCheck struct{}
+
+func (rejectAllCheck) Name() string                  { return "reject_all" }
+func (rejectAllCheck) Check(*RiskCheckRequest) error { return errors.New("rejected") }
+
+func setupOrderRiskTest(t *testing.T) *OrderManager {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err)
+	exch.SetDefaults()
+	exch.GetBase().Name = fakeExchangeName
+	require.NoError(t, em.Add(omfExchange{IBotExchange: exch}))
+
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	return m
+}
+
+func riskTestSubmitResponse(id string, amount float64) *order.SubmitResponse {
+	return &order.SubmitResponse{
+		Exchange:  fakeExchangeName,
+		OrderID:   id,
+		Status:    order.New,
+		AssetType: asset.Spot,
+		Pair:      btcusdPair,
+		Amount:    amount,
+	}
+}
+
+func riskTestOrder() *order.Submit {
+	return &order.Submit{
+		Exchange:  fakeExchangeName,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Amount:    1,
+		Price:     1337,
+	}
+}
+
+func TestNewRiskManager(t *testing.T) {
+	t.Parallel()
+	_, err := newRiskManager(nil, false)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = newRiskManager(&config.OrderRisk{Limits: config.RiskLimits{MaxOrderNotional: -1}}, false)
+	assert.ErrorIs(t, err, errRiskLimitNegative)
+
+	_, err = newRiskManager(&config.OrderRisk{Limits: config.RiskLimits{MaxOrdersPerInterval: 1}}, false)
+	assert.ErrorIs(t, err, errOrderRateIntervalUnset)
+
+	_, err = newRiskManager(&config.OrderRisk{Limits: config.RiskLimits{MaxPosition: 1}}, false)
+	assert.ErrorIs(t, err, errRiskFuturesTrackingNeeded)
+
+	_, err = newRiskManager(&config.OrderRisk{Limits: config.RiskLimits{MaxDailyLoss: 1}}, true)
+	assert.NoError(t, err)
+
+	_, err = newRiskManager(&config.OrderRisk{Rules: []config.RiskRule{{}}}, false)
+	assert.ErrorIs(t, err, errRiskRuleExchangeUnset)
+
+	_, err = newRiskManager(&config.OrderRisk{Rules: []config.RiskRule{{Exchange: testExchange, Pair: btcusdPair}}}, false)
+	assert.ErrorIs(t, err, errRiskRuleAssetUnset)
+
+	_, err = newRiskManager(&config.OrderRisk{Rules: []config.RiskRule{{Exchange: testExchange, RiskLimits: config.RiskLimits{OrderRateInterval: -1}}}}, false)
+	assert.ErrorIs(t, err, errRiskLimitNegative)
+
+	r, err := newRiskManager(&config.OrderRisk{Enabled: true}, false)
+	require.NoError(t, err)
+	assert.Len(t, r.status().Checks, 7, "all default checks should be registered")
+}
+
+func TestRiskLimitsFor(t *testing.T) {
+	t.Parallel()
+	r, err := newRiskManager(&config.OrderRisk{
+		Enabled: true,
+		Limits:  config.RiskLimits{MaxOrderNotional: 100, MaxOpenOrders: 10},
+		Rules: []config.RiskRule{
+			{Exchange: testExchange, Asset: asset.Spot, Pair: btcusdPair, RiskLimits: config.RiskLimits{MaxOrderNotional: 5}},
+			{Exchange: testExchange, RiskLimits: config.RiskLimits{MaxOrderNotional: 50, PriceBandPercent: 1}},
+			{Exchange: "fake", RiskLimits: config.RiskLimits{MaxOpenOrders: 1}},
+		},
+	}, false)
+	require.NoError(t, err)
+
+	limits, enabled := r.limitsFor("fake2", asset.Spot, btcusdPair)
+	assert.True(t, enabled)
+	assert.Equal(t, config.RiskLimits{MaxOrderNotional: 100, MaxOpenOrders: 10}, limits, "default limits should apply")
+
+	limits, _ = r.limitsFor("BITSTAMP", asset.Spot, currency.NewBTCUSDT())
+	assert.Equal(t, config.RiskLimits{MaxOrderNotional: 50, MaxOpenOrders: 10, PriceBandPercent: 1}, limits, "exchange rule should override the defaults")
+
+	limits, _ = r.limitsFor(testExchange, asset.Spot, btcusdPair)
+	assert.Equal(t, config.RiskLimits{MaxOrderNotional: 5, MaxOpenOrders: 10, PriceBandPercent: 1}, limits, "pair rule should override the exchange rule")
+
+	limits, _ = r.limitsFor(testExchange, asset.Margin, btcusdPair)
+	assert.Equal(t, 50.0, limits.MaxOrderNotional, "pair rule should only match its asset")
+}
+
+func TestOrderNotionalCheck(t *testing.T) {
+	t.Parallel()
+	req := &RiskCheckRequest{Order: riskTestOrder()}
+	assert.NoError(t, orderNotionalCheck{}.Check(req), "disabled check should not error")
+
+	req.Limits.MaxOrderNotional = 1337
+	assert.NoError(t, orderNotionalCheck{}.Check(req))
+
+	req.Order.Amount = 2
+	assert.ErrorIs(t, orderNotionalCheck{}.Check(req), errMaxOrderNotionalExceeded)
+
+	req.Order.Price = 0
+	assert.ErrorIs(t, orderNotionalCheck{}.Check(req), errNoReferencePrice)
+
+	req.ReferencePrice = 100
+	assert.NoError(t, orderNotionalCheck{}.Check(req), "market orders should use the reference price")
+
+	req.Order.Amount = 0
+	req.Order.QuoteAmount = 1338
+	assert.ErrorIs(t, orderNotionalCheck{}.Check(req), errMaxOrderNotionalExceeded, "quote amounts should be used as the notional")
+}
+
+func TestPairNotionalCheck(t *testing.T) {
+	t.Parallel()
+	req := &RiskCheckRequest{
+		Order:  riskTestOrder(),
+		Limits: config.RiskLimits{MaxPairNotional: 2000},
+		OpenOrders: []order.Detail{
+			{AssetType: asset.Spot, Pair: btcusdPair, Amount: 1, ExecutedAmount: 0.5, Price: 1000},
+			{AssetType: asset.Margin, Pair: btcusdPair, Amount: 10, Price: 1000},
+			{AssetType: asset.Spot, Pair: currency.NewBTCUSDT(), Amount: 10, Price: 1000},
+		},
+	}
+	assert.NoError(t, pairNotionalCheck{}.Check(req))
+
+	req.OpenOrders = append(req.OpenOrders, order.Detail{AssetType: asset.Spot, Pair: btcusdPair, Amount: 1})
+	req.ReferencePrice = 200
+	assert.ErrorIs(t, pairNotionalCheck{}.Check(req), errMaxPairNotionalExceeded, "open market orders should use the reference price")
+}
+
+func TestOpenOrdersCheck(t *testing.T) {
+	t.Parallel()
+	req := &RiskCheckRequest{
+		Order:      riskTestOrder(),
+		Limits:     config.RiskLimits{MaxOpenOrders: 2},
+		OpenOrders: []order.Detail{{}},
+	}
+	assert.NoError(t, openOrdersCheck{}.Check(req))
+
+	req.OpenOrders = append(req.OpenOrders, order.Detail{})
+	assert.ErrorIs(t, openOrdersCheck{}.Check(req), errMaxOpenOrdersExceeded)
+}
+
+func TestPositionCheck(t *testing.T) {
+	t.Parallel()
+	req := &RiskCheckRequest{
+		Order:  riskTestOrder(),
+		Limits: config.RiskLimits{MaxPosition: 2},
+	}
+	req.Order.Amount = 5
+	assert.NoError(t, positionCheck{}.Check(req), "spot orders should not be checked")
+
+	req.Order.AssetType = asset.Futures
+	req.Order.Side = order.Long
+	assert.ErrorIs(t, positionCheck{}.Check(req), errMaxPositionExceeded)
+
+	req.Position = &futures.Position{LatestSize: decimal.NewFromInt(4), LatestDirection: order.Short}
+	assert.NoError(t, positionCheck{}.Check(req), "orders reducing the position should be accepted")
+
+	req.Order.Amount = 1
+	req.Order.Side = order.Short
+	assert.ErrorIs(t, positionCheck{}.Check(req), errMaxPositionExceeded)
+
+	req.Position.LatestDirection = order.Long
+	assert.NoError(t, positionCheck{}.Check(req))
+}
+
+func TestDailyLossCheck(t *testing.T) {
+	t.Parallel()
+	req := &RiskCheckRequest{
+		Order:            riskTestOrder(),
+		Limits:           config.RiskLimits{MaxDailyLoss: 100},
+		DailyRealisedPNL: decimal.NewFromInt(-99),
+	}
+	assert.NoError(t, dailyLossCheck{}.Check(req))
+
+	req.DailyRealisedPNL = decimal.NewFromInt(-100)
+	assert.ErrorIs(t, dailyLossCheck{}.Check(req), errMaxDailyLossExceeded)
+
+	req.Order.ReduceOnly = true
+	assert.NoError(t, dailyLossCheck{}.Check(req), "reduce only orders should be accepted")
+}
+
+func TestPriceBandCheck(t *testing.T) {
+	t.Parallel()
+	req := &RiskCheckRequest{
+		Order:  riskTestOrder(),
+		Limits: config.RiskLimits{PriceBandPercent: 5},
+	}
+	assert.ErrorIs(t, priceBandCheck{}.Check(req), errNoReferencePrice)
+
+	req.ReferencePrice = 1300
+	assert.NoError(t, priceBandCheck{}.Check(req))
+
+	req.Order.Price = 1400
+	assert.ErrorIs(t, priceBandCheck{}.Check(req), errPriceBandExceeded)
+
+	req.Order.Price = 0
+	assert.NoError(t, priceBandCheck{}.Check(req), "market orders should not be checked")
+}
+
+func TestOrderRateCheck(t *testing.T) {
+	t.Parallel()
+	c := &orderRateCheck{orders: make(map[string][]time.Time)}
+	tn := time.Now()
+	req := &RiskCheckRequest{
+		Order:  riskTestOrder(),
+		Limits: config.RiskLimits{MaxOrdersPerInterval: 2, OrderRateInterval: time.Second},
+		Time:   tn,
+	}
+	require.NoError(t, c.Check(req))
+	require.NoError(t, c.Check(req))
+	assert.ErrorIs(t, c.Check(req), errOrderRateExceeded)
+
+	req.Order.Exchange = testExchange
+	assert.NoError(t, c.Check(req), "order rates should be tracked per exchange")
+
+	req.Order.Exchange = fakeExchangeName
+	req.Time = tn.Add(time.Second)
+	assert.NoError(t, c.Check(req), "orders outside of the interval should not be counted")
+}
+
+func TestOrderManagerCheckRisk(t *testing.T) {
+	t.Parallel()
+	m := setupOrderRiskTest(t)
+	recorder := &riskEventRecorder{}
+	m.orderStore.commsManager = recorder
+
+	_, err := m.SubmitFakeOrder(riskTestOrder(), riskTestSubmitResponse("1", 1), false)
+	require.NoError(t, err, "disabled risk checks should not reject orders")
+
+	err = m.UpdateRiskConfig(&config.OrderRisk{Enabled: true, Limits: config.RiskLimits{MaxOrderNotional: 100}})
+	require.NoError(t, err)
+
+	_, err = m.SubmitFakeOrder(riskTestOrder(), riskTestSubmitResponse("2", 1), false)
+	assert.ErrorIs(t, err, ErrRiskCheckFailed)
+	assert.ErrorIs(t, err, errMaxOrderNotionalExceeded)
+
+	o := riskTestOrder()
+	o.Price = 0
+	o.Type = order.Market
+	o.Amount = 0.05
+	_, err = m.SubmitFakeOrder(o, riskTestSubmitResponse("3", 0.05), false)
+	assert.NoError(t, err, "market order notional should use the cached ticker price")
+
+	status, err := m.GetRiskStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	require.Len(t, status.Violations, 1)
+	assert.Equal(t, "max_order_notional", status.Violations[0].Check)
+	assert.Equal(t, btcusdPair, status.Violations[0].Pair)
+
+	var riskEvents int
+	recorder.m.Lock()
+	for i := range recorder.events {
+		if recorder.events[i].Type == riskEventType {
+			riskEvents++
+		}
+	}
+	recorder.m.Unlock()
+	assert.Equal(t, 1, riskEvents, "violation should be pushed to the communications manager")
+
+	err = m.UpdateRiskConfig(&config.OrderRisk{Limits: config.RiskLimits{MaxPosition: 1}})
+	assert.ErrorIs(t, err, errRiskFuturesTrackingNeeded)
+	err = m.UpdateRiskConfig(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	var nilManager *OrderManager
+	err = nilManager.UpdateRiskConfig(&config.OrderRisk{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = nilManager.GetRiskStatus()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+}
+
+func TestOrderManagerModifyRisk(t *testing.T) {
+	t.Parallel()
+	m := setupOrderRiskTest(t)
+	err := m.orderStore.add(&order.Detail{
+		Exchange:       fakeExchangeName,
+		AssetType:      asset.Spot,
+		Pair:           btcusdPair,
+		OrderID:        "modifyme",
+		Type:           order.Limit,
+		Side:           order.Buy,
+		Status:         order.PartiallyFilled,
+		Price:          50,
+		Amount:         2,
+		ExecutedAmount: 1,
+	})
+	require.NoError(t, err)
+
+	err = m.UpdateRiskConfig(&config.OrderRisk{Enabled: true, Limits: config.RiskLimits{MaxOrderNotional: 100, MaxPairNotional: 100, MaxOpenOrders: 1}})
+	require.NoError(t, err)
+
+	_, err = m.Modify(t.Context(), &order.Modify{Exchange: fakeExchangeName, OrderID: "modifyme", Price: 100, Amount: 3})
+	assert.ErrorIs(t, err, errMaxOrderNotionalExceeded, "Modify should risk check the remaining amount at the new price")
+
+	_, err = m.Modify(t.Context(), &order.Modify{Exchange: fakeExchangeName, OrderID: "modifyme", Price: 99})
+	require.NoError(t, err, "Modify must not count the order being modified against the open order limits")
+
+	status, err := m.GetRiskStatus()
+	require.NoError(t, err)
+	require.Len(t, status.Violations, 1)
+	assert.Equal(t, "max_order_notional", status.Violations[0].Check)
+
+	require.NoError(t, m.ActivateKillSwitch(t.Context(), "test"))
+	_, err = m.Modify(t.Context(), &order.Modify{Exchange: fakeExchangeName, OrderID: "modified_order_id", Price: 10})
+	assert.ErrorIs(t, err, ErrKillSwitchActive)
+}
+
+func TestOrderManagerAddRiskCheck(t *testing.T) {
+	t.Parallel()
+	m := setupOrderRiskTest(t)
+	err := m.AddRiskCheck(nil)
+	assert.ErrorIs(t, err, errNilRiskCheck)
+
+	err = m.AddRiskCheck(orderNotionalCheck{})
+	assert.ErrorIs(t, err, errRiskCheckAlreadyAdded)
+
+	err = m.AddRiskCheck(&orderRateCheck{})
+	assert.ErrorIs(t, err, errRiskCheckAlreadyAdded)
+
+	err = m.AddRiskCheck(rejectAllCheck{})
+	require.NoError(t, err)
+
+	err = m.UpdateRiskConfig(&config.OrderRisk{Enabled: true})
+	require.NoError(t, err)
+	_, err = m.SubmitFakeOrder(riskTestOrder(), riskTestSubmitResponse("1", 1), false)
+	assert.ErrorIs(t, err, ErrRiskCheckFailed)
+
+	var nilManager *OrderManager
+	err = nilManager.AddRiskCheck(rejectAllCheck{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+}
+
+func TestOrderManagerKillSwitch(t *testing.T) {
+	t.Parallel()
+	m := setupOrderRiskTest(t)
+	assert.False(t, m.IsKillSwitchActive())
+
+	err := m.orderStore.add(&order.Detail{
+		Exchange:  fakeExchangeName,
+		AssetType: asset.Spot,
+		Pair:      btcusdPair,
+		OrderID:   "killme",
+		Status:    order.Active,
+		Amount:    1,
+	})
+	require.NoError(t, err)
+
+	err = m.DeactivateKillSwitch()
+	assert.ErrorIs(t, err, errKillSwitchOff)
+
+	err = m.ActivateKillSwitch(t.Context(), "test")
+	require.NoError(t, err)
+	assert.True(t, m.IsKillSwitchActive())
+
+	err = m.ActivateKillSwitch(t.Context(), "test")
+	assert.ErrorIs(t, err, errKillSwitchActive)
+
+	od, err := m.GetByExchangeAndID(fakeExchangeName, "killme")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, od.Status, "kill switch should cancel all orders")
+
+	_, err = m.Submit(t.Context(), riskTestOrder())
+	assert.ErrorIs(t, err, ErrKillSwitchActive)
+
+	status, err := m.GetRiskStatus()
+	require.NoError(t, err)
+	assert.True(t, status.KillSwitchActive)
+	assert.Equal(t, "test", status.KillSwitchReason)
+	assert.False(t, status.KillSwitchActivatedAt.IsZero())
+
+	err = m.DeactivateKillSwitch()
+	require.NoError(t, err)
+	assert.False(t, m.IsKillSwitchActive())
+
+	var nilManager *OrderManager
+	assert.False(t, nilManager.IsKillSwitchActive())
+	err = nilManager.ActivateKillSwitch(t.Context(), "")
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	err = nilManager.DeactivateKillSwitch()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+}
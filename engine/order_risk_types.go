@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	riskEventType       = "risk"
+	killSwitchAuditID   = "kill_switch"
+	maxStoredViolations = 100
+)
+
+// Pre-trade risk errors
+var (
+	ErrKillSwitchActive          = errors.New("kill switch is active, order submission is blocked")
+	ErrRiskCheckFailed           = errors.New("pre-trade risk check failed")
+	errKillSwitchActive          = errors.New("kill switch is already active")
+	errKillSwitchOff             = errors.New("kill switch is not active")
+	errNilRiskCheck              = errors.New("nil pre-trade risk check")
+	errRiskCheckNameUnset        = errors.New("pre-trade risk check name unset")
+	errRiskCheckAlreadyAdded     = errors.New("pre-trade risk check already added")
+	errRiskLimitNegative         = errors.New("risk limits cannot be negative")
+	errRiskRuleExchangeUnset     = errors.New("risk rule exchange unset")
+	errRiskRuleAssetUnset        = errors.New("risk rule asset must be set when a pair is set")
+	errOrderRateIntervalUnset    = errors.New("orderRateInterval must be set when maxOrdersPerInterval is set")
+	errRiskFuturesTrackingNeeded = errors.New("maxPosition and maxDailyLoss require orderManager activelyTrackFuturesPositions")
+	errNoReferencePrice          = errors.New("no order price or cached ticker price available")
+	errMaxOrderNotionalExceeded  = errors.New("order notional exceeds maximum")
+	errMaxPairNotionalExceeded   = errors.New("open order notional for pair exceeds maximum")
+	errMaxOpenOrdersExceeded     = errors.New("open order count exceeds maximum")
+	errMaxPositionExceeded       = errors.New("position size exceeds maximum")
+	errMaxDailyLossExceeded      = errors.New("daily realised loss limit reached")
+	errPriceBandExceeded         = errors.New("order price outside of allowed band")
+	errOrderRateExceeded         = errors.New("order rate exceeds maximum")
+)
+
+// PreTradeRiskCheck is a rule evaluated against every order before it is
+// submitted to an exchange. Returning an error rejects the order
+type PreTradeRiskCheck interface {
+	Name() string
+	Check(*RiskCheckRequest) error
+}
+
+// RiskCheckRequest holds an order, the risk limits which apply to it and the
+// order manager state used to evaluate them
+type RiskCheckRequest struct {
+	Order  *order.Submit
+	Limits config.RiskLimits
+	// ReferencePrice is the last price of the cached ticker, zero when no
+	// ticker has been cached
+	ReferencePrice float64
+	// OpenOrders are the active orders tracked for the exchange
+	OpenOrders []order.Detail
+	// Position is the open futures position for the exchange asset pair, nil
+	// when there is no open position
+	Position *futures.Position
+	// DailyRealisedPNL is the realised futures PNL on the exchange since the
+	// start of the UTC day
+	DailyRealisedPNL decimal.Decimal
+	Time             time.Time
+}
+
+// RiskViolation is an order rejected by a pre-trade risk check
+type RiskViolation struct {
+	Time     time.Time
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Check    string
+	Message  string
+}
+
+// RiskStatus holds the state of the pre-trade risk checks and kill switch
+type RiskStatus struct {
+	Enabled               bool
+	Checks                []string
+	KillSwitchActive      bool
+	KillSwitchReason      string
+	KillSwitchActivatedAt time.Time
+	Violations            []RiskViolation
+}
+
+// riskManager evaluates pre-trade risk checks and holds the kill switch state
+// for the order manager
+type riskManager struct {
+	m                     sync.RWMutex
+	cfg                   config.OrderRisk
+	checks                []PreTradeRiskCheck
+	rate                  *orderRateCheck
+	killSwitch            bool
+	killSwitchReason      string
+	killSwitchActivatedAt time.Time
+	violations            []RiskViolation
+}
+
+// orderNotionalCheck limits the quote value of a single order
+type orderNotionalCheck struct{}
+
+// pairNotionalCheck limits the quote value of open orders for a pair
+type pairNotionalCheck struct{}
+
+// openOrdersCheck limits the number of open orders on an exchange
+type openOrdersCheck struct{}
+
+// positionCheck limits the futures position size after an order is filled
+type positionCheck struct{}
+
+// dailyLossCheck blocks orders once the daily realised loss limit is reached
+type dailyLossCheck struct{}
+
+// priceBandCheck rejects limit prices too far from the cached ticker price
+type priceBandCheck struct{}
+
+// orderRateCheck throttles the number of orders submitted to an exchange
+type orderRateCheck struct {
+	m      sync.Mutex
+	orders map[string][]time.Time
+}
@@ -0,0 +1,403 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewOrderRouter returns an order router which submits child orders through
+// the order manager
+func NewOrderRouter(em iExchangeManager, om iOrderSubmitter) (*OrderRouter, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	return &OrderRouter{exchangeManager: em, orderManager: om}, nil
+}
+
+// Route splits a parent order without an exchange across every enabled
+// exchange which trades the pair and holds funds for the order. Live depth,
+// taker fees and exchange execution limits are evaluated to allocate the
+// amount to the cheapest liquidity, the child orders are submitted through the
+// order manager and an aggregated report is returned. A limit order's price
+// caps the levels considered and is used as the price of each child order
+func (r *OrderRouter) Route(ctx context.Context, parent *order.Submit) (*RoutedOrder, error) {
+	if r == nil {
+		return nil, fmt.Errorf("order router %w", ErrNilSubsystem)
+	}
+	if err := validateRoutedOrder(parent); err != nil {
+		return nil, err
+	}
+	if !r.orderManager.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+
+	venues, excluded, err := r.getVenues(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	allocs, excluded := allocateRoute(venues, parent, excluded)
+	if len(allocs) == 0 {
+		var errs error
+		for i := range excluded {
+			errs = common.AppendError(errs, fmt.Errorf("%s: %w", excluded[i].Exchange, excluded[i].Err))
+		}
+		return nil, fmt.Errorf("%w for %s %s %s: %w", errRouteLiquidityExceeded, parent.Side, parent.Pair, parent.AssetType, errs)
+	}
+
+	report := &RoutedOrder{
+		Pair:            parent.Pair,
+		Asset:           parent.AssetType,
+		Side:            parent.Side,
+		Type:            parent.Type,
+		RequestedAmount: parent.Amount,
+		Children:        make([]RoutedChildOrder, len(allocs)),
+		Excluded:        excluded,
+	}
+	report.BestSingleVenue, report.BestSingleVenuePrice = bestSingleVenue(venues, parent)
+
+	var wg sync.WaitGroup
+	for i := range allocs {
+		wg.Add(1)
+		go func(child *RoutedChildOrder, alloc *routeAllocation) {
+			defer wg.Done()
+			r.submitChild(ctx, parent, child, alloc)
+		}(&report.Children[i], &allocs[i])
+	}
+	wg.Wait()
+
+	var expectedValue, filledValue float64
+	for i := range report.Children {
+		c := &report.Children[i]
+		if c.Err != nil {
+			log.Errorf(log.OrderMgr, "Order router unable to submit %s %s %v %s: %v", c.Exchange, parent.Side, c.Amount, parent.Pair, c.Err)
+			continue
+		}
+		report.RoutedAmount += c.Amount
+		expectedValue += c.Amount * withFee(c.ExpectedPrice, c.FeeRate, parent.Side.IsLong())
+		if c.ExecutedAmount > 0 {
+			price := c.AveragePrice
+			if price == 0 {
+				price = c.ExpectedPrice
+			}
+			report.FilledAmount += c.ExecutedAmount
+			filledValue += c.ExecutedAmount * withFee(price, c.FeeRate, parent.Side.IsLong())
+		}
+	}
+	if report.RoutedAmount > 0 {
+		report.ExpectedPrice = expectedValue / report.RoutedAmount
+	}
+	if report.FilledAmount > 0 {
+		report.EffectivePrice = filledValue / report.FilledAmount
+	}
+	if report.BestSingleVenuePrice > 0 {
+		price := report.EffectivePrice
+		if price == 0 {
+			price = report.ExpectedPrice
+		}
+		if price > 0 {
+			report.PriceImprovement = (report.BestSingleVenuePrice - price) / report.BestSingleVenuePrice * 100
+			if !parent.Side.IsLong() {
+				report.PriceImprovement = -report.PriceImprovement
+			}
+		}
+	}
+	return report, nil
+}
+
+// validateRoutedOrder checks the parent order can be routed
+func validateRoutedOrder(parent *order.Submit) error {
+	if parent == nil {
+		return errNilOrder
+	}
+	if parent.Exchange != "" {
+		return errRouteExchangeSet
+	}
+	if parent.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if !parent.AssetType.IsValid() {
+		return fmt.Errorf("%w: %q", order.ErrAssetNotSet, parent.AssetType)
+	}
+	if !parent.Side.IsLong() && !parent.Side.IsShort() {
+		return fmt.Errorf("%w: %q", order.ErrSideIsInvalid, parent.Side)
+	}
+	if parent.Amount <= 0 {
+		if parent.QuoteAmount > 0 {
+			return errRouteQuoteAmountUnset
+		}
+		return fmt.Errorf("%w: %v", order.ErrAmountIsInvalid, parent.Amount)
+	}
+	switch parent.Type {
+	case order.Market:
+	case order.Limit:
+		if parent.Price <= 0 {
+			return fmt.Errorf("%w: %v", order.ErrPriceMustBeSetIfLimitOrder, parent.Price)
+		}
+	default:
+		return fmt.Errorf("%w: %s", errRouteOrderTypeInvalid, parent.Type)
+	}
+	return nil
+}
+
+// getVenues evaluates every loaded exchange for the parent order, returning
+// those which can be routed to and why the enabled exchanges trading the pair
+// were excluded
+func (r *OrderRouter) getVenues(ctx context.Context, parent *order.Submit) ([]*routeVenue, []RouteExclusion, error) {
+	exchanges, err := r.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, nil, err
+	}
+	var venues []*routeVenue
+	var excluded []RouteExclusion
+	for _, exch := range exchanges {
+		if !exch.IsEnabled() || !exch.GetAssetTypes(true).Contains(parent.AssetType) {
+			continue
+		}
+		enabled, err := exch.GetEnabledPairs(parent.AssetType)
+		if err != nil || !enabled.Contains(parent.Pair, true) {
+			continue
+		}
+		v, err := getRouteVenue(ctx, exch, parent)
+		if err != nil {
+			excluded = append(excluded, RouteExclusion{Exchange: exch.GetName(), Err: err})
+			continue
+		}
+		venues = append(venues, v)
+	}
+	if len(venues) == 0 {
+		var errs error
+		for i := range excluded {
+			errs = common.AppendError(errs, fmt.Errorf("%s: %w", excluded[i].Exchange, excluded[i].Err))
+		}
+		return nil, nil, fmt.Errorf("%w for %s %s: %w", errNoRoutableVenues, parent.Pair, parent.AssetType, errs)
+	}
+	return venues, excluded, nil
+}
+
+// getRouteVenue retrieves the funds, orderbook side, taker fee and execution
+// limits of an exchange for the parent order
+func getRouteVenue(ctx context.Context, exch exchange.IBotExchange, parent *order.Submit) (*routeVenue, error) {
+	buy := parent.Side.IsLong()
+	creds, err := exch.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fundingCurrency := parent.Pair.Base
+	if buy {
+		fundingCurrency = parent.Pair.Quote
+	}
+	balance, err := account.GetBalance(exch.GetName(), creds.SubAccount, creds, parent.AssetType, fundingCurrency)
+	if err != nil {
+		return nil, err
+	}
+	v := &routeVenue{exch: exch, funds: balance.GetFree()}
+	if v.funds <= 0 {
+		return nil, fmt.Errorf("%w %s", errRouteNoFunds, fundingCurrency)
+	}
+
+	book, err := orderbook.Get(exch.GetName(), parent.Pair, parent.AssetType)
+	if err != nil {
+		if book, err = exch.UpdateOrderbook(ctx, parent.Pair, parent.AssetType); err != nil {
+			return nil, err
+		}
+	}
+	levels := book.Bids
+	if buy {
+		levels = book.Asks
+	}
+	for i := range levels {
+		if parent.Type == order.Limit && ((buy && levels[i].Price > parent.Price) || (!buy && levels[i].Price < parent.Price)) {
+			break
+		}
+		v.levels = append(v.levels, levels[i])
+	}
+	if len(v.levels) == 0 {
+		return nil, errRouteLiquidityExceeded
+	}
+
+	if v.fee, err = getTakerFeeRate(ctx, exch, parent.Pair); err != nil {
+		return nil, err
+	}
+	if v.limits, err = exch.GetOrderExecutionLimits(parent.AssetType, parent.Pair); err != nil {
+		// Child amounts are not conformed to a step when limits are not loaded
+		v.limits = order.MinMaxLevel{}
+	}
+	return v, nil
+}
+
+// getTakerFeeRate returns the taker fee rate of an exchange for the pair. The
+// offline fee schedule is used when the account fee cannot be retrieved
+func getTakerFeeRate(ctx context.Context, exch exchange.IBotExchange, pair currency.Pair) (float64, error) {
+	f := &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          pair,
+		PurchasePrice: 1,
+		Amount:        1,
+	}
+	fee, err := exch.GetFeeByType(ctx, f)
+	if err == nil {
+		return fee, nil
+	}
+	f.FeeType = exchange.OfflineTradeFee
+	fee, err = exch.GetFeeByType(ctx, f)
+	if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+		log.Warnf(log.OrderMgr, "Unable to retrieve %s fees, continuing without fees: %v", exch.GetName(), err)
+		return 0, nil
+	}
+	return fee, err
+}
+
+// withFee returns the price adjusted for the cost of the fee rate
+func withFee(price, fee float64, buy bool) float64 {
+	if buy {
+		return price * (1 + fee)
+	}
+	return price * (1 - fee)
+}
+
+// allocateRoute allocates the parent order across the venues and conforms
+// each allocation to its exchange's execution limits. A venue whose
+// allocation cannot be submitted is excluded and its share reallocated to the
+// remaining venues
+func allocateRoute(venues []*routeVenue, parent *order.Submit, excluded []RouteExclusion) ([]routeAllocation, []RouteExclusion) {
+	venues = slices.Clone(venues)
+	for {
+		allocs := allocate(venues, parent.Amount, parent.Side.IsLong())
+		rejected := -1
+		for i := range allocs {
+			v := allocs[i].venue
+			conformed := v.limits.ConformToAmount(allocs[i].amount)
+			price := parent.Price
+			if parent.Type == order.Market {
+				price = allocs[i].cost / allocs[i].amount
+			}
+			err := v.exch.CheckOrderExecutionLimits(parent.AssetType, parent.Pair, price, conformed, parent.Type)
+			if errors.Is(err, order.ErrCannotValidateAsset) || errors.Is(err, order.ErrCannotValidateBaseCurrency) || errors.Is(err, order.ErrCannotValidateQuoteCurrency) {
+				err = nil
+			}
+			if err == nil && conformed <= 0 {
+				err = order.ErrAmountBelowMin
+			}
+			if err != nil {
+				excluded = append(excluded, RouteExclusion{Exchange: v.exch.GetName(), Err: err})
+				rejected = slices.Index(venues, v)
+				break
+			}
+			allocs[i].cost *= conformed / allocs[i].amount
+			allocs[i].amount = conformed
+		}
+		if rejected == -1 {
+			return allocs, excluded
+		}
+		venues = slices.Delete(venues, rejected, rejected+1)
+	}
+}
+
+// allocate allocates the amount to the best fee inclusive levels across all
+// venues within the funds each venue holds. As the levels of each venue are
+// ordered best first, repeatedly taking the best remaining level across all
+// venues minimises the total cost of the split
+func allocate(venues []*routeVenue, amount float64, buy bool) []routeAllocation {
+	type candidate struct {
+		venue int
+		level orderbook.Level
+		price float64
+	}
+	var candidates []candidate
+	for i := range venues {
+		for j := range venues[i].levels {
+			candidates = append(candidates, candidate{
+				venue: i,
+				level: venues[i].levels[j],
+				price: withFee(venues[i].levels[j].Price, venues[i].fee, buy),
+			})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		if buy {
+			return cmp.Compare(a.price, b.price)
+		}
+		return cmp.Compare(b.price, a.price)
+	})
+
+	allocs := make([]routeAllocation, len(venues))
+	funds := make([]float64, len(venues))
+	for i := range venues {
+		allocs[i].venue = venues[i]
+		funds[i] = venues[i].funds
+	}
+	remaining := amount
+	for i := range candidates {
+		if remaining <= 0 {
+			break
+		}
+		c := &candidates[i]
+		take := min(c.level.Amount, remaining)
+		if buy {
+			take = min(take, funds[c.venue]/c.price)
+			funds[c.venue] -= take * c.price
+		} else {
+			take = min(take, funds[c.venue])
+			funds[c.venue] -= take
+		}
+		if take <= 0 {
+			continue
+		}
+		allocs[c.venue].amount += take
+		allocs[c.venue].cost += take * c.level.Price
+		remaining -= take
+	}
+	return slices.DeleteFunc(allocs, func(a routeAllocation) bool { return a.amount <= 0 })
+}
+
+// bestSingleVenue returns the venue which can fill the whole parent amount at
+// the best fee inclusive average price on its own
+func bestSingleVenue(venues []*routeVenue, parent *order.Submit) (name string, price float64) {
+	buy := parent.Side.IsLong()
+	for _, v := range venues {
+		allocs := allocate([]*routeVenue{v}, parent.Amount, buy)
+		if len(allocs) == 0 || allocs[0].amount < parent.Amount {
+			continue
+		}
+		avg := withFee(allocs[0].cost/allocs[0].amount, v.fee, buy)
+		if price == 0 || (buy && avg < price) || (!buy && avg > price) {
+			name, price = v.exch.GetName(), avg
+		}
+	}
+	return name, price
+}
+
+// submitChild submits an allocation as a child order of the parent
+func (r *OrderRouter) submitChild(ctx context.Context, parent *order.Submit, child *RoutedChildOrder, alloc *routeAllocation) {
+	child.Exchange = alloc.venue.exch.GetName()
+	child.Amount = alloc.amount
+	child.ExpectedPrice = alloc.cost / alloc.amount
+	child.FeeRate = alloc.venue.fee
+	s := *parent
+	s.Exchange = child.Exchange
+	s.Amount = alloc.amount
+	resp, err := r.orderManager.Submit(ctx, &s)
+	if err != nil {
+		child.Err = err
+		return
+	}
+	child.OrderID = resp.OrderID
+	child.Status = resp.Status
+	child.ExecutedAmount = resp.ExecutedAmount
+	child.AveragePrice = resp.AverageExecutedPrice
+}
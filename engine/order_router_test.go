@@ -0,0 +1,218 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var routerTestCreds = &account.Credentials{Key: "routerKey", Secret: "routerSecret"}
+
+// routerExchange overrides the credential and fee calls used by the order
+// router
+type routerExchange struct {
+	exchange.IBotExchange
+	fee float64
+}
+
+func (e *routerExchange) GetCredentials(context.Context) (*account.Credentials, error) {
+	return routerTestCreds, nil
+}
+
+func (e *routerExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	return e.fee * f.PurchasePrice * f.Amount, nil
+}
+
+// addRouterExchange loads an exchange with an orderbook and balances for the
+// BTC-USDT spot pair
+func addRouterExchange(t *testing.T, em *ExchangeManager, name string, fee float64, asks orderbook.Levels, balances ...account.Balance) *routerExchange {
+	t.Helper()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = name
+	b.Enabled = true
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled:  true,
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+			Available:     currency.Pairs{cp},
+			Enabled:       currency.Pairs{cp},
+		},
+	}
+	e := &routerExchange{IBotExchange: exch, fee: fee}
+	require.NoError(t, em.Add(e), "Add must not error")
+
+	depth, err := orderbook.DeployDepth(name, cp, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{
+		Bids:        orderbook.Levels{{Price: asks[0].Price - 1, Amount: 10}},
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}), "LoadSnapshot must not error")
+
+	if len(balances) > 0 {
+		require.NoError(t, account.Process(&account.Holdings{
+			Exchange: name,
+			Accounts: []account.SubAccount{{AssetType: asset.Spot, Currencies: balances}},
+		}, routerTestCreds), "Process must not error")
+	}
+	return e
+}
+
+func routerTestOrder() *order.Submit {
+	return &order.Submit{
+		Pair:      currency.NewBTCUSDT(),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    3,
+	}
+}
+
+func TestNewOrderRouter(t *testing.T) {
+	t.Parallel()
+	_, err := NewOrderRouter(nil, &fakeOrderSubmitter{})
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = NewOrderRouter(NewExchangeManager(), nil)
+	assert.ErrorIs(t, err, errNilOrderManager)
+	r, err := NewOrderRouter(NewExchangeManager(), &fakeOrderSubmitter{})
+	require.NoError(t, err, "NewOrderRouter must not error")
+	assert.NotNil(t, r)
+}
+
+func TestValidateRoutedOrder(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, validateRoutedOrder(nil), errNilOrder)
+
+	s := routerTestOrder()
+	s.Exchange = "binance"
+	assert.ErrorIs(t, validateRoutedOrder(s), errRouteExchangeSet)
+
+	s = routerTestOrder()
+	s.Pair = currency.EMPTYPAIR
+	assert.ErrorIs(t, validateRoutedOrder(s), order.ErrPairIsEmpty)
+
+	s = routerTestOrder()
+	s.AssetType = asset.Empty
+	assert.ErrorIs(t, validateRoutedOrder(s), order.ErrAssetNotSet)
+
+	s = routerTestOrder()
+	s.Side = order.AnySide
+	assert.ErrorIs(t, validateRoutedOrder(s), order.ErrSideIsInvalid)
+
+	s = routerTestOrder()
+	s.Amount = 0
+	assert.ErrorIs(t, validateRoutedOrder(s), order.ErrAmountIsInvalid)
+	s.QuoteAmount = 100
+	assert.ErrorIs(t, validateRoutedOrder(s), errRouteQuoteAmountUnset)
+
+	s = routerTestOrder()
+	s.Type = order.Limit
+	assert.ErrorIs(t, validateRoutedOrder(s), order.ErrPriceMustBeSetIfLimitOrder)
+
+	s = routerTestOrder()
+	s.Type = order.Stop
+	assert.ErrorIs(t, validateRoutedOrder(s), errRouteOrderTypeInvalid)
+
+	assert.NoError(t, validateRoutedOrder(routerTestOrder()), "validateRoutedOrder should not error on a valid order")
+}
+
+func TestOrderRouterRoute(t *testing.T) {
+	t.Parallel()
+	var r *OrderRouter
+	_, err := r.Route(t.Context(), routerTestOrder())
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	em := NewExchangeManager()
+	usdt := account.Balance{Currency: currency.USDT, Total: 10000, Free: 10000}
+	addRouterExchange(t, em, "routerA", 0.001, orderbook.Levels{{Price: 100, Amount: 1}, {Price: 103, Amount: 5}}, usdt)
+	addRouterExchange(t, em, "routerB", 0.002, orderbook.Levels{{Price: 101, Amount: 1.5}, {Price: 102, Amount: 5}}, usdt)
+	addRouterExchange(t, em, "routerC", 0, orderbook.Levels{{Price: 50, Amount: 10}})
+	om := &fakeOrderSubmitter{orders: make(map[string]*order.Detail)}
+	r, err = NewOrderRouter(em, om)
+	require.NoError(t, err, "NewOrderRouter must not error")
+
+	report, err := r.Route(t.Context(), routerTestOrder())
+	require.NoError(t, err, "Route must not error")
+	require.Len(t, report.Children, 2, "Route should split the order across both funded venues")
+	require.Len(t, om.submitted, 2, "Route should submit a child order to each venue")
+	amounts := map[string]float64{}
+	for i := range report.Children {
+		require.NoError(t, report.Children[i].Err)
+		assert.NotEmpty(t, report.Children[i].OrderID)
+		amounts[report.Children[i].Exchange] = report.Children[i].Amount
+	}
+	assert.InDelta(t, 1, amounts["routerA"], 1e-9, "the cheapest level should be allocated first")
+	assert.InDelta(t, 2, amounts["routerB"], 1e-9, "the remainder should be allocated to the next cheapest levels")
+	assert.InDelta(t, 3, report.RoutedAmount, 1e-9)
+	assert.Equal(t, "routerB", report.BestSingleVenue)
+	assert.InDelta(t, 101.703, report.BestSingleVenuePrice, 1e-9)
+	assert.InDelta(t, 303.005/3, report.ExpectedPrice, 1e-9, "ExpectedPrice should include taker fees")
+	assert.Positive(t, report.PriceImprovement, "splitting should improve on the best single venue")
+	require.Len(t, report.Excluded, 1)
+	assert.Equal(t, "routerC", report.Excluded[0].Exchange, "venues without funds should be excluded")
+
+	s := routerTestOrder()
+	s.Side = order.Sell
+	_, err = r.Route(t.Context(), s)
+	assert.ErrorIs(t, err, errNoRoutableVenues, "Route should error when no venue holds the base currency")
+}
+
+func TestOrderRouterRouteLimits(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	usdt := account.Balance{Currency: currency.USDT, Total: 10000, Free: 10000}
+	a := addRouterExchange(t, em, "routerLimitsA", 0, orderbook.Levels{{Price: 100, Amount: 0.05}, {Price: 110, Amount: 5}}, usdt)
+	addRouterExchange(t, em, "routerLimitsB", 0, orderbook.Levels{{Price: 101, Amount: 0.5}, {Price: 102, Amount: 5}}, account.Balance{Currency: currency.USDT, Total: 203.5, Free: 203.5})
+	require.NoError(t, a.GetBase().LoadLimits([]order.MinMaxLevel{{
+		Pair:                    currency.NewBTCUSDT(),
+		Asset:                   asset.Spot,
+		MinimumBaseAmount:       0.1,
+		AmountStepIncrementSize: 0.1,
+	}}), "LoadLimits must not error")
+	om := &fakeOrderSubmitter{orders: make(map[string]*order.Detail)}
+	r, err := NewOrderRouter(em, om)
+	require.NoError(t, err, "NewOrderRouter must not error")
+
+	// B's funds cover 0.5 @ 101 and 1.5 @ 102, leaving A with 0.05 @ 100 and
+	// 1 @ 110 which is conformed down to its amount step
+	s := routerTestOrder()
+	s.Amount = 3.05
+	report, err := r.Route(t.Context(), s)
+	require.NoError(t, err, "Route must not error")
+	amounts := map[string]float64{}
+	for i := range report.Children {
+		amounts[report.Children[i].Exchange] = report.Children[i].Amount
+	}
+	assert.InDelta(t, 1, amounts["routerLimitsA"], 1e-9, "allocations should be conformed to the exchange amount step")
+	assert.InDelta(t, 2, amounts["routerLimitsB"], 1e-9, "allocations should be limited by the venue funds")
+	assert.Equal(t, "routerLimitsA", report.BestSingleVenue, "only venues which can fill the order within their funds should be compared")
+
+	// A's allocation below its minimum is excluded and reallocated to B
+	s.Amount = 0.55
+	report, err = r.Route(t.Context(), s)
+	require.NoError(t, err, "Route must not error")
+	require.Len(t, report.Children, 1)
+	assert.Equal(t, "routerLimitsB", report.Children[0].Exchange)
+	assert.InDelta(t, 0.55, report.Children[0].Amount, 1e-9)
+	require.Len(t, report.Excluded, 1)
+	assert.ErrorIs(t, report.Excluded[0].Err, order.ErrAmountBelowMin)
+
+	s.Type = order.Limit
+	s.Price = 99
+	_, err = r.Route(t.Context(), s)
+	assert.ErrorIs(t, err, errNoRoutableVenues, "limit prices should cap the levels considered")
+}
@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	errRouteExchangeSet       = errors.New("routed orders must not specify an exchange")
+	errRouteOrderTypeInvalid  = errors.New("routed orders must be market or limit orders")
+	errRouteQuoteAmountUnset  = errors.New("routed orders must be specified by base amount")
+	errNoRoutableVenues       = errors.New("no exchanges available to route the order to")
+	errRouteNoFunds           = errors.New("no free balance of")
+	errRouteLiquidityExceeded = errors.New("no liquidity available within the order constraints")
+)
+
+// OrderRouter splits a parent order across the enabled exchanges which hold
+// funds for it, allocating to the cheapest liquidity after taker fees, and
+// submits the child orders through the order manager
+type OrderRouter struct {
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+}
+
+// RoutedOrder is the aggregated report of a routed parent order. Prices
+// include the estimated taker fee of each venue
+type RoutedOrder struct {
+	Pair            currency.Pair
+	Asset           asset.Item
+	Side            order.Side
+	Type            order.Type
+	RequestedAmount float64
+	// RoutedAmount is the amount submitted across all child orders, which
+	// can be less than requested when liquidity, funds or exchange amount
+	// steps do not allow the full amount
+	RoutedAmount float64
+	FilledAmount float64
+	// ExpectedPrice is the average price of the allocated orderbook levels
+	ExpectedPrice float64
+	// EffectivePrice is the average price of the reported child order fills
+	EffectivePrice float64
+	// BestSingleVenue is the exchange which could fill the requested amount
+	// at the best average price on its own
+	BestSingleVenue      string
+	BestSingleVenuePrice float64
+	// PriceImprovement is the percentage by which the effective price, or
+	// expected price when no fills have been reported, beats the best single
+	// venue price
+	PriceImprovement float64
+	Children         []RoutedChildOrder
+	Excluded         []RouteExclusion
+}
+
+// RoutedChildOrder is a child order submitted to a single venue
+type RoutedChildOrder struct {
+	Exchange      string
+	Amount        float64
+	ExpectedPrice float64
+	FeeRate       float64
+	OrderID       string
+	Status        order.Status
+	// ExecutedAmount and AveragePrice are as reported by the exchange on
+	// submission
+	ExecutedAmount float64
+	AveragePrice   float64
+	Err            error
+}
+
+// RouteExclusion records why an exchange was not routed to
+type RouteExclusion struct {
+	Exchange string
+	Err      error
+}
+
+// routeVenue holds the state of an exchange evaluated for routing
+type routeVenue struct {
+	exch   exchange.IBotExchange
+	levels orderbook.Levels
+	// fee is the taker fee rate
+	fee    float64
+	limits order.MinMaxLevel
+	// funds is the free balance in the quote currency when buying and the
+	// base currency when selling
+	funds float64
+}
+
+// routeAllocation is the amount allocated to a venue
+type routeAllocation struct {
+	venue  *routeVenue
+	amount float64
+	// cost is the fee exclusive notional value of the allocated levels
+	cost float64
+}
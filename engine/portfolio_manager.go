@@ -2,12 +2,17 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -21,6 +26,13 @@ const PortfolioManagerName = "portfolio"
 // PortfolioSleepDelay defines the default sleep time between portfolio manager runs
 var PortfolioSleepDelay = time.Minute
 
+const defaultPortfolioSnapshotInterval = time.Hour
+
+var (
+	errPortfolioSnapshotsDisabled = errors.New("portfolio snapshots are not enabled")
+	errPortfolioSnapshotNotFiat   = errors.New("portfolio snapshot currency must be a fiat currency")
+)
+
 // portfolioManager routinely retrieves a user's holdings through exchange APIs as well
 // as through addresses provided in the config
 type portfolioManager struct {
@@ -31,6 +43,29 @@ type portfolioManager struct {
 	shutdown              chan struct{}
 	base                  *portfolio.Base
 	m                     sync.Mutex
+	// snapshots stores holdings valued in snapshotFiat at most once per
+	// snapshotInterval when set
+	snapshots        portfoliosnapshot.IDBService
+	snapshotFiat     currency.Code
+	snapshotInterval time.Duration
+	lastSnapshot     time.Time
+}
+
+// EquityCurve is the fiat value of portfolio holdings over time, optionally
+// limited to an exchange or currency
+type EquityCurve struct {
+	Fiat     currency.Code
+	Exchange string
+	Currency currency.Code
+	Points   []EquityPoint
+}
+
+// EquityPoint is the value of the holdings in an equity curve at the time of a
+// snapshot. Amount is only set when the curve is limited to a currency
+type EquityPoint struct {
+	Time   time.Time
+	Amount float64
+	Value  float64
 }
 
 // setupPortfolioManager creates a new portfolio manager
@@ -45,6 +80,9 @@ func setupPortfolioManager(e *ExchangeManager, portfolioManagerDelay time.Durati
 	if cfg == nil {
 		cfg = &portfolio.Base{Addresses: []portfolio.Address{}}
 	}
+	// Addresses whitelisted before their whitelisted time was recorded start
+	// any withdrawal cooling-off period from now
+	cfg.SetWhiteListedTimes(time.Now())
 	m := &portfolioManager{
 		portfolioManagerDelay: portfolioManagerDelay,
 		exchangeManager:       e,
@@ -54,6 +92,41 @@ func setupPortfolioManager(e *ExchangeManager, portfolioManagerDelay time.Durati
 	return m, nil
 }
 
+// SetupSnapshotPersistence stores periodic snapshots of exchange and watched
+// address holdings in the database, valued in the configured fiat currency
+func (m *portfolioManager) SetupSnapshotPersistence(dcm iDatabaseConnectionManager, cfg *config.PortfolioSnapshots) error {
+	if m == nil {
+		return fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("portfolio manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w PortfolioSnapshots", errNilConfig)
+	}
+	fiat := currency.USD
+	if cfg.FiatCurrency != "" {
+		fiat = currency.NewCode(cfg.FiatCurrency)
+		if !fiat.IsFiatCurrency() {
+			return fmt.Errorf("%w: %s", errPortfolioSnapshotNotFiat, fiat)
+		}
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultPortfolioSnapshotInterval
+	}
+	repo, err := portfoliosnapshot.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.snapshots = repo
+	m.snapshotFiat = fiat
+	m.snapshotInterval = cfg.Interval
+	return nil
+}
+
 // IsRunning safely checks whether the subsystem is running
 func (m *portfolioManager) IsRunning() bool {
 	return m != nil && atomic.LoadInt32(&m.started) == 1
@@ -140,9 +213,143 @@ func (m *portfolioManager) processPortfolio() {
 
 		log.Debugf(log.PortfolioMgr, "Portfolio manager: Successfully updated address balance for %s address(es) %s\n", key, value)
 	}
+	if m.snapshots != nil && time.Since(m.lastSnapshot) >= m.snapshotInterval {
+		if err := m.snapshots.Insert(m.buildSnapshot(allExchangesHoldings)); err != nil {
+			log.Errorf(log.PortfolioMgr, "Portfolio manager unable to store snapshot: %v", err)
+		} else {
+			m.lastSnapshot = time.Now()
+		}
+	}
 	atomic.CompareAndSwapInt32(&m.processing, 1, 0)
 }
 
+// buildSnapshot values the total holdings of each currency on every exchange
+// and the balances of watched addresses in the snapshot fiat currency
+func (m *portfolioManager) buildSnapshot(accounts []account.Holdings) *portfoliosnapshot.Details {
+	s := &portfoliosnapshot.Details{
+		Fiat:      m.snapshotFiat.String(),
+		Timestamp: time.Now(),
+	}
+	for x := range accounts {
+		var balances []portfoliosnapshot.Balance
+		for y := range accounts[x].Accounts {
+		next:
+			for z := range accounts[x].Accounts[y].Currencies {
+				c := accounts[x].Accounts[y].Currencies[z]
+				code := c.Currency.Upper().String()
+				for i := range balances {
+					if balances[i].Currency == code {
+						balances[i].Amount += c.Total
+						continue next
+					}
+				}
+				balances = append(balances, portfoliosnapshot.Balance{
+					Exchange: accounts[x].Exchange,
+					Currency: code,
+					Amount:   c.Total,
+				})
+			}
+		}
+		for i := range balances {
+			if balances[i].Amount == 0 {
+				continue
+			}
+			m.valueBalance(&balances[i], accounts[x].Exchange)
+			s.Balances = append(s.Balances, balances[i])
+			s.Value += balances[i].Value
+		}
+	}
+	for i := range m.base.Addresses {
+		a := &m.base.Addresses[i]
+		if a.Description == portfolio.ExchangeAddress || a.Balance == 0 {
+			continue
+		}
+		b := portfoliosnapshot.Balance{
+			Address:  a.Address,
+			Currency: a.CoinType.Upper().String(),
+			Amount:   a.Balance,
+		}
+		m.valueBalance(&b, "")
+		s.Balances = append(s.Balances, b)
+		s.Value += b.Value
+	}
+	return s
+}
+
+// valueBalance sets the fiat price and value of a snapshot balance. Balances
+// without a market price are stored without a value
+func (m *portfolioManager) valueBalance(b *portfoliosnapshot.Balance, exchName string) {
+	price, err := getFiatPrice(m.exchangeManager, exchName, currency.NewCode(b.Currency), m.snapshotFiat)
+	if err != nil {
+		log.Warnf(log.PortfolioMgr, "Portfolio manager unable to value %s snapshot balance: %v", b.Currency, err)
+		return
+	}
+	b.Price = price
+	b.Value = b.Amount * price
+}
+
+// GetEquityCurve returns the value of the holdings stored in portfolio
+// snapshots between the start and end dates. Supplying an exchange or
+// currency limits the curve to its holdings, otherwise the total value of
+// every snapshot is returned. Snapshots valued in another fiat currency are
+// converted to the configured fiat currency
+func (m *portfolioManager) GetEquityCurve(start, end time.Time, exchName string, c currency.Code) (*EquityCurve, error) {
+	if m == nil {
+		return nil, fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("portfolio manager %w", ErrSubSystemNotStarted)
+	}
+	if m.snapshots == nil {
+		return nil, errPortfolioSnapshotsDisabled
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	snapshots, err := m.snapshots.GetInRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &EquityCurve{
+		Fiat:     m.snapshotFiat,
+		Exchange: exchName,
+		Currency: c,
+		Points:   make([]EquityPoint, len(snapshots)),
+	}
+	rates := make(map[string]float64)
+	for i := range snapshots {
+		rate, ok := rates[snapshots[i].Fiat]
+		if !ok {
+			if rate, err = getFiatRate(currency.NewCode(snapshots[i].Fiat), m.snapshotFiat); err != nil {
+				return nil, err
+			}
+			rates[snapshots[i].Fiat] = rate
+		}
+		p := EquityPoint{Time: snapshots[i].Timestamp}
+		if exchName == "" && c.IsEmpty() {
+			p.Value = snapshots[i].Value * rate
+			resp.Points[i] = p
+			continue
+		}
+		for j := range snapshots[i].Balances {
+			b := &snapshots[i].Balances[j]
+			if exchName != "" && !strings.EqualFold(b.Exchange, exchName) {
+				continue
+			}
+			if !c.IsEmpty() && !c.Equal(currency.NewCode(b.Currency)) {
+				continue
+			}
+			p.Amount += b.Amount
+			p.Value += b.Value * rate
+		}
+		if c.IsEmpty() {
+			p.Amount = 0
+		}
+		resp.Points[i] = p
+	}
+	return resp, nil
+}
+
 // seedExchangeAccountInfo seeds account info
 func (m *portfolioManager) seedExchangeAccountInfo(accounts []account.Holdings) {
 	if len(accounts) == 0 {
@@ -332,6 +539,15 @@ func (m *portfolioManager) IsWhiteListed(address string) bool {
 	return m.base.IsWhiteListed(address)
 }
 
+// WhiteListedAt returns when an address was whitelisted to withdraw to, or a
+// zero time if it is not whitelisted
+func (m *portfolioManager) WhiteListedAt(address string) time.Time {
+	if m == nil || !m.IsRunning() {
+		return time.Time{}
+	}
+	return m.base.WhiteListedAt(address)
+}
+
 // IsExchangeSupported checks if an exchange is supported
 func (m *portfolioManager) IsExchangeSupported(exchange, address string) bool {
 	if m == nil || !m.IsRunning() {
@@ -3,11 +3,40 @@ package engine
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
 
+type portfolioSnapshotRepository struct {
+	snapshots []portfoliosnapshot.Details
+}
+
+func (r *portfolioSnapshotRepository) Insert(s *portfoliosnapshot.Details) error {
+	r.snapshots = append(r.snapshots, *s)
+	return nil
+}
+
+func (r *portfolioSnapshotRepository) GetInRange(start, end time.Time) ([]portfoliosnapshot.Details, error) {
+	var resp []portfoliosnapshot.Details
+	for i := range r.snapshots {
+		if !r.snapshots[i].Timestamp.Before(start) && !r.snapshots[i].Timestamp.After(end) {
+			resp = append(resp, r.snapshots[i])
+		}
+	}
+	return resp, nil
+}
+
 func TestSetupPortfolioManager(t *testing.T) {
 	_, err := setupPortfolioManager(nil, 0, nil)
 	assert.ErrorIs(t, err, errNilExchangeManager)
@@ -20,6 +49,24 @@ func TestSetupPortfolioManager(t *testing.T) {
 	}
 }
 
+func TestPortfolioManagerWhiteListedAt(t *testing.T) {
+	t.Parallel()
+	var m *portfolioManager
+	assert.Zero(t, m.WhiteListedAt("1337"), "WhiteListedAt should return a zero time on a nil manager")
+
+	m, err := setupPortfolioManager(NewExchangeManager(), 0, &portfolio.Base{
+		Addresses: []portfolio.Address{{Address: "1337", WhiteListed: true}},
+	})
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	assert.Zero(t, m.WhiteListedAt("1337"), "WhiteListedAt should return a zero time when not running")
+
+	var wg sync.WaitGroup
+	require.NoError(t, m.Start(&wg), "Start must not error")
+	assert.WithinDuration(t, time.Now(), m.WhiteListedAt("1337"), time.Minute, "WhiteListedAt should return the time set during setup")
+	assert.Zero(t, m.WhiteListedAt("1338"), "WhiteListedAt should return a zero time for an unknown address")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
 func TestIsPortfolioManagerRunning(t *testing.T) {
 	var m *portfolioManager
 	if m.IsRunning() {
@@ -94,3 +141,125 @@ func TestProcessPortfolio(t *testing.T) {
 
 	m.processPortfolio()
 }
+
+func TestSetupSnapshotPersistence(t *testing.T) {
+	t.Parallel()
+	var m *portfolioManager
+	err := m.SetupSnapshotPersistence(nil, nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = &portfolioManager{started: 1}
+	err = m.SetupSnapshotPersistence(nil, nil)
+	assert.ErrorIs(t, err, ErrSubSystemAlreadyStarted)
+
+	m.started = 0
+	err = m.SetupSnapshotPersistence(nil, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	err = m.SetupSnapshotPersistence(&DatabaseConnectionManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	err = m.SetupSnapshotPersistence(&DatabaseConnectionManager{}, &config.PortfolioSnapshots{FiatCurrency: "BTC"})
+	assert.ErrorIs(t, err, errPortfolioSnapshotNotFiat)
+
+	cfg := &config.PortfolioSnapshots{}
+	err = m.SetupSnapshotPersistence(&DatabaseConnectionManager{}, cfg)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+	assert.Equal(t, defaultPortfolioSnapshotInterval, cfg.Interval)
+}
+
+func TestPortfolioSnapshots(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = "portfolioSnapshots"
+	b.Enabled = true
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled:  true,
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+			Available:     currency.Pairs{cp},
+			Enabled:       currency.Pairs{cp},
+		},
+	}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: b.Name,
+		Pair:         cp,
+		AssetType:    asset.Spot,
+		Last:         150,
+		LastUpdated:  time.Now(),
+	}), "ProcessTicker must not error")
+
+	m, err := setupPortfolioManager(em, 0, &portfolio.Base{Addresses: []portfolio.Address{
+		{Address: "portfolioSnapshots", CoinType: currency.BTC, Balance: 1, Description: portfolio.ExchangeAddress},
+		{Address: "0xdead", CoinType: currency.BTC, Balance: 0.5, Description: portfolio.PersonalAddress},
+	}})
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	repo := &portfolioSnapshotRepository{}
+	m.snapshots, m.snapshotFiat, m.snapshotInterval = repo, currency.USD, time.Hour
+
+	s := m.buildSnapshot([]account.Holdings{{
+		Exchange: "portfolioSnapshots",
+		Accounts: []account.SubAccount{
+			{AssetType: asset.Spot, Currencies: []account.Balance{
+				{Currency: currency.BTC, Total: 0.6},
+				{Currency: currency.USDT, Total: 100},
+				{Currency: currency.ETH},
+			}},
+			{AssetType: asset.Margin, Currencies: []account.Balance{
+				{Currency: currency.BTC, Total: 0.4},
+				{Currency: currency.XRP, Total: 5},
+			}},
+		},
+	}})
+	assert.Equal(t, "USD", s.Fiat)
+	require.Len(t, s.Balances, 4, "empty balances and exchange addresses should be skipped")
+	assert.Equal(t, portfoliosnapshot.Balance{Exchange: "portfolioSnapshots", Currency: "BTC", Amount: 1, Price: 150, Value: 150}, s.Balances[0])
+	assert.Zero(t, s.Balances[2].Value, "balances without a market price should not be valued")
+	assert.Equal(t, portfoliosnapshot.Balance{Address: "0xdead", Currency: "BTC", Amount: 0.5, Price: 150, Value: 75}, s.Balances[3])
+	assert.Equal(t, 325.0, s.Value)
+
+	m.base.Addresses = nil
+	m.processPortfolio()
+	m.processPortfolio()
+	assert.Len(t, repo.snapshots, 1, "snapshots should not be stored more than once per interval")
+
+	_, err = m.GetEquityCurve(time.Time{}, time.Now(), "", currency.EMPTYCODE)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	var wg sync.WaitGroup
+	require.NoError(t, m.Start(&wg), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	tt := time.Now().Add(-time.Hour)
+	s.Timestamp = tt
+	repo.snapshots = []portfoliosnapshot.Details{*s}
+	_, err = m.GetEquityCurve(tt, tt.Add(-time.Minute), "", currency.EMPTYCODE)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+
+	curve, err := m.GetEquityCurve(tt.Add(-time.Minute), tt.Add(time.Minute), "", currency.EMPTYCODE)
+	require.NoError(t, err, "GetEquityCurve must not error")
+	require.Len(t, curve.Points, 1)
+	assert.Equal(t, 325.0, curve.Points[0].Value)
+	assert.Zero(t, curve.Points[0].Amount)
+
+	curve, err = m.GetEquityCurve(tt.Add(-time.Minute), tt.Add(time.Minute), "PORTFOLIOSNAPSHOTS", currency.EMPTYCODE)
+	require.NoError(t, err, "GetEquityCurve must not error")
+	require.Len(t, curve.Points, 1)
+	assert.Equal(t, 250.0, curve.Points[0].Value, "exchange curve should exclude watched addresses")
+
+	curve, err = m.GetEquityCurve(tt.Add(-time.Minute), tt.Add(time.Minute), "", currency.BTC)
+	require.NoError(t, err, "GetEquityCurve must not error")
+	require.Len(t, curve.Points, 1)
+	assert.Equal(t, 1.5, curve.Points[0].Amount)
+	assert.Equal(t, 225.0, curve.Points[0].Value)
+
+	m.snapshots = nil
+	_, err = m.GetEquityCurve(tt, tt, "", currency.EMPTYCODE)
+	assert.ErrorIs(t, err, errPortfolioSnapshotsDisabled)
+}
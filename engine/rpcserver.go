@@ -2,7 +2,6 @@ package engine
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
@@ -25,12 +24,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fundingopportunity"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
@@ -42,20 +43,19 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/consolidated"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
-	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -85,45 +85,7 @@ var (
 type RPCServer struct {
 	gctrpc.UnimplementedGoCryptoTraderServiceServer
 	*Engine
-}
-
-func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return ctx, errors.New("unable to extract metadata")
-	}
-
-	authStr, ok := md["authorization"]
-	if !ok {
-		return ctx, errors.New("authorization header missing")
-	}
-
-	if !strings.Contains(authStr[0], "Basic") {
-		return ctx, errors.New("basic not found in authorization header")
-	}
-
-	decoded, err := base64.StdEncoding.DecodeString(strings.Split(authStr[0], " ")[1])
-	if err != nil {
-		return ctx, errors.New("unable to base64 decode authorization header")
-	}
-
-	cred := strings.Split(string(decoded), ":")
-	username := cred[0]
-	password := cred[1]
-
-	if username != s.Config.RemoteControl.Username ||
-		password != s.Config.RemoteControl.Password {
-		return ctx, errors.New("username/password mismatch")
-	}
-	ctx, err = account.ParseCredentialsMetadata(ctx, md)
-	if err != nil {
-		return ctx, err
-	}
-
-	if _, ok := md["verbose"]; ok {
-		ctx = request.WithVerbose(ctx)
-	}
-	return ctx, nil
+	rpcAuth *rpcAuth
 }
 
 // StartRPCServer starts a gRPC server with TLS auth
@@ -146,7 +108,15 @@ func StartRPCServer(engine *Engine) {
 		return
 	}
 
-	s := RPCServer{Engine: engine}
+	a := engine.rpcAuth
+	if a == nil {
+		if a, err = newRPCAuth(&engine.Config.RemoteControl); err != nil {
+			log.Errorf(log.GRPCSys, "gRPC server could not set up authentication: %s\n", err)
+			return
+		}
+	}
+
+	s := RPCServer{Engine: engine, rpcAuth: a}
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
 		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
@@ -183,13 +153,9 @@ func (s *RPCServer) StartRPCRESTProxy() {
 	}
 
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: s.Config.RemoteControl.Username,
-			Password: s.Config.RemoteControl.Password,
-		}),
-	}
+	// The caller's authorization header is forwarded so that the gRPC server
+	// checks their scopes rather than those of the proxy
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 	err = gctrpc.RegisterGoCryptoTraderServiceHandlerFromEndpoint(context.Background(),
 		mux, s.Config.RemoteControl.GRPC.ListenAddress, opts)
 	if err != nil {
@@ -214,19 +180,6 @@ func (s *RPCServer) StartRPCRESTProxy() {
 	log.Debugln(log.GRPCSys, "gRPC proxy server started!")
 }
 
-func (s *RPCServer) authClient(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username, password, ok := r.BasicAuth()
-		if !ok || username != s.Config.RemoteControl.Username || password != s.Config.RemoteControl.Password {
-			w.Header().Set("WWW-Authenticate", `Basic realm="restricted"`)
-			http.Error(w, "Access denied", http.StatusUnauthorized)
-			log.Warnf(log.GRPCSys, "gRPC proxy server unauthorised access attempt. IP: %s Path: %s\n", r.RemoteAddr, r.URL.Path)
-			return
-		}
-		handler.ServeHTTP(w, r)
-	})
-}
-
 // GetInfo returns info about the current GoCryptoTrader session
 func (s *RPCServer) GetInfo(_ context.Context, _ *gctrpc.GetInfoRequest) (*gctrpc.GetInfoResponse, error) {
 	rpcEndpoints, err := s.getRPCEndpoints()
@@ -1483,17 +1436,56 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 
 // GetEvents returns the stored events list
 func (s *RPCServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
-	return &gctrpc.GetEventsResponse{}, common.ErrNotYetImplemented
+	events, err := s.eventManager.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventsResponse{Events: make([]*gctrpc.EventDetails, len(events))}
+	for i := range events {
+		resp.Events[i] = &gctrpc.EventDetails{
+			Id:              events[i].ID,
+			Exchange:        events[i].Exchange,
+			Item:            events[i].Item,
+			ConditionParams: eventConditionParamsToRPC(&events[i].Condition),
+			Conditions:      eventConditionToRPC(events[i].Conditions),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: events[i].Pair.Delimiter,
+				Base:      events[i].Pair.Base.String(),
+				Quote:     events[i].Pair.Quote.String(),
+			},
+			Asset:  events[i].Asset.String(),
+			Action: events[i].Action,
+			ActionParams: &gctrpc.EventActionParams{
+				Side:      events[i].ActionParams.Side,
+				OrderType: events[i].ActionParams.OrderType,
+				Amount:    events[i].ActionParams.Amount,
+				Price:     events[i].ActionParams.Price,
+				Script:    events[i].ActionParams.Script,
+				Relayer:   events[i].ActionParams.Relayer,
+				Message:   events[i].ActionParams.Message,
+			},
+			Repeat:       events[i].Repeat,
+			Cooldown:     int64(events[i].Cooldown),
+			TriggerCount: events[i].TriggerCount,
+			Executed:     events[i].Executed,
+		}
+		if !events[i].LastTriggered.IsZero() {
+			resp.Events[i].LastTriggered = timestamppb.New(events[i].LastTriggered)
+		}
+	}
+	return resp, nil
 }
 
 // AddEvent adds an event
-func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
-	evtCondition := EventConditionParams{
-		CheckBids:       r.ConditionParams.CheckBids,
-		CheckAsks:       r.ConditionParams.CheckAsks,
-		Condition:       r.ConditionParams.Condition,
-		OrderbookAmount: r.ConditionParams.OrderbookAmount,
-		Price:           r.ConditionParams.Price,
+func (s *RPCServer) AddEvent(ctx context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w AddEventRequest", common.ErrNilPointer)
+	}
+	if err := authoriseEventAction(ctx, r.Action); err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, fmt.Errorf("%w CurrencyPair", common.ErrNilPointer)
 	}
 
 	p := currency.NewPairWithDelimiter(r.Pair.Base,
@@ -1514,7 +1506,32 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 		return nil, err
 	}
 
-	id, err := s.eventManager.Add(r.Exchange, r.Item, evtCondition, p, a, r.Action)
+	evt := &Event{
+		Exchange:   r.Exchange,
+		Item:       r.Item,
+		Conditions: rpcToEventCondition(r.Conditions),
+		Pair:       p,
+		Asset:      a,
+		Action:     r.Action,
+		Repeat:     r.Repeat,
+		Cooldown:   time.Duration(r.Cooldown),
+	}
+	if r.ConditionParams != nil {
+		evt.Condition = rpcToEventConditionParams(r.ConditionParams)
+	}
+	if r.ActionParams != nil {
+		evt.ActionParams = EventActionParams{
+			Side:      r.ActionParams.Side,
+			OrderType: r.ActionParams.OrderType,
+			Amount:    r.ActionParams.Amount,
+			Price:     r.ActionParams.Price,
+			Script:    r.ActionParams.Script,
+			Relayer:   r.ActionParams.Relayer,
+			Message:   r.ActionParams.Message,
+		}
+	}
+
+	id, err := s.eventManager.AddEvent(evt)
 	if err != nil {
 		return nil, err
 	}
@@ -1522,6 +1539,70 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 	return &gctrpc.AddEventResponse{Id: id}, nil
 }
 
+func rpcToEventConditionParams(c *gctrpc.ConditionParams) EventConditionParams {
+	return EventConditionParams{
+		Condition:       c.Condition,
+		Price:           c.Price,
+		CheckBids:       c.CheckBids,
+		CheckAsks:       c.CheckAsks,
+		OrderbookAmount: c.OrderbookAmount,
+		Value:           c.Value,
+		Indicator:       c.Indicator,
+		Interval:        kline.Interval(c.Interval),
+		Period:          c.Period,
+	}
+}
+
+func rpcToEventCondition(c *gctrpc.EventCondition) *EventCondition {
+	if c == nil {
+		return nil
+	}
+	cond := &EventCondition{
+		Item:       c.Item,
+		Operator:   c.Operator,
+		Conditions: make([]EventCondition, len(c.Conditions)),
+	}
+	if c.Params != nil {
+		cond.Params = rpcToEventConditionParams(c.Params)
+	}
+	for i := range c.Conditions {
+		if child := rpcToEventCondition(c.Conditions[i]); child != nil {
+			cond.Conditions[i] = *child
+		}
+	}
+	return cond
+}
+
+func eventConditionParamsToRPC(c *EventConditionParams) *gctrpc.ConditionParams {
+	return &gctrpc.ConditionParams{
+		Condition:       c.Condition,
+		Price:           c.Price,
+		CheckBids:       c.CheckBids,
+		CheckAsks:       c.CheckAsks,
+		OrderbookAmount: c.OrderbookAmount,
+		Value:           c.Value,
+		Indicator:       c.Indicator,
+		Interval:        int64(c.Interval),
+		Period:          c.Period,
+	}
+}
+
+func eventConditionToRPC(c *EventCondition) *gctrpc.EventCondition {
+	if c == nil {
+		return nil
+	}
+	cond := &gctrpc.EventCondition{
+		Item:       c.Item,
+		Params:     eventConditionParamsToRPC(&c.Params),
+		Operator:   c.Operator,
+		Conditions: make([]*gctrpc.EventCondition, len(c.Conditions)),
+	}
+	for i := range c.Conditions {
+		cond.Conditions[i] = eventConditionToRPC(&c.Conditions[i])
+	}
+	return cond
+}
+
 // RemoveEvent removes an event, specified by an event ID
 func (s *RPCServer) RemoveEvent(_ context.Context, r *gctrpc.RemoveEventRequest) (*gctrpc.GenericResponse, error) {
 	if !s.eventManager.Remove(r.Id) {
@@ -1642,36 +1723,13 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.W
 			FeeAmount:  r.Fee,
 			Chain:      r.Chain,
 		},
+		ConfirmationCode: r.TotpCode,
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
-	if err != nil {
+	if err := s.setWithdrawalCredentials(req); err != nil {
 		return nil, err
 	}
 
-	if exchCfg.API.Credentials.OTPSecret != "" {
-		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
-		if errOTP != nil {
-			return nil, errOTP
-		}
-
-		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
-		if errOTP != nil {
-			return nil, errOTP
-		}
-		req.OneTimePassword = codeNum
-	}
-
-	if exchCfg.API.Credentials.PIN != "" {
-		pinCode, errPin := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
-		if errPin != nil {
-			return nil, errPin
-		}
-		req.PIN = pinCode
-	}
-
-	req.TradePassword = exchCfg.API.Credentials.TradePassword
-
 	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -1712,22 +1770,41 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 		Fiat: withdraw.FiatRequest{
 			Bank: *bankAccount,
 		},
+		ConfirmationCode: r.TotpCode,
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
+	if err := s.setWithdrawalCredentials(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// setWithdrawalCredentials sets the one time password, PIN and trade password
+// stored in an exchange's config on a withdrawal request
+func (s *RPCServer) setWithdrawalCredentials(req *withdraw.Request) error {
+	exchCfg, err := s.Config.GetExchangeConfig(req.Exchange)
+	if err != nil {
+		return err
+	}
+
 	if exchCfg.API.Credentials.OTPSecret != "" {
 		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
 		if errOTP != nil {
-			return nil, errOTP
+			return errOTP
 		}
 
 		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
 		if errOTP != nil {
-			return nil, errOTP
+			return errOTP
 		}
 		req.OneTimePassword = codeNum
 	}
@@ -1735,22 +1812,13 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 	if exchCfg.API.Credentials.PIN != "" {
 		pinCode, errPIN := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
 		if errPIN != nil {
-			return nil, errPIN
+			return errPIN
 		}
 		req.PIN = pinCode
 	}
 
 	req.TradePassword = exchCfg.API.Credentials.TradePassword
-
-	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
-	return &gctrpc.WithdrawResponse{
-		Id:     resp.ID.String(),
-		Status: resp.Exchange.Status,
-	}, nil
+	return nil
 }
 
 // WithdrawalEventByID returns previous withdrawal request details
@@ -2964,6 +3032,21 @@ func (s *RPCServer) WebsocketGetInfo(_ context.Context, r *gctrpc.WebsocketGetIn
 		return nil, err
 	}
 
+	info := w.GetConnectionInfo()
+	conns := make([]*gctrpc.WebsocketConnection, len(info))
+	for i := range info {
+		conns[i] = &gctrpc.WebsocketConnection{
+			Url:           info[i].URL,
+			Shard:         int64(info[i].Shard),
+			Authenticated: info[i].Authenticated,
+			Connected:     info[i].Connected,
+			Subscriptions: int64(info[i].Subscriptions),
+		}
+		if info[i].MessageFilter != nil {
+			conns[i].MessageFilter = fmt.Sprint(info[i].MessageFilter)
+		}
+	}
+
 	return &gctrpc.WebsocketGetInfoResponse{
 		Exchange:      exch.GetName(),
 		Supported:     exch.SupportsWebsocket(),
@@ -2971,6 +3054,7 @@ func (s *RPCServer) WebsocketGetInfo(_ context.Context, r *gctrpc.WebsocketGetIn
 		Authenticated: w.CanUseAuthenticatedEndpoints(),
 		RunningUrl:    w.GetWebsocketURL(),
 		ProxyAddress:  w.GetProxyAddress(),
+		Connections:   conns,
 	}, nil
 }
 
@@ -3767,7 +3851,9 @@ func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertData
 		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
 	}
 	err = common.StartEndTimeCheck(start, end)
-	if err != nil {
+	if err != nil && !errors.Is(err, common.ErrStartAfterTimeNow) {
+		// the data history manager validates whether the job data type
+		// can be scheduled to start in the future
 		return nil, err
 	}
 
@@ -3790,6 +3876,7 @@ func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertData
 		SecondaryExchangeSource:  r.SecondaryExchangeName,
 		IssueTolerancePercentage: r.IssueTolerancePercentage,
 		ReplaceOnIssue:           r.ReplaceOnIssue,
+		OrderbookDepth:           r.OrderbookDepth,
 		PrerequisiteJobNickname:  r.PrerequisiteJobNickname,
 	}
 
@@ -3881,6 +3968,7 @@ func (s *RPCServer) GetDataHistoryJobDetails(_ context.Context, r *gctrpc.GetDat
 		SecondaryExchangeName:    result.SecondaryExchangeSource,
 		IssueTolerancePercentage: result.IssueTolerancePercentage,
 		ReplaceOnIssue:           result.ReplaceOnIssue,
+		OrderbookDepth:           result.OrderbookDepth,
 		JobResults:               jobResults,
 	}, nil
 }
@@ -3919,6 +4007,7 @@ func (s *RPCServer) GetActiveDataHistoryJobs(_ context.Context, _ *gctrpc.GetInf
 			SecondaryExchangeName:    jobs[i].SecondaryExchangeSource,
 			IssueTolerancePercentage: jobs[i].IssueTolerancePercentage,
 			ReplaceOnIssue:           jobs[i].ReplaceOnIssue,
+			OrderbookDepth:           jobs[i].OrderbookDepth,
 		}
 	}
 	return &gctrpc.DataHistoryJobs{Results: response}, nil
@@ -3973,6 +4062,7 @@ func (s *RPCServer) GetDataHistoryJobsBetween(_ context.Context, r *gctrpc.GetDa
 			SecondaryExchangeName:    jobs[i].SecondaryExchangeSource,
 			IssueTolerancePercentage: jobs[i].IssueTolerancePercentage,
 			ReplaceOnIssue:           jobs[i].ReplaceOnIssue,
+			OrderbookDepth:           jobs[i].OrderbookDepth,
 		}
 	}
 	return &gctrpc.DataHistoryJobs{
@@ -5942,3 +6032,1099 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// AddSyntheticOrder creates an OCO, bracket or trailing stop order which is
+// emulated by the synthetic order manager
+func (s *RPCServer) AddSyntheticOrder(ctx context.Context, r *gctrpc.AddSyntheticOrderRequest) (*gctrpc.SyntheticOrder, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w AddSyntheticOrderRequest", common.ErrNilPointer)
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	cp := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	err = checkParams(r.Exchange, exch, a, cp)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.syntheticOrderManager.Add(ctx, &SyntheticOrderRequest{
+		Type:             SyntheticOrderType(strings.ToUpper(r.Type)),
+		Exchange:         exch.GetName(),
+		Pair:             cp,
+		Asset:            a,
+		Side:             side,
+		Amount:           r.Amount,
+		EntryPrice:       r.EntryPrice,
+		TakeProfitPrice:  r.TakeProfitPrice,
+		StopLossPrice:    r.StopLossPrice,
+		TrailingDistance: r.TrailingDistance,
+		TrailingPercent:  r.TrailingPercent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return syntheticOrderToRPC(o), nil
+}
+
+// GetSyntheticOrders returns synthetic orders managed by the synthetic order
+// manager
+func (s *RPCServer) GetSyntheticOrders(_ context.Context, r *gctrpc.GetSyntheticOrdersRequest) (*gctrpc.GetSyntheticOrdersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetSyntheticOrdersRequest", common.ErrNilPointer)
+	}
+	orders, err := s.syntheticOrderManager.GetOrders(r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+	})
+	resp := &gctrpc.GetSyntheticOrdersResponse{
+		Orders: make([]*gctrpc.SyntheticOrder, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders[i] = syntheticOrderToRPC(&orders[i])
+	}
+	return resp, nil
+}
+
+// CancelSyntheticOrder cancels a synthetic order and any of its open child
+// orders
+func (s *RPCServer) CancelSyntheticOrder(ctx context.Context, r *gctrpc.CancelSyntheticOrderRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CancelSyntheticOrderRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.syntheticOrderManager.Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: fmt.Sprintf("synthetic order %v cancelled", id)}, nil
+}
+
+func syntheticOrderToRPC(o *SyntheticOrder) *gctrpc.SyntheticOrder {
+	return &gctrpc.SyntheticOrder{
+		Id:       o.ID.String(),
+		Type:     string(o.Type),
+		Exchange: o.Exchange,
+		Asset:    o.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		Side:              o.Side.String(),
+		Status:            string(o.Status),
+		Amount:            o.Amount,
+		EntryPrice:        o.EntryPrice,
+		TakeProfitPrice:   o.TakeProfitPrice,
+		StopLossPrice:     o.StopLossPrice,
+		TrailingDistance:  o.TrailingDistance,
+		TrailingPercent:   o.TrailingPercent,
+		TriggerPrice:      o.TriggerPrice,
+		LastPrice:         o.LastPrice,
+		EntryOrderId:      o.EntryOrderID,
+		TakeProfitOrderId: o.TakeProfitOrderID,
+		StopOrderId:       o.StopOrderID,
+		Error:             o.Error,
+		CreatedAt:         timestamppb.New(o.CreatedAt),
+		UpdatedAt:         timestamppb.New(o.UpdatedAt),
+	}
+}
+
+// StartExecutionAlgorithm starts working a parent order with a TWAP, VWAP,
+// iceberg or POV execution algorithm
+func (s *RPCServer) StartExecutionAlgorithm(ctx context.Context, r *gctrpc.StartExecutionAlgorithmRequest) (*gctrpc.ExecutionAlgorithm, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w StartExecutionAlgorithmRequest", common.ErrNilPointer)
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	cp := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	err = checkParams(r.Exchange, exch, a, cp)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	algo, err := s.executionAlgorithmManager.Add(ctx, &ExecutionAlgorithmRequest{
+		Type:              AlgoType(strings.ToUpper(r.Type)),
+		Exchange:          exch.GetName(),
+		Pair:              cp,
+		Asset:             a,
+		Side:              side,
+		Amount:            r.Amount,
+		Price:             r.Price,
+		Duration:          time.Duration(r.Duration),
+		Slices:            int(r.Slices),
+		Interval:          kline.Interval(r.Interval),
+		ProfileDays:       int(r.ProfileDays),
+		ClipSize:          r.ClipSize,
+		ClipVariance:      r.ClipVariance,
+		ParticipationRate: r.ParticipationRate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executionAlgorithmToRPC(algo), nil
+}
+
+// PauseExecutionAlgorithm cancels the open child orders of an execution
+// algorithm and stops it submitting more until resumed
+func (s *RPCServer) PauseExecutionAlgorithm(ctx context.Context, r *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GenericExecutionAlgorithmRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.executionAlgorithmManager.Pause(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: fmt.Sprintf("execution algorithm %v paused", id)}, nil
+}
+
+// ResumeExecutionAlgorithm resumes a paused execution algorithm
+func (s *RPCServer) ResumeExecutionAlgorithm(_ context.Context, r *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GenericExecutionAlgorithmRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.executionAlgorithmManager.Resume(id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: fmt.Sprintf("execution algorithm %v resumed", id)}, nil
+}
+
+// CancelExecutionAlgorithm cancels an execution algorithm and its open child
+// orders
+func (s *RPCServer) CancelExecutionAlgorithm(ctx context.Context, r *gctrpc.GenericExecutionAlgorithmRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GenericExecutionAlgorithmRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.executionAlgorithmManager.Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: fmt.Sprintf("execution algorithm %v cancelled", id)}, nil
+}
+
+// GetExecutionAlgorithms returns the status of a single execution algorithm
+// when an ID is supplied, otherwise all execution algorithms
+func (s *RPCServer) GetExecutionAlgorithms(_ context.Context, r *gctrpc.GetExecutionAlgorithmsRequest) (*gctrpc.GetExecutionAlgorithmsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetExecutionAlgorithmsRequest", common.ErrNilPointer)
+	}
+	if r.Id != "" {
+		id, err := uuid.FromString(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		algo, err := s.executionAlgorithmManager.GetAlgorithm(id)
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GetExecutionAlgorithmsResponse{
+			Algorithms: []*gctrpc.ExecutionAlgorithm{executionAlgorithmToRPC(algo)},
+		}, nil
+	}
+	algos, err := s.executionAlgorithmManager.GetAlgorithms(r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(algos, func(i, j int) bool {
+		return algos[i].CreatedAt.Before(algos[j].CreatedAt)
+	})
+	resp := &gctrpc.GetExecutionAlgorithmsResponse{
+		Algorithms: make([]*gctrpc.ExecutionAlgorithm, len(algos)),
+	}
+	for i := range algos {
+		resp.Algorithms[i] = executionAlgorithmToRPC(&algos[i])
+	}
+	return resp, nil
+}
+
+func executionAlgorithmToRPC(a *ExecutionAlgorithm) *gctrpc.ExecutionAlgorithm {
+	resp := &gctrpc.ExecutionAlgorithm{
+		Id:       a.ID.String(),
+		Type:     string(a.Type),
+		Exchange: a.Exchange,
+		Asset:    a.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: a.Pair.Delimiter,
+			Base:      a.Pair.Base.String(),
+			Quote:     a.Pair.Quote.String(),
+		},
+		Side:              a.Side.String(),
+		Status:            string(a.Status),
+		Amount:            a.Amount,
+		Price:             a.Price,
+		Duration:          int64(a.Duration),
+		Slices:            int64(a.Slices),
+		Interval:          int64(a.Interval),
+		ProfileDays:       int64(a.ProfileDays),
+		ClipSize:          a.ClipSize,
+		ClipVariance:      a.ClipVariance,
+		ParticipationRate: a.ParticipationRate,
+		ArrivalPrice:      a.ArrivalPrice,
+		SubmittedAmount:   a.SubmittedAmount,
+		FilledAmount:      a.FilledAmount,
+		AveragePrice:      a.AveragePrice,
+		Slippage:          a.Slippage,
+		MarketVolume:      a.MarketVolume,
+		ChildOrderIds:     a.ChildOrderIDs,
+		Error:             a.Error,
+		StartTime:         timestamppb.New(a.StartTime),
+		CreatedAt:         timestamppb.New(a.CreatedAt),
+		UpdatedAt:         timestamppb.New(a.UpdatedAt),
+	}
+	if !a.EndTime.IsZero() {
+		resp.EndTime = timestamppb.New(a.EndTime)
+	}
+	return resp
+}
+
+// GetRiskStatus returns the state of the pre-trade risk checks, the kill
+// switch and the most recent risk violations
+func (s *RPCServer) GetRiskStatus(_ context.Context, r *gctrpc.GetRiskStatusRequest) (*gctrpc.GetRiskStatusResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetRiskStatusRequest", common.ErrNilPointer)
+	}
+	status, err := s.OrderManager.GetRiskStatus()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRiskStatusResponse{
+		Enabled:          status.Enabled,
+		Checks:           status.Checks,
+		KillSwitchActive: status.KillSwitchActive,
+		KillSwitchReason: status.KillSwitchReason,
+		Violations:       make([]*gctrpc.RiskViolation, len(status.Violations)),
+	}
+	if !status.KillSwitchActivatedAt.IsZero() {
+		resp.KillSwitchActivatedAt = timestamppb.New(status.KillSwitchActivatedAt)
+	}
+	for i := range status.Violations {
+		resp.Violations[i] = &gctrpc.RiskViolation{
+			Time:     timestamppb.New(status.Violations[i].Time),
+			Exchange: status.Violations[i].Exchange,
+			Asset:    status.Violations[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: status.Violations[i].Pair.Delimiter,
+				Base:      status.Violations[i].Pair.Base.String(),
+				Quote:     status.Violations[i].Pair.Quote.String(),
+			},
+			Check:   status.Violations[i].Check,
+			Message: status.Violations[i].Message,
+		}
+	}
+	return resp, nil
+}
+
+// SetKillSwitch activates the kill switch, cancelling all orders and blocking
+// new submissions, or deactivates it
+func (s *RPCServer) SetKillSwitch(ctx context.Context, r *gctrpc.SetKillSwitchRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w SetKillSwitchRequest", common.ErrNilPointer)
+	}
+	if !r.Active {
+		if err := s.OrderManager.DeactivateKillSwitch(); err != nil {
+			return nil, err
+		}
+		return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "kill switch deactivated"}, nil
+	}
+	reason := r.Reason
+	if reason == "" {
+		reason = "activated via gRPC"
+	}
+	if err := s.OrderManager.ActivateKillSwitch(ctx, reason); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "kill switch activated"}, nil
+}
+
+// newConsolidatedAggregator validates the requested sources against the loaded
+// exchanges and returns an aggregator for them. The quote defaults to that of
+// the first source
+func (s *RPCServer) newConsolidatedAggregator(sources []*gctrpc.ConsolidatedOrderbookSource, assetType, quote string) (*consolidated.Aggregator, error) {
+	if len(sources) == 0 {
+		return nil, consolidated.ErrNoSources
+	}
+	a, err := asset.New(assetType)
+	if err != nil {
+		return nil, err
+	}
+	srcs := make([]consolidated.Source, len(sources))
+	for i := range sources {
+		if sources[i] == nil || sources[i].Pair == nil {
+			return nil, errCurrencyPairUnset
+		}
+		exch, err := s.GetExchangeByName(sources[i].Exchange)
+		if err != nil {
+			return nil, err
+		}
+		p := currency.NewPairWithDelimiter(sources[i].Pair.Base, sources[i].Pair.Quote, sources[i].Pair.Delimiter)
+		if err := checkParams(sources[i].Exchange, exch, a, p); err != nil {
+			return nil, err
+		}
+		srcs[i] = consolidated.Source{Exchange: exch.GetName(), Pair: p}
+	}
+	q := srcs[0].Pair.Quote
+	if quote != "" {
+		q = currency.NewCode(quote)
+	}
+	return consolidated.New(a, q, srcs...)
+}
+
+// consolidatedOrderbookResponse converts a consolidated book to its gRPC
+// representation, limiting each side to maxLevels when positive
+func consolidatedOrderbookResponse(b *consolidated.Book, maxLevels int64) *gctrpc.ConsolidatedOrderbookResponse {
+	bids, asks := b.Bids, b.Asks
+	if maxLevels > 0 {
+		bids = bids[:min(int64(len(bids)), maxLevels)]
+		asks = asks[:min(int64(len(asks)), maxLevels)]
+	}
+	resp := &gctrpc.ConsolidatedOrderbookResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Base:  b.Pair.Base.String(),
+			Quote: b.Pair.Quote.String(),
+		},
+		AssetType:   b.Asset.String(),
+		Bids:        consolidatedOrderbookItems(bids),
+		Asks:        consolidatedOrderbookItems(asks),
+		Venues:      make([]*gctrpc.ConsolidatedOrderbookVenue, len(b.Venues)),
+		LastUpdated: b.LastUpdated.UnixMicro(),
+	}
+	for i := range b.Venues {
+		resp.Venues[i] = &gctrpc.ConsolidatedOrderbookVenue{
+			Exchange:     b.Venues[i].Exchange,
+			CurrencyPair: b.Venues[i].Pair.String(),
+			Rate:         b.Venues[i].Rate,
+			Bids:         int64(b.Venues[i].Bids),
+			Asks:         int64(b.Venues[i].Asks),
+		}
+		if !b.Venues[i].LastUpdated.IsZero() {
+			resp.Venues[i].LastUpdated = b.Venues[i].LastUpdated.UnixMicro()
+		}
+		if b.Venues[i].Err != nil {
+			resp.Venues[i].Error = b.Venues[i].Err.Error()
+		}
+	}
+	return resp
+}
+
+func consolidatedOrderbookItems(levels consolidated.Levels) []*gctrpc.ConsolidatedOrderbookItem {
+	items := make([]*gctrpc.ConsolidatedOrderbookItem, len(levels))
+	for i := range levels {
+		items[i] = &gctrpc.ConsolidatedOrderbookItem{
+			Exchange:     levels[i].Exchange,
+			CurrencyPair: levels[i].Pair.String(),
+			Amount:       levels[i].Amount,
+			Price:        levels[i].Price,
+			NativePrice:  levels[i].NativePrice,
+		}
+	}
+	return items
+}
+
+func simulateConsolidatedOrderResponse(r *consolidated.Result) *gctrpc.SimulateConsolidatedOrderResponse {
+	resp := &gctrpc.SimulateConsolidatedOrderResponse{
+		Orders:             consolidatedOrderbookItems(r.Orders),
+		Fills:              make([]*gctrpc.ConsolidatedOrderFill, len(r.Fills)),
+		Amount:             r.Amount,
+		MinimumPrice:       r.MinimumPrice,
+		MaximumPrice:       r.MaximumPrice,
+		PercentageGainLoss: r.PercentageGainOrLoss,
+		Status:             r.Status,
+	}
+	for i := range r.Fills {
+		resp.Fills[i] = &gctrpc.ConsolidatedOrderFill{
+			Exchange:     r.Fills[i].Exchange,
+			CurrencyPair: r.Fills[i].Pair.String(),
+			BaseAmount:   r.Fills[i].BaseAmount,
+			QuoteAmount:  r.Fills[i].QuoteAmount,
+			AveragePrice: r.Fills[i].AveragePrice,
+			Levels:       int64(r.Fills[i].Levels),
+		}
+	}
+	return resp
+}
+
+// GetConsolidatedOrderbook returns the orderbooks of the same base currency
+// across multiple exchanges as a single book tagged by venue and normalised to
+// one quote currency
+func (s *RPCServer) GetConsolidatedOrderbook(_ context.Context, r *gctrpc.GetConsolidatedOrderbookRequest) (*gctrpc.ConsolidatedOrderbookResponse, error) {
+	agg, err := s.newConsolidatedAggregator(r.Sources, r.AssetType, r.Quote)
+	if err != nil {
+		return nil, err
+	}
+	b, err := agg.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	return consolidatedOrderbookResponse(b, r.MaxLevels), nil
+}
+
+// GetConsolidatedOrderbookStream streams the consolidated orderbook each time
+// one of its venues is updated
+func (s *RPCServer) GetConsolidatedOrderbookStream(r *gctrpc.GetConsolidatedOrderbookRequest, stream gctrpc.GoCryptoTraderService_GetConsolidatedOrderbookStreamServer) error {
+	agg, err := s.newConsolidatedAggregator(r.Sources, r.AssetType, r.Quote)
+	if err != nil {
+		return err
+	}
+	for {
+		alert := agg.Wait(stream.Context().Done())
+		var resp *gctrpc.ConsolidatedOrderbookResponse
+		b, err := agg.Retrieve()
+		if err != nil {
+			resp = &gctrpc.ConsolidatedOrderbookResponse{
+				AssetType:   r.AssetType,
+				LastUpdated: time.Now().UnixMicro(),
+				Error:       err.Error(),
+			}
+		} else {
+			resp = consolidatedOrderbookResponse(b, r.MaxLevels)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		if kicked := <-alert; kicked {
+			return stream.Context().Err()
+		}
+	}
+}
+
+// SimulateConsolidatedOrder simulates an order across the consolidated
+// orderbook of multiple exchanges and returns the amount filled on each venue
+func (s *RPCServer) SimulateConsolidatedOrder(_ context.Context, r *gctrpc.SimulateConsolidatedOrderRequest) (*gctrpc.SimulateConsolidatedOrderResponse, error) {
+	agg, err := s.newConsolidatedAggregator(r.Sources, r.AssetType, r.Quote)
+	if err != nil {
+		return nil, err
+	}
+	b, err := agg.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	buy := strings.EqualFold(r.Side, order.Buy.String()) || strings.EqualFold(r.Side, order.Bid.String())
+	result, err := b.SimulateOrder(r.Amount, buy)
+	if err != nil {
+		return nil, err
+	}
+	return simulateConsolidatedOrderResponse(result), nil
+}
+
+// ConsolidatedWhaleBomb finds the amount required to reach a price target
+// across the consolidated orderbook of multiple exchanges
+func (s *RPCServer) ConsolidatedWhaleBomb(_ context.Context, r *gctrpc.ConsolidatedWhaleBombRequest) (*gctrpc.SimulateConsolidatedOrderResponse, error) {
+	agg, err := s.newConsolidatedAggregator(r.Sources, r.AssetType, r.Quote)
+	if err != nil {
+		return nil, err
+	}
+	b, err := agg.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	buy := strings.EqualFold(r.Side, order.Buy.String()) || strings.EqualFold(r.Side, order.Bid.String())
+	result, err := b.WhaleBomb(r.PriceTarget, buy)
+	if err != nil {
+		return nil, err
+	}
+	return simulateConsolidatedOrderResponse(result), nil
+}
+
+// RouteOrder splits an order without an exchange across every enabled
+// exchange holding funds for it and returns the aggregated fill report
+func (s *RPCServer) RouteOrder(ctx context.Context, r *gctrpc.RouteOrderRequest) (*gctrpc.RouteOrderResponse, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+
+	router, err := NewOrderRouter(s.ExchangeManager, s.OrderManager)
+	if err != nil {
+		return nil, err
+	}
+	report, err := router.Route(ctx, &order.Submit{
+		Pair:          currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		AssetType:     a,
+		Side:          side,
+		Type:          oType,
+		Amount:        r.Amount,
+		Price:         r.Price,
+		ClientID:      r.ClientId,
+		ClientOrderID: r.ClientId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]*gctrpc.RoutedChildOrder, len(report.Children))
+	for i := range report.Children {
+		children[i] = &gctrpc.RoutedChildOrder{
+			Exchange:       report.Children[i].Exchange,
+			Amount:         report.Children[i].Amount,
+			ExpectedPrice:  report.Children[i].ExpectedPrice,
+			FeeRate:        report.Children[i].FeeRate,
+			OrderId:        report.Children[i].OrderID,
+			Status:         report.Children[i].Status.String(),
+			ExecutedAmount: report.Children[i].ExecutedAmount,
+			AveragePrice:   report.Children[i].AveragePrice,
+		}
+		if report.Children[i].Err != nil {
+			children[i].Error = report.Children[i].Err.Error()
+		}
+	}
+	excluded := make([]*gctrpc.RouteExclusion, len(report.Excluded))
+	for i := range report.Excluded {
+		excluded[i] = &gctrpc.RouteExclusion{
+			Exchange: report.Excluded[i].Exchange,
+			Error:    report.Excluded[i].Err.Error(),
+		}
+	}
+	return &gctrpc.RouteOrderResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: report.Pair.Delimiter,
+			Base:      report.Pair.Base.String(),
+			Quote:     report.Pair.Quote.String(),
+		},
+		AssetType:            report.Asset.String(),
+		Side:                 report.Side.String(),
+		OrderType:            report.Type.String(),
+		RequestedAmount:      report.RequestedAmount,
+		RoutedAmount:         report.RoutedAmount,
+		FilledAmount:         report.FilledAmount,
+		ExpectedPrice:        report.ExpectedPrice,
+		EffectivePrice:       report.EffectivePrice,
+		BestSingleVenue:      report.BestSingleVenue,
+		BestSingleVenuePrice: report.BestSingleVenuePrice,
+		PriceImprovement:     report.PriceImprovement,
+		Children:             children,
+		Excluded:             excluded,
+	}, nil
+}
+
+// GetArbitrageOpportunities returns the opportunities most recently published
+// by the arbitrage manager, newest first
+func (s *RPCServer) GetArbitrageOpportunities(_ context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetArbitrageOpportunitiesRequest", common.ErrNilPointer)
+	}
+	opps, err := s.arbitrageManager.GetOpportunities()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{
+		Opportunities: make([]*gctrpc.ArbitrageOpportunity, len(opps)),
+	}
+	for i := range opps {
+		resp.Opportunities[i] = arbitrageOpportunityToRPC(&opps[i])
+	}
+	return resp, nil
+}
+
+// GetArbitrageOpportunityStream streams opportunities as they are published
+// by the arbitrage manager
+func (s *RPCServer) GetArbitrageOpportunityStream(r *gctrpc.GetArbitrageOpportunitiesRequest, stream gctrpc.GoCryptoTraderService_GetArbitrageOpportunityStreamServer) error {
+	if r == nil {
+		return fmt.Errorf("%w GetArbitrageOpportunitiesRequest", common.ErrNilPointer)
+	}
+	pipe, err := s.arbitrageManager.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if pipeErr := pipe.Release(); pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			opp, ok := data.(*ArbitrageOpportunity)
+			if !ok {
+				return common.GetTypeAssertError("*engine.ArbitrageOpportunity", data)
+			}
+			if err := stream.Send(arbitrageOpportunityToRPC(opp)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func arbitrageOpportunityToRPC(opp *ArbitrageOpportunity) *gctrpc.ArbitrageOpportunity {
+	legs := make([]*gctrpc.ArbitrageLeg, len(opp.Legs))
+	for i := range opp.Legs {
+		legs[i] = &gctrpc.ArbitrageLeg{
+			Exchange: opp.Legs[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: opp.Legs[i].Pair.Delimiter,
+				Base:      opp.Legs[i].Pair.Base.String(),
+				Quote:     opp.Legs[i].Pair.Quote.String(),
+			},
+			Side:         opp.Legs[i].Side.String(),
+			Amount:       opp.Legs[i].Amount,
+			AveragePrice: opp.Legs[i].AveragePrice,
+			FeeRate:      opp.Legs[i].FeeRate,
+			OrderId:      opp.Legs[i].OrderID,
+		}
+		if opp.Legs[i].Err != nil {
+			legs[i].Error = opp.Legs[i].Err.Error()
+		}
+	}
+	return &gctrpc.ArbitrageOpportunity{
+		Id:           opp.ID,
+		Type:         string(opp.Type),
+		AssetType:    opp.Asset.String(),
+		Currency:     opp.Currency.String(),
+		StartAmount:  opp.StartAmount,
+		EndAmount:    opp.EndAmount,
+		Profit:       opp.Profit,
+		NetEdge:      opp.NetEdge,
+		GrossEdge:    opp.GrossEdge,
+		Transferable: opp.Transferable,
+		Legs:         legs,
+		DetectedAt:   timestamppb.New(opp.DetectedAt),
+		Executed:     opp.Executed,
+	}
+}
+
+// GetLedgerPnL returns the cost basis and realised and unrealised PnL of the
+// spot holdings tracked by the ledger manager
+func (s *RPCServer) GetLedgerPnL(_ context.Context, r *gctrpc.GetLedgerPnLRequest) (*gctrpc.GetLedgerPnLResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetLedgerPnLRequest", common.ErrNilPointer)
+	}
+	codes := make([]currency.Code, len(r.Currencies))
+	for i := range r.Currencies {
+		codes[i] = currency.NewCode(r.Currencies[i])
+	}
+	pnl, err := s.ledgerManager.GetPnL(codes...)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetLedgerPnLResponse{
+		Fiat:        pnl.Fiat.String(),
+		Method:      pnl.Method.String(),
+		Assets:      make([]*gctrpc.LedgerAssetPnL, len(pnl.Assets)),
+		CostBasis:   pnl.CostBasis,
+		MarketValue: pnl.MarketValue,
+		Realised:    pnl.Realised,
+		Unrealised:  pnl.Unrealised,
+		Total:       pnl.Total,
+	}
+	for i := range pnl.Assets {
+		a := &pnl.Assets[i]
+		lots := make([]*gctrpc.LedgerLot, len(a.Lots))
+		for j := range a.Lots {
+			lots[j] = &gctrpc.LedgerLot{
+				Exchange: a.Lots[j].Exchange,
+				Amount:   a.Lots[j].Amount,
+				Cost:     a.Lots[j].Cost,
+				Acquired: timestamppb.New(a.Lots[j].Acquired),
+			}
+		}
+		resp.Assets[i] = &gctrpc.LedgerAssetPnL{
+			Currency:    a.Currency.String(),
+			Amount:      a.Amount,
+			CostBasis:   a.CostBasis,
+			AverageCost: a.AverageCost,
+			MarketPrice: a.MarketPrice,
+			MarketValue: a.MarketValue,
+			Realised:    a.Realised,
+			Unrealised:  a.Unrealised,
+			Total:       a.Total,
+			Disposed:    a.Disposed,
+			Unmatched:   a.Unmatched,
+			Lots:        lots,
+		}
+		if a.Err != nil {
+			resp.Assets[i].Error = a.Err.Error()
+		}
+	}
+	return resp, nil
+}
+
+// AddLedgerTransfer records a deposit or withdrawal made outside of the engine
+// against the ledger manager
+func (s *RPCServer) AddLedgerTransfer(_ context.Context, r *gctrpc.AddLedgerTransferRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w AddLedgerTransferRequest", common.ErrNilPointer)
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	e := &ledger.Entry{
+		Exchange:    exch.GetName(),
+		Type:        ledger.EntryType(strings.ToUpper(r.Type)),
+		Currency:    currency.NewCode(r.Currency),
+		Amount:      r.Amount,
+		Price:       r.Price,
+		Fee:         r.Fee,
+		FeeCurrency: currency.NewCode(r.FeeCurrency),
+	}
+	if err := s.ledgerManager.RecordTransfer(e); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: e.ID}, nil
+}
+
+// GetPortfolioEquityCurve returns the fiat value of the holdings stored in
+// portfolio snapshots over a date range, optionally limited to an exchange or
+// currency
+func (s *RPCServer) GetPortfolioEquityCurve(_ context.Context, r *gctrpc.GetPortfolioEquityCurveRequest) (*gctrpc.GetPortfolioEquityCurveResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetPortfolioEquityCurveRequest", common.ErrNilPointer)
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	var exchName string
+	if r.Exchange != "" {
+		var exch exchange.IBotExchange
+		if exch, err = s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+		exchName = exch.GetName()
+	}
+	curve, err := s.portfolioManager.GetEquityCurve(start, end, exchName, currency.NewCode(r.Currency))
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioEquityCurveResponse{
+		Fiat:     curve.Fiat.String(),
+		Exchange: curve.Exchange,
+		Currency: curve.Currency.Upper().String(),
+		Points:   make([]*gctrpc.EquityPoint, len(curve.Points)),
+	}
+	for i := range curve.Points {
+		resp.Points[i] = &gctrpc.EquityPoint{
+			Timestamp: timestamppb.New(curve.Points[i].Time),
+			Amount:    curve.Points[i].Amount,
+			Value:     curve.Points[i].Value,
+		}
+	}
+	return resp, nil
+}
+
+// ReloadConfig reads the config file and applies any changes made to it to the
+// running engine. No changes are applied if any of them require a restart
+func (s *RPCServer) ReloadConfig(_ context.Context, _ *gctrpc.ReloadConfigRequest) (*gctrpc.ReloadConfigResponse, error) {
+	result, err := s.configReloader.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ReloadConfigResponse{Applied: result.Applied}, nil
+}
+
+// CreateRPCToken creates a gRPC bearer token with the requested scopes. The
+// token is only returned in this response
+func (s *RPCServer) CreateRPCToken(ctx context.Context, r *gctrpc.CreateRPCTokenRequest) (*gctrpc.CreateRPCTokenResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CreateRPCTokenRequest", common.ErrNilPointer)
+	}
+	expires, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse expiry time %v", errInvalidTimes, err)
+	}
+	var createdBy string
+	if p, err := rpcPrincipalFromContext(ctx); err == nil {
+		createdBy = p.identity()
+	}
+	token, details, err := s.rpcAuth.createToken(r.Name, createdBy, r.Scopes, expires)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CreateRPCTokenResponse{Details: rpcTokenToProto(details), Token: token}, nil
+}
+
+// GetRPCTokens returns the details of the gRPC bearer tokens
+func (s *RPCServer) GetRPCTokens(_ context.Context, _ *gctrpc.GetRPCTokensRequest) (*gctrpc.GetRPCTokensResponse, error) {
+	tokens, err := s.rpcAuth.tokens()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRPCTokensResponse{Tokens: make([]*gctrpc.RPCToken, len(tokens))}
+	for i := range tokens {
+		resp.Tokens[i] = rpcTokenToProto(&tokens[i])
+	}
+	return resp, nil
+}
+
+// RevokeRPCToken removes a gRPC bearer token
+func (s *RPCServer) RevokeRPCToken(_ context.Context, r *gctrpc.RevokeRPCTokenRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RevokeRPCTokenRequest", common.ErrNilPointer)
+	}
+	if err := s.rpcAuth.revokeToken(r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+func rpcTokenToProto(t *config.RPCToken) *gctrpc.RPCToken {
+	return &gctrpc.RPCToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		Expires:   timestamppb.New(t.Expires),
+		Expired:   !t.Expires.After(time.Now()),
+	}
+}
+
+// GetPendingWithdrawals returns the withdrawals awaiting approval from a
+// second principal
+func (s *RPCServer) GetPendingWithdrawals(_ context.Context, _ *gctrpc.GetPendingWithdrawalsRequest) (*gctrpc.GetPendingWithdrawalsResponse, error) {
+	pending, err := s.WithdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPendingWithdrawalsResponse{
+		Withdrawals: make([]*gctrpc.PendingWithdrawal, len(pending)),
+	}
+	for i := range pending {
+		w := &gctrpc.PendingWithdrawal{
+			Id:          pending[i].ID.String(),
+			Exchange:    pending[i].Request.Exchange,
+			Currency:    pending[i].Request.Currency.String(),
+			Amount:      pending[i].Request.Amount,
+			Description: pending[i].Request.Description,
+			RequestedBy: pending[i].RequestedBy,
+			CreatedAt:   timestamppb.New(pending[i].CreatedAt),
+			Expires:     timestamppb.New(pending[i].Expires),
+		}
+		switch pending[i].Request.Type {
+		case withdraw.Crypto:
+			w.Type = "crypto"
+			w.Address = pending[i].Request.Crypto.Address
+		case withdraw.Fiat:
+			w.Type = "fiat"
+			w.BankAccountId = pending[i].Request.Fiat.Bank.ID
+		}
+		resp.Withdrawals[i] = w
+	}
+	return resp, nil
+}
+
+// ApproveWithdrawal submits a withdrawal which is pending approval to its
+// exchange. It must be approved by a different principal to the one which
+// requested it
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.ApproveWithdrawalRequest) (*gctrpc.WithdrawResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w ApproveWithdrawalRequest", common.ErrNilPointer)
+	}
+	resp, err := s.WithdrawManager.ApproveWithdrawal(ctx, r.Id, r.TotpCode, s.setWithdrawalCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal rejects a withdrawal which is pending approval
+func (s *RPCServer) RejectWithdrawal(ctx context.Context, r *gctrpc.RejectWithdrawalRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RejectWithdrawalRequest", common.ErrNilPointer)
+	}
+	if err := s.WithdrawManager.RejectWithdrawal(ctx, r.Id, r.Reason); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetFundingRateScan returns the perpetual funding rates and ranked carry
+// opportunities of the funding rate scanner's most recent scan, optionally
+// filtered by underlying currency
+func (s *RPCServer) GetFundingRateScan(_ context.Context, r *gctrpc.GetFundingRateScanRequest) (*gctrpc.GetFundingRateScanResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetFundingRateScanRequest", common.ErrNilPointer)
+	}
+	scan, err := s.fundingRateScanner.GetScan()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFundingRateScanResponse{
+		ScannedAt: timestamppb.New(scan.Time),
+	}
+	for i := range scan.Rates {
+		if !matchesUnderlying(scan.Rates[i].Underlying.Base.String(), scan.Rates[i].Underlying.Quote.String(), r.Base, r.Quote) {
+			continue
+		}
+		rate := &gctrpc.PerpetualFundingRate{
+			Exchange:                scan.Rates[i].Exchange,
+			Asset:                   scan.Rates[i].Asset.String(),
+			Pair:                    currencyPairToRPC(scan.Rates[i].Pair),
+			Underlying:              currencyPairToRPC(scan.Rates[i].Underlying),
+			SettlementType:          scan.Rates[i].SettlementType.String(),
+			Rate:                    scan.Rates[i].Rate,
+			PredictedRate:           scan.Rates[i].PredictedRate,
+			FundingInterval:         scan.Rates[i].Interval.String(),
+			AnnualisedRate:          scan.Rates[i].AnnualisedRate,
+			AnnualisedPredictedRate: scan.Rates[i].AnnualisedPredictedRate,
+			TakerFee:                scan.Rates[i].TakerFee,
+			RateTime:                timestamppb.New(scan.Rates[i].RateTime),
+		}
+		if !scan.Rates[i].TimeOfNextRate.IsZero() {
+			rate.TimeOfNextRate = timestamppb.New(scan.Rates[i].TimeOfNextRate)
+		}
+		resp.Rates = append(resp.Rates, rate)
+	}
+	for i := range scan.Opportunities {
+		opp := &scan.Opportunities[i]
+		if !matchesUnderlying(opp.Underlying.Base.String(), opp.Underlying.Quote.String(), r.Base, r.Quote) {
+			continue
+		}
+		resp.Opportunities = append(resp.Opportunities, &gctrpc.FundingOpportunity{
+			Type:       string(opp.Type),
+			Underlying: currencyPairToRPC(opp.Underlying),
+			LongLeg:    fundingLegToRPC(&opp.Long),
+			ShortLeg:   fundingLegToRPC(&opp.Short),
+			GrossRate:  opp.GrossRate,
+			FeeRate:    opp.FeeRate,
+			NetRate:    opp.NetRate,
+			DetectedAt: timestamppb.New(opp.DetectedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetFundingOpportunityHistory returns the funding opportunities stored by the
+// funding rate scanner between the start and end dates, optionally filtered by
+// underlying currency
+func (s *RPCServer) GetFundingOpportunityHistory(_ context.Context, r *gctrpc.GetFundingOpportunityHistoryRequest) (*gctrpc.GetFundingOpportunityHistoryResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetFundingOpportunityHistoryRequest", common.ErrNilPointer)
+	}
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	if err = common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	opps, err := fundingopportunity.GetInRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFundingOpportunityHistoryResponse{}
+	for i := range opps {
+		if !matchesUnderlying(opps[i].Base, opps[i].Quote, r.Base, r.Quote) {
+			continue
+		}
+		resp.Opportunities = append(resp.Opportunities, &gctrpc.FundingOpportunity{
+			Type: opps[i].Type,
+			Underlying: &gctrpc.CurrencyPair{
+				Base:  opps[i].Base,
+				Quote: opps[i].Quote,
+			},
+			LongLeg: &gctrpc.FundingLeg{
+				Exchange:       opps[i].LongExchange,
+				Asset:          opps[i].LongAsset,
+				AnnualisedRate: opps[i].LongRate,
+			},
+			ShortLeg: &gctrpc.FundingLeg{
+				Exchange:       opps[i].ShortExchange,
+				Asset:          opps[i].ShortAsset,
+				AnnualisedRate: opps[i].ShortRate,
+			},
+			GrossRate:  opps[i].GrossRate,
+			FeeRate:    opps[i].FeeRate,
+			NetRate:    opps[i].NetRate,
+			DetectedAt: timestamppb.New(opps[i].Timestamp),
+		})
+	}
+	return resp, nil
+}
+
+// matchesUnderlying returns whether an underlying base and quote match the
+// optional base and quote filters
+func matchesUnderlying(base, quote, filterBase, filterQuote string) bool {
+	return (filterBase == "" || strings.EqualFold(base, filterBase)) &&
+		(filterQuote == "" || strings.EqualFold(quote, filterQuote))
+}
+
+func currencyPairToRPC(p currency.Pair) *gctrpc.CurrencyPair {
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}
+}
+
+func fundingLegToRPC(leg *FundingLeg) *gctrpc.FundingLeg {
+	return &gctrpc.FundingLeg{
+		Exchange:       leg.Exchange,
+		Asset:          leg.Asset.String(),
+		Pair:           currencyPairToRPC(leg.Pair),
+		AnnualisedRate: leg.AnnualisedRate,
+		TakerFee:       leg.TakerFee,
+	}
+}
@@ -0,0 +1,605 @@
+package engine
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// rpcMethodScopes maps each gRPC method to the scope required to call it.
+// Methods which are not listed require the admin scope
+var rpcMethodScopes = map[string]string{
+	"GetInfo":                           RPCScopeRead,
+	"GetSubsystems":                     RPCScopeRead,
+	"GetRPCEndpoints":                   RPCScopeRead,
+	"GetCommunicationRelayers":          RPCScopeRead,
+	"GetExchanges":                      RPCScopeRead,
+	"GetExchangeInfo":                   RPCScopeRead,
+	"GetTicker":                         RPCScopeRead,
+	"GetTickers":                        RPCScopeRead,
+	"GetOrderbook":                      RPCScopeRead,
+	"GetOrderbooks":                     RPCScopeRead,
+	"GetAccountInfo":                    RPCScopeRead,
+	"UpdateAccountInfo":                 RPCScopeRead,
+	"GetAccountInfoStream":              RPCScopeRead,
+	"GetPortfolio":                      RPCScopeRead,
+	"GetPortfolioSummary":               RPCScopeRead,
+	"GetForexProviders":                 RPCScopeRead,
+	"GetForexRates":                     RPCScopeRead,
+	"GetOrders":                         RPCScopeRead,
+	"GetOrder":                          RPCScopeRead,
+	"SimulateOrder":                     RPCScopeRead,
+	"WhaleBomb":                         RPCScopeRead,
+	"GetEvents":                         RPCScopeRead,
+	"GetCryptocurrencyDepositAddresses": RPCScopeRead,
+	"GetCryptocurrencyDepositAddress":   RPCScopeRead,
+	"GetAvailableTransferChains":        RPCScopeRead,
+	"WithdrawalEventByID":               RPCScopeRead,
+	"WithdrawalEventsByExchange":        RPCScopeRead,
+	"WithdrawalEventsByDate":            RPCScopeRead,
+	"GetExchangePairs":                  RPCScopeRead,
+	"GetOrderbookStream":                RPCScopeRead,
+	"GetExchangeOrderbookStream":        RPCScopeRead,
+	"GetTickerStream":                   RPCScopeRead,
+	"GetExchangeTickerStream":           RPCScopeRead,
+	"GetHistoricCandles":                RPCScopeRead,
+	"GetExchangeAssets":                 RPCScopeRead,
+	"WebsocketGetInfo":                  RPCScopeRead,
+	"WebsocketGetSubscriptions":         RPCScopeRead,
+	"GetRecentTrades":                   RPCScopeRead,
+	"GetHistoricTrades":                 RPCScopeRead,
+	"GetSavedTrades":                    RPCScopeRead,
+	"FindMissingSavedCandleIntervals":   RPCScopeRead,
+	"FindMissingSavedTradeIntervals":    RPCScopeRead,
+	"GetDataHistoryJobDetails":          RPCScopeRead,
+	"GetActiveDataHistoryJobs":          RPCScopeRead,
+	"GetDataHistoryJobsBetween":         RPCScopeRead,
+	"GetDataHistoryJobSummary":          RPCScopeRead,
+	"GetManagedOrders":                  RPCScopeRead,
+	"CurrencyStateGetAll":               RPCScopeRead,
+	"CurrencyStateTrading":              RPCScopeRead,
+	"CurrencyStateDeposit":              RPCScopeRead,
+	"CurrencyStateWithdraw":             RPCScopeRead,
+	"CurrencyStateTradingPair":          RPCScopeRead,
+	"GetFuturesPositionsSummary":        RPCScopeRead,
+	"GetFuturesPositionsOrders":         RPCScopeRead,
+	"GetCollateral":                     RPCScopeRead,
+	"GetTechnicalAnalysis":              RPCScopeRead,
+	"GetMarginRatesHistory":             RPCScopeRead,
+	"GetManagedPosition":                RPCScopeRead,
+	"GetAllManagedPositions":            RPCScopeRead,
+	"GetFundingRates":                   RPCScopeRead,
+	"GetLatestFundingRate":              RPCScopeRead,
+	"GetOrderbookMovement":              RPCScopeRead,
+	"GetOrderbookAmountByNominal":       RPCScopeRead,
+	"GetOrderbookAmountByImpact":        RPCScopeRead,
+	"GetCollateralMode":                 RPCScopeRead,
+	"GetLeverage":                       RPCScopeRead,
+	"GetOpenInterest":                   RPCScopeRead,
+	"GetCurrencyTradeURL":               RPCScopeRead,
+	"GetSyntheticOrders":                RPCScopeRead,
+	"GetExecutionAlgorithms":            RPCScopeRead,
+	"GetRiskStatus":                     RPCScopeRead,
+	"GetConsolidatedOrderbook":          RPCScopeRead,
+	"GetConsolidatedOrderbookStream":    RPCScopeRead,
+	"SimulateConsolidatedOrder":         RPCScopeRead,
+	"ConsolidatedWhaleBomb":             RPCScopeRead,
+	"GetArbitrageOpportunities":         RPCScopeRead,
+	"GetArbitrageOpportunityStream":     RPCScopeRead,
+	"GetLedgerPnL":                      RPCScopeRead,
+	"GetPortfolioEquityCurve":           RPCScopeRead,
+	"GetPendingWithdrawals":             RPCScopeRead,
+	"GetFundingRateScan":                RPCScopeRead,
+	"GetFundingOpportunityHistory":      RPCScopeRead,
+
+	"SubmitOrder":              RPCScopeTrade,
+	"CancelOrder":              RPCScopeTrade,
+	"CancelBatchOrders":        RPCScopeTrade,
+	"CancelAllOrders":          RPCScopeTrade,
+	"ModifyOrder":              RPCScopeTrade,
+	"AddEvent":                 RPCScopeTrade,
+	"RemoveEvent":              RPCScopeTrade,
+	"SetCollateralMode":        RPCScopeTrade,
+	"SetMarginType":            RPCScopeTrade,
+	"SetLeverage":              RPCScopeTrade,
+	"ChangePositionMargin":     RPCScopeTrade,
+	"AddSyntheticOrder":        RPCScopeTrade,
+	"CancelSyntheticOrder":     RPCScopeTrade,
+	"StartExecutionAlgorithm":  RPCScopeTrade,
+	"PauseExecutionAlgorithm":  RPCScopeTrade,
+	"ResumeExecutionAlgorithm": RPCScopeTrade,
+	"CancelExecutionAlgorithm": RPCScopeTrade,
+	"RouteOrder":               RPCScopeTrade,
+	"AddLedgerTransfer":        RPCScopeTrade,
+
+	"WithdrawFiatFunds":           RPCScopeWithdraw,
+	"WithdrawCryptocurrencyFunds": RPCScopeWithdraw,
+	"ApproveWithdrawal":           RPCScopeWithdraw,
+	"RejectWithdrawal":            RPCScopeWithdraw,
+
+	"EnableSubsystem":                  RPCScopeAdmin,
+	"DisableSubsystem":                 RPCScopeAdmin,
+	"EnableExchange":                   RPCScopeAdmin,
+	"DisableExchange":                  RPCScopeAdmin,
+	"GetExchangeOTPCode":               RPCScopeAdmin,
+	"GetExchangeOTPCodes":              RPCScopeAdmin,
+	"GetConfig":                        RPCScopeAdmin,
+	"ReloadConfig":                     RPCScopeAdmin,
+	"AddPortfolioAddress":              RPCScopeAdmin,
+	"RemovePortfolioAddress":           RPCScopeAdmin,
+	"GetLoggerDetails":                 RPCScopeAdmin,
+	"SetLoggerDetails":                 RPCScopeAdmin,
+	"SetExchangePair":                  RPCScopeAdmin,
+	"SetExchangeAsset":                 RPCScopeAdmin,
+	"SetAllExchangePairs":              RPCScopeAdmin,
+	"UpdateExchangeSupportedPairs":     RPCScopeAdmin,
+	"GetAuditEvent":                    RPCScopeAdmin,
+	"GCTScriptExecute":                 RPCScopeAdmin,
+	"GCTScriptUpload":                  RPCScopeAdmin,
+	"GCTScriptReadScript":              RPCScopeAdmin,
+	"GCTScriptStatus":                  RPCScopeAdmin,
+	"GCTScriptQuery":                   RPCScopeAdmin,
+	"GCTScriptStop":                    RPCScopeAdmin,
+	"GCTScriptStopAll":                 RPCScopeAdmin,
+	"GCTScriptListAll":                 RPCScopeAdmin,
+	"GCTScriptAutoLoadToggle":          RPCScopeAdmin,
+	"WebsocketSetEnabled":              RPCScopeAdmin,
+	"WebsocketSetProxy":                RPCScopeAdmin,
+	"WebsocketSetURL":                  RPCScopeAdmin,
+	"ConvertTradesToCandles":           RPCScopeAdmin,
+	"SetExchangeTradeProcessing":       RPCScopeAdmin,
+	"UpsertDataHistoryJob":             RPCScopeAdmin,
+	"SetDataHistoryJobStatus":          RPCScopeAdmin,
+	"UpdateDataHistoryJobPrerequisite": RPCScopeAdmin,
+	"SetKillSwitch":                    RPCScopeAdmin,
+	"Shutdown":                         RPCScopeAdmin,
+	"CreateRPCToken":                   RPCScopeAdmin,
+	"GetRPCTokens":                     RPCScopeAdmin,
+	"RevokeRPCToken":                   RPCScopeAdmin,
+}
+
+// isValidRPCScope returns whether a scope can be granted
+func isValidRPCScope(scope string) bool {
+	switch scope {
+	case RPCScopeRead, RPCScopeTrade, RPCScopeWithdraw, RPCScopeAdmin:
+		return true
+	}
+	return false
+}
+
+// checkRPCScopes verifies that scopes are set and valid
+func checkRPCScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return errNoRPCScopes
+	}
+	for _, s := range scopes {
+		if !isValidRPCScope(s) {
+			return fmt.Errorf("%w: %q", errInvalidRPCScope, s)
+		}
+	}
+	return nil
+}
+
+// rpcEventActionScopes holds the scopes required to add events with actions
+// which trade or run scripts. Other actions only require the AddEvent scope
+var rpcEventActionScopes = map[string]string{
+	ActionSubmitOrder:  RPCScopeTrade,
+	ActionCancelOrders: RPCScopeTrade,
+	// Scripts can perform any action, including withdrawals
+	ActionRunScript: RPCScopeAdmin,
+}
+
+// rpcMethodScope returns the scope required to call a full gRPC method name
+func rpcMethodScope(fullMethod string) string {
+	if scope, ok := rpcMethodScopes[fullMethod[strings.LastIndexByte(fullMethod, '/')+1:]]; ok {
+		return scope
+	}
+	return RPCScopeAdmin
+}
+
+// newRPCAuth checks the gRPC principals and tokens in the remote control config
+// and returns an authenticator for them
+func newRPCAuth(cfg *config.RemoteControlConfig) (*rpcAuth, error) {
+	if err := checkRPCAuthConfig(cfg); err != nil {
+		return nil, err
+	}
+	return &rpcAuth{cfg: cfg, verified: make(map[[sha256.Size]byte]*rpcPrincipal)}, nil
+}
+
+// checkRPCAuthConfig checks the gRPC principals and tokens in a remote control
+// config
+func checkRPCAuthConfig(cfg *config.RemoteControlConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("%w RemoteControlConfig", errNilConfig)
+	}
+	if strings.HasPrefix(cfg.Username, rpcTokenPrincipalPrefix) {
+		return fmt.Errorf("%w %q: username must not start with %q", errRPCPrincipalInvalid, cfg.Username, rpcTokenPrincipalPrefix)
+	}
+	usernames := map[string]bool{cfg.Username: true}
+	for i := range cfg.Principals {
+		p := &cfg.Principals[i]
+		if p.Username == "" {
+			return fmt.Errorf("%w %d: username unset", errRPCPrincipalInvalid, i)
+		}
+		if strings.HasPrefix(p.Username, rpcTokenPrincipalPrefix) {
+			return fmt.Errorf("%w %q: username must not start with %q", errRPCPrincipalInvalid, p.Username, rpcTokenPrincipalPrefix)
+		}
+		if usernames[p.Username] {
+			return fmt.Errorf("%w %q", errRPCPrincipalDuplicate, p.Username)
+		}
+		usernames[p.Username] = true
+		if _, err := bcrypt.Cost([]byte(p.PasswordHash)); err != nil {
+			return fmt.Errorf("%w %q: passwordHash must be a bcrypt hash: %w", errRPCPrincipalInvalid, p.Username, err)
+		}
+		if err := checkRPCScopes(p.Scopes); err != nil {
+			return fmt.Errorf("%w %q: %w", errRPCPrincipalInvalid, p.Username, err)
+		}
+	}
+	for i := range cfg.Tokens {
+		if usernames[cfg.Tokens[i].Name] {
+			return fmt.Errorf("gRPC token %q: %w", cfg.Tokens[i].Name, errRPCTokenNameReserved)
+		}
+		if err := checkRPCScopes(cfg.Tokens[i].Scopes); err != nil {
+			return fmt.Errorf("gRPC token %q: %w", cfg.Tokens[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// update replaces the gRPC principals and tokens with those of an updated
+// remote control config. Cached credentials are cleared so that principals
+// which are changed or removed can no longer authenticate with them
+func (a *rpcAuth) update(cfg *config.RemoteControlConfig) error {
+	if a == nil {
+		return errNilRPCAuth
+	}
+	if err := checkRPCAuthConfig(cfg); err != nil {
+		return err
+	}
+	principals := slices.Clone(cfg.Principals)
+	for i := range principals {
+		principals[i].Scopes = slices.Clone(principals[i].Scopes)
+	}
+	tokens := slices.Clone(cfg.Tokens)
+	for i := range tokens {
+		tokens[i].Scopes = slices.Clone(tokens[i].Scopes)
+	}
+
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.cfg.Principals = principals
+	a.cfg.Tokens = tokens
+	a.verifiedMtx.Lock()
+	clear(a.verified)
+	a.verifiedMtx.Unlock()
+	return nil
+}
+
+// authenticate returns the principal for an authorization header value
+func (a *rpcAuth) authenticate(authHeader string) (*rpcPrincipal, error) {
+	if a == nil {
+		return nil, fmt.Errorf("%w: %w", errRPCUnauthenticated, errNilRPCAuth)
+	}
+	if authHeader == "" {
+		return nil, fmt.Errorf("%w: %w", errRPCUnauthenticated, errRPCAuthHeaderMissing)
+	}
+	scheme, value, ok := strings.Cut(authHeader, " ")
+	if !ok {
+		return nil, fmt.Errorf("%w: %w", errRPCUnauthenticated, errRPCAuthHeaderMalformed)
+	}
+	switch strings.ToLower(scheme) {
+	case "basic":
+		return a.authenticateBasic(value)
+	case "bearer":
+		return a.authenticateToken(value)
+	}
+	return nil, fmt.Errorf("%w: %w %q", errRPCUnauthenticated, errRPCAuthSchemeInvalid, scheme)
+}
+
+// authenticateBasic checks basic auth credentials against the remote control
+// username and password, which has full access, then the gRPC principals
+func (a *rpcAuth) authenticateBasic(value string) (*rpcPrincipal, error) {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to base64 decode authorization header", errRPCUnauthenticated)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: %w", errRPCUnauthenticated, errRPCAuthHeaderMalformed)
+	}
+
+	a.m.RLock()
+	defer a.m.RUnlock()
+	if subtle.ConstantTimeCompare([]byte(username), []byte(a.cfg.Username)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(password), []byte(a.cfg.Password)) == 1 {
+		return &rpcPrincipal{name: username, scopes: []string{RPCScopeAdmin}}, nil
+	}
+
+	key := sha256.Sum256(decoded)
+	a.verifiedMtx.Lock()
+	p, ok := a.verified[key]
+	a.verifiedMtx.Unlock()
+	if ok {
+		return p, nil
+	}
+	for i := range a.cfg.Principals {
+		cfgPrincipal := &a.cfg.Principals[i]
+		if cfgPrincipal.Username != username {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword([]byte(cfgPrincipal.PasswordHash), []byte(password)) != nil {
+			break
+		}
+		p = &rpcPrincipal{name: username, scopes: slices.Clone(cfgPrincipal.Scopes)}
+		a.verifiedMtx.Lock()
+		a.verified[key] = p
+		a.verifiedMtx.Unlock()
+		return p, nil
+	}
+	return nil, fmt.Errorf("%w: %w for %q", errRPCUnauthenticated, errRPCCredentialsMismatch, username)
+}
+
+// authenticateToken checks a bearer token against the unexpired gRPC tokens
+func (a *rpcAuth) authenticateToken(token string) (*rpcPrincipal, error) {
+	hash := hashRPCToken(token)
+	a.m.RLock()
+	defer a.m.RUnlock()
+	for i := range a.cfg.Tokens {
+		t := &a.cfg.Tokens[i]
+		if subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hash)) != 1 {
+			continue
+		}
+		if !t.Expires.After(time.Now()) {
+			break
+		}
+		return &rpcPrincipal{name: rpcTokenPrincipalPrefix + t.Name, scopes: slices.Clone(t.Scopes), owner: t.CreatedBy}, nil
+	}
+	return nil, fmt.Errorf("%w: %w", errRPCUnauthenticated, errRPCTokenInvalid)
+}
+
+// createToken creates a gRPC token with the supplied scopes, returning the
+// token along with its stored details. Only a hash of the token is stored so
+// it cannot be retrieved again. The token is authenticated as a principal
+// named after the token, prefixed to keep it apart from users, which acts on
+// behalf of the user who created it
+func (a *rpcAuth) createToken(name, createdBy string, scopes []string, expires time.Time) (string, *config.RPCToken, error) {
+	if a == nil {
+		return "", nil, errNilRPCAuth
+	}
+	if name == "" {
+		return "", nil, errRPCTokenNameUnset
+	}
+	if err := checkRPCScopes(scopes); err != nil {
+		return "", nil, err
+	}
+	if !expires.After(time.Now()) {
+		return "", nil, errRPCTokenExpiryInvalid
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", nil, err
+	}
+	b := make([]byte, rpcTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token := rpcTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	a.m.Lock()
+	defer a.m.Unlock()
+	if name == a.cfg.Username || slices.ContainsFunc(a.cfg.Principals, func(p config.RPCPrincipal) bool { return p.Username == name }) {
+		return "", nil, fmt.Errorf("%w: %q", errRPCTokenNameReserved, name)
+	}
+	for i := range a.cfg.Tokens {
+		if a.cfg.Tokens[i].Name == name {
+			return "", nil, fmt.Errorf("%w: %q", errRPCTokenNameDuplicate, name)
+		}
+	}
+	t := config.RPCToken{
+		ID:        id.String(),
+		Name:      name,
+		Hash:      hashRPCToken(token),
+		Scopes:    slices.Clone(scopes),
+		CreatedAt: time.Now().UTC(),
+		Expires:   expires.UTC(),
+		CreatedBy: createdBy,
+	}
+	previous := a.cfg.Tokens
+	a.cfg.Tokens = append(slices.Clip(previous), t)
+	if err := a.saveTokens(previous); err != nil {
+		return "", nil, err
+	}
+	return token, &t, nil
+}
+
+// saveTokens saves the config after the tokens have changed, restoring the
+// previous tokens if it cannot be saved. Must be called with the lock held
+func (a *rpcAuth) saveTokens(previous []config.RPCToken) error {
+	if a.save == nil {
+		return nil
+	}
+	if err := a.save(); err != nil {
+		a.cfg.Tokens = previous
+		return fmt.Errorf("unable to save gRPC tokens: %w", err)
+	}
+	return nil
+}
+
+// tokens returns the details of the gRPC tokens
+func (a *rpcAuth) tokens() ([]config.RPCToken, error) {
+	if a == nil {
+		return nil, errNilRPCAuth
+	}
+	a.m.RLock()
+	defer a.m.RUnlock()
+	tokens := slices.Clone(a.cfg.Tokens)
+	for i := range tokens {
+		tokens[i].Scopes = slices.Clone(tokens[i].Scopes)
+	}
+	return tokens, nil
+}
+
+// revokeToken removes a gRPC token by ID
+func (a *rpcAuth) revokeToken(id string) error {
+	if a == nil {
+		return errNilRPCAuth
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	for i := range a.cfg.Tokens {
+		if a.cfg.Tokens[i].ID == id {
+			previous := a.cfg.Tokens
+			a.cfg.Tokens = slices.Delete(slices.Clone(previous), i, i+1)
+			return a.saveTokens(previous)
+		}
+	}
+	return fmt.Errorf("%w: %q", errRPCTokenNotFound, id)
+}
+
+// hashRPCToken returns the stored hash of a gRPC token. Tokens are random so a
+// plain hash is sufficient
+func hashRPCToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// authorise checks that the principal has the scope required to call a full
+// gRPC method name
+func (p *rpcPrincipal) authorise(fullMethod string) error {
+	return p.authoriseScope(fullMethod, rpcMethodScope(fullMethod))
+}
+
+// identity returns the user accountable for the principal's calls, which is
+// the user who created a token or the principal itself
+func (p *rpcPrincipal) identity() string {
+	if p.owner != "" {
+		return p.owner
+	}
+	return p.name
+}
+
+// authoriseScope checks the principal has been granted a scope required for an
+// operation
+func (p *rpcPrincipal) authoriseScope(operation, scope string) error {
+	if slices.Contains(p.scopes, RPCScopeAdmin) || slices.Contains(p.scopes, scope) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s requires the %q %w", errRPCPermissionDenied, operation, scope, errRPCScopeNotGranted)
+}
+
+// authoriseEventAction checks the principal adding an event has the scope
+// required by its action, as an event can perform actions which its method
+// scope does not grant. Events not added via gRPC are not checked
+func authoriseEventAction(ctx context.Context, action string) error {
+	p, err := rpcPrincipalFromContext(ctx)
+	if err != nil {
+		return nil //nolint:nilerr // events added outside of gRPC have no principal
+	}
+	scope, ok := rpcEventActionScopes[action]
+	if !ok {
+		return nil
+	}
+	return p.authoriseScope("AddEvent action "+action, scope)
+}
+
+// withRPCPrincipal stores an authenticated principal in a context
+func withRPCPrincipal(ctx context.Context, p *rpcPrincipal) context.Context {
+	return context.WithValue(ctx, rpcPrincipalKey{}, p)
+}
+
+// rpcPrincipalFromContext returns the principal authenticated for a gRPC call
+func rpcPrincipalFromContext(ctx context.Context) (*rpcPrincipal, error) {
+	if p, ok := ctx.Value(rpcPrincipalKey{}).(*rpcPrincipal); ok && p != nil {
+		return p, nil
+	}
+	return nil, errRPCPrincipalNotInContext
+}
+
+// authenticateClient authenticates a gRPC call and checks the caller has the
+// scope required for the method called. Denied calls are logged and written to
+// the audit log
+func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
+	method, _ := grpc.Method(ctx)
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, s.denyRPCCall(ctx, method, nil, fmt.Errorf("%w: %w", errRPCUnauthenticated, errRPCMetadataUnextracted))
+	}
+	var authHeader string
+	if v := md.Get("authorization"); len(v) > 0 {
+		authHeader = v[0]
+	}
+	p, err := s.rpcAuth.authenticate(authHeader)
+	if err != nil {
+		return ctx, s.denyRPCCall(ctx, method, nil, err)
+	}
+	if err := p.authorise(method); err != nil {
+		return ctx, s.denyRPCCall(ctx, method, p, err)
+	}
+	ctx = withRPCPrincipal(ctx, p)
+
+	ctx, err = account.ParseCredentialsMetadata(ctx, md)
+	if err != nil {
+		return ctx, err
+	}
+
+	if _, ok := md["verbose"]; ok {
+		ctx = request.WithVerbose(ctx)
+	}
+	return ctx, nil
+}
+
+// denyRPCCall logs and audits a denied gRPC call and returns its error
+func (s *RPCServer) denyRPCCall(ctx context.Context, method string, p *rpcPrincipal, err error) error {
+	caller := "unknown"
+	if pr, ok := peer.FromContext(ctx); ok && pr.Addr != nil {
+		caller = pr.Addr.String()
+	}
+	reportRPCDenial(method, caller, p, err)
+	return err
+}
+
+// authClient authenticates gRPC proxy requests before they are forwarded to
+// the gRPC server along with their authorization header, where the caller's
+// scopes are checked
+func (s *RPCServer) authClient(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := s.rpcAuth.authenticate(r.Header.Get("Authorization")); err != nil {
+			reportRPCDenial(r.URL.Path, r.RemoteAddr, nil, err)
+			w.Header().Set("WWW-Authenticate", `Basic realm="restricted"`)
+			http.Error(w, "Access denied", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// reportRPCDenial logs a denied gRPC or gRPC proxy call and writes it to the
+// audit log
+func reportRPCDenial(method, caller string, p *rpcPrincipal, err error) {
+	id := caller
+	if p != nil {
+		id = p.name
+	}
+	msg := fmt.Sprintf("gRPC call %s from %s denied: %v", method, caller, err)
+	log.Warnln(log.GRPCSys, msg)
+	audit.Event(id, rpcAuthEventType, msg)
+}
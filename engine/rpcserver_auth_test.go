@@ -0,0 +1,396 @@
+package engine
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServerTransportStream struct {
+	method string
+}
+
+func (f *fakeServerTransportStream) Method() string               { return f.method }
+func (f *fakeServerTransportStream) SetHeader(metadata.MD) error  { return nil }
+func (f *fakeServerTransportStream) SendHeader(metadata.MD) error { return nil }
+func (f *fakeServerTransportStream) SetTrailer(metadata.MD) error { return nil }
+
+func basicAuthHeader(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+// setupRPCAuthTest returns an authenticator with the admin user admin:admin
+// and a read only principal reader:reader
+func setupRPCAuthTest(t *testing.T) *rpcAuth {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte("reader"), bcrypt.MinCost)
+	require.NoError(t, err, "GenerateFromPassword must not error")
+	a, err := newRPCAuth(&config.RemoteControlConfig{
+		Username: "admin",
+		Password: "admin",
+		Principals: []config.RPCPrincipal{
+			{Username: "reader", PasswordHash: string(hash), Scopes: []string{RPCScopeRead}},
+		},
+	})
+	require.NoError(t, err, "newRPCAuth must not error")
+	return a
+}
+
+func TestRPCMethodScopes(t *testing.T) {
+	t.Parallel()
+	desc := gctrpc.GoCryptoTraderService_ServiceDesc
+	methods := make(map[string]bool, len(desc.Methods)+len(desc.Streams))
+	for i := range desc.Methods {
+		methods[desc.Methods[i].MethodName] = true
+	}
+	for i := range desc.Streams {
+		methods[desc.Streams[i].StreamName] = true
+	}
+	for m := range methods {
+		assert.Containsf(t, rpcMethodScopes, m, "gRPC method %s should have a scope", m)
+	}
+	for m, scope := range rpcMethodScopes {
+		assert.Truef(t, methods[m], "scoped method %s should be a gRPC method", m)
+		assert.Truef(t, isValidRPCScope(scope), "scope %q for method %s should be valid", scope, m)
+	}
+
+	assert.Equal(t, RPCScopeRead, rpcMethodScope(gctrpc.GoCryptoTraderService_GetInfo_FullMethodName), "GetInfo should require the read scope")
+	assert.Equal(t, RPCScopeTrade, rpcMethodScope(gctrpc.GoCryptoTraderService_SubmitOrder_FullMethodName), "SubmitOrder should require the trade scope")
+	assert.Equal(t, RPCScopeTrade, rpcMethodScope(gctrpc.GoCryptoTraderService_AddLedgerTransfer_FullMethodName), "AddLedgerTransfer should require the trade scope")
+	assert.Equal(t, RPCScopeWithdraw, rpcMethodScope(gctrpc.GoCryptoTraderService_WithdrawCryptocurrencyFunds_FullMethodName), "WithdrawCryptocurrencyFunds should require the withdraw scope")
+	assert.Equal(t, RPCScopeAdmin, rpcMethodScope(gctrpc.GoCryptoTraderService_Shutdown_FullMethodName), "Shutdown should require the admin scope")
+	assert.Equal(t, RPCScopeAdmin, rpcMethodScope("/gctrpc.GoCryptoTraderService/Unknown"), "unknown methods should require the admin scope")
+}
+
+func TestNewRPCAuth(t *testing.T) {
+	t.Parallel()
+	_, err := newRPCAuth(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err, "GenerateFromPassword must not error")
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Principals: []config.RPCPrincipal{{}}})
+	assert.ErrorIs(t, err, errRPCPrincipalInvalid)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Username: "admin", Principals: []config.RPCPrincipal{{Username: "admin"}}})
+	assert.ErrorIs(t, err, errRPCPrincipalDuplicate)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Principals: []config.RPCPrincipal{{Username: "user", PasswordHash: "password"}}})
+	assert.ErrorIs(t, err, errRPCPrincipalInvalid)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Principals: []config.RPCPrincipal{{Username: "user", PasswordHash: string(hash)}}})
+	assert.ErrorIs(t, err, errNoRPCScopes)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Principals: []config.RPCPrincipal{{Username: "user", PasswordHash: string(hash), Scopes: []string{"superuser"}}}})
+	assert.ErrorIs(t, err, errInvalidRPCScope)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Tokens: []config.RPCToken{{Name: "token", Scopes: []string{"superuser"}}}})
+	assert.ErrorIs(t, err, errInvalidRPCScope)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Username: "token:admin"})
+	assert.ErrorIs(t, err, errRPCPrincipalInvalid)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Principals: []config.RPCPrincipal{{Username: "token:user", PasswordHash: string(hash), Scopes: []string{RPCScopeRead}}}})
+	assert.ErrorIs(t, err, errRPCPrincipalInvalid)
+
+	_, err = newRPCAuth(&config.RemoteControlConfig{Username: "admin", Tokens: []config.RPCToken{{Name: "admin", Scopes: []string{RPCScopeRead}}}})
+	assert.ErrorIs(t, err, errRPCTokenNameReserved)
+
+	a, err := newRPCAuth(&config.RemoteControlConfig{
+		Principals: []config.RPCPrincipal{{Username: "user", PasswordHash: string(hash), Scopes: []string{RPCScopeRead, RPCScopeTrade}}},
+		Tokens:     []config.RPCToken{{Name: "token", Scopes: []string{RPCScopeRead}}},
+	})
+	require.NoError(t, err, "newRPCAuth must not error")
+	assert.NotNil(t, a.verified, "verified should be initialised")
+}
+
+func TestRPCAuthAuthenticate(t *testing.T) {
+	t.Parallel()
+	var a *rpcAuth
+	_, err := a.authenticate(basicAuthHeader("admin", "admin"))
+	assert.ErrorIs(t, err, errNilRPCAuth)
+
+	a = setupRPCAuthTest(t)
+	_, err = a.authenticate("")
+	assert.ErrorIs(t, err, errRPCAuthHeaderMissing)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "error should have the Unauthenticated code")
+
+	_, err = a.authenticate("Basic")
+	assert.ErrorIs(t, err, errRPCAuthHeaderMalformed)
+
+	_, err = a.authenticate("Digest abc")
+	assert.ErrorIs(t, err, errRPCAuthSchemeInvalid)
+
+	_, err = a.authenticate("Basic !!!")
+	assert.ErrorIs(t, err, errRPCUnauthenticated)
+
+	_, err = a.authenticate("Basic " + base64.StdEncoding.EncodeToString([]byte("admin")))
+	assert.ErrorIs(t, err, errRPCAuthHeaderMalformed)
+
+	p, err := a.authenticate(basicAuthHeader("admin", "admin"))
+	require.NoError(t, err, "authenticate must not error for the admin user")
+	assert.Equal(t, "admin", p.name, "name should be set")
+	assert.Equal(t, []string{RPCScopeAdmin}, p.scopes, "the admin user should have the admin scope")
+
+	_, err = a.authenticate(basicAuthHeader("admin", "wrong"))
+	assert.ErrorIs(t, err, errRPCCredentialsMismatch)
+
+	_, err = a.authenticate(basicAuthHeader("reader", "wrong"))
+	assert.ErrorIs(t, err, errRPCCredentialsMismatch)
+
+	p, err = a.authenticate(basicAuthHeader("reader", "reader"))
+	require.NoError(t, err, "authenticate must not error for a principal")
+	assert.Equal(t, "reader", p.name, "name should be set")
+	assert.Equal(t, []string{RPCScopeRead}, p.scopes, "scopes should be set")
+	assert.Len(t, a.verified, 1, "verified credentials should be cached")
+	cached, err := a.authenticate(basicAuthHeader("reader", "reader"))
+	require.NoError(t, err, "authenticate must not error for cached credentials")
+	assert.Same(t, p, cached, "cached principal should be returned")
+
+	token, _, err := a.createToken("dashboard", "", []string{RPCScopeRead}, time.Now().Add(time.Hour))
+	require.NoError(t, err, "createToken must not error")
+	p, err = a.authenticate("Bearer " + token)
+	require.NoError(t, err, "authenticate must not error for a token")
+	assert.Equal(t, "token:dashboard", p.name, "name should be the prefixed token name")
+	assert.Empty(t, p.owner, "owner should be empty for a token created outside of gRPC")
+	assert.Equal(t, "token:dashboard", p.identity(), "identity should be the token when it has no creator")
+	assert.Equal(t, []string{RPCScopeRead}, p.scopes, "scopes should be set")
+
+	_, err = a.authenticate("Bearer gct_invalid")
+	assert.ErrorIs(t, err, errRPCTokenInvalid)
+
+	a.cfg.Tokens[0].Expires = time.Now().Add(-time.Second)
+	_, err = a.authenticate("Bearer " + token)
+	assert.ErrorIs(t, err, errRPCTokenInvalid)
+}
+
+func TestRPCAuthTokens(t *testing.T) {
+	t.Parallel()
+	var a *rpcAuth
+	_, _, err := a.createToken("", "", nil, time.Time{})
+	assert.ErrorIs(t, err, errNilRPCAuth)
+	_, err = a.tokens()
+	assert.ErrorIs(t, err, errNilRPCAuth)
+	assert.ErrorIs(t, a.revokeToken(""), errNilRPCAuth)
+
+	a = setupRPCAuthTest(t)
+	expires := time.Now().Add(time.Hour)
+	_, _, err = a.createToken("", "", []string{RPCScopeRead}, expires)
+	assert.ErrorIs(t, err, errRPCTokenNameUnset)
+	_, _, err = a.createToken("bot", "", nil, expires)
+	assert.ErrorIs(t, err, errNoRPCScopes)
+	_, _, err = a.createToken("bot", "", []string{"everything"}, expires)
+	assert.ErrorIs(t, err, errInvalidRPCScope)
+	_, _, err = a.createToken("bot", "", []string{RPCScopeRead}, time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, errRPCTokenExpiryInvalid)
+	_, _, err = a.createToken("admin", "", []string{RPCScopeRead}, expires)
+	assert.ErrorIs(t, err, errRPCTokenNameReserved)
+	_, _, err = a.createToken("reader", "", []string{RPCScopeRead}, expires)
+	assert.ErrorIs(t, err, errRPCTokenNameReserved)
+
+	token, details, err := a.createToken("bot", "", []string{RPCScopeRead, RPCScopeTrade}, expires)
+	require.NoError(t, err, "createToken must not error")
+	assert.True(t, len(token) > len(rpcTokenPrefix), "token should be returned")
+	assert.NotEmpty(t, details.ID, "ID should be set")
+	assert.Equal(t, hashRPCToken(token), details.Hash, "only the hash of the token should be stored")
+	assert.NotContains(t, details.Hash, token, "the token should not be stored")
+	assert.True(t, expires.Equal(details.Expires), "Expires should be set")
+
+	_, _, err = a.createToken("bot", "", []string{RPCScopeRead}, expires)
+	assert.ErrorIs(t, err, errRPCTokenNameDuplicate)
+
+	tokens, err := a.tokens()
+	require.NoError(t, err, "tokens must not error")
+	require.Len(t, tokens, 1, "tokens must return the created token")
+	tokens[0].Scopes[0] = RPCScopeAdmin
+	assert.Equal(t, RPCScopeRead, a.cfg.Tokens[0].Scopes[0], "tokens should return a copy")
+
+	assert.ErrorIs(t, a.revokeToken("missing"), errRPCTokenNotFound)
+	require.NoError(t, a.revokeToken(details.ID), "revokeToken must not error")
+	assert.Empty(t, a.cfg.Tokens, "revoked token should be removed")
+	_, err = a.authenticate("Bearer " + token)
+	assert.ErrorIs(t, err, errRPCTokenInvalid)
+
+	var saved int
+	a.save = func() error {
+		saved++
+		if saved > 2 {
+			return errExpectedTestError
+		}
+		return nil
+	}
+	_, details, err = a.createToken("bot", "", []string{RPCScopeRead}, expires)
+	require.NoError(t, err, "createToken must not error")
+	assert.Equal(t, 1, saved, "createToken should save the token")
+	require.NoError(t, a.revokeToken(details.ID), "revokeToken must not error")
+	assert.Equal(t, 2, saved, "revokeToken should save the tokens")
+
+	_, details, err = a.createToken("bot", "", []string{RPCScopeRead}, expires)
+	assert.ErrorIs(t, err, errExpectedTestError)
+	assert.Nil(t, details, "details should not be returned when the token cannot be saved")
+	assert.Empty(t, a.cfg.Tokens, "a token which cannot be saved should be removed")
+
+	a.cfg.Tokens = []config.RPCToken{{ID: "saved", Name: "saved", Scopes: []string{RPCScopeRead}}}
+	assert.ErrorIs(t, a.revokeToken("saved"), errExpectedTestError)
+	assert.Len(t, a.cfg.Tokens, 1, "a revoked token which cannot be saved should be restored")
+}
+
+func TestRPCAuthUpdate(t *testing.T) {
+	t.Parallel()
+	var a *rpcAuth
+	assert.ErrorIs(t, a.update(&config.RemoteControlConfig{}), errNilRPCAuth)
+
+	a = setupRPCAuthTest(t)
+	assert.ErrorIs(t, a.update(nil), errNilConfig)
+	assert.ErrorIs(t, a.update(&config.RemoteControlConfig{Username: "admin", Principals: []config.RPCPrincipal{{Username: "admin"}}}), errRPCPrincipalDuplicate)
+
+	_, err := a.authenticate(basicAuthHeader("reader", "reader"))
+	require.NoError(t, err, "authenticate must not error")
+	require.Len(t, a.verified, 1, "verified credentials must be cached")
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("changed"), bcrypt.MinCost)
+	require.NoError(t, err, "GenerateFromPassword must not error")
+	updated := &config.RemoteControlConfig{
+		Username:   "admin",
+		Principals: []config.RPCPrincipal{{Username: "reader", PasswordHash: string(hash), Scopes: []string{RPCScopeRead}}},
+		Tokens:     []config.RPCToken{{Name: "dashboard", Scopes: []string{RPCScopeRead}}},
+	}
+	require.NoError(t, a.update(updated), "update must not error")
+	assert.Empty(t, a.verified, "verified credentials should be cleared")
+	_, err = a.authenticate(basicAuthHeader("reader", "reader"))
+	assert.ErrorIs(t, err, errRPCCredentialsMismatch, "the previous password should not authenticate")
+	_, err = a.authenticate(basicAuthHeader("reader", "changed"))
+	assert.NoError(t, err, "the updated password should authenticate")
+	updated.Tokens[0].Scopes[0] = RPCScopeAdmin
+	assert.Equal(t, RPCScopeRead, a.cfg.Tokens[0].Scopes[0], "updated tokens should be copied")
+}
+
+func TestRPCPrincipalAuthorise(t *testing.T) {
+	t.Parallel()
+	reader := &rpcPrincipal{name: "reader", scopes: []string{RPCScopeRead}}
+	assert.NoError(t, reader.authorise(gctrpc.GoCryptoTraderService_GetTicker_FullMethodName), "read scope should allow GetTicker")
+	err := reader.authorise(gctrpc.GoCryptoTraderService_SubmitOrder_FullMethodName)
+	assert.ErrorIs(t, err, errRPCScopeNotGranted)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "error should have the PermissionDenied code")
+
+	trader := &rpcPrincipal{name: "trader", scopes: []string{RPCScopeRead, RPCScopeTrade}}
+	assert.NoError(t, trader.authorise(gctrpc.GoCryptoTraderService_SubmitOrder_FullMethodName), "trade scope should allow SubmitOrder")
+	assert.ErrorIs(t, trader.authorise(gctrpc.GoCryptoTraderService_WithdrawCryptocurrencyFunds_FullMethodName), errRPCPermissionDenied)
+	assert.ErrorIs(t, trader.authorise(gctrpc.GoCryptoTraderService_ReloadConfig_FullMethodName), errRPCPermissionDenied)
+
+	admin := &rpcPrincipal{name: "admin", scopes: []string{RPCScopeAdmin}}
+	assert.NoError(t, admin.authorise(gctrpc.GoCryptoTraderService_WithdrawCryptocurrencyFunds_FullMethodName), "admin scope should allow every method")
+	assert.NoError(t, admin.authorise(gctrpc.GoCryptoTraderService_Shutdown_FullMethodName), "admin scope should allow every method")
+}
+
+func TestRPCPrincipalIdentity(t *testing.T) {
+	t.Parallel()
+	a := setupRPCAuthTest(t)
+	token, details, err := a.createToken("bot", "reader", []string{RPCScopeRead}, time.Now().Add(time.Hour))
+	require.NoError(t, err, "createToken must not error")
+	assert.Equal(t, "reader", details.CreatedBy, "CreatedBy should be set")
+	p, err := a.authenticate("Bearer " + token)
+	require.NoError(t, err, "authenticate must not error")
+	assert.Equal(t, "token:bot", p.name, "name should be the prefixed token name")
+	assert.Equal(t, "reader", p.identity(), "identity should be the user who created the token")
+}
+
+func TestAuthoriseEventAction(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, authoriseEventAction(t.Context(), ActionRunScript), "events not added via gRPC should not be checked")
+
+	reader := withRPCPrincipal(t.Context(), &rpcPrincipal{name: "reader", scopes: []string{RPCScopeRead}})
+	assert.ErrorIs(t, authoriseEventAction(reader, ActionSubmitOrder), errRPCPermissionDenied)
+	assert.NoError(t, authoriseEventAction(reader, ActionConsolePrint), "actions without a scope should be allowed")
+
+	trader := withRPCPrincipal(t.Context(), &rpcPrincipal{name: "trader", scopes: []string{RPCScopeTrade}})
+	assert.NoError(t, authoriseEventAction(trader, ActionSubmitOrder), "trade scope should allow order events")
+	assert.NoError(t, authoriseEventAction(trader, ActionCancelOrders), "trade scope should allow order events")
+	assert.ErrorIs(t, authoriseEventAction(trader, ActionRunScript), errRPCScopeNotGranted)
+
+	admin := withRPCPrincipal(t.Context(), &rpcPrincipal{name: "admin", scopes: []string{RPCScopeAdmin}})
+	assert.NoError(t, authoriseEventAction(admin, ActionRunScript), "admin scope should allow script events")
+}
+
+func TestRPCPrincipalFromContext(t *testing.T) {
+	t.Parallel()
+	_, err := rpcPrincipalFromContext(t.Context())
+	assert.ErrorIs(t, err, errRPCPrincipalNotInContext)
+
+	p := &rpcPrincipal{name: "reader"}
+	got, err := rpcPrincipalFromContext(withRPCPrincipal(t.Context(), p))
+	require.NoError(t, err, "rpcPrincipalFromContext must not error")
+	assert.Same(t, p, got, "the stored principal should be returned")
+}
+
+func TestAuthenticateClient(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	callCtx := func(method string, md metadata.MD) context.Context {
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), &fakeServerTransportStream{method: method})
+		if md == nil {
+			return ctx
+		}
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	_, err := s.authenticateClient(callCtx(gctrpc.GoCryptoTraderService_GetInfo_FullMethodName, nil))
+	assert.ErrorIs(t, err, errRPCMetadataUnextracted)
+
+	_, err = s.authenticateClient(callCtx(gctrpc.GoCryptoTraderService_GetInfo_FullMethodName, metadata.Pairs("authorization", basicAuthHeader("admin", "admin"))))
+	assert.ErrorIs(t, err, errNilRPCAuth)
+
+	s.rpcAuth = setupRPCAuthTest(t)
+	_, err = s.authenticateClient(callCtx(gctrpc.GoCryptoTraderService_GetInfo_FullMethodName, metadata.MD{}))
+	assert.ErrorIs(t, err, errRPCAuthHeaderMissing)
+
+	_, err = s.authenticateClient(callCtx(gctrpc.GoCryptoTraderService_SubmitOrder_FullMethodName, metadata.Pairs("authorization", basicAuthHeader("reader", "reader"))))
+	assert.ErrorIs(t, err, errRPCPermissionDenied)
+
+	ctx, err := s.authenticateClient(callCtx(gctrpc.GoCryptoTraderService_GetInfo_FullMethodName, metadata.Pairs("authorization", basicAuthHeader("reader", "reader"), "verbose", "true")))
+	require.NoError(t, err, "authenticateClient must not error")
+	p, err := rpcPrincipalFromContext(ctx)
+	require.NoError(t, err, "rpcPrincipalFromContext must not error")
+	assert.Equal(t, "reader", p.name, "the principal should be stored in the context")
+}
+
+func TestAuthClient(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}, rpcAuth: setupRPCAuthTest(t)}
+	h := s.authClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, tc := range []struct {
+		auth string
+		code int
+	}{
+		{"", http.StatusUnauthorized},
+		{basicAuthHeader("reader", "wrong"), http.StatusUnauthorized},
+		{"Bearer gct_invalid", http.StatusUnauthorized},
+		{basicAuthHeader("reader", "reader"), http.StatusOK},
+		{basicAuthHeader("admin", "admin"), http.StatusOK},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/v1/getinfo", http.NoBody)
+		if tc.auth != "" {
+			r.Header.Set("Authorization", tc.auth)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equalf(t, tc.code, w.Code, "authorization %q should return the expected status", tc.auth)
+	}
+}
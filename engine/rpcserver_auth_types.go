@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"crypto/sha256"
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC access scopes which can be granted to principals and tokens
+const (
+	// RPCScopeRead allows reading market data along with account, order and
+	// engine state
+	RPCScopeRead = "read"
+	// RPCScopeTrade allows submitting, modifying and cancelling orders and
+	// changing positions
+	RPCScopeTrade = "trade"
+	// RPCScopeWithdraw allows withdrawing funds
+	RPCScopeWithdraw = "withdraw"
+	// RPCScopeAdmin allows every method, including managing the engine, its
+	// config and gRPC tokens
+	RPCScopeAdmin = "admin"
+)
+
+const (
+	rpcAuthEventType = "rpc_auth"
+	rpcTokenPrefix   = "gct_"
+	rpcTokenLength   = 32
+	// rpcTokenPrincipalPrefix prefixes the principal name of a gRPC token so
+	// that a token cannot be named after a user
+	rpcTokenPrincipalPrefix = "token:"
+)
+
+var (
+	errRPCUnauthenticated       = status.Error(codes.Unauthenticated, "unauthenticated")
+	errRPCPermissionDenied      = status.Error(codes.PermissionDenied, "permission denied")
+	errNilRPCAuth               = errors.New("gRPC authentication is not set up")
+	errInvalidRPCScope          = errors.New("invalid gRPC scope")
+	errNoRPCScopes              = errors.New("no gRPC scopes set")
+	errRPCPrincipalInvalid      = errors.New("invalid gRPC principal")
+	errRPCPrincipalDuplicate    = errors.New("duplicate gRPC principal username")
+	errRPCTokenNameUnset        = errors.New("gRPC token name unset")
+	errRPCTokenNameDuplicate    = errors.New("gRPC token name already in use")
+	errRPCTokenNameReserved     = errors.New("gRPC token name matches a principal username")
+	errRPCTokenExpiryInvalid    = errors.New("gRPC token expiry must be in the future")
+	errRPCTokenNotFound         = errors.New("gRPC token not found")
+	errRPCAuthHeaderMissing     = errors.New("authorization header missing")
+	errRPCAuthSchemeInvalid     = errors.New("unsupported authorization scheme")
+	errRPCCredentialsMismatch   = errors.New("username/password mismatch")
+	errRPCTokenInvalid          = errors.New("invalid or expired token")
+	errRPCMetadataUnextracted   = errors.New("unable to extract metadata")
+	errRPCAuthHeaderMalformed   = errors.New("malformed authorization header")
+	errRPCScopeNotGranted       = errors.New("scope not granted")
+	errRPCPrincipalNotInContext = errors.New("gRPC principal not found in context")
+)
+
+// rpcAuth authenticates gRPC clients against the remote control config and
+// manages gRPC tokens
+type rpcAuth struct {
+	m   sync.RWMutex
+	cfg *config.RemoteControlConfig
+	// save writes the config to file after a gRPC token is created or revoked
+	// so that tokens persist across restarts
+	save func() error
+
+	verifiedMtx sync.Mutex
+	// verified caches basic auth credentials which passed a bcrypt check,
+	// keyed by a hash of the credentials, so that each call does not need to
+	// perform one
+	verified map[[sha256.Size]byte]*rpcPrincipal
+}
+
+// rpcPrincipal is an authenticated gRPC client
+type rpcPrincipal struct {
+	name   string
+	scopes []string
+	// owner is the user a token acts on behalf of, empty for users
+	owner string
+}
+
+type rpcPrincipalKey struct{}
@@ -32,6 +32,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -46,10 +47,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/consolidated"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
 	"google.golang.org/grpc/metadata"
@@ -2930,7 +2933,7 @@ func TestGetManagedPosition(t *testing.T) {
 	assert.NoError(t, err)
 
 	s.OrderManager.started = 1
-	s.OrderManager.activelyTrackFuturesPositions = true
+	s.OrderManager.activelyTrackFuturesPositions.Store(true)
 	_, err = s.GetManagedPosition(t.Context(), request)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
@@ -3803,6 +3806,9 @@ func TestRPCProxyAuthClient(t *testing.T) {
 			},
 		},
 	}
+	var err error
+	s.rpcAuth, err = newRPCAuth(&s.Config.RemoteControl)
+	require.NoError(t, err, "newRPCAuth must not error")
 
 	dummyHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -3893,3 +3899,592 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestSyntheticOrderRPC(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err)
+
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	b.Enabled = true
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	err = b.CurrencyPairs.Store(asset.Spot, &currency.PairStore{
+		AssetEnabled:  true,
+		Enabled:       []currency.Pair{currency.NewBTCUSDT()},
+		Available:     []currency.Pair{currency.NewBTCUSDT()},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+	})
+	require.NoError(t, err)
+	err = em.Add(fExchange{IBotExchange: exch})
+	require.NoError(t, err)
+
+	m, om := setupSyntheticOrderManagerTest(t)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, syntheticOrderManager: m}}
+
+	_, err = s.AddSyntheticOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetSyntheticOrders(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.CancelSyntheticOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.AddSyntheticOrderRequest{
+		Type:     "oco",
+		Exchange: fakeExchangeName,
+		Asset:    "spot",
+		Side:     "sell",
+		Amount:   1,
+	}
+	_, err = s.AddSyntheticOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"}
+	_, err = s.AddSyntheticOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errSyntheticOrderPricesInvalid)
+
+	req.TakeProfitPrice = 110
+	req.StopLossPrice = 90
+	o, err := s.AddSyntheticOrder(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, string(SyntheticOCO), o.Type)
+	assert.Equal(t, string(SyntheticStatusActive), o.Status)
+	assert.NotEmpty(t, o.TakeProfitOrderId)
+
+	resp, err := s.GetSyntheticOrders(t.Context(), &gctrpc.GetSyntheticOrdersRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	assert.Equal(t, o.Id, resp.Orders[0].Id)
+
+	_, err = s.CancelSyntheticOrder(t.Context(), &gctrpc.CancelSyntheticOrderRequest{Id: "bad"})
+	assert.Error(t, err)
+	_, err = s.CancelSyntheticOrder(t.Context(), &gctrpc.CancelSyntheticOrderRequest{Id: o.Id})
+	require.NoError(t, err)
+	assert.Equal(t, []string{o.TakeProfitOrderId}, om.cancelled)
+
+	resp, err = s.GetSyntheticOrders(t.Context(), &gctrpc.GetSyntheticOrdersRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Orders)
+}
+
+func TestExecutionAlgorithmRPC(t *testing.T) {
+	t.Parallel()
+	m, om, fe := setupExecutionAlgorithmManagerTest(t)
+	b := fe.GetBase()
+	b.Enabled = true
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	err := b.CurrencyPairs.Store(asset.Spot, &currency.PairStore{
+		AssetEnabled:  true,
+		Enabled:       []currency.Pair{currency.NewBTCUSDT()},
+		Available:     []currency.Pair{currency.NewBTCUSDT()},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+	})
+	require.NoError(t, err)
+	s := RPCServer{Engine: &Engine{ExchangeManager: m.exchangeManager.(*ExchangeManager), executionAlgorithmManager: m}}
+
+	_, err = s.StartExecutionAlgorithm(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetExecutionAlgorithms(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.PauseExecutionAlgorithm(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.StartExecutionAlgorithmRequest{
+		Type:     "twap",
+		Exchange: fakeExchangeName,
+		Asset:    "spot",
+		Side:     "buy",
+		Amount:   1,
+		Duration: int64(4 * time.Minute),
+	}
+	_, err = s.StartExecutionAlgorithm(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"}
+	_, err = s.StartExecutionAlgorithm(t.Context(), req)
+	assert.ErrorIs(t, err, errAlgoSlicesInvalid)
+
+	req.Slices = 4
+	algo, err := s.StartExecutionAlgorithm(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, string(AlgoStatusRunning), algo.Status)
+	assert.Equal(t, 0.25, algo.SubmittedAmount)
+	require.Len(t, algo.ChildOrderIds, 1)
+
+	id := &gctrpc.GenericExecutionAlgorithmRequest{Id: algo.Id}
+	_, err = s.PauseExecutionAlgorithm(t.Context(), id)
+	require.NoError(t, err)
+	_, err = s.ResumeExecutionAlgorithm(t.Context(), id)
+	require.NoError(t, err)
+
+	resp, err := s.GetExecutionAlgorithms(t.Context(), &gctrpc.GetExecutionAlgorithmsRequest{Id: algo.Id})
+	require.NoError(t, err)
+	require.Len(t, resp.Algorithms, 1)
+	assert.Equal(t, string(AlgoStatusRunning), resp.Algorithms[0].Status)
+
+	_, err = s.CancelExecutionAlgorithm(t.Context(), id)
+	require.NoError(t, err)
+	assert.Len(t, om.cancelled, 1)
+
+	resp, err = s.GetExecutionAlgorithms(t.Context(), &gctrpc.GetExecutionAlgorithmsRequest{IncludeInactive: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Algorithms, 1)
+	assert.Equal(t, string(AlgoStatusCancelled), resp.Algorithms[0].Status)
+}
+
+func TestRiskRPC(t *testing.T) {
+	t.Parallel()
+	m := setupOrderRiskTest(t)
+	s := RPCServer{Engine: &Engine{OrderManager: m}}
+
+	_, err := s.GetRiskStatus(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.SetKillSwitch(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.SetKillSwitch(t.Context(), &gctrpc.SetKillSwitchRequest{})
+	assert.ErrorIs(t, err, errKillSwitchOff)
+
+	_, err = s.SetKillSwitch(t.Context(), &gctrpc.SetKillSwitchRequest{Active: true})
+	require.NoError(t, err)
+
+	resp, err := s.GetRiskStatus(t.Context(), &gctrpc.GetRiskStatusRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.KillSwitchActive)
+	assert.Equal(t, "activated via gRPC", resp.KillSwitchReason)
+	assert.NotNil(t, resp.KillSwitchActivatedAt)
+	assert.NotEmpty(t, resp.Checks)
+
+	_, err = s.SetKillSwitch(t.Context(), &gctrpc.SetKillSwitchRequest{})
+	require.NoError(t, err)
+
+	require.NoError(t, m.UpdateRiskConfig(&config.OrderRisk{Enabled: true, Limits: config.RiskLimits{MaxOrderNotional: 1}}))
+	_, err = m.SubmitFakeOrder(riskTestOrder(), riskTestSubmitResponse("1", 1), false)
+	require.ErrorIs(t, err, ErrRiskCheckFailed)
+
+	resp, err = s.GetRiskStatus(t.Context(), &gctrpc.GetRiskStatusRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Enabled)
+	assert.False(t, resp.KillSwitchActive)
+	require.Len(t, resp.Violations, 1)
+	assert.Equal(t, fakeExchangeName, resp.Violations[0].Exchange)
+	assert.Equal(t, "max_order_notional", resp.Violations[0].Check)
+}
+
+func TestEventRPC(t *testing.T) {
+	t.Parallel()
+	m, _ := setupEventRuleTest(t, nil)
+	em, ok := m.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	exch, err := em.GetExchangeByName(eventTestExchangeName)
+	require.NoError(t, err)
+	b := exch.GetBase()
+	b.Enabled = true
+	pairs := currency.Pairs{currency.NewPairWithDelimiter("BTC", "USD", "-")}
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, pairs, false))
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, pairs, true))
+	require.NoError(t, b.CurrencyPairs.SetAssetEnabled(asset.Spot, true))
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, eventManager: m}}
+
+	_, err = s.AddEvent(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.AddEventRequest{
+		Exchange:  eventTestExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD", Delimiter: "-"},
+		AssetType: asset.Spot.String(),
+		Action:    ActionPush,
+		Conditions: &gctrpc.EventCondition{
+			Operator: ConditionOr,
+			Conditions: []*gctrpc.EventCondition{
+				{Item: ItemSpread, Params: &gctrpc.ConditionParams{Condition: ConditionLessThan, Value: 0.5}},
+				{Item: ItemIndicator, Params: &gctrpc.ConditionParams{Condition: ConditionGreaterThan, Value: 70, Indicator: IndicatorRSI, Interval: int64(kline.OneHour), Period: 14}},
+			},
+		},
+		ActionParams: &gctrpc.EventActionParams{Relayer: "slack"},
+		Repeat:       true,
+		Cooldown:     int64(time.Hour),
+	}
+	id, err := s.AddEvent(t.Context(), req)
+	require.NoError(t, err)
+
+	resp, err := s.GetEvents(t.Context(), &gctrpc.GetEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, id.Id, resp.Events[0].Id)
+	assert.True(t, resp.Events[0].Repeat)
+	assert.Equal(t, int64(time.Hour), resp.Events[0].Cooldown)
+	assert.Equal(t, "slack", resp.Events[0].ActionParams.Relayer)
+	require.Len(t, resp.Events[0].Conditions.Conditions, 2)
+	assert.Equal(t, int64(kline.OneHour), resp.Events[0].Conditions.Conditions[1].Params.Interval)
+	assert.Nil(t, resp.Events[0].LastTriggered)
+
+	trader := withRPCPrincipal(t.Context(), &rpcPrincipal{name: "trader", scopes: []string{RPCScopeTrade}})
+	req.Action = ActionRunScript
+	req.ActionParams = &gctrpc.EventActionParams{Script: "withdraw.gct"}
+	_, err = s.AddEvent(trader, req)
+	assert.ErrorIs(t, err, errRPCPermissionDenied, "a trade scoped principal should not add an event which runs a script")
+	assert.ErrorIs(t, err, errRPCScopeNotGranted, "the error should report the scope was not granted")
+	resp, err = s.GetEvents(t.Context(), &gctrpc.GetEventsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Events, 1, "a denied event should not be added")
+
+	req.Action = ActionPush
+	req.ActionParams = &gctrpc.EventActionParams{Relayer: "slack"}
+	_, err = s.AddEvent(trader, req)
+	assert.NoError(t, err, "a trade scoped principal should add an event which pushes a message")
+}
+
+func TestConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	cp := currency.NewPairWithDelimiter("BTC", "USD", "-")
+	for i, name := range []string{"consolidatedA", "consolidatedB"} {
+		exch, err := em.NewExchangeByName("binance")
+		require.NoError(t, err, "NewExchangeByName must not error")
+		exch.SetDefaults()
+		b := exch.GetBase()
+		b.Name = name
+		b.Enabled = true
+		b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+			asset.Spot: {
+				AssetEnabled:  true,
+				ConfigFormat:  &currency.PairFormat{Delimiter: "-"},
+				RequestFormat: &currency.PairFormat{Delimiter: "-"},
+				Available:     currency.Pairs{cp},
+				Enabled:       currency.Pairs{cp},
+			},
+		}
+		require.NoError(t, em.Add(exch), "Add must not error")
+		depth, err := orderbook.DeployDepth(name, cp, asset.Spot)
+		require.NoError(t, err, "DeployDepth must not error")
+		offset := float64(i)
+		require.NoError(t, depth.LoadSnapshot(&orderbook.Book{
+			Bids:        orderbook.Levels{{Price: 100 - offset, Amount: 1}},
+			Asks:        orderbook.Levels{{Price: 101 + offset, Amount: 1}},
+			LastUpdated: time.Now(),
+		}), "LoadSnapshot must not error")
+	}
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err := s.GetConsolidatedOrderbook(t.Context(), &gctrpc.GetConsolidatedOrderbookRequest{})
+	assert.ErrorIs(t, err, consolidated.ErrNoSources)
+
+	sources := []*gctrpc.ConsolidatedOrderbookSource{
+		{Exchange: "consolidatedA", Pair: &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD", Delimiter: "-"}},
+		{Exchange: "consolidatedB"},
+	}
+	_, err = s.GetConsolidatedOrderbook(t.Context(), &gctrpc.GetConsolidatedOrderbookRequest{Sources: sources, AssetType: asset.Spot.String()})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	sources[1].Pair = sources[0].Pair
+	resp, err := s.GetConsolidatedOrderbook(t.Context(), &gctrpc.GetConsolidatedOrderbookRequest{Sources: sources, AssetType: asset.Spot.String(), MaxLevels: 1})
+	require.NoError(t, err, "GetConsolidatedOrderbook must not error")
+	assert.Equal(t, "USD", resp.Pair.Quote, "the quote should default to the first source")
+	require.Len(t, resp.Bids, 1, "bids should be limited to max levels")
+	assert.Equal(t, "consolidatedA", resp.Bids[0].Exchange)
+	require.Len(t, resp.Asks, 1, "asks should be limited to max levels")
+	require.Len(t, resp.Venues, 2)
+
+	sim, err := s.SimulateConsolidatedOrder(t.Context(), &gctrpc.SimulateConsolidatedOrderRequest{Sources: sources, AssetType: asset.Spot.String(), Side: order.Sell.String(), Amount: 2})
+	require.NoError(t, err, "SimulateConsolidatedOrder must not error")
+	assert.Equal(t, 199.0, sim.Amount)
+	assert.Len(t, sim.Fills, 2, "SimulateConsolidatedOrder should fill across both venues")
+
+	sim, err = s.ConsolidatedWhaleBomb(t.Context(), &gctrpc.ConsolidatedWhaleBombRequest{Sources: sources, AssetType: asset.Spot.String(), Side: order.Buy.String(), PriceTarget: 102})
+	require.NoError(t, err, "ConsolidatedWhaleBomb must not error")
+	assert.Equal(t, 101.0, sim.Amount)
+	require.Len(t, sim.Fills, 1)
+	assert.Equal(t, "consolidatedA", sim.Fills[0].Exchange)
+}
+
+func TestRouteOrder(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{ExchangeManager: NewExchangeManager()}}
+	_, err := s.RouteOrder(t.Context(), &gctrpc.RouteOrderRequest{AssetType: "meow"})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	req := &gctrpc.RouteOrderRequest{AssetType: asset.Spot.String(), Side: "buy", OrderType: "market", Amount: 1}
+	_, err = s.RouteOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USDT"}
+	req.Side = "meow"
+	_, err = s.RouteOrder(t.Context(), req)
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+
+	req.Side = "buy"
+	req.OrderType = "limit"
+	_, err = s.RouteOrder(t.Context(), req)
+	assert.ErrorIs(t, err, order.ErrPriceMustBeSetIfLimitOrder)
+
+	req.OrderType = "market"
+	_, err = s.RouteOrder(t.Context(), req)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "RouteOrder should error when the order manager is not running")
+}
+
+func TestGetArbitrageOpportunities(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetArbitrageOpportunities(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetArbitrageOpportunities(t.Context(), &gctrpc.GetArbitrageOpportunitiesRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err := SetupArbitrageManager(NewExchangeManager(), &fakeOrderSubmitter{}, &riskEventRecorder{}, nil, &config.ArbitrageManager{})
+	require.NoError(t, err, "SetupArbitrageManager must not error")
+	m.opportunities = []ArbitrageOpportunity{
+		{ID: "first", Type: CrossExchangeArbitrage, Asset: asset.Spot, Currency: currency.USDT},
+		{ID: "second", Type: TriangularArbitrage, Asset: asset.Spot, Currency: currency.USDT, Legs: []ArbitrageLeg{
+			{Exchange: "test", Pair: currency.NewBTCUSDT(), Side: order.Buy, Amount: 1, Err: errArbitrageLiquidity},
+		}},
+	}
+	s.arbitrageManager = m
+	resp, err := s.GetArbitrageOpportunities(t.Context(), &gctrpc.GetArbitrageOpportunitiesRequest{})
+	require.NoError(t, err, "GetArbitrageOpportunities must not error")
+	require.Len(t, resp.Opportunities, 2)
+	assert.Equal(t, "second", resp.Opportunities[0].Id, "opportunities should be returned newest first")
+	assert.Equal(t, "TRIANGULAR", resp.Opportunities[0].Type)
+	require.Len(t, resp.Opportunities[0].Legs, 1)
+	assert.Equal(t, "BUY", resp.Opportunities[0].Legs[0].Side)
+	assert.Equal(t, errArbitrageLiquidity.Error(), resp.Opportunities[0].Legs[0].Error)
+}
+
+func TestGetLedgerPnL(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetLedgerPnL(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetLedgerPnL(t.Context(), &gctrpc.GetLedgerPnLRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	s.ledgerManager, _ = setupLedgerTest(t, "ledgerRPC", 150)
+	require.NoError(t, s.ledgerManager.RecordTransfer(&ledger.Entry{
+		Exchange: "ledgerRPC", Type: ledger.Deposit, Currency: currency.BTC, Amount: 1, Price: 100,
+	}), "RecordTransfer must not error")
+	resp, err := s.GetLedgerPnL(t.Context(), &gctrpc.GetLedgerPnLRequest{Currencies: []string{"btc"}})
+	require.NoError(t, err, "GetLedgerPnL must not error")
+	assert.Equal(t, "USD", resp.Fiat)
+	assert.Equal(t, "FIFO", resp.Method)
+	require.Len(t, resp.Assets, 1)
+	assert.Equal(t, 50.0, resp.Assets[0].Unrealised)
+	require.Len(t, resp.Assets[0].Lots, 1)
+	assert.Equal(t, "ledgerRPC", resp.Assets[0].Lots[0].Exchange)
+}
+
+func TestAddLedgerTransfer(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().Name = "ledgerTransferRPC"
+	require.NoError(t, em.Add(exch), "Add must not error")
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err = s.AddLedgerTransfer(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.AddLedgerTransfer(t.Context(), &gctrpc.AddLedgerTransferRequest{Exchange: "ledgerTransferRPC"})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	s.ledgerManager, err = SetupLedgerManager(em, &ledgerOrderManager{}, &config.LedgerManager{})
+	require.NoError(t, err, "SetupLedgerManager must not error")
+	require.NoError(t, s.ledgerManager.Start(), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, s.ledgerManager.Stop(), "Stop should not error") })
+	_, err = s.AddLedgerTransfer(t.Context(), &gctrpc.AddLedgerTransferRequest{Exchange: "ledgerTransferRPC", Type: "trade"})
+	assert.ErrorIs(t, err, errLedgerTransferType)
+	resp, err := s.AddLedgerTransfer(t.Context(), &gctrpc.AddLedgerTransferRequest{Exchange: "ledgerTransferRPC", Type: "deposit", Currency: "btc", Amount: 1, Price: 100})
+	require.NoError(t, err, "AddLedgerTransfer must not error")
+	assert.Equal(t, MsgStatusSuccess, resp.Status)
+	assert.NotEmpty(t, resp.Data, "response should contain the entry ID")
+}
+
+func TestGetPortfolioEquityCurve(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{ExchangeManager: NewExchangeManager()}}
+	_, err := s.GetPortfolioEquityCurve(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	end := time.Now()
+	start := end.Add(-time.Hour)
+	_, err = s.GetPortfolioEquityCurve(t.Context(), &gctrpc.GetPortfolioEquityCurveRequest{StartDate: "bad"})
+	assert.ErrorIs(t, err, errInvalidTimes)
+	req := &gctrpc.GetPortfolioEquityCurveRequest{
+		StartDate: start.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   end.Format(common.SimpleTimeFormatWithTimezone),
+		Exchange:  "fake",
+	}
+	_, err = s.GetPortfolioEquityCurve(t.Context(), req)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	req.Exchange = ""
+	_, err = s.GetPortfolioEquityCurve(t.Context(), req)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	s.portfolioManager, err = setupPortfolioManager(s.ExchangeManager, 0, nil)
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	var wg sync.WaitGroup
+	require.NoError(t, s.portfolioManager.Start(&wg), "Start must not error")
+	_, err = s.GetPortfolioEquityCurve(t.Context(), req)
+	assert.ErrorIs(t, err, errPortfolioSnapshotsDisabled)
+	require.NoError(t, s.portfolioManager.Stop(), "Stop must not error")
+	wg.Wait()
+
+	// Snapshot persistence can only be set up before the manager starts, and
+	// the last snapshot time prevents the manager routine storing another
+	s.portfolioManager, err = setupPortfolioManager(s.ExchangeManager, 0, nil)
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	s.portfolioManager.snapshots = &portfolioSnapshotRepository{snapshots: []portfoliosnapshot.Details{
+		{Fiat: "USD", Value: 100, Timestamp: end.Add(-time.Minute), Balances: []portfoliosnapshot.Balance{{Exchange: "test", Currency: "BTC", Amount: 1, Price: 100, Value: 100}}},
+	}}
+	s.portfolioManager.snapshotFiat = currency.USD
+	s.portfolioManager.snapshotInterval = time.Hour
+	s.portfolioManager.lastSnapshot = time.Now()
+	require.NoError(t, s.portfolioManager.Start(&wg), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, s.portfolioManager.Stop(), "Stop should not error") })
+	req.Currency = "btc"
+	resp, err := s.GetPortfolioEquityCurve(t.Context(), req)
+	require.NoError(t, err, "GetPortfolioEquityCurve must not error")
+	assert.Equal(t, "USD", resp.Fiat)
+	assert.Equal(t, "BTC", resp.Currency)
+	require.Len(t, resp.Points, 1)
+	assert.Equal(t, 1.0, resp.Points[0].Amount)
+	assert.Equal(t, 100.0, resp.Points[0].Value)
+}
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.ReloadConfig(t.Context(), &gctrpc.ReloadConfigRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	target := &fakeConfigReloadTarget{}
+	s.configReloader, err = SetupConfigReloader(&config.ConfigReloader{}, writeReloadConfig(t, nil), nil, target)
+	require.NoError(t, err, "SetupConfigReloader must not error")
+	resp, err := s.ReloadConfig(t.Context(), &gctrpc.ReloadConfigRequest{})
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Empty(t, resp.Applied, "Applied should be empty when the config is unchanged")
+}
+
+func TestRPCTokens(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.CreateRPCToken(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.CreateRPCToken(t.Context(), &gctrpc.CreateRPCTokenRequest{Expires: "bad"})
+	assert.ErrorIs(t, err, errInvalidTimes)
+	req := &gctrpc.CreateRPCTokenRequest{
+		Name:    "dashboard",
+		Scopes:  []string{RPCScopeRead},
+		Expires: time.Now().Add(time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	_, err = s.CreateRPCToken(t.Context(), req)
+	assert.ErrorIs(t, err, errNilRPCAuth)
+	_, err = s.GetRPCTokens(t.Context(), &gctrpc.GetRPCTokensRequest{})
+	assert.ErrorIs(t, err, errNilRPCAuth)
+
+	s.rpcAuth, err = newRPCAuth(&config.RemoteControlConfig{})
+	require.NoError(t, err, "newRPCAuth must not error")
+	created, err := s.CreateRPCToken(withRPCPrincipal(t.Context(), &rpcPrincipal{name: "token:admin", owner: "admin"}), req)
+	require.NoError(t, err, "CreateRPCToken must not error")
+	assert.NotEmpty(t, created.Token, "Token should be returned")
+	assert.Equal(t, "admin", s.rpcAuth.cfg.Tokens[0].CreatedBy, "CreatedBy should be the user the creating token acts on behalf of")
+	assert.Equal(t, "dashboard", created.Details.Name, "Name should be set")
+	assert.Equal(t, []string{RPCScopeRead}, created.Details.Scopes, "Scopes should be set")
+	assert.False(t, created.Details.Expired, "Expired should be false")
+
+	tokens, err := s.GetRPCTokens(t.Context(), &gctrpc.GetRPCTokensRequest{})
+	require.NoError(t, err, "GetRPCTokens must not error")
+	require.Len(t, tokens.Tokens, 1, "GetRPCTokens must return the created token")
+	assert.Equal(t, created.Details.Id, tokens.Tokens[0].Id, "Id should match the created token")
+
+	_, err = s.RevokeRPCToken(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.RevokeRPCToken(t.Context(), &gctrpc.RevokeRPCTokenRequest{Id: "missing"})
+	assert.ErrorIs(t, err, errRPCTokenNotFound)
+	_, err = s.RevokeRPCToken(t.Context(), &gctrpc.RevokeRPCTokenRequest{Id: created.Details.Id})
+	require.NoError(t, err, "RevokeRPCToken must not error")
+	tokens, err = s.GetRPCTokens(t.Context(), &gctrpc.GetRPCTokensRequest{})
+	require.NoError(t, err, "GetRPCTokens must not error")
+	assert.Empty(t, tokens.Tokens, "revoked token should be removed")
+}
+
+func TestGetFundingRateScan(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetFundingRateScan(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetFundingRateScan(t.Context(), &gctrpc.GetFundingRateScanRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err := SetupFundingRateScanner(NewExchangeManager(), &config.FundingRateScanner{})
+	require.NoError(t, err, "SetupFundingRateScanner must not error")
+	s.fundingRateScanner = m
+	_, err = s.GetFundingRateScan(t.Context(), &gctrpc.GetFundingRateScanRequest{})
+	assert.ErrorIs(t, err, errFundingRateScanNotStarted)
+
+	tn := time.Now()
+	btcusdt := currency.NewBTCUSDT()
+	ethusdt := currency.NewPair(currency.ETH, currency.USDT)
+	m.lastScan = tn
+	m.rates = []PerpetualFundingRate{
+		{Exchange: "one", Asset: asset.USDTMarginedFutures, Pair: btcusdt, Underlying: btcusdt, SettlementType: futures.Linear, Interval: time.Hour * 8, AnnualisedRate: 10, RateTime: tn},
+		{Exchange: "one", Asset: asset.USDTMarginedFutures, Pair: ethusdt, Underlying: ethusdt, AnnualisedRate: 5, RateTime: tn, TimeOfNextRate: tn.Add(time.Hour)},
+	}
+	m.opportunities = []FundingOpportunity{
+		{
+			Type:       SpotPerpetualFunding,
+			Underlying: btcusdt,
+			Long:       FundingLeg{Exchange: "one", Asset: asset.Spot, Pair: btcusdt},
+			Short:      FundingLeg{Exchange: "one", Asset: asset.USDTMarginedFutures, Pair: btcusdt, AnnualisedRate: 10},
+			GrossRate:  10,
+			NetRate:    8,
+			DetectedAt: tn,
+		},
+	}
+	resp, err := s.GetFundingRateScan(t.Context(), &gctrpc.GetFundingRateScanRequest{})
+	require.NoError(t, err, "GetFundingRateScan must not error")
+	require.Len(t, resp.Rates, 2)
+	assert.Equal(t, "linear", resp.Rates[0].SettlementType)
+	assert.Equal(t, "8h0m0s", resp.Rates[0].FundingInterval)
+	assert.Nil(t, resp.Rates[0].TimeOfNextRate, "TimeOfNextRate should not be set when unknown")
+	assert.NotNil(t, resp.Rates[1].TimeOfNextRate, "TimeOfNextRate should be set")
+	require.Len(t, resp.Opportunities, 1)
+	assert.Equal(t, "SPOT_PERPETUAL", resp.Opportunities[0].Type)
+	assert.Equal(t, "spot", resp.Opportunities[0].LongLeg.Asset)
+	assert.Equal(t, 8.0, resp.Opportunities[0].NetRate)
+	assert.Equal(t, tn.Unix(), resp.ScannedAt.AsTime().Unix())
+
+	resp, err = s.GetFundingRateScan(t.Context(), &gctrpc.GetFundingRateScanRequest{Base: "eth"})
+	require.NoError(t, err, "GetFundingRateScan must not error")
+	require.Len(t, resp.Rates, 1, "rates should be filtered by underlying")
+	assert.Equal(t, "ETH", resp.Rates[0].Underlying.Base)
+	assert.Empty(t, resp.Opportunities, "opportunities should be filtered by underlying")
+}
+
+func TestGetFundingOpportunityHistory(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	_, err := s.GetFundingOpportunityHistory(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetFundingOpportunityHistory(t.Context(), &gctrpc.GetFundingOpportunityHistoryRequest{})
+	assert.ErrorIs(t, err, database.ErrDatabaseSupportDisabled)
+
+	s.Config.Database.Enabled = true
+	_, err = s.GetFundingOpportunityHistory(t.Context(), &gctrpc.GetFundingOpportunityHistoryRequest{StartDate: "bad"})
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	end := time.Now()
+	_, err = s.GetFundingOpportunityHistory(t.Context(), &gctrpc.GetFundingOpportunityHistoryRequest{
+		StartDate: end.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   end.Add(-time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	})
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+
+	assert.True(t, matchesUnderlying("BTC", "USDT", "", ""), "no filter should match")
+	assert.True(t, matchesUnderlying("BTC", "USDT", "btc", "usdt"), "filters should match case insensitively")
+	assert.False(t, matchesUnderlying("BTC", "USDT", "", "USDC"), "a different quote should not match")
+}
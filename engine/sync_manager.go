@@ -57,6 +57,28 @@ func SetupSyncManager(c *config.SyncManagerConfig, exchangeManager iExchangeMana
 		return nil, errNilConfig
 	}
 
+	if err := checkSyncManagerConfig(c); err != nil {
+		return nil, err
+	}
+
+	s := &SyncManager{
+		config:                         *c,
+		remoteConfig:                   remoteConfig,
+		exchangeManager:                exchangeManager,
+		websocketRoutineManagerEnabled: websocketRoutineManagerEnabled,
+		fiatDisplayCurrency:            c.FiatDisplayCurrency,
+		format:                         *c.PairFormatDisplay,
+		tickerBatchLastRequested:       make(map[key.ExchangeAsset]time.Time),
+		currencyPairs:                  make(map[key.ExchangePairAsset]*currencyPairSyncAgent),
+	}
+	s.logConfig()
+	s.inService.Add(1)
+	return s, nil
+}
+
+// checkSyncManagerConfig sets defaults for unset sync manager values and
+// validates the display currency and format
+func checkSyncManagerConfig(c *config.SyncManagerConfig) error {
 	if c.NumWorkers <= 0 {
 		c.NumWorkers = config.DefaultSyncerWorkers
 	}
@@ -70,37 +92,60 @@ func SetupSyncManager(c *config.SyncManagerConfig, exchangeManager iExchangeMana
 	}
 
 	if c.FiatDisplayCurrency.IsEmpty() {
-		return nil, fmt.Errorf("FiatDisplayCurrency %w", currency.ErrCurrencyCodeEmpty)
+		return fmt.Errorf("FiatDisplayCurrency %w", currency.ErrCurrencyCodeEmpty)
 	}
 
 	if !c.FiatDisplayCurrency.IsFiatCurrency() {
-		return nil, fmt.Errorf("%s %w", c.FiatDisplayCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
+		return fmt.Errorf("%s %w", c.FiatDisplayCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
 	}
 
 	if c.PairFormatDisplay == nil {
-		return nil, fmt.Errorf("%T %w", c.PairFormatDisplay, common.ErrNilPointer)
-	}
-
-	s := &SyncManager{
-		config:                         *c,
-		remoteConfig:                   remoteConfig,
-		exchangeManager:                exchangeManager,
-		websocketRoutineManagerEnabled: websocketRoutineManagerEnabled,
-		fiatDisplayCurrency:            c.FiatDisplayCurrency,
-		format:                         *c.PairFormatDisplay,
-		tickerBatchLastRequested:       make(map[key.ExchangeAsset]time.Time),
-		currencyPairs:                  make(map[key.ExchangePairAsset]*currencyPairSyncAgent),
+		return fmt.Errorf("%T %w", c.PairFormatDisplay, common.ErrNilPointer)
 	}
+	return nil
+}
 
+func (m *SyncManager) logConfig() {
 	log.Debugf(log.SyncMgr,
 		"Exchange currency pair syncer config: continuous: %v ticker: %v"+
 			" orderbook: %v trades: %v workers: %v verbose: %v timeout REST: %v"+
 			" timeout Websocket: %v",
-		s.config.SynchronizeContinuously, s.config.SynchronizeTicker, s.config.SynchronizeOrderbook,
-		s.config.SynchronizeTrades, s.config.NumWorkers, s.config.Verbose, s.config.TimeoutREST,
-		s.config.TimeoutWebsocket)
-	s.inService.Add(1)
-	return s, nil
+		m.config.SynchronizeContinuously, m.config.SynchronizeTicker, m.config.SynchronizeOrderbook,
+		m.config.SynchronizeTrades, m.config.NumWorkers, m.config.Verbose, m.config.TimeoutREST,
+		m.config.TimeoutWebsocket)
+}
+
+// reconfigure replaces the config of a stopped sync manager. Tracked sync
+// items are dropped and are added again from the enabled pairs of each
+// exchange when the sync manager is next started
+func (m *SyncManager) reconfigure(c *config.SyncManagerConfig) error {
+	if m == nil {
+		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrNilSubsystem)
+	}
+	if m.IsRunning() {
+		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrSubSystemAlreadyStarted)
+	}
+	if c == nil {
+		return fmt.Errorf("%T %w", c, common.ErrNilPointer)
+	}
+	if !c.SynchronizeOrderbook && !c.SynchronizeTicker && !c.SynchronizeTrades {
+		return errNoSyncItemsEnabled
+	}
+	if err := checkSyncManagerConfig(c); err != nil {
+		return err
+	}
+
+	// Workers read the config without locking, so wait for them to exit
+	m.workers.Wait()
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.config = *c
+	m.fiatDisplayCurrency = c.FiatDisplayCurrency
+	m.format = *c.PairFormatDisplay
+	m.tickerBatchLastRequested = make(map[key.ExchangeAsset]time.Time)
+	m.currencyPairs = make(map[key.ExchangePairAsset]*currencyPairSyncAgent)
+	m.logConfig()
+	return nil
 }
 
 // IsRunning safely checks whether the subsystem is running
@@ -206,16 +251,18 @@ func (m *SyncManager) Start() error {
 		m.initSyncStartTime = time.Now()
 	}
 
+	// Copied as the config can be replaced by reconfigure once the syncer stops
+	logInitialSyncEvents, syncContinuously := m.config.LogInitialSyncEvents, m.config.SynchronizeContinuously
 	go func() {
 		m.initSyncWG.Wait()
 		if atomic.CompareAndSwapInt32(&m.initSyncCompleted, 0, 1) {
-			if m.config.LogInitialSyncEvents {
+			if logInitialSyncEvents {
 				log.Debugf(log.SyncMgr, "Exchange CurrencyPairSyncer initial sync is complete.")
 				log.Debugf(log.SyncMgr, "Exchange CurrencyPairSyncer initial sync took %v [%v sync items].",
 					time.Since(m.initSyncStartTime), createdCounter)
 			}
 
-			if !m.config.SynchronizeContinuously {
+			if !syncContinuously {
 				log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer stopping.")
 				err := m.Stop()
 				if err != nil {
@@ -230,6 +277,7 @@ func (m *SyncManager) Start() error {
 		return nil
 	}
 
+	m.workers.Add(m.config.NumWorkers)
 	for range m.config.NumWorkers {
 		go m.worker()
 	}
@@ -258,6 +306,37 @@ func (m *SyncManager) get(k key.ExchangePairAsset) *currencyPairSyncAgent {
 	return m.currencyPairs[k]
 }
 
+// syncStatuses returns the state of every tracked sync item. Items which are
+// being synced at the time of the call are skipped rather than waited upon
+func (m *SyncManager) syncStatuses() []syncStatus {
+	if !m.IsRunning() {
+		return nil
+	}
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(m.currencyPairs))
+	for _, c := range m.currencyPairs {
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+
+	var statuses []syncStatus
+	for _, c := range agents {
+		for i, t := range c.trackers {
+			if t == nil || !c.locks[i].TryLock() {
+				continue
+			}
+			statuses = append(statuses, syncStatus{
+				Key:         c.Key,
+				Item:        syncItemType(i),
+				LastUpdated: t.LastUpdated,
+				HaveData:    t.HaveData,
+			})
+			c.locks[i].Unlock()
+		}
+	}
+	return statuses
+}
+
 func newCurrencyPairSyncAgent(k key.ExchangePairAsset) *currencyPairSyncAgent {
 	return &currencyPairSyncAgent{
 		Key:      k,
@@ -450,6 +529,7 @@ func (m *SyncManager) worker() {
 	cleanup := func() {
 		log.Debugln(log.SyncMgr,
 			"Exchange CurrencyPairSyncer worker shutting down.")
+		m.workers.Done()
 	}
 	defer cleanup()
 
@@ -74,6 +74,41 @@ func TestSyncManagerStart(t *testing.T) {
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
+func TestSyncManagerReconfigure(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	assert.ErrorIs(t, m.reconfigure(nil), ErrNilSubsystem)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("Bitstamp")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	m, err = SetupSyncManager(&config.SyncManagerConfig{SynchronizeTrades: true, FiatDisplayCurrency: currency.USD, PairFormatDisplay: &currency.EMPTYFORMAT}, em, &config.RemoteControlConfig{}, false)
+	require.NoError(t, err, "SetupSyncManager must not error")
+
+	assert.ErrorIs(t, m.reconfigure(nil), common.ErrNilPointer)
+	assert.ErrorIs(t, m.reconfigure(&config.SyncManagerConfig{}), errNoSyncItemsEnabled)
+	assert.ErrorIs(t, m.reconfigure(&config.SyncManagerConfig{SynchronizeTicker: true, FiatDisplayCurrency: currency.BTC}), currency.ErrFiatDisplayCurrencyIsNotFiat)
+
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.ErrorIs(t, m.reconfigure(&config.SyncManagerConfig{SynchronizeTicker: true}), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+
+	pairFmt := currency.PairFormat{Delimiter: "/", Uppercase: true}
+	require.NoError(t, m.reconfigure(&config.SyncManagerConfig{SynchronizeTicker: true, NumWorkers: 2, FiatDisplayCurrency: currency.EUR, PairFormatDisplay: &pairFmt}), "reconfigure must not error")
+	assert.True(t, m.config.SynchronizeTicker, "SynchronizeTicker should be updated")
+	assert.False(t, m.config.SynchronizeTrades, "SynchronizeTrades should be updated")
+	assert.Equal(t, 2, m.config.NumWorkers, "NumWorkers should be updated")
+	assert.Equal(t, currency.EUR, m.fiatDisplayCurrency, "fiatDisplayCurrency should be updated")
+	assert.Equal(t, pairFmt, m.format, "format should be updated")
+	assert.Empty(t, m.currencyPairs, "currencyPairs should be reset")
+
+	require.NoError(t, m.Start(), "Start must not error after reconfigure")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
 func TestSyncManagerStop(t *testing.T) {
 	t.Parallel()
 	var m *SyncManager
@@ -27,6 +27,14 @@ type currencyPairSyncAgent struct {
 	locks    []sync.Mutex
 }
 
+// syncStatus is the state of a sync item for an exchange asset pair
+type syncStatus struct {
+	Key         key.ExchangePairAsset
+	Item        syncItemType
+	LastUpdated time.Time
+	HaveData    bool
+}
+
 // SyncManager stores the exchange currency pair syncer object
 type SyncManager struct {
 	initSyncCompleted              int32
@@ -40,6 +48,7 @@ type SyncManager struct {
 	mux                            sync.Mutex
 	initSyncWG                     sync.WaitGroup
 	inService                      sync.WaitGroup
+	workers                        sync.WaitGroup
 
 	currencyPairs            map[key.ExchangePairAsset]*currencyPairSyncAgent
 	tickerBatchLastRequested map[key.ExchangeAsset]time.Time
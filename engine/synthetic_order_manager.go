@@ -0,0 +1,624 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupSyntheticOrderManager creates a synthetic order manager subsystem
+func SetupSyntheticOrderManager(om iOrderSubmitter, cm iCommsManager, cfg *config.SyntheticOrderManager) (*SyntheticOrderManager, error) {
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cm == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w SyntheticOrderManager", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultSyntheticOrderCheckInterval
+	}
+	return &SyntheticOrderManager{
+		shutdown:      make(chan struct{}),
+		orders:        make(map[uuid.UUID]*syntheticOrder),
+		subscriptions: make(map[string]dispatch.Pipe),
+		orderManager:  om,
+		commsManager:  cm,
+		checkInterval: cfg.CheckInterval,
+		verbose:       cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *SyntheticOrderManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *SyntheticOrderManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("synthetic order manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("synthetic order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.OrderMgr, "Synthetic order manager starting...")
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Synthetic orders which are still
+// active are left in place but will no longer be monitored
+func (m *SyntheticOrderManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("synthetic order manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("synthetic order manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.OrderMgr, "Synthetic order manager shutting down...")
+	close(m.shutdown)
+	m.m.Lock()
+	for exch, pipe := range m.subscriptions {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.OrderMgr, "Synthetic order manager unable to release %s ticker subscription: %v", exch, err)
+		}
+		delete(m.subscriptions, exch)
+	}
+	m.m.Unlock()
+	m.wg.Wait()
+	log.Debugln(log.OrderMgr, "Synthetic order manager shutdown.")
+	return nil
+}
+
+// run periodically checks child orders and polls the latest stored ticker
+// prices for exchanges which do not yet have a dispatch subscription
+func (m *SyntheticOrderManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.checkChildOrders()
+			m.subscribe()
+			m.pollTickers()
+		}
+	}
+}
+
+// Add validates and activates a synthetic order
+func (m *SyntheticOrderManager) Add(ctx context.Context, req *SyntheticOrderRequest) (*SyntheticOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("synthetic order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("synthetic order manager %w", ErrSubSystemNotStarted)
+	}
+	if !m.orderManager.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	req.Exchange = strings.ToLower(req.Exchange)
+	o := &syntheticOrder{SyntheticOrder: SyntheticOrder{
+		ID:                    id,
+		SyntheticOrderRequest: *req,
+		CreatedAt:             time.Now(),
+	}}
+	// The order is not shared until it is stored so child orders are
+	// submitted without holding its lock
+	switch req.Type {
+	case SyntheticOCO:
+		o.Status = SyntheticStatusActive
+		o.TakeProfitOrderID, err = m.submitChild(ctx, o, req.Side, req.TakeProfitPrice, req.Amount)
+	case SyntheticBracket:
+		o.Status = SyntheticStatusPending
+		o.EntryOrderID, err = m.submitChild(ctx, o, req.Side, req.EntryPrice, req.Amount)
+	case SyntheticTrailingStop:
+		o.Status = SyntheticStatusActive
+	}
+	if err != nil {
+		return nil, err
+	}
+	o.UpdatedAt = o.CreatedAt
+	o.m.Lock()
+	defer o.m.Unlock()
+	m.m.Lock()
+	m.orders[id] = o
+	m.m.Unlock()
+	m.notify(o, "added")
+	resp := o.SyntheticOrder
+	return &resp, nil
+}
+
+// Cancel cancels an active synthetic order and any of its open child orders.
+// A pending bracket whose entry has partly filled is kept active with exits
+// for the executed amount, which can then be cancelled in turn
+func (m *SyntheticOrderManager) Cancel(ctx context.Context, id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("synthetic order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("synthetic order manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	o, ok := m.orders[id]
+	m.m.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w %v", errSyntheticOrderNotFound, id)
+	}
+	o.m.Lock()
+	if !o.isActive() {
+		o.m.Unlock()
+		return fmt.Errorf("%w %v status %v", errSyntheticOrderInactive, id, o.Status)
+	}
+	if o.busy {
+		o.m.Unlock()
+		return fmt.Errorf("%w %v", errSyntheticOrderBusy, id)
+	}
+	childID := o.TakeProfitOrderID
+	pending := o.Status == SyntheticStatusPending
+	if pending {
+		childID = o.EntryOrderID
+	}
+	o.busy = true
+	o.m.Unlock()
+
+	executed, err := m.cancelChild(ctx, o, childID)
+	// A bracket entry which partly filled before it was cancelled has opened
+	// a position, so its exits are placed instead of leaving it unprotected
+	if err == nil && pending && executed > 0 {
+		o.m.Lock()
+		o.Amount = executed
+		o.m.Unlock()
+		m.placeTakeProfit(ctx, o, executed)
+		return nil
+	}
+
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.busy = false
+	if err != nil {
+		m.fail(o, err)
+		return err
+	}
+	m.setStatus(o, SyntheticStatusCancelled)
+	return nil
+}
+
+// GetOrders returns a copy of all synthetic orders. Completed, cancelled and
+// failed orders are only included when includeInactive is set
+func (m *SyntheticOrderManager) GetOrders(includeInactive bool) ([]SyntheticOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("synthetic order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("synthetic order manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]SyntheticOrder, 0, len(m.orders))
+	for _, o := range m.orders {
+		o.m.Lock()
+		if includeInactive || o.isActive() {
+			resp = append(resp, o.SyntheticOrder)
+		}
+		o.m.Unlock()
+	}
+	return resp, nil
+}
+
+// processTicker evaluates the triggers of all active synthetic orders
+// matching the ticker's exchange, pair and asset
+func (m *SyntheticOrderManager) processTicker(t *ticker.Price) {
+	if t == nil {
+		return
+	}
+	price := t.Last
+	if price == 0 {
+		return
+	}
+	for _, o := range m.getActive() {
+		if o.Exchange != strings.ToLower(t.ExchangeName) || o.Asset != t.AssetType || !o.Pair.Equal(t.Pair) {
+			continue
+		}
+		m.evaluate(o, price)
+	}
+}
+
+// evaluate updates the trigger price of a synthetic order and executes its
+// exit if the trigger has been breached
+func (m *SyntheticOrderManager) evaluate(o *syntheticOrder, price float64) {
+	o.m.Lock()
+	if o.Status != SyntheticStatusActive || o.busy {
+		o.m.Unlock()
+		return
+	}
+	o.LastPrice = price
+	exitSide := o.exitSide()
+	switch o.Type {
+	case SyntheticOCO, SyntheticBracket:
+		o.TriggerPrice = o.StopLossPrice
+	case SyntheticTrailingStop:
+		o.TriggerPrice = o.updateTrailingStop(price)
+	}
+	if exitSide.IsLong() && price < o.TriggerPrice || exitSide.IsShort() && price > o.TriggerPrice {
+		o.m.Unlock()
+		return
+	}
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Synthetic %s order %v triggered at %v with trigger %v", o.Type, o.ID, price, o.TriggerPrice)
+	}
+	o.busy = true
+	takeProfitID, amount := o.TakeProfitOrderID, o.Amount
+	o.m.Unlock()
+
+	ctx := context.TODO()
+	executed, err := m.cancelChild(ctx, o, takeProfitID)
+	var stopID string
+	// Any amount filled by the take profit has already closed part of the
+	// position, so only the remainder is exited
+	if remaining := amount - executed; err == nil && remaining > 0 {
+		stopID, err = m.submitChild(ctx, o, exitSide, 0, remaining)
+	}
+
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.busy = false
+	if err != nil {
+		m.fail(o, err)
+		return
+	}
+	o.StopOrderID = stopID
+	m.setStatus(o, SyntheticStatusCompleted)
+}
+
+// checkChildOrders moves synthetic orders along their state machine based on
+// the status of child orders tracked by the order manager
+func (m *SyntheticOrderManager) checkChildOrders() {
+	for _, o := range m.getActive() {
+		m.checkChildOrder(o)
+	}
+}
+
+func (m *SyntheticOrderManager) checkChildOrder(o *syntheticOrder) {
+	if amount, entryFilled := m.updateFromChildOrder(o); entryFilled {
+		m.placeTakeProfit(context.TODO(), o, amount)
+	}
+}
+
+// updateFromChildOrder applies the status of the open child order to the
+// synthetic order. It returns the amount to close and true when a bracket
+// entry has finished with an executed amount and its take profit needs to be
+// placed. An entry which is cancelled after partly filling still has its
+// executed amount protected by the exit legs
+func (m *SyntheticOrderManager) updateFromChildOrder(o *syntheticOrder) (float64, bool) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	if o.busy {
+		return 0, false
+	}
+	var childID string
+	switch {
+	case o.Status == SyntheticStatusPending:
+		childID = o.EntryOrderID
+	case o.Status == SyntheticStatusActive && o.TakeProfitOrderID != "":
+		childID = o.TakeProfitOrderID
+	default:
+		return 0, false
+	}
+	child, err := m.orderManager.GetByExchangeAndID(o.Exchange, childID)
+	if err != nil {
+		if !errors.Is(err, ErrOrderNotFound) && !errors.Is(err, ErrExchangeNotFound) {
+			log.Errorf(log.OrderMgr, "Synthetic order %v unable to retrieve child order %v: %v", o.ID, childID, err)
+		}
+		return 0, false
+	}
+	switch {
+	case o.Status == SyntheticStatusPending && child.IsInactive() && child.ExecutedAmount > 0:
+		// The amount is taken from the child order which finished rather
+		// than the requested amount so the exits match the position held
+		o.Amount = child.ExecutedAmount
+		o.busy = true
+		return child.ExecutedAmount, true
+	case child.Status == order.Filled && o.Status == SyntheticStatusPending:
+		// Exchanges which do not report the executed amount are assumed to
+		// have filled the requested amount
+		o.busy = true
+		return o.Amount, true
+	case child.Status == order.Filled:
+		m.setStatus(o, SyntheticStatusCompleted)
+	case child.IsInactive():
+		o.Error = fmt.Sprintf("child order %v %v", childID, child.Status)
+		m.setStatus(o, SyntheticStatusCancelled)
+	}
+	return 0, false
+}
+
+// placeTakeProfit submits the take profit order of a bracket whose entry has
+// filled and marks the synthetic order active
+func (m *SyntheticOrderManager) placeTakeProfit(ctx context.Context, o *syntheticOrder, amount float64) {
+	id, err := m.submitChild(ctx, o, o.exitSide(), o.TakeProfitPrice, amount)
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.busy = false
+	if err != nil {
+		m.fail(o, err)
+		return
+	}
+	o.TakeProfitOrderID = id
+	o.Status = SyntheticStatusActive
+	o.UpdatedAt = time.Now()
+	m.notify(o, "entry filled")
+}
+
+// subscribe attempts to subscribe to ticker updates for every exchange with an
+// active synthetic order. Subscriptions can only be made once the exchange
+// has stored a ticker so this is retried on each check
+func (m *SyntheticOrderManager) subscribe() {
+	if !dispatch.IsRunning() {
+		return
+	}
+	for _, o := range m.getActive() {
+		m.m.Lock()
+		// Stop releases subscriptions under the lock before waiting on
+		// listeners, so none can be added once it has been called
+		if atomic.LoadInt32(&m.started) == 0 {
+			m.m.Unlock()
+			return
+		}
+		if _, ok := m.subscriptions[o.Exchange]; ok {
+			m.m.Unlock()
+			continue
+		}
+		pipe, err := ticker.SubscribeToExchangeTickers(o.Exchange)
+		if err != nil {
+			m.m.Unlock()
+			continue
+		}
+		m.subscriptions[o.Exchange] = pipe
+		m.wg.Add(1)
+		m.m.Unlock()
+		go m.listen(pipe)
+	}
+}
+
+// listen processes ticker updates pushed by the dispatch system
+func (m *SyntheticOrderManager) listen(pipe dispatch.Pipe) {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			t, ok := data.(*ticker.Price)
+			if !ok {
+				log.Errorln(log.OrderMgr, common.GetTypeAssertError("*ticker.Price", data))
+				continue
+			}
+			m.processTicker(t)
+		}
+	}
+}
+
+// pollTickers evaluates active orders on exchanges without a dispatch
+// subscription against the latest stored ticker
+func (m *SyntheticOrderManager) pollTickers() {
+	for _, o := range m.getActive() {
+		m.m.RLock()
+		_, subscribed := m.subscriptions[o.Exchange]
+		m.m.RUnlock()
+		if subscribed {
+			continue
+		}
+		t, err := ticker.GetTicker(o.Exchange, o.Pair, o.Asset)
+		if err != nil {
+			continue
+		}
+		m.evaluate(o, t.Last)
+	}
+}
+
+// getActive returns all pending and active synthetic orders
+func (m *SyntheticOrderManager) getActive() []*syntheticOrder {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]*syntheticOrder, 0, len(m.orders))
+	for _, o := range m.orders {
+		o.m.Lock()
+		if o.isActive() {
+			resp = append(resp, o)
+		}
+		o.m.Unlock()
+	}
+	return resp
+}
+
+// submitChild submits a limit order if a price is supplied, otherwise a market
+// order. It only reads the fields of the synthetic order which do not change
+// so it can be called without holding its lock
+func (m *SyntheticOrderManager) submitChild(ctx context.Context, o *syntheticOrder, side order.Side, price, amount float64) (string, error) {
+	oType := order.Market
+	if price > 0 {
+		oType = order.Limit
+	}
+	resp, err := m.orderManager.Submit(ctx, &order.Submit{
+		Exchange:  o.Exchange,
+		Pair:      o.Pair,
+		AssetType: o.Asset,
+		Side:      side,
+		Type:      oType,
+		Amount:    amount,
+		Price:     price,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.OrderID, nil
+}
+
+// cancelChild cancels an open child order and returns the amount it executed
+// before it was cancelled. Like submitChild it can be called without holding
+// the synthetic order lock
+func (m *SyntheticOrderManager) cancelChild(ctx context.Context, o *syntheticOrder, id string) (float64, error) {
+	if id == "" {
+		return 0, nil
+	}
+	child, err := m.orderManager.GetByExchangeAndID(o.Exchange, id)
+	if err == nil && child.IsInactive() {
+		return child.ExecutedAmount, nil
+	}
+	err = m.orderManager.Cancel(ctx, &order.Cancel{
+		Exchange:  o.Exchange,
+		OrderID:   id,
+		Pair:      o.Pair,
+		AssetType: o.Asset,
+	})
+	if err != nil {
+		return 0, err
+	}
+	// An order which is no longer tracked is not known to have executed
+	var executed float64
+	if child, err = m.orderManager.GetByExchangeAndID(o.Exchange, id); err == nil {
+		executed = child.ExecutedAmount
+	}
+	return executed, nil
+}
+
+// fail marks the synthetic order as failed as the state of its child orders
+// on the exchange is no longer known
+func (m *SyntheticOrderManager) fail(o *syntheticOrder, err error) {
+	o.Error = err.Error()
+	m.setStatus(o, SyntheticStatusFailed)
+}
+
+// setStatus updates the status of a synthetic order and notifies listeners
+func (m *SyntheticOrderManager) setStatus(o *syntheticOrder, s SyntheticOrderStatus) {
+	o.Status = s
+	o.UpdatedAt = time.Now()
+	m.notify(o, strings.ToLower(string(s)))
+}
+
+func (m *SyntheticOrderManager) notify(o *syntheticOrder, action string) {
+	msg := fmt.Sprintf("Synthetic %s order %v %s for %s %s %s status=%s", o.Type, o.ID, action, o.Exchange, o.Asset, o.Pair, o.Status)
+	if o.Error != "" {
+		msg += " error=" + o.Error
+	}
+	if o.Status == SyntheticStatusFailed {
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		log.Infoln(log.OrderMgr, msg)
+	}
+	m.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+}
+
+// isActive returns whether the synthetic order is still being managed
+func (o *SyntheticOrder) isActive() bool {
+	return o.Status == SyntheticStatusPending || o.Status == SyntheticStatusActive
+}
+
+// exitSide returns the side of the order which closes the position
+func (o *SyntheticOrder) exitSide() order.Side {
+	if o.Type != SyntheticBracket {
+		return o.Side
+	}
+	if o.Side.IsLong() {
+		return order.Sell
+	}
+	return order.Buy
+}
+
+// updateTrailingStop tracks the best price seen and returns the new stop
+func (o *SyntheticOrder) updateTrailingStop(price float64) float64 {
+	sell := o.Side.IsShort()
+	if o.ReferencePrice == 0 || sell && price > o.ReferencePrice || !sell && price < o.ReferencePrice {
+		o.ReferencePrice = price
+	}
+	distance := o.TrailingDistance
+	if o.TrailingPercent > 0 {
+		distance = o.ReferencePrice * o.TrailingPercent / 100
+	}
+	if sell {
+		return o.ReferencePrice - distance
+	}
+	return o.ReferencePrice + distance
+}
+
+// validate checks the synthetic order request parameters
+func (r *SyntheticOrderRequest) validate() error {
+	if r == nil {
+		return errNilSyntheticOrderRequest
+	}
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair.IsEmpty() {
+		return errCurrencyPairUnset
+	}
+	if !r.Asset.IsValid() {
+		return errAssetTypeUnset
+	}
+	if !order.IsValidOrderSubmissionSide(r.Side) {
+		return fmt.Errorf("%w %v", order.ErrSideIsInvalid, r.Side)
+	}
+	if r.Amount <= 0 || math.IsNaN(r.Amount) {
+		return errSyntheticOrderAmountInvalid
+	}
+	switch r.Type {
+	case SyntheticOCO:
+		// The take profit leg must rest on the correct side of the book for
+		// the closing side, with the stop loss opposite it
+		if r.TakeProfitPrice <= 0 || r.StopLossPrice <= 0 ||
+			r.Side.IsShort() && r.TakeProfitPrice <= r.StopLossPrice ||
+			r.Side.IsLong() && r.TakeProfitPrice >= r.StopLossPrice {
+			return errSyntheticOrderPricesInvalid
+		}
+	case SyntheticBracket:
+		if r.TakeProfitPrice <= 0 || r.StopLossPrice <= 0 ||
+			r.Side.IsLong() && r.TakeProfitPrice <= r.StopLossPrice ||
+			r.Side.IsShort() && r.TakeProfitPrice >= r.StopLossPrice {
+			return errSyntheticOrderPricesInvalid
+		}
+		if r.EntryPrice < 0 || r.EntryPrice > 0 &&
+			(r.EntryPrice <= math.Min(r.TakeProfitPrice, r.StopLossPrice) ||
+				r.EntryPrice >= math.Max(r.TakeProfitPrice, r.StopLossPrice)) {
+			return errSyntheticOrderEntryPriceInvalid
+		}
+	case SyntheticTrailingStop:
+		if (r.TrailingDistance > 0) == (r.TrailingPercent > 0) ||
+			r.TrailingDistance < 0 || r.TrailingPercent < 0 || r.TrailingPercent >= 100 {
+			return errSyntheticOrderTrailingInvalid
+		}
+	default:
+		return fmt.Errorf("%w %q", errSyntheticOrderTypeInvalid, r.Type)
+	}
+	return nil
+}
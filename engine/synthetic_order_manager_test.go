@@ -0,0 +1,402 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// fakeOrderSubmitter is a fake order manager which records submitted and
+// cancelled child orders. Submitted orders are left open unless executed is
+// set, which returns the amount each order executes on submission
+type fakeOrderSubmitter struct {
+	m         sync.Mutex
+	submitted []order.Submit
+	cancelled []string
+	orders    map[string]*order.Detail
+	submitErr error
+	executed  func(*order.Submit) float64
+}
+
+func (s *fakeOrderSubmitter) IsRunning() bool {
+	return true
+}
+
+func (s *fakeOrderSubmitter) Submit(_ context.Context, sub *order.Submit) (*OrderSubmitResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.submitErr != nil {
+		return nil, s.submitErr
+	}
+	s.submitted = append(s.submitted, *sub)
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	d := &order.Detail{
+		Exchange: sub.Exchange,
+		OrderID:  id.String(),
+		Side:     sub.Side,
+		Type:     sub.Type,
+		Price:    sub.Price,
+		Amount:   sub.Amount,
+		Status:   order.New,
+	}
+	if s.executed != nil {
+		d.ExecutedAmount = s.executed(sub)
+		d.RemainingAmount = d.Amount - d.ExecutedAmount
+		d.Status = order.Filled
+		if d.RemainingAmount > 0 {
+			d.Status = order.PartiallyFilledCancelled
+		}
+	}
+	s.orders[d.OrderID] = d
+	return &OrderSubmitResponse{Detail: d}, nil
+}
+
+func (s *fakeOrderSubmitter) Cancel(_ context.Context, c *order.Cancel) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.cancelled = append(s.cancelled, c.OrderID)
+	if d, ok := s.orders[c.OrderID]; ok {
+		d.Status = order.Cancelled
+	}
+	return nil
+}
+
+func (s *fakeOrderSubmitter) GetByExchangeAndID(_, id string) (*order.Detail, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	d, ok := s.orders[id]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	return d.CopyToPointer(), nil
+}
+
+func (s *fakeOrderSubmitter) setStatus(id string, status order.Status, executed float64) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.orders[id].Status = status
+	s.orders[id].ExecutedAmount = executed
+}
+
+func setupSyntheticOrderManagerTest(t *testing.T) (*SyntheticOrderManager, *fakeOrderSubmitter) {
+	t.Helper()
+	om := &fakeOrderSubmitter{orders: make(map[string]*order.Detail)}
+	m, err := SetupSyntheticOrderManager(om, &CommunicationManager{}, &config.SyntheticOrderManager{CheckInterval: time.Hour})
+	require.NoError(t, err)
+	m.started = 1
+	return m, om
+}
+
+func syntheticTestRequest(t SyntheticOrderType, side order.Side) *SyntheticOrderRequest {
+	return &SyntheticOrderRequest{
+		Type:     t,
+		Exchange: testExchange,
+		Pair:     currency.NewBTCUSD(),
+		Asset:    asset.Spot,
+		Side:     side,
+		Amount:   1,
+	}
+}
+
+func TestSetupSyntheticOrderManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupSyntheticOrderManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager)
+
+	_, err = SetupSyntheticOrderManager(&fakeOrderSubmitter{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+
+	_, err = SetupSyntheticOrderManager(&fakeOrderSubmitter{}, &CommunicationManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	cfg := &config.SyntheticOrderManager{}
+	m, err := SetupSyntheticOrderManager(&fakeOrderSubmitter{}, &CommunicationManager{}, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, defaultSyntheticOrderCheckInterval, m.checkInterval)
+}
+
+func TestSyntheticOrderManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *SyntheticOrderManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupSyntheticOrderManager(&fakeOrderSubmitter{}, &CommunicationManager{}, &config.SyntheticOrderManager{})
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+}
+
+func TestSyntheticOrderRequestValidate(t *testing.T) {
+	t.Parallel()
+	var r *SyntheticOrderRequest
+	assert.ErrorIs(t, r.validate(), errNilSyntheticOrderRequest)
+
+	r = &SyntheticOrderRequest{}
+	assert.ErrorIs(t, r.validate(), errExchangeNameUnset)
+	r.Exchange = testExchange
+	assert.ErrorIs(t, r.validate(), errCurrencyPairUnset)
+	r.Pair = currency.NewBTCUSD()
+	assert.ErrorIs(t, r.validate(), errAssetTypeUnset)
+	r.Asset = asset.Spot
+	assert.ErrorIs(t, r.validate(), order.ErrSideIsInvalid)
+	r.Side = order.Sell
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderAmountInvalid)
+	r.Amount = 1
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderTypeInvalid)
+
+	r.Type = SyntheticOCO
+	r.TakeProfitPrice, r.StopLossPrice = 90, 110
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderPricesInvalid, "sell OCO take profit must be above the stop loss")
+	r.TakeProfitPrice, r.StopLossPrice = 110, 90
+	assert.NoError(t, r.validate())
+
+	r.Type = SyntheticBracket
+	r.Side = order.Buy
+	assert.NoError(t, r.validate())
+	r.EntryPrice = 120
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderEntryPriceInvalid)
+	r.EntryPrice = 100
+	assert.NoError(t, r.validate())
+	r.Side = order.Sell
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderPricesInvalid, "short bracket take profit must be below the stop loss")
+
+	r.Type = SyntheticTrailingStop
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderTrailingInvalid)
+	r.TrailingDistance, r.TrailingPercent = 10, 1
+	assert.ErrorIs(t, r.validate(), errSyntheticOrderTrailingInvalid, "only one trailing parameter can be set")
+	r.TrailingPercent = 0
+	assert.NoError(t, r.validate())
+}
+
+func TestSyntheticOCO(t *testing.T) {
+	t.Parallel()
+	m, om := setupSyntheticOrderManagerTest(t)
+	req := syntheticTestRequest(SyntheticOCO, order.Sell)
+	req.TakeProfitPrice = 110
+	req.StopLossPrice = 90
+	o, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, SyntheticStatusActive, o.Status)
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, order.Limit, om.submitted[0].Type)
+	assert.Equal(t, 110.0, om.submitted[0].Price)
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 95})
+	require.Len(t, om.submitted, 1, "price above the stop must not trigger")
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSDT(), AssetType: asset.Spot, Last: 80})
+	require.Len(t, om.submitted, 1, "ticker for another pair must not trigger")
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 89})
+	require.Len(t, om.submitted, 2)
+	assert.Equal(t, order.Market, om.submitted[1].Type)
+	assert.Equal(t, order.Sell, om.submitted[1].Side)
+	assert.Equal(t, []string{o.TakeProfitOrderID}, om.cancelled, "take profit leg must be cancelled")
+
+	orders, err := m.GetOrders(true)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, SyntheticStatusCompleted, orders[0].Status)
+	assert.NotEmpty(t, orders[0].StopOrderID)
+
+	orders, err = m.GetOrders(false)
+	require.NoError(t, err)
+	assert.Empty(t, orders)
+
+	o, err = m.Add(t.Context(), req)
+	require.NoError(t, err)
+	om.setStatus(o.TakeProfitOrderID, order.Filled, 1)
+	m.checkChildOrders()
+	orders, err = m.GetOrders(false)
+	require.NoError(t, err)
+	assert.Empty(t, orders, "filled take profit must complete the OCO")
+
+	o, err = m.Add(t.Context(), req)
+	require.NoError(t, err)
+	om.setStatus(o.TakeProfitOrderID, order.PartiallyFilled, 0.4)
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 89})
+	require.Len(t, om.submitted, 5)
+	assert.Equal(t, order.Market, om.submitted[4].Type)
+	assert.InDelta(t, 0.6, om.submitted[4].Amount, 1e-9, "stop must only exit the amount not filled by the take profit")
+
+	o, err = m.Add(t.Context(), req)
+	require.NoError(t, err)
+	om.setStatus(o.TakeProfitOrderID, order.Filled, 1)
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 89})
+	assert.Len(t, om.submitted, 6, "stop must not submit an exit once the take profit has filled")
+	orders, err = m.GetOrders(false)
+	require.NoError(t, err)
+	assert.Empty(t, orders, "filled take profit must complete the OCO when the stop triggers")
+}
+
+func TestSyntheticBracket(t *testing.T) {
+	t.Parallel()
+	m, om := setupSyntheticOrderManagerTest(t)
+	req := syntheticTestRequest(SyntheticBracket, order.Buy)
+	req.EntryPrice = 100
+	req.TakeProfitPrice = 120
+	req.StopLossPrice = 90
+	o, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, SyntheticStatusPending, o.Status)
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, order.Buy, om.submitted[0].Side)
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 80})
+	require.Len(t, om.submitted, 1, "pending bracket must not trigger its stop")
+
+	om.setStatus(o.EntryOrderID, order.Filled, 0.5)
+	m.checkChildOrders()
+	require.Len(t, om.submitted, 2)
+	assert.Equal(t, order.Sell, om.submitted[1].Side)
+	assert.Equal(t, 120.0, om.submitted[1].Price)
+	assert.Equal(t, 0.5, om.submitted[1].Amount, "exit must use the executed entry amount")
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 90})
+	require.Len(t, om.submitted, 3)
+	assert.Equal(t, order.Market, om.submitted[2].Type)
+	assert.Equal(t, 0.5, om.submitted[2].Amount)
+}
+
+func TestSyntheticBracketPartialEntry(t *testing.T) {
+	t.Parallel()
+	m, om := setupSyntheticOrderManagerTest(t)
+	req := syntheticTestRequest(SyntheticBracket, order.Buy)
+	req.EntryPrice = 100
+	req.TakeProfitPrice = 120
+	req.StopLossPrice = 90
+
+	o, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	om.setStatus(o.EntryOrderID, order.Cancelled, 0)
+	m.checkChildOrders()
+	require.Len(t, om.submitted, 1, "unfilled cancelled entry must not place exits")
+	assert.Equal(t, SyntheticStatusCancelled, m.orders[o.ID].Status)
+
+	o, err = m.Add(t.Context(), req)
+	require.NoError(t, err)
+	om.setStatus(o.EntryOrderID, order.PartiallyFilledCancelled, 0.3)
+	m.checkChildOrders()
+	require.Len(t, om.submitted, 3)
+	assert.Equal(t, order.Sell, om.submitted[2].Side)
+	assert.Equal(t, 120.0, om.submitted[2].Price)
+	assert.Equal(t, 0.3, om.submitted[2].Amount, "take profit must use the amount executed by the cancelled entry")
+	assert.Equal(t, SyntheticStatusActive, m.orders[o.ID].Status)
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 90})
+	require.Len(t, om.submitted, 4)
+	assert.Equal(t, order.Market, om.submitted[3].Type)
+	assert.Equal(t, 0.3, om.submitted[3].Amount, "stop loss must use the amount executed by the cancelled entry")
+
+	o, err = m.Add(t.Context(), req)
+	require.NoError(t, err)
+	om.setStatus(o.EntryOrderID, order.PartiallyFilled, 0.2)
+	require.NoError(t, m.Cancel(t.Context(), o.ID))
+	assert.Contains(t, om.cancelled, o.EntryOrderID)
+	require.Len(t, om.submitted, 6)
+	assert.Equal(t, 0.2, om.submitted[5].Amount, "cancelling a partly filled entry must place exits for the executed amount")
+	assert.Equal(t, SyntheticStatusActive, m.orders[o.ID].Status)
+	assert.Equal(t, om.submitted[5].Amount, m.orders[o.ID].Amount)
+}
+
+func TestSyntheticTrailingStop(t *testing.T) {
+	t.Parallel()
+	m, om := setupSyntheticOrderManagerTest(t)
+	req := syntheticTestRequest(SyntheticTrailingStop, order.Sell)
+	req.TrailingDistance = 10
+	_, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	assert.Empty(t, om.submitted)
+
+	for _, price := range []float64{100, 120, 111} {
+		m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: price})
+	}
+	orders, err := m.GetOrders(false)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, 110.0, orders[0].TriggerPrice, "stop must trail the highest price")
+	assert.Empty(t, om.submitted)
+
+	m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: 110})
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, order.Market, om.submitted[0].Type)
+
+	req = syntheticTestRequest(SyntheticTrailingStop, order.Buy)
+	req.TrailingPercent = 10
+	o, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	for _, price := range []float64{100, 80} {
+		m.processTicker(&ticker.Price{ExchangeName: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Last: price})
+	}
+	orders, err = m.GetOrders(false)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, o.ID, orders[0].ID)
+	assert.InDelta(t, 88.0, orders[0].TriggerPrice, 1e-9, "buy stop must trail the lowest price")
+}
+
+func TestSyntheticOrderCancel(t *testing.T) {
+	t.Parallel()
+	m, om := setupSyntheticOrderManagerTest(t)
+	err := m.Cancel(t.Context(), uuid.Nil)
+	assert.ErrorIs(t, err, errSyntheticOrderNotFound)
+
+	req := syntheticTestRequest(SyntheticOCO, order.Sell)
+	req.TakeProfitPrice = 110
+	req.StopLossPrice = 90
+	o, err := m.Add(t.Context(), req)
+	require.NoError(t, err)
+	m.orders[o.ID].busy = true
+	err = m.Cancel(t.Context(), o.ID)
+	assert.ErrorIs(t, err, errSyntheticOrderBusy)
+	assert.Empty(t, om.cancelled, "busy order must not cancel its child orders")
+
+	m.orders[o.ID].busy = false
+	require.NoError(t, m.Cancel(t.Context(), o.ID))
+	assert.Equal(t, []string{o.TakeProfitOrderID}, om.cancelled)
+
+	err = m.Cancel(t.Context(), o.ID)
+	assert.ErrorIs(t, err, errSyntheticOrderInactive)
+
+	om.submitErr = errors.New("exchange offline")
+	_, err = m.Add(t.Context(), req)
+	assert.ErrorIs(t, err, om.submitErr)
+}
+
+func TestSyntheticOrderManagerNotStarted(t *testing.T) {
+	t.Parallel()
+	var m *SyntheticOrderManager
+	_, err := m.Add(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.GetOrders(false)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	assert.ErrorIs(t, m.Cancel(t.Context(), uuid.Nil), ErrNilSubsystem)
+
+	m, err = SetupSyntheticOrderManager(&fakeOrderSubmitter{}, &CommunicationManager{}, &config.SyntheticOrderManager{})
+	require.NoError(t, err)
+	_, err = m.Add(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.GetOrders(false)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.Cancel(t.Context(), uuid.Nil), ErrSubSystemNotStarted)
+}
@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// SyntheticOrderManagerName is an exported subsystem name
+const SyntheticOrderManagerName = "synthetic_orders"
+
+const defaultSyntheticOrderCheckInterval = time.Second
+
+// Synthetic order types which can be emulated
+const (
+	// SyntheticOCO places a take profit limit order on the exchange and
+	// watches the market for the stop loss trigger. When one leg completes
+	// the other is cancelled
+	SyntheticOCO SyntheticOrderType = "OCO"
+	// SyntheticBracket places an entry order and once it is filled manages a
+	// take profit and stop loss as an OCO pair
+	SyntheticBracket SyntheticOrderType = "BRACKET"
+	// SyntheticTrailingStop follows the market by a fixed distance or
+	// percentage and submits a market order once the market reverses through
+	// the stop
+	SyntheticTrailingStop SyntheticOrderType = "TRAILING_STOP"
+)
+
+// Synthetic order statuses
+const (
+	// SyntheticStatusPending is a bracket order waiting on its entry to fill
+	SyntheticStatusPending SyntheticOrderStatus = "PENDING"
+	// SyntheticStatusActive is an order watching the market for its triggers
+	SyntheticStatusActive SyntheticOrderStatus = "ACTIVE"
+	// SyntheticStatusCompleted is an order whose exit has been executed
+	SyntheticStatusCompleted SyntheticOrderStatus = "COMPLETED"
+	// SyntheticStatusCancelled is an order which was cancelled before its exit
+	// was executed
+	SyntheticStatusCancelled SyntheticOrderStatus = "CANCELLED"
+	// SyntheticStatusFailed is an order which could not submit or cancel one
+	// of its child orders
+	SyntheticStatusFailed SyntheticOrderStatus = "FAILED"
+)
+
+var (
+	errNilOrderManager                 = errors.New("cannot start with nil order manager")
+	errNilSyntheticOrderRequest        = errors.New("nil synthetic order request received")
+	errSyntheticOrderTypeInvalid       = errors.New("invalid synthetic order type")
+	errSyntheticOrderNotFound          = errors.New("synthetic order not found")
+	errSyntheticOrderInactive          = errors.New("synthetic order is no longer active")
+	errSyntheticOrderBusy              = errors.New("synthetic order child orders are being updated")
+	errSyntheticOrderAmountInvalid     = errors.New("synthetic order amount must be greater than zero")
+	errSyntheticOrderPricesInvalid     = errors.New("synthetic order take profit and stop loss prices are invalid for the order side")
+	errSyntheticOrderTrailingInvalid   = errors.New("synthetic trailing stop requires either a trailing distance or a trailing percentage")
+	errSyntheticOrderEntryPriceInvalid = errors.New("synthetic bracket entry price must be between the take profit and stop loss prices")
+)
+
+// SyntheticOrderType defines which order type is being emulated
+type SyntheticOrderType string
+
+// SyntheticOrderStatus defines the state of a synthetic order
+type SyntheticOrderStatus string
+
+// SyntheticOrderManager emulates OCO, bracket and trailing stop orders for any
+// exchange by watching ticker updates and submitting or cancelling child
+// orders via the order manager
+type SyntheticOrderManager struct {
+	started       int32
+	shutdown      chan struct{}
+	wg            sync.WaitGroup
+	m             sync.RWMutex
+	orders        map[uuid.UUID]*syntheticOrder
+	subscriptions map[string]dispatch.Pipe
+	orderManager  iOrderSubmitter
+	commsManager  iCommsManager
+	checkInterval time.Duration
+	verbose       bool
+}
+
+// SyntheticOrderRequest holds the parameters to create a synthetic order.
+// Side is the side of the closing order for OCO and trailing stops, and the
+// side of the entry order for brackets
+type SyntheticOrderRequest struct {
+	Type             SyntheticOrderType
+	Exchange         string
+	Pair             currency.Pair
+	Asset            asset.Item
+	Side             order.Side
+	Amount           float64
+	EntryPrice       float64
+	TakeProfitPrice  float64
+	StopLossPrice    float64
+	TrailingDistance float64
+	TrailingPercent  float64
+}
+
+// SyntheticOrder holds the state of an emulated order and its child orders
+type SyntheticOrder struct {
+	ID uuid.UUID
+	SyntheticOrderRequest
+	Status            SyntheticOrderStatus
+	EntryOrderID      string
+	TakeProfitOrderID string
+	StopOrderID       string
+	TriggerPrice      float64
+	ReferencePrice    float64
+	LastPrice         float64
+	Error             string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// syntheticOrder guards a synthetic order while its triggers are evaluated
+type syntheticOrder struct {
+	m sync.Mutex
+	// busy is set while child orders are submitted or cancelled without
+	// holding the lock so the order is not triggered or cancelled twice
+	busy bool
+	SyntheticOrder
+}
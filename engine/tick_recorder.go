@@ -0,0 +1,227 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/tickdata"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupTickRecorder creates a tick recorder subsystem. Files are written to
+// the tickdata folder in the data directory unless a directory is configured
+func SetupTickRecorder(cfg *config.TickRecorder, dataDir string) (*TickRecorder, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w TickRecorder", errNilConfig)
+	}
+	dir := cfg.Directory
+	if dir == "" {
+		if dataDir == "" {
+			return nil, errTickRecorderDirectoryUnset
+		}
+		dir = filepath.Join(dataDir, tickRecorderDirectory)
+	}
+	if cfg.SnapshotInterval <= 0 {
+		cfg.SnapshotInterval = tickdata.DefaultSnapshotInterval
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultTickRecorderFlushInterval
+	}
+	return &TickRecorder{
+		verbose:          cfg.Verbose,
+		directory:        dir,
+		snapshotInterval: cfg.SnapshotInterval,
+		maxDepth:         cfg.MaxDepth,
+		flushInterval:    cfg.FlushInterval,
+		shutdown:         make(chan struct{}),
+		recordings:       make(map[key.ExchangePairAsset]*tickRecording),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (r *TickRecorder) IsRunning() bool {
+	return r != nil && atomic.LoadInt32(&r.started) == 1
+}
+
+// Start runs the subsystem
+func (r *TickRecorder) Start() error {
+	if r == nil {
+		return fmt.Errorf("tick recorder %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return fmt.Errorf("tick recorder %w", ErrSubSystemAlreadyStarted)
+	}
+	if err := common.CreateDir(r.directory); err != nil {
+		atomic.StoreInt32(&r.started, 0)
+		return err
+	}
+	log.Debugf(log.DataHistory, "Tick recorder starting, writing to %s", r.directory)
+	r.shutdown = make(chan struct{})
+	r.wg.Add(1)
+	go r.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, closing all open tick data files
+func (r *TickRecorder) Stop() error {
+	if r == nil {
+		return fmt.Errorf("tick recorder %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 1, 0) {
+		return fmt.Errorf("tick recorder %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.DataHistory, "Tick recorder shutting down...")
+	close(r.shutdown)
+	r.wg.Wait()
+	r.m.Lock()
+	defer r.m.Unlock()
+	var errs error
+	for k, rec := range r.recordings {
+		errs = common.AppendError(errs, rec.close())
+		delete(r.recordings, k)
+	}
+	log.Debugln(log.DataHistory, "Tick recorder shutdown.")
+	return errs
+}
+
+func (r *TickRecorder) run() {
+	defer r.wg.Done()
+	t := time.NewTicker(r.flushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-t.C:
+			r.flush()
+		}
+	}
+}
+
+// flush writes buffered compressed data for every recording to disk
+func (r *TickRecorder) flush() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for k, rec := range r.recordings {
+		if err := rec.writer.Flush(); err != nil {
+			log.Errorf(log.DataHistory, "Tick recorder unable to flush %s %s %s: %v", k.Exchange, k.Asset, k.Pair(), err)
+		}
+	}
+}
+
+// websocketDataHandler records orderbook updates and trades from the exchange
+// websocket streams
+func (r *TickRecorder) websocketDataHandler(_ string, data any) error {
+	if !r.IsRunning() {
+		return nil
+	}
+	switch d := data.(type) {
+	case *orderbook.Depth:
+		b, err := d.Retrieve()
+		if err != nil {
+			// invalid books are resynced by the websocket and recorded once
+			// they are valid again
+			return nil //nolint:nilerr // not an error for the recorder
+		}
+		return r.recordBook(b)
+	case trade.Data:
+		return r.recordTrades(d)
+	case []trade.Data:
+		return r.recordTrades(d...)
+	}
+	return nil
+}
+
+func (r *TickRecorder) recordBook(b *orderbook.Book) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	rec, err := r.getRecording(b.Exchange, b.Asset, b.Pair)
+	if err != nil {
+		return err
+	}
+	if err := rec.writer.WriteBook(b); err != nil {
+		return fmt.Errorf("tick recorder %s %s %s: %w", b.Exchange, b.Asset, b.Pair, err)
+	}
+	return nil
+}
+
+func (r *TickRecorder) recordTrades(trades ...trade.Data) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var errs error
+	for i := range trades {
+		rec, err := r.getRecording(trades[i].Exchange, trades[i].AssetType, trades[i].CurrencyPair)
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		if err := rec.writer.WriteTrade(&trades[i]); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("tick recorder %s %s %s: %w", trades[i].Exchange, trades[i].AssetType, trades[i].CurrencyPair, err))
+		}
+	}
+	return errs
+}
+
+// getRecording returns the open recording for the exchange, asset and pair,
+// creating the file when it is first seen
+// NOTE: This requires locking.
+func (r *TickRecorder) getRecording(exch string, a asset.Item, p currency.Pair) (*tickRecording, error) {
+	k := key.ExchangePairAsset{
+		Exchange: strings.ToLower(exch),
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+		Asset:    a,
+	}
+	if rec, ok := r.recordings[k]; ok {
+		return rec, nil
+	}
+	if !r.IsRunning() {
+		// Stop may have closed all recordings while waiting for the lock
+		return nil, fmt.Errorf("tick recorder %w", ErrSubSystemNotStarted)
+	}
+	path := filepath.Join(r.directory, TickDataFileName(exch, a, p, time.Now()))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, file.DefaultPermissionOctal)
+	if err != nil {
+		return nil, fmt.Errorf("tick recorder %s %s %s: %w", exch, a, p, err)
+	}
+	w, err := tickdata.NewWriter(f, exch, a, p)
+	if err != nil {
+		return nil, common.AppendError(err, f.Close())
+	}
+	w.SnapshotInterval = r.snapshotInterval
+	w.MaxDepth = r.maxDepth
+	rec := &tickRecording{file: f, writer: w}
+	r.recordings[k] = rec
+	if r.verbose {
+		log.Debugf(log.DataHistory, "Tick recorder recording %s %s %s to %s", exch, a, p, path)
+	}
+	return rec, nil
+}
+
+func (t *tickRecording) close() error {
+	return common.AppendError(t.writer.Close(), t.file.Close())
+}
+
+// TickDataFileName returns the tick data file name for a recording of an
+// exchange, asset and pair started at t
+func TickDataFileName(exch string, a asset.Item, p currency.Pair, t time.Time) string {
+	return fmt.Sprintf("%s_%s_%s-%s_%s%s",
+		strings.ToLower(exch),
+		a,
+		p.Base.Lower(),
+		p.Quote.Lower(),
+		t.UTC().Format("20060102T150405"),
+		tickdata.FileExtension)
+}
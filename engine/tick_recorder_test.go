@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/tickdata"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func TestSetupTickRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := SetupTickRecorder(nil, "")
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupTickRecorder(&config.TickRecorder{}, "")
+	assert.ErrorIs(t, err, errTickRecorderDirectoryUnset)
+
+	r, err := SetupTickRecorder(&config.TickRecorder{}, "data")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("data", tickRecorderDirectory), r.directory)
+	assert.Equal(t, tickdata.DefaultSnapshotInterval, r.snapshotInterval)
+	assert.Equal(t, defaultTickRecorderFlushInterval, r.flushInterval)
+
+	r, err = SetupTickRecorder(&config.TickRecorder{Directory: "ticks", SnapshotInterval: 5, MaxDepth: 10}, "data")
+	require.NoError(t, err)
+	assert.Equal(t, "ticks", r.directory)
+	assert.Equal(t, 5, r.snapshotInterval)
+	assert.Equal(t, 10, r.maxDepth)
+}
+
+func TestTickRecorderStartStop(t *testing.T) {
+	t.Parallel()
+	var r *TickRecorder
+	assert.False(t, r.IsRunning())
+	assert.ErrorIs(t, r.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, r.Stop(), ErrNilSubsystem)
+
+	r, err := SetupTickRecorder(&config.TickRecorder{Directory: t.TempDir()}, "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, r.Start())
+	assert.True(t, r.IsRunning())
+	assert.ErrorIs(t, r.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, r.Stop())
+	assert.False(t, r.IsRunning())
+}
+
+func TestTickRecorderWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	r, err := SetupTickRecorder(&config.TickRecorder{Directory: dir}, "")
+	require.NoError(t, err)
+
+	const exch = "tickrecordertest"
+	depth, err := orderbook.DeployDepth(exch, btcusdPair, asset.Spot)
+	require.NoError(t, err)
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{
+		Bids:         []orderbook.Level{{Price: 99, Amount: 3}, {Price: 98, Amount: 1}},
+		Asks:         []orderbook.Level{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+		LastUpdated:  time.Now(),
+		LastPushed:   time.Now(),
+		RestSnapshot: true,
+	}))
+	tr := trade.Data{
+		Exchange:     exch,
+		CurrencyPair: btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Price:        99,
+		Amount:       0.5,
+		Timestamp:    time.Now(),
+	}
+
+	require.NoError(t, r.websocketDataHandler(exch, depth), "websocketDataHandler must not error when not running")
+	assert.Empty(t, r.recordings, "nothing should be recorded when not running")
+
+	require.NoError(t, r.Start())
+	require.NoError(t, r.websocketDataHandler(exch, depth))
+	require.NoError(t, r.websocketDataHandler(exch, []trade.Data{tr}))
+	require.NoError(t, r.websocketDataHandler(exch, "unhandled"))
+	assert.Len(t, r.recordings, 1, "books and trades for the same pair should share a recording")
+	require.NoError(t, r.Stop())
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+tickdata.FileExtension))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+	tdr, err := tickdata.NewReader(f)
+	require.NoError(t, err)
+	rec, err := tdr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, tickdata.RecordSnapshot, rec.Type)
+	assert.Len(t, rec.Book.Bids, 2)
+	rec, err = tdr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, tickdata.RecordTrade, rec.Type)
+	assert.Equal(t, order.Sell, rec.Trade.Side)
+	_, err = tdr.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestTickDataFileName(t *testing.T) {
+	t.Parallel()
+	n := TickDataFileName("Binance", asset.Spot, btcusdPair, time.Date(2026, 10, 16, 11, 0, 0, 0, time.UTC))
+	assert.Equal(t, "binance_spot_btc-usd_20261016T110000"+tickdata.FileExtension, n)
+}
@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/tickdata"
+)
+
+// TickRecorderName is an exported subsystem name
+const TickRecorderName = "tick_recorder"
+
+const (
+	defaultTickRecorderFlushInterval = time.Second * 5
+	tickRecorderDirectory            = "tickdata"
+)
+
+var errTickRecorderDirectoryUnset = errors.New("tick recorder directory unset")
+
+// TickRecorder writes the orderbook and trade updates received from exchange
+// websockets to a tick data file per exchange, asset and pair. The files can
+// be replayed by the backtester with the orderbook data type
+type TickRecorder struct {
+	started          int32
+	verbose          bool
+	directory        string
+	snapshotInterval int
+	maxDepth         int
+	flushInterval    time.Duration
+	shutdown         chan struct{}
+	wg               sync.WaitGroup
+	m                sync.Mutex
+	recordings       map[key.ExchangePairAsset]*tickRecording
+}
+
+// tickRecording is an open tick data file
+type tickRecording struct {
+	file   *os.File
+	writer *tickdata.Writer
+}
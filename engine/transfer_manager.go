@@ -0,0 +1,354 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/deposit"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// SetupTransferManager creates a transfer manager subsystem
+func SetupTransferManager(em iExchangeManager, cm iCommsManager, cfg *config.TransferManager) (*TransferManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cm == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w TransferManager", errNilConfig)
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultTransferCheckInterval
+	}
+	if cfg.TrackingPeriod <= 0 {
+		cfg.TrackingPeriod = defaultTransferTrackingPeriod
+	}
+	return &TransferManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		commsManager:    cm,
+		checkInterval:   cfg.CheckInterval,
+		trackingPeriod:  cfg.TrackingPeriod,
+		verbose:         cfg.Verbose,
+		withdrawals:     make(map[string]*withdraw.Response),
+		deposits:        make(map[string]*deposit.Data),
+		seeded:          make(map[string]bool),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *TransferManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem. Withdrawals stored in the database which have not
+// settled within the tracking period are resumed
+func (m *TransferManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("transfer manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("transfer manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Transfer manager starting. Check interval: %v\n", m.checkInterval)
+	m.loadUnsettledWithdrawals()
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *TransferManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("transfer manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("transfer manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.PortfolioMgr, "Transfer manager shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.PortfolioMgr, "Transfer manager shutdown.")
+	return nil
+}
+
+// TrackWithdrawal tracks a withdrawal submitted to an exchange until it
+// completes or fails
+func (m *TransferManager) TrackWithdrawal(resp *withdraw.Response) error {
+	if m == nil {
+		return fmt.Errorf("transfer manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("transfer manager %w", ErrSubSystemNotStarted)
+	}
+	if resp == nil {
+		return fmt.Errorf("%w withdrawal response", common.ErrNilPointer)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.track(resp)
+	return nil
+}
+
+// track adds a copy of a withdrawal to the tracked withdrawals. Withdrawals
+// without an exchange ID or which have settled are ignored
+func (m *TransferManager) track(resp *withdraw.Response) {
+	if resp.Exchange.ID == "" || withdraw.IsFinalStatus(resp.Exchange.Status) {
+		return
+	}
+	w := *resp
+	if w.RequestDetails.Exchange == "" {
+		// stored withdrawals only hold the exchange name in their response
+		w.RequestDetails.Exchange = w.Exchange.Name
+	}
+	if w.CreatedAt.IsZero() {
+		w.CreatedAt = time.Now()
+	}
+	m.withdrawals[transferKey(w.RequestDetails.Exchange, w.Exchange.ID)] = &w
+}
+
+func (m *TransferManager) loadUnsettledWithdrawals() {
+	stored, err := dbwithdraw.GetUnsettledEvents(time.Now().Add(-m.trackingPeriod))
+	if err != nil {
+		if !errors.Is(err, database.ErrDatabaseSupportDisabled) && !errors.Is(err, common.ErrNoResults) {
+			log.Errorf(log.PortfolioMgr, "Transfer manager unable to load unsettled withdrawals: %v", err)
+		}
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range stored {
+		m.track(stored[i])
+	}
+}
+
+func (m *TransferManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.checkTransfers()
+		}
+	}
+}
+
+// checkTransfers polls each exchange with authenticated API support for the
+// progress of its tracked withdrawals and for its deposits. The lock is not
+// held while exchanges are queried so that withdrawals can be tracked during a
+// check
+func (m *TransferManager) checkTransfers() {
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.PortfolioMgr, "Transfer manager cannot get exchanges: %v", err)
+		return
+	}
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() || !exchanges[i].IsRESTAuthenticationSupported() {
+			continue
+		}
+		m.checkWithdrawals(context.TODO(), exchanges[i])
+		m.checkDeposits(context.TODO(), exchanges[i])
+	}
+}
+
+// checkWithdrawals updates the lifecycle status of the tracked withdrawals on
+// an exchange from its withdrawal history for their asset and currency
+func (m *TransferManager) checkWithdrawals(ctx context.Context, exch exchange.IBotExchange) {
+	exchName := exch.GetName()
+	tracked := make(map[withdrawalHistoryKey][]string)
+	m.m.Lock()
+	for key, w := range m.withdrawals {
+		if !strings.EqualFold(w.RequestDetails.Exchange, exchName) {
+			continue
+		}
+		k := withdrawalHistoryKey{asset: w.RequestDetails.Asset, currency: w.RequestDetails.Currency}
+		if k.asset == asset.Empty {
+			k.asset = asset.Spot
+		}
+		tracked[k] = append(tracked[k], key)
+	}
+	m.m.Unlock()
+
+	for k, keys := range tracked {
+		history, err := exch.GetWithdrawalsHistory(ctx, k.currency, k.asset)
+		if err != nil {
+			m.logHistoryError(exchName, "withdrawal", err)
+			continue
+		}
+		m.updateWithdrawals(exchName, keys, history)
+	}
+}
+
+// updateWithdrawals updates the tracked withdrawals from an exchange's
+// withdrawal history. Withdrawals which settled or stopped being tracked since
+// the history was requested are skipped
+func (m *TransferManager) updateWithdrawals(exchName string, keys []string, history []exchange.WithdrawalHistory) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, key := range keys {
+		w, ok := m.withdrawals[key]
+		if !ok {
+			continue
+		}
+		idx := -1
+		for j := range history {
+			if history[j].TransferID == w.Exchange.ID {
+				idx = j
+				break
+			}
+		}
+		if idx == -1 {
+			if time.Since(w.CreatedAt) > m.trackingPeriod {
+				log.Warnf(log.PortfolioMgr, "Transfer manager no longer tracking %s withdrawal %s, not found within tracking period", exchName, w.Exchange.ID)
+				delete(m.withdrawals, key)
+			}
+			continue
+		}
+		status := transferLifecycleStatus(exchName, exchangeWithdrawalStatuses, history[idx].Status, history[idx].CryptoTxID, history[idx].Confirmations)
+		if status == w.Exchange.Status && history[idx].CryptoTxID == w.Exchange.TxID && history[idx].Confirmations == w.Exchange.Confirmations {
+			continue
+		}
+		w.Exchange.Status = status
+		w.Exchange.TxID = history[idx].CryptoTxID
+		w.Exchange.Confirmations = history[idx].Confirmations
+		w.UpdatedAt = time.Now()
+		if !w.ID.IsNil() {
+			if err := dbwithdraw.UpdateLifecycle(w.ID, status, w.Exchange.TxID, w.Exchange.Confirmations); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+				log.Errorf(log.PortfolioMgr, "Transfer manager unable to store %s withdrawal %s status: %v", exchName, w.Exchange.ID, err)
+			}
+		}
+		m.notify(fmt.Sprintf("%s withdrawal %s of %v %s is %s", exchName, w.Exchange.ID, w.RequestDetails.Amount, w.RequestDetails.Currency, describeTransferStatus(status, w.Exchange.TxID, w.Exchange.Confirmations)))
+		if withdraw.IsFinalStatus(status) {
+			delete(m.withdrawals, key)
+		}
+	}
+}
+
+// checkDeposits records the deposits in an exchange's funding history and
+// notifies changes to their lifecycle status
+func (m *TransferManager) checkDeposits(ctx context.Context, exch exchange.IBotExchange) {
+	exchName := exch.GetName()
+	history, err := exch.GetAccountFundingHistory(ctx)
+	if err != nil {
+		m.logHistoryError(exchName, "funding", err)
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	seeded := m.seeded[exchName]
+	var changed []deposit.Data
+	for i := range history {
+		if !strings.Contains(strings.ToLower(history[i].TransferType), "deposit") {
+			continue
+		}
+		id := history[i].TransferID
+		if id == "" {
+			id = history[i].CryptoTxID
+		}
+		if id == "" {
+			continue
+		}
+		status := transferLifecycleStatus(exchName, exchangeDepositStatuses, history[i].Status, history[i].CryptoTxID, history[i].Confirmations)
+		key := transferKey(exchName, id)
+		if prev, ok := m.deposits[key]; ok && prev.Status == status && prev.TxID == history[i].CryptoTxID && prev.Confirmations == history[i].Confirmations {
+			continue
+		}
+		d := &deposit.Data{
+			Exchange:      exchName,
+			ExchangeID:    id,
+			Status:        status,
+			Currency:      history[i].Currency,
+			Amount:        history[i].Amount,
+			Fee:           history[i].Fee,
+			Address:       history[i].CryptoToAddress,
+			TxID:          history[i].CryptoTxID,
+			Chain:         history[i].CryptoChain,
+			Confirmations: history[i].Confirmations,
+			CreatedAt:     history[i].Timestamp,
+			UpdatedAt:     time.Now(),
+		}
+		m.deposits[key] = d
+		changed = append(changed, *d)
+		if seeded {
+			m.notify(fmt.Sprintf("%s deposit %s of %v %s is %s", exchName, id, d.Amount, d.Currency, describeTransferStatus(status, d.TxID, d.Confirmations)))
+		}
+	}
+	m.seeded[exchName] = true
+	if len(changed) == 0 {
+		return
+	}
+	if err := deposit.Insert(changed...); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.PortfolioMgr, "Transfer manager unable to store %s deposits: %v", exchName, err)
+	}
+}
+
+// logHistoryError logs a failure to fetch transfer history. Exchanges which
+// do not support the request are only logged when verbose
+func (m *TransferManager) logHistoryError(exchName, history string, err error) {
+	if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+		if m.verbose {
+			log.Debugf(log.PortfolioMgr, "Transfer manager skipping %s %s history: %v", exchName, history, err)
+		}
+		return
+	}
+	log.Errorf(log.PortfolioMgr, "Transfer manager unable to get %s %s history: %v", exchName, history, err)
+}
+
+// notify logs a transfer lifecycle change and relays it to the
+// communications manager
+func (m *TransferManager) notify(msg string) {
+	log.Infoln(log.PortfolioMgr, "Transfer manager: "+msg)
+	m.commsManager.PushEvent(base.Event{Type: transferEventType, Message: msg})
+}
+
+// transferLifecycleStatus maps a transfer status returned by an exchange to a
+// lifecycle status. Pending transfers with a transaction hash have been
+// broadcast and broadcast transfers with confirmations are confirming
+func transferLifecycleStatus(exchName string, statuses map[string]map[string]string, status, txID string, confirmations int64) string {
+	s, ok := statuses[strings.ToLower(exchName)][status]
+	if !ok {
+		s = withdraw.StringToLifecycleStatus(status)
+	}
+	if s == withdraw.StatusPending && txID != "" {
+		s = withdraw.StatusBroadcast
+	}
+	if s == withdraw.StatusBroadcast && confirmations > 0 {
+		s = withdraw.StatusConfirming
+	}
+	return s
+}
+
+func describeTransferStatus(status, txID string, confirmations int64) string {
+	var sb strings.Builder
+	sb.WriteString(status)
+	if txID != "" {
+		sb.WriteString(" tx: " + txID)
+	}
+	if confirmations > 0 {
+		fmt.Fprintf(&sb, " confirmations: %d", confirmations)
+	}
+	return sb.String()
+}
+
+func transferKey(exchName, id string) string {
+	return strings.ToLower(exchName) + "-" + id
+}
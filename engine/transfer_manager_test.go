@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// transferTestExchange returns preset transfer history without an exchange
+// connection
+type transferTestExchange struct {
+	exchange.IBotExchange
+	withdrawals []exchange.WithdrawalHistory
+	funding     []exchange.FundingHistory
+	err         error
+	// assets holds the assets withdrawal history was requested for
+	assets []asset.Item
+	// onHistory is called while withdrawal history is requested when set
+	onHistory func()
+}
+
+func (e *transferTestExchange) IsEnabled() bool { return true }
+
+func (e *transferTestExchange) IsRESTAuthenticationSupported() bool { return true }
+
+func (e *transferTestExchange) GetWithdrawalsHistory(_ context.Context, _ currency.Code, a asset.Item) ([]exchange.WithdrawalHistory, error) {
+	e.assets = append(e.assets, a)
+	if e.onHistory != nil {
+		e.onHistory()
+	}
+	return e.withdrawals, e.err
+}
+
+func (e *transferTestExchange) GetAccountFundingHistory(context.Context) ([]exchange.FundingHistory, error) {
+	return e.funding, e.err
+}
+
+func setupTransferTest(t *testing.T) (*TransferManager, *transferTestExchange, *riskEventRecorder) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().Name = fakeExchangeName
+	e := &transferTestExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(e), "Add must not error")
+	events := &riskEventRecorder{}
+	m, err := SetupTransferManager(em, events, &config.TransferManager{})
+	require.NoError(t, err, "SetupTransferManager must not error")
+	m.started = 1
+	return m, e, events
+}
+
+func TestSetupTransferManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupTransferManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupTransferManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+
+	_, err = SetupTransferManager(NewExchangeManager(), &riskEventRecorder{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	cfg := &config.TransferManager{}
+	m, err := SetupTransferManager(NewExchangeManager(), &riskEventRecorder{}, cfg)
+	require.NoError(t, err, "SetupTransferManager must not error")
+	assert.Equal(t, defaultTransferCheckInterval, cfg.CheckInterval, "CheckInterval should be defaulted")
+	assert.Equal(t, defaultTransferTrackingPeriod, cfg.TrackingPeriod, "TrackingPeriod should be defaulted")
+	assert.Equal(t, defaultTransferTrackingPeriod, m.trackingPeriod, "trackingPeriod should be set")
+}
+
+func TestTransferManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *TransferManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+
+	m, err := SetupTransferManager(NewExchangeManager(), &riskEventRecorder{}, &config.TransferManager{})
+	require.NoError(t, err, "SetupTransferManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+}
+
+func TestTransferManagerTrackWithdrawal(t *testing.T) {
+	t.Parallel()
+	var m *TransferManager
+	assert.ErrorIs(t, m.TrackWithdrawal(&withdraw.Response{}), ErrNilSubsystem)
+
+	m, err := SetupTransferManager(NewExchangeManager(), &riskEventRecorder{}, &config.TransferManager{})
+	require.NoError(t, err, "SetupTransferManager must not error")
+	assert.ErrorIs(t, m.TrackWithdrawal(&withdraw.Response{}), ErrSubSystemNotStarted)
+
+	m.started = 1
+	assert.ErrorIs(t, m.TrackWithdrawal(nil), common.ErrNilPointer)
+
+	require.NoError(t, m.TrackWithdrawal(&withdraw.Response{}), "TrackWithdrawal must not error")
+	assert.Empty(t, m.withdrawals, "withdrawals without an exchange ID should not be tracked")
+
+	require.NoError(t, m.TrackWithdrawal(&withdraw.Response{Exchange: withdraw.ExchangeResponse{ID: "1", Status: withdraw.StatusFailed}}), "TrackWithdrawal must not error")
+	assert.Empty(t, m.withdrawals, "settled withdrawals should not be tracked")
+
+	resp := &withdraw.Response{Exchange: withdraw.ExchangeResponse{Name: "Fake", ID: "1337", Status: "submitted"}}
+	require.NoError(t, m.TrackWithdrawal(resp), "TrackWithdrawal must not error")
+	require.Contains(t, m.withdrawals, "fake-1337", "withdrawal must be tracked by exchange and ID")
+	tracked := m.withdrawals["fake-1337"]
+	assert.Equal(t, "Fake", tracked.RequestDetails.Exchange, "the exchange name should be taken from the response")
+	assert.False(t, tracked.CreatedAt.IsZero(), "CreatedAt should be set")
+	assert.NotSame(t, resp, tracked, "a copy of the withdrawal should be tracked")
+}
+
+func TestTransferLifecycleStatus(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		exchange      string
+		statuses      map[string]map[string]string
+		status        string
+		txID          string
+		confirmations int64
+		expected      string
+	}{
+		{exchange: "Binance", statuses: exchangeWithdrawalStatuses, status: "6", expected: withdraw.StatusCompleted},
+		{exchange: "Binance", statuses: exchangeWithdrawalStatuses, status: "4", txID: "0x1", confirmations: 3, expected: withdraw.StatusConfirming},
+		{exchange: "Okx", statuses: exchangeWithdrawalStatuses, status: "-2", expected: withdraw.StatusFailed},
+		{exchange: "Okx", statuses: exchangeDepositStatuses, status: "0", expected: withdraw.StatusConfirming},
+		{exchange: "Okx", statuses: exchangeDepositStatuses, status: "2", expected: withdraw.StatusCompleted},
+		{exchange: "Kraken", statuses: exchangeWithdrawalStatuses, status: "Initial", expected: withdraw.StatusPending},
+		{exchange: "Kraken", statuses: exchangeWithdrawalStatuses, status: "Pending", txID: "0x1", expected: withdraw.StatusBroadcast},
+		{exchange: "Kraken", statuses: exchangeWithdrawalStatuses, status: "Pending", txID: "0x1", confirmations: 1, expected: withdraw.StatusConfirming},
+		{exchange: "Kraken", statuses: exchangeWithdrawalStatuses, status: "Success", txID: "0x1", confirmations: 1, expected: withdraw.StatusCompleted},
+	} {
+		assert.Equalf(t, tc.expected, transferLifecycleStatus(tc.exchange, tc.statuses, tc.status, tc.txID, tc.confirmations), "transferLifecycleStatus should map %s status %q correctly", tc.exchange, tc.status)
+	}
+}
+
+func TestTransferManagerCheckTransfers(t *testing.T) {
+	t.Parallel()
+	m, e, events := setupTransferTest(t)
+	require.NoError(t, m.TrackWithdrawal(&withdraw.Response{
+		Exchange:       withdraw.ExchangeResponse{ID: "1337", Status: "submitted"},
+		RequestDetails: withdraw.Request{Exchange: fakeExchangeName, Currency: currency.BTC, Amount: 1},
+	}), "TrackWithdrawal must not error")
+
+	e.withdrawals = []exchange.WithdrawalHistory{
+		{TransferID: "1336", Status: "Success"},
+		{TransferID: "1337", Status: "Processing", CryptoTxID: "0xabc", Confirmations: 2},
+	}
+	e.funding = []exchange.FundingHistory{
+		{TransferID: "d1", TransferType: "deposit", Status: "Success", Currency: "BTC", Amount: 2},
+		{TransferID: "w1", TransferType: "withdrawal", Status: "Success", Currency: "BTC", Amount: 1},
+		{TransferType: "deposit", Status: "Success"},
+	}
+	m.checkTransfers()
+	require.Contains(t, m.withdrawals, "fake-1337", "an unsettled withdrawal must remain tracked")
+	tracked := m.withdrawals["fake-1337"]
+	assert.Equal(t, withdraw.StatusConfirming, tracked.Exchange.Status, "the withdrawal status should be updated")
+	assert.Equal(t, "0xabc", tracked.Exchange.TxID, "the withdrawal transaction hash should be updated")
+	assert.Equal(t, int64(2), tracked.Exchange.Confirmations, "the withdrawal confirmations should be updated")
+	require.Len(t, m.deposits, 1, "only deposits with an ID must be recorded")
+	assert.Equal(t, withdraw.StatusCompleted, m.deposits["fake-d1"].Status, "the deposit status should be mapped")
+	require.Len(t, events.events, 1, "deposits seen on the first check must not be notified")
+	assert.Equal(t, transferEventType, events.events[0].Type)
+	assert.Contains(t, events.events[0].Message, "withdrawal 1337 of 1 BTC is confirming tx: 0xabc confirmations: 2")
+
+	m.checkTransfers()
+	assert.Len(t, events.events, 1, "unchanged transfers should not be notified")
+
+	e.withdrawals[1].Status = "Success"
+	e.withdrawals[1].Confirmations = 6
+	e.funding = append(e.funding, exchange.FundingHistory{TransferType: "Deposit", Status: "Pending", CryptoTxID: "0xdef", Currency: "ETH", Amount: 3})
+	m.checkTransfers()
+	assert.Empty(t, m.withdrawals, "completed withdrawals should no longer be tracked")
+	require.Len(t, events.events, 3, "the completed withdrawal and new deposit must be notified")
+	assert.Contains(t, events.events[1].Message, "withdrawal 1337 of 1 BTC is completed")
+	assert.Contains(t, events.events[2].Message, "deposit 0xdef of 3 ETH is broadcast tx: 0xdef")
+	assert.Equal(t, withdraw.StatusBroadcast, m.deposits["fake-0xdef"].Status, "deposits without an ID should be keyed by transaction hash")
+}
+
+func TestTransferManagerCheckTransfersUntracked(t *testing.T) {
+	t.Parallel()
+	m, e, events := setupTransferTest(t)
+	require.NoError(t, m.TrackWithdrawal(&withdraw.Response{
+		Exchange:       withdraw.ExchangeResponse{ID: "1337", Status: "submitted"},
+		RequestDetails: withdraw.Request{Exchange: fakeExchangeName, Currency: currency.BTC, Amount: 1},
+		CreatedAt:      time.Now(),
+	}), "TrackWithdrawal must not error")
+
+	e.err = common.ErrFunctionNotSupported
+	m.checkTransfers()
+	assert.Contains(t, m.withdrawals, "fake-1337", "withdrawals should remain tracked when history is unsupported")
+	assert.Empty(t, m.seeded, "exchanges without funding history should not be seeded")
+
+	e.err = nil
+	m.checkTransfers()
+	assert.Contains(t, m.withdrawals, "fake-1337", "withdrawals missing from history should remain tracked within the tracking period")
+
+	m.withdrawals["fake-1337"].CreatedAt = time.Now().Add(-m.trackingPeriod - time.Minute)
+	m.checkTransfers()
+	assert.Empty(t, m.withdrawals, "withdrawals missing from history should not be tracked after the tracking period")
+	assert.Empty(t, events.events, "no transfers should be notified")
+}
+
+func TestTransferManagerCheckWithdrawalsAsset(t *testing.T) {
+	t.Parallel()
+	m, e, _ := setupTransferTest(t)
+	require.NoError(t, m.TrackWithdrawal(&withdraw.Response{
+		Exchange:       withdraw.ExchangeResponse{ID: "1337", Status: "submitted"},
+		RequestDetails: withdraw.Request{Exchange: fakeExchangeName, Currency: currency.BTC, Amount: 1},
+	}), "TrackWithdrawal must not error")
+	require.NoError(t, m.TrackWithdrawal(&withdraw.Response{
+		Exchange:       withdraw.ExchangeResponse{ID: "1338", Status: "submitted"},
+		RequestDetails: withdraw.Request{Exchange: fakeExchangeName, Currency: currency.BTC, Amount: 1, Asset: asset.Margin},
+	}), "TrackWithdrawal must not error")
+
+	e.onHistory = func() {
+		assert.NoError(t, m.TrackWithdrawal(&withdraw.Response{
+			Exchange:       withdraw.ExchangeResponse{ID: "1339", Status: "submitted"},
+			RequestDetails: withdraw.Request{Exchange: fakeExchangeName, Currency: currency.BTC, Amount: 1},
+		}), "TrackWithdrawal should not be blocked while history is requested")
+	}
+	e.withdrawals = []exchange.WithdrawalHistory{{TransferID: "1338", Status: "Success"}}
+	m.checkTransfers()
+	assert.ElementsMatch(t, []asset.Item{asset.Spot, asset.Margin}, e.assets, "history should be requested for the asset of each withdrawal")
+	assert.NotContains(t, m.withdrawals, "fake-1338", "the completed margin withdrawal should no longer be tracked")
+	assert.Contains(t, m.withdrawals, "fake-1337", "the spot withdrawal should remain tracked")
+	assert.Contains(t, m.withdrawals, "fake-1339", "a withdrawal tracked during a check should be tracked")
+}
+
+func TestWithdrawManagerTrackWithdrawal(t *testing.T) {
+	t.Parallel()
+	m, _, _ := withdrawalPolicyTestManager(t)
+	tm, _, _ := setupTransferTest(t)
+	m.SetTransferManager(tm)
+	_, err := m.SubmitWithdrawal(t.Context(), &withdraw.Request{
+		Exchange: fakeExchangeName,
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	})
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	require.Contains(t, tm.withdrawals, "fake-1337", "a submitted withdrawal must be tracked")
+	assert.Equal(t, currency.BTC, tm.withdrawals["fake-1337"].RequestDetails.Currency)
+}
@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// TransferManagerName is an exported subsystem name
+const TransferManagerName = "transfer_manager"
+
+const (
+	transferEventType             = "transfer"
+	defaultTransferCheckInterval  = time.Minute
+	defaultTransferTrackingPeriod = time.Hour * 24 * 7
+)
+
+// exchangeWithdrawalStatuses maps the numeric withdrawal statuses returned by
+// exchanges to lifecycle statuses, keyed by exchange name. Descriptive
+// statuses are mapped by withdraw.StringToLifecycleStatus
+var exchangeWithdrawalStatuses = map[string]map[string]string{
+	"binance": {
+		"0": withdraw.StatusPending,   // email sent
+		"1": withdraw.StatusFailed,    // cancelled
+		"2": withdraw.StatusPending,   // awaiting approval
+		"3": withdraw.StatusFailed,    // rejected
+		"4": withdraw.StatusBroadcast, // processing
+		"5": withdraw.StatusFailed,    // failure
+		"6": withdraw.StatusCompleted, // completed
+	},
+	"okx": {
+		"-3": withdraw.StatusFailed,    // cancelling
+		"-2": withdraw.StatusFailed,    // cancelled
+		"-1": withdraw.StatusFailed,    // failed
+		"0":  withdraw.StatusPending,   // waiting withdrawal
+		"1":  withdraw.StatusBroadcast, // withdrawing
+		"2":  withdraw.StatusCompleted, // withdraw success
+		"4":  withdraw.StatusPending,   // waiting manual review
+		"5":  withdraw.StatusPending,   // waiting manual review
+		"6":  withdraw.StatusPending,   // waiting manual review
+		"7":  withdraw.StatusPending,   // approved
+		"8":  withdraw.StatusPending,   // waiting manual review
+		"9":  withdraw.StatusPending,   // waiting manual review
+		"10": withdraw.StatusPending,   // waiting transfer
+		"12": withdraw.StatusPending,   // waiting manual review
+		"15": withdraw.StatusPending,   // pending transaction validation
+		"16": withdraw.StatusPending,   // delayed due to local laws
+		"17": withdraw.StatusPending,   // pending travel rule
+	},
+}
+
+// exchangeDepositStatuses maps the numeric deposit statuses returned by
+// exchanges to lifecycle statuses, keyed by exchange name
+var exchangeDepositStatuses = map[string]map[string]string{
+	"okx": {
+		"0":  withdraw.StatusConfirming, // waiting for confirmation
+		"1":  withdraw.StatusConfirming, // credited, cannot withdraw
+		"2":  withdraw.StatusCompleted,  // deposit successful
+		"8":  withdraw.StatusPending,    // temporary deposit suspension
+		"11": withdraw.StatusPending,    // address blacklisted
+		"12": withdraw.StatusPending,    // account or deposit frozen
+		"13": withdraw.StatusPending,    // sub-account deposit interception
+		"14": withdraw.StatusPending,    // KYC limit
+	},
+}
+
+// TransferManager polls exchanges for the progress of submitted withdrawals
+// and for deposits, storing their lifecycle status and notifying when it
+// changes
+type TransferManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	m               sync.Mutex
+	exchangeManager iExchangeManager
+	commsManager    iCommsManager
+	checkInterval   time.Duration
+	trackingPeriod  time.Duration
+	verbose         bool
+	// withdrawals holds the submitted withdrawals which have not completed or
+	// failed, keyed by exchange and exchange withdrawal ID
+	withdrawals map[string]*withdraw.Response
+	// deposits holds the last seen state of each deposit, keyed by exchange
+	// and exchange deposit ID
+	deposits map[string]*deposit.Data
+	// seeded holds the exchanges whose existing deposits have been recorded.
+	// Deposits seen before an exchange is seeded are not notified
+	seeded map[string]bool
+}
+
+// withdrawalHistoryKey groups tracked withdrawals by the withdrawal history
+// they are found in
+type withdrawalHistoryKey struct {
+	asset    asset.Item
+	currency currency.Code
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -23,6 +25,7 @@ func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bo
 		exchangeManager:  em,
 		portfolioManager: pm,
 		isDryRun:         isDryRun,
+		policy:           newWithdrawalPolicy(),
 	}, nil
 }
 
@@ -49,7 +52,11 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	}
 
 	// Determines if the currency can be withdrawn from the exchange
-	errF := exch.CanWithdraw(req.Currency, asset.Spot)
+	a := req.Asset
+	if a == asset.Empty {
+		a = asset.Spot
+	}
+	errF := exch.CanWithdraw(req.Currency, a)
 	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
 		return nil, errF
 	}
@@ -59,37 +66,118 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		resp.ID = withdraw.DryRunID
 		resp.Exchange.Status = "dryrun"
 		resp.Exchange.ID = withdraw.DryRunID.String()
-	} else {
-		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Crypto {
-			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
-				return nil, withdraw.ErrStrAddressNotWhiteListed
-			}
-			if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
-				return nil, withdraw.ErrStrExchangeNotSupportedByAddress
-			}
+		dbwithdraw.Event(resp)
+		withdraw.Cache.Add(resp.ID, resp)
+		return resp, nil
+	}
+
+	if req.Type == withdraw.Crypto {
+		if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
+			return nil, withdraw.ErrStrAddressNotWhiteListed
 		}
-		switch req.Type {
-		case withdraw.Fiat:
-			ret, err = exch.WithdrawFiatFunds(ctx, req)
-		case withdraw.Crypto:
-			ret, err = exch.WithdrawCryptocurrencyFunds(ctx, req)
-		default:
-			return nil, fmt.Errorf("unsupported withdrawal type: %v", req.Type)
+		if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
+			return nil, withdraw.ErrStrExchangeNotSupportedByAddress
 		}
-
+	}
+	if req.Type != withdraw.Fiat && req.Type != withdraw.Crypto {
+		return nil, fmt.Errorf("unsupported withdrawal type: %v", req.Type)
+	}
+	var usage *withdrawalUsage
+	if m.policy.isEnabled() {
+		var held bool
+		usage, held, err = m.checkWithdrawalPolicy(ctx, resp)
 		if err != nil {
-			resp.Exchange.Status = err.Error()
-		} else {
-			resp.Exchange.Status = ret.Status
-			resp.Exchange.ID = ret.ID
+			return nil, err
+		}
+		if held {
+			return resp, nil
 		}
 	}
-	dbwithdraw.Event(resp)
-	if err == nil {
-		withdraw.Cache.Add(resp.ID, resp)
+	if err := m.submit(ctx, exch, resp); err != nil {
+		m.policy.release(usage)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// submit submits a withdrawal request to its exchange and stores the outcome.
+// Withdrawals which were pending approval have their stored status updated
+func (m *WithdrawManager) submit(ctx context.Context, exch exchange.IBotExchange, resp *withdraw.Response) error {
+	req := &resp.RequestDetails
+	var ret *withdraw.ExchangeResponse
+	var err error
+	switch req.Type {
+	case withdraw.Fiat:
+		ret, err = exch.WithdrawFiatFunds(ctx, req)
+	case withdraw.Crypto:
+		ret, err = exch.WithdrawCryptocurrencyFunds(ctx, req)
+	default:
+		return fmt.Errorf("unsupported withdrawal type: %v", req.Type)
+	}
+
+	if err != nil {
+		resp.Exchange.Status = err.Error()
+	} else {
+		resp.Exchange.Status = ret.Status
+		resp.Exchange.ID = ret.ID
+	}
+	if resp.ID.IsNil() {
+		dbwithdraw.Event(resp)
+	} else {
+		m.updateWithdrawalStatus(resp)
+	}
+	if err != nil {
+		return err
+	}
+	withdraw.Cache.Add(resp.ID, resp)
+	if req.Type == withdraw.Crypto {
+		m.recordLedgerWithdrawal(req, resp)
+	}
+	if m.transferManager.IsRunning() {
+		if err := m.transferManager.TrackWithdrawal(resp); err != nil {
+			log.Errorf(log.PortfolioMgr, "Unable to track %s %s withdrawal: %v", req.Exchange, req.Currency, err)
+		}
+	}
+	return nil
+}
+
+// SetTransferManager sets the transfer manager which tracks submitted
+// withdrawals until they complete or fail
+func (m *WithdrawManager) SetTransferManager(tm *TransferManager) {
+	if m == nil {
+		return
+	}
+	m.transferManager = tm
+}
+
+// SetLedgerManager sets the ledger manager which completed cryptocurrency
+// withdrawals are recorded against
+func (m *WithdrawManager) SetLedgerManager(lm *LedgerManager) {
+	if m == nil {
+		return
+	}
+	m.ledgerManager = lm
+}
+
+// recordLedgerWithdrawal records a submitted cryptocurrency withdrawal and its
+// fee against the ledger
+func (m *WithdrawManager) recordLedgerWithdrawal(req *withdraw.Request, resp *withdraw.Response) {
+	if !m.ledgerManager.IsRunning() {
+		return
+	}
+	e := &ledger.Entry{
+		Exchange: req.Exchange,
+		Type:     ledger.Withdrawal,
+		Currency: req.Currency,
+		Amount:   req.Amount,
+		Fee:      req.Crypto.FeeAmount,
+	}
+	if !resp.ID.IsNil() {
+		e.ID = resp.ID.String()
+	}
+	if err := m.ledgerManager.RecordTransfer(e); err != nil {
+		log.Errorf(log.PortfolioMgr, "Unable to record %s %s withdrawal in ledger: %v", req.Exchange, req.Currency, err)
 	}
-	return resp, err
 }
 
 // WithdrawalEventByID returns a withdrawal request by ID
@@ -13,4 +13,11 @@ type WithdrawManager struct {
 	exchangeManager  iExchangeManager
 	portfolioManager iPortfolioManager
 	isDryRun         bool
+	// ledgerManager records completed cryptocurrency withdrawals when set
+	ledgerManager *LedgerManager
+	policy        *withdrawalPolicy
+	// commsManager relays withdrawal policy events when set
+	commsManager iCommsManager
+	// transferManager tracks submitted withdrawals until they settle when set
+	transferManager *TransferManager
 }
@@ -0,0 +1,562 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// newWithdrawalPolicy returns a disabled withdrawal policy
+func newWithdrawalPolicy() *withdrawalPolicy {
+	return &withdrawalPolicy{
+		cfg:       config.WithdrawalPolicy{ApprovalExpiry: defaultWithdrawalApprovalExpiry},
+		usedCodes: make(map[string]time.Time),
+		pending:   make(map[uuid.UUID]*PendingWithdrawal),
+	}
+}
+
+// validateWithdrawalPolicy ensures an enabled withdrawal policy can be applied
+func validateWithdrawalPolicy(cfg *config.WithdrawalPolicy) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.AddressCoolingOffPeriod < 0 || cfg.ApprovalExpiry < 0 {
+		return errWithdrawalPolicyNegative
+	}
+	if cfg.TOTPSecret == "" {
+		return errWithdrawalTOTPSecretUnset
+	}
+	if _, err := totp.GenerateCode(cfg.TOTPSecret, time.Now()); err != nil {
+		return fmt.Errorf("%w: %w", errWithdrawalTOTPSecretInvalid, err)
+	}
+	for i := range cfg.Limits {
+		if cfg.Limits[i].Currency.IsEmpty() {
+			return fmt.Errorf("limit %d %w", i, errWithdrawalLimitCurrencyUnset)
+		}
+		if cfg.Limits[i].DailyLimit < 0 || cfg.Limits[i].WeeklyLimit < 0 || cfg.Limits[i].ApprovalThreshold < 0 {
+			return fmt.Errorf("limit %d %s %w", i, cfg.Limits[i].Currency, errWithdrawalPolicyNegative)
+		}
+		for j := range i {
+			if cfg.Limits[j].Currency.Equal(cfg.Limits[i].Currency) {
+				return fmt.Errorf("%w %s", errWithdrawalLimitDuplicate, cfg.Limits[i].Currency)
+			}
+		}
+	}
+	return nil
+}
+
+// update replaces the withdrawal policy config, withdrawals pending approval
+// and withdrawal usage are retained
+func (p *withdrawalPolicy) update(cfg *config.WithdrawalPolicy) error {
+	if err := validateWithdrawalPolicy(cfg); err != nil {
+		return err
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.cfg = *cfg
+	p.cfg.Limits = slices.Clone(cfg.Limits)
+	if p.cfg.ApprovalExpiry == 0 {
+		p.cfg.ApprovalExpiry = defaultWithdrawalApprovalExpiry
+	}
+	return nil
+}
+
+// isEnabled returns whether withdrawals are checked against the policy
+func (p *withdrawalPolicy) isEnabled() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.cfg.Enabled
+}
+
+// admit checks a withdrawal request against the policy and counts its amount
+// against the currency's limits. It returns whether the withdrawal must be
+// approved by a second principal before it is submitted
+func (p *withdrawalPolicy) admit(req *withdraw.Request, whiteListedAt time.Time) (*withdrawalUsage, bool, error) {
+	now := time.Now()
+	p.m.Lock()
+	defer p.m.Unlock()
+	if err := p.confirm(req.ConfirmationCode, now); err != nil {
+		return nil, false, err
+	}
+	if err := p.checkCoolingOff(req, whiteListedAt, now); err != nil {
+		return nil, false, err
+	}
+
+	p.usage = slices.DeleteFunc(p.usage, func(u *withdrawalUsage) bool {
+		return now.Sub(u.time) >= withdrawalWeeklyWindow
+	})
+	var needsApproval bool
+	if i := slices.IndexFunc(p.cfg.Limits, func(l config.WithdrawalLimit) bool {
+		return l.Currency.Equal(req.Currency)
+	}); i != -1 {
+		limit := p.cfg.Limits[i]
+		var daily, weekly float64
+		for _, u := range p.usage {
+			if !u.currency.Equal(req.Currency) {
+				continue
+			}
+			weekly += u.amount
+			if now.Sub(u.time) < withdrawalDailyWindow {
+				daily += u.amount
+			}
+		}
+		if limit.DailyLimit > 0 && daily+req.Amount > limit.DailyLimit {
+			return nil, false, fmt.Errorf("%w: %v %s of %v %s already withdrawn", errWithdrawalDailyLimitExceeded, daily, req.Currency, limit.DailyLimit, req.Currency)
+		}
+		if limit.WeeklyLimit > 0 && weekly+req.Amount > limit.WeeklyLimit {
+			return nil, false, fmt.Errorf("%w: %v %s of %v %s already withdrawn", errWithdrawalWeeklyLimitExceeded, weekly, req.Currency, limit.WeeklyLimit, req.Currency)
+		}
+		needsApproval = limit.ApprovalThreshold > 0 && req.Amount > limit.ApprovalThreshold
+	}
+	u := &withdrawalUsage{currency: req.Currency, amount: req.Amount, time: now}
+	p.usage = append(p.usage, u)
+	return u, needsApproval, nil
+}
+
+// checkAddress checks that a cryptocurrency withdrawal address has been
+// whitelisted for longer than the cooling-off period
+func (p *withdrawalPolicy) checkAddress(req *withdraw.Request, whiteListedAt time.Time) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.checkCoolingOff(req, whiteListedAt, time.Now())
+}
+
+// checkCoolingOff checks a withdrawal address against the cooling-off period,
+// the caller must hold the policy lock
+func (p *withdrawalPolicy) checkCoolingOff(req *withdraw.Request, whiteListedAt, now time.Time) error {
+	if req.Type != withdraw.Crypto || p.cfg.AddressCoolingOffPeriod <= 0 {
+		return nil
+	}
+	if whiteListedAt.IsZero() {
+		return errWithdrawalAddressCoolingOff
+	}
+	if until := whiteListedAt.Add(p.cfg.AddressCoolingOffPeriod); now.Before(until) {
+		return fmt.Errorf("%w until %s", errWithdrawalAddressCoolingOff, until.Format(time.DateTime))
+	}
+	return nil
+}
+
+// confirm validates a TOTP confirmation code which has not been used before,
+// the caller must hold the policy lock
+func (p *withdrawalPolicy) confirm(code string, now time.Time) error {
+	if code == "" {
+		return errWithdrawalConfirmationRequired
+	}
+	for c, t := range p.usedCodes {
+		if now.Sub(t) >= withdrawalConfirmationCodeExpiry {
+			delete(p.usedCodes, c)
+		}
+	}
+	if _, used := p.usedCodes[code]; used || !totp.Validate(code, p.cfg.TOTPSecret) {
+		return errWithdrawalConfirmationInvalid
+	}
+	p.usedCodes[code] = now
+	return nil
+}
+
+// seed counts withdrawals made before the policy was set up, such as those
+// stored by a previous run, against their currencies' limits
+func (p *withdrawalPolicy) seed(usage []*withdrawalUsage) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.usage = append(p.usage, usage...)
+}
+
+// release stops a withdrawal counting against its currency's limits
+func (p *withdrawalPolicy) release(u *withdrawalUsage) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.releaseUsage(u)
+}
+
+// releaseUsage removes a withdrawal's usage, the caller must hold the policy
+// lock
+func (p *withdrawalPolicy) releaseUsage(u *withdrawalUsage) {
+	p.usage = slices.DeleteFunc(p.usage, func(v *withdrawalUsage) bool {
+		return v == u
+	})
+}
+
+// hold stores a withdrawal until it is approved, rejected or expires. Exchange
+// credentials and the confirmation code are not retained
+func (p *withdrawalPolicy) hold(id uuid.UUID, req *withdraw.Request, requester *rpcPrincipal, u *withdrawalUsage) *PendingWithdrawal {
+	p.m.Lock()
+	defer p.m.Unlock()
+	pw := &PendingWithdrawal{
+		ID:        id,
+		Request:   *req,
+		CreatedAt: u.time,
+		Expires:   u.time.Add(p.cfg.ApprovalExpiry),
+		usage:     u,
+	}
+	if requester != nil {
+		pw.RequestedBy = requester.name
+		pw.requester = requester.identity()
+	}
+	pw.Request.TradePassword = ""
+	pw.Request.OneTimePassword = 0
+	pw.Request.PIN = 0
+	pw.Request.ConfirmationCode = ""
+	p.pending[id] = pw
+	return pw
+}
+
+// approve removes a pending withdrawal so that it can be submitted. The
+// approver must differ from the requester and confirm the approval when a TOTP
+// secret is set. Tokens are treated as the user who created them so that a
+// requester cannot approve their own withdrawal with a token
+func (p *withdrawalPolicy) approve(id uuid.UUID, approver *rpcPrincipal, code string) (*PendingWithdrawal, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	pw, ok := p.pending[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errPendingWithdrawalNotFound, id)
+	}
+	if pw.requester != "" && strings.EqualFold(pw.requester, approver.identity()) {
+		return nil, errWithdrawalSelfApproval
+	}
+	if p.cfg.TOTPSecret != "" {
+		if err := p.confirm(code, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	delete(p.pending, id)
+	return pw, nil
+}
+
+// reject removes a pending withdrawal and stops it counting against its
+// currency's limits
+func (p *withdrawalPolicy) reject(id uuid.UUID) (*PendingWithdrawal, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	pw, ok := p.pending[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errPendingWithdrawalNotFound, id)
+	}
+	delete(p.pending, id)
+	p.releaseUsage(pw.usage)
+	return pw, nil
+}
+
+// expire removes and returns the pending withdrawals which were not approved
+// in time
+func (p *withdrawalPolicy) expire(now time.Time) []*PendingWithdrawal {
+	p.m.Lock()
+	defer p.m.Unlock()
+	var expired []*PendingWithdrawal
+	for id, pw := range p.pending {
+		if now.Before(pw.Expires) {
+			continue
+		}
+		delete(p.pending, id)
+		p.releaseUsage(pw.usage)
+		expired = append(expired, pw)
+	}
+	return expired
+}
+
+// list returns the pending withdrawals, oldest first
+func (p *withdrawalPolicy) list() []PendingWithdrawal {
+	p.m.Lock()
+	defer p.m.Unlock()
+	resp := make([]PendingWithdrawal, 0, len(p.pending))
+	for _, pw := range p.pending {
+		resp = append(resp, *pw)
+	}
+	slices.SortFunc(resp, func(a, b PendingWithdrawal) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return resp
+}
+
+// SetWithdrawalPolicy sets the policy withdrawals are checked against before
+// they are submitted to an exchange. Withdrawals pending approval are retained
+func (m *WithdrawManager) SetWithdrawalPolicy(cfg *config.WithdrawalPolicy) error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w WithdrawalPolicy", errNilConfig)
+	}
+	return m.policy.update(cfg)
+}
+
+// SetCommunicationsManager sets the communications manager which withdrawal
+// policy events are relayed through
+func (m *WithdrawManager) SetCommunicationsManager(cm iCommsManager) {
+	if m == nil {
+		return
+	}
+	m.commsManager = cm
+}
+
+// GetPendingWithdrawals returns the withdrawals awaiting approval
+func (m *WithdrawManager) GetPendingWithdrawals() ([]PendingWithdrawal, error) {
+	if m == nil {
+		return nil, fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	m.expirePendingWithdrawals()
+	return m.policy.list(), nil
+}
+
+// ApproveWithdrawal submits a withdrawal which is pending approval. The gRPC
+// principal approving it must differ from the one which requested it.
+// setCredentials populates the exchange credentials of the request before it
+// is submitted when set
+func (m *WithdrawManager) ApproveWithdrawal(ctx context.Context, id, confirmationCode string, setCredentials func(*withdraw.Request) error) (*withdraw.Response, error) {
+	if m == nil {
+		return nil, fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	approver, err := rpcPrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s", errPendingWithdrawalNotFound, id)
+	}
+	m.expirePendingWithdrawals()
+	pw, err := m.policy.approve(pid, approver, confirmationCode)
+	if err != nil {
+		return nil, err
+	}
+	// The address may have been removed from the whitelist or whitelisted again
+	// while the withdrawal was pending
+	if err := m.checkWithdrawalAddress(&pw.Request); err != nil {
+		m.policy.release(pw.usage)
+		m.updateWithdrawalStatus(&withdraw.Response{
+			ID:       pw.ID,
+			Exchange: withdraw.ExchangeResponse{Status: withdraw.StatusRejected},
+		})
+		m.reportWithdrawalEvent(pw.ID.String(), fmt.Sprintf("%s requested by %s rejected on approval by %s: %v",
+			describeWithdrawal(pw.ID, &pw.Request), cmp.Or(pw.RequestedBy, "unknown"), approver.name, err))
+		return nil, err
+	}
+	m.reportWithdrawalEvent(pw.ID.String(), fmt.Sprintf("%s requested by %s approved by %s",
+		describeWithdrawal(pw.ID, &pw.Request), cmp.Or(pw.RequestedBy, "unknown"), approver.name))
+
+	resp := &withdraw.Response{
+		ID:             pw.ID,
+		Exchange:       withdraw.ExchangeResponse{Name: pw.Request.Exchange},
+		RequestDetails: pw.Request,
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(pw.Request.Exchange)
+	if err == nil && setCredentials != nil {
+		err = setCredentials(&resp.RequestDetails)
+	}
+	if err == nil {
+		err = m.submit(ctx, exch, resp)
+	} else {
+		resp.Exchange.Status = err.Error()
+		m.updateWithdrawalStatus(resp)
+	}
+	if err != nil {
+		m.policy.release(pw.usage)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// RejectWithdrawal rejects a withdrawal which is pending approval
+func (m *WithdrawManager) RejectWithdrawal(ctx context.Context, id, reason string) error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	pid, err := uuid.FromString(id)
+	if err != nil {
+		return fmt.Errorf("%w %s", errPendingWithdrawalNotFound, id)
+	}
+	m.expirePendingWithdrawals()
+	pw, err := m.policy.reject(pid)
+	if err != nil {
+		return err
+	}
+	m.updateWithdrawalStatus(&withdraw.Response{
+		ID:       pw.ID,
+		Exchange: withdraw.ExchangeResponse{Status: withdraw.StatusRejected},
+	})
+	msg := fmt.Sprintf("%s requested by %s rejected by %s", describeWithdrawal(pw.ID, &pw.Request),
+		cmp.Or(pw.RequestedBy, "unknown"), cmp.Or(withdrawalPrincipal(ctx), "unknown"))
+	if reason != "" {
+		msg += ": " + reason
+	}
+	m.reportWithdrawalEvent(pw.ID.String(), msg)
+	return nil
+}
+
+// checkWithdrawalPolicy checks a withdrawal request against the withdrawal
+// policy. Refused requests are stored and reported as rejected and requests
+// which need approval are stored and reported as pending. It returns the usage
+// counted against the currency's limits and whether the request was held for
+// approval
+func (m *WithdrawManager) checkWithdrawalPolicy(ctx context.Context, resp *withdraw.Response) (*withdrawalUsage, bool, error) {
+	m.expirePendingWithdrawals()
+	req := &resp.RequestDetails
+	requestedBy := withdrawalPrincipal(ctx)
+	var whiteListedAt time.Time
+	if req.Type == withdraw.Crypto {
+		whiteListedAt = m.portfolioManager.WhiteListedAt(req.Crypto.Address)
+	}
+	u, needsApproval, err := m.policy.admit(req, whiteListedAt)
+	if err != nil {
+		if id, errID := uuid.NewV4(); errID == nil {
+			resp.ID = id
+		}
+		resp.Exchange.Status = withdraw.StatusRejected
+		dbwithdraw.Event(resp)
+		m.reportWithdrawalEvent(resp.ID.String(), fmt.Sprintf("%s requested by %s rejected: %v",
+			describeWithdrawal(resp.ID, req), cmp.Or(requestedBy, "unknown"), err))
+		return nil, false, err
+	}
+	if !needsApproval {
+		return u, false, nil
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		m.policy.release(u)
+		return nil, false, err
+	}
+	resp.ID = id
+	resp.Exchange.Status = withdraw.StatusPendingApproval
+	dbwithdraw.Event(resp)
+	requester, _ := rpcPrincipalFromContext(ctx) // nil when not requested via gRPC
+	pw := m.policy.hold(resp.ID, req, requester, u)
+	m.reportWithdrawalEvent(pw.ID.String(), fmt.Sprintf("%s requested by %s is pending approval until %s",
+		describeWithdrawal(pw.ID, req), cmp.Or(requestedBy, "unknown"), pw.Expires.Format(time.DateTime)))
+	return u, true, nil
+}
+
+// checkWithdrawalAddress checks that a cryptocurrency withdrawal address is
+// whitelisted for the exchange and outside its cooling-off period
+func (m *WithdrawManager) checkWithdrawalAddress(req *withdraw.Request) error {
+	if req.Type != withdraw.Crypto {
+		return nil
+	}
+	if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
+		return withdraw.ErrStrAddressNotWhiteListed
+	}
+	if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
+		return withdraw.ErrStrExchangeNotSupportedByAddress
+	}
+	return m.policy.checkAddress(req, m.portfolioManager.WhiteListedAt(req.Crypto.Address))
+}
+
+// loadWithdrawalHistory counts the withdrawals stored within the weekly limit
+// window against the withdrawal limits so that they persist across restarts,
+// and rejects withdrawals left pending approval by a previous run
+func (m *WithdrawManager) loadWithdrawalHistory() error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	pending, err := dbwithdraw.GetEventsByStatus(withdraw.StatusPendingApproval)
+	if err != nil && !errors.Is(err, common.ErrNoResults) {
+		return err
+	}
+	recent, err := dbwithdraw.GetEventsSince(time.Now().Add(-withdrawalWeeklyWindow))
+	if err != nil && !errors.Is(err, common.ErrNoResults) {
+		return err
+	}
+	m.seedWithdrawalHistory(append(pending, slices.DeleteFunc(recent, func(r *withdraw.Response) bool {
+		return r.Exchange.Status == withdraw.StatusPendingApproval
+	})...))
+	return nil
+}
+
+// seedWithdrawalHistory counts stored withdrawals which were submitted to an
+// exchange against the withdrawal limits. Withdrawals left pending approval by
+// a previous run are rejected, as their requester and exchange details are not
+// stored so they cannot be approved safely
+func (m *WithdrawManager) seedWithdrawalHistory(stored []*withdraw.Response) {
+	var usage []*withdrawalUsage
+	for _, resp := range stored {
+		if resp.RequestDetails.Exchange == "" {
+			// stored withdrawals only hold the exchange name in their response
+			resp.RequestDetails.Exchange = resp.Exchange.Name
+		}
+		switch resp.Exchange.Status {
+		case withdraw.StatusPendingApproval:
+			resp.Exchange.Status = withdraw.StatusRejected
+			m.updateWithdrawalStatus(resp)
+			m.reportWithdrawalEvent(resp.ID.String(), describeWithdrawal(resp.ID, &resp.RequestDetails)+" expired awaiting approval as the engine restarted")
+		case withdraw.StatusRejected, withdraw.StatusFailed:
+			// the funds did not leave the exchange
+		default:
+			// Withdrawals which the exchange did not accept have no exchange ID
+			if resp.Exchange.ID == "" || resp.Exchange.ID == withdraw.DryRunID.String() {
+				continue
+			}
+			usage = append(usage, &withdrawalUsage{
+				currency: resp.RequestDetails.Currency,
+				amount:   resp.RequestDetails.Amount,
+				time:     resp.CreatedAt,
+			})
+		}
+	}
+	m.policy.seed(usage)
+}
+
+// expirePendingWithdrawals rejects pending withdrawals which were not approved
+// in time
+func (m *WithdrawManager) expirePendingWithdrawals() {
+	for _, pw := range m.policy.expire(time.Now()) {
+		m.updateWithdrawalStatus(&withdraw.Response{
+			ID:       pw.ID,
+			Exchange: withdraw.ExchangeResponse{Status: withdraw.StatusRejected},
+		})
+		m.reportWithdrawalEvent(pw.ID.String(), fmt.Sprintf("%s requested by %s expired awaiting approval",
+			describeWithdrawal(pw.ID, &pw.Request), cmp.Or(pw.RequestedBy, "unknown")))
+	}
+}
+
+// updateWithdrawalStatus updates the stored status of a withdrawal
+func (m *WithdrawManager) updateWithdrawalStatus(resp *withdraw.Response) {
+	err := dbwithdraw.UpdateStatus(resp.ID, resp.Exchange.ID, resp.Exchange.Status)
+	if err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.PortfolioMgr, "Unable to update withdrawal %s status: %v", resp.ID, err)
+	}
+}
+
+// reportWithdrawalEvent logs a withdrawal policy event, relays it through the
+// communications manager and writes it to the audit log
+func (m *WithdrawManager) reportWithdrawalEvent(id, msg string) {
+	log.Warnln(log.PortfolioMgr, msg)
+	if m.commsManager != nil {
+		m.commsManager.PushEvent(base.Event{Type: withdrawalEventType, Message: msg})
+	}
+	audit.Event(id, withdrawalEventType, msg)
+}
+
+// withdrawalPrincipal returns the name of the gRPC principal making a
+// withdrawal request, or an empty string when it was not made over gRPC
+func withdrawalPrincipal(ctx context.Context) string {
+	p, err := rpcPrincipalFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return p.name
+}
+
+// describeWithdrawal summarises a withdrawal request for event messages
+func describeWithdrawal(id uuid.UUID, req *withdraw.Request) string {
+	desc := fmt.Sprintf("Withdrawal %s of %v %s from %s", id, req.Amount, req.Currency, req.Exchange)
+	if req.Type == withdraw.Crypto {
+		desc += " to " + req.Crypto.Address
+	}
+	return desc
+}
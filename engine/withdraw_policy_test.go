@@ -0,0 +1,457 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const withdrawalPolicyTestSecret = "JBSWY3DPEHPK3PXP"
+
+func withdrawalPolicyTestCode(t *testing.T) string {
+	t.Helper()
+	code, err := totp.GenerateCode(withdrawalPolicyTestSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	return code
+}
+
+// withdrawalPolicyExchange accepts withdrawals without an exchange connection
+type withdrawalPolicyExchange struct {
+	exchange.IBotExchange
+	err error
+}
+
+func (w *withdrawalPolicyExchange) CanWithdraw(currency.Code, asset.Item) error {
+	return nil
+}
+
+func (w *withdrawalPolicyExchange) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return &withdraw.ExchangeResponse{ID: "1337", Status: "submitted"}, nil
+}
+
+func withdrawalPolicyTestManager(t *testing.T) (*WithdrawManager, *withdrawalPolicyExchange, *portfolioManager) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().Name = fakeExchangeName
+	w := &withdrawalPolicyExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(w), "Add must not error")
+
+	pm, err := setupPortfolioManager(em, 0, &portfolio.Base{
+		Addresses: []portfolio.Address{{
+			Address:            "1337",
+			CoinType:           currency.BTC,
+			WhiteListed:        true,
+			SupportedExchanges: fakeExchangeName,
+		}},
+	})
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	pm.started = 1
+
+	m, err := SetupWithdrawManager(em, pm, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	return m, w, pm
+}
+
+func withdrawalPolicyTestConfig() *config.WithdrawalPolicy {
+	return &config.WithdrawalPolicy{
+		Enabled:    true,
+		TOTPSecret: withdrawalPolicyTestSecret,
+		Limits: []config.WithdrawalLimit{
+			{Currency: currency.BTC, DailyLimit: 2, WeeklyLimit: 3, ApprovalThreshold: 1},
+		},
+	}
+}
+
+func TestValidateWithdrawalPolicy(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, validateWithdrawalPolicy(&config.WithdrawalPolicy{TOTPSecret: "invalid"}), "validateWithdrawalPolicy must not error when disabled")
+	require.NoError(t, validateWithdrawalPolicy(withdrawalPolicyTestConfig()), "validateWithdrawalPolicy must not error")
+
+	cfg := withdrawalPolicyTestConfig()
+	cfg.AddressCoolingOffPeriod = -time.Hour
+	assert.ErrorIs(t, validateWithdrawalPolicy(cfg), errWithdrawalPolicyNegative)
+
+	cfg = withdrawalPolicyTestConfig()
+	cfg.TOTPSecret = ""
+	assert.ErrorIs(t, validateWithdrawalPolicy(cfg), errWithdrawalTOTPSecretUnset)
+
+	cfg.TOTPSecret = "1"
+	assert.ErrorIs(t, validateWithdrawalPolicy(cfg), errWithdrawalTOTPSecretInvalid)
+
+	cfg = withdrawalPolicyTestConfig()
+	cfg.Limits[0].Currency = currency.EMPTYCODE
+	assert.ErrorIs(t, validateWithdrawalPolicy(cfg), errWithdrawalLimitCurrencyUnset)
+
+	cfg = withdrawalPolicyTestConfig()
+	cfg.Limits[0].WeeklyLimit = -1
+	assert.ErrorIs(t, validateWithdrawalPolicy(cfg), errWithdrawalPolicyNegative)
+
+	cfg = withdrawalPolicyTestConfig()
+	cfg.Limits = append(cfg.Limits, config.WithdrawalLimit{Currency: currency.BTC})
+	assert.ErrorIs(t, validateWithdrawalPolicy(cfg), errWithdrawalLimitDuplicate)
+}
+
+func TestSetWithdrawalPolicy(t *testing.T) {
+	t.Parallel()
+	var m *WithdrawManager
+	assert.ErrorIs(t, m.SetWithdrawalPolicy(withdrawalPolicyTestConfig()), ErrNilSubsystem)
+
+	m, _, _ = withdrawalPolicyTestManager(t)
+	assert.False(t, m.policy.isEnabled(), "policy should be disabled by default")
+	assert.ErrorIs(t, m.SetWithdrawalPolicy(nil), errNilConfig)
+
+	cfg := withdrawalPolicyTestConfig()
+	cfg.TOTPSecret = ""
+	assert.ErrorIs(t, m.SetWithdrawalPolicy(cfg), errWithdrawalTOTPSecretUnset)
+	assert.False(t, m.policy.isEnabled(), "an invalid policy should not be applied")
+
+	cfg = withdrawalPolicyTestConfig()
+	require.NoError(t, m.SetWithdrawalPolicy(cfg), "SetWithdrawalPolicy must not error")
+	assert.True(t, m.policy.isEnabled(), "policy should be enabled")
+	assert.Equal(t, defaultWithdrawalApprovalExpiry, m.policy.cfg.ApprovalExpiry, "ApprovalExpiry should default")
+	cfg.Limits[0].DailyLimit = 42
+	assert.Equal(t, 2.0, m.policy.cfg.Limits[0].DailyLimit, "policy limits should not share memory with the config")
+}
+
+func TestWithdrawalPolicyAdmit(t *testing.T) {
+	t.Parallel()
+	p := newWithdrawalPolicy()
+	require.NoError(t, p.update(withdrawalPolicyTestConfig()), "update must not error")
+
+	req := &withdraw.Request{Currency: currency.BTC, Amount: 1, Type: withdraw.Crypto}
+	_, _, err := p.admit(req, time.Now())
+	assert.ErrorIs(t, err, errWithdrawalConfirmationRequired)
+
+	req.ConfirmationCode = "000000"
+	if req.ConfirmationCode == withdrawalPolicyTestCode(t) {
+		req.ConfirmationCode = "111111"
+	}
+	_, _, err = p.admit(req, time.Now())
+	assert.ErrorIs(t, err, errWithdrawalConfirmationInvalid)
+
+	req.ConfirmationCode = withdrawalPolicyTestCode(t)
+	u, needsApproval, err := p.admit(req, time.Now())
+	require.NoError(t, err, "admit must not error")
+	assert.False(t, needsApproval, "a withdrawal at the approval threshold should not need approval")
+	assert.Equal(t, 1.0, u.amount, "usage should hold the withdrawal amount")
+
+	_, _, err = p.admit(req, time.Now())
+	assert.ErrorIs(t, err, errWithdrawalConfirmationInvalid, "a confirmation code should not be accepted twice")
+
+	clear(p.usedCodes)
+	req.Amount = 1.5
+	_, _, err = p.admit(req, time.Now())
+	assert.ErrorIs(t, err, errWithdrawalDailyLimitExceeded)
+
+	clear(p.usedCodes)
+	p.usage[0].time = time.Now().Add(-withdrawalDailyWindow)
+	p.usage = append(p.usage, &withdrawalUsage{currency: currency.BTC, amount: 1, time: time.Now().Add(-withdrawalDailyWindow * 2)})
+	_, _, err = p.admit(req, time.Now())
+	assert.ErrorIs(t, err, errWithdrawalWeeklyLimitExceeded)
+
+	clear(p.usedCodes)
+	p.usage[1].time = time.Now().Add(-withdrawalWeeklyWindow)
+	u, needsApproval, err = p.admit(req, time.Now())
+	require.NoError(t, err, "admit must not error")
+	assert.True(t, needsApproval, "a withdrawal above the approval threshold should need approval")
+	assert.Len(t, p.usage, 2, "usage outside the weekly window should be removed")
+
+	p.release(u)
+	assert.Len(t, p.usage, 1, "release should remove the usage")
+
+	clear(p.usedCodes)
+	req.Currency = currency.ETH
+	req.Amount = 100
+	_, needsApproval, err = p.admit(req, time.Now())
+	require.NoError(t, err, "admit must not error for a currency without limits")
+	assert.False(t, needsApproval, "a currency without limits should not need approval")
+
+	p.cfg.AddressCoolingOffPeriod = time.Hour
+	clear(p.usedCodes)
+	_, _, err = p.admit(req, time.Time{})
+	assert.ErrorIs(t, err, errWithdrawalAddressCoolingOff, "an address without a whitelisted time should be cooling off")
+
+	clear(p.usedCodes)
+	_, _, err = p.admit(req, time.Now().Add(-time.Minute))
+	assert.ErrorIs(t, err, errWithdrawalAddressCoolingOff)
+
+	clear(p.usedCodes)
+	_, _, err = p.admit(req, time.Now().Add(-time.Hour))
+	assert.NoError(t, err, "admit should not error once the cooling-off period has passed")
+
+	clear(p.usedCodes)
+	req.Type = withdraw.Fiat
+	_, _, err = p.admit(req, time.Time{})
+	assert.NoError(t, err, "the cooling-off period should not apply to fiat withdrawals")
+}
+
+func TestWithdrawalPolicyPending(t *testing.T) {
+	t.Parallel()
+	p := newWithdrawalPolicy()
+	require.NoError(t, p.update(withdrawalPolicyTestConfig()), "update must not error")
+
+	req := &withdraw.Request{
+		Currency:         currency.BTC,
+		Amount:           1.5,
+		TradePassword:    "hunter2",
+		PIN:              1337,
+		OneTimePassword:  1337,
+		ConfirmationCode: "1337",
+	}
+	u := &withdrawalUsage{currency: currency.BTC, amount: 1.5, time: time.Now()}
+	p.usage = append(p.usage, u)
+	id := uuid.Must(uuid.NewV4())
+	alice := &rpcPrincipal{name: "alice"}
+	bob := &rpcPrincipal{name: "bob"}
+	pw := p.hold(id, req, alice, u)
+	assert.Equal(t, u.time.Add(defaultWithdrawalApprovalExpiry), pw.Expires, "Expires should be set from the approval expiry")
+	assert.Empty(t, pw.Request.TradePassword, "TradePassword should not be retained")
+	assert.Zero(t, pw.Request.PIN, "PIN should not be retained")
+	assert.Zero(t, pw.Request.OneTimePassword, "OneTimePassword should not be retained")
+	assert.Empty(t, pw.Request.ConfirmationCode, "ConfirmationCode should not be retained")
+	require.Len(t, p.list(), 1, "list must return the pending withdrawal")
+
+	_, err := p.approve(uuid.Must(uuid.NewV4()), bob, withdrawalPolicyTestCode(t))
+	assert.ErrorIs(t, err, errPendingWithdrawalNotFound)
+
+	_, err = p.approve(id, &rpcPrincipal{name: "Alice"}, withdrawalPolicyTestCode(t))
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval)
+
+	_, err = p.approve(id, &rpcPrincipal{name: "token:bot", owner: "alice"}, withdrawalPolicyTestCode(t))
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval, "a token created by the requester should not approve")
+
+	_, err = p.approve(id, bob, "")
+	assert.ErrorIs(t, err, errWithdrawalConfirmationRequired)
+
+	approved, err := p.approve(id, bob, withdrawalPolicyTestCode(t))
+	require.NoError(t, err, "approve must not error")
+	assert.Equal(t, id, approved.ID, "approve should return the pending withdrawal")
+	assert.Empty(t, p.list(), "an approved withdrawal should no longer be pending")
+	assert.Len(t, p.usage, 1, "an approved withdrawal should still count against the limits")
+
+	p.hold(id, req, alice, u)
+	_, err = p.reject(uuid.Must(uuid.NewV4()))
+	assert.ErrorIs(t, err, errPendingWithdrawalNotFound)
+	_, err = p.reject(id)
+	require.NoError(t, err, "reject must not error")
+	assert.Empty(t, p.list(), "a rejected withdrawal should no longer be pending")
+	assert.Empty(t, p.usage, "a rejected withdrawal should not count against the limits")
+
+	p.usage = append(p.usage, u)
+	p.hold(id, req, alice, u)
+	assert.Empty(t, p.expire(time.Now()), "expire should not remove a withdrawal before it expires")
+	expired := p.expire(time.Now().Add(defaultWithdrawalApprovalExpiry))
+	require.Len(t, expired, 1, "expire must remove an expired withdrawal")
+	assert.Equal(t, id, expired[0].ID, "expire should return the expired withdrawal")
+	assert.Empty(t, p.usage, "an expired withdrawal should not count against the limits")
+}
+
+func TestSeedWithdrawalHistory(t *testing.T) {
+	t.Parallel()
+	m, _, _ := withdrawalPolicyTestManager(t)
+	require.NoError(t, m.SetWithdrawalPolicy(withdrawalPolicyTestConfig()), "SetWithdrawalPolicy must not error")
+	events := &riskEventRecorder{}
+	m.SetCommunicationsManager(events)
+
+	created := time.Now().Add(-time.Hour)
+	stored := func(status, exchangeID string) *withdraw.Response {
+		return &withdraw.Response{
+			ID:             uuid.Must(uuid.NewV4()),
+			Exchange:       withdraw.ExchangeResponse{Name: fakeExchangeName, ID: exchangeID, Status: status},
+			RequestDetails: withdraw.Request{Currency: currency.BTC, Amount: 0.5, Type: withdraw.Crypto},
+			CreatedAt:      created,
+		}
+	}
+	m.seedWithdrawalHistory([]*withdraw.Response{
+		stored("submitted", "1337"),
+		stored(withdraw.StatusCompleted, "1338"),
+		stored(withdraw.StatusPendingApproval, ""),
+		stored(withdraw.StatusRejected, ""),
+		stored(withdraw.StatusFailed, "1339"),
+		stored("insufficient funds", ""),
+		stored("dryrun", withdraw.DryRunID.String()),
+	})
+	require.Len(t, m.policy.usage, 2, "only withdrawals accepted by the exchange must count against the limits")
+	assert.Equal(t, created, m.policy.usage[0].time, "usage should be counted from when the withdrawal was created")
+	assert.Empty(t, m.policy.list(), "stored pending withdrawals should not be held")
+	require.Len(t, events.events, 1, "a stored pending withdrawal must be reported")
+	assert.Contains(t, events.events[0].Message, "expired awaiting approval", "event should report the expiry")
+	assert.Contains(t, events.events[0].Message, fakeExchangeName, "event should include the exchange name")
+
+	clear(m.policy.usedCodes)
+	_, err := m.SubmitWithdrawal(t.Context(), &withdraw.Request{
+		Exchange:         fakeExchangeName,
+		Currency:         currency.BTC,
+		Amount:           1.5,
+		Type:             withdraw.Crypto,
+		Crypto:           withdraw.CryptoRequest{Address: "1337"},
+		ConfirmationCode: withdrawalPolicyTestCode(t),
+	})
+	assert.ErrorIs(t, err, errWithdrawalDailyLimitExceeded, "stored withdrawals should count against the daily limit")
+
+	m = nil
+	assert.ErrorIs(t, m.loadWithdrawalHistory(), ErrNilSubsystem)
+}
+
+func TestSubmitWithdrawalPolicy(t *testing.T) {
+	t.Parallel()
+	m, exch, pm := withdrawalPolicyTestManager(t)
+	cfg := withdrawalPolicyTestConfig()
+	cfg.AddressCoolingOffPeriod = time.Hour
+	require.NoError(t, m.SetWithdrawalPolicy(cfg), "SetWithdrawalPolicy must not error")
+	events := &riskEventRecorder{}
+	m.SetCommunicationsManager(events)
+
+	alice := withRPCPrincipal(t.Context(), &rpcPrincipal{name: "alice", scopes: []string{RPCScopeWithdraw}})
+	bob := withRPCPrincipal(t.Context(), &rpcPrincipal{name: "bob", scopes: []string{RPCScopeWithdraw}})
+	req := &withdraw.Request{
+		Exchange:         fakeExchangeName,
+		Currency:         currency.BTC,
+		Amount:           1.5,
+		Type:             withdraw.Crypto,
+		Crypto:           withdraw.CryptoRequest{Address: "1337"},
+		ConfirmationCode: withdrawalPolicyTestCode(t),
+	}
+	_, err := m.SubmitWithdrawal(alice, req)
+	assert.ErrorIs(t, err, errWithdrawalAddressCoolingOff, "an address whitelisted during setup should be cooling off")
+	require.Len(t, events.events, 1, "a rejected withdrawal must be reported")
+	assert.Equal(t, withdrawalEventType, events.events[0].Type, "event type should be withdrawal")
+	assert.Contains(t, events.events[0].Message, "rejected", "event should report the rejection")
+
+	pm.base.Addresses[0].WhiteListedAt = time.Now().Add(-time.Hour * 2)
+	clear(m.policy.usedCodes)
+	resp, err := m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, withdraw.StatusPendingApproval, resp.Exchange.Status, "a withdrawal above the approval threshold should be pending approval")
+	assert.False(t, resp.ID.IsNil(), "a pending withdrawal should have an ID")
+	require.Len(t, events.events, 2, "a pending withdrawal must be reported")
+	assert.Contains(t, events.events[1].Message, "pending approval", "event should report the pending approval")
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1, "GetPendingWithdrawals must return the pending withdrawal")
+	assert.Equal(t, "alice", pending[0].RequestedBy, "RequestedBy should be the requesting principal")
+
+	clear(m.policy.usedCodes)
+	_, err = m.ApproveWithdrawal(context.Background(), resp.ID.String(), withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, errRPCPrincipalNotInContext)
+
+	_, err = m.ApproveWithdrawal(bob, "1337", withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, errPendingWithdrawalNotFound)
+
+	_, err = m.ApproveWithdrawal(alice, resp.ID.String(), withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval)
+
+	a := setupRPCAuthTest(t)
+	token, _, err := a.createToken("alicebot", "alice", []string{RPCScopeWithdraw}, time.Now().Add(time.Hour))
+	require.NoError(t, err, "createToken must not error")
+	aliceToken, err := a.authenticate("Bearer " + token)
+	require.NoError(t, err, "authenticate must not error")
+	clear(m.policy.usedCodes)
+	_, err = m.ApproveWithdrawal(withRPCPrincipal(t.Context(), aliceToken), resp.ID.String(), withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval, "a token minted by the requester should not approve their withdrawal")
+	clear(m.policy.usedCodes)
+
+	var credentialsSet bool
+	approved, err := m.ApproveWithdrawal(bob, resp.ID.String(), withdrawalPolicyTestCode(t), func(*withdraw.Request) error {
+		credentialsSet = true
+		return nil
+	})
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.True(t, credentialsSet, "credentials should be set before submission")
+	assert.Equal(t, resp.ID, approved.ID, "an approved withdrawal should keep its ID")
+	assert.Equal(t, "submitted", approved.Exchange.Status, "an approved withdrawal should be submitted to the exchange")
+	assert.Equal(t, "1337", approved.Exchange.ID, "an approved withdrawal should have its exchange ID set")
+	assert.Len(t, m.policy.usage, 1, "a submitted withdrawal should count against the limits")
+	assert.Contains(t, events.events[2].Message, "approved by bob", "event should report the approval")
+
+	clear(m.policy.usedCodes)
+	req.Amount = 0.5
+	resp, err = m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, "submitted", resp.Exchange.Status, "a withdrawal below the approval threshold should be submitted to the exchange")
+
+	clear(m.policy.usedCodes)
+	_, err = m.SubmitWithdrawal(alice, req)
+	assert.ErrorIs(t, err, errWithdrawalDailyLimitExceeded)
+
+	m.policy.usage = nil
+	exch.err = errExpectedTestError
+	clear(m.policy.usedCodes)
+	_, err = m.SubmitWithdrawal(alice, req)
+	assert.ErrorIs(t, err, errExpectedTestError)
+	assert.Empty(t, m.policy.usage, "a failed submission should not count against the limits")
+
+	clear(m.policy.usedCodes)
+	req.Amount = 1.5
+	resp, err = m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	clear(m.policy.usedCodes)
+	_, err = m.ApproveWithdrawal(bob, resp.ID.String(), withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, errExpectedTestError)
+	assert.Empty(t, m.policy.usage, "a failed approved submission should not count against the limits")
+
+	clear(m.policy.usedCodes)
+	resp, err = m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	pm.base.Addresses[0].WhiteListedAt = time.Now()
+	clear(m.policy.usedCodes)
+	_, err = m.ApproveWithdrawal(bob, resp.ID.String(), withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, errWithdrawalAddressCoolingOff, "an address whitelisted again while pending should be cooling off on approval")
+	assert.Empty(t, m.policy.usage, "a withdrawal rejected on approval should not count against the limits")
+	assert.Contains(t, events.events[len(events.events)-1].Message, "rejected on approval by bob", "event should report the rejection")
+	pm.base.Addresses[0].WhiteListedAt = time.Now().Add(-time.Hour * 2)
+
+	clear(m.policy.usedCodes)
+	resp, err = m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	pm.base.Addresses[0].WhiteListed = false
+	clear(m.policy.usedCodes)
+	_, err = m.ApproveWithdrawal(bob, resp.ID.String(), withdrawalPolicyTestCode(t), nil)
+	assert.ErrorIs(t, err, withdraw.ErrStrAddressNotWhiteListed, "an address removed from the whitelist while pending should be rejected on approval")
+	assert.Empty(t, m.policy.usage, "a withdrawal rejected on approval should not count against the limits")
+	pm.base.Addresses[0].WhiteListed = true
+
+	clear(m.policy.usedCodes)
+	resp, err = m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.ErrorIs(t, m.RejectWithdrawal(bob, "1337", ""), errPendingWithdrawalNotFound)
+	require.NoError(t, m.RejectWithdrawal(bob, resp.ID.String(), "unexpected"), "RejectWithdrawal must not error")
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "a rejected withdrawal should no longer be pending")
+	assert.Contains(t, events.events[len(events.events)-1].Message, "rejected by bob: unexpected", "event should report the rejection and reason")
+
+	clear(m.policy.usedCodes)
+	resp, err = m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	m.policy.pending[resp.ID].Expires = time.Now()
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "an expired withdrawal should no longer be pending")
+	assert.Contains(t, events.events[len(events.events)-1].Message, "expired awaiting approval", "event should report the expiry")
+
+	m = nil
+	_, err = m.GetPendingWithdrawals()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.ApproveWithdrawal(bob, resp.ID.String(), "", nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	assert.ErrorIs(t, m.RejectWithdrawal(bob, resp.ID.String(), ""), ErrNilSubsystem)
+}
@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const (
+	withdrawalEventType              = "withdrawal"
+	defaultWithdrawalApprovalExpiry  = time.Hour * 24
+	withdrawalDailyWindow            = time.Hour * 24
+	withdrawalWeeklyWindow           = time.Hour * 24 * 7
+	withdrawalConfirmationCodeExpiry = time.Second * 90
+)
+
+var (
+	errWithdrawalPolicyNegative       = errors.New("withdrawal policy values cannot be negative")
+	errWithdrawalTOTPSecretUnset      = errors.New("withdrawal policy TOTP secret unset")
+	errWithdrawalTOTPSecretInvalid    = errors.New("invalid withdrawal policy TOTP secret")
+	errWithdrawalLimitCurrencyUnset   = errors.New("withdrawal limit currency unset")
+	errWithdrawalLimitDuplicate       = errors.New("duplicate withdrawal limit currency")
+	errWithdrawalConfirmationRequired = errors.New("withdrawal TOTP confirmation code required")
+	errWithdrawalConfirmationInvalid  = errors.New("invalid or reused withdrawal TOTP confirmation code")
+	errWithdrawalAddressCoolingOff    = errors.New("address is within its whitelist cooling-off period")
+	errWithdrawalDailyLimitExceeded   = errors.New("daily withdrawal limit exceeded")
+	errWithdrawalWeeklyLimitExceeded  = errors.New("weekly withdrawal limit exceeded")
+	errPendingWithdrawalNotFound      = errors.New("pending withdrawal not found")
+	errWithdrawalSelfApproval         = errors.New("withdrawal cannot be approved by the principal who requested it")
+)
+
+// withdrawalPolicy enforces withdrawal limits, whitelisted address cooling-off
+// periods and TOTP confirmation, and holds withdrawals above an approval
+// threshold until a second principal approves them
+type withdrawalPolicy struct {
+	m   sync.Mutex
+	cfg config.WithdrawalPolicy
+	// usage holds the withdrawals submitted or pending approval within the
+	// weekly limit window
+	usage []*withdrawalUsage
+	// usedCodes holds accepted confirmation codes until they expire so they
+	// cannot be replayed
+	usedCodes map[string]time.Time
+	pending   map[uuid.UUID]*PendingWithdrawal
+}
+
+// withdrawalUsage is an amount counted against a currency's withdrawal limits
+type withdrawalUsage struct {
+	currency currency.Code
+	amount   float64
+	time     time.Time
+}
+
+// PendingWithdrawal is a withdrawal request awaiting approval from a second
+// principal before it is submitted to an exchange
+type PendingWithdrawal struct {
+	ID          uuid.UUID
+	Request     withdraw.Request
+	RequestedBy string
+	CreatedAt   time.Time
+	Expires     time.Time
+
+	// requester is the user accountable for the request, which is the user
+	// who created the token when it was requested with a token
+	requester string
+	usage     *withdrawalUsage
+}
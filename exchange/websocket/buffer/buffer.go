@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 const packageError = "websocket orderbook buffer error: %w"
@@ -45,6 +46,8 @@ func (o *Orderbook) Setup(exchangeConfig *config.Exchange, c *Config, dataHandle
 
 	o.sortBuffer = c.SortBuffer
 	o.sortBufferByUpdateIDs = c.SortBufferByUpdateIDs
+	o.checksum = c.Checksum
+	o.resync = c.Resync
 	o.exchangeName = exchangeConfig.Name
 	o.dataHandler = dataHandler
 	o.ob = make(map[key.PairAsset]*orderbookHolder)
@@ -95,14 +98,25 @@ func (o *Orderbook) Update(u *orderbook.Update) error {
 		return fmt.Errorf("%w for Exchange %s CurrencyPair: %s AssetType: %s", orderbook.ErrDepthNotFound, o.exchangeName, u.Pair, u.Asset)
 	}
 
+	if u.ExpectedChecksum != 0 && u.GenerateChecksum == nil {
+		u.GenerateChecksum = o.checksum
+	}
+
+	wasValid := holder.ob.IsValid()
+	var err error
 	if o.bufferEnabled {
-		if processed, err := o.processBufferUpdate(holder, u); err != nil || !processed {
-			return err
+		var processed bool
+		if processed, err = o.processBufferUpdate(holder, u); err == nil && !processed {
+			return nil
 		}
 	} else {
-		if err := holder.ob.ProcessUpdate(u); err != nil {
-			return err
+		err = holder.ob.ProcessUpdate(u)
+	}
+	if err != nil {
+		if wasValid {
+			o.resyncOrderbook(holder, u.Pair, u.Asset, err)
 		}
+		return err
 	}
 
 	// Publish all state changes, disregarding verbosity or sync requirements.
@@ -111,6 +125,46 @@ func (o *Orderbook) Update(u *orderbook.Update) error {
 	return nil
 }
 
+// ValidateChecksum verifies a stored orderbook against a checksum which is sent
+// separately from the updates it covers, using the checksum function set in
+// the buffer config. On mismatch the orderbook is invalidated and resynced
+func (o *Orderbook) ValidateChecksum(p currency.Pair, a asset.Item, expected uint32) error {
+	o.m.RLock()
+	holder, ok := o.ob[key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}]
+	o.m.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w for Exchange %s CurrencyPair: %s AssetType: %s", orderbook.ErrDepthNotFound, o.exchangeName, p, a)
+	}
+	wasValid := holder.ob.IsValid()
+	if err := holder.ob.ValidateChecksum(expected, o.checksum); err != nil {
+		if wasValid {
+			o.resyncOrderbook(holder, p, a, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// resyncOrderbook calls the resync function set in the buffer config when an
+// orderbook has been invalidated by a checksum mismatch. Only one resync runs
+// per orderbook at a time
+func (o *Orderbook) resyncOrderbook(holder *orderbookHolder, p currency.Pair, a asset.Item, err error) {
+	if o.resync == nil || !errors.Is(err, orderbook.ErrChecksumMismatch) || !holder.resyncing.CompareAndSwap(false, true) {
+		return
+	}
+	if o.verbose {
+		log.Debugf(log.WebsocketMgr, "%s resyncing %s %s orderbook after checksum mismatch", o.exchangeName, p, a)
+	}
+	go func() {
+		defer holder.resyncing.Store(false)
+		err := o.resync(p, a)
+		orderbook.RecordResync(o.exchangeName, err)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%s %s %s orderbook resync failed: %v", o.exchangeName, p, a, err)
+		}
+	}()
+}
+
 // processBufferUpdate stores update into buffer, when buffer at capacity as
 // defined by o.obBufferLimit it well then sort and apply updates.
 func (o *Orderbook) processBufferUpdate(holder *orderbookHolder, u *orderbook.Update) (bool, error) {
@@ -3,6 +3,7 @@ package buffer
 import (
 	"math/rand"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -725,6 +726,13 @@ func TestSetup(t *testing.T) {
 	require.True(t, w.sortBuffer)
 	require.True(t, w.sortBufferByUpdateIDs)
 	require.Equal(t, "test", w.exchangeName)
+
+	bufferConf.Checksum = func(*orderbook.Book) uint32 { return 1337 }
+	bufferConf.Resync = func(currency.Pair, asset.Item) error { return nil }
+	err = w.Setup(exchangeConfig, bufferConf, make(chan any))
+	require.NoError(t, err)
+	require.NotNil(t, w.checksum)
+	require.NotNil(t, w.resync)
 }
 
 func TestInvalidateOrderbook(t *testing.T) {
@@ -760,3 +768,75 @@ func TestInvalidateOrderbook(t *testing.T) {
 	_, err = w.GetOrderbook(cp, asset.Spot)
 	require.ErrorIs(t, err, orderbook.ErrOrderbookInvalid)
 }
+
+func TestChecksumResync(t *testing.T) {
+	t.Parallel()
+	cp, err := getExclusivePair()
+	require.NoError(t, err)
+
+	const exch = "checksumResync"
+	var checksum atomic.Uint32
+	checksum.Store(1337)
+	resynced := make(chan struct{}, 2)
+	w := &Orderbook{}
+	err = w.Setup(&config.Exchange{Name: exch}, &Config{
+		Checksum: func(*orderbook.Book) uint32 { return checksum.Load() },
+		Resync: func(p currency.Pair, a asset.Item) error {
+			assert.Equal(t, cp, p, "Resync should be called with the correct pair")
+			assert.Equal(t, asset.Spot, a, "Resync should be called with the correct asset")
+			resynced <- struct{}{}
+			return nil
+		},
+	}, make(chan any, 10))
+	require.NoError(t, err)
+
+	snapshot := func() *orderbook.Book {
+		return &orderbook.Book{
+			Exchange:          exch,
+			Asks:              orderbook.Levels{{Price: 4001, Amount: 1}},
+			Bids:              orderbook.Levels{{Price: 4000, Amount: 1}},
+			Asset:             asset.Spot,
+			Pair:              cp,
+			LastUpdated:       time.Now(),
+			ValidateOrderbook: true,
+		}
+	}
+	update := func() *orderbook.Update {
+		return &orderbook.Update{
+			Asks:             orderbook.Levels{{Price: 4002, Amount: 1}},
+			Pair:             cp,
+			Asset:            asset.Spot,
+			UpdateTime:       time.Now(),
+			ExpectedChecksum: 1337,
+		}
+	}
+	require.NoError(t, w.LoadSnapshot(snapshot()))
+	require.NoError(t, w.Update(update()), "Update must use the registered checksum function")
+
+	checksum.Store(1336)
+	err = w.Update(update())
+	require.ErrorIs(t, err, orderbook.ErrChecksumMismatch)
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		require.Fail(t, "mismatch must trigger a resync")
+	}
+	err = w.Update(update())
+	require.ErrorIs(t, err, orderbook.ErrOrderbookInvalid)
+	assert.Eventually(t, func() bool { return orderbook.GetDesyncStats(exch).Resyncs == 1 }, time.Second, time.Millisecond)
+
+	require.NoError(t, w.LoadSnapshot(snapshot()))
+	err = w.ValidateChecksum(cp, asset.Spot, 1337)
+	require.ErrorIs(t, err, orderbook.ErrChecksumMismatch)
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		require.Fail(t, "ValidateChecksum mismatch must trigger a resync")
+	}
+	assert.Empty(t, resynced, "an invalid orderbook must not trigger further resyncs")
+	assert.Eventually(t, func() bool { return orderbook.GetDesyncStats(exch).Resyncs == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, int64(2), orderbook.GetDesyncStats(exch).ChecksumMismatches)
+
+	err = w.ValidateChecksum(currency.NewBTCUSD(), asset.Futures, 1337)
+	assert.ErrorIs(t, err, orderbook.ErrDepthNotFound)
+}
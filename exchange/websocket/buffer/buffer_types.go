@@ -2,8 +2,11 @@ package buffer
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
@@ -15,6 +18,14 @@ type Config struct {
 	// SortBufferByUpdateIDs allows the sorting of the buffered updates by their
 	// corresponding update IDs.
 	SortBufferByUpdateIDs bool
+	// Checksum generates the checksum of a stored orderbook for updates which
+	// set an ExpectedChecksum without their own GenerateChecksum function, and
+	// for checksums sent separately to ValidateChecksum.
+	Checksum orderbook.ChecksumFunc
+	// Resync is called in a separate routine when an orderbook is invalidated
+	// by a checksum mismatch and should restore it, e.g. by resubscribing to
+	// the orderbook channel or loading a REST snapshot.
+	Resync func(currency.Pair, asset.Item) error
 }
 
 // Orderbook defines a local cache of orderbooks for amending, appending
@@ -25,6 +36,8 @@ type Orderbook struct {
 	bufferEnabled         bool
 	sortBuffer            bool
 	sortBufferByUpdateIDs bool // When timestamps aren't provided, an id can help sort
+	checksum              orderbook.ChecksumFunc
+	resync                func(currency.Pair, asset.Item) error
 	exchangeName          string
 	dataHandler           chan<- any
 	verbose               bool
@@ -35,6 +48,7 @@ type Orderbook struct {
 // orderbookHolder defines a store of pending updates and a pointer to the
 // orderbook depth
 type orderbookHolder struct {
-	ob     *orderbook.Depth
-	buffer []orderbook.Update
+	ob        *orderbook.Depth
+	buffer    []orderbook.Update
+	resyncing atomic.Bool
 }
@@ -20,6 +20,7 @@ import (
 
 	gws "github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -129,6 +130,7 @@ type connection struct {
 	Traffic              chan struct{}
 	readMessageErrors    chan error
 	requestIDGenerator   func() int64
+	recorder             *atomic.Pointer[mock.WebsocketRecorder] // recorder is shared with the manager and is set while recording
 }
 
 // Dial sets proxy urls and then connects to the websocket
@@ -172,7 +174,15 @@ func (c *connection) SendJSONMessage(ctx context.Context, epl request.EndpointLi
 				log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
 			}
 		}
-		return c.Connection.WriteJSON(data)
+		if err := c.Connection.WriteJSON(data); err != nil {
+			return err
+		}
+		if c.isRecording() {
+			if msg, err := json.Marshal(data); err == nil {
+				c.record(mock.WebsocketOutbound, msg)
+			}
+		}
+		return nil
 	})
 }
 
@@ -182,7 +192,11 @@ func (c *connection) SendRawMessage(ctx context.Context, epl request.EndpointLim
 		if request.IsVerbose(ctx, c.Verbose) {
 			log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(message))
 		}
-		return c.Connection.WriteMessage(messageType, message)
+		if err := c.Connection.WriteMessage(messageType, message); err != nil {
+			return err
+		}
+		c.record(mock.WebsocketOutbound, message)
+		return nil
 	})
 }
 
@@ -299,6 +313,10 @@ func (c *connection) ReadMessage() Response {
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if er, ok := c.reporter().(EventReporter); ok {
+		er.MessageReceived(c.ExchangeName)
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case gws.TextMessage:
@@ -313,6 +331,7 @@ func (c *connection) ReadMessage() Response {
 	if c.Verbose {
 		log.Debugf(log.WebsocketMgr, "%v %v: Message received: %v", c.ExchangeName, removeURLQueryString(c.URL), string(standardMessage))
 	}
+	c.record(mock.WebsocketInbound, standardMessage)
 	return Response{Raw: standardMessage, Type: mType}
 }
 
@@ -427,13 +446,22 @@ func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context
 		return nil, err
 	}
 
-	if c.Reporter != nil {
-		c.Reporter.Latency(c.ExchangeName, outbound, time.Since(start))
+	if r := c.reporter(); r != nil {
+		r.Latency(c.ExchangeName, outbound, time.Since(start))
 	}
 
 	return resps, err
 }
 
+// reporter returns the connection's reporter, or the global reporter if it
+// does not have one
+func (c *connection) reporter() Reporter {
+	if c.Reporter != nil {
+		return c.Reporter
+	}
+	return getGlobalReporter()
+}
+
 // waitForResponses waits for N responses from a channel
 func (c *connection) waitForResponses(ctx context.Context, signature any, ch <-chan []byte, expected int, messageInspector Inspector) ([][]byte, error) {
 	timeout := time.NewTimer(c.ResponseMaxLimit * time.Duration(expected))
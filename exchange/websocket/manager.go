@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket/buffer"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
@@ -60,6 +61,7 @@ var (
 	errExchangeConfigEmpty                  = errors.New("exchange config is empty")
 	errCannotObtainOutboundConnection       = errors.New("cannot obtain outbound connection")
 	errMessageFilterNotComparable           = errors.New("message filter is not comparable")
+	errMultiConnectionManagementUnset       = errors.New("multi connection management is not enabled")
 )
 
 // Websocket functionality list and state consts
@@ -92,7 +94,7 @@ type Manager struct {
 	exchangeName                  string
 	features                      *protocol.Features
 	m                             sync.Mutex
-	connections                   map[Connection]*connectionWrapper
+	connections                   map[Connection]*connectionShard
 	subscriptions                 *subscription.Store
 	connector                     func() error
 	rateLimitDefinitions          request.RateLimitDefinitions // rate limiters shared between Websocket and REST connections
@@ -115,8 +117,16 @@ type Manager struct {
 	ExchangeLevelReporter         Reporter   // Latency reporter
 	MaxSubscriptionsPerConnection int
 
+	// recorder captures connection frames to a recording file when set
+	recorder atomic.Pointer[mock.WebsocketRecorder]
+
+	// hasConnected records whether the websocket has connected before so that
+	// subsequent connections can be reported as reconnections
+	hasConnected bool
+
 	// connectionManager stores all *potential* connections for the exchange, organised within connectionWrapper structs.
-	// Each connectionWrapper one connection (will be expanded soon) tailored for specific exchange functionalities or asset types. // TODO: Expand this to support multiple connections per connectionWrapper
+	// Each connectionWrapper holds one or more connection shards tailored for specific exchange functionalities or asset
+	// types, opening additional shards when its subscriptions exceed MaxSubscriptionsPerConnection.
 	// For example, separate connections can be used for Spot, Margin, and Futures trading. This structure is especially useful
 	// for exchanges that differentiate between trading pairs by using different connection endpoints or protocols for various asset classes.
 	// If an exchange does not require such differentiation, all connections may be managed under a single connectionWrapper.
@@ -156,20 +166,35 @@ type ManagerSetup struct {
 }
 
 // connectionWrapper contains the connection setup details to be used when
-// attempting a new connection. It also contains the subscriptions that are
-// associated with the specific connection.
+// attempting a new connection. It also contains the connection shards and the
+// subscriptions that are associated with each of them.
 type connectionWrapper struct {
-	setup         *ConnectionSetup
-	subscriptions *subscription.Store
-	connection    Connection
+	setup  *ConnectionSetup
+	shards []*connectionShard
+	// pending holds the inactive subscriptions of connections which dropped and could not be re-established. They are
+	// given a new connection by the next reconnect or subscription update
+	pending subscription.List
 }
 
-var globalReporter Reporter
+var globalReporter atomic.Pointer[Reporter]
 
-// SetupGlobalReporter sets a reporter interface to be used
-// for all exchange requests
+// SetupGlobalReporter sets a reporter interface to be used for all exchange
+// connections without their own reporter. It is resolved as each event is
+// reported, so it applies to connections which have already been set up
 func SetupGlobalReporter(r Reporter) {
-	globalReporter = r
+	if r == nil {
+		globalReporter.Store(nil)
+		return
+	}
+	globalReporter.Store(&r)
+}
+
+// getGlobalReporter returns the reporter set by SetupGlobalReporter
+func getGlobalReporter() Reporter {
+	if r := globalReporter.Load(); r != nil {
+		return *r
+	}
+	return nil
 }
 
 // NewManager initialises the websocket struct
@@ -188,7 +213,7 @@ func NewManager() *Manager {
 		subscriptions:     subscription.NewStore(),
 		features:          &protocol.Features{},
 		Orderbook:         buffer.Orderbook{},
-		connections:       make(map[Connection]*connectionWrapper),
+		connections:       make(map[Connection]*connectionShard),
 	}
 }
 
@@ -321,9 +346,6 @@ func (m *Manager) SetupNewConnection(c *ConnectionSetup) error {
 	if c.ConnectionLevelReporter == nil {
 		c.ConnectionLevelReporter = m.ExchangeLevelReporter
 	}
-	if c.ConnectionLevelReporter == nil {
-		c.ConnectionLevelReporter = globalReporter
-	}
 
 	if m.useMultiConnectionManagement {
 		// The connection and supporting functions are defined per connection
@@ -359,10 +381,7 @@ func (m *Manager) SetupNewConnection(c *ConnectionSetup) error {
 				return fmt.Errorf("%w: %w", errConnSetup, errDuplicateConnectionSetup)
 			}
 		}
-		m.connectionManager = append(m.connectionManager, &connectionWrapper{
-			setup:         c,
-			subscriptions: subscription.NewStore(),
-		})
+		m.connectionManager = append(m.connectionManager, &connectionWrapper{setup: c})
 		return nil
 	}
 
@@ -403,6 +422,7 @@ func (m *Manager) getConnectionFromSetup(c *ConnectionSetup) *connection {
 		Reporter:             c.ConnectionLevelReporter,
 		requestIDGenerator:   c.RequestIDGenerator,
 		RateLimitDefinitions: m.rateLimitDefinitions,
+		recorder:             &m.recorder,
 	}
 }
 
@@ -446,6 +466,7 @@ func (m *Manager) connect() error {
 			return fmt.Errorf("%v Error connecting %w", m.exchangeName, err)
 		}
 		m.setState(connectedState)
+		m.reportConnected()
 
 		if m.connectionMonitorRunning.CompareAndSwap(false, true) {
 			// This oversees all connections and does not need to be part of wait group management.
@@ -514,65 +535,51 @@ func (m *Manager) connect() error {
 			break
 		}
 
-		// TODO: Add window for max subscriptions per connection, to spawn new connections if needed.
-
-		conn := m.getConnectionFromSetup(m.connectionManager[i].setup)
-
-		err = m.connectionManager[i].setup.Connector(context.TODO(), conn)
-		if err != nil {
-			multiConnectFatalError = fmt.Errorf("%v Error connecting %w", m.exchangeName, err)
-			break
-		}
-
-		if !conn.IsConnected() {
-			multiConnectFatalError = fmt.Errorf("%s websocket: [conn:%d] [URL:%s] failed to connect", m.exchangeName, i+1, conn.URL)
-			break
-		}
-
-		m.connections[conn] = m.connectionManager[i]
-		m.connectionManager[i].connection = conn
-
-		m.Wg.Add(1)
-		go m.Reader(context.TODO(), conn, m.connectionManager[i].setup.Handler)
-
-		if m.connectionManager[i].setup.Authenticate != nil && m.CanUseAuthenticatedEndpoints() {
-			err = m.connectionManager[i].setup.Authenticate(context.TODO(), conn)
+		batches := partitionSubscriptions(subs, m.shardCount(len(subs)))
+		for j, batch := range batches {
+			shard, err := m.dialShard(m.connectionManager[i].setup)
 			if err != nil {
-				multiConnectFatalError = fmt.Errorf("%s websocket: [conn:%d] [URL:%s] failed to authenticate %w", m.exchangeName, i+1, conn.URL, err)
+				multiConnectFatalError = fmt.Errorf("%s websocket: [conn:%d] [shard:%d] [URL:%s] %w", m.exchangeName, i+1, j, m.connectionManager[i].setup.URL, err)
 				break
 			}
-		}
+			m.connectionManager[i].shards = append(m.connectionManager[i].shards, shard)
 
-		err = m.connectionManager[i].setup.Subscriber(context.TODO(), conn, subs)
-		if err != nil {
-			subscriptionError = common.AppendError(subscriptionError, fmt.Errorf("%v Error subscribing %w", m.exchangeName, err))
-			continue
-		}
+			err = m.connectionManager[i].setup.Subscriber(context.TODO(), shard.connection, batch)
+			if err != nil {
+				subscriptionError = common.AppendError(subscriptionError, fmt.Errorf("%v Error subscribing %w", m.exchangeName, err))
+				continue
+			}
 
-		if missing := m.connectionManager[i].subscriptions.Missing(subs); len(missing) > 0 {
-			subscriptionError = common.AppendError(subscriptionError, fmt.Errorf("%v %w %q", m.exchangeName, ErrSubscriptionsNotAdded, missing))
-			continue
-		}
+			if missing := shard.subscriptions.Missing(batch); len(missing) > 0 {
+				subscriptionError = common.AppendError(subscriptionError, fmt.Errorf("%v %w %q", m.exchangeName, ErrSubscriptionsNotAdded, missing))
+				continue
+			}
 
-		if m.verbose {
-			log.Debugf(log.WebsocketMgr, "%s websocket: [conn:%d] [URL:%s] connected. [Subscribed: %d]",
-				m.exchangeName,
-				i+1,
-				conn.URL,
-				len(subs))
+			if m.verbose {
+				log.Debugf(log.WebsocketMgr, "%s websocket: [conn:%d] [shard:%d] [URL:%s] connected. [Subscribed: %d]",
+					m.exchangeName,
+					i+1,
+					j,
+					shard.connection.GetURL(),
+					len(batch))
+			}
+		}
+		if multiConnectFatalError != nil {
+			break
 		}
 	}
 
 	if multiConnectFatalError != nil {
 		// Roll back any successful connections and flush subscriptions
 		for x := range m.connectionManager {
-			if m.connectionManager[x].connection != nil {
-				if err := m.connectionManager[x].connection.Shutdown(); err != nil {
+			for _, shard := range m.connectionManager[x].shards {
+				if err := shard.connection.Shutdown(); err != nil {
 					log.Errorln(log.WebsocketMgr, err)
 				}
-				m.connectionManager[x].connection = nil
+				shard.subscriptions.Clear()
 			}
-			m.connectionManager[x].subscriptions.Clear()
+			m.connectionManager[x].shards = nil
+			m.connectionManager[x].pending = nil
 		}
 		clear(m.connections)
 		m.setState(disconnectedState) // Flip from connecting to disconnected.
@@ -589,6 +596,7 @@ func (m *Manager) connect() error {
 	// All subscriptions have been sent and stored. All data received is being
 	// handled by the appropriate data handler.
 	m.setState(connectedState)
+	m.reportConnected()
 
 	if m.connectionMonitorRunning.CompareAndSwap(false, true) {
 		// This oversees all connections and does not need to be part of wait group management.
@@ -652,14 +660,15 @@ func (m *Manager) shutdown() error {
 
 	// Shutdown managed connections
 	for x := range m.connectionManager {
-		if m.connectionManager[x].connection != nil {
-			if err := m.connectionManager[x].connection.Shutdown(); err != nil {
+		for _, shard := range m.connectionManager[x].shards {
+			if err := shard.connection.Shutdown(); err != nil {
 				nonFatalCloseConnectionErrors = common.AppendError(nonFatalCloseConnectionErrors, err)
 			}
-			m.connectionManager[x].connection = nil
 			// Flush any subscriptions from last connection across any managed connections
-			m.connectionManager[x].subscriptions.Clear()
+			shard.subscriptions.Clear()
 		}
+		m.connectionManager[x].shards = nil
+		m.connectionManager[x].pending = nil
 	}
 	// Clean map of old connections
 	clear(m.connections)
@@ -726,6 +735,11 @@ func (m *Manager) IsEnabled() bool {
 	return m.enabled.Load()
 }
 
+// IsMultiConnectionManaged returns whether connections are managed by the connection manager
+func (m *Manager) IsMultiConnectionManaged() bool {
+	return m.useMultiConnectionManagement
+}
+
 // CanUseAuthenticatedWebsocketForWrapper Handles a common check to
 // verify whether a wrapper can use an authenticated websocket endpoint
 func (m *Manager) CanUseAuthenticatedWebsocketForWrapper() bool {
@@ -789,6 +803,26 @@ func (m *Manager) SetWebsocketURL(url string, auth, reconnect bool) error {
 	return nil
 }
 
+// SetConnectionURLs points every connection setup at the URL when using multi connection management
+// The websocket must not be connected, since connections take their URL from their setup when dialled
+func (m *Manager) SetConnectionURLs(url string) error {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if !m.useMultiConnectionManagement {
+		return fmt.Errorf("%s: %w", m.exchangeName, errMultiConnectionManagementUnset)
+	}
+	if m.IsConnected() {
+		return fmt.Errorf("%s: %w", m.exchangeName, errAlreadyConnected)
+	}
+	if err := checkWebsocketURL(url); err != nil {
+		return err
+	}
+	for _, w := range m.connectionManager {
+		w.setup.URL = url
+	}
+	return nil
+}
+
 // GetWebsocketURL returns the running websocket URL
 func (m *Manager) GetWebsocketURL() string {
 	return m.runningURL
@@ -813,8 +847,8 @@ func (m *Manager) SetProxyAddress(proxyAddr string) error {
 	}
 
 	for _, wrapper := range m.connectionManager {
-		if wrapper.connection != nil {
-			wrapper.connection.SetProxy(proxyAddr)
+		for _, shard := range wrapper.shards {
+			shard.connection.SetProxy(proxyAddr)
 		}
 	}
 	if m.Conn != nil {
@@ -950,7 +984,11 @@ func (m *Manager) observeConnection(t *time.Timer) (exit bool) {
 	case err := <-m.ReadMessageErrors:
 		if errors.Is(err, errConnectionFault) {
 			log.Warnf(log.WebsocketMgr, "%v websocket has been disconnected. Reason: %v", m.exchangeName, err)
-			if m.IsConnected() {
+			// Managed connections are reconnected individually, falling back to a full connection cycle on failure
+			if shardErr := m.reconnectShards(); shardErr != nil && m.IsConnected() {
+				if !errors.Is(shardErr, errShardingNotSupported) {
+					log.Errorf(log.WebsocketMgr, "%v websocket: connectionMonitor shard reconnect err: %s", m.exchangeName, shardErr)
+				}
 				if shutdownErr := m.Shutdown(); shutdownErr != nil {
 					log.Errorf(log.WebsocketMgr, "%v websocket: connectionMonitor shutdown err: %s", m.exchangeName, shutdownErr)
 				}
@@ -1015,6 +1053,9 @@ func (m *Manager) observeTraffic(t *time.Timer) bool {
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr, "%v websocket: has not received a traffic alert in %v. Reconnecting", m.exchangeName, m.trafficTimeout)
 		}
+		if er := m.eventReporter(); er != nil {
+			er.TrafficTimeout(m.exchangeName)
+		}
 		if m.IsConnected() {
 			go func() { // Without this the m.Shutdown() call below will deadlock
 				if err := m.Shutdown(); err != nil {
@@ -1027,6 +1068,29 @@ func (m *Manager) observeTraffic(t *time.Timer) bool {
 	return true
 }
 
+// eventReporter returns the exchange level or global reporter if it observes
+// websocket events
+func (m *Manager) eventReporter() EventReporter {
+	r := m.ExchangeLevelReporter
+	if r == nil {
+		r = getGlobalReporter()
+	}
+	er, _ := r.(EventReporter)
+	return er
+}
+
+// reportConnected reports a reconnection if the websocket has connected before
+// NOTE: This requires locking.
+func (m *Manager) reportConnected() {
+	if !m.hasConnected {
+		m.hasConnected = true
+		return
+	}
+	if er := m.eventReporter(); er != nil {
+		er.Reconnected(m.exchangeName)
+	}
+}
+
 // signalReceived checks if a signal has been received, this also clears the signal.
 func signalReceived(ch chan struct{}) bool {
 	select {
@@ -1060,10 +1124,11 @@ func (m *Manager) GetConnection(messageFilter any) (Connection, error) {
 
 	for _, wrapper := range m.connectionManager {
 		if wrapper.setup.MessageFilter == messageFilter {
-			if wrapper.connection == nil {
+			if len(wrapper.shards) == 0 {
 				return nil, fmt.Errorf("%s: %s %w associated with message filter: '%v'", m.exchangeName, wrapper.setup.URL, ErrNotConnected, messageFilter)
 			}
-			return wrapper.connection, nil
+			// Outbound requests are sent on the first shard, additional shards only carry subscriptions
+			return wrapper.shards[0].connection, nil
 		}
 	}
 
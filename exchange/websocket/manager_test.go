@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -301,14 +302,14 @@ func TestConnectionMessageErrors(t *testing.T) {
 	require.ErrorIs(t, err, ErrSubscriptionsNotAdded)
 	require.NoError(t, ws.shutdown())
 
-	ws.connectionManager[0].subscriptions = subscription.NewStore()
-	ws.connectionManager[0].setup.Subscriber = func(context.Context, Connection, subscription.List) error {
-		return ws.connectionManager[0].subscriptions.Add(&subscription.Subscription{Channel: "test"})
+	ws.connectionManager[0].setup.Subscriber = func(_ context.Context, conn Connection, _ subscription.List) error {
+		return ws.AddSuccessfulSubscriptions(conn, &subscription.Subscription{Channel: "test"})
 	}
 	err = ws.Connect()
 	require.NoError(t, err)
 
-	err = ws.connectionManager[0].connection.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("test"))
+	require.Len(t, ws.connectionManager[0].shards, 1, "must have one connection shard")
+	err = ws.connectionManager[0].shards[0].connection.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("test"))
 	require.NoError(t, err)
 
 	require.NoError(t, err)
@@ -413,7 +414,7 @@ func TestManager(t *testing.T) {
 
 	ws.useMultiConnectionManagement = true
 
-	ws.connectionManager = []*connectionWrapper{{setup: &ConnectionSetup{URL: "ws://demos.kaazing.com/echo"}, connection: &connection{}}}
+	ws.connectionManager = []*connectionWrapper{{setup: &ConnectionSetup{URL: "ws://demos.kaazing.com/echo"}, shards: []*connectionShard{{connection: &connection{}, subscriptions: subscription.NewStore()}}}}
 	err = ws.SetProxyAddress("https://192.168.0.1:1337")
 	require.NoError(t, err)
 }
@@ -789,6 +790,41 @@ func BenchmarkGenerateMessageID_Low(b *testing.B) {
 	}
 }
 
+func TestSetConnectionURLs(t *testing.T) {
+	t.Parallel()
+	single := NewManager()
+	require.NoError(t, single.Setup(newDefaultSetup()), "Setup must not error")
+	assert.False(t, single.IsMultiConnectionManaged(), "IsMultiConnectionManaged should return false")
+	assert.ErrorIs(t, single.SetConnectionURLs("ws://localhost"), errMultiConnectionManagementUnset, "SetConnectionURLs should error without multi connection management")
+
+	multi := NewManager()
+	set := newDefaultSetup()
+	set.UseMultiConnectionManagement = true
+	require.NoError(t, multi.Setup(set), "Setup must not error")
+	assert.True(t, multi.IsMultiConnectionManaged(), "IsMultiConnectionManaged should return true")
+	for _, auth := range []bool{false, true} {
+		err := multi.SetupNewConnection(&ConnectionSetup{
+			URL:                   "ws://" + strconv.FormatBool(auth),
+			Authenticated:         auth,
+			Connector:             func(context.Context, Connection) error { return nil },
+			GenerateSubscriptions: func() (subscription.List, error) { return nil, nil },
+			Subscriber:            func(context.Context, Connection, subscription.List) error { return nil },
+			Unsubscriber:          func(context.Context, Connection, subscription.List) error { return nil },
+			Handler:               func(context.Context, Connection, []byte) error { return nil },
+		})
+		require.NoErrorf(t, err, "SetupNewConnection must not error for auth: %v", auth)
+	}
+
+	assert.ErrorIs(t, multi.SetConnectionURLs("http://localhost"), errInvalidWebsocketURL, "SetConnectionURLs should error on an invalid URL")
+	require.NoError(t, multi.SetConnectionURLs("ws://localhost"), "SetConnectionURLs must not error")
+	for _, w := range multi.connectionManager {
+		assert.Equal(t, "ws://localhost", w.setup.URL, "connection setup URL should be updated")
+	}
+
+	multi.setState(connectedState)
+	assert.ErrorIs(t, multi.SetConnectionURLs("ws://localhost"), errAlreadyConnected, "SetConnectionURLs should error when connected")
+}
+
 func TestCheckWebsocketURL(t *testing.T) {
 	err := checkWebsocketURL("")
 	assert.ErrorIs(t, err, errInvalidWebsocketURL, "checkWebsocketURL should error correctly on empty string")
@@ -1144,6 +1180,69 @@ func TestLatency(t *testing.T) {
 	require.Equal(t, exch, r.name, "Latency must have the correct exchange name")
 }
 
+type eventReporter struct {
+	reporter
+	messages, reconnects, trafficTimeouts atomic.Int32
+}
+
+func (r *eventReporter) MessageReceived(string) { r.messages.Add(1) }
+func (r *eventReporter) Reconnected(string)     { r.reconnects.Add(1) }
+func (r *eventReporter) TrafficTimeout(string)  { r.trafficTimeouts.Add(1) }
+
+func TestEventReporter(t *testing.T) {
+	t.Parallel()
+	r := &eventReporter{}
+	ws := Manager{ShutdownC: make(chan struct{}), TrafficAlert: make(chan struct{}, 1), ExchangeLevelReporter: r}
+	ws.reportConnected()
+	assert.Zero(t, r.reconnects.Load(), "reportConnected should not report the first connection")
+	ws.reportConnected()
+	assert.Equal(t, int32(1), r.reconnects.Load(), "reportConnected should report a reconnection")
+
+	require.True(t, ws.observeTraffic(time.NewTimer(0)), "observeTraffic must exit without traffic")
+	assert.Equal(t, int32(1), r.trafficTimeouts.Load(), "observeTraffic should report the traffic timeout")
+
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	defer mock.Close()
+	wc := &connection{
+		URL:      "ws" + mock.URL[len("http"):] + "/ws",
+		Traffic:  make(chan struct{}, 1),
+		Reporter: r,
+	}
+	require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}), "Dial must not error")
+	require.NoError(t, wc.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte(`{}`)), "SendRawMessage must not error")
+	require.NotNil(t, wc.ReadMessage().Raw, "ReadMessage must return the echoed message")
+	assert.Equal(t, int32(1), r.messages.Load(), "ReadMessage should report the received message")
+}
+
+func TestGlobalReporter(t *testing.T) {
+	r := &eventReporter{}
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	defer mock.Close()
+	wc := &connection{
+		URL:     "ws" + mock.URL[len("http"):] + "/ws",
+		Traffic: make(chan struct{}, 1),
+	}
+	require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}), "Dial must not error")
+	read := func() {
+		t.Helper()
+		require.NoError(t, wc.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte(`{}`)), "SendRawMessage must not error")
+		require.NotNil(t, wc.ReadMessage().Raw, "ReadMessage must return the echoed message")
+	}
+
+	SetupGlobalReporter(r)
+	t.Cleanup(func() { SetupGlobalReporter(nil) })
+	read()
+	assert.Equal(t, int32(1), r.messages.Load(), "ReadMessage should report to the global reporter set after the connection was created")
+	ws := Manager{}
+	ws.reportConnected()
+	ws.reportConnected()
+	assert.Equal(t, int32(1), r.reconnects.Load(), "reportConnected should report to the global reporter")
+
+	SetupGlobalReporter(nil)
+	read()
+	assert.Equal(t, int32(1), r.messages.Load(), "ReadMessage should not report once the global reporter is removed")
+}
+
 func TestRemoveURLQueryString(t *testing.T) {
 	t.Parallel()
 	assert.Equal(t, "https://www.google.com", removeURLQueryString("https://www.google.com?test=1"), "removeURLQueryString should remove query string")
@@ -1330,7 +1429,7 @@ func TestGetConnection(t *testing.T) {
 	require.ErrorIs(t, err, ErrNotConnected)
 
 	expected := &connection{}
-	ws.connectionManager[0].connection = expected
+	ws.connectionManager[0].shards = []*connectionShard{{connection: expected}}
 
 	conn, err := ws.GetConnection("testURL")
 	require.NoError(t, err)
@@ -0,0 +1,63 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errAlreadyRecording = errors.New("websocket is already recording")
+	errNotRecording     = errors.New("websocket is not recording")
+)
+
+// StartRecording captures the inbound and outbound frames of all connections, including those established later, into
+// a recording file at path. Recordings can be served back with mock.NewWebsocketReplayHandler for offline testing
+func (m *Manager) StartRecording(path string) error {
+	r, err := mock.NewWebsocketRecorder(path)
+	if err != nil {
+		return fmt.Errorf("%s websocket: %w", m.exchangeName, err)
+	}
+	if !m.recorder.CompareAndSwap(nil, r) {
+		if err := r.Close(); err != nil {
+			log.Errorf(log.WebsocketMgr, "%s websocket: %v", m.exchangeName, err)
+		}
+		return fmt.Errorf("%s %w", m.exchangeName, errAlreadyRecording)
+	}
+	return nil
+}
+
+// StopRecording stops capturing frames and closes the recording file
+func (m *Manager) StopRecording() error {
+	r := m.recorder.Swap(nil)
+	if r == nil {
+		return fmt.Errorf("%s %w", m.exchangeName, errNotRecording)
+	}
+	return r.Close()
+}
+
+// IsRecording returns whether frames are being captured to a recording file
+func (m *Manager) IsRecording() bool {
+	return m.recorder.Load() != nil
+}
+
+// isRecording returns whether the connection's frames are being recorded
+func (c *connection) isRecording() bool {
+	return c.recorder != nil && c.recorder.Load() != nil
+}
+
+// record writes a frame to the manager recording if one is in progress
+func (c *connection) record(direction string, payload []byte) {
+	if c.recorder == nil {
+		return
+	}
+	r := c.recorder.Load()
+	if r == nil {
+		return
+	}
+	if err := r.Record(direction, c.URL, payload); err != nil {
+		log.Errorf(log.WebsocketMgr, "%v %v: websocket recording error: %v", c.ExchangeName, removeURLQueryString(c.URL), err)
+	}
+}
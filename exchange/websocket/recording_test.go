@@ -0,0 +1,54 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
+)
+
+func TestRecording(t *testing.T) {
+	t.Parallel()
+	m := NewManager()
+	m.exchangeName = "GTX"
+	assert.False(t, m.IsRecording(), "IsRecording should be false")
+	require.ErrorIs(t, m.StopRecording(), errNotRecording)
+	require.Error(t, m.StartRecording(""), "StartRecording must error with an empty path")
+
+	path := filepath.Join(t.TempDir(), "ws.json")
+	require.NoError(t, m.StartRecording(path), "StartRecording must not error")
+	require.ErrorIs(t, m.StartRecording(path), errAlreadyRecording)
+	assert.True(t, m.IsRecording(), "IsRecording should be true")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	defer server.Close()
+	conn := m.getConnectionFromSetup(&ConnectionSetup{URL: "ws" + server.URL[len("http"):] + "/ws"})
+	require.NoError(t, conn.Dial(t.Context(), gws.DefaultDialer, nil), "Dial must not error")
+	defer func() { assert.NoError(t, conn.Shutdown(), "Shutdown should not error") }()
+
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("ping")), "SendRawMessage must not error")
+	assert.Equal(t, "ping", string(conn.ReadMessage().Raw), "ReadMessage should return the echo")
+	require.NoError(t, conn.SendJSONMessage(t.Context(), request.Unset, map[string]string{"op": "subscribe"}), "SendJSONMessage must not error")
+	assert.JSONEq(t, `{"op":"subscribe"}`, string(conn.ReadMessage().Raw), "ReadMessage should return the echo")
+
+	require.NoError(t, m.StopRecording(), "StopRecording must not error")
+	assert.False(t, m.IsRecording(), "IsRecording should be false after stopping")
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("unrecorded")), "SendRawMessage must not error")
+
+	frames, err := mock.LoadWebsocketRecording(path)
+	require.NoError(t, err, "LoadWebsocketRecording must not error")
+	require.Len(t, frames, 4, "must record each inbound and outbound frame")
+	for i, direction := range []string{mock.WebsocketOutbound, mock.WebsocketInbound, mock.WebsocketOutbound, mock.WebsocketInbound} {
+		assert.Equal(t, direction, frames[i].Direction, "Direction should be correct")
+		assert.Equal(t, conn.URL, frames[i].URL, "URL should be the connection URL")
+	}
+	assert.Equal(t, "ping", string(frames[0].Payload()), "Payload should be the raw message")
+	assert.JSONEq(t, `{"op":"subscribe"}`, string(frames[2].Payload()), "Payload should be the JSON encoded message")
+}
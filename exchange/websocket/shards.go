@@ -0,0 +1,317 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errConnectionNotEstablished = errors.New("connection not established")
+	errShardingNotSupported     = errors.New("connection sharding requires multi connection management")
+)
+
+// connectionShard is a single connection established from a connectionWrapper setup along with the subscriptions it
+// carries. A wrapper opens as many shards as are needed to keep each connection within MaxSubscriptionsPerConnection.
+type connectionShard struct {
+	setup         *ConnectionSetup
+	subscriptions *subscription.Store
+	connection    Connection
+}
+
+// ConnectionInfo describes the state of an individual websocket connection
+type ConnectionInfo struct {
+	URL           string
+	MessageFilter any
+	Shard         int
+	Authenticated bool
+	Connected     bool
+	Subscriptions int
+}
+
+// GetConnectionInfo returns the state of each websocket connection held by the manager
+func (m *Manager) GetConnectionInfo() []ConnectionInfo {
+	if m == nil {
+		return nil
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	if !m.useMultiConnectionManagement {
+		var info []ConnectionInfo
+		if m.Conn != nil {
+			info = append(info, ConnectionInfo{URL: m.Conn.GetURL(), Connected: isConnected(m.Conn), Subscriptions: m.subscriptions.Len()})
+		}
+		if m.AuthConn != nil {
+			info = append(info, ConnectionInfo{URL: m.AuthConn.GetURL(), Authenticated: true, Connected: isConnected(m.AuthConn)})
+		}
+		return info
+	}
+	var info []ConnectionInfo
+	for _, w := range m.connectionManager {
+		for i, s := range w.shards {
+			info = append(info, ConnectionInfo{
+				URL:           s.connection.GetURL(),
+				MessageFilter: w.setup.MessageFilter,
+				Shard:         i,
+				Authenticated: w.setup.Authenticated,
+				Connected:     isConnected(s.connection),
+				Subscriptions: s.subscriptions.Len(),
+			})
+		}
+	}
+	return info
+}
+
+// isConnected returns whether a connection reports itself as connected, connections which do not expose their
+// state are assumed to be connected
+func isConnected(c Connection) bool {
+	if s, ok := c.(interface{ IsConnected() bool }); ok {
+		return s.IsConnected()
+	}
+	return true
+}
+
+// subscriptionList returns the subscriptions across all shards of a wrapper
+func (w *connectionWrapper) subscriptionList() subscription.List {
+	var subs subscription.List
+	for _, s := range w.shards {
+		subs = append(subs, s.subscriptions.List()...)
+	}
+	return subs
+}
+
+// shardCount returns the number of connections required to carry n subscriptions
+func (m *Manager) shardCount(n int) int {
+	if m.MaxSubscriptionsPerConnection <= 0 || n <= m.MaxSubscriptionsPerConnection {
+		return 1
+	}
+	return (n + m.MaxSubscriptionsPerConnection - 1) / m.MaxSubscriptionsPerConnection
+}
+
+// partitionSubscriptions splits subscriptions into n batches whose sizes differ by no more than one
+func partitionSubscriptions(subs subscription.List, n int) []subscription.List {
+	batches := make([]subscription.List, n)
+	for i := range n {
+		batches[i] = subs[i*len(subs)/n : (i+1)*len(subs)/n]
+	}
+	return batches
+}
+
+// dialShard establishes a new connection for a wrapper setup, starts its reader and authenticates it when required.
+// The shard is registered for subscription routing but is not added to the wrapper
+func (m *Manager) dialShard(setup *ConnectionSetup) (*connectionShard, error) {
+	conn := m.getConnectionFromSetup(setup)
+	if err := setup.Connector(context.TODO(), conn); err != nil {
+		return nil, fmt.Errorf("error connecting: %w", err)
+	}
+	if !conn.IsConnected() {
+		return nil, errConnectionNotEstablished
+	}
+	s := &connectionShard{setup: setup, subscriptions: subscription.NewStore(), connection: conn}
+	m.connections[conn] = s
+	m.Wg.Add(1)
+	go m.Reader(context.TODO(), conn, setup.Handler)
+
+	if setup.Authenticate != nil && m.CanUseAuthenticatedEndpoints() {
+		if err := setup.Authenticate(context.TODO(), conn); err != nil {
+			m.closeShard(s)
+			return nil, fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// closeShard shuts down a shard connection and removes it from subscription routing
+func (m *Manager) closeShard(s *connectionShard) {
+	delete(m.connections, s.connection)
+	if err := s.connection.Shutdown(); err != nil {
+		log.Warnf(log.WebsocketMgr, "%v websocket: failed to shutdown connection: %v", m.exchangeName, err)
+	}
+	s.subscriptions.Clear()
+}
+
+// updateShardSubscriptions brings the subscriptions of a wrapper in line with those incoming. Removed subscriptions
+// are unsubscribed from the shard carrying them, shards which are no longer required are drained into the remaining
+// shards, and new subscriptions are given to the least loaded shards, opening further connections once every shard
+// is at MaxSubscriptionsPerConnection. Shards left without subscriptions are closed.
+func (m *Manager) updateShardSubscriptions(w *connectionWrapper, incoming subscription.List) error {
+	// Pending subscriptions are not carried by any connection, so those still required are subscribed as new
+	w.pending = nil
+	current, err := subscription.NewStoreFromList(w.subscriptionList())
+	if err != nil {
+		return err
+	}
+	subs, unsubs := current.Diff(incoming)
+	if len(unsubs) != 0 {
+		for _, s := range w.shards {
+			contained := s.subscriptions.Contained(unsubs)
+			if len(contained) == 0 {
+				continue
+			}
+			if err := m.UnsubscribeChannels(s.connection, contained); err != nil {
+				return err
+			}
+			if remaining := s.subscriptions.Contained(contained); len(remaining) > 0 {
+				return fmt.Errorf("%v %w %q", m.exchangeName, ErrSubscriptionsNotRemoved, remaining)
+			}
+		}
+	}
+
+	total := len(subs)
+	for _, s := range w.shards {
+		total += s.subscriptions.Len()
+	}
+	// Consolidate onto fewer connections when the remaining subscriptions no longer need them all
+	for required := m.shardCount(total); len(w.shards) > required; {
+		drain := slices.MinFunc(w.shards, func(a, b *connectionShard) int {
+			return a.subscriptions.Len() - b.subscriptions.Len()
+		})
+		drained := drain.subscriptions.List()
+		m.closeShard(drain)
+		subs = append(subs, resetSubscriptions(drained)...)
+		w.shards = slices.DeleteFunc(w.shards, func(s *connectionShard) bool { return s == drain })
+	}
+
+	if len(subs) != 0 {
+		for len(w.shards) < m.shardCount(total) {
+			s, err := m.dialShard(w.setup)
+			if err != nil {
+				return fmt.Errorf("%s websocket: [URL:%s] %w", m.exchangeName, w.setup.URL, err)
+			}
+			w.shards = append(w.shards, s)
+		}
+		for i, batch := range assignSubscriptions(w.shards, subs) {
+			if len(batch) == 0 {
+				continue
+			}
+			if err := m.SubscribeToChannels(w.shards[i].connection, batch); err != nil {
+				return err
+			}
+			if missing := w.shards[i].subscriptions.Missing(batch); len(missing) > 0 {
+				return fmt.Errorf("%v %w %q", m.exchangeName, ErrSubscriptionsNotAdded, missing)
+			}
+		}
+	}
+
+	w.shards = slices.DeleteFunc(w.shards, func(s *connectionShard) bool {
+		if s.subscriptions.Len() != 0 {
+			return false
+		}
+		m.closeShard(s)
+		return true
+	})
+	return nil
+}
+
+// resetSubscriptions returns subscriptions carried by a closed connection to an inactive state so that they can be
+// subscribed again on another connection
+func resetSubscriptions(subs subscription.List) subscription.List {
+	for _, s := range subs {
+		if s.State() != subscription.InactiveState {
+			_ = s.SetState(subscription.InactiveState)
+		}
+	}
+	return subs
+}
+
+// assignSubscriptions distributes subscriptions one at a time to the least loaded shard, returning a batch per shard.
+// Provided there are enough shards for the total, no shard is taken beyond MaxSubscriptionsPerConnection
+func assignSubscriptions(shards []*connectionShard, subs subscription.List) []subscription.List {
+	load := make([]int, len(shards))
+	for i, s := range shards {
+		load[i] = s.subscriptions.Len()
+	}
+	batches := make([]subscription.List, len(shards))
+	for _, sub := range subs {
+		i := 0
+		for j := range load {
+			if load[j] < load[i] {
+				i = j
+			}
+		}
+		batches[i] = append(batches[i], sub)
+		load[i]++
+	}
+	return batches
+}
+
+// reconnectShards re-establishes any managed connections which have dropped and restores their subscriptions on a
+// new connection, leaving the other connections untouched. Every dropped connection is attempted; a connection which
+// cannot be re-established is removed and its subscriptions are held inactive as pending, to be given a new connection
+// by the next attempt. The errors are returned together
+func (m *Manager) reconnectShards() error {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if !m.useMultiConnectionManagement {
+		return errShardingNotSupported
+	}
+	if !m.IsConnected() {
+		return nil
+	}
+	var errs error
+	var reconnected bool
+	for _, w := range m.connectionManager {
+		if len(w.pending) != 0 {
+			pending := w.pending
+			w.pending = nil
+			for _, batch := range partitionSubscriptions(pending, m.shardCount(len(pending))) {
+				s, err := m.dialShard(w.setup)
+				if err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("%s websocket: [URL:%s] %w", m.exchangeName, w.setup.URL, err))
+					w.pending = append(w.pending, batch...)
+					continue
+				}
+				w.shards = append(w.shards, s)
+				reconnected = true
+				errs = common.AppendError(errs, m.resubscribeShard(w, s, batch))
+			}
+		}
+
+		shards := make([]*connectionShard, 0, len(w.shards))
+		for i, s := range w.shards {
+			if isConnected(s.connection) {
+				shards = append(shards, s)
+				continue
+			}
+			subs := s.subscriptions.List()
+			m.closeShard(s)
+			resetSubscriptions(subs)
+			replacement, err := m.dialShard(w.setup)
+			if err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("%s websocket: [URL:%s] [shard:%d] %w", m.exchangeName, w.setup.URL, i, err))
+				w.pending = append(w.pending, subs...)
+				continue
+			}
+			shards = append(shards, replacement)
+			reconnected = true
+			if m.verbose {
+				log.Debugf(log.WebsocketMgr, "%s websocket: [URL:%s] [shard:%d] reconnected. [Resubscribing: %d]", m.exchangeName, w.setup.URL, i, len(subs))
+			}
+			errs = common.AppendError(errs, m.resubscribeShard(w, replacement, subs))
+		}
+		w.shards = shards
+	}
+	if reconnected && errs == nil {
+		m.reportConnected()
+	}
+	return errs
+}
+
+// resubscribeShard subscribes a reconnected shard to the subscriptions carried by the connection it replaces
+func (m *Manager) resubscribeShard(w *connectionWrapper, s *connectionShard, subs subscription.List) error {
+	if len(subs) == 0 {
+		return nil
+	}
+	if err := w.setup.Subscriber(context.TODO(), s.connection, subs); err != nil {
+		return fmt.Errorf("%v Error subscribing %w", m.exchangeName, err)
+	}
+	if missing := s.subscriptions.Missing(subs); len(missing) > 0 {
+		return fmt.Errorf("%v %w %q", m.exchangeName, ErrSubscriptionsNotAdded, missing)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
+)
+
+// newShardedManager returns a multi connection manager limited to two subscriptions per connection, with a single
+// connection setup generating the given number of subscriptions against a mock websocket server
+func newShardedManager(t *testing.T, subCount *int) *Manager {
+	t.Helper()
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	t.Cleanup(mock.Close)
+
+	m := NewManager()
+	set := newDefaultSetup()
+	set.UseMultiConnectionManagement = true
+	set.MaxWebsocketSubscriptionsPerConnection = 2
+	require.NoError(t, m.Setup(set), "Setup must not error")
+	require.NoError(t, m.SetupNewConnection(&ConnectionSetup{
+		URL: "ws" + mock.URL[len("http"):] + "/ws",
+		Connector: func(ctx context.Context, conn Connection) error {
+			return conn.Dial(ctx, gws.DefaultDialer, nil)
+		},
+		GenerateSubscriptions: func() (subscription.List, error) {
+			subs := make(subscription.List, *subCount)
+			for i := range subs {
+				subs[i] = &subscription.Subscription{Channel: "shard" + strconv.Itoa(i)}
+			}
+			return subs, nil
+		},
+		Subscriber:   currySimpleSubConn(m),
+		Unsubscriber: currySimpleUnsubConn(m),
+		Handler:      func(context.Context, Connection, []byte) error { return nil },
+	}), "SetupNewConnection must not error")
+	return m
+}
+
+func shardLoads(w *connectionWrapper) []int {
+	loads := make([]int, len(w.shards))
+	for i, s := range w.shards {
+		loads[i] = s.subscriptions.Len()
+	}
+	return loads
+}
+
+func TestShardCount(t *testing.T) {
+	t.Parallel()
+	m := &Manager{}
+	assert.Equal(t, 1, m.shardCount(500), "shardCount should return 1 without a subscription limit")
+	m.MaxSubscriptionsPerConnection = 240
+	assert.Equal(t, 1, m.shardCount(0), "shardCount should return 1 for no subscriptions")
+	assert.Equal(t, 1, m.shardCount(240), "shardCount should return 1 at the limit")
+	assert.Equal(t, 2, m.shardCount(241), "shardCount should return 2 above the limit")
+	assert.Equal(t, 3, m.shardCount(700), "shardCount should return 3")
+}
+
+func TestPartitionSubscriptions(t *testing.T) {
+	t.Parallel()
+	subs := make(subscription.List, 7)
+	batches := partitionSubscriptions(subs, 3)
+	require.Len(t, batches, 3, "partitionSubscriptions must return a batch per shard")
+	for _, b := range batches {
+		assert.InDelta(t, 7.0/3, len(b), 1, "batch sizes should be balanced")
+	}
+	assert.Len(t, partitionSubscriptions(subs, 1)[0], 7, "a single batch should contain every subscription")
+}
+
+func TestAssignSubscriptions(t *testing.T) {
+	t.Parallel()
+	full := subscription.NewStore()
+	require.NoError(t, full.Add(&subscription.Subscription{Channel: "a"}))
+	require.NoError(t, full.Add(&subscription.Subscription{Channel: "b"}))
+	shards := []*connectionShard{{subscriptions: full}, {subscriptions: subscription.NewStore()}}
+	batches := assignSubscriptions(shards, make(subscription.List, 4))
+	require.Len(t, batches, 2, "assignSubscriptions must return a batch per shard")
+	assert.Len(t, batches[0], 1, "the loaded shard should receive fewer subscriptions")
+	assert.Len(t, batches[1], 3, "the empty shard should receive more subscriptions")
+}
+
+func TestConnectShardsSubscriptions(t *testing.T) {
+	t.Parallel()
+	subCount := 5
+	m := newShardedManager(t, &subCount)
+	require.NoError(t, m.Connect(), "Connect must not error")
+	w := m.connectionManager[0]
+	assert.Equal(t, []int{1, 2, 2}, shardLoads(w), "subscriptions should be balanced across three connections")
+	assert.Len(t, m.GetSubscriptions(), 5, "GetSubscriptions should return subscriptions across all connections")
+
+	info := m.GetConnectionInfo()
+	require.Len(t, info, 3, "GetConnectionInfo must return each connection")
+	for i := range info {
+		assert.Equal(t, i, info[i].Shard, "Shard should be set")
+		assert.True(t, info[i].Connected, "Connected should be true")
+	}
+
+	require.NoError(t, m.Shutdown(), "Shutdown must not error")
+	assert.Empty(t, w.shards, "Shutdown should close all connections")
+	assert.Empty(t, m.GetConnectionInfo(), "GetConnectionInfo should return no connections after shutdown")
+}
+
+func TestUpdateShardSubscriptions(t *testing.T) {
+	t.Parallel()
+	subCount := 3
+	m := newShardedManager(t, &subCount)
+	m.setState(connectedState)
+	w := m.connectionManager[0]
+
+	require.NoError(t, m.FlushChannels(), "FlushChannels must not error")
+	assert.Equal(t, []int{2, 1}, shardLoads(w), "subscriptions should be spread across two connections")
+	first := w.shards[0]
+
+	subCount = 6
+	require.NoError(t, m.FlushChannels(), "FlushChannels must not error")
+	assert.Equal(t, []int{2, 2, 2}, shardLoads(w), "new subscriptions should fill the existing connection and open another")
+	assert.Same(t, first, w.shards[0], "existing connections should be kept")
+
+	subCount = 2
+	require.NoError(t, m.FlushChannels(), "FlushChannels must not error")
+	require.Len(t, w.shards, 1, "remaining subscriptions should be consolidated onto a single connection")
+	assert.Len(t, m.GetSubscriptions(), 2, "GetSubscriptions should return the remaining subscriptions")
+	for _, s := range m.GetSubscriptions() {
+		assert.Equal(t, subscription.SubscribedState, s.State(), "consolidated subscriptions should be resubscribed")
+	}
+
+	subCount = 0
+	require.NoError(t, m.FlushChannels(), "FlushChannels must not error")
+	assert.Empty(t, w.shards, "connections should be closed when no subscriptions remain")
+	assert.Empty(t, m.connections, "closed connections should be removed from routing")
+
+	require.NoError(t, m.Shutdown(), "Shutdown must not error")
+}
+
+func TestReconnectShards(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, (&Manager{}).reconnectShards(), errShardingNotSupported, "reconnectShards should error without multi connection management")
+
+	subCount := 4
+	m := newShardedManager(t, &subCount)
+	require.NoError(t, m.reconnectShards(), "reconnectShards must not error when disconnected")
+	m.setState(connectedState)
+	require.NoError(t, m.FlushChannels(), "FlushChannels must not error")
+	w := m.connectionManager[0]
+	require.Len(t, w.shards, 2, "must have two connections")
+	faulted, healthy := w.shards[0], w.shards[1]
+
+	conn, ok := faulted.connection.(*connection)
+	require.True(t, ok, "connection must be a *connection")
+	require.NoError(t, conn.Connection.NetConn().Close(), "closing the underlying connection must not error")
+	require.Eventually(t, func() bool { return !conn.IsConnected() }, time.Second, time.Millisecond, "connection must report as disconnected")
+	require.ErrorIs(t, <-m.ReadMessageErrors, errConnectionFault, "a connection fault must be reported")
+
+	require.NoError(t, m.reconnectShards(), "reconnectShards must not error")
+	require.Len(t, w.shards, 2, "must still have two connections")
+	assert.NotSame(t, faulted, w.shards[0], "the faulted connection should be replaced")
+	assert.Same(t, healthy, w.shards[1], "the healthy connection should be untouched")
+	assert.True(t, isConnected(w.shards[0].connection), "the replacement connection should be connected")
+	assert.Equal(t, []int{2, 2}, shardLoads(w), "subscriptions should be restored on the replacement connection")
+	assert.NotContains(t, m.connections, faulted.connection, "the faulted connection should be removed from routing")
+
+	// Drop both connections and fail the first redial, the second connection should still be re-established
+	first, second := w.shards[0], w.shards[1]
+	for _, s := range w.shards {
+		conn, ok := s.connection.(*connection)
+		require.True(t, ok, "connection must be a *connection")
+		require.NoError(t, conn.Connection.NetConn().Close(), "closing the underlying connection must not error")
+		require.Eventually(t, func() bool { return !conn.IsConnected() }, time.Second, time.Millisecond, "connection must report as disconnected")
+		require.ErrorIs(t, <-m.ReadMessageErrors, errConnectionFault, "a connection fault must be reported")
+	}
+	connector := w.setup.Connector
+	var dials int
+	w.setup.Connector = func(ctx context.Context, conn Connection) error {
+		if dials++; dials == 1 {
+			return errDastardlyReason
+		}
+		return connector(ctx, conn)
+	}
+	require.ErrorIs(t, m.reconnectShards(), errDastardlyReason, "reconnectShards must error when a connection cannot be re-established")
+	require.Len(t, w.shards, 1, "the connection which failed to reconnect must be removed")
+	assert.NotSame(t, first, w.shards[0], "the connection which failed to reconnect should not be retained")
+	assert.NotSame(t, second, w.shards[0], "the remaining dropped connection should be replaced")
+	assert.True(t, isConnected(w.shards[0].connection), "the replacement connection should be connected")
+	assert.NotContains(t, m.connections, first.connection, "the connection which failed to reconnect should be removed from routing")
+	assert.Equal(t, []int{2}, shardLoads(w), "subscriptions should be restored on the replacement connection")
+	require.Len(t, w.pending, 2, "subscriptions of the connection which failed to reconnect must be pending")
+	for _, sub := range w.pending {
+		assert.Equal(t, subscription.InactiveState, sub.State(), "pending subscriptions should be inactive")
+	}
+	assert.Len(t, m.GetSubscriptions(), 4, "GetSubscriptions should include pending subscriptions")
+
+	require.NoError(t, m.reconnectShards(), "reconnectShards must not error")
+	require.Len(t, w.shards, 2, "pending subscriptions must be given a new connection")
+	assert.Empty(t, w.pending, "no subscriptions should remain pending")
+	assert.Equal(t, []int{2, 2}, shardLoads(w), "pending subscriptions should be restored on the new connection")
+	for _, sub := range w.shards[1].subscriptions.List() {
+		assert.Equal(t, subscription.SubscribedState, sub.State(), "restored subscriptions should be subscribed")
+	}
+
+	require.NoError(t, m.Shutdown(), "Shutdown must not error")
+}
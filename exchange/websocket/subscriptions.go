@@ -7,8 +7,9 @@ import (
 	"slices"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
-	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // Public subscription errors
@@ -69,6 +70,44 @@ func (m *Manager) ResubscribeToChannel(conn Connection, s *subscription.Subscrip
 	return m.SubscribeToChannels(conn, l)
 }
 
+// ResubscribeOrderbook resubscribes to the orderbook subscription containing a pair so that the exchange sends a fresh
+// snapshot, restoring an invalidated orderbook. A subscription for the asset is preferred, otherwise a subscription for
+// another asset sharing the pair is used. It may be used as the orderbook buffer Resync function
+func (m *Manager) ResubscribeOrderbook(p currency.Pair, a asset.Item) error {
+	var fallback *subscription.Subscription
+	var fallbackConn Connection
+	check := func(conn Connection, subs subscription.List) *subscription.Subscription {
+		for _, s := range subs {
+			if s.Channel != subscription.OrderbookChannel || !s.Pairs.Contains(p, true) {
+				continue
+			}
+			if s.Asset == a {
+				return s
+			}
+			if fallback == nil {
+				fallback, fallbackConn = s, conn
+			}
+		}
+		return nil
+	}
+	for _, c := range m.connectionManager {
+		for _, shard := range c.shards {
+			if s := check(shard.connection, shard.subscriptions.List()); s != nil {
+				return m.ResubscribeToChannel(shard.connection, s)
+			}
+		}
+	}
+	if m.subscriptions != nil {
+		if s := check(m.Conn, m.subscriptions.List()); s != nil {
+			return m.ResubscribeToChannel(m.Conn, s)
+		}
+	}
+	if fallback != nil {
+		return m.ResubscribeToChannel(fallbackConn, fallback)
+	}
+	return fmt.Errorf("%w: %s %s %s", subscription.ErrNotFound, subscription.OrderbookChannel, p, a)
+}
+
 // SubscribeToChannels subscribes to websocket channels using the exchange specific Subscriber method
 // Errors are returned for duplicates or exceeding max Subscriptions
 func (m *Manager) SubscribeToChannels(conn Connection, subs subscription.List) error {
@@ -189,12 +228,10 @@ func (m *Manager) GetSubscription(key any) *subscription.Subscription {
 		return nil
 	}
 	for _, c := range m.connectionManager {
-		if c.subscriptions == nil {
-			continue
-		}
-		sub := c.subscriptions.Get(key)
-		if sub != nil {
-			return sub
+		for _, shard := range c.shards {
+			if sub := shard.subscriptions.Get(key); sub != nil {
+				return sub
+			}
 		}
 	}
 	if m.subscriptions == nil {
@@ -210,9 +247,8 @@ func (m *Manager) GetSubscriptions() subscription.List {
 	}
 	var subs subscription.List
 	for _, c := range m.connectionManager {
-		if c.subscriptions != nil {
-			subs = append(subs, c.subscriptions.List()...)
-		}
+		subs = append(subs, c.subscriptionList()...)
+		subs = append(subs, c.pending...)
 	}
 	if m.subscriptions != nil {
 		subs = append(subs, m.subscriptions.List()...)
@@ -289,36 +325,15 @@ func (m *Manager) FlushChannels() error {
 			return err
 		}
 
-		// Case if there is nothing to unsubscribe from and the connection is nil
-		if len(newSubs) == 0 && m.connectionManager[x].connection == nil {
+		// Case if there is nothing to unsubscribe from and no connections are held
+		if len(newSubs) == 0 && len(m.connectionManager[x].shards) == 0 {
 			continue
 		}
 
-		// If there are subscriptions to subscribe to but no connection to subscribe to, establish a new connection.
-		if m.connectionManager[x].connection == nil {
-			conn := m.getConnectionFromSetup(m.connectionManager[x].setup)
-			if err := m.connectionManager[x].setup.Connector(context.TODO(), conn); err != nil {
-				return err
-			}
-			m.Wg.Add(1)
-			go m.Reader(context.TODO(), conn, m.connectionManager[x].setup.Handler)
-			m.connections[conn] = m.connectionManager[x]
-			m.connectionManager[x].connection = conn
-		}
-
-		err = m.updateChannelSubscriptions(m.connectionManager[x].connection, m.connectionManager[x].subscriptions, newSubs)
-		if err != nil {
+		// Subscriptions are balanced across the wrapper's connections, which are opened and closed as needed
+		if err := m.updateShardSubscriptions(m.connectionManager[x], newSubs); err != nil {
 			return err
 		}
-
-		// If there are no subscriptions to subscribe to, close the connection as it is no longer needed.
-		if m.connectionManager[x].subscriptions.Len() == 0 {
-			delete(m.connections, m.connectionManager[x].connection) // Remove from lookup map
-			if err := m.connectionManager[x].connection.Shutdown(); err != nil {
-				log.Warnf(log.WebsocketMgr, "%v websocket: failed to shutdown connection: %v", m.exchangeName, err)
-			}
-			m.connectionManager[x].connection = nil
-		}
 	}
 	return nil
 }
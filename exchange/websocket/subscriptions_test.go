@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 )
 
@@ -73,8 +75,10 @@ func TestSubscribeUnsubscribe(t *testing.T) {
 	require.NoError(t, multi.SetupNewConnection(amazingCandidate))
 
 	amazingConn := multi.getConnectionFromSetup(amazingCandidate)
-	multi.connections = map[Connection]*connectionWrapper{
-		amazingConn: multi.connectionManager[0],
+	amazingShard := &connectionShard{setup: amazingCandidate, subscriptions: subscription.NewStore(), connection: amazingConn}
+	multi.connectionManager[0].shards = []*connectionShard{amazingShard}
+	multi.connections = map[Connection]*connectionShard{
+		amazingConn: amazingShard,
 	}
 
 	subs, err = amazingCandidate.GenerateSubscriptions()
@@ -139,6 +143,28 @@ func TestResubscribe(t *testing.T) {
 	assert.NoError(t, ws.ResubscribeToChannel(nil, channel[0]), "Resubscribe should not error now the channel is subscribed")
 }
 
+func TestResubscribeOrderbook(t *testing.T) {
+	t.Parallel()
+	ws := NewManager()
+	require.NoError(t, ws.Setup(newDefaultSetup()), "WS Setup must not error")
+	ws.Subscriber = currySimpleSub(ws)
+	ws.Unsubscriber = currySimpleUnsub(ws)
+
+	btcusd, ethusd := currency.NewBTCUSD(), currency.NewPair(currency.ETH, currency.USD)
+	assert.ErrorIs(t, ws.ResubscribeOrderbook(btcusd, asset.Spot), subscription.ErrNotFound, "ResubscribeOrderbook should error when not subscribed")
+
+	subs := subscription.List{
+		{Channel: subscription.TickerChannel, Asset: asset.Spot, Pairs: currency.Pairs{btcusd}},
+		{Channel: subscription.OrderbookChannel, Asset: asset.Margin, Pairs: currency.Pairs{btcusd}},
+		{Channel: subscription.OrderbookChannel, Asset: asset.Spot, Pairs: currency.Pairs{ethusd}},
+	}
+	require.NoError(t, ws.SubscribeToChannels(nil, subs), "SubscribeToChannels must not error")
+	assert.NoError(t, ws.ResubscribeOrderbook(ethusd, asset.Spot), "ResubscribeOrderbook should not error")
+	assert.NoError(t, ws.ResubscribeOrderbook(btcusd, asset.Spot), "ResubscribeOrderbook should fall back to another asset")
+	assert.ErrorIs(t, ws.ResubscribeOrderbook(currency.NewBTCUSDT(), asset.Spot), subscription.ErrNotFound, "ResubscribeOrderbook should error when the pair is not subscribed")
+	assert.Len(t, ws.GetSubscriptions(), 3, "Resubscribing should not change the subscriptions")
+}
+
 // TestSubscriptions tests adding, getting and removing subscriptions
 func TestSubscriptions(t *testing.T) {
 	t.Parallel()
@@ -54,3 +54,11 @@ type UnhandledMessageWarning struct {
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
 }
+
+// EventReporter is an optional Reporter extension which observes websocket
+// message throughput and connection lifecycle events
+type EventReporter interface {
+	MessageReceived(name string)
+	Reconnected(name string)
+	TrafficTimeout(name string)
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -27,6 +28,9 @@ import (
 type Exchange struct {
 	exchange.Base
 	obm *orderbookManager
+
+	obmWorkersRunning       atomic.Bool
+	authKeyKeepAliveRunning atomic.Bool
 }
 
 const (
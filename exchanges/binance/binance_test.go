@@ -1985,7 +1985,7 @@ func BenchmarkWsHandleData(bb *testing.B) {
 	}()
 	for bb.Loop() {
 		for x := range lines {
-			assert.NoError(bb, e.wsHandleData(lines[x]))
+			assert.NoError(bb, e.wsHandleData(bb.Context(), nil, lines[x]))
 		}
 	}
 }
@@ -1994,8 +1994,7 @@ func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	e := new(Exchange) //nolint:govet // Intentional shadow
 	require.NoError(t, testexch.Setup(e), "Test instance Setup must not error")
-	channels, err := e.generateSubscriptions() // Note: We grab this before it's overwritten by MockWsInstance below
-	require.NoError(t, err, "generateSubscriptions must not error")
+	defaultSubs := e.Features.Subscriptions // Note: We grab this before it's cleared by MockWsInstance below
 	if mockTests {
 		exp := []string{"btcusdt@depth@100ms", "btcusdt@kline_1m", "btcusdt@ticker", "btcusdt@trade", "dogeusdt@depth@100ms", "dogeusdt@kline_1m", "dogeusdt@ticker", "dogeusdt@trade"}
 		mock := func(tb testing.TB, msg []byte, w *gws.Conn) error {
@@ -2009,17 +2008,20 @@ func TestSubscribe(t *testing.T) {
 	} else {
 		testexch.SetupWs(t, e)
 	}
-	err = e.Subscribe(channels)
-	require.NoError(t, err, "Subscribe must not error")
-	err = e.Unsubscribe(channels)
-	require.NoError(t, err, "Unsubscribe must not error")
+	e.Features.Subscriptions = defaultSubs
+	channels, err := e.generateSubscriptions()
+	require.NoError(t, err, "generateSubscriptions must not error")
+	err = e.Websocket.FlushChannels() // Subscribes to the default subscriptions
+	require.NoError(t, err, "FlushChannels must not error")
+	assert.Len(t, e.Websocket.GetSubscriptions(), len(channels), "GetSubscriptions should return all subscribed channels")
+	e.Features.Subscriptions = subscription.List{}
+	err = e.Websocket.FlushChannels() // Unsubscribes from the subscriptions no longer generated
+	require.NoError(t, err, "FlushChannels must not error")
+	assert.Empty(t, e.Websocket.GetSubscriptions(), "GetSubscriptions should return no subscriptions")
 }
 
 func TestSubscribeBadResp(t *testing.T) {
 	t.Parallel()
-	channels := subscription.List{
-		{Channel: "moons@ticker"},
-	}
 	mock := func(tb testing.TB, msg []byte, w *gws.Conn) error {
 		tb.Helper()
 		var req WsPayload
@@ -2028,15 +2030,16 @@ func TestSubscribeBadResp(t *testing.T) {
 		return w.WriteMessage(gws.TextMessage, fmt.Appendf(nil, `{"result":{"error":"carrots"},"id":%d}`, req.ID))
 	}
 	b := testexch.MockWsInstance[Exchange](t, mockws.CurryWsMockUpgrader(t, mock))
-	err := b.Subscribe(channels)
-	assert.ErrorIs(t, err, common.ErrUnknownError, "Subscribe should error correctly")
-	assert.ErrorContains(t, err, "carrots", "Subscribe should error containing the carrots")
+	b.Features.Subscriptions = subscription.List{{Enabled: true, Asset: asset.Spot, Channel: subscription.TickerChannel}}
+	err := b.Websocket.FlushChannels()
+	assert.ErrorIs(t, err, common.ErrUnknownError, "FlushChannels should error correctly")
+	assert.ErrorContains(t, err, "carrots", "FlushChannels should error containing the carrots")
 }
 
 func TestWsTickerUpdate(t *testing.T) {
 	t.Parallel()
 	pressXToJSON := []byte(`{"stream":"btcusdt@ticker","data":{"e":"24hrTicker","E":1580254809477,"s":"BTCUSDT","p":"420.97000000","P":"4.720","w":"9058.27981278","x":"8917.98000000","c":"9338.96000000","Q":"0.17246300","b":"9338.03000000","B":"0.18234600","a":"9339.70000000","A":"0.14097600","o":"8917.99000000","h":"9373.19000000","l":"8862.40000000","v":"72229.53692000","q":"654275356.16896672","O":1580168409456,"C":1580254809456,"F":235294268,"L":235894703,"n":600436}}`)
-	err := e.wsHandleData(pressXToJSON)
+	err := e.wsHandleData(t.Context(), nil, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2068,7 +2071,7 @@ func TestWsKlineUpdate(t *testing.T) {
 		"B": "123456"   
 	  }
 	}}`)
-	err := e.wsHandleData(pressXToJSON)
+	err := e.wsHandleData(t.Context(), nil, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2090,7 +2093,7 @@ func TestWsTradeUpdate(t *testing.T) {
 	  "m": true,        
 	  "M": true         
 	}}`)
-	err := e.wsHandleData(pressXToJSON)
+	err := e.wsHandleData(t.Context(), nil, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2149,7 +2152,7 @@ func TestWsDepthUpdate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err := e.wsHandleData(update1); err != nil {
+	if err := e.wsHandleData(t.Context(), nil, update1); err != nil {
 		t.Fatal(err)
 	}
 
@@ -2185,7 +2188,7 @@ func TestWsDepthUpdate(t *testing.T) {
 	  ]
 	}}`)
 
-	if err = e.wsHandleData(update2); err != nil {
+	if err = e.wsHandleData(t.Context(), nil, update2); err != nil {
 		t.Error(err)
 	}
 
@@ -2218,7 +2221,7 @@ func TestWsBalanceUpdate(t *testing.T) {
   "a": "BTC",                   
   "d": "100.00000000",          
   "T": 1573200697068}}`)
-	err := e.wsHandleData(pressXToJSON)
+	err := e.wsHandleData(t.Context(), nil, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2250,7 +2253,7 @@ func TestWsOCO(t *testing.T) {
     }
   ]
 }}`)
-	err := e.wsHandleData(pressXToJSON)
+	err := e.wsHandleData(t.Context(), nil, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2581,7 +2584,7 @@ func TestWsOrderExecutionReport(t *testing.T) {
 		<-e.Websocket.DataHandler
 	}
 
-	err := e.wsHandleData(payload)
+	err := e.wsHandleData(t.Context(), nil, payload)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2596,7 +2599,7 @@ func TestWsOrderExecutionReport(t *testing.T) {
 	}
 
 	payload = []byte(`{"stream":"jTfvpakT2yT0hVIo5gYWVihZhdM2PrBgJUZ5PyfZ4EVpCkx4Uoxk5timcrQc","data":{"e":"executionReport","E":1616633041556,"s":"BTCUSDT","c":"YeULctvPAnHj5HXCQo9Mob","S":"BUY","o":"LIMIT","f":"GTC","q":"0.00028600","p":"52436.85000000","P":"0.00000000","F":"0.00000000","g":-1,"C":"","x":"TRADE","X":"FILLED","r":"NONE","i":5341783271,"l":"0.00028600","z":"0.00028600","L":"52436.85000000","n":"0.00000029","N":"BTC","T":1616633041555,"t":726946523,"I":11390206312,"w":false,"m":false,"M":true,"O":1616633041555,"Z":"14.99693910","Y":"14.99693910","Q":"0.00000000","W":1616633041555}}`)
-	err = e.wsHandleData(payload)
+	err = e.wsHandleData(t.Context(), nil, payload)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -2605,7 +2608,7 @@ func TestWsOrderExecutionReport(t *testing.T) {
 func TestWsOutboundAccountPosition(t *testing.T) {
 	t.Parallel()
 	payload := []byte(`{"stream":"jTfvpakT2yT0hVIo5gYWVihZhdM2PrBgJUZ5PyfZ4EVpCkx4Uoxk5timcrQc","data":{"e":"outboundAccountPosition","E":1616628815745,"u":1616628815745,"B":[{"a":"BTC","f":"0.00225109","l":"0.00123000"},{"a":"BNB","f":"0.00000000","l":"0.00000000"},{"a":"USDT","f":"54.43390661","l":"0.00000000"}]}}`)
-	if err := e.wsHandleData(payload); err != nil {
+	if err := e.wsHandleData(t.Context(), nil, payload); err != nil {
 		t.Fatal(err)
 	}
 }
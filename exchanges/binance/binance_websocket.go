@@ -35,6 +35,9 @@ const (
 	wsListSubscriptionsMethod = "LIST_SUBSCRIPTIONS"
 )
 
+// userDataStreamConnection is the message filter of the connection which receives the user data stream
+const userDataStreamConnection = "userDataStream"
+
 var listenKey string
 
 var (
@@ -49,9 +52,35 @@ var (
 	maxWSOrderbookWorkers = 10
 )
 
-// WsConnect initiates a websocket connection
-func (e *Exchange) WsConnect() error {
-	ctx := context.TODO()
+// WsConnect initiates a public websocket connection
+func (e *Exchange) WsConnect(ctx context.Context, conn websocket.Connection) error {
+	if err := e.wsDial(ctx, conn); err != nil {
+		return err
+	}
+	e.setupOrderbookManager(ctx)
+	return nil
+}
+
+// wsConnectUserData initiates a websocket connection to the user data stream of a new listen key
+func (e *Exchange) wsConnectUserData(ctx context.Context, conn websocket.Connection) error {
+	var err error
+	listenKey, err = e.GetWsAuthStreamKey(ctx)
+	if err != nil {
+		e.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		return fmt.Errorf("%v unable to connect to authenticated Websocket. Error: %w", e.Name, err)
+	}
+	conn.SetURL(conn.GetURL() + "?streams=" + listenKey)
+	if err := e.wsDial(ctx, conn); err != nil {
+		return err
+	}
+	if e.authKeyKeepAliveRunning.CompareAndSwap(false, true) {
+		go e.KeepAuthKeyAlive(ctx)
+	}
+	return nil
+}
+
+// wsDial dials a websocket connection and sets up its ping handler
+func (e *Exchange) wsDial(ctx context.Context, conn websocket.Connection) error {
 	if !e.Websocket.IsEnabled() || !e.IsEnabled() {
 		return websocket.ErrWebsocketNotEnabled
 	}
@@ -59,51 +88,26 @@ func (e *Exchange) WsConnect() error {
 	var dialer gws.Dialer
 	dialer.HandshakeTimeout = e.Config.HTTPTimeout
 	dialer.Proxy = http.ProxyFromEnvironment
-	var err error
-	if e.Websocket.CanUseAuthenticatedEndpoints() {
-		listenKey, err = e.GetWsAuthStreamKey(ctx)
-		if err != nil {
-			e.Websocket.SetCanUseAuthenticatedEndpoints(false)
-			log.Errorf(log.ExchangeSys,
-				"%v unable to connect to authenticated Websocket. Error: %s",
-				e.Name,
-				err)
-		} else {
-			// cleans on failed connection
-			clean := strings.Split(e.Websocket.GetWebsocketURL(), "?streams=")
-			authPayload := clean[0] + "?streams=" + listenKey
-			err = e.Websocket.SetWebsocketURL(authPayload, false, false)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	err = e.Websocket.Conn.Dial(ctx, &dialer, http.Header{})
-	if err != nil {
+	if err := conn.Dial(ctx, &dialer, http.Header{}); err != nil {
 		return fmt.Errorf("%v - Unable to connect to Websocket. Error: %s",
 			e.Name,
 			err)
 	}
 
-	if e.Websocket.CanUseAuthenticatedEndpoints() {
-		go e.KeepAuthKeyAlive(ctx)
-	}
-
-	e.Websocket.Conn.SetupPingHandler(request.Unset, websocket.PingHandler{
+	conn.SetupPingHandler(request.Unset, websocket.PingHandler{
 		UseGorillaHandler: true,
 		MessageType:       gws.PongMessage,
 		Delay:             pingDelay,
 	})
-
-	e.Websocket.Wg.Add(1)
-	go e.wsReadData()
-
-	e.setupOrderbookManager(ctx)
 	return nil
 }
 
+// setupOrderbookManager starts the orderbook sync workers, which run until the websocket is shut down. Workers are
+// only started by the first connection made, so connections added for further subscriptions share them
 func (e *Exchange) setupOrderbookManager(ctx context.Context) {
+	if !e.obmWorkersRunning.CompareAndSwap(false, true) {
+		return
+	}
 	if e.obm == nil {
 		e.obm = &orderbookManager{
 			state: make(map[currency.Code]map[currency.Code]map[asset.Item]*update),
@@ -138,6 +142,7 @@ func (e *Exchange) KeepAuthKeyAlive(ctx context.Context) {
 		select {
 		case <-e.Websocket.ShutdownC:
 			ticks.Stop()
+			e.authKeyKeepAliveRunning.Store(false)
 			return
 		case <-ticks.C:
 			err := e.MaintainWsAuthStreamKey(ctx)
@@ -149,27 +154,9 @@ func (e *Exchange) KeepAuthKeyAlive(ctx context.Context) {
 	}
 }
 
-// wsReadData receives and passes on websocket messages for processing
-func (e *Exchange) wsReadData() {
-	defer e.Websocket.Wg.Done()
-
-	for {
-		resp := e.Websocket.Conn.ReadMessage()
-		if resp.Raw == nil {
-			return
-		}
-		err := e.wsHandleData(resp.Raw)
-		if err != nil {
-			e.Websocket.DataHandler <- err
-		}
-	}
-}
-
-func (e *Exchange) wsHandleData(respRaw []byte) error {
+func (e *Exchange) wsHandleData(_ context.Context, conn websocket.Connection, respRaw []byte) error {
 	if id, err := jsonparser.GetInt(respRaw, "id"); err == nil {
-		if e.Websocket.Match.IncomingWithData(id, respRaw) {
-			return nil
-		}
+		return conn.RequireMatchWithData(id, respRaw)
 	}
 
 	if resultString, err := jsonparser.GetUnsafeString(respRaw, "result"); err == nil {
@@ -558,21 +545,19 @@ func formatChannelInterval(s *subscription.Subscription) string {
 }
 
 // Subscribe subscribes to a set of channels
-func (e *Exchange) Subscribe(channels subscription.List) error {
-	ctx := context.TODO()
-	return e.ParallelChanOp(ctx, channels, func(ctx context.Context, l subscription.List) error { return e.manageSubs(ctx, wsSubscribeMethod, l) }, 50)
+func (e *Exchange) Subscribe(ctx context.Context, conn websocket.Connection, channels subscription.List) error {
+	return e.ParallelChanOp(ctx, channels, func(ctx context.Context, l subscription.List) error { return e.manageSubs(ctx, conn, wsSubscribeMethod, l) }, 50)
 }
 
 // Unsubscribe unsubscribes from a set of channels
-func (e *Exchange) Unsubscribe(channels subscription.List) error {
-	ctx := context.TODO()
-	return e.ParallelChanOp(ctx, channels, func(ctx context.Context, l subscription.List) error { return e.manageSubs(ctx, wsUnsubscribeMethod, l) }, 50)
+func (e *Exchange) Unsubscribe(ctx context.Context, conn websocket.Connection, channels subscription.List) error {
+	return e.ParallelChanOp(ctx, channels, func(ctx context.Context, l subscription.List) error { return e.manageSubs(ctx, conn, wsUnsubscribeMethod, l) }, 50)
 }
 
 // manageSubs subscribes or unsubscribes from a list of subscriptions
-func (e *Exchange) manageSubs(ctx context.Context, op string, subs subscription.List) error {
+func (e *Exchange) manageSubs(ctx context.Context, conn websocket.Connection, op string, subs subscription.List) error {
 	if op == wsSubscribeMethod {
-		if err := e.Websocket.AddSubscriptions(e.Websocket.Conn, subs...); err != nil { // Note: AddSubscription will set state to subscribing
+		if err := e.Websocket.AddSubscriptions(conn, subs...); err != nil { // Note: AddSubscription will set state to subscribing
 			return err
 		}
 	} else {
@@ -582,12 +567,12 @@ func (e *Exchange) manageSubs(ctx context.Context, op string, subs subscription.
 	}
 
 	req := WsPayload{
-		ID:     e.Websocket.Conn.GenerateMessageID(false),
+		ID:     conn.GenerateMessageID(false),
 		Method: op,
 		Params: subs.QualifiedChannels(),
 	}
 
-	respRaw, err := e.Websocket.Conn.SendMessageReturnResponse(ctx, request.Unset, req.ID, req)
+	respRaw, err := conn.SendMessageReturnResponse(ctx, request.Unset, req.ID, req)
 	if err == nil {
 		if v, d, _, rErr := jsonparser.Get(respRaw, "result"); rErr != nil {
 			err = rErr
@@ -601,7 +586,7 @@ func (e *Exchange) manageSubs(ctx context.Context, op string, subs subscription.
 		e.Websocket.DataHandler <- err
 
 		if op == wsSubscribeMethod {
-			if err2 := e.Websocket.RemoveSubscriptions(e.Websocket.Conn, subs...); err2 != nil {
+			if err2 := e.Websocket.RemoveSubscriptions(conn, subs...); err2 != nil {
 				err = common.AppendError(err, err2)
 			}
 		}
@@ -609,13 +594,32 @@ func (e *Exchange) manageSubs(ctx context.Context, op string, subs subscription.
 		if op == wsSubscribeMethod {
 			err = common.AppendError(err, subs.SetStates(subscription.SubscribedState))
 		} else {
-			err = e.Websocket.RemoveSubscriptions(e.Websocket.Conn, subs...)
+			err = e.Websocket.RemoveSubscriptions(conn, subs...)
 		}
 	}
 
 	return err
 }
 
+// generateUserDataSubscriptions returns the user data stream subscription when authenticated endpoints can be used
+// The stream is selected by the listen key in the connection URL, so the subscription is never sent to the exchange
+func (e *Exchange) generateUserDataSubscriptions() (subscription.List, error) {
+	if !e.Websocket.CanUseAuthenticatedEndpoints() {
+		return subscription.List{}, nil
+	}
+	return subscription.List{{Channel: subscription.MyAccountChannel, Authenticated: true}}, nil
+}
+
+// subscribeUserData stores the user data stream subscription, which is received as soon as the connection is made
+func (e *Exchange) subscribeUserData(_ context.Context, conn websocket.Connection, subs subscription.List) error {
+	return e.Websocket.AddSuccessfulSubscriptions(conn, subs...)
+}
+
+// unsubscribeUserData removes the user data stream subscription
+func (e *Exchange) unsubscribeUserData(_ context.Context, conn websocket.Connection, subs subscription.List) error {
+	return e.Websocket.RemoveSubscriptions(conn, subs...)
+}
+
 // ProcessOrderbookUpdate processes the websocket orderbook update
 func (e *Exchange) ProcessOrderbookUpdate(cp currency.Pair, a asset.Item, ws *WebsocketDepthStream) error {
 	updateBid := make([]orderbook.Level, len(ws.UpdateBids))
@@ -709,6 +713,7 @@ func (e *Exchange) SynchroniseWebsocketOrderbook(ctx context.Context) {
 		for {
 			select {
 			case <-e.Websocket.ShutdownC:
+				e.obmWorkersRunning.Store(false)
 				for {
 					select {
 					case <-e.obm.jobs:
@@ -218,28 +218,48 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 		return err
 	}
 	err = e.Websocket.Setup(&websocket.ManagerSetup{
-		ExchangeConfig:        exch,
-		DefaultURL:            binanceDefaultWebsocketURL,
-		RunningURL:            ePoint,
-		Connector:             e.WsConnect,
-		Subscriber:            e.Subscribe,
-		Unsubscriber:          e.Unsubscribe,
-		GenerateSubscriptions: e.generateSubscriptions,
-		Features:              &e.Features.Supports.WebsocketCapabilities,
+		ExchangeConfig:                         exch,
+		Features:                               &e.Features.Supports.WebsocketCapabilities,
+		MaxWebsocketSubscriptionsPerConnection: 1024,
 		OrderbookBufferConfig: buffer.Config{
 			SortBuffer:            true,
 			SortBufferByUpdateIDs: true,
 		},
-		TradeFeed: e.Features.Enabled.TradeFeed,
+		TradeFeed:                    e.Features.Enabled.TradeFeed,
+		UseMultiConnectionManagement: true,
 	})
 	if err != nil {
 		return err
 	}
 
+	// Public
+	if err := e.Websocket.SetupNewConnection(&websocket.ConnectionSetup{
+		URL:                   ePoint,
+		ResponseCheckTimeout:  exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:      exch.WebsocketResponseMaxLimit,
+		RateLimit:             request.NewWeightedRateLimitByDuration(250 * time.Millisecond),
+		Connector:             e.WsConnect,
+		GenerateSubscriptions: e.generateSubscriptions,
+		Subscriber:            e.Subscribe,
+		Unsubscriber:          e.Unsubscribe,
+		Handler:               e.wsHandleData,
+	}); err != nil {
+		return err
+	}
+
+	// User data stream
 	return e.Websocket.SetupNewConnection(&websocket.ConnectionSetup{
-		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
-		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
-		RateLimit:            request.NewWeightedRateLimitByDuration(250 * time.Millisecond),
+		URL:                   ePoint,
+		ResponseCheckTimeout:  exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:      exch.WebsocketResponseMaxLimit,
+		RateLimit:             request.NewWeightedRateLimitByDuration(250 * time.Millisecond),
+		Authenticated:         true,
+		Connector:             e.wsConnectUserData,
+		GenerateSubscriptions: e.generateUserDataSubscriptions,
+		Subscriber:            e.subscribeUserData,
+		Unsubscriber:          e.unsubscribeUserData,
+		Handler:               e.wsHandleData,
+		MessageFilter:         userDataStreamConnection,
 	})
 }
 
@@ -702,6 +722,7 @@ func (e *Exchange) GetWithdrawalsHistory(ctx context.Context, c currency.Code, _
 			CryptoToAddress: withdrawals[i].Address,
 			CryptoTxID:      withdrawals[i].TransactionID,
 			CryptoChain:     withdrawals[i].Network,
+			Confirmations:   withdrawals[i].ConfirmNumber,
 			Timestamp:       withdrawals[i].ApplyTime.Time(),
 		}
 	}
@@ -1816,10 +1816,7 @@ var testOb = orderbook.Book{
 }
 
 func TestChecksum(t *testing.T) {
-	err := validateCRC32(&testOb, 190468240)
-	if err != nil {
-		t.Fatal(err)
-	}
+	assert.Equal(t, uint32(190468240), orderbookChecksum(&testOb), "orderbookChecksum should return the correct checksum")
 }
 
 func TestReOrderbyID(t *testing.T) {
@@ -6,8 +6,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"hash/crc32"
 	"net/http"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -1609,20 +1609,8 @@ func (e *Exchange) WsUpdateOrderbook(c *subscription.Subscription, p currency.Pa
 
 	if checkme.Sequence+1 == sequenceNo {
 		// Sequence numbers get dropped, if checksum is not in line with
-		// sequence, do not check.
-		ob, err := e.Websocket.Orderbook.GetOrderbook(p, assetType)
-		if err != nil {
-			return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s %w",
-				p,
-				assetType,
-				err)
-		}
-
-		if err = validateCRC32(ob, checkme.Token); err != nil {
-			log.Errorf(log.WebsocketMgr, "%s websocket orderbook update error, will resubscribe orderbook: %v", e.Name, err)
-			if e2 := e.resubOrderbook(c); e2 != nil {
-				log.Errorf(log.WebsocketMgr, "%s error resubscribing orderbook: %v", e.Name, e2)
-			}
+		// sequence, do not check. A mismatch invalidates and resyncs the book
+		if err := e.Websocket.Orderbook.ValidateChecksum(p, assetType, checkme.Token); err != nil {
 			return err
 		}
 	}
@@ -1630,31 +1618,6 @@ func (e *Exchange) WsUpdateOrderbook(c *subscription.Subscription, p currency.Pa
 	return e.Websocket.Orderbook.Update(&orderbookUpdate)
 }
 
-// resubOrderbook resubscribes the orderbook after a consistency error, probably a failed checksum,
-// which forces a fresh snapshot. If we don't do this the orderbook will keep erroring and drifting.
-// Flushing the orderbook happens immediately, but the ReSub itself is a go routine to avoid blocking the WS data channel
-func (e *Exchange) resubOrderbook(c *subscription.Subscription) error {
-	if c == nil {
-		return fmt.Errorf("%w: Subscription param", common.ErrNilPointer)
-	}
-	if len(c.Pairs) != 1 {
-		return subscription.ErrNotSinglePair
-	}
-	if err := e.Websocket.Orderbook.InvalidateOrderbook(c.Pairs[0], c.Asset); err != nil {
-		// Non-fatal error
-		log.Errorf(log.ExchangeSys, "%s error flushing orderbook: %v", e.Name, err)
-	}
-
-	// Resub will block so we have to do this in a goro
-	go func() {
-		if err := e.Websocket.ResubscribeToChannel(e.Websocket.Conn, c); err != nil {
-			log.Errorf(log.ExchangeSys, "%s error resubscribing orderbook: %v", e.Name, err)
-		}
-	}()
-
-	return nil
-}
-
 // generateSubscriptions returns a list of subscriptions from the configured subscriptions feature
 func (e *Exchange) generateSubscriptions() (subscription.List, error) {
 	return e.Features.Subscriptions.ExpandTemplates(e)
@@ -2042,62 +2005,34 @@ func makeRequestInterface(channelName string, data any) []any {
 	return []any{0, channelName, nil, data}
 }
 
-func validateCRC32(book *orderbook.Book, token uint32) error {
+// orderbookChecksum calculates the checksum of the top 25 bids and asks of a
+// raw book from their order IDs and signed amounts
+func orderbookChecksum(book *orderbook.Book) uint32 {
 	// Order ID's need to be sub-sorted in ascending order, this needs to be
-	// done on the main book to ensure that we do not cut price levels out below
-	reOrderByID(book.Bids)
-	reOrderByID(book.Asks)
-
-	// R0 precision calculation is based on order ID's and amount values
-	var bids, asks []orderbook.Level
-	for i := range 25 {
-		if i < len(book.Bids) {
-			bids = append(bids, book.Bids[i])
-		}
-		if i < len(book.Asks) {
-			asks = append(asks, book.Asks[i])
-		}
-	}
+	// done on the whole book to ensure that we do not cut price levels out
+	// below. The levels are copied so the stored book is not reordered
+	bids, asks := slices.Clone(book.Bids), slices.Clone(book.Asks)
+	reOrderByID(bids)
+	reOrderByID(asks)
 
 	// ensure '-' (negative amount) is passed back to string buffer as
 	// this is needed for calcs - These get swapped if funding rate
-	bidmod := float64(1)
+	bidMod, askMod := 1.0, -1.0
 	if book.IsFundingRate {
-		bidmod = -1
+		bidMod, askMod = -1, 1
 	}
-
-	askMod := float64(-1)
-	if book.IsFundingRate {
-		askMod = 1
+	for i := range bids {
+		bids[i].Amount *= bidMod
 	}
-
-	var check strings.Builder
-	for i := range 25 {
-		if i < len(bids) {
-			check.WriteString(strconv.FormatInt(bids[i].ID, 10))
-			check.WriteString(":")
-			check.WriteString(strconv.FormatFloat(bidmod*bids[i].Amount, 'f', -1, 64))
-			check.WriteString(":")
-		}
-
-		if i < len(asks) {
-			check.WriteString(strconv.FormatInt(asks[i].ID, 10))
-			check.WriteString(":")
-			check.WriteString(strconv.FormatFloat(askMod*asks[i].Amount, 'f', -1, 64))
-			check.WriteString(":")
-		}
+	for i := range asks {
+		asks[i].Amount *= askMod
 	}
+	return orderbook.InterleavedChecksum(bids, asks, 25, ":", checksumLevel)
+}
 
-	checksumStr := strings.TrimSuffix(check.String(), ":")
-	checksum := crc32.ChecksumIEEE([]byte(checksumStr))
-	if checksum == token {
-		return nil
-	}
-	return fmt.Errorf("invalid checksum for %s %s: calculated [%d] does not match [%d]",
-		book.Asset,
-		book.Pair,
-		checksum,
-		token)
+// checksumLevel formats a level as ID:amount for checksum calculation
+func checksumLevel(l *orderbook.Level) string {
+	return strconv.FormatInt(l.ID, 10) + ":" + orderbook.FormatChecksumFloat(l.Amount)
 }
 
 // reOrderByID sub sorts orderbook items by its corresponding ID when price
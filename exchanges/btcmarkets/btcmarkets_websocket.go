@@ -5,10 +5,8 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"hash/crc32"
 	"net/http"
 	"strconv"
-	"strings"
 	"text/template"
 	"time"
 
@@ -139,12 +137,12 @@ func (e *Exchange) wsHandleData(ctx context.Context, respRaw []byte) error {
 				Asks:                       orderbook.Levels(ob.Asks),
 				Pair:                       ob.Currency,
 				ExpectedChecksum:           ob.Checksum,
-				GenerateChecksum:           orderbookChecksum,
 				SkipOutOfOrderLastUpdateID: true,
 			})
 		}
 		if err != nil {
-			if errors.Is(err, orderbook.ErrOrderbookInvalid) {
+			// Checksum mismatches are resynced by the orderbook buffer
+			if errors.Is(err, orderbook.ErrOrderbookInvalid) && !errors.Is(err, orderbook.ErrChecksumMismatch) {
 				err2 := e.ReSubscribeSpecificOrderbook(ob.Currency)
 				if err2 != nil {
 					return err2
@@ -418,26 +416,25 @@ func (e *Exchange) ReSubscribeSpecificOrderbook(pair currency.Pair) error {
 	return e.Subscribe(sub)
 }
 
-// orderbookChecksum calculates a checksum for the orderbook liquidity
+// resyncOrderbook resubscribes to an orderbook after a checksum mismatch
+func (e *Exchange) resyncOrderbook(pair currency.Pair, _ asset.Item) error {
+	return e.ReSubscribeSpecificOrderbook(pair)
+}
+
+// orderbookChecksum calculates a checksum for the orderbook liquidity from
+// the top 10 bids followed by the top 10 asks
 func orderbookChecksum(ob *orderbook.Book) uint32 {
-	return crc32.ChecksumIEEE([]byte(concatOrderbookLiquidity(ob.Bids) + concatOrderbookLiquidity(ob.Asks)))
+	return orderbook.ConcatenatedChecksum(ob.Bids, ob.Asks, 10, checksumLevel)
 }
 
-// concatOrderbookLiquidity concatenates price and amounts together for checksum processing
-func concatOrderbookLiquidity(liquidity orderbook.Levels) string {
-	var c string
-	for x := range min(10, len(liquidity)) {
-		c += trim(liquidity[x].Price) + trim(liquidity[x].Amount)
-	}
-	return c
+// checksumLevel concatenates price and amount together for checksum processing
+func checksumLevel(l *orderbook.Level) string {
+	return trim(l.Price) + trim(l.Amount)
 }
 
 // trim turns value into string, removes the decimal point and all the leading zeros
 func trim(value float64) string {
-	valstr := strconv.FormatFloat(value, 'f', -1, 64)
-	valstr = strings.ReplaceAll(valstr, ".", "")
-	valstr = strings.TrimLeft(valstr, "0")
-	return valstr
+	return orderbook.TrimChecksumValue(orderbook.FormatChecksumFloat(value))
 }
 
 func channelName(s *subscription.Subscription) string {
@@ -162,6 +162,8 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 		Features:              &e.Features.Supports.WebsocketCapabilities,
 		OrderbookBufferConfig: buffer.Config{
 			SortBuffer: true,
+			Checksum:   orderbookChecksum,
+			Resync:     e.resyncOrderbook,
 		},
 	})
 	if err != nil {
@@ -127,6 +127,7 @@ type FundingHistory struct {
 	CryptoFromAddress string
 	CryptoTxID        string
 	CryptoChain       string
+	Confirmations     int64
 	BankTo            string
 	BankFrom          string
 }
@@ -144,6 +145,7 @@ type WithdrawalHistory struct {
 	CryptoToAddress string
 	CryptoTxID      string
 	CryptoChain     string
+	Confirmations   int64
 	BankTo          string
 }
 
@@ -231,6 +231,38 @@ func (c *PositionController) GetAllOpenPositions() ([]Position, error) {
 	return openPositions, nil
 }
 
+// GetRealisedPNLSince returns the realised PNL, minus fees, of all orders
+// tracked for an exchange since the provided time
+func (c *PositionController) GetRealisedPNLSince(exch string, since time.Time) (decimal.Decimal, error) {
+	if c == nil {
+		return decimal.Zero, fmt.Errorf("position controller %w", common.ErrNilPointer)
+	}
+	if exch == "" {
+		return decimal.Zero, errExchangeNameEmpty
+	}
+	exch = strings.ToLower(exch)
+	c.m.Lock()
+	defer c.m.Unlock()
+	var realisedPNL decimal.Decimal
+	for k, multiPositionTracker := range c.multiPositionTrackers {
+		if k.Exchange != exch {
+			continue
+		}
+		positions := multiPositionTracker.GetPositions()
+		for i := range positions {
+			pnlHistory := make([]PNLResult, 0, len(positions[i].PNLHistory))
+			for j := range positions[i].PNLHistory {
+				if positions[i].PNLHistory[j].Time.Before(since) {
+					continue
+				}
+				pnlHistory = append(pnlHistory, positions[i].PNLHistory[j])
+			}
+			realisedPNL = realisedPNL.Add(calculateRealisedPNL(pnlHistory))
+		}
+	}
+	return realisedPNL, nil
+}
+
 // UpdateOpenPositionUnrealisedPNL finds an open position from
 // an exchange asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
@@ -2,6 +2,7 @@ package futures
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -1084,6 +1085,51 @@ func TestGetAllOpenPositions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetRealisedPNLSince(t *testing.T) {
+	t.Parallel()
+	var nilPC *PositionController
+	_, err := nilPC.GetRealisedPNLSince(testExchange, time.Time{})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	pc := SetupPositionController()
+	_, err = pc.GetRealisedPNLSince("", time.Time{})
+	assert.ErrorIs(t, err, errExchangeNameEmpty)
+
+	pnl, err := pc.GetRealisedPNLSince(testExchange, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, pnl.IsZero(), "realised PNL should be zero with no positions")
+
+	cp := currency.NewPair(currency.BTC, currency.PERP)
+	tn := time.Now()
+	err = pc.TrackNewOrder(&order.Detail{
+		Date:      tn,
+		Exchange:  testExchange,
+		Pair:      cp,
+		AssetType: asset.Futures,
+		Side:      order.Long,
+		OrderID:   "lol",
+		Price:     1337,
+		Amount:    1337,
+	})
+	require.NoError(t, err)
+
+	for _, tracker := range pc.multiPositionTrackers {
+		tracker.positions[0].pnlHistory = []PNLResult{
+			{Time: tn.Add(-time.Hour), IsOrder: true, RealisedPNLBeforeFees: decimal.NewFromInt(-100)},
+			{Time: tn, IsOrder: true, RealisedPNLBeforeFees: decimal.NewFromInt(-10), Fee: decimal.NewFromInt(1)},
+			{Time: tn, RealisedPNLBeforeFees: decimal.NewFromInt(-1000)},
+		}
+	}
+
+	pnl, err = pc.GetRealisedPNLSince(strings.ToUpper(testExchange), tn.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "-11", pnl.String(), "GetRealisedPNLSince should only include orders since the provided time")
+
+	pnl, err = pc.GetRealisedPNLSince(testExchange, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "-111", pnl.String(), "GetRealisedPNLSince should include all orders")
+}
+
 func TestPCTrackFundingDetails(t *testing.T) {
 	t.Parallel()
 	pc := SetupPositionController()
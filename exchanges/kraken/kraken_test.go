@@ -1405,20 +1405,7 @@ const krakenAPIDocChecksum = 974947235
 
 func TestChecksumCalculation(t *testing.T) {
 	t.Parallel()
-	expected := "5005"
-	if v := trim("0.05005"); v != expected {
-		t.Errorf("expected %s but received %s", expected, v)
-	}
-
-	expected = "500"
-	if v := trim("0.00000500"); v != expected {
-		t.Errorf("expected %s but received %s", expected, v)
-	}
-
-	err := validateCRC32(&testOb, krakenAPIDocChecksum)
-	if err != nil {
-		t.Error(err)
-	}
+	assert.Equal(t, uint32(krakenAPIDocChecksum), orderbookChecksum(&testOb), "orderbookChecksum should match the API documentation")
 }
 
 func TestGetCharts(t *testing.T) {
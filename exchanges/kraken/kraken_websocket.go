@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"hash/crc32"
 	"net/http"
 	"strconv"
 	"strings"
@@ -80,7 +79,6 @@ func init() {
 var (
 	errCancellingOrder = errors.New("error cancelling order")
 	errSubPairMissing  = errors.New("pair missing from subscription response")
-	errInvalidChecksum = errors.New("invalid checksum")
 )
 
 var defaultSubscriptions = subscription.List{
@@ -547,16 +545,7 @@ func (e *Exchange) wsProcessOrderBook(c string, response []json.RawMessage, pair
 			copy(update.Bids, update2.Bids)
 			update.Checksum = update2.Checksum
 		}
-		err := e.wsProcessOrderBookUpdate(pair, &update)
-		if errors.Is(err, errInvalidChecksum) {
-			log.Debugf(log.Global, "%s Resubscribing to invalid %s orderbook", e.Name, pair)
-			go func() {
-				if e2 := e.Websocket.ResubscribeToChannel(e.Websocket.Conn, s); e2 != nil && !errors.Is(e2, subscription.ErrInStateAlready) {
-					log.Errorf(log.ExchangeSys, "%s resubscription failure for %v: %v", e.Name, pair, e2)
-				}
-			}()
-		}
-		return err
+		return e.wsProcessOrderBookUpdate(pair, &update)
 	}
 
 	var snapshot wsSnapshot
@@ -612,10 +601,11 @@ func (e *Exchange) wsProcessOrderBookPartial(pair currency.Pair, obSnapshot *wsS
 // wsProcessOrderBookUpdate updates an orderbook entry for a given currency pair
 func (e *Exchange) wsProcessOrderBookUpdate(pair currency.Pair, wsUpdt *wsUpdate) error {
 	obUpdate := orderbook.Update{
-		Asset: asset.Spot,
-		Pair:  pair,
-		Bids:  make(orderbook.Levels, len(wsUpdt.Bids)),
-		Asks:  make(orderbook.Levels, len(wsUpdt.Asks)),
+		Asset:            asset.Spot,
+		Pair:             pair,
+		Bids:             make(orderbook.Levels, len(wsUpdt.Bids)),
+		Asks:             make(orderbook.Levels, len(wsUpdt.Asks)),
+		ExpectedChecksum: wsUpdt.Checksum,
 	}
 
 	// Calculating checksum requires incoming decimal place checks for both
@@ -650,49 +640,19 @@ func (e *Exchange) wsProcessOrderBookUpdate(pair currency.Pair, wsUpdt *wsUpdate
 	}
 	obUpdate.UpdateTime = highestLastUpdate
 
-	err := e.Websocket.Orderbook.Update(&obUpdate)
-	if err != nil {
-		return err
-	}
-
-	book, err := e.Websocket.Orderbook.GetOrderbook(pair, asset.Spot)
-	if err != nil {
-		return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s %w", pair, asset.Spot, err)
-	}
-
-	return validateCRC32(book, wsUpdt.Checksum)
+	return e.Websocket.Orderbook.Update(&obUpdate)
 }
 
-func validateCRC32(b *orderbook.Book, token uint32) error {
-	if b == nil {
-		return common.ErrNilPointer
-	}
-	var checkStr strings.Builder
-	for i := 0; i < 10 && i < len(b.Asks); i++ {
-		_, err := checkStr.WriteString(trim(b.Asks[i].StrPrice + trim(b.Asks[i].StrAmount)))
-		if err != nil {
-			return err
-		}
-	}
-
-	for i := 0; i < 10 && i < len(b.Bids); i++ {
-		_, err := checkStr.WriteString(trim(b.Bids[i].StrPrice) + trim(b.Bids[i].StrAmount))
-		if err != nil {
-			return err
-		}
-	}
-
-	if check := crc32.ChecksumIEEE([]byte(checkStr.String())); check != token {
-		return fmt.Errorf("%s %s %w %d, expected %d", b.Pair, b.Asset, errInvalidChecksum, check, token)
-	}
-	return nil
+// orderbookChecksum calculates the checksum of the top 10 asks followed by the
+// top 10 bids, using the price and amount strings with their decimal points
+// and leading zeros removed
+func orderbookChecksum(b *orderbook.Book) uint32 {
+	return orderbook.ConcatenatedChecksum(b.Asks, b.Bids, 10, checksumLevel)
 }
 
-// trim removes '.' and prefixed '0' from subsequent string
-func trim(s string) string {
-	s = strings.Replace(s, ".", "", 1)
-	s = strings.TrimLeft(s, "0")
-	return s
+// checksumLevel formats a level for checksum calculation
+func checksumLevel(l *orderbook.Level) string {
+	return orderbook.TrimChecksumValue(l.StrPrice) + orderbook.TrimChecksumValue(l.StrAmount)
 }
 
 // wsProcessCandle converts candle data and sends it to the data handler
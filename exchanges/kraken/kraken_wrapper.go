@@ -202,7 +202,11 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 		Unsubscriber:          e.Unsubscribe,
 		GenerateSubscriptions: e.generateSubscriptions,
 		Features:              &e.Features.Supports.WebsocketCapabilities,
-		OrderbookBufferConfig: buffer.Config{SortBuffer: true},
+		OrderbookBufferConfig: buffer.Config{
+			SortBuffer: true,
+			Checksum:   orderbookChecksum,
+			Resync:     e.Websocket.ResubscribeOrderbook,
+		},
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,235 @@
+package mock
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Websocket frame directions
+const (
+	// WebsocketInbound is a frame sent by the exchange and received by the client
+	WebsocketInbound = "inbound"
+	// WebsocketOutbound is a frame sent by the client to the exchange
+	WebsocketOutbound = "outbound"
+)
+
+var (
+	errWebsocketRecorderClosed  = errors.New("websocket recorder is closed")
+	errInvalidFrameDirection    = errors.New("invalid websocket frame direction")
+	errNoWebsocketFrames        = errors.New("no websocket frames recorded")
+	errWebsocketRecordingNoPath = errors.New("no path to websocket recording file found")
+)
+
+// WebsocketFrame defines a websocket message captured by a WebsocketRecorder
+type WebsocketFrame struct {
+	// Offset is the time elapsed between the recording starting and the frame being sent or received
+	Offset    time.Duration `json:"offset"`
+	Direction string        `json:"direction"`
+	URL       string        `json:"url"`
+	// Data holds JSON payloads, any other payload is stored as Text
+	Data json.RawMessage `json:"data,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+// Payload returns the frame message as sent over the connection
+func (f *WebsocketFrame) Payload() []byte {
+	if len(f.Data) != 0 {
+		return f.Data
+	}
+	return []byte(f.Text)
+}
+
+// WebsocketRecorder writes websocket frames to a recording file, one JSON encoded frame per line
+type WebsocketRecorder struct {
+	m     sync.Mutex
+	w     io.WriteCloser
+	start time.Time
+}
+
+// NewWebsocketRecorder creates a recording file at path, replacing any existing recording
+func NewWebsocketRecorder(path string) (*WebsocketRecorder, error) {
+	if path == "" {
+		return nil, errWebsocketRecordingNoPath
+	}
+	w, err := file.Writer(path)
+	if err != nil {
+		return nil, err
+	}
+	return &WebsocketRecorder{w: w, start: time.Now()}, nil
+}
+
+// Record writes a frame for a message sent or received on the connection to url
+func (r *WebsocketRecorder) Record(direction, connURL string, payload []byte) error {
+	if direction != WebsocketInbound && direction != WebsocketOutbound {
+		return fmt.Errorf("%w: %q", errInvalidFrameDirection, direction)
+	}
+	frame := WebsocketFrame{Direction: direction, URL: removeQueryString(connURL)}
+	if json.Valid(payload) {
+		frame.Data = bytes.Clone(payload)
+	} else {
+		frame.Text = string(payload)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.w == nil {
+		return errWebsocketRecorderClosed
+	}
+	frame.Offset = time.Since(r.start)
+	line, err := json.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	_, err = r.w.Write(append(line, '\n'))
+	return err
+}
+
+// Close closes the recording file
+func (r *WebsocketRecorder) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.w == nil {
+		return errWebsocketRecorderClosed
+	}
+	err := r.w.Close()
+	r.w = nil
+	return err
+}
+
+// LoadWebsocketRecording reads the frames from a recording file
+func LoadWebsocketRecording(path string) ([]WebsocketFrame, error) {
+	if path == "" {
+		return nil, errWebsocketRecordingNoPath
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var frames []WebsocketFrame
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 16*1024*1024) // Orderbook snapshots can exceed the default token size
+	for s.Scan() {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var frame WebsocketFrame
+		if err := json.Unmarshal(s.Bytes(), &frame); err != nil {
+			return nil, fmt.Errorf("%s frame %d: %w", path, len(frames)+1, err)
+		}
+		if frame.Direction != WebsocketInbound && frame.Direction != WebsocketOutbound {
+			return nil, fmt.Errorf("%s frame %d: %w: %q", path, len(frames)+1, errInvalidFrameDirection, frame.Direction)
+		}
+		frames = append(frames, frame)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("%s: %w", path, errNoWebsocketFrames)
+	}
+	return frames, nil
+}
+
+// WebsocketReplayConfig defines how recorded frames are served by a replay handler
+type WebsocketReplayConfig struct {
+	// Timing sends inbound frames with the delays between them as recorded, otherwise they are sent immediately
+	Timing bool
+	// AwaitOutbound holds back the inbound frames recorded after an outbound frame until the client sends a message,
+	// so that responses such as subscription acknowledgements and snapshots follow the client's subscribe requests
+	AwaitOutbound bool
+}
+
+var replayUpgrader = gws.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }}
+
+// NewWebsocketReplayHandler returns a http.HandlerFunc which upgrades each request to a websocket connection and
+// replays the recorded inbound frames for the connection URL path. If no frames were recorded against the request
+// path, every frame is replayed
+func NewWebsocketReplayHandler(frames []WebsocketFrame, cfg *WebsocketReplayConfig) http.HandlerFunc {
+	if cfg == nil {
+		cfg = &WebsocketReplayConfig{}
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, err := replayUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Printf("websocket replay: upgrade error: %v", err)
+			return
+		}
+		defer c.Close()
+
+		received := make(chan struct{}, len(frames))
+		go func() {
+			for {
+				if _, _, err := c.ReadMessage(); err != nil {
+					close(received)
+					return
+				}
+				received <- struct{}{}
+			}
+		}()
+
+		var last time.Duration
+		for _, f := range framesForPath(frames, r.URL.Path) {
+			if f.Direction == WebsocketOutbound {
+				if !cfg.AwaitOutbound {
+					continue
+				}
+				if _, ok := <-received; !ok {
+					return
+				}
+				last = f.Offset
+				continue
+			}
+			if cfg.Timing && f.Offset > last {
+				time.Sleep(f.Offset - last)
+			}
+			last = f.Offset
+			if err := c.WriteMessage(gws.TextMessage, f.Payload()); err != nil {
+				return
+			}
+		}
+		// Hold the connection open until the client closes it, as an exchange would
+		for {
+			if _, ok := <-received; !ok {
+				return
+			}
+		}
+	}
+}
+
+// framesForPath returns the frames recorded against a URL path, or all frames if none match
+func framesForPath(frames []WebsocketFrame, path string) []WebsocketFrame {
+	var matched []WebsocketFrame
+	for i := range frames {
+		if u, err := url.Parse(frames[i].URL); err == nil && u.Path == path {
+			matched = append(matched, frames[i])
+		}
+	}
+	if len(matched) == 0 {
+		return frames
+	}
+	return matched
+}
+
+// removeQueryString strips any query string from a URL so that credentials and tokens are not recorded
+func removeQueryString(connURL string) string {
+	u, err := url.Parse(connURL)
+	if err != nil {
+		return connURL
+	}
+	u.RawQuery = ""
+	return u.String()
+}
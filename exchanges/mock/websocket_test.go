@@ -0,0 +1,122 @@
+package mock
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketRecorder("")
+	require.ErrorIs(t, err, errWebsocketRecordingNoPath)
+
+	path := filepath.Join(t.TempDir(), "testdata", "ws.json")
+	r, err := NewWebsocketRecorder(path)
+	require.NoError(t, err, "NewWebsocketRecorder must not error")
+
+	require.ErrorIs(t, r.Record("sideways", "wss://test", nil), errInvalidFrameDirection)
+	require.NoError(t, r.Record(WebsocketOutbound, "wss://test/ws?token=secret", []byte(`{"op":"subscribe"}`)), "Record must not error")
+	require.NoError(t, r.Record(WebsocketInbound, "wss://test/ws", []byte("pong")), "Record must not error")
+	require.NoError(t, r.Close(), "Close must not error")
+	require.ErrorIs(t, r.Record(WebsocketInbound, "wss://test/ws", nil), errWebsocketRecorderClosed)
+	require.ErrorIs(t, r.Close(), errWebsocketRecorderClosed)
+
+	frames, err := LoadWebsocketRecording(path)
+	require.NoError(t, err, "LoadWebsocketRecording must not error")
+	require.Len(t, frames, 2, "must load both frames")
+	assert.Equal(t, WebsocketOutbound, frames[0].Direction, "Direction should be outbound")
+	assert.Equal(t, "wss://test/ws", frames[0].URL, "URL should have the query string removed")
+	assert.JSONEq(t, `{"op":"subscribe"}`, string(frames[0].Payload()), "Payload should return the JSON data")
+	assert.Equal(t, WebsocketInbound, frames[1].Direction, "Direction should be inbound")
+	assert.Equal(t, "pong", string(frames[1].Payload()), "Payload should return the text")
+	assert.GreaterOrEqual(t, frames[1].Offset, frames[0].Offset, "Offset should increase")
+}
+
+func TestLoadWebsocketRecording(t *testing.T) {
+	t.Parallel()
+	_, err := LoadWebsocketRecording("")
+	require.ErrorIs(t, err, errWebsocketRecordingNoPath)
+
+	dir := t.TempDir()
+	_, err = LoadWebsocketRecording(filepath.Join(dir, "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	empty := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+	_, err = LoadWebsocketRecording(empty)
+	require.ErrorIs(t, err, errNoWebsocketFrames)
+
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"direction":"sideways"}`), 0o600))
+	_, err = LoadWebsocketRecording(invalid)
+	require.ErrorIs(t, err, errInvalidFrameDirection)
+
+	malformed := filepath.Join(dir, "malformed.json")
+	require.NoError(t, os.WriteFile(malformed, []byte(`{"direction":`), 0o600))
+	_, err = LoadWebsocketRecording(malformed)
+	require.Error(t, err, "LoadWebsocketRecording must error on malformed frames")
+}
+
+func TestNewWebsocketReplayHandler(t *testing.T) {
+	t.Parallel()
+	frames := []WebsocketFrame{
+		{Direction: WebsocketInbound, URL: "wss://test/ws", Text: "welcome"},
+		{Direction: WebsocketOutbound, URL: "wss://test/ws", Data: []byte(`{"op":"subscribe"}`)},
+		{Direction: WebsocketInbound, URL: "wss://test/ws", Data: []byte(`{"event":"subscribed"}`), Offset: time.Millisecond},
+		{Direction: WebsocketInbound, URL: "wss://test/private", Text: "private"},
+	}
+
+	s := httptest.NewServer(NewWebsocketReplayHandler(frames, &WebsocketReplayConfig{AwaitOutbound: true, Timing: true}))
+	defer s.Close()
+	wsURL := "ws" + strings.TrimPrefix(s.URL, "http")
+
+	c, resp, err := gws.DefaultDialer.Dial(wsURL+"/ws", nil)
+	require.NoError(t, err, "Dial must not error")
+	defer resp.Body.Close()
+	defer c.Close()
+
+	_, msg, err := c.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, "welcome", string(msg), "first inbound frame should be replayed on connection")
+
+	require.NoError(t, c.SetReadDeadline(time.Now().Add(50*time.Millisecond)))
+	_, _, err = c.ReadMessage()
+	require.Error(t, err, "frames after an outbound frame must be held until the client sends a message")
+	require.NoError(t, c.Close())
+
+	c, resp2, err := gws.DefaultDialer.Dial(wsURL+"/ws", nil)
+	require.NoError(t, err, "Dial must not error")
+	defer resp2.Body.Close()
+	defer c.Close()
+	_, msg, err = c.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, "welcome", string(msg), "each connection should replay from the start")
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"op":"subscribe"}`)))
+	_, msg, err = c.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.JSONEq(t, `{"event":"subscribed"}`, string(msg), "inbound frame should be replayed after the outbound message")
+
+	private, resp3, err := gws.DefaultDialer.Dial(wsURL+"/private", nil)
+	require.NoError(t, err, "Dial must not error")
+	defer resp3.Body.Close()
+	defer private.Close()
+	_, msg, err = private.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, "private", string(msg), "only frames recorded against the request path should be replayed")
+
+	all, resp4, err := gws.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err, "Dial must not error")
+	defer resp4.Body.Close()
+	defer all.Close()
+	_, msg, err = all.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, "welcome", string(msg), "all frames should be replayed when no frames match the request path")
+}
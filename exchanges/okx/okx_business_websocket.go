@@ -2,14 +2,11 @@ package okx
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"net/http"
-	"strconv"
 	"time"
 
 	gws "github.com/gorilla/websocket"
-	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
@@ -44,7 +41,7 @@ var (
 )
 
 // WsConnectBusiness connects to a business websocket channel.
-func (e *Exchange) WsConnectBusiness(ctx context.Context) error {
+func (e *Exchange) WsConnectBusiness(ctx context.Context, conn websocket.Connection) error {
 	if !e.Websocket.IsEnabled() || !e.IsEnabled() {
 		return websocket.ErrWebsocketNotEnabled
 	}
@@ -52,25 +49,20 @@ func (e *Exchange) WsConnectBusiness(ctx context.Context) error {
 	dialer.ReadBufferSize = 8192
 	dialer.WriteBufferSize = 8192
 
-	e.Websocket.Conn.SetURL(okxBusinessWebsocketURL)
-	err := e.Websocket.Conn.Dial(ctx, &dialer, http.Header{})
-	if err != nil {
+	conn.SetURL(okxBusinessWebsocketURL)
+	if err := conn.Dial(ctx, &dialer, http.Header{}); err != nil {
 		return err
 	}
-	e.Websocket.Wg.Add(1)
-	go e.wsReadData(ctx, e.Websocket.Conn)
 	if e.Verbose {
-		log.Debugf(log.ExchangeSys, "Successful connection to %v\n",
-			e.Websocket.GetWebsocketURL())
+		log.Debugf(log.ExchangeSys, "Successful connection to %v\n", conn.GetURL())
 	}
-	e.Websocket.Conn.SetupPingHandler(request.UnAuth, websocket.PingHandler{
+	conn.SetupPingHandler(request.UnAuth, websocket.PingHandler{
 		MessageType: gws.TextMessage,
 		Message:     pingMsg,
 		Delay:       time.Second * 20,
 	})
 	if e.Websocket.CanUseAuthenticatedEndpoints() {
-		err = e.WsSpreadAuth(ctx)
-		if err != nil {
+		if err := e.WsSpreadAuth(ctx, conn); err != nil {
 			log.Errorf(log.ExchangeSys, "Error connecting auth socket: %s\n", err.Error())
 			e.Websocket.SetCanUseAuthenticatedEndpoints(false)
 		}
@@ -78,34 +70,12 @@ func (e *Exchange) WsConnectBusiness(ctx context.Context) error {
 	return nil
 }
 
-// WsSpreadAuth will connect to Okx's Private websocket connection and Authenticate with a login payload.
-func (e *Exchange) WsSpreadAuth(ctx context.Context) error {
+// WsSpreadAuth authenticates a business websocket connection with a login payload.
+func (e *Exchange) WsSpreadAuth(ctx context.Context, conn websocket.Connection) error {
 	if !e.Websocket.CanUseAuthenticatedEndpoints() {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", e.Name)
 	}
-	creds, err := e.GetCredentials(ctx)
-	if err != nil {
-		return err
-	}
-	e.Websocket.SetCanUseAuthenticatedEndpoints(true)
-	ts := time.Now().Unix()
-	signPath := "/users/self/verify"
-	hmac, err := crypto.GetHMAC(crypto.HashSHA256,
-		[]byte(strconv.FormatInt(ts, 10)+http.MethodGet+signPath),
-		[]byte(creds.Secret),
-	)
-	if err != nil {
-		return err
-	}
-	args := []WebsocketLoginData{
-		{
-			APIKey:     creds.Key,
-			Passphrase: creds.ClientID,
-			Timestamp:  ts,
-			Sign:       base64.StdEncoding.EncodeToString(hmac),
-		},
-	}
-	return e.SendAuthenticatedWebsocketRequest(ctx, request.Unset, "login-response", operationLogin, args, nil)
+	return e.WsAuth(ctx, conn)
 }
 
 // GenerateDefaultBusinessSubscriptions returns a list of default subscriptions to business websocket.
@@ -158,18 +128,18 @@ func (e *Exchange) GenerateDefaultBusinessSubscriptions() ([]subscription.Subscr
 }
 
 // BusinessSubscribe sends a websocket subscription request to several channels to receive data.
-func (e *Exchange) BusinessSubscribe(ctx context.Context, channelsToSubscribe subscription.List) error {
-	return e.handleBusinessSubscription(ctx, operationSubscribe, channelsToSubscribe)
+func (e *Exchange) BusinessSubscribe(ctx context.Context, conn websocket.Connection, channelsToSubscribe subscription.List) error {
+	return e.handleBusinessSubscription(ctx, conn, operationSubscribe, channelsToSubscribe)
 }
 
 // BusinessUnsubscribe sends a websocket unsubscription request to several channels to receive data.
-func (e *Exchange) BusinessUnsubscribe(ctx context.Context, channelsToUnsubscribe subscription.List) error {
-	return e.handleBusinessSubscription(ctx, operationUnsubscribe, channelsToUnsubscribe)
+func (e *Exchange) BusinessUnsubscribe(ctx context.Context, conn websocket.Connection, channelsToUnsubscribe subscription.List) error {
+	return e.handleBusinessSubscription(ctx, conn, operationUnsubscribe, channelsToUnsubscribe)
 }
 
 // handleBusinessSubscription sends a subscription and unsubscription information thought the business websocket endpoint.
 // as of the okx, exchange this endpoint sends subscription and unsubscription messages but with a list of json objects.
-func (e *Exchange) handleBusinessSubscription(ctx context.Context, operation string, subscriptions subscription.List) error {
+func (e *Exchange) handleBusinessSubscription(ctx context.Context, conn websocket.Connection, operation string, subscriptions subscription.List) error {
 	wsSubscriptionReq := WSSubscriptionInformationList{Operation: operation}
 	var channels subscription.List
 	var authChannels subscription.List
@@ -210,14 +180,14 @@ func (e *Exchange) handleBusinessSubscription(ctx context.Context, operation str
 		}
 		if len(chunk) > maxConnByteLen {
 			i--
-			err = e.Websocket.Conn.SendJSONMessage(ctx, request.UnAuth, wsSubscriptionReq)
+			err = conn.SendJSONMessage(ctx, request.UnAuth, wsSubscriptionReq)
 			if err != nil {
 				return err
 			}
 			if operation == operationUnsubscribe {
-				err = e.Websocket.RemoveSubscriptions(e.Websocket.Conn, channels...)
+				err = e.Websocket.RemoveSubscriptions(conn, channels...)
 			} else {
-				err = e.Websocket.AddSuccessfulSubscriptions(e.Websocket.Conn, channels...)
+				err = e.Websocket.AddSuccessfulSubscriptions(conn, channels...)
 			}
 			if err != nil {
 				return err
@@ -227,17 +197,17 @@ func (e *Exchange) handleBusinessSubscription(ctx context.Context, operation str
 			continue
 		}
 	}
-	err = e.Websocket.Conn.SendJSONMessage(ctx, request.UnAuth, wsSubscriptionReq)
+	err = conn.SendJSONMessage(ctx, request.UnAuth, wsSubscriptionReq)
 	if err != nil {
 		return err
 	}
 
 	if operation == operationUnsubscribe {
 		channels = append(channels, authChannels...)
-		err = e.Websocket.RemoveSubscriptions(e.Websocket.Conn, channels...)
+		err = e.Websocket.RemoveSubscriptions(conn, channels...)
 	} else {
 		channels = append(channels, authChannels...)
-		err = e.Websocket.AddSuccessfulSubscriptions(e.Websocket.Conn, channels...)
+		err = e.Websocket.AddSuccessfulSubscriptions(conn, channels...)
 	}
 	return err
 }
@@ -7,11 +7,13 @@ import (
 	"log"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	gws "github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -34,6 +36,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	testexch "github.com/thrasher-corp/gocryptotrader/internal/testing/exchange"
 	testsubs "github.com/thrasher-corp/gocryptotrader/internal/testing/subscriptions"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -3915,7 +3918,9 @@ func TestOrderPushData(t *testing.T) {
 	t.Parallel()
 	e := new(Exchange) //nolint:govet // Intentional shadow
 	require.NoError(t, testexch.Setup(e), "Test instance Setup must not error")
-	testexch.FixtureToDataHandler(t, "testdata/wsOrders.json", e.WsHandleData)
+	testexch.FixtureToDataHandler(t, "testdata/wsOrders.json", func(ctx context.Context, r []byte) error {
+		return e.WsHandleData(ctx, nil, r)
+	})
 	close(e.Websocket.DataHandler)
 	require.Len(t, e.Websocket.DataHandler, 4, "Should see 4 orders")
 	for resp := range e.Websocket.DataHandler {
@@ -4028,7 +4033,7 @@ func TestPushData(t *testing.T) {
 			e.API.AuthenticatedSupport = false
 			e.API.AuthenticatedWebsocketSupport = false
 		}
-		err := e.WsHandleData(t.Context(), []byte(pushDataMap[x]))
+		err := e.WsHandleData(t.Context(), nil, []byte(pushDataMap[x]))
 		require.NoErrorf(t, err, "Okx %s error %s", x, err)
 	}
 }
@@ -4042,7 +4047,7 @@ func TestPushDataDynamic(t *testing.T) {
 	}
 	var err error
 	for x := range dataMap {
-		err = e.WsHandleData(t.Context(), []byte(dataMap[x]))
+		err = e.WsHandleData(t.Context(), nil, []byte(dataMap[x]))
 		require.NoError(t, err)
 	}
 }
@@ -4068,7 +4073,7 @@ func TestWSProcessTrades(t *testing.T) {
 	p := currency.NewPairWithDelimiter("BTC", "USDT", currency.DashDelimiter)
 
 	for _, a := range assets {
-		err := e.Websocket.AddSubscriptions(e.Websocket.Conn, &subscription.Subscription{
+		err := e.Websocket.AddSubscriptions(nil, &subscription.Subscription{
 			Asset:   a,
 			Pairs:   currency.Pairs{p},
 			Channel: subscription.AllTradesChannel,
@@ -4076,7 +4081,9 @@ func TestWSProcessTrades(t *testing.T) {
 		})
 		require.NoError(t, err, "AddSubscriptions must not error")
 	}
-	testexch.FixtureToDataHandler(t, "testdata/wsAllTrades.json", e.WsHandleData)
+	testexch.FixtureToDataHandler(t, "testdata/wsAllTrades.json", func(ctx context.Context, r []byte) error {
+		return e.WsHandleData(ctx, nil, r)
+	})
 
 	exp := []trade.Data{
 		{
@@ -6093,6 +6100,31 @@ func TestGenerateSubscriptions(t *testing.T) {
 	testsubs.EqualLists(t, exp, subs)
 }
 
+// TestSubscribeShardsConnections ensures subscriptions beyond the per connection limit are spread across further connections
+func TestSubscribeShardsConnections(t *testing.T) {
+	t.Parallel()
+
+	e := testexch.MockWsInstance[Exchange](t, mockws.CurryWsMockUpgrader(t, func(testing.TB, []byte, *gws.Conn) error { return nil })) //nolint:govet // Intentional shadow
+	t.Cleanup(func() { assert.NoError(t, e.Websocket.Shutdown(), "Shutdown should not error") })
+
+	pairs := make(currency.Pairs, 300)
+	for i := range pairs {
+		pairs[i] = currency.NewPairWithDelimiter("SHARD"+strconv.Itoa(i), "USDT", "-")
+	}
+	require.NoError(t, e.CurrencyPairs.StorePairs(asset.Spot, pairs, false), "StorePairs must not error for available pairs")
+	require.NoError(t, e.CurrencyPairs.StorePairs(asset.Spot, pairs, true), "StorePairs must not error for enabled pairs")
+	e.Features.Subscriptions = subscription.List{{Enabled: true, Asset: asset.Spot, Channel: subscription.TickerChannel}}
+	require.NoError(t, e.Websocket.FlushChannels(), "FlushChannels must not error")
+
+	info := e.Websocket.GetConnectionInfo()
+	require.Len(t, info, 2, "subscriptions past the limit must be spread across two connections")
+	for i := range info {
+		assert.Equal(t, 150, info[i].Subscriptions, "subscriptions should be balanced across connections")
+		assert.True(t, info[i].Connected, "connection should be connected")
+	}
+	assert.Len(t, e.Websocket.GetSubscriptions(), len(pairs), "GetSubscriptions should return subscriptions across all connections")
+}
+
 const (
 	processSpreadOrderbookJSON      = `{"arg":{"channel":"sprd-books5", "sprdId": "BTC-USDT_BTC-USDT-SWAP" }, "data": [ { "asks": [ ["111.06","55154","2"], ["111.07","53276","2"], ["111.08","72435","2"], ["111.09","70312","2"], ["111.1","67272","2"]], "bids": [ ["111.05","57745","2"], ["111.04","57109","2"], ["111.03","69563","2"], ["111.02","71248","2"], ["111.01","65090","2"]], "ts": "1670324386802"}]}`
 	wsProcessPublicSpreadTradesJSON = `{"arg":{"channel":"sprd-public-trades", "sprdId": "BTC-USDT_BTC-USDT-SWAP" }, "data": [ { "sprdId": "BTC-USDT_BTC-USDT-SWAP", "tradeId": "2499206329160695808", "px": "-10", "sz": "0.001", "side": "sell", "ts": "1726801105519"}]}`
@@ -1428,7 +1428,7 @@ type DepositHistoryResponseItem struct {
 	DepositID           string       `json:"depId"`
 	AreaCodeFrom        string       `json:"areaCodeFrom"`
 	FromWithdrawalID    string       `json:"fromWdId"`
-	ActualDepBlkConfirm string       `json:"actualDepBlkConfirm"`
+	ActualDepBlkConfirm types.Number `json:"actualDepBlkConfirm"`
 }
 
 // WithdrawalInput represents request parameters for cryptocurrency withdrawal
@@ -219,6 +219,9 @@ const (
 	copyTrading = "copytrading-notification"
 )
 
+// privateConnection is the message filter of the private connection, which websocket trading requests are sent on
+const privateConnection = "private"
+
 var defaultSubscriptions = subscription.List{
 	{Enabled: true, Asset: asset.All, Channel: subscription.AllTradesChannel},
 	{Enabled: true, Asset: asset.All, Channel: subscription.OrderbookChannel},
@@ -236,8 +239,7 @@ var subscriptionNames = map[string]string{
 }
 
 // WsConnect initiates a websocket connection
-func (e *Exchange) WsConnect() error {
-	ctx := context.TODO()
+func (e *Exchange) WsConnect(ctx context.Context, conn websocket.Connection) error {
 	if !e.Websocket.IsEnabled() || !e.IsEnabled() {
 		return websocket.ErrWebsocketNotEnabled
 	}
@@ -245,54 +247,26 @@ func (e *Exchange) WsConnect() error {
 	dialer.ReadBufferSize = 8192
 	dialer.WriteBufferSize = 8192
 
-	err := e.Websocket.Conn.Dial(ctx, &dialer, http.Header{})
-	if err != nil {
+	if err := conn.Dial(ctx, &dialer, http.Header{}); err != nil {
 		return err
 	}
-	e.Websocket.Wg.Add(1)
-	go e.wsReadData(ctx, e.Websocket.Conn)
 	if e.Verbose {
-		log.Debugf(log.ExchangeSys, "Successful connection to %v\n",
-			e.Websocket.GetWebsocketURL())
+		log.Debugf(log.ExchangeSys, "Successful connection to %v\n", conn.GetURL())
 	}
-	e.Websocket.Conn.SetupPingHandler(request.Unset, websocket.PingHandler{
+	conn.SetupPingHandler(request.Unset, websocket.PingHandler{
 		MessageType: gws.TextMessage,
 		Message:     pingMsg,
 		Delay:       time.Second * 20,
 	})
-	if e.Websocket.CanUseAuthenticatedEndpoints() {
-		err = e.WsAuth(ctx)
-		if err != nil {
-			log.Errorf(log.ExchangeSys, "Error connecting auth socket: %s\n", err.Error())
-			e.Websocket.SetCanUseAuthenticatedEndpoints(false)
-		}
-	}
 	return nil
 }
 
-// WsAuth will connect to Okx's Private websocket connection and Authenticate with a login payload.
-func (e *Exchange) WsAuth(ctx context.Context) error {
-	if !e.AreCredentialsValid(ctx) || !e.Websocket.CanUseAuthenticatedEndpoints() {
-		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", e.Name)
-	}
+// WsAuth authenticates a private websocket connection with a login payload
+func (e *Exchange) WsAuth(ctx context.Context, conn websocket.Connection) error {
 	creds, err := e.GetCredentials(ctx)
 	if err != nil {
 		return err
 	}
-	var dialer gws.Dialer
-	err = e.Websocket.AuthConn.Dial(ctx, &dialer, http.Header{})
-	if err != nil {
-		return err
-	}
-	e.Websocket.Wg.Add(1)
-	go e.wsReadData(ctx, e.Websocket.AuthConn)
-	e.Websocket.AuthConn.SetupPingHandler(request.Unset, websocket.PingHandler{
-		MessageType: gws.TextMessage,
-		Message:     pingMsg,
-		Delay:       time.Second * 20,
-	})
-
-	e.Websocket.SetCanUseAuthenticatedEndpoints(true)
 	ts := time.Now().Unix()
 	signPath := "/users/self/verify"
 	hmac, err := crypto.GetHMAC(crypto.HashSHA256,
@@ -312,127 +286,88 @@ func (e *Exchange) WsAuth(ctx context.Context) error {
 		},
 	}
 
-	return e.SendAuthenticatedWebsocketRequest(ctx, request.Unset, "login-response", operationLogin, args, nil)
+	return e.sendWebsocketRequest(ctx, conn, request.Unset, "login-response", operationLogin, args, nil)
 }
 
-// wsReadData sends msgs from public and auth websockets to data handler
-func (e *Exchange) wsReadData(ctx context.Context, ws websocket.Connection) {
-	defer e.Websocket.Wg.Done()
-	for {
-		resp := ws.ReadMessage()
-		if resp.Raw == nil {
-			return
-		}
-		if err := e.WsHandleData(ctx, resp.Raw); err != nil {
-			e.Websocket.DataHandler <- err
-		}
+// generatePublicSubscriptions returns the configured subscriptions which are sent on the public connection
+func (e *Exchange) generatePublicSubscriptions() (subscription.List, error) {
+	subs, err := e.generateSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	return subs.Public(), nil
+}
+
+// generatePrivateSubscriptions returns the configured subscriptions which are sent on the private connection
+func (e *Exchange) generatePrivateSubscriptions() (subscription.List, error) {
+	subs, err := e.generateSubscriptions()
+	if err != nil {
+		return nil, err
 	}
+	return subs.Private(), nil
 }
 
 // Subscribe sends a websocket subscription request to several channels to receive data.
-func (e *Exchange) Subscribe(channelsToSubscribe subscription.List) error {
-	ctx := context.TODO()
-	return e.handleSubscription(ctx, operationSubscribe, channelsToSubscribe)
+func (e *Exchange) Subscribe(ctx context.Context, conn websocket.Connection, channelsToSubscribe subscription.List) error {
+	return e.handleSubscription(ctx, conn, operationSubscribe, channelsToSubscribe)
 }
 
 // Unsubscribe sends a websocket unsubscription request to several channels to receive data.
-func (e *Exchange) Unsubscribe(channelsToUnsubscribe subscription.List) error {
-	ctx := context.TODO()
-	return e.handleSubscription(ctx, operationUnsubscribe, channelsToUnsubscribe)
+func (e *Exchange) Unsubscribe(ctx context.Context, conn websocket.Connection, channelsToUnsubscribe subscription.List) error {
+	return e.handleSubscription(ctx, conn, operationUnsubscribe, channelsToUnsubscribe)
 }
 
 // handleSubscription sends a subscription and unsubscription information thought the websocket endpoint.
 // as of the okx, exchange this endpoint sends subscription and unsubscription messages but with a list of json objects.
-func (e *Exchange) handleSubscription(ctx context.Context, operation string, subs subscription.List) error {
+func (e *Exchange) handleSubscription(ctx context.Context, conn websocket.Connection, operation string, subs subscription.List) error {
 	reqs := WSSubscriptionInformationList{Operation: operation}
-	authRequests := WSSubscriptionInformationList{Operation: operation}
 	var channels subscription.List
-	var authChannels subscription.List
 	var errs error
 	for i := 0; i < len(subs); i++ {
-		s := subs[i]
 		var arg SubscriptionInfo
-		if err := json.Unmarshal([]byte(s.QualifiedChannel), &arg); err != nil {
+		if err := json.Unmarshal([]byte(subs[i].QualifiedChannel), &arg); err != nil {
 			errs = common.AppendError(errs, err)
 			continue
 		}
-
-		if s.Authenticated {
-			authChannels = append(authChannels, s)
-			authRequests.Arguments = append(authRequests.Arguments, arg)
-			authChunk, err := json.Marshal(authRequests)
-			if err != nil {
-				return err
-			}
-			if len(authChunk) > maxConnByteLen {
-				authRequests.Arguments = authRequests.Arguments[:len(authRequests.Arguments)-1]
-				i--
-				err = e.Websocket.AuthConn.SendJSONMessage(ctx, request.Unset, authRequests)
-				if err != nil {
-					return err
-				}
-				if operation == operationUnsubscribe {
-					err = e.Websocket.RemoveSubscriptions(e.Websocket.AuthConn, channels...)
-				} else {
-					err = e.Websocket.AddSuccessfulSubscriptions(e.Websocket.AuthConn, channels...)
-				}
-				if err != nil {
-					return err
-				}
-				authChannels = subscription.List{}
-				authRequests.Arguments = []SubscriptionInfo{}
-			}
-		} else {
-			channels = append(channels, s)
-			reqs.Arguments = append(reqs.Arguments, arg)
-			chunk, err := json.Marshal(reqs)
-			if err != nil {
+		reqs.Arguments = append(reqs.Arguments, arg)
+		chunk, err := json.Marshal(reqs)
+		if err != nil {
+			return err
+		}
+		if len(chunk) > maxConnByteLen && len(channels) > 0 {
+			// Send the arguments which fit within the limit and carry this one over to the next request
+			reqs.Arguments = reqs.Arguments[:len(reqs.Arguments)-1]
+			if err := e.sendSubscriptionRequest(ctx, conn, reqs, channels); err != nil {
 				return err
 			}
-			if len(chunk) > maxConnByteLen {
-				i--
-				err = e.Websocket.Conn.SendJSONMessage(ctx, request.Unset, reqs)
-				if err != nil {
-					return err
-				}
-				if operation == operationUnsubscribe {
-					err = e.Websocket.RemoveSubscriptions(e.Websocket.Conn, channels...)
-				} else {
-					err = e.Websocket.AddSuccessfulSubscriptions(e.Websocket.Conn, channels...)
-				}
-				if err != nil {
-					return err
-				}
-				channels = subscription.List{}
-				reqs.Arguments = []SubscriptionInfo{}
-				continue
-			}
+			channels = subscription.List{}
+			reqs.Arguments = []SubscriptionInfo{}
+			i--
+			continue
 		}
+		channels = append(channels, subs[i])
 	}
-
-	if len(reqs.Arguments) > 0 {
-		if err := e.Websocket.Conn.SendJSONMessage(ctx, request.Unset, reqs); err != nil {
-			return err
-		}
+	if len(channels) > 0 {
+		errs = common.AppendError(errs, e.sendSubscriptionRequest(ctx, conn, reqs, channels))
 	}
+	return errs
+}
 
-	if len(authRequests.Arguments) > 0 && e.Websocket.CanUseAuthenticatedEndpoints() {
-		if err := e.Websocket.AuthConn.SendJSONMessage(ctx, request.Unset, authRequests); err != nil {
-			return err
-		}
+// sendSubscriptionRequest sends a subscription or unsubscription request and updates the connection's subscriptions
+func (e *Exchange) sendSubscriptionRequest(ctx context.Context, conn websocket.Connection, req WSSubscriptionInformationList, channels subscription.List) error {
+	if err := conn.SendJSONMessage(ctx, request.Unset, req); err != nil {
+		return err
 	}
-
-	channels = append(channels, authChannels...)
-	if operation == operationUnsubscribe {
-		return e.Websocket.RemoveSubscriptions(e.Websocket.Conn, channels...)
+	if req.Operation == operationUnsubscribe {
+		return e.Websocket.RemoveSubscriptions(conn, channels...)
 	}
-	return e.Websocket.AddSuccessfulSubscriptions(e.Websocket.Conn, channels...)
+	return e.Websocket.AddSuccessfulSubscriptions(conn, channels...)
 }
 
 // WsHandleData will read websocket raw data and pass to appropriate handler
-func (e *Exchange) WsHandleData(ctx context.Context, respRaw []byte) error {
+func (e *Exchange) WsHandleData(ctx context.Context, conn websocket.Connection, respRaw []byte) error {
 	if id, _ := jsonparser.GetString(respRaw, "id"); id != "" {
-		return e.Websocket.Match.RequireMatchWithData(id, respRaw)
+		return conn.RequireMatchWithData(id, respRaw)
 	}
 
 	var resp wsIncomingData
@@ -444,7 +379,7 @@ func (e *Exchange) WsHandleData(ctx context.Context, respRaw []byte) error {
 		return fmt.Errorf("%w unmarshalling %v", err, respRaw)
 	}
 	if resp.Event == operationLogin || (resp.Event == "error" && slices.Contains(authConnErrorCodes, resp.StatusCode)) {
-		return e.Websocket.Match.RequireMatchWithData("login-response", respRaw)
+		return conn.RequireMatchWithData("login-response", respRaw)
 	}
 	if len(resp.Data) == 0 {
 		return nil
@@ -971,14 +906,7 @@ func (e *Exchange) wsProcessOrderBooks(data []byte) error {
 		}
 		if err != nil {
 			if errors.Is(err, errInvalidChecksum) {
-				err = e.Subscribe(subscription.List{
-					{
-						Channel: response.Argument.Channel,
-						Asset:   assets[0],
-						Pairs:   currency.Pairs{response.Argument.InstrumentID},
-					},
-				})
-				if err != nil {
+				if err := e.Websocket.ResubscribeOrderbook(response.Argument.InstrumentID, assets[0]); err != nil {
 					e.Websocket.DataHandler <- err
 				}
 			} else {
@@ -1050,7 +978,6 @@ func (e *Exchange) WsProcessUpdateOrderbook(data *WsOrderBookData, pair currency
 			Pair:             pair,
 			Asset:            assets[i],
 			UpdateTime:       data.Timestamp.Time(),
-			GenerateChecksum: generateOrderbookChecksum,
 			ExpectedChecksum: uint32(data.Checksum), //nolint:gosec // Requires type casting
 			Asks:             asks,
 			Bids:             bids,
@@ -1076,21 +1003,12 @@ func (e *Exchange) AppendWsOrderbookItems(entries [][4]types.Number) (orderbook.
 // there are less than 25 entries (for whatever reason)
 // eg Bid:Ask:Bid:Ask:Ask:Ask
 func generateOrderbookChecksum(orderbookData *orderbook.Book) uint32 {
-	var checksum strings.Builder
-	for i := range allowableIterations {
-		if len(orderbookData.Bids)-1 >= i {
-			price := strconv.FormatFloat(orderbookData.Bids[i].Price, 'f', -1, 64)
-			amount := strconv.FormatFloat(orderbookData.Bids[i].Amount, 'f', -1, 64)
-			checksum.WriteString(price + wsOrderbookChecksumDelimiter + amount + wsOrderbookChecksumDelimiter)
-		}
-		if len(orderbookData.Asks)-1 >= i {
-			price := strconv.FormatFloat(orderbookData.Asks[i].Price, 'f', -1, 64)
-			amount := strconv.FormatFloat(orderbookData.Asks[i].Amount, 'f', -1, 64)
-			checksum.WriteString(price + wsOrderbookChecksumDelimiter + amount + wsOrderbookChecksumDelimiter)
-		}
-	}
-	checksumStr := strings.TrimSuffix(checksum.String(), wsOrderbookChecksumDelimiter)
-	return crc32.ChecksumIEEE([]byte(checksumStr))
+	return orderbook.InterleavedChecksum(orderbookData.Bids, orderbookData.Asks, allowableIterations, wsOrderbookChecksumDelimiter, checksumLevel)
+}
+
+// checksumLevel formats a level as price:quantity for checksum calculation
+func checksumLevel(l *orderbook.Level) string {
+	return orderbook.FormatChecksumFloat(l.Price) + wsOrderbookChecksumDelimiter + orderbook.FormatChecksumFloat(l.Amount)
 }
 
 // CalculateOrderbookChecksum alternates over the first 25 bid and ask entries from websocket data.
@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket/buffer"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -205,36 +206,49 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 	}
 	if err := e.Websocket.Setup(&websocket.ManagerSetup{
 		ExchangeConfig:                         exch,
-		DefaultURL:                             apiWebsocketPublicURL,
-		RunningURL:                             wsRunningEndpoint,
-		Connector:                              e.WsConnect,
-		Subscriber:                             e.Subscribe,
-		Unsubscriber:                           e.Unsubscribe,
-		GenerateSubscriptions:                  e.generateSubscriptions,
 		Features:                               &e.Features.Supports.WebsocketCapabilities,
 		MaxWebsocketSubscriptionsPerConnection: 240,
 		RateLimitDefinitions:                   rateLimits,
+		OrderbookBufferConfig: buffer.Config{
+			Checksum: generateOrderbookChecksum,
+			Resync:   e.Websocket.ResubscribeOrderbook,
+		},
+		UseMultiConnectionManagement: true,
 	}); err != nil {
 		return err
 	}
 
+	// Public
 	if err := e.Websocket.SetupNewConnection(&websocket.ConnectionSetup{
-		URL:                  apiWebsocketPublicURL,
-		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
-		ResponseMaxLimit:     websocketResponseMaxLimit,
-		RateLimit:            request.NewRateLimitWithWeight(time.Second, 2, 1),
-		RequestIDGenerator:   e.messageIDSeq.IncrementAndGet,
+		URL:                   wsRunningEndpoint,
+		ResponseCheckTimeout:  exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:      websocketResponseMaxLimit,
+		RateLimit:             request.NewRateLimitWithWeight(time.Second, 2, 1),
+		Connector:             e.WsConnect,
+		GenerateSubscriptions: e.generatePublicSubscriptions,
+		Subscriber:            e.Subscribe,
+		Unsubscriber:          e.Unsubscribe,
+		Handler:               e.WsHandleData,
+		RequestIDGenerator:    e.messageIDSeq.IncrementAndGet,
 	}); err != nil {
 		return err
 	}
 
+	// Private
 	return e.Websocket.SetupNewConnection(&websocket.ConnectionSetup{
-		URL:                  apiWebsocketPrivateURL,
-		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
-		ResponseMaxLimit:     websocketResponseMaxLimit,
-		Authenticated:        true,
-		RateLimit:            request.NewRateLimitWithWeight(time.Second, 2, 1),
-		RequestIDGenerator:   e.messageIDSeq.IncrementAndGet,
+		URL:                   apiWebsocketPrivateURL,
+		ResponseCheckTimeout:  exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:      websocketResponseMaxLimit,
+		Authenticated:         true,
+		RateLimit:             request.NewRateLimitWithWeight(time.Second, 2, 1),
+		Connector:             e.WsConnect,
+		Authenticate:          e.WsAuth,
+		GenerateSubscriptions: e.generatePrivateSubscriptions,
+		Subscriber:            e.Subscribe,
+		Unsubscriber:          e.Unsubscribe,
+		Handler:               e.WsHandleData,
+		RequestIDGenerator:    e.messageIDSeq.IncrementAndGet,
+		MessageFilter:         privateConnection,
 	})
 }
 
@@ -679,6 +693,9 @@ func (e *Exchange) GetAccountFundingHistory(ctx context.Context) ([]exchange.Fun
 			TransferType:    "deposit",
 			CryptoToAddress: depositHistories[x].ToDepositAddress,
 			CryptoTxID:      depositHistories[x].TransactionID,
+			CryptoChain:     depositHistories[x].Chain,
+			TransferID:      depositHistories[x].DepositID,
+			Confirmations:   depositHistories[x].ActualDepBlkConfirm.Int64(),
 		})
 	}
 	for x := range withdrawalHistories {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -28,7 +29,7 @@ func (e *Exchange) WSPlaceOrder(ctx context.Context, arg *PlaceOrderRequestParam
 		return nil, err
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*OrderData
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, placeOrderEPL, id, "order", []PlaceOrderRequestParam{*arg}, &resp); err != nil {
@@ -49,7 +50,7 @@ func (e *Exchange) WSPlaceMultipleOrders(ctx context.Context, args []PlaceOrderR
 		}
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*OrderData
 	return resp, e.SendAuthenticatedWebsocketRequest(ctx, placeMultipleOrdersEPL, id, "batch-orders", args, &resp)
@@ -67,7 +68,7 @@ func (e *Exchange) WSCancelOrder(ctx context.Context, arg *CancelOrderRequestPar
 		return nil, order.ErrOrderIDNotSet
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*OrderData
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, cancelOrderEPL, id, "cancel-order", []CancelOrderRequestParam{*arg}, &resp); err != nil {
@@ -92,7 +93,7 @@ func (e *Exchange) WSCancelMultipleOrders(ctx context.Context, args []CancelOrde
 		}
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*OrderData
 	return resp, e.SendAuthenticatedWebsocketRequest(ctx, cancelMultipleOrdersEPL, id, "batch-cancel-orders", args, &resp)
@@ -113,7 +114,7 @@ func (e *Exchange) WSAmendOrder(ctx context.Context, arg *AmendOrderRequestParam
 		return nil, errInvalidNewSizeOrPriceInformation
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*OrderData
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, amendOrderEPL, id, "amend-order", []AmendOrderRequestParams{*arg}, &resp); err != nil {
@@ -140,7 +141,7 @@ func (e *Exchange) WSAmendMultipleOrders(ctx context.Context, args []AmendOrderR
 		}
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*OrderData
 	return resp, e.SendAuthenticatedWebsocketRequest(ctx, amendMultipleOrdersEPL, id, "batch-amend-orders", args, &resp)
@@ -161,7 +162,7 @@ func (e *Exchange) WSMassCancelOrders(ctx context.Context, args []CancelMassReqP
 		}
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resps []*struct {
 		Result bool `json:"result"`
@@ -188,7 +189,7 @@ func (e *Exchange) WSPlaceSpreadOrder(ctx context.Context, arg *SpreadOrderParam
 		return nil, err
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*SpreadOrderResponse
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, placeSpreadOrderEPL, id, "sprd-order", []SpreadOrderParam{*arg}, &resp); err != nil {
@@ -210,7 +211,7 @@ func (e *Exchange) WSAmendSpreadOrder(ctx context.Context, arg *AmendSpreadOrder
 		return nil, errSizeOrPriceIsRequired
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*SpreadOrderResponse
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, amendSpreadOrderEPL, id, "sprd-amend-order", []AmendSpreadOrderParam{*arg}, &resp); err != nil {
@@ -234,7 +235,7 @@ func (e *Exchange) WSCancelSpreadOrder(ctx context.Context, orderID, clientOrder
 		arg["clOrdId"] = clientOrderID
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resp []*SpreadOrderResponse
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, cancelSpreadOrderEPL, id, "sprd-cancel-order", []map[string]string{arg}, &resp); err != nil {
@@ -251,7 +252,7 @@ func (e *Exchange) WSCancelAllSpreadOrders(ctx context.Context, spreadID string)
 		arg["sprdId"] = spreadID
 	}
 
-	id := strconv.FormatInt(e.Websocket.AuthConn.GenerateMessageID(false), 10)
+	id := strconv.FormatInt(e.messageIDSeq.IncrementAndGet(), 10)
 
 	var resps []*ResponseResult
 	if err := e.SendAuthenticatedWebsocketRequest(ctx, cancelAllSpreadOrderEPL, id, "sprd-mass-cancel", []map[string]string{arg}, &resps); err != nil {
@@ -270,8 +271,17 @@ func (e *Exchange) WSCancelAllSpreadOrders(ctx context.Context, spreadID string)
 	return nil
 }
 
-// SendAuthenticatedWebsocketRequest sends a websocket request to the server
+// SendAuthenticatedWebsocketRequest sends a websocket request to the server on the private connection
 func (e *Exchange) SendAuthenticatedWebsocketRequest(ctx context.Context, epl request.EndpointLimit, id, operation string, payload, result any) error {
+	conn, err := e.Websocket.GetConnection(privateConnection)
+	if err != nil {
+		return err
+	}
+	return e.sendWebsocketRequest(ctx, conn, epl, id, operation, payload, result)
+}
+
+// sendWebsocketRequest sends a websocket request on a connection and unmarshals the response data into result
+func (e *Exchange) sendWebsocketRequest(ctx context.Context, conn websocket.Connection, epl request.EndpointLimit, id, operation string, payload, result any) error {
 	if operation == "" || payload == nil {
 		return errInvalidWebsocketRequest
 	}
@@ -289,7 +299,7 @@ func (e *Exchange) SendAuthenticatedWebsocketRequest(ctx context.Context, epl re
 		Arguments: payload,
 	}
 
-	incoming, err := e.Websocket.AuthConn.SendMessageReturnResponse(ctx, epl, id, outbound)
+	incoming, err := conn.SendMessageReturnResponse(ctx, epl, id, outbound)
 	if err != nil {
 		return err
 	}
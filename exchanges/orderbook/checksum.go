@@ -0,0 +1,132 @@
+package orderbook
+
+import (
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ChecksumFunc generates a checksum from a snapshot of the stored orderbook
+// which is compared against the checksum sent by an exchange
+type ChecksumFunc func(snapshot *Book) uint32
+
+// LevelFormatter returns the string representation of a level used in a
+// checksum string
+type LevelFormatter func(l *Level) string
+
+// DesyncStats holds how often the orderbooks of an exchange have failed
+// checksum verification and how often they were resynced as a result
+type DesyncStats struct {
+	ChecksumMismatches int64
+	Resyncs            int64
+	ResyncFailures     int64
+	LastMismatch       time.Time
+}
+
+// desyncs tracks checksum mismatches and resyncs per exchange
+var desyncs = struct {
+	m     sync.Mutex
+	stats map[string]*DesyncStats
+}{stats: make(map[string]*DesyncStats)}
+
+// InterleavedChecksum returns the CRC32 checksum of up to depth bid and ask
+// levels, alternating between a bid and an ask level at each index. Each level
+// is followed by the delimiter except for the last e.g. bid:ask:bid:ask:ask
+func InterleavedChecksum(bids, asks Levels, depth int, delimiter string, format LevelFormatter) uint32 {
+	var sb strings.Builder
+	for i := range depth {
+		if i < len(bids) {
+			sb.WriteString(format(&bids[i]))
+			sb.WriteString(delimiter)
+		}
+		if i < len(asks) {
+			sb.WriteString(format(&asks[i]))
+			sb.WriteString(delimiter)
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(strings.TrimSuffix(sb.String(), delimiter)))
+}
+
+// ConcatenatedChecksum returns the CRC32 checksum of up to depth levels of
+// the first side followed by up to depth levels of the second side, without
+// any delimiters
+func ConcatenatedChecksum(first, second Levels, depth int, format LevelFormatter) uint32 {
+	var sb strings.Builder
+	for _, side := range []Levels{first, second} {
+		for i := range min(depth, len(side)) {
+			sb.WriteString(format(&side[i]))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(sb.String()))
+}
+
+// FormatChecksumFloat formats a float with the minimum number of digits
+// required to represent it, as used by most exchange checksums
+func FormatChecksumFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// TrimChecksumValue removes the decimal point and leading zeros from a
+// number string e.g. 0.00500 becomes 500
+func TrimChecksumValue(s string) string {
+	return strings.TrimLeft(strings.Replace(s, ".", "", 1), "0")
+}
+
+// recordChecksumMismatch increments the checksum mismatch count for an
+// exchange
+func recordChecksumMismatch(exchange string) {
+	desyncs.m.Lock()
+	defer desyncs.m.Unlock()
+	s := getDesyncStats(exchange)
+	s.ChecksumMismatches++
+	s.LastMismatch = time.Now()
+}
+
+// RecordResync increments the resync count for an exchange and its failure
+// count if the resync returned an error
+func RecordResync(exchange string, err error) {
+	desyncs.m.Lock()
+	defer desyncs.m.Unlock()
+	s := getDesyncStats(exchange)
+	s.Resyncs++
+	if err != nil {
+		s.ResyncFailures++
+	}
+}
+
+// GetDesyncStats returns the checksum mismatch and resync counts for an
+// exchange
+func GetDesyncStats(exchange string) DesyncStats {
+	desyncs.m.Lock()
+	defer desyncs.m.Unlock()
+	if s, ok := desyncs.stats[strings.ToLower(exchange)]; ok {
+		return *s
+	}
+	return DesyncStats{}
+}
+
+// GetAllDesyncStats returns the checksum mismatch and resync counts for every
+// exchange which has desynced, keyed by lower case exchange name
+func GetAllDesyncStats() map[string]DesyncStats {
+	desyncs.m.Lock()
+	defer desyncs.m.Unlock()
+	stats := make(map[string]DesyncStats, len(desyncs.stats))
+	for k, v := range desyncs.stats {
+		stats[k] = *v
+	}
+	return stats
+}
+
+// getDesyncStats returns the stats for an exchange, creating them if needed
+// NOTE: This requires locking.
+func getDesyncStats(exchange string) *DesyncStats {
+	exchange = strings.ToLower(exchange)
+	s, ok := desyncs.stats[exchange]
+	if !ok {
+		s = &DesyncStats{}
+		desyncs.stats[exchange] = s
+	}
+	return s
+}
@@ -0,0 +1,63 @@
+package orderbook
+
+import (
+	"errors"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func priceAmountFormatter(l *Level) string {
+	return FormatChecksumFloat(l.Price) + ":" + FormatChecksumFloat(l.Amount)
+}
+
+func TestInterleavedChecksum(t *testing.T) {
+	t.Parallel()
+	bids := Levels{{Price: 1, Amount: 0.5}, {Price: 0.5, Amount: 2}}
+	asks := Levels{{Price: 2, Amount: 1}, {Price: 3, Amount: 1.5}, {Price: 4, Amount: 1}}
+	assert.Equal(t, crc32.ChecksumIEEE([]byte("1:0.5:2:1:0.5:2:3:1.5:4:1")), InterleavedChecksum(bids, asks, 25, ":", priceAmountFormatter))
+	assert.Equal(t, crc32.ChecksumIEEE([]byte("1:0.5:2:1")), InterleavedChecksum(bids, asks, 1, ":", priceAmountFormatter), "must respect depth")
+	assert.Equal(t, crc32.ChecksumIEEE(nil), InterleavedChecksum(nil, nil, 25, ":", priceAmountFormatter))
+}
+
+func TestConcatenatedChecksum(t *testing.T) {
+	t.Parallel()
+	format := func(l *Level) string {
+		return TrimChecksumValue(FormatChecksumFloat(l.Price)) + TrimChecksumValue(FormatChecksumFloat(l.Amount))
+	}
+	bids := Levels{{Price: 0.05, Amount: 0.005}, {Price: 0.04, Amount: 1}}
+	asks := Levels{{Price: 0.06, Amount: 10}}
+	assert.Equal(t, crc32.ChecksumIEEE([]byte("5541610")), ConcatenatedChecksum(bids, asks, 10, format))
+	assert.Equal(t, crc32.ChecksumIEEE([]byte("61055")), ConcatenatedChecksum(asks, bids, 1, format), "must respect depth and side order")
+}
+
+func TestTrimChecksumValue(t *testing.T) {
+	t.Parallel()
+	for in, exp := range map[string]string{
+		"0.05005":    "5005",
+		"0.00000500": "500",
+		"32.0001":    "320001",
+		"16000":      "16000",
+		"0":          "",
+	} {
+		assert.Equal(t, exp, TrimChecksumValue(in), "TrimChecksumValue should return correct value for %s", in)
+	}
+}
+
+func TestDesyncStats(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, DesyncStats{}, GetDesyncStats("desyncstats"), "unknown exchange should return empty stats")
+
+	recordChecksumMismatch("DesyncStats")
+	recordChecksumMismatch("desyncstats")
+	RecordResync("DESYNCSTATS", nil)
+	RecordResync("desyncstats", errors.New("resync failed"))
+
+	s := GetDesyncStats("desyncStats")
+	assert.Equal(t, int64(2), s.ChecksumMismatches)
+	assert.Equal(t, int64(2), s.Resyncs)
+	assert.Equal(t, int64(1), s.ResyncFailures)
+	assert.False(t, s.LastMismatch.IsZero(), "LastMismatch should be set")
+	assert.Equal(t, s, GetAllDesyncStats()["desyncstats"])
+}
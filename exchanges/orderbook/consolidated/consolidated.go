@@ -0,0 +1,256 @@
+package consolidated
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// New returns an aggregator for the supplied sources, normalising their prices
+// to the quote currency. Sources must share a base currency and may be quoted
+// in different fiat currencies, which are converted using the foreign
+// exchange rates loaded by the currency package. An orderbook depth is deployed
+// for every source so that the aggregator can be waited on before its venues
+// have synced
+func New(a asset.Item, quote currency.Code, sources ...Source) (*Aggregator, error) {
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w: %q", asset.ErrInvalidAsset, a)
+	}
+	if quote.IsEmpty() {
+		return nil, errQuoteUnset
+	}
+	if len(sources) == 0 {
+		return nil, ErrNoSources
+	}
+	agg := &Aggregator{
+		pair:    currency.NewPair(sources[0].Pair.Base, quote),
+		asset:   a,
+		sources: make([]source, 0, len(sources)),
+		rate:    fiatRate,
+	}
+	for i := range sources {
+		if sources[i].Exchange == "" {
+			return nil, errExchangeNameUnset
+		}
+		if sources[i].Pair.IsEmpty() {
+			return nil, fmt.Errorf("%w for %s", errPairUnset, sources[i].Exchange)
+		}
+		if !sources[i].Pair.Base.Equal(agg.pair.Base) {
+			return nil, fmt.Errorf("%w: %s %s does not have base %s", ErrBaseCurrencyMismatch, sources[i].Exchange, sources[i].Pair, agg.pair.Base)
+		}
+		for j := range agg.sources {
+			if agg.sources[j].Exchange == sources[i].Exchange && agg.sources[j].Pair.Equal(sources[i].Pair) {
+				return nil, fmt.Errorf("%w: %s %s", errDuplicateSource, sources[i].Exchange, sources[i].Pair)
+			}
+		}
+		depth, err := orderbook.DeployDepth(sources[i].Exchange, sources[i].Pair, a)
+		if err != nil {
+			return nil, err
+		}
+		agg.sources = append(agg.sources, source{Source: sources[i], depth: depth})
+	}
+	return agg, nil
+}
+
+// fiatRate converts between fiat currencies using the loaded foreign exchange
+// rates
+func fiatRate(from, to currency.Code) (float64, error) {
+	return currency.GetForeignExchangeRate(currency.NewPair(from, to))
+}
+
+// quoteRate returns the multiplier which normalises prices in the supplied
+// quote to the consolidated quote
+func (a *Aggregator) quoteRate(quote currency.Code) (float64, error) {
+	if quote.Equal(a.pair.Quote) {
+		return 1, nil
+	}
+	rate, err := a.rate(quote, a.pair.Quote)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 {
+		return 0, fmt.Errorf("%w %v converting %s to %s", errInvalidRate, rate, quote, a.pair.Quote)
+	}
+	return rate, nil
+}
+
+// Retrieve builds a consolidated snapshot from the current state of each
+// venue's orderbook. Venues which cannot be retrieved or converted are
+// reported in the book's venue list and excluded from its levels; an error is
+// only returned when no venue is available
+func (a *Aggregator) Retrieve() (*Book, error) {
+	b := &Book{
+		Pair:   a.pair,
+		Asset:  a.asset,
+		Venues: make([]Venue, len(a.sources)),
+	}
+	var errs error
+	var available bool
+	for i := range a.sources {
+		s := &a.sources[i]
+		v := &b.Venues[i]
+		v.Exchange = s.Exchange
+		v.Pair = s.Pair
+		v.Rate, v.Err = a.quoteRate(s.Pair.Quote)
+		var ob *orderbook.Book
+		if v.Err == nil {
+			ob, v.Err = s.depth.Retrieve()
+		}
+		if v.Err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %s: %w", s.Exchange, s.Pair, v.Err))
+			continue
+		}
+		available = true
+		v.Bids = len(ob.Bids)
+		v.Asks = len(ob.Asks)
+		v.LastUpdated = ob.LastUpdated
+		if ob.LastUpdated.After(b.LastUpdated) {
+			b.LastUpdated = ob.LastUpdated
+		}
+		b.Bids = appendLevels(b.Bids, ob.Bids, &s.Source, v.Rate)
+		b.Asks = appendLevels(b.Asks, ob.Asks, &s.Source, v.Rate)
+	}
+	if !available {
+		return nil, fmt.Errorf("%w: %w", ErrNoVenuesAvailable, errs)
+	}
+	slices.SortStableFunc(b.Bids, func(x, y Level) int {
+		return cmp.Or(cmp.Compare(y.Price, x.Price), cmp.Compare(x.Exchange, y.Exchange))
+	})
+	slices.SortStableFunc(b.Asks, func(x, y Level) int {
+		return cmp.Or(cmp.Compare(x.Price, y.Price), cmp.Compare(x.Exchange, y.Exchange))
+	})
+	return b, nil
+}
+
+// appendLevels normalises and tags venue levels before appending them to a
+// consolidated side
+func appendLevels(side Levels, levels orderbook.Levels, s *Source, rate float64) Levels {
+	for i := range levels {
+		side = append(side, Level{
+			Exchange:    s.Exchange,
+			Pair:        s.Pair,
+			Price:       levels[i].Price * rate,
+			NativePrice: levels[i].Price,
+			Amount:      levels[i].Amount,
+		})
+	}
+	return side
+}
+
+// Wait returns a channel which receives once any venue's orderbook has been
+// updated. Kick allows for cancellation of waiting, in which case true is
+// received, and can be nil when not needed. As with depth alerts, call Wait
+// before Retrieve so that updates between the two are not missed
+func (a *Aggregator) Wait(kick <-chan struct{}) <-chan bool {
+	reply := make(chan bool, 1)
+	done := make(chan struct{})
+	var once sync.Once
+	finish := func(kicked bool) {
+		once.Do(func() {
+			reply <- kicked
+			// Releases the waits on the venues which did not alert
+			close(done)
+		})
+	}
+	for i := range a.sources {
+		alert := a.sources[i].depth.Wait(done)
+		go func() { finish(<-alert) }()
+	}
+	if kick != nil {
+		go func() {
+			select {
+			case <-kick:
+				finish(true)
+			case <-done:
+			}
+		}()
+	}
+	return reply
+}
+
+// SimulateOrder simulates an order across all venues of the consolidated
+// book. A buy amount is denominated in the consolidated quote currency and a
+// sell amount in the base currency
+func (b *Book) SimulateOrder(amount float64, buy bool) (*Result, error) {
+	r, err := b.orderbook().SimulateOrder(amount, buy)
+	if err != nil {
+		return nil, err
+	}
+	return b.result(r, buy), nil
+}
+
+// WhaleBomb finds the amount required across all venues of the consolidated
+// book to move the best price to the price target
+func (b *Book) WhaleBomb(priceTarget float64, buy bool) (*Result, error) {
+	r, err := b.orderbook().WhaleBomb(priceTarget, buy)
+	if err != nil {
+		return nil, err
+	}
+	return b.result(r, buy), nil
+}
+
+// orderbook returns the consolidated book as an untagged orderbook whose levels
+// index the same as the consolidated levels
+func (b *Book) orderbook() *orderbook.Book {
+	return &orderbook.Book{
+		Bids:     b.Bids.levels(),
+		Asks:     b.Asks.levels(),
+		Exchange: Exchange,
+		Pair:     b.Pair,
+		Asset:    b.Asset,
+	}
+}
+
+// result tags the levels consumed by an orderbook calculation with their venue
+// and totals them by venue
+func (b *Book) result(r *orderbook.WhaleBombResult, buy bool) *Result {
+	side := b.Bids
+	if buy {
+		side = b.Asks
+	}
+	res := &Result{
+		Amount:               r.Amount,
+		MinimumPrice:         r.MinimumPrice,
+		MaximumPrice:         r.MaximumPrice,
+		PercentageGainOrLoss: r.PercentageGainOrLoss,
+		Orders:               make(Levels, len(r.Orders)),
+		Status:               r.Status,
+	}
+	for i := range r.Orders {
+		l := side[i]
+		// The last consumed level can be partially filled
+		l.Amount = r.Orders[i].Amount
+		res.Orders[i] = l
+		idx := slices.IndexFunc(res.Fills, func(f Fill) bool {
+			return f.Exchange == l.Exchange && f.Pair.Equal(l.Pair)
+		})
+		if idx == -1 {
+			res.Fills = append(res.Fills, Fill{Exchange: l.Exchange, Pair: l.Pair})
+			idx = len(res.Fills) - 1
+		}
+		res.Fills[idx].BaseAmount += l.Amount
+		res.Fills[idx].QuoteAmount += l.Amount * l.Price
+		res.Fills[idx].Levels++
+	}
+	for i := range res.Fills {
+		if res.Fills[i].BaseAmount > 0 {
+			res.Fills[i].AveragePrice = res.Fills[i].QuoteAmount / res.Fills[i].BaseAmount
+		}
+	}
+	return res
+}
+
+// levels strips the venue tags from a consolidated side
+func (l Levels) levels() orderbook.Levels {
+	levels := make(orderbook.Levels, len(l))
+	for i := range l {
+		levels[i] = orderbook.Level{Price: l[i].Price, Amount: l[i].Amount}
+	}
+	return levels
+}
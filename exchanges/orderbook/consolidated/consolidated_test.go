@@ -0,0 +1,185 @@
+package consolidated
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errRateUnavailable = errors.New("rate unavailable")
+
+// loadBook deploys and loads an orderbook for the exchange and pair
+func loadBook(t *testing.T, exch string, p currency.Pair, bids, asks orderbook.Levels) *orderbook.Depth {
+	t.Helper()
+	depth, err := orderbook.DeployDepth(exch, p, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{Bids: bids, Asks: asks, LastUpdated: time.Now()}), "LoadSnapshot must not error")
+	return depth
+}
+
+// testRate converts EUR to USD at 1.1
+func testRate(from, to currency.Code) (float64, error) {
+	if from.Equal(currency.EUR) && to.Equal(currency.USD) {
+		return 1.1, nil
+	}
+	return 0, errRateUnavailable
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewBTCUSD()
+	_, err := New(asset.Empty, currency.USD, Source{Exchange: "a", Pair: btcusd})
+	assert.ErrorIs(t, err, asset.ErrInvalidAsset)
+	_, err = New(asset.Spot, currency.EMPTYCODE, Source{Exchange: "a", Pair: btcusd})
+	assert.ErrorIs(t, err, errQuoteUnset)
+	_, err = New(asset.Spot, currency.USD)
+	assert.ErrorIs(t, err, ErrNoSources)
+	_, err = New(asset.Spot, currency.USD, Source{Pair: btcusd})
+	assert.ErrorIs(t, err, errExchangeNameUnset)
+	_, err = New(asset.Spot, currency.USD, Source{Exchange: "a"})
+	assert.ErrorIs(t, err, errPairUnset)
+	_, err = New(asset.Spot, currency.USD, Source{Exchange: "a", Pair: btcusd}, Source{Exchange: "b", Pair: currency.NewPair(currency.ETH, currency.USD)})
+	assert.ErrorIs(t, err, ErrBaseCurrencyMismatch)
+	_, err = New(asset.Spot, currency.USD, Source{Exchange: "a", Pair: btcusd}, Source{Exchange: "a", Pair: btcusd})
+	assert.ErrorIs(t, err, errDuplicateSource)
+
+	agg, err := New(asset.Spot, currency.USD, Source{Exchange: "TestNew", Pair: btcusd}, Source{Exchange: "TestNew", Pair: currency.NewPair(currency.BTC, currency.EUR)})
+	require.NoError(t, err, "New must not error")
+	assert.Len(t, agg.sources, 2, "New should deploy a depth for each source")
+	assert.True(t, agg.pair.Equal(btcusd), "New should quote the consolidated pair in the supplied quote")
+}
+
+func TestRetrieve(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewBTCUSD()
+	btceur := currency.NewPair(currency.BTC, currency.EUR)
+	loadBook(t, "TestRetrieveA", btcusd,
+		orderbook.Levels{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}},
+		orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}})
+	loadBook(t, "TestRetrieveB", btceur,
+		orderbook.Levels{{Price: 80, Amount: 3}},
+		orderbook.Levels{{Price: 100, Amount: 4}})
+	agg, err := New(asset.Spot, currency.USD,
+		Source{Exchange: "TestRetrieveA", Pair: btcusd},
+		Source{Exchange: "TestRetrieveB", Pair: btceur},
+		Source{Exchange: "TestRetrieveC", Pair: currency.NewPair(currency.BTC, currency.GBP)})
+	require.NoError(t, err, "New must not error")
+	agg.rate = testRate
+
+	b, err := agg.Retrieve()
+	require.NoError(t, err, "Retrieve must not error")
+	require.Len(t, b.Bids, 3)
+	require.Len(t, b.Asks, 3)
+	assert.Equal(t, Level{Exchange: "TestRetrieveA", Pair: btcusd, Price: 100, NativePrice: 100, Amount: 1}, b.Bids[0], "bids should be ordered best price first")
+	assert.Equal(t, "TestRetrieveB", b.Bids[2].Exchange, "bids should be ordered best price first")
+	assert.InDelta(t, 88, b.Bids[2].Price, 1e-9, "bids should be normalised to the consolidated quote")
+	assert.Equal(t, "TestRetrieveA", b.Asks[0].Exchange, "asks should be ordered best price first")
+	assert.InDelta(t, 110, b.Asks[2].Price, 1e-9, "asks should be normalised to the consolidated quote")
+	assert.Equal(t, 100.0, b.Asks[2].NativePrice, "asks should retain the native price")
+
+	require.Len(t, b.Venues, 3)
+	assert.Equal(t, 1.0, b.Venues[0].Rate)
+	assert.Equal(t, 1.1, b.Venues[1].Rate)
+	assert.Equal(t, 1, b.Venues[1].Bids)
+	assert.ErrorIs(t, b.Venues[2].Err, errRateUnavailable, "venues which cannot be converted should be reported")
+	assert.False(t, b.LastUpdated.IsZero(), "LastUpdated should be set from the venues")
+
+	agg.rate = func(currency.Code, currency.Code) (float64, error) { return -1, nil }
+	b, err = agg.Retrieve()
+	require.NoError(t, err, "Retrieve must not error")
+	assert.ErrorIs(t, b.Venues[1].Err, errInvalidRate)
+
+	agg, err = New(asset.Spot, currency.USD, Source{Exchange: "TestRetrieveC", Pair: currency.NewPair(currency.BTC, currency.GBP)})
+	require.NoError(t, err, "New must not error")
+	agg.rate = testRate
+	_, err = agg.Retrieve()
+	assert.ErrorIs(t, err, ErrNoVenuesAvailable)
+	assert.ErrorIs(t, err, errRateUnavailable)
+}
+
+func TestCalculations(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewBTCUSD()
+	btceur := currency.NewPair(currency.BTC, currency.EUR)
+	loadBook(t, "TestCalculationsA", btcusd,
+		orderbook.Levels{{Price: 100, Amount: 1}, {Price: 95, Amount: 1}},
+		orderbook.Levels{{Price: 101, Amount: 1}, {Price: 115, Amount: 1}})
+	loadBook(t, "TestCalculationsB", btceur,
+		orderbook.Levels{{Price: 90, Amount: 2}},
+		orderbook.Levels{{Price: 100, Amount: 2}})
+	agg, err := New(asset.Spot, currency.USD,
+		Source{Exchange: "TestCalculationsA", Pair: btcusd},
+		Source{Exchange: "TestCalculationsB", Pair: btceur})
+	require.NoError(t, err, "New must not error")
+	agg.rate = testRate
+	b, err := agg.Retrieve()
+	require.NoError(t, err, "Retrieve must not error")
+
+	_, err = b.SimulateOrder(0, true)
+	assert.Error(t, err, "SimulateOrder should error on an invalid amount")
+
+	// Sells 2.5 BTC: 1 @ 100 on A, then 1.5 @ 99 on B
+	r, err := b.SimulateOrder(2.5, false)
+	require.NoError(t, err, "SimulateOrder must not error")
+	require.Len(t, r.Orders, 2)
+	assert.Equal(t, "TestCalculationsB", r.Orders[1].Exchange)
+	assert.Equal(t, 1.5, r.Orders[1].Amount, "the last level should be partially consumed")
+	require.Len(t, r.Fills, 2)
+	assert.Equal(t, Fill{Exchange: "TestCalculationsA", Pair: btcusd, BaseAmount: 1, QuoteAmount: 100, AveragePrice: 100, Levels: 1}, r.Fills[0])
+	assert.Equal(t, 1.5, r.Fills[1].BaseAmount)
+	assert.InDelta(t, 148.5, r.Fills[1].QuoteAmount, 1e-9)
+	assert.InDelta(t, 99, r.Fills[1].AveragePrice, 1e-9)
+	assert.InDelta(t, 248.5, r.Amount, 1e-9, "SimulateOrder should return the quote received")
+
+	// Spends 321 USD: 101 on A then 220 buying 2 BTC on B @ 110
+	r, err = b.SimulateOrder(321, true)
+	require.NoError(t, err, "SimulateOrder must not error")
+	require.Len(t, r.Fills, 2)
+	assert.Equal(t, "TestCalculationsB", r.Fills[1].Exchange)
+	assert.InDelta(t, 2, r.Fills[1].BaseAmount, 1e-9)
+	assert.InDelta(t, 3, r.Amount, 1e-9, "SimulateOrder should return the base bought")
+
+	_, err = b.WhaleBomb(-1, true)
+	assert.Error(t, err, "WhaleBomb should error on an invalid price target")
+
+	r, err = b.WhaleBomb(112, true)
+	require.NoError(t, err, "WhaleBomb must not error")
+	require.Len(t, r.Orders, 2)
+	assert.InDelta(t, 321, r.Amount, 1e-9, "WhaleBomb should return the quote required")
+	assert.Equal(t, 115.0, r.MaximumPrice)
+	assert.Len(t, r.Fills, 2)
+}
+
+func TestWait(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewBTCUSD()
+	loadBook(t, "TestWaitA", btcusd, orderbook.Levels{{Price: 100, Amount: 1}}, nil)
+	depth := loadBook(t, "TestWaitB", btcusd, orderbook.Levels{{Price: 100, Amount: 1}}, nil)
+	agg, err := New(asset.Spot, currency.USD, Source{Exchange: "TestWaitA", Pair: btcusd}, Source{Exchange: "TestWaitB", Pair: btcusd})
+	require.NoError(t, err, "New must not error")
+
+	alert := agg.Wait(nil)
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{Bids: orderbook.Levels{{Price: 101, Amount: 1}}, LastUpdated: time.Now()}), "LoadSnapshot must not error")
+	select {
+	case kicked := <-alert:
+		assert.False(t, kicked, "Wait should not be kicked on a venue update")
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "Wait should receive a venue update")
+	}
+
+	kick := make(chan struct{})
+	alert = agg.Wait(kick)
+	close(kick)
+	select {
+	case kicked := <-alert:
+		assert.True(t, kicked, "Wait should be kicked")
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "Wait should receive a kick")
+	}
+}
@@ -0,0 +1,121 @@
+package consolidated
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Exchange is the name given to the aggregate book when it is handed to the
+// orderbook calculators
+const Exchange = "consolidated"
+
+// Public errors
+var (
+	ErrNoSources            = errors.New("no orderbook sources supplied")
+	ErrNoVenuesAvailable    = errors.New("no venue orderbooks available")
+	ErrBaseCurrencyMismatch = errors.New("sources must share the same base currency")
+)
+
+var (
+	errExchangeNameUnset = errors.New("exchange name unset")
+	errPairUnset         = errors.New("currency pair unset")
+	errQuoteUnset        = errors.New("consolidated quote currency unset")
+	errDuplicateSource   = errors.New("duplicate orderbook source")
+	errInvalidRate       = errors.New("invalid quote conversion rate")
+)
+
+// RateFunc returns the rate which converts a price quoted in one currency to
+// another
+type RateFunc func(from, to currency.Code) (float64, error)
+
+// Source defines an exchange pair whose orderbook is consolidated
+type Source struct {
+	Exchange string
+	Pair     currency.Pair
+}
+
+// Aggregator consolidates the orderbooks of the same base currency across
+// multiple exchanges into a single book quoted in one currency
+type Aggregator struct {
+	pair    currency.Pair
+	asset   asset.Item
+	sources []source
+	rate    RateFunc
+}
+
+// source is a Source with its deployed orderbook depth
+type source struct {
+	Source
+	depth *orderbook.Depth
+}
+
+// Book is a consolidated orderbook snapshot with levels tagged by venue. Bids
+// and asks are ordered best price first, with levels at the same price
+// ordered by exchange name
+type Book struct {
+	Bids Levels
+	Asks Levels
+
+	// Pair is the shared base currency against the consolidated quote
+	Pair        currency.Pair
+	Asset       asset.Item
+	Venues      []Venue
+	LastUpdated time.Time
+}
+
+// Level is a venue orderbook level with its price normalised to the
+// consolidated quote currency
+type Level struct {
+	Exchange string
+	// Pair is the pair the level is natively quoted in on the exchange
+	Pair        currency.Pair
+	Price       float64
+	NativePrice float64
+	Amount      float64
+}
+
+// Levels is a side of the consolidated orderbook
+type Levels []Level
+
+// Venue describes the state of a source at the time the book was built
+type Venue struct {
+	Exchange string
+	Pair     currency.Pair
+	// Rate is the multiplier applied to native prices to normalise them
+	Rate        float64
+	Bids        int
+	Asks        int
+	LastUpdated time.Time
+	// Err is set when the venue could not contribute to the book
+	Err error
+}
+
+// Result is the outcome of a simulated order or whale bomb against the
+// consolidated book
+type Result struct {
+	Amount               float64
+	MinimumPrice         float64
+	MaximumPrice         float64
+	PercentageGainOrLoss float64
+	// Orders are the levels consumed, in consumption order
+	Orders Levels
+	// Fills are the consumed levels totalled by venue
+	Fills  []Fill
+	Status string
+}
+
+// Fill is the portion of a consolidated calculation which would execute on a
+// single venue
+type Fill struct {
+	Exchange   string
+	Pair       currency.Pair
+	BaseAmount float64
+	// QuoteAmount is denominated in the consolidated quote currency
+	QuoteAmount  float64
+	AveragePrice float64
+	Levels       int
+}
@@ -23,8 +23,9 @@ const (
 
 // Public error vars
 var (
-	ErrDepthNotFound = errors.New("orderbook depth not found")
-	ErrEmptyUpdate   = errors.New("update contains no bids or asks")
+	ErrDepthNotFound    = errors.New("orderbook depth not found")
+	ErrEmptyUpdate      = errors.New("update contains no bids or asks")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
 )
 
 var (
@@ -32,7 +33,6 @@ var (
 	errUpdateFailed           = errors.New("orderbook update failed")
 	errDeleteFailed           = errors.New("orderbook update delete failed")
 	errRESTSnapshot           = errors.New("cannot update REST protocol loaded snapshot")
-	errChecksumMismatch       = errors.New("checksum mismatch")
 	errChecksumGeneratorUnset = errors.New("checksum generator unset")
 )
 
@@ -49,7 +49,7 @@ type Update struct {
 	// ExpectedChecksum defines the expected value when the books have been verified
 	ExpectedChecksum uint32
 	// GenerateChecksum is a function that will be called to generate a checksum from the stored orderbook post update
-	GenerateChecksum func(snapshot *Book) uint32
+	GenerateChecksum ChecksumFunc
 	// AllowEmpty, when true, permits loading an empty order book update to set an UpdateID without including actual data
 	AllowEmpty bool
 	// Action defines the action to be performed on the orderbook e.g. amend, delete, insert, update/insert
@@ -102,11 +102,8 @@ func (d *Depth) ProcessUpdate(u *Update) error {
 	}
 
 	if u.ExpectedChecksum != 0 {
-		if u.GenerateChecksum == nil {
-			return d.invalidate(errChecksumGeneratorUnset)
-		}
-		if checksum := u.GenerateChecksum(d.snapshot()); checksum != u.ExpectedChecksum {
-			return d.invalidate(fmt.Errorf("%s %s %s %w: expected '%d', got '%d'", d.exchange, d.pair, d.asset, errChecksumMismatch, u.ExpectedChecksum, checksum))
+		if err := d.verifyChecksum(u.ExpectedChecksum, u.GenerateChecksum); err != nil {
+			return err
 		}
 	}
 
@@ -117,6 +114,35 @@ func (d *Depth) ProcessUpdate(u *Update) error {
 	return nil
 }
 
+// ValidateChecksum verifies the stored orderbook against a checksum which is
+// sent separately from the updates it covers. On mismatch the orderbook is
+// invalidated and the error returned
+func (d *Depth) ValidateChecksum(expected uint32, generate ChecksumFunc) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.validationError != nil {
+		return d.validationError
+	}
+	if !d.validateOrderbook {
+		return nil
+	}
+	return d.verifyChecksum(expected, generate)
+}
+
+// verifyChecksum generates a checksum from the stored orderbook and
+// invalidates it if the checksum does not match the expected value
+// NOTE: This requires locking.
+func (d *Depth) verifyChecksum(expected uint32, generate ChecksumFunc) error {
+	if generate == nil {
+		return d.invalidate(errChecksumGeneratorUnset)
+	}
+	if checksum := generate(d.snapshot()); checksum != expected {
+		recordChecksumMismatch(d.exchange)
+		return d.invalidate(fmt.Errorf("%s %s %s %w: expected '%d', got '%d'", d.exchange, d.pair, d.asset, ErrChecksumMismatch, expected, checksum))
+	}
+	return nil
+}
+
 func (d *Depth) snapshot() *Book {
 	return &Book{
 		Bids:                   d.bidLevels.Levels,
@@ -73,7 +73,7 @@ func TestProcessUpdate(t *testing.T) {
 
 	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
 	err = d.ProcessUpdate(&Update{UpdateTime: time.Now(), Asks: Levels{{Price: 1337.5, Amount: 69420, ID: 69420}}, ExpectedChecksum: 1337, GenerateChecksum: func(*Book) uint32 { return 1336 }})
-	require.ErrorIs(t, err, errChecksumMismatch)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
 
 	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
 	err = d.ProcessUpdate(&Update{UpdateTime: time.Now(), Asks: Levels{{Price: 1337.5, Amount: 69420, ID: 69420}}, ExpectedChecksum: 1337, GenerateChecksum: func(*Book) uint32 { return 1337 }})
@@ -86,6 +86,27 @@ func TestProcessUpdate(t *testing.T) {
 	require.NoError(t, err, "must not error when ValidateOrderbook is false")
 }
 
+func TestValidateChecksum(t *testing.T) {
+	t.Parallel()
+	d := NewDepth(id)
+	d.exchange = "validatechecksum"
+	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
+	assert.NoError(t, d.ValidateChecksum(1337, func(*Book) uint32 { return 1336 }), "must not verify when ValidateOrderbook is false")
+
+	d.validateOrderbook = true
+	assert.NoError(t, d.ValidateChecksum(1337, func(*Book) uint32 { return 1337 }))
+	assert.Zero(t, GetDesyncStats("validatechecksum").ChecksumMismatches)
+
+	err := d.ValidateChecksum(1337, func(*Book) uint32 { return 1336 })
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.False(t, d.IsValid(), "mismatch must invalidate the orderbook")
+	assert.ErrorIs(t, d.ValidateChecksum(1337, func(*Book) uint32 { return 1337 }), ErrOrderbookInvalid, "must return the validation error until a new snapshot is loaded")
+	assert.Equal(t, int64(1), GetDesyncStats("ValidateChecksum").ChecksumMismatches, "mismatch must be recorded once")
+
+	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
+	assert.ErrorIs(t, d.ValidateChecksum(1337, nil), errChecksumGeneratorUnset)
+}
+
 func TestUpdate(t *testing.T) {
 	t.Parallel()
 	d := NewDepth(id)
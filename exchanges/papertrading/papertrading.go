@@ -0,0 +1,871 @@
+package papertrading
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// New wraps an exchange in a paper trading simulator. Starting balances are
+// loaded from the config balances which match the exchange name
+func New(exch exchange.IBotExchange, cfg *config.PaperTrading) (*Exchange, error) {
+	if exch == nil {
+		return nil, errNilExchange
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w paper trading config", common.ErrNilPointer)
+	}
+	e := &Exchange{
+		IBotExchange: exch,
+		balances:     make(map[asset.Item]map[*currency.Item]*balance),
+		orders:       make(map[string]*paperOrder),
+		watching:     make(map[key.PairAsset]bool),
+		taken:        make(map[key.PairAsset]*takenVolume),
+		shutdown:     make(chan struct{}),
+	}
+	for i := range cfg.Balances {
+		b := &cfg.Balances[i]
+		if !strings.EqualFold(b.Exchange, exch.GetName()) {
+			continue
+		}
+		if !b.Asset.IsValid() || b.Currency.IsEmpty() || b.Amount < 0 {
+			return nil, fmt.Errorf("%w %v %v %v %v", errInvalidBalance, b.Exchange, b.Asset, b.Currency, b.Amount)
+		}
+		e.getBalance(b.Asset, b.Currency).total += b.Amount
+	}
+	return e, nil
+}
+
+// Shutdown stops matching resting orders and shuts down the wrapped exchange
+func (e *Exchange) Shutdown() error {
+	e.m.Lock()
+	select {
+	case <-e.shutdown:
+	default:
+		close(e.shutdown)
+	}
+	e.m.Unlock()
+	e.wg.Wait()
+	return e.IBotExchange.Shutdown()
+}
+
+// SubmitOrder simulates an order against the live orderbook. Market orders
+// are filled immediately. Limit orders which cross the book fill against the
+// levels up to their price and the remainder rests until the orderbook
+// crosses it. Trigger orders such as stops and take profits are not supported
+func (e *Exchange) SubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if s == nil {
+		return nil, order.ErrSubmissionIsNil
+	}
+	// trigger orders would otherwise be matched as limit orders and fill
+	// before the market reaches their trigger price
+	if s.Type != order.Market && s.Type != order.Limit {
+		return nil, fmt.Errorf("%w %v", order.ErrUnsupportedOrderType, s.Type)
+	}
+	if err := s.Validate(e.GetTradingRequirements()); err != nil {
+		return nil, err
+	}
+	if s.AssetType != asset.Spot {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, s.AssetType)
+	}
+	depth, err := e.getDepth(ctx, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if s.Type == order.Market {
+		return e.submitMarketOrder(ctx, s, depth)
+	}
+	return e.submitLimitOrder(ctx, s, depth)
+}
+
+// WebsocketSubmitOrder simulates an order the same as SubmitOrder
+func (e *Exchange) WebsocketSubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	return e.SubmitOrder(ctx, s)
+}
+
+// WebsocketSubmitOrders simulates a batch of orders the same as SubmitOrder
+func (e *Exchange) WebsocketSubmitOrders(ctx context.Context, orders []*order.Submit) ([]*order.SubmitResponse, error) {
+	resp := make([]*order.SubmitResponse, 0, len(orders))
+	var errs error
+	for i := range orders {
+		r, err := e.SubmitOrder(ctx, orders[i])
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		resp = append(resp, r)
+	}
+	return resp, errs
+}
+
+func (e *Exchange) submitMarketOrder(ctx context.Context, s *order.Submit, depth *orderbook.Depth) (*order.SubmitResponse, error) {
+	var m *orderbook.Movement
+	var err error
+	switch {
+	case s.Side.IsLong() && s.Amount > 0:
+		m, err = depth.LiftTheAsksFromBest(s.Amount, true)
+	case s.Side.IsLong():
+		m, err = depth.LiftTheAsksFromBest(s.QuoteAmount, false)
+	case s.Amount > 0:
+		m, err = depth.HitTheBidsFromBest(s.Amount, false)
+	default:
+		m, err = depth.HitTheBidsFromBest(s.QuoteAmount, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.FullBookSideConsumed {
+		return nil, fmt.Errorf("%w %v %v %v", errInsufficientLiquidity, e.GetName(), s.AssetType, s.Pair)
+	}
+	baseAmount, quoteAmount := m.Sold, m.Purchased
+	if s.Side.IsLong() {
+		baseAmount, quoteAmount = m.Purchased, m.Sold
+	}
+	price := quoteAmount / baseAmount
+	fee, err := e.getFee(ctx, s.Pair, price, baseAmount, false)
+	if err != nil {
+		return nil, err
+	}
+	po, err := e.newOrder(s)
+	if err != nil {
+		return nil, err
+	}
+	po.detail.Price = price
+	po.detail.Amount = baseAmount
+	po.detail.RemainingAmount = baseAmount
+	po.fee = fee
+
+	e.m.Lock()
+	if err = e.reserve(po); err != nil {
+		e.m.Unlock()
+		return nil, err
+	}
+	e.orders[po.detail.OrderID] = po
+	f := e.fill(po, price, baseAmount, fee, false)
+	detail := po.detail.Copy()
+	e.m.Unlock()
+
+	e.publish(&detail, f)
+	return submitResponse(&detail), nil
+}
+
+func (e *Exchange) submitLimitOrder(ctx context.Context, s *order.Submit, depth *orderbook.Depth) (*order.SubmitResponse, error) {
+	amount := s.Amount
+	if amount == 0 {
+		amount = s.QuoteAmount / s.Price
+	}
+	matched, cost, err := crossingAmount(depth, s.Side, s.Price, amount)
+	if err != nil {
+		return nil, err
+	}
+	if matched > 0 && s.TimeInForce.Is(order.PostOnly) {
+		return nil, fmt.Errorf("%w %v %v at %v", errPostOnlyWouldCross, s.Side, s.Pair, s.Price)
+	}
+	if s.TimeInForce.Is(order.FillOrKill) && amount-matched > amount*fillTolerance {
+		matched, cost = 0, 0
+	}
+	rests := amount-matched > amount*fillTolerance && !s.TimeInForce.Is(order.ImmediateOrCancel) && !s.TimeInForce.Is(order.FillOrKill)
+	var takerFee, makerFee float64
+	if matched > 0 {
+		if takerFee, err = e.getFee(ctx, s.Pair, cost/matched, matched, false); err != nil {
+			return nil, err
+		}
+	}
+	if rests {
+		if makerFee, err = e.getFee(ctx, s.Pair, s.Price, amount-matched, true); err != nil {
+			return nil, err
+		}
+	}
+	po, err := e.newOrder(s)
+	if err != nil {
+		return nil, err
+	}
+	po.detail.Amount = amount
+	po.detail.RemainingAmount = amount
+	po.fee = takerFee + makerFee
+
+	e.m.Lock()
+	if err = e.reserve(po); err != nil {
+		e.m.Unlock()
+		return nil, err
+	}
+	e.orders[po.detail.OrderID] = po
+	var fills []fill.Data
+	if matched > 0 {
+		fills = append(fills, e.fill(po, cost/matched, matched, takerFee, false))
+	}
+	switch {
+	case !po.detail.IsActive():
+	case rests:
+		e.watch(depth, key.PairAsset{Base: s.Pair.Base.Item, Quote: s.Pair.Quote.Item, Asset: s.AssetType})
+	default:
+		e.release(po)
+		po.detail.Status = order.Cancelled
+		if po.detail.ExecutedAmount > 0 {
+			po.detail.Status = order.PartiallyFilledCancelled
+		}
+		po.detail.CloseTime = time.Now()
+	}
+	detail := po.detail.Copy()
+	e.m.Unlock()
+
+	e.publish(&detail, fills...)
+	return submitResponse(&detail), nil
+}
+
+// ModifyOrder amends the price or amount of a resting limit order
+func (e *Exchange) ModifyOrder(ctx context.Context, action *order.Modify) (*order.ModifyResponse, error) {
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	e.m.Lock()
+	po, err := e.getActiveOrder(action.OrderID)
+	if err != nil {
+		e.m.Unlock()
+		return nil, err
+	}
+	price, amount, executed := po.detail.Price, po.detail.Amount, po.detail.ExecutedAmount
+	pair, a := po.detail.Pair, po.detail.AssetType
+	e.m.Unlock()
+
+	if action.Price > 0 {
+		price = action.Price
+	}
+	if action.Amount > 0 {
+		amount = action.Amount
+	}
+	if amount <= executed {
+		return nil, fmt.Errorf("%w %v executed %v", errAmountBelowExecuted, action.OrderID, executed)
+	}
+	fee, err := e.getFee(ctx, pair, price, amount-executed, true)
+	if err != nil {
+		return nil, err
+	}
+
+	e.m.Lock()
+	// the order may have filled while the fee was retrieved
+	po, err = e.getActiveOrder(action.OrderID)
+	if err != nil {
+		e.m.Unlock()
+		return nil, err
+	}
+	if filled := po.detail.ExecutedAmount; filled != executed {
+		if amount <= filled {
+			e.m.Unlock()
+			return nil, fmt.Errorf("%w %v executed %v", errAmountBelowExecuted, action.OrderID, filled)
+		}
+		fee *= (amount - filled) / (amount - executed)
+		executed = filled
+	}
+	e.release(po)
+	previousPrice, previousAmount, previousRemaining, previousFee := po.detail.Price, po.detail.Amount, po.detail.RemainingAmount, po.fee
+	po.detail.Price, po.detail.Amount, po.detail.RemainingAmount, po.fee = price, amount, amount-executed, fee
+	if err = e.reserve(po); err != nil {
+		po.detail.Price, po.detail.Amount, po.detail.RemainingAmount, po.fee = previousPrice, previousAmount, previousRemaining, previousFee
+		if reserveErr := e.reserve(po); reserveErr != nil {
+			err = common.AppendError(err, reserveErr)
+		}
+		e.m.Unlock()
+		return nil, err
+	}
+	po.detail.LastUpdated = time.Now()
+	detail := po.detail.Copy()
+	e.m.Unlock()
+
+	resp, err := action.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Price = detail.Price
+	resp.Amount = detail.Amount
+	resp.RemainingAmount = detail.RemainingAmount
+	resp.Status = detail.Status
+	resp.Date = detail.Date
+	resp.LastUpdated = detail.LastUpdated
+
+	e.publish(&detail)
+	// the amended price may already cross the orderbook
+	if depth, err := orderbook.GetDepth(e.GetName(), pair, a); err == nil {
+		e.matchDepth(depth, key.PairAsset{Base: pair.Base.Item, Quote: pair.Quote.Item, Asset: a})
+	}
+	return resp, nil
+}
+
+// CancelOrder cancels a resting order and releases its reserved funds
+func (e *Exchange) CancelOrder(_ context.Context, o *order.Cancel) error {
+	if err := o.Validate(o.StandardCancel()); err != nil {
+		return err
+	}
+	e.m.Lock()
+	detail, err := e.cancel(o.OrderID)
+	e.m.Unlock()
+	if err != nil {
+		return err
+	}
+	e.publish(detail)
+	return nil
+}
+
+// CancelBatchOrders cancels multiple resting orders
+func (e *Exchange) CancelBatchOrders(_ context.Context, o []order.Cancel) (*order.CancelBatchResponse, error) {
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(o))}
+	details := make([]*order.Detail, 0, len(o))
+	e.m.Lock()
+	for i := range o {
+		if err := o[i].Validate(o[i].StandardCancel()); err != nil {
+			resp.Status[o[i].OrderID] = err.Error()
+			continue
+		}
+		detail, err := e.cancel(o[i].OrderID)
+		if err != nil {
+			resp.Status[o[i].OrderID] = err.Error()
+			continue
+		}
+		resp.Status[o[i].OrderID] = order.Cancelled.String()
+		details = append(details, detail)
+	}
+	e.m.Unlock()
+	for i := range details {
+		e.publish(details[i])
+	}
+	return resp, nil
+}
+
+// CancelAllOrders cancels all resting orders matching the optional asset and
+// pair
+func (e *Exchange) CancelAllOrders(_ context.Context, o *order.Cancel) (order.CancelAllResponse, error) {
+	if err := o.Validate(); err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	var details []*order.Detail
+	e.m.Lock()
+	for id, po := range e.orders {
+		if !po.detail.IsActive() ||
+			(o.AssetType != asset.Empty && po.detail.AssetType != o.AssetType) ||
+			(!o.Pair.IsEmpty() && !po.detail.Pair.Equal(o.Pair)) {
+			continue
+		}
+		detail, err := e.cancel(id)
+		if err != nil {
+			resp.Status[id] = err.Error()
+			continue
+		}
+		resp.Status[id] = order.Cancelled.String()
+		resp.Count++
+		details = append(details, detail)
+	}
+	e.m.Unlock()
+	for i := range details {
+		e.publish(details[i])
+	}
+	return resp, nil
+}
+
+// GetOrderInfo returns a simulated order
+func (e *Exchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	po, ok := e.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errOrderNotFound, orderID)
+	}
+	return po.detail.CopyToPointer(), nil
+}
+
+// GetActiveOrders returns resting simulated orders
+func (e *Exchange) GetActiveOrders(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, true)), nil
+}
+
+// GetOrderHistory returns filled and cancelled simulated orders
+func (e *Exchange) GetOrderHistory(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, false)), nil
+}
+
+// UpdateAccountInfo returns the simulated account balances
+func (e *Exchange) UpdateAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return e.holdings(a)
+}
+
+// GetCachedAccountInfo returns the simulated account balances
+func (e *Exchange) GetCachedAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return e.holdings(a)
+}
+
+// WithdrawCryptocurrencyFunds is disabled when paper trading
+func (e *Exchange) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, errWithdrawalsDisabled
+}
+
+// WithdrawFiatFunds is disabled when paper trading
+func (e *Exchange) WithdrawFiatFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, errWithdrawalsDisabled
+}
+
+// WithdrawFiatFundsToInternationalBank is disabled when paper trading
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, errWithdrawalsDisabled
+}
+
+func (e *Exchange) holdings(a asset.Item) (account.Holdings, error) {
+	if !a.IsValid() {
+		return account.Holdings{}, fmt.Errorf("%v %w", a, asset.ErrNotSupported)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	sub := account.SubAccount{AssetType: a, Currencies: make([]account.Balance, 0, len(e.balances[a]))}
+	for _, b := range e.balances[a] {
+		sub.Currencies = append(sub.Currencies, account.Balance{
+			Currency:               b.currency,
+			Total:                  b.total,
+			Hold:                   b.hold,
+			Free:                   b.total - b.hold,
+			AvailableWithoutBorrow: b.total - b.hold,
+			UpdatedAt:              time.Now(),
+		})
+	}
+	slices.SortFunc(sub.Currencies, func(a, b account.Balance) int {
+		return strings.Compare(a.Currency.String(), b.Currency.String())
+	})
+	return account.Holdings{Exchange: e.GetName(), Accounts: []account.SubAccount{sub}}, nil
+}
+
+func (e *Exchange) getOrders(a asset.Item, active bool) []order.Detail {
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := make([]order.Detail, 0, len(e.orders))
+	for _, po := range e.orders {
+		if po.detail.AssetType != a || po.detail.IsActive() != active {
+			continue
+		}
+		resp = append(resp, po.detail.Copy())
+	}
+	return resp
+}
+
+// getDepth returns the live orderbook depth, fetching the orderbook from the
+// exchange when it is not yet being synced
+func (e *Exchange) getDepth(ctx context.Context, pair currency.Pair, a asset.Item) (*orderbook.Depth, error) {
+	depth, err := orderbook.GetDepth(e.GetName(), pair, a)
+	if err == nil && depth.IsValid() {
+		return depth, nil
+	}
+	if _, err = e.IBotExchange.UpdateOrderbook(ctx, pair, a); err != nil {
+		return nil, err
+	}
+	return orderbook.GetDepth(e.GetName(), pair, a)
+}
+
+// getFee returns the exchange trading fee for an order. The offline fee
+// schedule is used when the account fee cannot be retrieved
+func (e *Exchange) getFee(ctx context.Context, pair currency.Pair, price, amount float64, isMaker bool) (float64, error) {
+	f := &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          pair,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        amount,
+	}
+	fee, err := e.IBotExchange.GetFeeByType(ctx, f)
+	if err == nil {
+		return fee, nil
+	}
+	f.FeeType = exchange.OfflineTradeFee
+	fee, err = e.IBotExchange.GetFeeByType(ctx, f)
+	if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+		log.Warnf(log.ExchangeSys, "%s paper trading fees unavailable, orders will not be charged fees: %v", e.GetName(), err)
+		return 0, nil
+	}
+	return fee, err
+}
+
+func (e *Exchange) newOrder(s *order.Submit) (*paperOrder, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.DeriveSubmitResponse(id.String())
+	if err != nil {
+		return nil, err
+	}
+	resp.Status = order.New
+	detail, err := resp.DeriveDetail(uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	return &paperOrder{detail: *detail}, nil
+}
+
+// getBalance returns a currency balance, creating it when it does not exist.
+// Must be called with the lock held
+func (e *Exchange) getBalance(a asset.Item, c currency.Code) *balance {
+	balances, ok := e.balances[a]
+	if !ok {
+		balances = make(map[*currency.Item]*balance)
+		e.balances[a] = balances
+	}
+	b, ok := balances[c.Item]
+	if !ok {
+		b = &balance{currency: c}
+		balances[c.Item] = b
+	}
+	return b
+}
+
+// reserve holds the funds an order's remaining amount requires. Buy orders
+// hold the quote cost plus fees and sell orders hold the base amount. Must be
+// called with the lock held
+func (e *Exchange) reserve(po *paperOrder) error {
+	holdCurrency, hold := po.required()
+	b := e.getBalance(po.detail.AssetType, holdCurrency)
+	if free := b.total - b.hold; free < hold {
+		return fmt.Errorf("%w %v %v free %v required %v", errInsufficientBalance, po.detail.AssetType, holdCurrency, free, hold)
+	}
+	po.holdCurrency, po.hold = holdCurrency, hold
+	b.hold += hold
+	return nil
+}
+
+// required returns the currency and amount an order's remaining amount needs
+// held. Buy orders need the quote cost plus fees and sell orders need the
+// base amount
+func (po *paperOrder) required() (currency.Code, float64) {
+	d := &po.detail
+	if d.Side.IsLong() {
+		return d.Pair.Quote, d.Price*d.RemainingAmount + po.fee
+	}
+	return d.Pair.Base, d.RemainingAmount
+}
+
+// release returns an order's held funds. Must be called with the lock held
+func (e *Exchange) release(po *paperOrder) {
+	if po.hold == 0 {
+		return
+	}
+	e.getBalance(po.detail.AssetType, po.holdCurrency).hold -= po.hold
+	po.hold = 0
+}
+
+// fill settles an amount of an order at the supplied price and charges the
+// supplied fee in the quote currency. Funds are held again for any amount
+// which remains. Must be called with the lock held
+func (e *Exchange) fill(po *paperOrder, price, amount, fee float64, isMaker bool) fill.Data {
+	e.release(po)
+	d := &po.detail
+	cost := price * amount
+	base := e.getBalance(d.AssetType, d.Pair.Base)
+	quote := e.getBalance(d.AssetType, d.Pair.Quote)
+	if d.Side.IsLong() {
+		quote.total -= cost + fee
+		base.total += amount
+	} else {
+		base.total -= amount
+		quote.total += cost - fee
+	}
+	po.fee -= fee
+	now := time.Now()
+	tradeID := d.OrderID + "-" + strconv.Itoa(len(d.Trades)+1)
+	d.ExecutedAmount += amount
+	d.RemainingAmount -= amount
+	d.Cost += cost
+	d.AverageExecutedPrice = d.Cost / d.ExecutedAmount
+	d.CostAsset = d.Pair.Quote
+	d.Fee += fee
+	d.FeeAsset = d.Pair.Quote
+	d.LastUpdated = now
+	if d.RemainingAmount > d.Amount*fillTolerance {
+		d.Status = order.PartiallyFilled
+		// the fill cost no more than was held for it so the remainder is
+		// always covered
+		po.holdCurrency, po.hold = po.required()
+		e.getBalance(d.AssetType, po.holdCurrency).hold += po.hold
+	} else {
+		d.ExecutedAmount = d.Amount
+		d.RemainingAmount = 0
+		d.Status = order.Filled
+		d.CloseTime = now
+	}
+	d.Trades = append(d.Trades, order.TradeHistory{
+		Price:     price,
+		Amount:    amount,
+		Fee:       fee,
+		Exchange:  d.Exchange,
+		TID:       tradeID,
+		Type:      d.Type,
+		Side:      d.Side,
+		Timestamp: now,
+		IsMaker:   isMaker,
+		FeeAsset:  d.Pair.Quote.String(),
+		Total:     cost,
+	})
+	return fill.Data{
+		ID:            tradeID,
+		Timestamp:     now,
+		Exchange:      d.Exchange,
+		AssetType:     d.AssetType,
+		CurrencyPair:  d.Pair,
+		Side:          d.Side,
+		OrderID:       d.OrderID,
+		ClientOrderID: d.ClientOrderID,
+		TradeID:       tradeID,
+		Price:         price,
+		Amount:        amount,
+	}
+}
+
+// cancel cancels an active order. Must be called with the lock held
+func (e *Exchange) cancel(orderID string) (*order.Detail, error) {
+	po, err := e.getActiveOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	e.release(po)
+	po.detail.Status = order.Cancelled
+	if po.detail.ExecutedAmount > 0 {
+		po.detail.Status = order.PartiallyFilledCancelled
+	}
+	po.detail.LastUpdated = time.Now()
+	po.detail.CloseTime = po.detail.LastUpdated
+	return po.detail.CopyToPointer(), nil
+}
+
+// getActiveOrder must be called with the lock held
+func (e *Exchange) getActiveOrder(orderID string) (*paperOrder, error) {
+	po, ok := e.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errOrderNotFound, orderID)
+	}
+	if !po.detail.IsActive() {
+		return nil, fmt.Errorf("%w %v %v", errOrderNotActive, orderID, po.detail.Status)
+	}
+	return po, nil
+}
+
+// watch starts matching resting orders on a pair against orderbook updates.
+// Must be called with the lock held
+func (e *Exchange) watch(depth *orderbook.Depth, k key.PairAsset) {
+	if e.watching[k] {
+		return
+	}
+	e.watching[k] = true
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		for {
+			// Register for the next update before inspecting the book so that
+			// an update landing between the check and the wait is not missed
+			alert := depth.Wait(e.shutdown)
+			resting := e.matchDepth(depth, k)
+			if kicked := <-alert; kicked || !resting {
+				return
+			}
+		}
+	}()
+}
+
+// matchDepth fills the resting orders which the orderbook has crossed against
+// the volume of the levels at or better than their price and returns whether
+// any orders remain resting on the pair. Orders are matched in price then time
+// priority and volume which has already been filled is not filled again
+func (e *Exchange) matchDepth(depth *orderbook.Depth, k key.PairAsset) bool {
+	// an invalid orderbook has no levels and fills nothing
+	asks, bids, _ := depth.GetLevels(0)
+	askAmounts, bidAmounts := levelAmounts(asks), levelAmounts(bids)
+	var details []order.Detail
+	var fills []fill.Data
+	resting := false
+	e.m.Lock()
+	taken, ok := e.taken[k]
+	if !ok {
+		taken = &takenVolume{}
+		e.taken[k] = taken
+	}
+	removeTaken(asks, taken.asks)
+	removeTaken(bids, taken.bids)
+	active := make([]*paperOrder, 0, len(e.orders))
+	for _, po := range e.orders {
+		d := &po.detail
+		if !d.IsActive() || d.AssetType != k.Asset || d.Pair.Base.Item != k.Base || d.Pair.Quote.Item != k.Quote {
+			continue
+		}
+		active = append(active, po)
+	}
+	slices.SortFunc(active, func(a, b *paperOrder) int {
+		if a.detail.Side.IsLong() != b.detail.Side.IsLong() {
+			if a.detail.Side.IsLong() {
+				return -1
+			}
+			return 1
+		}
+		c := cmp.Compare(a.detail.Price, b.detail.Price)
+		if a.detail.Side.IsLong() {
+			c = -c
+		}
+		if c == 0 {
+			c = a.detail.Date.Compare(b.detail.Date)
+		}
+		return c
+	})
+	for _, po := range active {
+		d := &po.detail
+		levels := bids
+		if d.Side.IsLong() {
+			levels = asks
+		}
+		if amount, _ := takeLevels(levels, d.Side, d.Price, d.RemainingAmount); amount > 0 {
+			fills = append(fills, e.fill(po, d.Price, amount, po.fee*amount/d.RemainingAmount, true))
+			details = append(details, d.Copy())
+		}
+		if d.IsActive() {
+			resting = true
+		}
+	}
+	taken.asks, taken.bids = takenAmounts(asks, askAmounts), takenAmounts(bids, bidAmounts)
+	if !resting {
+		delete(e.watching, k)
+		delete(e.taken, k)
+	}
+	e.m.Unlock()
+	for i := range details {
+		e.publish(&details[i], fills[i])
+	}
+	return resting
+}
+
+// publish sends simulated order updates and fills through the exchange
+// websocket so that subscribers receive them as they would from the exchange
+func (e *Exchange) publish(d *order.Detail, fills ...fill.Data) {
+	ws, err := e.IBotExchange.GetWebsocket()
+	if err != nil || ws == nil || !ws.IsConnected() {
+		return
+	}
+	if err := ws.Fills.Update(fills...); err != nil && !errors.Is(err, fill.ErrFeedDisabled) {
+		log.Errorf(log.ExchangeSys, "%s paper trading fill update error: %v", e.GetName(), err)
+	}
+	ws.DataHandler <- d
+}
+
+// crossingAmount walks the opposing orderbook side from the best price up to
+// the limit price and returns the amount an order would match and its cost
+func crossingAmount(depth *orderbook.Depth, side order.Side, price, amount float64) (matched, cost float64, err error) {
+	asks, bids, err := depth.GetLevels(0)
+	if err != nil {
+		return 0, 0, err
+	}
+	levels := bids
+	if side.IsLong() {
+		levels = asks
+	}
+	matched, cost = takeLevels(levels, side, price, amount)
+	return matched, cost, nil
+}
+
+// levelAmounts returns the volume of each level
+func levelAmounts(levels orderbook.Levels) []float64 {
+	amounts := make([]float64, len(levels))
+	for i := range levels {
+		amounts[i] = levels[i].Amount
+	}
+	return amounts
+}
+
+// removeTaken removes the volume which has already been filled from each
+// level. Volume which has since left a level is no longer counted as taken
+func removeTaken(levels orderbook.Levels, taken map[float64]float64) {
+	for i := range levels {
+		levels[i].Amount -= min(taken[levels[i].Price], levels[i].Amount)
+	}
+}
+
+// takenAmounts returns the volume which has been filled from each level
+// compared to the volume the orderbook shows
+func takenAmounts(levels orderbook.Levels, shown []float64) map[float64]float64 {
+	taken := make(map[float64]float64)
+	for i := range levels {
+		if t := shown[i] - levels[i].Amount; t > 0 {
+			taken[levels[i].Price] = t
+		}
+	}
+	return taken
+}
+
+// takeLevels matches an amount against the opposing levels from the best
+// price up to the limit price, removing the matched volume from the levels,
+// and returns the amount matched and its cost
+func takeLevels(levels orderbook.Levels, side order.Side, price, amount float64) (matched, cost float64) {
+	for i := range levels {
+		if (side.IsLong() && levels[i].Price > price) || (side.IsShort() && levels[i].Price < price) {
+			break
+		}
+		take := min(levels[i].Amount, amount-matched)
+		if take <= 0 {
+			continue
+		}
+		levels[i].Amount -= take
+		matched += take
+		cost += take * levels[i].Price
+		if amount-matched <= amount*fillTolerance {
+			break
+		}
+	}
+	return matched, cost
+}
+
+func submitResponse(d *order.Detail) *order.SubmitResponse {
+	resp := &order.SubmitResponse{
+		Exchange:             d.Exchange,
+		Type:                 d.Type,
+		Side:                 d.Side,
+		Pair:                 d.Pair,
+		AssetType:            d.AssetType,
+		TimeInForce:          d.TimeInForce,
+		Price:                d.Price,
+		Amount:               d.Amount,
+		QuoteAmount:          d.QuoteAmount,
+		RemainingAmount:      d.RemainingAmount,
+		ClientID:             d.ClientID,
+		ClientOrderID:        d.ClientOrderID,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		LastUpdated:          d.LastUpdated,
+		Date:                 d.Date,
+		Status:               d.Status,
+		OrderID:              d.OrderID,
+		Trades:               d.Trades,
+		Fee:                  d.Fee,
+		FeeAsset:             d.FeeAsset,
+		Cost:                 d.Cost,
+	}
+	if d.ExecutedAmount > 0 {
+		resp.Purchased = d.ExecutedAmount
+		if d.Side.IsShort() {
+			resp.Purchased = d.Cost - d.Fee
+		}
+	}
+	return resp
+}
@@ -0,0 +1,480 @@
+package papertrading
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+const feeRate = 0.001
+
+var testPair = currency.NewBTCUSDT()
+
+// fakeExchange overrides the functions the simulator relies upon
+type fakeExchange struct {
+	exchange.IBotExchange
+	name           string
+	feeUnsupported bool
+	shutdown       bool
+}
+
+func (f *fakeExchange) GetName() string {
+	return f.name
+}
+
+func (f *fakeExchange) GetTradingRequirements() protocol.TradingRequirements {
+	return protocol.TradingRequirements{}
+}
+
+func (f *fakeExchange) GetFeeByType(_ context.Context, b *exchange.FeeBuilder) (float64, error) {
+	if f.feeUnsupported {
+		return 0, common.ErrFunctionNotSupported
+	}
+	if b.FeeType != exchange.OfflineTradeFee {
+		return 0, exchange.ErrCredentialsAreEmpty
+	}
+	return b.PurchasePrice * b.Amount * feeRate, nil
+}
+
+func (f *fakeExchange) UpdateOrderbook(context.Context, currency.Pair, asset.Item) (*orderbook.Book, error) {
+	return nil, orderbook.ErrOrderbookNotFound
+}
+
+func (f *fakeExchange) GetWebsocket() (*websocket.Manager, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (f *fakeExchange) Shutdown() error {
+	f.shutdown = true
+	return nil
+}
+
+// newTestExchange returns a simulator for a uniquely named exchange with an
+// orderbook and starting balances of 1 BTC and 10000 USDT
+func newTestExchange(t *testing.T) (*Exchange, *orderbook.Depth) {
+	t.Helper()
+	name := t.Name()
+	depth, err := orderbook.DeployDepth(name, testPair, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	loadBook(t, depth, 100, 101)
+	e, err := New(&fakeExchange{name: name}, &config.PaperTrading{
+		Balances: []config.PaperTradingBalance{
+			{Exchange: name, Asset: asset.Spot, Currency: currency.BTC, Amount: 1},
+			{Exchange: name, Asset: asset.Spot, Currency: currency.USDT, Amount: 10000},
+			{Exchange: "other", Asset: asset.Spot, Currency: currency.USDT, Amount: 1337},
+		},
+	})
+	require.NoError(t, err, "New must not error")
+	t.Cleanup(func() { assert.NoError(t, e.Shutdown(), "Shutdown should not error") })
+	return e, depth
+}
+
+func loadBook(t *testing.T, depth *orderbook.Depth, bid, ask float64) {
+	t.Helper()
+	require.NoError(t, depth.LoadSnapshot(&orderbook.Book{
+		Bids:         orderbook.Levels{{Price: bid, Amount: 2}, {Price: bid - 1, Amount: 2}},
+		Asks:         orderbook.Levels{{Price: ask, Amount: 2}, {Price: ask + 1, Amount: 2}},
+		LastUpdated:  time.Now(),
+		LastPushed:   time.Now(),
+		RestSnapshot: true,
+	}), "LoadSnapshot must not error")
+}
+
+func getBalance(t *testing.T, e *Exchange, c currency.Code) (total, free float64) {
+	t.Helper()
+	h, err := e.UpdateAccountInfo(t.Context(), asset.Spot)
+	require.NoError(t, err, "UpdateAccountInfo must not error")
+	require.Len(t, h.Accounts, 1, "UpdateAccountInfo must return one account")
+	for _, b := range h.Accounts[0].Currencies {
+		if b.Currency.Equal(c) {
+			return b.Total, b.Free
+		}
+	}
+	return 0, 0
+}
+
+func newSubmit(e *Exchange, side order.Side, orderType order.Type, price, amount float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  e.GetName(),
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      side,
+		Type:      orderType,
+		Price:     price,
+		Amount:    amount,
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, &config.PaperTrading{})
+	assert.ErrorIs(t, err, errNilExchange)
+
+	_, err = New(&fakeExchange{name: "test"}, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = New(&fakeExchange{name: "test"}, &config.PaperTrading{
+		Balances: []config.PaperTradingBalance{{Exchange: "TEST", Asset: asset.Spot, Amount: 1}},
+	})
+	assert.ErrorIs(t, err, errInvalidBalance)
+
+	e, err := New(&fakeExchange{name: "test"}, &config.PaperTrading{
+		Balances: []config.PaperTradingBalance{
+			{Exchange: "TEST", Asset: asset.Spot, Currency: currency.USDT, Amount: 1},
+			{Exchange: "test", Asset: asset.Spot, Currency: currency.USDT, Amount: 2},
+			{Exchange: "other", Asset: asset.Spot, Currency: currency.BTC, Amount: 3},
+		},
+	})
+	require.NoError(t, err, "New must not error")
+	total, free := getBalance(t, e, currency.USDT)
+	assert.Equal(t, 3.0, total, "New should load matching balances")
+	assert.Equal(t, 3.0, free, "New should load matching balances")
+	total, _ = getBalance(t, e, currency.BTC)
+	assert.Zero(t, total, "New should ignore balances for other exchanges")
+}
+
+func TestSubmitMarketOrder(t *testing.T) {
+	t.Parallel()
+	e, _ := newTestExchange(t)
+
+	s := newSubmit(e, order.Buy, order.Market, 0, 1)
+	s.AssetType = asset.Futures
+	_, err := e.SubmitOrder(t.Context(), s)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	_, err = e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Market, 0, 5))
+	assert.ErrorIs(t, err, errInsufficientLiquidity)
+
+	resp, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Market, 0, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status)
+	assert.Equal(t, 101.0, resp.AverageExecutedPrice)
+	assert.InDelta(t, 202*feeRate, resp.Fee, 1e-9)
+	assert.Equal(t, 2.0, resp.Purchased)
+	require.Len(t, resp.Trades, 1, "SubmitOrder must return the fill")
+	total, _ := getBalance(t, e, currency.BTC)
+	assert.Equal(t, 3.0, total)
+	total, _ = getBalance(t, e, currency.USDT)
+	assert.InDelta(t, 10000-202-202*feeRate, total, 1e-9)
+
+	resp, err = e.SubmitOrder(t.Context(), newSubmit(e, order.Sell, order.Market, 0, 3))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, (200+99)/3.0, resp.AverageExecutedPrice, "SubmitOrder should walk the bids")
+	total, _ = getBalance(t, e, currency.BTC)
+	assert.Zero(t, total)
+
+	_, err = e.SubmitOrder(t.Context(), newSubmit(e, order.Sell, order.Market, 0, 1))
+	assert.ErrorIs(t, err, errInsufficientBalance)
+
+	s = newSubmit(e, order.Buy, order.Market, 0, 0)
+	s.QuoteAmount = 101
+	resp, err = e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, 1.0, resp.Amount, "SubmitOrder should derive the base amount from the quote amount")
+}
+
+func TestSubmitLimitOrder(t *testing.T) {
+	t.Parallel()
+	e, depth := newTestExchange(t)
+
+	s := newSubmit(e, order.Buy, order.Limit, 101, 1)
+	s.TimeInForce = order.PostOnly
+	_, err := e.SubmitOrder(t.Context(), s)
+	assert.ErrorIs(t, err, errPostOnlyWouldCross)
+
+	for _, orderType := range []order.Type{order.Stop, order.StopLimit, order.TakeProfit, order.TrailingStop} {
+		s = newSubmit(e, order.Sell, orderType, 95, 1)
+		s.TriggerPrice = 95
+		_, err = e.SubmitOrder(t.Context(), s)
+		assert.ErrorIsf(t, err, order.ErrUnsupportedOrderType, "SubmitOrder should reject %s orders", orderType)
+	}
+	total, free := getBalance(t, e, currency.BTC)
+	assert.Equal(t, 1.0, total, "rejected trigger orders should not fill")
+	assert.Equal(t, total, free, "rejected trigger orders should not hold funds")
+
+	s = newSubmit(e, order.Buy, order.Limit, 90, 1)
+	s.TimeInForce = order.ImmediateOrCancel
+	resp, err := e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Cancelled, resp.Status, "SubmitOrder should cancel an immediate or cancel order which does not cross")
+
+	_, err = e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 100, 1000))
+	assert.ErrorIs(t, err, errInsufficientBalance)
+
+	resp, err = e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 105, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "SubmitOrder should fill a limit order which crosses the book")
+	assert.Equal(t, 101.0, resp.AverageExecutedPrice, "SubmitOrder should fill at the best ask")
+	require.Len(t, resp.Trades, 1, "SubmitOrder must return the fill")
+	assert.False(t, resp.Trades[0].IsMaker, "SubmitOrder should fill a crossing order as a taker")
+
+	buy, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 95, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, buy.Status)
+	sell, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Sell, order.Limit, 110, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, sell.Status)
+
+	total, free = getBalance(t, e, currency.USDT)
+	assert.InDelta(t, 95+95*feeRate, total-free, 1e-9, "SubmitOrder should hold the resting buy cost and fee")
+	total, free = getBalance(t, e, currency.BTC)
+	assert.Equal(t, 2.0, total-free, "SubmitOrder should hold the resting sell amount")
+
+	active, err := e.GetActiveOrders(t.Context(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetActiveOrders must not error")
+	assert.Len(t, active, 2)
+
+	loadBook(t, depth, 94, 95)
+	assert.Eventually(t, func() bool {
+		d, err := e.GetOrderInfo(t.Context(), buy.OrderID, testPair, asset.Spot)
+		return err == nil && d.Status == order.Filled
+	}, time.Second*5, time.Millisecond*10, "resting buy should fill when the asks cross it")
+
+	d, err := e.GetOrderInfo(t.Context(), buy.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, 95.0, d.AverageExecutedPrice, "resting orders should fill at their limit price")
+	require.Len(t, d.Trades, 1, "GetOrderInfo must return the fill")
+	assert.True(t, d.Trades[0].IsMaker, "resting orders should fill as a maker")
+	total, _ = getBalance(t, e, currency.BTC)
+	assert.Equal(t, 3.0, total)
+
+	loadBook(t, depth, 110, 111)
+	assert.Eventually(t, func() bool {
+		d, err := e.GetOrderInfo(t.Context(), sell.OrderID, testPair, asset.Spot)
+		return err == nil && d.Status == order.Filled
+	}, time.Second*5, time.Millisecond*10, "resting sell should fill when the bids cross it")
+	total, free = getBalance(t, e, currency.BTC)
+	assert.Equal(t, 1.0, total)
+	assert.Equal(t, total, free, "filled orders should release their hold")
+
+	history, err := e.GetOrderHistory(t.Context(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetOrderHistory must not error")
+	assert.Len(t, history, 4)
+
+	_, err = e.GetOrderInfo(t.Context(), "meow", testPair, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotFound)
+}
+
+func TestSubmitLimitOrderPartialFill(t *testing.T) {
+	t.Parallel()
+	e, depth := newTestExchange(t)
+
+	s := newSubmit(e, order.Buy, order.Limit, 102, 5)
+	s.TimeInForce = order.FillOrKill
+	resp, err := e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Cancelled, resp.Status, "SubmitOrder should cancel a fill or kill order which cannot fill in full")
+	assert.Empty(t, resp.Trades, "SubmitOrder should not fill a cancelled fill or kill order")
+
+	s = newSubmit(e, order.Buy, order.Limit, 101, 3)
+	s.TimeInForce = order.ImmediateOrCancel
+	resp, err = e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "SubmitOrder should cancel the remainder of an immediate or cancel order")
+	assert.Equal(t, 1.0, resp.RemainingAmount, "SubmitOrder should only fill levels up to the limit price")
+	total, free := getBalance(t, e, currency.USDT)
+	assert.Equal(t, total, free, "SubmitOrder should release the hold of a cancelled remainder")
+	assert.InDelta(t, 10000-202-202*feeRate, total, 1e-9)
+
+	loadBook(t, depth, 100, 101)
+	resp, err = e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 102, 5))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilled, resp.Status, "SubmitOrder should rest the remainder of a crossing order")
+	assert.Equal(t, 4.0, resp.Purchased, "SubmitOrder should fill every level up to the limit price")
+	assert.Equal(t, 1.0, resp.RemainingAmount)
+	assert.InDelta(t, 101.5, resp.AverageExecutedPrice, 1e-9, "SubmitOrder should fill at the average price of the levels taken")
+	total, free = getBalance(t, e, currency.USDT)
+	assert.InDelta(t, 102+102*feeRate, total-free, 1e-9, "SubmitOrder should hold the resting remainder cost and fee")
+
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: resp.OrderID, Amount: 4})
+	assert.ErrorIs(t, err, errAmountBelowExecuted)
+
+	loadBook(t, depth, 101, 102)
+	assert.Eventually(t, func() bool {
+		d, err := e.GetOrderInfo(t.Context(), resp.OrderID, testPair, asset.Spot)
+		return err == nil && d.Status == order.Filled
+	}, time.Second*5, time.Millisecond*10, "resting remainder should fill when the asks cross it")
+	d, err := e.GetOrderInfo(t.Context(), resp.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	require.Len(t, d.Trades, 2, "GetOrderInfo must return both fills")
+	assert.False(t, d.Trades[0].IsMaker, "crossing fill should be a taker")
+	assert.True(t, d.Trades[1].IsMaker, "resting fill should be a maker")
+	assert.InDelta(t, (406+102)/5.0, d.AverageExecutedPrice, 1e-9)
+	total, free = getBalance(t, e, currency.USDT)
+	assert.Equal(t, total, free, "filled orders should release their hold")
+	assert.InDelta(t, 10000-202-202*feeRate-508-508*feeRate, total, 1e-9)
+	total, _ = getBalance(t, e, currency.BTC)
+	assert.Equal(t, 8.0, total)
+}
+
+func TestMatchDepthPartialFill(t *testing.T) {
+	t.Parallel()
+	e, depth := newTestExchange(t)
+
+	first, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 95, 3))
+	require.NoError(t, err, "SubmitOrder must not error")
+	second, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 96, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	// asks of 95 x 2 and 96 x 2 cross both orders
+	loadBook(t, depth, 94, 95)
+	assert.Eventually(t, func() bool {
+		d, err := e.GetOrderInfo(t.Context(), first.OrderID, testPair, asset.Spot)
+		return err == nil && d.Status == order.PartiallyFilled
+	}, time.Second*5, time.Millisecond*10, "resting order should partially fill against the volume the book shows")
+
+	d, err := e.GetOrderInfo(t.Context(), second.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, d.Status, "the higher priced order should be matched first")
+	d, err = e.GetOrderInfo(t.Context(), first.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, 1.0, d.ExecutedAmount, "the lower priced order should only take the volume left at its price")
+	assert.Equal(t, 2.0, d.RemainingAmount)
+	assert.Equal(t, 95.0, d.AverageExecutedPrice, "resting orders should fill at their limit price")
+	require.Len(t, d.Trades, 1, "GetOrderInfo must return the fill")
+	assert.True(t, d.Trades[0].IsMaker, "resting orders should fill as a maker")
+	total, free := getBalance(t, e, currency.USDT)
+	assert.InDelta(t, 190+190*feeRate, total-free, 1e-9, "the remainder cost and fee should stay held")
+	assert.InDelta(t, 10000-96-96*feeRate-95-95*feeRate, total, 1e-9)
+
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: first.OrderID, Amount: 1})
+	assert.ErrorIs(t, err, errAmountBelowExecuted)
+	k := key.PairAsset{Base: testPair.Base.Item, Quote: testPair.Quote.Item, Asset: asset.Spot}
+	loadBook(t, depth, 94, 95)
+	assert.True(t, e.matchDepth(depth, k), "matchDepth should keep the order resting")
+	d, err = e.GetOrderInfo(t.Context(), first.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, 1.0, d.ExecutedAmount, "volume which has already been filled should not be filled again")
+
+	loadBook(t, depth, 92, 93)
+	assert.Eventually(t, func() bool {
+		d, err := e.GetOrderInfo(t.Context(), first.OrderID, testPair, asset.Spot)
+		return err == nil && d.Status == order.Filled
+	}, time.Second*5, time.Millisecond*10, "resting remainder should fill against new volume")
+}
+
+func TestModifyOrder(t *testing.T) {
+	t.Parallel()
+	e, _ := newTestExchange(t)
+	resp, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 90, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: "meow"})
+	assert.ErrorIs(t, err, errOrderNotFound)
+
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: resp.OrderID, Amount: 1000})
+	assert.ErrorIs(t, err, errInsufficientBalance)
+	total, free := getBalance(t, e, currency.USDT)
+	assert.InDelta(t, 90+90*feeRate, total-free, 1e-9, "ModifyOrder should restore the hold when the modification fails")
+
+	m, err := e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: resp.OrderID, Price: 80, Amount: 2})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, 80.0, m.Price)
+	assert.Equal(t, 2.0, m.Amount)
+	total, free = getBalance(t, e, currency.USDT)
+	assert.InDelta(t, 160+160*feeRate, total-free, 1e-9, "ModifyOrder should update the hold")
+
+	m, err = e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: resp.OrderID, Price: 101})
+	require.NoError(t, err, "ModifyOrder must not error")
+	d, err := e.GetOrderInfo(t.Context(), m.OrderID, testPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, d.Status, "ModifyOrder should fill an order amended to cross the book")
+
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{Pair: testPair, AssetType: asset.Spot, OrderID: resp.OrderID, Price: 90})
+	assert.ErrorIs(t, err, errOrderNotActive)
+}
+
+func TestCancelOrders(t *testing.T) {
+	t.Parallel()
+	e, _ := newTestExchange(t)
+	ids := make([]string, 4)
+	for i := range ids {
+		resp, err := e.SubmitOrder(t.Context(), newSubmit(e, order.Buy, order.Limit, 90, 1))
+		require.NoError(t, err, "SubmitOrder must not error")
+		ids[i] = resp.OrderID
+	}
+
+	err := e.CancelOrder(t.Context(), &order.Cancel{})
+	assert.ErrorIs(t, err, order.ErrOrderIDNotSet)
+
+	err = e.CancelOrder(t.Context(), &order.Cancel{OrderID: ids[0]})
+	require.NoError(t, err, "CancelOrder must not error")
+	err = e.CancelOrder(t.Context(), &order.Cancel{OrderID: ids[0]})
+	assert.ErrorIs(t, err, errOrderNotActive)
+
+	batch, err := e.CancelBatchOrders(t.Context(), []order.Cancel{{OrderID: ids[1]}, {OrderID: "meow"}})
+	require.NoError(t, err, "CancelBatchOrders must not error")
+	assert.Equal(t, order.Cancelled.String(), batch.Status[ids[1]])
+	assert.Contains(t, batch.Status["meow"], errOrderNotFound.Error())
+
+	all, err := e.CancelAllOrders(t.Context(), &order.Cancel{AssetType: asset.Spot, Pair: testPair})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Equal(t, int64(2), all.Count)
+
+	total, free := getBalance(t, e, currency.USDT)
+	assert.Equal(t, total, free, "cancelled orders should release their hold")
+	active, err := e.GetActiveOrders(t.Context(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetActiveOrders must not error")
+	assert.Empty(t, active)
+}
+
+func TestWebsocketSubmitOrders(t *testing.T) {
+	t.Parallel()
+	e, _ := newTestExchange(t)
+	resp, err := e.WebsocketSubmitOrders(t.Context(), []*order.Submit{
+		newSubmit(e, order.Buy, order.Limit, 90, 1),
+		newSubmit(e, order.Buy, order.Limit, 0, 1),
+	})
+	assert.ErrorIs(t, err, order.ErrPriceMustBeSetIfLimitOrder)
+	assert.Len(t, resp, 1)
+}
+
+func TestGetFee(t *testing.T) {
+	t.Parallel()
+	e, err := New(&fakeExchange{name: "test"}, &config.PaperTrading{})
+	require.NoError(t, err, "New must not error")
+	fee, err := e.getFee(t.Context(), testPair, 100, 2, true)
+	require.NoError(t, err, "getFee must not error")
+	assert.Equal(t, 200*feeRate, fee, "getFee should fall back to offline fees")
+
+	e, err = New(&fakeExchange{name: "test", feeUnsupported: true}, &config.PaperTrading{})
+	require.NoError(t, err, "New must not error")
+	fee, err = e.getFee(t.Context(), testPair, 100, 2, true)
+	require.NoError(t, err, "getFee must not error")
+	assert.Zero(t, fee, "getFee should not charge fees when unsupported")
+}
+
+func TestWithdrawalsDisabled(t *testing.T) {
+	t.Parallel()
+	e, err := New(&fakeExchange{name: "test"}, &config.PaperTrading{})
+	require.NoError(t, err, "New must not error")
+	_, err = e.WithdrawCryptocurrencyFunds(t.Context(), nil)
+	assert.ErrorIs(t, err, errWithdrawalsDisabled)
+	_, err = e.WithdrawFiatFunds(t.Context(), nil)
+	assert.ErrorIs(t, err, errWithdrawalsDisabled)
+	_, err = e.WithdrawFiatFundsToInternationalBank(t.Context(), nil)
+	assert.ErrorIs(t, err, errWithdrawalsDisabled)
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	f := &fakeExchange{name: "test"}
+	e, err := New(f, &config.PaperTrading{})
+	require.NoError(t, err, "New must not error")
+	require.NoError(t, e.Shutdown(), "Shutdown must not error")
+	assert.True(t, f.shutdown, "Shutdown should shut down the wrapped exchange")
+	assert.NoError(t, e.Shutdown(), "Shutdown should not error when called twice")
+}
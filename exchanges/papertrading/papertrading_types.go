@@ -0,0 +1,70 @@
+package papertrading
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errNilExchange           = errors.New("exchange is nil")
+	errInsufficientBalance   = errors.New("insufficient balance")
+	errInsufficientLiquidity = errors.New("insufficient orderbook liquidity")
+	errOrderNotFound         = errors.New("order not found")
+	errOrderNotActive        = errors.New("order is not active")
+	errPostOnlyWouldCross    = errors.New("post only order would cross the orderbook")
+	errWithdrawalsDisabled   = errors.New("withdrawals are disabled when paper trading")
+	errInvalidBalance        = errors.New("invalid starting balance")
+	errAmountBelowExecuted   = errors.New("order amount must exceed the executed amount")
+)
+
+// fillTolerance is the relative amount below which an order is considered
+// fully filled
+const fillTolerance = 1e-9
+
+// Exchange wraps an exchange so that market data is passed through to the
+// exchange while order and account management are served by a local
+// simulator. Resting limit orders are filled once the live orderbook crosses
+// their price
+type Exchange struct {
+	exchange.IBotExchange
+	m        sync.Mutex
+	balances map[asset.Item]map[*currency.Item]*balance
+	orders   map[string]*paperOrder
+	// watching holds the pairs with resting orders which are being matched
+	// against orderbook updates
+	watching map[key.PairAsset]bool
+	// taken holds the orderbook volume resting orders have filled against so
+	// that levels which are unchanged by an update are not filled again
+	taken    map[key.PairAsset]*takenVolume
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+}
+
+// balance is a simulated currency balance. Hold is reserved by active orders
+type balance struct {
+	currency currency.Code
+	total    float64
+	hold     float64
+}
+
+// takenVolume is the volume filled against each price level of an orderbook
+type takenVolume struct {
+	asks map[float64]float64
+	bids map[float64]float64
+}
+
+// paperOrder tracks a simulated order and the funds it has reserved
+type paperOrder struct {
+	detail       order.Detail
+	holdCurrency currency.Code
+	hold         float64
+	// fee is the fee estimated for the remaining amount which has not yet
+	// been charged
+	fee float64
+}
@@ -113,7 +113,11 @@ func (r *Requester) InitiateRateLimit(ctx context.Context, e EndpointLimit) erro
 
 	rateLimiter := r.limiter[e]
 
+	start := time.Now()
 	err := RateLimit(ctx, rateLimiter)
+	if rr, ok := r.getReporter().(RateLimitReporter); ok && err == nil {
+		rr.RateLimitWait(r.name, time.Since(start))
+	}
 	if err != nil {
 		return fmt.Errorf("cannot rate limit request %w for endpoint %d", err, e)
 	}
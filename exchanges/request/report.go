@@ -10,8 +10,37 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
-// SetupGlobalReporter sets a reporter interface to be used
-// for all exchange requests
+// ErrorReporter is an optional Reporter extension which observes failed HTTP
+// requests. A status code of zero is reported when no response was received
+type ErrorReporter interface {
+	RequestError(name, method, path string, statusCode int)
+}
+
+// RateLimitReporter is an optional Reporter extension which observes the time
+// spent waiting on the rate limiter before a request is sent
+type RateLimitReporter interface {
+	RateLimitWait(name string, t time.Duration)
+}
+
+// SetupGlobalReporter sets a reporter interface to be used for all exchange
+// requests without their own reporter. It is resolved when each request is
+// sent, so it applies to requesters which have already been created
 func SetupGlobalReporter(r Reporter) {
-	globalReporter = r
+	if r == nil {
+		globalReporter.Store(nil)
+		return
+	}
+	globalReporter.Store(&r)
+}
+
+// getReporter returns the requester's reporter, or the global reporter if it
+// does not have one
+func (r *Requester) getReporter() Reporter {
+	if r.reporter != nil {
+		return r.reporter
+	}
+	if rep := globalReporter.Load(); rep != nil {
+		return *rep
+	}
+	return nil
 }
@@ -65,7 +65,6 @@ func New(name string, httpRequester *http.Client, opts ...RequesterOption) (*Req
 		retryPolicy: DefaultRetryPolicy,
 		maxRetries:  MaxRetryAttempts,
 		timedLock:   timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
-		reporter:    globalReporter,
 	}
 
 	for _, o := range opts {
@@ -197,8 +196,16 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		resp, err := r._HTTPClient.do(req)
 
-		if r.reporter != nil && err == nil {
-			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
+		reporter := r.getReporter()
+		if reporter != nil && err == nil {
+			reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
+		}
+		if er, ok := reporter.(ErrorReporter); ok {
+			if err != nil {
+				er.RequestError(r.name, p.Method, p.Path, 0)
+			} else if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusNoContent {
+				er.RequestError(r.name, p.Method, p.Path, resp.StatusCode)
+			}
 		}
 
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
@@ -13,6 +13,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -617,3 +618,68 @@ func TestGetRateLimiterDefinitions(t *testing.T) {
 	require.NotEmpty(t, r.GetRateLimiterDefinitions())
 	assert.Equal(t, globalshell, r.GetRateLimiterDefinitions())
 }
+
+type testReporter struct {
+	m             sync.Mutex
+	latencies     []string
+	errors        []int
+	rateLimitWait int
+}
+
+func (r *testReporter) Latency(_, _, path string, _ time.Duration) {
+	r.m.Lock()
+	r.latencies = append(r.latencies, path)
+	r.m.Unlock()
+}
+
+func (r *testReporter) RequestError(_, _, _ string, statusCode int) {
+	r.m.Lock()
+	r.errors = append(r.errors, statusCode)
+	r.m.Unlock()
+}
+
+func (r *testReporter) RateLimitWait(string, time.Duration) {
+	r.m.Lock()
+	r.rateLimitWait++
+	r.m.Unlock()
+}
+
+func TestReporter(t *testing.T) {
+	t.Parallel()
+	rep := &testReporter{}
+	r, err := New("test", new(http.Client), WithLimiter(globalshell), WithReporter(rep))
+	require.NoError(t, err, "New requester must not error")
+
+	err = r.SendPayload(t.Context(), UnAuth, func() (*Item, error) { return &Item{Path: testURL}, nil }, UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+	err = r.SendPayload(t.Context(), UnAuth, func() (*Item, error) { return &Item{Path: testURL + "/error"}, nil }, UnauthenticatedRequest)
+	require.ErrorIs(t, err, ErrBadStatus)
+
+	rep.m.Lock()
+	defer rep.m.Unlock()
+	assert.Equal(t, []string{testURL, testURL + "/error"}, rep.latencies, "Latency should be reported for every response")
+	assert.Equal(t, []int{http.StatusBadRequest}, rep.errors, "RequestError should be reported for bad statuses")
+	assert.Equal(t, 2, rep.rateLimitWait, "RateLimitWait should be reported for every request")
+}
+
+func TestGlobalReporter(t *testing.T) {
+	rep := &testReporter{}
+	r, err := New("test", new(http.Client), WithLimiter(globalshell))
+	require.NoError(t, err, "New requester must not error")
+	send := func() {
+		t.Helper()
+		err := r.SendPayload(t.Context(), UnAuth, func() (*Item, error) { return &Item{Path: testURL}, nil }, UnauthenticatedRequest)
+		require.NoError(t, err, "SendPayload must not error")
+	}
+
+	SetupGlobalReporter(rep)
+	t.Cleanup(func() { SetupGlobalReporter(nil) })
+	send()
+	SetupGlobalReporter(nil)
+	send()
+
+	rep.m.Lock()
+	defer rep.m.Unlock()
+	assert.Equal(t, []string{testURL}, rep.latencies, "Global reporter should only be used by existing requesters while it is set")
+	assert.Equal(t, 1, rep.rateLimitWait, "Global reporter should only be used by existing requesters while it is set")
+}
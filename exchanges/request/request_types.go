@@ -3,6 +3,7 @@ package request
 import (
 	"io"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
@@ -21,7 +22,7 @@ const (
 // Vars for rate limiter
 var (
 	MaxRetryAttempts = DefaultMaxRetryAttempts
-	globalReporter   Reporter
+	globalReporter   atomic.Pointer[Reporter]
 )
 
 // Requester struct for the request client
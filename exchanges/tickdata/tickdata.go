@@ -0,0 +1,420 @@
+package tickdata
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// NewWriter returns a Writer which records data for the exchange, asset and
+// pair to w. The file header is written immediately
+func NewWriter(w io.Writer, exchange string, a asset.Item, p currency.Pair) (*Writer, error) {
+	if w == nil {
+		return nil, fmt.Errorf("%w io.Writer", common.ErrNilPointer)
+	}
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w %v", asset.ErrInvalidAsset, a)
+	}
+	if p.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	tw := &Writer{
+		Exchange:         exchange,
+		Asset:            a,
+		Pair:             p,
+		SnapshotInterval: DefaultSnapshotInterval,
+		gz:               gzip.NewWriter(w),
+	}
+	tw.buf = append(tw.buf, magic...)
+	tw.buf = append(tw.buf, version)
+	tw.buf = appendString(tw.buf, exchange)
+	tw.buf = appendString(tw.buf, a.String())
+	tw.buf = appendString(tw.buf, p.Base.String())
+	tw.buf = appendString(tw.buf, p.Quote.String())
+	tw.buf = appendString(tw.buf, p.Delimiter)
+	if err := tw.flush(); err != nil {
+		return nil, err
+	}
+	return tw, nil
+}
+
+// WriteBook records an orderbook. The first book and every SnapshotInterval
+// books after it are written as full snapshots, otherwise only the levels
+// which changed since the previous book are written. Books with no changes
+// are skipped
+func (w *Writer) WriteBook(b *orderbook.Book) error {
+	if w.closed {
+		return errWriterClosed
+	}
+	if b == nil {
+		return fmt.Errorf("%w orderbook", common.ErrNilPointer)
+	}
+	if err := w.matchSource(b.Exchange, b.Asset, b.Pair); err != nil {
+		return err
+	}
+	bids, asks := w.truncate(b.Bids), w.truncate(b.Asks)
+	ts := b.LastUpdated
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	if !w.hasBook || w.deltasSinceSnapshot >= max(w.SnapshotInterval, 1) {
+		w.buf = append(w.buf, byte(RecordSnapshot))
+		w.buf = w.appendTime(w.buf, ts)
+		w.buf = appendLevels(w.buf, bids)
+		w.buf = appendLevels(w.buf, asks)
+		w.deltasSinceSnapshot = 0
+		w.hasBook = true
+	} else {
+		bidChanges, askChanges := diffLevels(w.bids, bids), diffLevels(w.asks, asks)
+		if len(bidChanges) == 0 && len(askChanges) == 0 {
+			return nil
+		}
+		w.buf = append(w.buf, byte(RecordDelta))
+		w.buf = w.appendTime(w.buf, ts)
+		w.buf = appendLevels(w.buf, bidChanges)
+		w.buf = appendLevels(w.buf, askChanges)
+		w.deltasSinceSnapshot++
+	}
+	w.bids = slices.Clone(bids)
+	w.asks = slices.Clone(asks)
+	return w.flush()
+}
+
+// WriteTrade records a trade
+func (w *Writer) WriteTrade(t *trade.Data) error {
+	if w.closed {
+		return errWriterClosed
+	}
+	if t == nil {
+		return fmt.Errorf("%w trade", common.ErrNilPointer)
+	}
+	if err := w.matchSource(t.Exchange, t.AssetType, t.CurrencyPair); err != nil {
+		return err
+	}
+	ts := t.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	var side byte
+	switch {
+	case t.Side.IsLong():
+		side = sideBuy
+	case t.Side.IsShort():
+		side = sideSell
+	}
+	w.buf = append(w.buf, byte(RecordTrade))
+	w.buf = w.appendTime(w.buf, ts)
+	w.buf = append(w.buf, side)
+	w.buf = appendFloat(w.buf, t.Price)
+	w.buf = appendFloat(w.buf, t.Amount)
+	return w.flush()
+}
+
+// Flush flushes any buffered compressed data to the underlying writer
+func (w *Writer) Flush() error {
+	if w.closed {
+		return errWriterClosed
+	}
+	return w.gz.Flush()
+}
+
+// Close flushes and closes the compressed stream. It does not close the
+// underlying writer
+func (w *Writer) Close() error {
+	if w.closed {
+		return errWriterClosed
+	}
+	w.closed = true
+	return w.gz.Close()
+}
+
+func (w *Writer) matchSource(exchange string, a asset.Item, p currency.Pair) error {
+	if !strings.EqualFold(exchange, w.Exchange) || a != w.Asset || !p.Equal(w.Pair) {
+		return fmt.Errorf("%w %s %s %s, received %s %s %s", errSourceMismatch, w.Exchange, w.Asset, w.Pair, exchange, a, p)
+	}
+	return nil
+}
+
+func (w *Writer) truncate(l orderbook.Levels) orderbook.Levels {
+	if w.MaxDepth > 0 && len(l) > w.MaxDepth {
+		return l[:w.MaxDepth]
+	}
+	return l
+}
+
+// appendTime appends the nanosecond difference from the previous record
+func (w *Writer) appendTime(b []byte, t time.Time) []byte {
+	ns := t.UnixNano()
+	b = binary.AppendVarint(b, ns-w.lastTime)
+	w.lastTime = ns
+	return b
+}
+
+func (w *Writer) flush() error {
+	_, err := w.gz.Write(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+// diffLevels returns the levels in next which were added or changed since
+// prev and a zero amount level for each price removed
+func diffLevels(prev, next orderbook.Levels) orderbook.Levels {
+	prevAmounts := make(map[float64]float64, len(prev))
+	for i := range prev {
+		prevAmounts[prev[i].Price] = prev[i].Amount
+	}
+	var changes orderbook.Levels
+	for i := range next {
+		if amount, ok := prevAmounts[next[i].Price]; !ok || amount != next[i].Amount {
+			changes = append(changes, orderbook.Level{Price: next[i].Price, Amount: next[i].Amount})
+		}
+		delete(prevAmounts, next[i].Price)
+	}
+	for price := range prevAmounts {
+		changes = append(changes, orderbook.Level{Price: price})
+	}
+	return changes
+}
+
+func appendLevels(b []byte, l orderbook.Levels) []byte {
+	b = binary.AppendUvarint(b, uint64(len(l)))
+	for i := range l {
+		b = appendFloat(b, l[i].Price)
+		b = appendFloat(b, l[i].Amount)
+	}
+	return b
+}
+
+func appendFloat(b []byte, f float64) []byte {
+	return binary.LittleEndian.AppendUint64(b, math.Float64bits(f))
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// NewReader returns a Reader for tick data written by a Writer, reading and
+// validating the file header
+func NewReader(r io.Reader) (*Reader, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w io.Reader", common.ErrNilPointer)
+	}
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	tr := &Reader{
+		gz:   gz,
+		r:    bufio.NewReader(gz),
+		bids: make(map[float64]float64),
+		asks: make(map[float64]float64),
+	}
+	header := make([]byte, len(magic)+1)
+	if _, err = io.ReadFull(tr.r, header); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidMagic, err)
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, errInvalidMagic
+	}
+	if header[len(magic)] != version {
+		return nil, fmt.Errorf("%w %d", errUnsupportedVersion, header[len(magic)])
+	}
+	if tr.Exchange, err = tr.readString(); err != nil {
+		return nil, err
+	}
+	a, err := tr.readString()
+	if err != nil {
+		return nil, err
+	}
+	if tr.Asset, err = asset.New(a); err != nil {
+		return nil, err
+	}
+	var pair [3]string
+	for i := range pair {
+		if pair[i], err = tr.readString(); err != nil {
+			return nil, err
+		}
+	}
+	tr.Pair = currency.NewPairWithDelimiter(pair[0], pair[1], pair[2])
+	return tr, nil
+}
+
+// Next returns the next record, or io.EOF when there are no more records
+func (r *Reader) Next() (*Record, error) {
+	t, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	rec := &Record{Type: RecordType(t)}
+	if rec.Time, err = r.readTime(); err != nil {
+		return nil, err
+	}
+	switch rec.Type {
+	case RecordSnapshot:
+		clear(r.bids)
+		clear(r.asks)
+		if err = r.readLevels(r.bids); err != nil {
+			return nil, err
+		}
+		if err = r.readLevels(r.asks); err != nil {
+			return nil, err
+		}
+		r.hasBook = true
+		rec.Book = r.book(rec.Time)
+	case RecordDelta:
+		if !r.hasBook {
+			return nil, errDeltaBeforeBook
+		}
+		if err = r.readLevels(r.bids); err != nil {
+			return nil, err
+		}
+		if err = r.readLevels(r.asks); err != nil {
+			return nil, err
+		}
+		rec.Book = r.book(rec.Time)
+	case RecordTrade:
+		side, err := r.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		td := &trade.Data{
+			Exchange:     r.Exchange,
+			CurrencyPair: r.Pair,
+			AssetType:    r.Asset,
+			Timestamp:    rec.Time,
+		}
+		switch side {
+		case sideBuy:
+			td.Side = order.Buy
+		case sideSell:
+			td.Side = order.Sell
+		}
+		if td.Price, err = r.readFloat(); err != nil {
+			return nil, err
+		}
+		if td.Amount, err = r.readFloat(); err != nil {
+			return nil, err
+		}
+		rec.Trade = td
+	default:
+		return nil, fmt.Errorf("%w %d", errUnknownRecordType, t)
+	}
+	return rec, nil
+}
+
+// Close closes the compressed stream. It does not close the underlying
+// reader
+func (r *Reader) Close() error {
+	return r.gz.Close()
+}
+
+// book builds a sorted orderbook from the current levels
+func (r *Reader) book(t time.Time) *orderbook.Book {
+	return &orderbook.Book{
+		Bids:        sortedLevels(r.bids, true),
+		Asks:        sortedLevels(r.asks, false),
+		Exchange:    r.Exchange,
+		Asset:       r.Asset,
+		Pair:        r.Pair,
+		LastUpdated: t,
+	}
+}
+
+// readLevels applies levels to the side, removing zero amount levels
+func (r *Reader) readLevels(side map[float64]float64) error {
+	count, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	// Each level is 16 bytes so reject counts which could not be real
+	if count > math.MaxInt32/16 {
+		return fmt.Errorf("%w %d", errInvalidLevelCount, count)
+	}
+	for range count {
+		price, err := r.readFloat()
+		if err != nil {
+			return err
+		}
+		amount, err := r.readFloat()
+		if err != nil {
+			return err
+		}
+		if amount == 0 {
+			delete(side, price)
+			continue
+		}
+		side[price] = amount
+	}
+	return nil
+}
+
+func (r *Reader) readTime() (time.Time, error) {
+	d, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return time.Time{}, unexpectedEOF(err)
+	}
+	r.lastTime += d
+	return time.Unix(0, r.lastTime).UTC(), nil
+}
+
+func (r *Reader) readFloat() (float64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r.r, b[:]); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b[:])), nil
+}
+
+func (r *Reader) readString() (string, error) {
+	l, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return "", unexpectedEOF(err)
+	}
+	if l > maxHeaderStringLength {
+		return "", fmt.Errorf("%w length %d", errInvalidHeader, l)
+	}
+	b := make([]byte, l)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return "", unexpectedEOF(err)
+	}
+	return string(b), nil
+}
+
+// unexpectedEOF converts io.EOF part way through a record so callers can
+// tell a truncated file from the end of the data
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func sortedLevels(side map[float64]float64, descending bool) orderbook.Levels {
+	prices := slices.Sorted(maps.Keys(side))
+	if descending {
+		slices.Reverse(prices)
+	}
+	l := make(orderbook.Levels, len(prices))
+	for i := range prices {
+		l[i] = orderbook.Level{Price: prices[i], Amount: side[prices[i]]}
+	}
+	return l
+}
@@ -0,0 +1,181 @@
+package tickdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "test"
+
+var testPair = currency.NewPairWithDelimiter("BTC", "USDT", "-")
+
+func testBook(t time.Time, bids, asks orderbook.Levels) *orderbook.Book {
+	return &orderbook.Book{
+		Exchange:    testExchange,
+		Asset:       asset.Spot,
+		Pair:        testPair,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: t,
+	}
+}
+
+func TestNewWriter(t *testing.T) {
+	t.Parallel()
+	_, err := NewWriter(nil, testExchange, asset.Spot, testPair)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = NewWriter(io.Discard, "", asset.Spot, testPair)
+	assert.ErrorIs(t, err, errExchangeNameUnset)
+	_, err = NewWriter(io.Discard, testExchange, asset.Empty, testPair)
+	assert.ErrorIs(t, err, asset.ErrInvalidAsset)
+	_, err = NewWriter(io.Discard, testExchange, asset.Spot, currency.EMPTYPAIR)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	w, err := NewWriter(io.Discard, testExchange, asset.Spot, testPair)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSnapshotInterval, w.SnapshotInterval)
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testExchange, asset.Spot, testPair)
+	require.NoError(t, err)
+	w.SnapshotInterval = 2
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	books := []*orderbook.Book{
+		testBook(start, orderbook.Levels{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}}, orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 3}}),
+		testBook(start.Add(time.Millisecond), orderbook.Levels{{Price: 100, Amount: 1.5}, {Price: 99, Amount: 2}}, orderbook.Levels{{Price: 102, Amount: 3}}),
+		testBook(start.Add(2*time.Millisecond), orderbook.Levels{{Price: 100.5, Amount: 0.1}, {Price: 100, Amount: 1.5}}, orderbook.Levels{{Price: 101.5, Amount: 4}, {Price: 102, Amount: 3}}),
+		testBook(start.Add(3*time.Millisecond), orderbook.Levels{{Price: 100, Amount: 1}}, orderbook.Levels{{Price: 101, Amount: 1}}),
+	}
+	require.NoError(t, w.WriteBook(books[0]))
+	require.NoError(t, w.WriteTrade(&trade.Data{
+		Exchange:     testExchange,
+		AssetType:    asset.Spot,
+		CurrencyPair: testPair,
+		Side:         order.Buy,
+		Price:        101,
+		Amount:       0.5,
+		Timestamp:    start.Add(time.Microsecond),
+	}))
+	for _, b := range books[1:] {
+		require.NoError(t, w.WriteBook(b))
+	}
+	require.NoError(t, w.WriteBook(books[3]), "unchanged books must be skipped")
+	require.NoError(t, w.Close())
+	assert.ErrorIs(t, w.WriteBook(books[0]), errWriterClosed)
+
+	r, err := NewReader(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, testExchange, r.Exchange)
+	assert.Equal(t, asset.Spot, r.Asset)
+	assert.True(t, testPair.Equal(r.Pair))
+	assert.Equal(t, testPair.Delimiter, r.Pair.Delimiter)
+
+	expectedTypes := []RecordType{RecordSnapshot, RecordTrade, RecordDelta, RecordDelta, RecordSnapshot}
+	var bookIndex int
+	for i, expected := range expectedTypes {
+		rec, err := r.Next()
+		require.NoErrorf(t, err, "Next must not error on record %d", i)
+		require.Equalf(t, expected, rec.Type, "record %d must have the correct type", i)
+		if rec.Type == RecordTrade {
+			require.NotNil(t, rec.Trade)
+			assert.Equal(t, order.Buy, rec.Trade.Side)
+			assert.Equal(t, 101.0, rec.Trade.Price)
+			assert.Equal(t, 0.5, rec.Trade.Amount)
+			assert.Equal(t, start.Add(time.Microsecond), rec.Trade.Timestamp)
+			continue
+		}
+		require.NotNil(t, rec.Book)
+		assert.Equalf(t, books[bookIndex].Bids, rec.Book.Bids, "record %d bids should match", i)
+		assert.Equalf(t, books[bookIndex].Asks, rec.Book.Asks, "record %d asks should match", i)
+		assert.Equalf(t, books[bookIndex].LastUpdated, rec.Time, "record %d time should match", i)
+		bookIndex++
+	}
+	_, err = r.Next()
+	assert.ErrorIs(t, err, io.EOF)
+	assert.NoError(t, r.Close())
+}
+
+func TestWriteBook(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testExchange, asset.Spot, testPair)
+	require.NoError(t, err)
+	assert.ErrorIs(t, w.WriteBook(nil), common.ErrNilPointer)
+
+	b := testBook(time.Now(), orderbook.Levels{{Price: 100, Amount: 1}}, nil)
+	b.Asset = asset.Futures
+	assert.ErrorIs(t, w.WriteBook(b), errSourceMismatch)
+	assert.ErrorIs(t, w.WriteTrade(&trade.Data{Exchange: "other", AssetType: asset.Spot, CurrencyPair: testPair}), errSourceMismatch)
+	assert.ErrorIs(t, w.WriteTrade(nil), common.ErrNilPointer)
+
+	w.MaxDepth = 1
+	require.NoError(t, w.WriteBook(testBook(time.Now(), orderbook.Levels{{Price: 100, Amount: 1}, {Price: 99, Amount: 1}}, orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}})))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(&buf)
+	require.NoError(t, err)
+	rec, err := r.Next()
+	require.NoError(t, err)
+	assert.Len(t, rec.Book.Bids, 1, "MaxDepth should limit the recorded bids")
+	assert.Len(t, rec.Book.Asks, 1, "MaxDepth should limit the recorded asks")
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+	_, err := NewReader(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write([]byte("NOTTICK\x01"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	_, err = NewReader(&buf)
+	assert.ErrorIs(t, err, errInvalidMagic)
+
+	buf.Reset()
+	gz = gzip.NewWriter(&buf)
+	_, err = gz.Write([]byte(magic + "\x09"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	_, err = NewReader(&buf)
+	assert.ErrorIs(t, err, errUnsupportedVersion)
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testExchange, asset.Spot, testPair)
+	require.NoError(t, err)
+	w.buf = append(w.buf, byte(RecordDelta), 0)
+	require.NoError(t, w.flush())
+	w.buf = append(w.buf, 99, 0)
+	require.NoError(t, w.flush())
+	w.buf = append(w.buf, byte(RecordSnapshot), 0, 1)
+	require.NoError(t, w.flush())
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(&buf)
+	require.NoError(t, err)
+	_, err = r.Next()
+	assert.ErrorIs(t, err, errDeltaBeforeBook)
+	_, err = r.Next()
+	assert.ErrorIs(t, err, errUnknownRecordType)
+	_, err = r.Next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF, "a truncated record should return an unexpected EOF")
+}
@@ -0,0 +1,102 @@
+package tickdata
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// RecordType identifies the contents of a record in a tick data file
+type RecordType uint8
+
+// Record types
+const (
+	RecordSnapshot RecordType = iota + 1
+	RecordDelta
+	RecordTrade
+)
+
+const (
+	// FileExtension is the extension used for tick data files
+	FileExtension = ".tick.gz"
+	// DefaultSnapshotInterval is the number of delta records written between
+	// full orderbook snapshots when none is specified
+	DefaultSnapshotInterval = 1000
+
+	magic   = "GCTTICK"
+	version = 1
+
+	sideBuy  = 1
+	sideSell = 2
+
+	maxHeaderStringLength = 256
+)
+
+var (
+	errExchangeNameUnset  = errors.New("exchange name unset")
+	errInvalidMagic       = errors.New("invalid tick data file header")
+	errInvalidHeader      = errors.New("invalid tick data header value")
+	errUnsupportedVersion = errors.New("unsupported tick data file version")
+	errUnknownRecordType  = errors.New("unknown tick data record type")
+	errDeltaBeforeBook    = errors.New("orderbook delta received before a snapshot")
+	errSourceMismatch     = errors.New("data does not match the recording exchange, asset and pair")
+	errWriterClosed       = errors.New("tick data writer is closed")
+	errInvalidLevelCount  = errors.New("invalid orderbook level count")
+)
+
+// Writer writes orderbook snapshots, orderbook deltas and trades for a single
+// exchange, asset and pair as a gzip compressed binary stream. A full
+// snapshot is written every SnapshotInterval deltas so a reader can recover
+// from any snapshot. Deltas only contain the price levels which changed, with
+// a zero amount representing a removed level
+type Writer struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// SnapshotInterval is the maximum number of deltas written between
+	// full snapshots
+	SnapshotInterval int
+	// MaxDepth limits the number of levels recorded per side, zero records
+	// the full book
+	MaxDepth int
+
+	gz                  *gzip.Writer
+	buf                 []byte
+	bids                orderbook.Levels
+	asks                orderbook.Levels
+	hasBook             bool
+	deltasSinceSnapshot int
+	lastTime            int64
+	closed              bool
+}
+
+// Reader reads tick data written by a Writer, reconstructing the full
+// orderbook after each snapshot and delta
+type Reader struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+
+	gz       *gzip.Reader
+	r        *bufio.Reader
+	bids     map[float64]float64
+	asks     map[float64]float64
+	hasBook  bool
+	lastTime int64
+}
+
+// Record is a single entry read from a tick data file. Book holds the full
+// reconstructed orderbook for snapshot and delta records and Trade holds the
+// trade for trade records
+type Record struct {
+	Type  RecordType
+	Time  time.Time
+	Book  *orderbook.Book
+	Trade *trade.Data
+}
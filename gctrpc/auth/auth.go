@@ -24,3 +24,20 @@ func (b BasicAuth) GetRequestMetadata(_ context.Context, _ ...string) (map[strin
 func (BasicAuth) RequireTransportSecurity() bool {
 	return true
 }
+
+// BearerAuth stores a gRPC bearer token
+type BearerAuth struct {
+	Token string
+}
+
+// GetRequestMetadata is a implementation of the GetRequestMetadata function
+func (b BearerAuth) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + b.Token,
+	}, nil
+}
+
+// RequireTransportSecurity is required for bearer auth
+func (BearerAuth) RequireTransportSecurity() bool {
+	return true
+}
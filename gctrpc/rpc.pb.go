@@ -4390,6 +4390,10 @@ type ConditionParams struct {
 	CheckBids       bool                   `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
 	CheckAsks       bool                   `protobuf:"varint,4,opt,name=check_asks,json=checkAsks,proto3" json:"check_asks,omitempty"`
 	OrderbookAmount float64                `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
+	Value           float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Indicator       string                 `protobuf:"bytes,7,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	Interval        int64                  `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	Period          int64                  `protobuf:"varint,9,opt,name=period,proto3" json:"period,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -4459,33 +4463,58 @@ func (x *ConditionParams) GetOrderbookAmount() float64 {
 	return 0
 }
 
-type GetEventsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item            string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams *ConditionParams       `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	Executed        bool                   `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ConditionParams) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
 }
 
-func (x *GetEventsResponse) Reset() {
-	*x = GetEventsResponse{}
+func (x *ConditionParams) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
+}
+
+func (x *ConditionParams) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *ConditionParams) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+type EventCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Params        *ConditionParams       `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Conditions    []*EventCondition      `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventCondition) Reset() {
+	*x = EventCondition{}
 	mi := &file_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsResponse) String() string {
+func (x *EventCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsResponse) ProtoMessage() {}
+func (*EventCondition) ProtoMessage() {}
 
-func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *EventCondition) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4497,87 +4526,166 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventCondition.ProtoReflect.Descriptor instead.
+func (*EventCondition) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetEventsResponse) GetId() int64 {
+func (x *EventCondition) GetItem() string {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
-	return 0
+	return ""
 }
 
-func (x *GetEventsResponse) GetExchange() string {
+func (x *EventCondition) GetParams() *ConditionParams {
 	if x != nil {
-		return x.Exchange
+		return x.Params
 	}
-	return ""
+	return nil
 }
 
-func (x *GetEventsResponse) GetItem() string {
+func (x *EventCondition) GetOperator() string {
 	if x != nil {
-		return x.Item
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *GetEventsResponse) GetConditionParams() *ConditionParams {
+func (x *EventCondition) GetConditions() []*EventCondition {
 	if x != nil {
-		return x.ConditionParams
+		return x.Conditions
 	}
 	return nil
 }
 
-func (x *GetEventsResponse) GetPair() *CurrencyPair {
+type EventActionParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Side          string                 `protobuf:"bytes,1,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Script        string                 `protobuf:"bytes,5,opt,name=script,proto3" json:"script,omitempty"`
+	Relayer       string                 `protobuf:"bytes,6,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventActionParams) Reset() {
+	*x = EventActionParams{}
+	mi := &file_rpc_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventActionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventActionParams) ProtoMessage() {}
+
+func (x *EventActionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventActionParams.ProtoReflect.Descriptor instead.
+func (*EventActionParams) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetEventsResponse) GetAction() string {
+func (x *EventActionParams) GetSide() string {
 	if x != nil {
-		return x.Action
+		return x.Side
 	}
 	return ""
 }
 
-func (x *GetEventsResponse) GetExecuted() bool {
+func (x *EventActionParams) GetOrderType() string {
 	if x != nil {
-		return x.Executed
+		return x.OrderType
 	}
-	return false
+	return ""
 }
 
-type AddEventRequest struct {
+func (x *EventActionParams) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EventActionParams) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *EventActionParams) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *EventActionParams) GetRelayer() string {
+	if x != nil {
+		return x.Relayer
+	}
+	return ""
+}
+
+func (x *EventActionParams) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EventDetails struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item            string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams *ConditionParams       `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType       string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item            string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Conditions      *EventCondition        `protobuf:"bytes,5,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,6,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset           string                 `protobuf:"bytes,7,opt,name=asset,proto3" json:"asset,omitempty"`
+	Action          string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	ActionParams    *EventActionParams     `protobuf:"bytes,9,opt,name=action_params,json=actionParams,proto3" json:"action_params,omitempty"`
+	Repeat          bool                   `protobuf:"varint,10,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Cooldown        int64                  `protobuf:"varint,11,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	TriggerCount    int64                  `protobuf:"varint,12,opt,name=trigger_count,json=triggerCount,proto3" json:"trigger_count,omitempty"`
+	LastTriggered   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_triggered,json=lastTriggered,proto3" json:"last_triggered,omitempty"`
+	Executed        bool                   `protobuf:"varint,14,opt,name=executed,proto3" json:"executed,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AddEventRequest) Reset() {
-	*x = AddEventRequest{}
-	mi := &file_rpc_proto_msgTypes[76]
+func (x *EventDetails) Reset() {
+	*x = EventDetails{}
+	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventRequest) String() string {
+func (x *EventDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventRequest) ProtoMessage() {}
+func (*EventDetails) ProtoMessage() {}
 
-func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+func (x *EventDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4588,118 +4696,130 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use EventDetails.ProtoReflect.Descriptor instead.
+func (*EventDetails) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *AddEventRequest) GetExchange() string {
+func (x *EventDetails) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventDetails) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetItem() string {
+func (x *EventDetails) GetItem() string {
 	if x != nil {
 		return x.Item
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetConditionParams() *ConditionParams {
+func (x *EventDetails) GetConditionParams() *ConditionParams {
 	if x != nil {
 		return x.ConditionParams
 	}
 	return nil
 }
 
-func (x *AddEventRequest) GetPair() *CurrencyPair {
+func (x *EventDetails) GetConditions() *EventCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *EventDetails) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *AddEventRequest) GetAssetType() string {
+func (x *EventDetails) GetAsset() string {
 	if x != nil {
-		return x.AssetType
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetAction() string {
+func (x *EventDetails) GetAction() string {
 	if x != nil {
 		return x.Action
 	}
 	return ""
 }
 
-type AddEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventDetails) GetActionParams() *EventActionParams {
+	if x != nil {
+		return x.ActionParams
+	}
+	return nil
 }
 
-func (x *AddEventResponse) Reset() {
-	*x = AddEventResponse{}
-	mi := &file_rpc_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventDetails) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
 }
 
-func (x *AddEventResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventDetails) GetCooldown() int64 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
 }
 
-func (*AddEventResponse) ProtoMessage() {}
-
-func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+func (x *EventDetails) GetTriggerCount() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TriggerCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
-func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+func (x *EventDetails) GetLastTriggered() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTriggered
+	}
+	return nil
 }
 
-func (x *AddEventResponse) GetId() int64 {
+func (x *EventDetails) GetExecuted() bool {
 	if x != nil {
-		return x.Id
+		return x.Executed
 	}
-	return 0
+	return false
 }
 
-type RemoveEventRequest struct {
+type GetEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events        []*EventDetails        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveEventRequest) Reset() {
-	*x = RemoveEventRequest{}
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
 	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveEventRequest) String() string {
+func (x *GetEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEventRequest) ProtoMessage() {}
+func (*GetEventsResponse) ProtoMessage() {}
 
-func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4711,39 +4831,48 @@ func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
-func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *RemoveEventRequest) GetId() int64 {
+func (x *GetEventsResponse) GetEvents() []*EventDetails {
 	if x != nil {
-		return x.Id
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-type GetCryptocurrencyDepositAddressesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AddEventRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item            string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType       string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Conditions      *EventCondition        `protobuf:"bytes,7,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	ActionParams    *EventActionParams     `protobuf:"bytes,8,opt,name=action_params,json=actionParams,proto3" json:"action_params,omitempty"`
+	Repeat          bool                   `protobuf:"varint,9,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Cooldown        int64                  `protobuf:"varint,10,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
-	*x = GetCryptocurrencyDepositAddressesRequest{}
+func (x *AddEventRequest) Reset() {
+	*x = AddEventRequest{}
 	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
+func (x *AddEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
+func (*AddEventRequest) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
+func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4755,22 +4884,217 @@ func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
+func (x *AddEventRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type DepositAddress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+func (x *AddEventRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetConditionParams() *ConditionParams {
+	if x != nil {
+		return x.ConditionParams
+	}
+	return nil
+}
+
+func (x *AddEventRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *AddEventRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetConditions() *EventCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *AddEventRequest) GetActionParams() *EventActionParams {
+	if x != nil {
+		return x.ActionParams
+	}
+	return nil
+}
+
+func (x *AddEventRequest) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
+
+func (x *AddEventRequest) GetCooldown() int64 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
+}
+
+type AddEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEventResponse) Reset() {
+	*x = AddEventResponse{}
+	mi := &file_rpc_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventResponse) ProtoMessage() {}
+
+func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AddEventResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEventRequest) Reset() {
+	*x = RemoveEventRequest{}
+	mi := &file_rpc_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEventRequest) ProtoMessage() {}
+
+func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemoveEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCryptocurrencyDepositAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
+	*x = GetCryptocurrencyDepositAddressesRequest{}
+	mi := &file_rpc_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
+
+func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type DepositAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
 	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -4778,7 +5102,7 @@ type DepositAddress struct {
 
 func (x *DepositAddress) Reset() {
 	*x = DepositAddress{}
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4790,7 +5114,7 @@ func (x *DepositAddress) String() string {
 func (*DepositAddress) ProtoMessage() {}
 
 func (x *DepositAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,7 +5127,7 @@ func (x *DepositAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
 func (*DepositAddress) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *DepositAddress) GetAddress() string {
@@ -4836,7 +5160,7 @@ type DepositAddresses struct {
 
 func (x *DepositAddresses) Reset() {
 	*x = DepositAddresses{}
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4848,7 +5172,7 @@ func (x *DepositAddresses) String() string {
 func (*DepositAddresses) ProtoMessage() {}
 
 func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +5185,7 @@ func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
 func (*DepositAddresses) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *DepositAddresses) GetAddresses() []*DepositAddress {
@@ -4880,7 +5204,7 @@ type GetCryptocurrencyDepositAddressesResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressesResponse{}
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4892,7 +5216,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
 func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +5229,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
@@ -4927,7 +5251,7 @@ type GetCryptocurrencyDepositAddressRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4939,7 +5263,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) String() string {
 func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4952,7 +5276,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
@@ -4993,7 +5317,7 @@ type GetCryptocurrencyDepositAddressResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressResponse{}
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5005,7 +5329,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) String() string {
 func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5018,7 +5342,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
@@ -5045,7 +5369,7 @@ type GetAvailableTransferChainsRequest struct {
 
 func (x *GetAvailableTransferChainsRequest) Reset() {
 	*x = GetAvailableTransferChainsRequest{}
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5057,7 +5381,7 @@ func (x *GetAvailableTransferChainsRequest) String() string {
 func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5070,7 +5394,7 @@ func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *GetAvailableTransferChainsRequest) GetExchange() string {
@@ -5096,7 +5420,7 @@ type GetAvailableTransferChainsResponse struct {
 
 func (x *GetAvailableTransferChainsResponse) Reset() {
 	*x = GetAvailableTransferChainsResponse{}
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5108,7 +5432,7 @@ func (x *GetAvailableTransferChainsResponse) String() string {
 func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,7 +5445,7 @@ func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GetAvailableTransferChainsResponse) GetChains() []string {
@@ -5138,13 +5462,14 @@ type WithdrawFiatRequest struct {
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	TotpCode      string                 `protobuf:"bytes,6,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WithdrawFiatRequest) Reset() {
 	*x = WithdrawFiatRequest{}
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5156,7 +5481,7 @@ func (x *WithdrawFiatRequest) String() string {
 func (*WithdrawFiatRequest) ProtoMessage() {}
 
 func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5169,7 +5494,7 @@ func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *WithdrawFiatRequest) GetExchange() string {
@@ -5207,6 +5532,13 @@ func (x *WithdrawFiatRequest) GetBankAccountId() string {
 	return ""
 }
 
+func (x *WithdrawFiatRequest) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
 type WithdrawCryptoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -5217,13 +5549,14 @@ type WithdrawCryptoRequest struct {
 	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
 	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
+	TotpCode      string                 `protobuf:"bytes,9,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WithdrawCryptoRequest) Reset() {
 	*x = WithdrawCryptoRequest{}
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5235,7 +5568,7 @@ func (x *WithdrawCryptoRequest) String() string {
 func (*WithdrawCryptoRequest) ProtoMessage() {}
 
 func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5248,7 +5581,7 @@ func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *WithdrawCryptoRequest) GetExchange() string {
@@ -5307,6 +5640,13 @@ func (x *WithdrawCryptoRequest) GetChain() string {
 	return ""
 }
 
+func (x *WithdrawCryptoRequest) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
 type WithdrawResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -5317,7 +5657,7 @@ type WithdrawResponse struct {
 
 func (x *WithdrawResponse) Reset() {
 	*x = WithdrawResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5329,7 +5669,7 @@ func (x *WithdrawResponse) String() string {
 func (*WithdrawResponse) ProtoMessage() {}
 
 func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5342,7 +5682,7 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *WithdrawResponse) GetId() string {
@@ -5368,7 +5708,7 @@ type WithdrawalEventByIDRequest struct {
 
 func (x *WithdrawalEventByIDRequest) Reset() {
 	*x = WithdrawalEventByIDRequest{}
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5380,7 +5720,7 @@ func (x *WithdrawalEventByIDRequest) String() string {
 func (*WithdrawalEventByIDRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,7 +5733,7 @@ func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *WithdrawalEventByIDRequest) GetId() string {
@@ -5412,7 +5752,7 @@ type WithdrawalEventByIDResponse struct {
 
 func (x *WithdrawalEventByIDResponse) Reset() {
 	*x = WithdrawalEventByIDResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5424,7 +5764,7 @@ func (x *WithdrawalEventByIDResponse) String() string {
 func (*WithdrawalEventByIDResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5437,7 +5777,7 @@ func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
@@ -5460,7 +5800,7 @@ type WithdrawalEventsByExchangeRequest struct {
 
 func (x *WithdrawalEventsByExchangeRequest) Reset() {
 	*x = WithdrawalEventsByExchangeRequest{}
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5472,7 +5812,7 @@ func (x *WithdrawalEventsByExchangeRequest) String() string {
 func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5485,7 +5825,7 @@ func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
@@ -5535,7 +5875,7 @@ type WithdrawalEventsByDateRequest struct {
 
 func (x *WithdrawalEventsByDateRequest) Reset() {
 	*x = WithdrawalEventsByDateRequest{}
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5547,7 +5887,7 @@ func (x *WithdrawalEventsByDateRequest) String() string {
 func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5560,7 +5900,7 @@ func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *WithdrawalEventsByDateRequest) GetExchange() string {
@@ -5600,7 +5940,7 @@ type WithdrawalEventsByExchangeResponse struct {
 
 func (x *WithdrawalEventsByExchangeResponse) Reset() {
 	*x = WithdrawalEventsByExchangeResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5612,7 +5952,7 @@ func (x *WithdrawalEventsByExchangeResponse) String() string {
 func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5625,7 +5965,7 @@ func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
@@ -5648,7 +5988,7 @@ type WithdrawalEventResponse struct {
 
 func (x *WithdrawalEventResponse) Reset() {
 	*x = WithdrawalEventResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5660,7 +6000,7 @@ func (x *WithdrawalEventResponse) String() string {
 func (*WithdrawalEventResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5673,7 +6013,7 @@ func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *WithdrawalEventResponse) GetId() string {
@@ -5722,7 +6062,7 @@ type WithdrawlExchangeEvent struct {
 
 func (x *WithdrawlExchangeEvent) Reset() {
 	*x = WithdrawlExchangeEvent{}
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5734,7 +6074,7 @@ func (x *WithdrawlExchangeEvent) String() string {
 func (*WithdrawlExchangeEvent) ProtoMessage() {}
 
 func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5747,7 +6087,7 @@ func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawlExchangeEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawlExchangeEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *WithdrawlExchangeEvent) GetName() string {
@@ -5785,7 +6125,7 @@ type WithdrawalRequestEvent struct {
 
 func (x *WithdrawalRequestEvent) Reset() {
 	*x = WithdrawalRequestEvent{}
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5797,7 +6137,7 @@ func (x *WithdrawalRequestEvent) String() string {
 func (*WithdrawalRequestEvent) ProtoMessage() {}
 
 func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,7 +6150,7 @@ func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *WithdrawalRequestEvent) GetCurrency() string {
@@ -5869,7 +6209,7 @@ type FiatWithdrawalEvent struct {
 
 func (x *FiatWithdrawalEvent) Reset() {
 	*x = FiatWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5881,7 +6221,7 @@ func (x *FiatWithdrawalEvent) String() string {
 func (*FiatWithdrawalEvent) ProtoMessage() {}
 
 func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,7 +6234,7 @@ func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *FiatWithdrawalEvent) GetBankName() string {
@@ -5951,7 +6291,7 @@ type CryptoWithdrawalEvent struct {
 
 func (x *CryptoWithdrawalEvent) Reset() {
 	*x = CryptoWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5963,7 +6303,7 @@ func (x *CryptoWithdrawalEvent) String() string {
 func (*CryptoWithdrawalEvent) ProtoMessage() {}
 
 func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,7 +6316,7 @@ func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
+	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *CryptoWithdrawalEvent) GetAddress() string {
@@ -6016,7 +6356,7 @@ type GetLoggerDetailsRequest struct {
 
 func (x *GetLoggerDetailsRequest) Reset() {
 	*x = GetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[100]
+	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6028,7 +6368,7 @@ func (x *GetLoggerDetailsRequest) String() string {
 func (*GetLoggerDetailsRequest) ProtoMessage() {}
 
 func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[100]
+	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6041,7 +6381,7 @@ func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{100}
+	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetLoggerDetailsRequest) GetLogger() string {
@@ -6063,7 +6403,7 @@ type GetLoggerDetailsResponse struct {
 
 func (x *GetLoggerDetailsResponse) Reset() {
 	*x = GetLoggerDetailsResponse{}
-	mi := &file_rpc_proto_msgTypes[101]
+	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6075,7 +6415,7 @@ func (x *GetLoggerDetailsResponse) String() string {
 func (*GetLoggerDetailsResponse) ProtoMessage() {}
 
 func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[101]
+	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6088,7 +6428,7 @@ func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{101}
+	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetLoggerDetailsResponse) GetInfo() bool {
@@ -6129,7 +6469,7 @@ type SetLoggerDetailsRequest struct {
 
 func (x *SetLoggerDetailsRequest) Reset() {
 	*x = SetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[102]
+	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6141,7 +6481,7 @@ func (x *SetLoggerDetailsRequest) String() string {
 func (*SetLoggerDetailsRequest) ProtoMessage() {}
 
 func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[102]
+	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6154,7 +6494,7 @@ func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
 func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{102}
+	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *SetLoggerDetailsRequest) GetLogger() string {
@@ -6181,7 +6521,7 @@ type GetExchangePairsRequest struct {
 
 func (x *GetExchangePairsRequest) Reset() {
 	*x = GetExchangePairsRequest{}
-	mi := &file_rpc_proto_msgTypes[103]
+	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6193,7 +6533,7 @@ func (x *GetExchangePairsRequest) String() string {
 func (*GetExchangePairsRequest) ProtoMessage() {}
 
 func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[103]
+	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6206,7 +6546,7 @@ func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{103}
+	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *GetExchangePairsRequest) GetExchange() string {
@@ -6232,7 +6572,7 @@ type GetExchangePairsResponse struct {
 
 func (x *GetExchangePairsResponse) Reset() {
 	*x = GetExchangePairsResponse{}
-	mi := &file_rpc_proto_msgTypes[104]
+	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6244,7 +6584,7 @@ func (x *GetExchangePairsResponse) String() string {
 func (*GetExchangePairsResponse) ProtoMessage() {}
 
 func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[104]
+	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6257,7 +6597,7 @@ func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
 func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{104}
+	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
@@ -6279,7 +6619,7 @@ type SetExchangePairRequest struct {
 
 func (x *SetExchangePairRequest) Reset() {
 	*x = SetExchangePairRequest{}
-	mi := &file_rpc_proto_msgTypes[105]
+	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6291,7 +6631,7 @@ func (x *SetExchangePairRequest) String() string {
 func (*SetExchangePairRequest) ProtoMessage() {}
 
 func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[105]
+	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6304,7 +6644,7 @@ func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{105}
+	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *SetExchangePairRequest) GetExchange() string {
@@ -6346,7 +6686,7 @@ type GetOrderbookStreamRequest struct {
 
 func (x *GetOrderbookStreamRequest) Reset() {
 	*x = GetOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[106]
+	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6358,7 +6698,7 @@ func (x *GetOrderbookStreamRequest) String() string {
 func (*GetOrderbookStreamRequest) ProtoMessage() {}
 
 func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[106]
+	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6371,7 +6711,7 @@ func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{106}
+	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetOrderbookStreamRequest) GetExchange() string {
@@ -6404,7 +6744,7 @@ type GetExchangeOrderbookStreamRequest struct {
 
 func (x *GetExchangeOrderbookStreamRequest) Reset() {
 	*x = GetExchangeOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[107]
+	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6416,7 +6756,7 @@ func (x *GetExchangeOrderbookStreamRequest) String() string {
 func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
 
 func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[107]
+	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,7 +6769,7 @@ func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{107}
+	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
@@ -6450,7 +6790,7 @@ type GetTickerStreamRequest struct {
 
 func (x *GetTickerStreamRequest) Reset() {
 	*x = GetTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[108]
+	mi := &file_rpc_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6462,7 +6802,7 @@ func (x *GetTickerStreamRequest) String() string {
 func (*GetTickerStreamRequest) ProtoMessage() {}
 
 func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[108]
+	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6475,7 +6815,7 @@ func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{108}
+	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetTickerStreamRequest) GetExchange() string {
@@ -6508,7 +6848,7 @@ type GetExchangeTickerStreamRequest struct {
 
 func (x *GetExchangeTickerStreamRequest) Reset() {
 	*x = GetExchangeTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[109]
+	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6520,7 +6860,7 @@ func (x *GetExchangeTickerStreamRequest) String() string {
 func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
 
 func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[109]
+	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6533,7 +6873,7 @@ func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{109}
+	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetExchangeTickerStreamRequest) GetExchange() string {
@@ -6556,7 +6896,7 @@ type GetAuditEventRequest struct {
 
 func (x *GetAuditEventRequest) Reset() {
 	*x = GetAuditEventRequest{}
-	mi := &file_rpc_proto_msgTypes[110]
+	mi := &file_rpc_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6568,7 +6908,7 @@ func (x *GetAuditEventRequest) String() string {
 func (*GetAuditEventRequest) ProtoMessage() {}
 
 func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[110]
+	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6581,7 +6921,7 @@ func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{110}
+	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetAuditEventRequest) GetStartDate() string {
@@ -6628,7 +6968,7 @@ type GetAuditEventResponse struct {
 
 func (x *GetAuditEventResponse) Reset() {
 	*x = GetAuditEventResponse{}
-	mi := &file_rpc_proto_msgTypes[111]
+	mi := &file_rpc_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6640,7 +6980,7 @@ func (x *GetAuditEventResponse) String() string {
 func (*GetAuditEventResponse) ProtoMessage() {}
 
 func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[111]
+	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6653,7 +6993,7 @@ func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
 func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{111}
+	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
@@ -6676,7 +7016,7 @@ type GetSavedTradesRequest struct {
 
 func (x *GetSavedTradesRequest) Reset() {
 	*x = GetSavedTradesRequest{}
-	mi := &file_rpc_proto_msgTypes[112]
+	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6688,7 +7028,7 @@ func (x *GetSavedTradesRequest) String() string {
 func (*GetSavedTradesRequest) ProtoMessage() {}
 
 func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[112]
+	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6701,7 +7041,7 @@ func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
 func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{112}
+	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetSavedTradesRequest) GetExchange() string {
@@ -6752,7 +7092,7 @@ type SavedTrades struct {
 
 func (x *SavedTrades) Reset() {
 	*x = SavedTrades{}
-	mi := &file_rpc_proto_msgTypes[113]
+	mi := &file_rpc_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6764,7 +7104,7 @@ func (x *SavedTrades) String() string {
 func (*SavedTrades) ProtoMessage() {}
 
 func (x *SavedTrades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[113]
+	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6777,7 +7117,7 @@ func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
 func (*SavedTrades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{113}
+	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *SavedTrades) GetPrice() float64 {
@@ -6827,7 +7167,7 @@ type SavedTradesResponse struct {
 
 func (x *SavedTradesResponse) Reset() {
 	*x = SavedTradesResponse{}
-	mi := &file_rpc_proto_msgTypes[114]
+	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6839,7 +7179,7 @@ func (x *SavedTradesResponse) String() string {
 func (*SavedTradesResponse) ProtoMessage() {}
 
 func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[114]
+	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6852,7 +7192,7 @@ func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
 func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{114}
+	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *SavedTradesResponse) GetExchangeName() string {
@@ -6899,7 +7239,7 @@ type ConvertTradesToCandlesRequest struct {
 
 func (x *ConvertTradesToCandlesRequest) Reset() {
 	*x = ConvertTradesToCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[115]
+	mi := &file_rpc_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6911,7 +7251,7 @@ func (x *ConvertTradesToCandlesRequest) String() string {
 func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
 
 func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[115]
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6924,7 +7264,7 @@ func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
 func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{115}
+	return file_rpc_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *ConvertTradesToCandlesRequest) GetExchange() string {
@@ -7002,7 +7342,7 @@ type GetHistoricCandlesRequest struct {
 
 func (x *GetHistoricCandlesRequest) Reset() {
 	*x = GetHistoricCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[116]
+	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7014,7 +7354,7 @@ func (x *GetHistoricCandlesRequest) String() string {
 func (*GetHistoricCandlesRequest) ProtoMessage() {}
 
 func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[116]
+	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7027,7 +7367,7 @@ func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{116}
+	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *GetHistoricCandlesRequest) GetExchange() string {
@@ -7121,7 +7461,7 @@ type GetHistoricCandlesResponse struct {
 
 func (x *GetHistoricCandlesResponse) Reset() {
 	*x = GetHistoricCandlesResponse{}
-	mi := &file_rpc_proto_msgTypes[117]
+	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7133,7 +7473,7 @@ func (x *GetHistoricCandlesResponse) String() string {
 func (*GetHistoricCandlesResponse) ProtoMessage() {}
 
 func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[117]
+	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7146,7 +7486,7 @@ func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{117}
+	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *GetHistoricCandlesResponse) GetExchange() string {
@@ -7206,7 +7546,7 @@ type Candle struct {
 
 func (x *Candle) Reset() {
 	*x = Candle{}
-	mi := &file_rpc_proto_msgTypes[118]
+	mi := &file_rpc_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7218,7 +7558,7 @@ func (x *Candle) String() string {
 func (*Candle) ProtoMessage() {}
 
 func (x *Candle) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+	mi := &file_rpc_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7231,7 +7571,7 @@ func (x *Candle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Candle.ProtoReflect.Descriptor instead.
 func (*Candle) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *Candle) GetTime() string {
@@ -7295,7 +7635,7 @@ type AuditEvent struct {
 
 func (x *AuditEvent) Reset() {
 	*x = AuditEvent{}
-	mi := &file_rpc_proto_msgTypes[119]
+	mi := &file_rpc_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7307,7 +7647,7 @@ func (x *AuditEvent) String() string {
 func (*AuditEvent) ProtoMessage() {}
 
 func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[119]
+	mi := &file_rpc_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7320,7 +7660,7 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
 func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{119}
+	return file_rpc_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *AuditEvent) GetType() string {
@@ -7363,7 +7703,7 @@ type GCTScript struct {
 
 func (x *GCTScript) Reset() {
 	*x = GCTScript{}
-	mi := &file_rpc_proto_msgTypes[120]
+	mi := &file_rpc_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7375,7 +7715,7 @@ func (x *GCTScript) String() string {
 func (*GCTScript) ProtoMessage() {}
 
 func (x *GCTScript) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[120]
+	mi := &file_rpc_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7388,7 +7728,7 @@ func (x *GCTScript) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
 func (*GCTScript) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{120}
+	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *GCTScript) GetUuid() string {
@@ -7428,7 +7768,7 @@ type GCTScriptExecuteRequest struct {
 
 func (x *GCTScriptExecuteRequest) Reset() {
 	*x = GCTScriptExecuteRequest{}
-	mi := &file_rpc_proto_msgTypes[121]
+	mi := &file_rpc_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7440,7 +7780,7 @@ func (x *GCTScriptExecuteRequest) String() string {
 func (*GCTScriptExecuteRequest) ProtoMessage() {}
 
 func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[121]
+	mi := &file_rpc_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7453,7 +7793,7 @@ func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{121}
+	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
@@ -7472,7 +7812,7 @@ type GCTScriptStopRequest struct {
 
 func (x *GCTScriptStopRequest) Reset() {
 	*x = GCTScriptStopRequest{}
-	mi := &file_rpc_proto_msgTypes[122]
+	mi := &file_rpc_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7484,7 +7824,7 @@ func (x *GCTScriptStopRequest) String() string {
 func (*GCTScriptStopRequest) ProtoMessage() {}
 
 func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[122]
+	mi := &file_rpc_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7497,7 +7837,7 @@ func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{122}
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *GCTScriptStopRequest) GetScript() *GCTScript {
@@ -7515,7 +7855,7 @@ type GCTScriptStopAllRequest struct {
 
 func (x *GCTScriptStopAllRequest) Reset() {
 	*x = GCTScriptStopAllRequest{}
-	mi := &file_rpc_proto_msgTypes[123]
+	mi := &file_rpc_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7527,7 +7867,7 @@ func (x *GCTScriptStopAllRequest) String() string {
 func (*GCTScriptStopAllRequest) ProtoMessage() {}
 
 func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[123]
+	mi := &file_rpc_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7540,7 +7880,7 @@ func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{123}
+	return file_rpc_proto_rawDescGZIP(), []int{126}
 }
 
 type GCTScriptStatusRequest struct {
@@ -7551,7 +7891,7 @@ type GCTScriptStatusRequest struct {
 
 func (x *GCTScriptStatusRequest) Reset() {
 	*x = GCTScriptStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[124]
+	mi := &file_rpc_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7563,7 +7903,7 @@ func (x *GCTScriptStatusRequest) String() string {
 func (*GCTScriptStatusRequest) ProtoMessage() {}
 
 func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[124]
+	mi := &file_rpc_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7576,7 +7916,7 @@ func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{124}
+	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
 type GCTScriptListAllRequest struct {
@@ -7587,7 +7927,7 @@ type GCTScriptListAllRequest struct {
 
 func (x *GCTScriptListAllRequest) Reset() {
 	*x = GCTScriptListAllRequest{}
-	mi := &file_rpc_proto_msgTypes[125]
+	mi := &file_rpc_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7599,7 +7939,7 @@ func (x *GCTScriptListAllRequest) String() string {
 func (*GCTScriptListAllRequest) ProtoMessage() {}
 
 func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+	mi := &file_rpc_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7612,7 +7952,7 @@ func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
+	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
 type GCTScriptUploadRequest struct {
@@ -7628,7 +7968,7 @@ type GCTScriptUploadRequest struct {
 
 func (x *GCTScriptUploadRequest) Reset() {
 	*x = GCTScriptUploadRequest{}
-	mi := &file_rpc_proto_msgTypes[126]
+	mi := &file_rpc_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7640,7 +7980,7 @@ func (x *GCTScriptUploadRequest) String() string {
 func (*GCTScriptUploadRequest) ProtoMessage() {}
 
 func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+	mi := &file_rpc_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7653,7 +7993,7 @@ func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *GCTScriptUploadRequest) GetScriptName() string {
@@ -7700,7 +8040,7 @@ type GCTScriptReadScriptRequest struct {
 
 func (x *GCTScriptReadScriptRequest) Reset() {
 	*x = GCTScriptReadScriptRequest{}
-	mi := &file_rpc_proto_msgTypes[127]
+	mi := &file_rpc_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7712,7 +8052,7 @@ func (x *GCTScriptReadScriptRequest) String() string {
 func (*GCTScriptReadScriptRequest) ProtoMessage() {}
 
 func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[127]
+	mi := &file_rpc_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7725,7 +8065,7 @@ func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{127}
+	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
@@ -7744,7 +8084,7 @@ type GCTScriptQueryRequest struct {
 
 func (x *GCTScriptQueryRequest) Reset() {
 	*x = GCTScriptQueryRequest{}
-	mi := &file_rpc_proto_msgTypes[128]
+	mi := &file_rpc_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7756,7 +8096,7 @@ func (x *GCTScriptQueryRequest) String() string {
 func (*GCTScriptQueryRequest) ProtoMessage() {}
 
 func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[128]
+	mi := &file_rpc_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7769,7 +8109,7 @@ func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{128}
+	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
@@ -7789,7 +8129,7 @@ type GCTScriptAutoLoadRequest struct {
 
 func (x *GCTScriptAutoLoadRequest) Reset() {
 	*x = GCTScriptAutoLoadRequest{}
-	mi := &file_rpc_proto_msgTypes[129]
+	mi := &file_rpc_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7801,7 +8141,7 @@ func (x *GCTScriptAutoLoadRequest) String() string {
 func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
 
 func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[129]
+	mi := &file_rpc_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7814,7 +8154,7 @@ func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
 func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{129}
+	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *GCTScriptAutoLoadRequest) GetScript() string {
@@ -7841,7 +8181,7 @@ type GCTScriptStatusResponse struct {
 
 func (x *GCTScriptStatusResponse) Reset() {
 	*x = GCTScriptStatusResponse{}
-	mi := &file_rpc_proto_msgTypes[130]
+	mi := &file_rpc_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7853,7 +8193,7 @@ func (x *GCTScriptStatusResponse) String() string {
 func (*GCTScriptStatusResponse) ProtoMessage() {}
 
 func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[130]
+	mi := &file_rpc_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7866,7 +8206,7 @@ func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
 func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{130}
+	return file_rpc_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GCTScriptStatusResponse) GetStatus() string {
@@ -7894,7 +8234,7 @@ type GCTScriptQueryResponse struct {
 
 func (x *GCTScriptQueryResponse) Reset() {
 	*x = GCTScriptQueryResponse{}
-	mi := &file_rpc_proto_msgTypes[131]
+	mi := &file_rpc_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7906,7 +8246,7 @@ func (x *GCTScriptQueryResponse) String() string {
 func (*GCTScriptQueryResponse) ProtoMessage() {}
 
 func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[131]
+	mi := &file_rpc_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7919,7 +8259,7 @@ func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
 func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{131}
+	return file_rpc_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GCTScriptQueryResponse) GetStatus() string {
@@ -7953,7 +8293,7 @@ type GenericResponse struct {
 
 func (x *GenericResponse) Reset() {
 	*x = GenericResponse{}
-	mi := &file_rpc_proto_msgTypes[132]
+	mi := &file_rpc_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7965,7 +8305,7 @@ func (x *GenericResponse) String() string {
 func (*GenericResponse) ProtoMessage() {}
 
 func (x *GenericResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[132]
+	mi := &file_rpc_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7978,7 +8318,7 @@ func (x *GenericResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
 func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{132}
+	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *GenericResponse) GetStatus() string {
@@ -8006,7 +8346,7 @@ type SetExchangeAssetRequest struct {
 
 func (x *SetExchangeAssetRequest) Reset() {
 	*x = SetExchangeAssetRequest{}
-	mi := &file_rpc_proto_msgTypes[133]
+	mi := &file_rpc_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8018,7 +8358,7 @@ func (x *SetExchangeAssetRequest) String() string {
 func (*SetExchangeAssetRequest) ProtoMessage() {}
 
 func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[133]
+	mi := &file_rpc_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8031,7 +8371,7 @@ func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{133}
+	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *SetExchangeAssetRequest) GetExchange() string {
@@ -8065,7 +8405,7 @@ type SetExchangeAllPairsRequest struct {
 
 func (x *SetExchangeAllPairsRequest) Reset() {
 	*x = SetExchangeAllPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[134]
+	mi := &file_rpc_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8077,7 +8417,7 @@ func (x *SetExchangeAllPairsRequest) String() string {
 func (*SetExchangeAllPairsRequest) ProtoMessage() {}
 
 func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[134]
+	mi := &file_rpc_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8090,7 +8430,7 @@ func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{134}
+	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *SetExchangeAllPairsRequest) GetExchange() string {
@@ -8116,7 +8456,7 @@ type UpdateExchangeSupportedPairsRequest struct {
 
 func (x *UpdateExchangeSupportedPairsRequest) Reset() {
 	*x = UpdateExchangeSupportedPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[135]
+	mi := &file_rpc_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8128,7 +8468,7 @@ func (x *UpdateExchangeSupportedPairsRequest) String() string {
 func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
 
 func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[135]
+	mi := &file_rpc_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8141,7 +8481,7 @@ func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{135}
+	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
@@ -8160,7 +8500,7 @@ type GetExchangeAssetsRequest struct {
 
 func (x *GetExchangeAssetsRequest) Reset() {
 	*x = GetExchangeAssetsRequest{}
-	mi := &file_rpc_proto_msgTypes[136]
+	mi := &file_rpc_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8172,7 +8512,7 @@ func (x *GetExchangeAssetsRequest) String() string {
 func (*GetExchangeAssetsRequest) ProtoMessage() {}
 
 func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[136]
+	mi := &file_rpc_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8185,7 +8525,7 @@ func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{136}
+	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *GetExchangeAssetsRequest) GetExchange() string {
@@ -8204,7 +8544,7 @@ type GetExchangeAssetsResponse struct {
 
 func (x *GetExchangeAssetsResponse) Reset() {
 	*x = GetExchangeAssetsResponse{}
-	mi := &file_rpc_proto_msgTypes[137]
+	mi := &file_rpc_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8216,7 +8556,7 @@ func (x *GetExchangeAssetsResponse) String() string {
 func (*GetExchangeAssetsResponse) ProtoMessage() {}
 
 func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[137]
+	mi := &file_rpc_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8229,7 +8569,7 @@ func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
 func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{137}
+	return file_rpc_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *GetExchangeAssetsResponse) GetAssets() string {
@@ -8248,7 +8588,7 @@ type WebsocketGetInfoRequest struct {
 
 func (x *WebsocketGetInfoRequest) Reset() {
 	*x = WebsocketGetInfoRequest{}
-	mi := &file_rpc_proto_msgTypes[138]
+	mi := &file_rpc_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8260,7 +8600,7 @@ func (x *WebsocketGetInfoRequest) String() string {
 func (*WebsocketGetInfoRequest) ProtoMessage() {}
 
 func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[138]
+	mi := &file_rpc_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8273,7 +8613,7 @@ func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{138}
+	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *WebsocketGetInfoRequest) GetExchange() string {
@@ -8292,13 +8632,14 @@ type WebsocketGetInfoResponse struct {
 	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
 	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
 	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+	Connections            []*WebsocketConnection `protobuf:"bytes,8,rep,name=connections,proto3" json:"connections,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *WebsocketGetInfoResponse) Reset() {
 	*x = WebsocketGetInfoResponse{}
-	mi := &file_rpc_proto_msgTypes[139]
+	mi := &file_rpc_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8310,7 +8651,7 @@ func (x *WebsocketGetInfoResponse) String() string {
 func (*WebsocketGetInfoResponse) ProtoMessage() {}
 
 func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[139]
+	mi := &file_rpc_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8323,7 +8664,7 @@ func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
 func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{139}
+	return file_rpc_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *WebsocketGetInfoResponse) GetExchange() string {
@@ -8375,6 +8716,97 @@ func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
 	return ""
 }
 
+func (x *WebsocketGetInfoResponse) GetConnections() []*WebsocketConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type WebsocketConnection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MessageFilter string                 `protobuf:"bytes,2,opt,name=message_filter,json=messageFilter,proto3" json:"message_filter,omitempty"`
+	Shard         int64                  `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	Authenticated bool                   `protobuf:"varint,4,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	Connected     bool                   `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	Subscriptions int64                  `protobuf:"varint,6,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsocketConnection) Reset() {
+	*x = WebsocketConnection{}
+	mi := &file_rpc_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsocketConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsocketConnection) ProtoMessage() {}
+
+func (x *WebsocketConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsocketConnection.ProtoReflect.Descriptor instead.
+func (*WebsocketConnection) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *WebsocketConnection) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebsocketConnection) GetMessageFilter() string {
+	if x != nil {
+		return x.MessageFilter
+	}
+	return ""
+}
+
+func (x *WebsocketConnection) GetShard() int64 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *WebsocketConnection) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *WebsocketConnection) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *WebsocketConnection) GetSubscriptions() int64 {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return 0
+}
+
 type WebsocketSetEnabledRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -8385,7 +8817,7 @@ type WebsocketSetEnabledRequest struct {
 
 func (x *WebsocketSetEnabledRequest) Reset() {
 	*x = WebsocketSetEnabledRequest{}
-	mi := &file_rpc_proto_msgTypes[140]
+	mi := &file_rpc_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8397,7 +8829,7 @@ func (x *WebsocketSetEnabledRequest) String() string {
 func (*WebsocketSetEnabledRequest) ProtoMessage() {}
 
 func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[140]
+	mi := &file_rpc_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8410,7 +8842,7 @@ func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{140}
+	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *WebsocketSetEnabledRequest) GetExchange() string {
@@ -8436,7 +8868,7 @@ type WebsocketGetSubscriptionsRequest struct {
 
 func (x *WebsocketGetSubscriptionsRequest) Reset() {
 	*x = WebsocketGetSubscriptionsRequest{}
-	mi := &file_rpc_proto_msgTypes[141]
+	mi := &file_rpc_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8448,7 +8880,7 @@ func (x *WebsocketGetSubscriptionsRequest) String() string {
 func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
 
 func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[141]
+	mi := &file_rpc_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8461,7 +8893,7 @@ func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{141}
+	return file_rpc_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
@@ -8483,7 +8915,7 @@ type WebsocketSubscription struct {
 
 func (x *WebsocketSubscription) Reset() {
 	*x = WebsocketSubscription{}
-	mi := &file_rpc_proto_msgTypes[142]
+	mi := &file_rpc_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8495,7 +8927,7 @@ func (x *WebsocketSubscription) String() string {
 func (*WebsocketSubscription) ProtoMessage() {}
 
 func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[142]
+	mi := &file_rpc_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8508,7 +8940,7 @@ func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
 func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{142}
+	return file_rpc_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *WebsocketSubscription) GetChannel() string {
@@ -8549,7 +8981,7 @@ type WebsocketGetSubscriptionsResponse struct {
 
 func (x *WebsocketGetSubscriptionsResponse) Reset() {
 	*x = WebsocketGetSubscriptionsResponse{}
-	mi := &file_rpc_proto_msgTypes[143]
+	mi := &file_rpc_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8561,7 +8993,7 @@ func (x *WebsocketGetSubscriptionsResponse) String() string {
 func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
 
 func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[143]
+	mi := &file_rpc_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8574,7 +9006,7 @@ func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{143}
+	return file_rpc_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
@@ -8601,7 +9033,7 @@ type WebsocketSetProxyRequest struct {
 
 func (x *WebsocketSetProxyRequest) Reset() {
 	*x = WebsocketSetProxyRequest{}
-	mi := &file_rpc_proto_msgTypes[144]
+	mi := &file_rpc_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8613,7 +9045,7 @@ func (x *WebsocketSetProxyRequest) String() string {
 func (*WebsocketSetProxyRequest) ProtoMessage() {}
 
 func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[144]
+	mi := &file_rpc_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8626,7 +9058,7 @@ func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{144}
+	return file_rpc_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *WebsocketSetProxyRequest) GetExchange() string {
@@ -8653,7 +9085,7 @@ type WebsocketSetURLRequest struct {
 
 func (x *WebsocketSetURLRequest) Reset() {
 	*x = WebsocketSetURLRequest{}
-	mi := &file_rpc_proto_msgTypes[145]
+	mi := &file_rpc_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8665,7 +9097,7 @@ func (x *WebsocketSetURLRequest) String() string {
 func (*WebsocketSetURLRequest) ProtoMessage() {}
 
 func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[145]
+	mi := &file_rpc_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8678,7 +9110,7 @@ func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
 func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{145}
+	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *WebsocketSetURLRequest) GetExchange() string {
@@ -8709,7 +9141,7 @@ type FindMissingCandlePeriodsRequest struct {
 
 func (x *FindMissingCandlePeriodsRequest) Reset() {
 	*x = FindMissingCandlePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[146]
+	mi := &file_rpc_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8721,7 +9153,7 @@ func (x *FindMissingCandlePeriodsRequest) String() string {
 func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
 
 func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[146]
+	mi := &file_rpc_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8734,7 +9166,7 @@ func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
 func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{146}
+	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
@@ -8792,7 +9224,7 @@ type FindMissingTradePeriodsRequest struct {
 
 func (x *FindMissingTradePeriodsRequest) Reset() {
 	*x = FindMissingTradePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[147]
+	mi := &file_rpc_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8804,7 +9236,7 @@ func (x *FindMissingTradePeriodsRequest) String() string {
 func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
 
 func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[147]
+	mi := &file_rpc_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8817,7 +9249,7 @@ func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
 func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{147}
+	return file_rpc_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
@@ -8868,7 +9300,7 @@ type FindMissingIntervalsResponse struct {
 
 func (x *FindMissingIntervalsResponse) Reset() {
 	*x = FindMissingIntervalsResponse{}
-	mi := &file_rpc_proto_msgTypes[148]
+	mi := &file_rpc_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8880,7 +9312,7 @@ func (x *FindMissingIntervalsResponse) String() string {
 func (*FindMissingIntervalsResponse) ProtoMessage() {}
 
 func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[148]
+	mi := &file_rpc_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8893,7 +9325,7 @@ func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
 func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{148}
+	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *FindMissingIntervalsResponse) GetExchangeName() string {
@@ -8941,7 +9373,7 @@ type SetExchangeTradeProcessingRequest struct {
 
 func (x *SetExchangeTradeProcessingRequest) Reset() {
 	*x = SetExchangeTradeProcessingRequest{}
-	mi := &file_rpc_proto_msgTypes[149]
+	mi := &file_rpc_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8953,7 +9385,7 @@ func (x *SetExchangeTradeProcessingRequest) String() string {
 func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
 
 func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[149]
+	mi := &file_rpc_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8966,7 +9398,7 @@ func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{149}
+	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
@@ -9004,13 +9436,14 @@ type UpsertDataHistoryJobRequest struct {
 	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
 	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
 	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	OrderbookDepth           uint64                 `protobuf:"varint,20,opt,name=orderbook_depth,json=orderbookDepth,proto3" json:"orderbook_depth,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *UpsertDataHistoryJobRequest) Reset() {
 	*x = UpsertDataHistoryJobRequest{}
-	mi := &file_rpc_proto_msgTypes[150]
+	mi := &file_rpc_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9022,7 +9455,7 @@ func (x *UpsertDataHistoryJobRequest) String() string {
 func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
 
 func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[150]
+	mi := &file_rpc_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9035,7 +9468,7 @@ func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
 func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{150}
+	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *UpsertDataHistoryJobRequest) GetNickname() string {
@@ -9171,6 +9604,13 @@ func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
 	return false
 }
 
+func (x *UpsertDataHistoryJobRequest) GetOrderbookDepth() uint64 {
+	if x != nil {
+		return x.OrderbookDepth
+	}
+	return 0
+}
+
 type InsertSequentialJobsRequest struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
@@ -9180,7 +9620,7 @@ type InsertSequentialJobsRequest struct {
 
 func (x *InsertSequentialJobsRequest) Reset() {
 	*x = InsertSequentialJobsRequest{}
-	mi := &file_rpc_proto_msgTypes[151]
+	mi := &file_rpc_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9192,7 +9632,7 @@ func (x *InsertSequentialJobsRequest) String() string {
 func (*InsertSequentialJobsRequest) ProtoMessage() {}
 
 func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[151]
+	mi := &file_rpc_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9205,7 +9645,7 @@ func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
 func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151}
+	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
@@ -9224,7 +9664,7 @@ type InsertSequentialJobsResponse struct {
 
 func (x *InsertSequentialJobsResponse) Reset() {
 	*x = InsertSequentialJobsResponse{}
-	mi := &file_rpc_proto_msgTypes[152]
+	mi := &file_rpc_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9236,7 +9676,7 @@ func (x *InsertSequentialJobsResponse) String() string {
 func (*InsertSequentialJobsResponse) ProtoMessage() {}
 
 func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[152]
+	mi := &file_rpc_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9249,7 +9689,7 @@ func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
 func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{152}
+	return file_rpc_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
@@ -9269,7 +9709,7 @@ type UpsertDataHistoryJobResponse struct {
 
 func (x *UpsertDataHistoryJobResponse) Reset() {
 	*x = UpsertDataHistoryJobResponse{}
-	mi := &file_rpc_proto_msgTypes[153]
+	mi := &file_rpc_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9281,7 +9721,7 @@ func (x *UpsertDataHistoryJobResponse) String() string {
 func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
 
 func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[153]
+	mi := &file_rpc_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9294,7 +9734,7 @@ func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
 func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{153}
+	return file_rpc_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *UpsertDataHistoryJobResponse) GetMessage() string {
@@ -9322,7 +9762,7 @@ type GetDataHistoryJobDetailsRequest struct {
 
 func (x *GetDataHistoryJobDetailsRequest) Reset() {
 	*x = GetDataHistoryJobDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[154]
+	mi := &file_rpc_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9334,7 +9774,7 @@ func (x *GetDataHistoryJobDetailsRequest) String() string {
 func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
 
 func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[154]
+	mi := &file_rpc_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9347,7 +9787,7 @@ func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{154}
+	return file_rpc_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *GetDataHistoryJobDetailsRequest) GetId() string {
@@ -9395,13 +9835,14 @@ type DataHistoryJob struct {
 	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
 	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
 	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
+	OrderbookDepth           uint64                  `protobuf:"varint,23,opt,name=orderbook_depth,json=orderbookDepth,proto3" json:"orderbook_depth,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *DataHistoryJob) Reset() {
 	*x = DataHistoryJob{}
-	mi := &file_rpc_proto_msgTypes[155]
+	mi := &file_rpc_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9413,7 +9854,7 @@ func (x *DataHistoryJob) String() string {
 func (*DataHistoryJob) ProtoMessage() {}
 
 func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[155]
+	mi := &file_rpc_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9426,7 +9867,7 @@ func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
 func (*DataHistoryJob) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{155}
+	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *DataHistoryJob) GetId() string {
@@ -9583,6 +10024,13 @@ func (x *DataHistoryJob) GetResultSummaries() []string {
 	return nil
 }
 
+func (x *DataHistoryJob) GetOrderbookDepth() uint64 {
+	if x != nil {
+		return x.OrderbookDepth
+	}
+	return 0
+}
+
 type DataHistoryJobResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
@@ -9596,7 +10044,7 @@ type DataHistoryJobResult struct {
 
 func (x *DataHistoryJobResult) Reset() {
 	*x = DataHistoryJobResult{}
-	mi := &file_rpc_proto_msgTypes[156]
+	mi := &file_rpc_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9608,7 +10056,7 @@ func (x *DataHistoryJobResult) String() string {
 func (*DataHistoryJobResult) ProtoMessage() {}
 
 func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[156]
+	mi := &file_rpc_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9621,7 +10069,7 @@ func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
 func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{156}
+	return file_rpc_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *DataHistoryJobResult) GetStartDate() string {
@@ -9668,7 +10116,7 @@ type DataHistoryJobs struct {
 
 func (x *DataHistoryJobs) Reset() {
 	*x = DataHistoryJobs{}
-	mi := &file_rpc_proto_msgTypes[157]
+	mi := &file_rpc_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9680,7 +10128,7 @@ func (x *DataHistoryJobs) String() string {
 func (*DataHistoryJobs) ProtoMessage() {}
 
 func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[157]
+	mi := &file_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9693,7 +10141,7 @@ func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
 func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{157}
+	return file_rpc_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
@@ -9713,7 +10161,7 @@ type GetDataHistoryJobsBetweenRequest struct {
 
 func (x *GetDataHistoryJobsBetweenRequest) Reset() {
 	*x = GetDataHistoryJobsBetweenRequest{}
-	mi := &file_rpc_proto_msgTypes[158]
+	mi := &file_rpc_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9725,7 +10173,7 @@ func (x *GetDataHistoryJobsBetweenRequest) String() string {
 func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
 
 func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[158]
+	mi := &file_rpc_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9738,7 +10186,7 @@ func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
 func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{158}
+	return file_rpc_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
@@ -9766,7 +10214,7 @@ type SetDataHistoryJobStatusRequest struct {
 
 func (x *SetDataHistoryJobStatusRequest) Reset() {
 	*x = SetDataHistoryJobStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[159]
+	mi := &file_rpc_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9778,7 +10226,7 @@ func (x *SetDataHistoryJobStatusRequest) String() string {
 func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
 
 func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[159]
+	mi := &file_rpc_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9791,7 +10239,7 @@ func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
 func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{159}
+	return file_rpc_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *SetDataHistoryJobStatusRequest) GetId() string {
@@ -9825,7 +10273,7 @@ type UpdateDataHistoryJobPrerequisiteRequest struct {
 
 func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
 	*x = UpdateDataHistoryJobPrerequisiteRequest{}
-	mi := &file_rpc_proto_msgTypes[160]
+	mi := &file_rpc_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9837,7 +10285,7 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
 func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
 
 func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[160]
+	mi := &file_rpc_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9850,7 +10298,7 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{160}
+	return file_rpc_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
@@ -9881,7 +10329,7 @@ type ModifyOrderRequest struct {
 
 func (x *ModifyOrderRequest) Reset() {
 	*x = ModifyOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[161]
+	mi := &file_rpc_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9893,7 +10341,7 @@ func (x *ModifyOrderRequest) String() string {
 func (*ModifyOrderRequest) ProtoMessage() {}
 
 func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[161]
+	mi := &file_rpc_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9906,7 +10354,7 @@ func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
 func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{161}
+	return file_rpc_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *ModifyOrderRequest) GetExchange() string {
@@ -9960,7 +10408,7 @@ type ModifyOrderResponse struct {
 
 func (x *ModifyOrderResponse) Reset() {
 	*x = ModifyOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[162]
+	mi := &file_rpc_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9972,7 +10420,7 @@ func (x *ModifyOrderResponse) String() string {
 func (*ModifyOrderResponse) ProtoMessage() {}
 
 func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[162]
+	mi := &file_rpc_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9985,7 +10433,7 @@ func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
 func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{162}
+	return file_rpc_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *ModifyOrderResponse) GetModifiedOrderId() string {
@@ -10004,7 +10452,7 @@ type CurrencyStateGetAllRequest struct {
 
 func (x *CurrencyStateGetAllRequest) Reset() {
 	*x = CurrencyStateGetAllRequest{}
-	mi := &file_rpc_proto_msgTypes[163]
+	mi := &file_rpc_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10016,7 +10464,7 @@ func (x *CurrencyStateGetAllRequest) String() string {
 func (*CurrencyStateGetAllRequest) ProtoMessage() {}
 
 func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[163]
+	mi := &file_rpc_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10029,7 +10477,7 @@ func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{163}
+	return file_rpc_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *CurrencyStateGetAllRequest) GetExchange() string {
@@ -10050,7 +10498,7 @@ type CurrencyStateTradingRequest struct {
 
 func (x *CurrencyStateTradingRequest) Reset() {
 	*x = CurrencyStateTradingRequest{}
-	mi := &file_rpc_proto_msgTypes[164]
+	mi := &file_rpc_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10062,7 +10510,7 @@ func (x *CurrencyStateTradingRequest) String() string {
 func (*CurrencyStateTradingRequest) ProtoMessage() {}
 
 func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[164]
+	mi := &file_rpc_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10075,7 +10523,7 @@ func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{164}
+	return file_rpc_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *CurrencyStateTradingRequest) GetExchange() string {
@@ -10110,7 +10558,7 @@ type CurrencyStateTradingPairRequest struct {
 
 func (x *CurrencyStateTradingPairRequest) Reset() {
 	*x = CurrencyStateTradingPairRequest{}
-	mi := &file_rpc_proto_msgTypes[165]
+	mi := &file_rpc_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10122,7 +10570,7 @@ func (x *CurrencyStateTradingPairRequest) String() string {
 func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
 
 func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[165]
+	mi := &file_rpc_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10135,7 +10583,7 @@ func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{165}
+	return file_rpc_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *CurrencyStateTradingPairRequest) GetExchange() string {
@@ -10170,7 +10618,7 @@ type CurrencyStateWithdrawRequest struct {
 
 func (x *CurrencyStateWithdrawRequest) Reset() {
 	*x = CurrencyStateWithdrawRequest{}
-	mi := &file_rpc_proto_msgTypes[166]
+	mi := &file_rpc_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10182,7 +10630,7 @@ func (x *CurrencyStateWithdrawRequest) String() string {
 func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
 
 func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[166]
+	mi := &file_rpc_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10195,7 +10643,7 @@ func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{166}
+	return file_rpc_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *CurrencyStateWithdrawRequest) GetExchange() string {
@@ -10230,7 +10678,7 @@ type CurrencyStateDepositRequest struct {
 
 func (x *CurrencyStateDepositRequest) Reset() {
 	*x = CurrencyStateDepositRequest{}
-	mi := &file_rpc_proto_msgTypes[167]
+	mi := &file_rpc_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10242,7 +10690,7 @@ func (x *CurrencyStateDepositRequest) String() string {
 func (*CurrencyStateDepositRequest) ProtoMessage() {}
 
 func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[167]
+	mi := &file_rpc_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10255,7 +10703,7 @@ func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
 func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{167}
+	return file_rpc_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *CurrencyStateDepositRequest) GetExchange() string {
@@ -10288,7 +10736,7 @@ type CurrencyStateResponse struct {
 
 func (x *CurrencyStateResponse) Reset() {
 	*x = CurrencyStateResponse{}
-	mi := &file_rpc_proto_msgTypes[168]
+	mi := &file_rpc_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10300,7 +10748,7 @@ func (x *CurrencyStateResponse) String() string {
 func (*CurrencyStateResponse) ProtoMessage() {}
 
 func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[168]
+	mi := &file_rpc_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10313,7 +10761,7 @@ func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
 func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{168}
+	return file_rpc_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
@@ -10336,7 +10784,7 @@ type CurrencyState struct {
 
 func (x *CurrencyState) Reset() {
 	*x = CurrencyState{}
-	mi := &file_rpc_proto_msgTypes[169]
+	mi := &file_rpc_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10348,7 +10796,7 @@ func (x *CurrencyState) String() string {
 func (*CurrencyState) ProtoMessage() {}
 
 func (x *CurrencyState) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[169]
+	mi := &file_rpc_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10361,7 +10809,7 @@ func (x *CurrencyState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
 func (*CurrencyState) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{169}
+	return file_rpc_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *CurrencyState) GetCurrency() string {
@@ -10410,7 +10858,7 @@ type FundingRate struct {
 
 func (x *FundingRate) Reset() {
 	*x = FundingRate{}
-	mi := &file_rpc_proto_msgTypes[170]
+	mi := &file_rpc_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10422,7 +10870,7 @@ func (x *FundingRate) String() string {
 func (*FundingRate) ProtoMessage() {}
 
 func (x *FundingRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[170]
+	mi := &file_rpc_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10435,7 +10883,7 @@ func (x *FundingRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
 func (*FundingRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{170}
+	return file_rpc_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *FundingRate) GetDate() string {
@@ -10479,7 +10927,7 @@ type FundingData struct {
 
 func (x *FundingData) Reset() {
 	*x = FundingData{}
-	mi := &file_rpc_proto_msgTypes[171]
+	mi := &file_rpc_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10491,7 +10939,7 @@ func (x *FundingData) String() string {
 func (*FundingData) ProtoMessage() {}
 
 func (x *FundingData) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[171]
+	mi := &file_rpc_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10504,7 +10952,7 @@ func (x *FundingData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
 func (*FundingData) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{171}
+	return file_rpc_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *FundingData) GetExchange() string {
@@ -10626,7 +11074,7 @@ type FuturesPositionStats struct {
 
 func (x *FuturesPositionStats) Reset() {
 	*x = FuturesPositionStats{}
-	mi := &file_rpc_proto_msgTypes[172]
+	mi := &file_rpc_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10638,7 +11086,7 @@ func (x *FuturesPositionStats) String() string {
 func (*FuturesPositionStats) ProtoMessage() {}
 
 func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[172]
+	mi := &file_rpc_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10651,7 +11099,7 @@ func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
 func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{172}
+	return file_rpc_proto_rawDescGZIP(), []int{176}
 }
 
 func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
@@ -10870,7 +11318,7 @@ type FuturePosition struct {
 
 func (x *FuturePosition) Reset() {
 	*x = FuturePosition{}
-	mi := &file_rpc_proto_msgTypes[173]
+	mi := &file_rpc_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10882,7 +11330,7 @@ func (x *FuturePosition) String() string {
 func (*FuturePosition) ProtoMessage() {}
 
 func (x *FuturePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[173]
+	mi := &file_rpc_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10895,7 +11343,7 @@ func (x *FuturePosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
 func (*FuturePosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{173}
+	return file_rpc_proto_rawDescGZIP(), []int{177}
 }
 
 func (x *FuturePosition) GetExchange() string {
@@ -11046,7 +11494,7 @@ type GetManagedPositionRequest struct {
 
 func (x *GetManagedPositionRequest) Reset() {
 	*x = GetManagedPositionRequest{}
-	mi := &file_rpc_proto_msgTypes[174]
+	mi := &file_rpc_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11058,7 +11506,7 @@ func (x *GetManagedPositionRequest) String() string {
 func (*GetManagedPositionRequest) ProtoMessage() {}
 
 func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[174]
+	mi := &file_rpc_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11071,7 +11519,7 @@ func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
 func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{174}
+	return file_rpc_proto_rawDescGZIP(), []int{178}
 }
 
 func (x *GetManagedPositionRequest) GetExchange() string {
@@ -11135,7 +11583,7 @@ type GetAllManagedPositionsRequest struct {
 
 func (x *GetAllManagedPositionsRequest) Reset() {
 	*x = GetAllManagedPositionsRequest{}
-	mi := &file_rpc_proto_msgTypes[175]
+	mi := &file_rpc_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11147,7 +11595,7 @@ func (x *GetAllManagedPositionsRequest) String() string {
 func (*GetAllManagedPositionsRequest) ProtoMessage() {}
 
 func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[175]
+	mi := &file_rpc_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11160,7 +11608,7 @@ func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{175}
+	return file_rpc_proto_rawDescGZIP(), []int{179}
 }
 
 func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
@@ -11200,7 +11648,7 @@ type GetManagedPositionsResponse struct {
 
 func (x *GetManagedPositionsResponse) Reset() {
 	*x = GetManagedPositionsResponse{}
-	mi := &file_rpc_proto_msgTypes[176]
+	mi := &file_rpc_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11212,7 +11660,7 @@ func (x *GetManagedPositionsResponse) String() string {
 func (*GetManagedPositionsResponse) ProtoMessage() {}
 
 func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[176]
+	mi := &file_rpc_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11225,7 +11673,7 @@ func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
 func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{176}
+	return file_rpc_proto_rawDescGZIP(), []int{180}
 }
 
 func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
@@ -11247,7 +11695,7 @@ type GetFuturesPositionsSummaryRequest struct {
 
 func (x *GetFuturesPositionsSummaryRequest) Reset() {
 	*x = GetFuturesPositionsSummaryRequest{}
-	mi := &file_rpc_proto_msgTypes[177]
+	mi := &file_rpc_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11259,7 +11707,7 @@ func (x *GetFuturesPositionsSummaryRequest) String() string {
 func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
 
 func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[177]
+	mi := &file_rpc_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11272,7 +11720,7 @@ func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{177}
+	return file_rpc_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
@@ -11315,7 +11763,7 @@ type GetFuturesPositionsSummaryResponse struct {
 
 func (x *GetFuturesPositionsSummaryResponse) Reset() {
 	*x = GetFuturesPositionsSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[178]
+	mi := &file_rpc_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11327,7 +11775,7 @@ func (x *GetFuturesPositionsSummaryResponse) String() string {
 func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
 
 func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[178]
+	mi := &file_rpc_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11340,7 +11788,7 @@ func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{178}
+	return file_rpc_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
@@ -11387,7 +11835,7 @@ type GetFuturesPositionsOrdersRequest struct {
 
 func (x *GetFuturesPositionsOrdersRequest) Reset() {
 	*x = GetFuturesPositionsOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[179]
+	mi := &file_rpc_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11399,7 +11847,7 @@ func (x *GetFuturesPositionsOrdersRequest) String() string {
 func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
 
 func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[179]
+	mi := &file_rpc_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11412,7 +11860,7 @@ func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{179}
+	return file_rpc_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
@@ -11480,7 +11928,7 @@ type GetFuturesPositionsOrdersResponse struct {
 
 func (x *GetFuturesPositionsOrdersResponse) Reset() {
 	*x = GetFuturesPositionsOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[180]
+	mi := &file_rpc_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11492,7 +11940,7 @@ func (x *GetFuturesPositionsOrdersResponse) String() string {
 func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
 
 func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[180]
+	mi := &file_rpc_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11505,7 +11953,7 @@ func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
 func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{180}
+	return file_rpc_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
@@ -11525,7 +11973,7 @@ type GetCollateralModeRequest struct {
 
 func (x *GetCollateralModeRequest) Reset() {
 	*x = GetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[181]
+	mi := &file_rpc_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11537,7 +11985,7 @@ func (x *GetCollateralModeRequest) String() string {
 func (*GetCollateralModeRequest) ProtoMessage() {}
 
 func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[181]
+	mi := &file_rpc_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11550,7 +11998,7 @@ func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
 func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{181}
+	return file_rpc_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *GetCollateralModeRequest) GetExchange() string {
@@ -11578,7 +12026,7 @@ type GetCollateralModeResponse struct {
 
 func (x *GetCollateralModeResponse) Reset() {
 	*x = GetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[182]
+	mi := &file_rpc_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11590,7 +12038,7 @@ func (x *GetCollateralModeResponse) String() string {
 func (*GetCollateralModeResponse) ProtoMessage() {}
 
 func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[182]
+	mi := &file_rpc_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11603,7 +12051,7 @@ func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
 func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{182}
+	return file_rpc_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *GetCollateralModeResponse) GetExchange() string {
@@ -11638,7 +12086,7 @@ type SetCollateralModeRequest struct {
 
 func (x *SetCollateralModeRequest) Reset() {
 	*x = SetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[183]
+	mi := &file_rpc_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11650,7 +12098,7 @@ func (x *SetCollateralModeRequest) String() string {
 func (*SetCollateralModeRequest) ProtoMessage() {}
 
 func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[183]
+	mi := &file_rpc_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11663,7 +12111,7 @@ func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
 func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{183}
+	return file_rpc_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *SetCollateralModeRequest) GetExchange() string {
@@ -11698,7 +12146,7 @@ type SetCollateralModeResponse struct {
 
 func (x *SetCollateralModeResponse) Reset() {
 	*x = SetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[184]
+	mi := &file_rpc_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11710,7 +12158,7 @@ func (x *SetCollateralModeResponse) String() string {
 func (*SetCollateralModeResponse) ProtoMessage() {}
 
 func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[184]
+	mi := &file_rpc_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11723,7 +12171,7 @@ func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
 func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{184}
+	return file_rpc_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *SetCollateralModeResponse) GetExchange() string {
@@ -11758,7 +12206,7 @@ type GetMarginTypeRequest struct {
 
 func (x *GetMarginTypeRequest) Reset() {
 	*x = GetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[185]
+	mi := &file_rpc_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11770,7 +12218,7 @@ func (x *GetMarginTypeRequest) String() string {
 func (*GetMarginTypeRequest) ProtoMessage() {}
 
 func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[185]
+	mi := &file_rpc_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11783,7 +12231,7 @@ func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
 func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{185}
+	return file_rpc_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *GetMarginTypeRequest) GetExchange() string {
@@ -11819,7 +12267,7 @@ type GetMarginTypeResponse struct {
 
 func (x *GetMarginTypeResponse) Reset() {
 	*x = GetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[186]
+	mi := &file_rpc_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11831,7 +12279,7 @@ func (x *GetMarginTypeResponse) String() string {
 func (*GetMarginTypeResponse) ProtoMessage() {}
 
 func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[186]
+	mi := &file_rpc_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11844,7 +12292,7 @@ func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
 func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{186}
+	return file_rpc_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *GetMarginTypeResponse) GetExchange() string {
@@ -11890,7 +12338,7 @@ type ChangePositionMarginRequest struct {
 
 func (x *ChangePositionMarginRequest) Reset() {
 	*x = ChangePositionMarginRequest{}
-	mi := &file_rpc_proto_msgTypes[187]
+	mi := &file_rpc_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11902,7 +12350,7 @@ func (x *ChangePositionMarginRequest) String() string {
 func (*ChangePositionMarginRequest) ProtoMessage() {}
 
 func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[187]
+	mi := &file_rpc_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11915,7 +12363,7 @@ func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
 func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{187}
+	return file_rpc_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *ChangePositionMarginRequest) GetExchange() string {
@@ -11981,7 +12429,7 @@ type ChangePositionMarginResponse struct {
 
 func (x *ChangePositionMarginResponse) Reset() {
 	*x = ChangePositionMarginResponse{}
-	mi := &file_rpc_proto_msgTypes[188]
+	mi := &file_rpc_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11993,7 +12441,7 @@ func (x *ChangePositionMarginResponse) String() string {
 func (*ChangePositionMarginResponse) ProtoMessage() {}
 
 func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[188]
+	mi := &file_rpc_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12006,7 +12454,7 @@ func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
 func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{188}
+	return file_rpc_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *ChangePositionMarginResponse) GetExchange() string {
@@ -12063,7 +12511,7 @@ type SetMarginTypeRequest struct {
 
 func (x *SetMarginTypeRequest) Reset() {
 	*x = SetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[189]
+	mi := &file_rpc_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12075,7 +12523,7 @@ func (x *SetMarginTypeRequest) String() string {
 func (*SetMarginTypeRequest) ProtoMessage() {}
 
 func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[189]
+	mi := &file_rpc_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12088,7 +12536,7 @@ func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
 func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{189}
+	return file_rpc_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *SetMarginTypeRequest) GetExchange() string {
@@ -12131,7 +12579,7 @@ type SetMarginTypeResponse struct {
 
 func (x *SetMarginTypeResponse) Reset() {
 	*x = SetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[190]
+	mi := &file_rpc_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12143,7 +12591,7 @@ func (x *SetMarginTypeResponse) String() string {
 func (*SetMarginTypeResponse) ProtoMessage() {}
 
 func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[190]
+	mi := &file_rpc_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12156,7 +12604,7 @@ func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
 func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{190}
+	return file_rpc_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *SetMarginTypeResponse) GetExchange() string {
@@ -12201,7 +12649,7 @@ type GetLeverageRequest struct {
 
 func (x *GetLeverageRequest) Reset() {
 	*x = GetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[191]
+	mi := &file_rpc_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12213,7 +12661,7 @@ func (x *GetLeverageRequest) String() string {
 func (*GetLeverageRequest) ProtoMessage() {}
 
 func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[191]
+	mi := &file_rpc_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12226,7 +12674,7 @@ func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
 func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{191}
+	return file_rpc_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *GetLeverageRequest) GetExchange() string {
@@ -12286,7 +12734,7 @@ type GetLeverageResponse struct {
 
 func (x *GetLeverageResponse) Reset() {
 	*x = GetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[192]
+	mi := &file_rpc_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12298,7 +12746,7 @@ func (x *GetLeverageResponse) String() string {
 func (*GetLeverageResponse) ProtoMessage() {}
 
 func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[192]
+	mi := &file_rpc_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12311,7 +12759,7 @@ func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
 func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{192}
+	return file_rpc_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *GetLeverageResponse) GetExchange() string {
@@ -12378,7 +12826,7 @@ type SetLeverageRequest struct {
 
 func (x *SetLeverageRequest) Reset() {
 	*x = SetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[193]
+	mi := &file_rpc_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12390,7 +12838,7 @@ func (x *SetLeverageRequest) String() string {
 func (*SetLeverageRequest) ProtoMessage() {}
 
 func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[193]
+	mi := &file_rpc_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12403,7 +12851,7 @@ func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
 func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{193}
+	return file_rpc_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *SetLeverageRequest) GetExchange() string {
@@ -12470,7 +12918,7 @@ type SetLeverageResponse struct {
 
 func (x *SetLeverageResponse) Reset() {
 	*x = SetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[194]
+	mi := &file_rpc_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12482,7 +12930,7 @@ func (x *SetLeverageResponse) String() string {
 func (*SetLeverageResponse) ProtoMessage() {}
 
 func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[194]
+	mi := &file_rpc_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12495,7 +12943,7 @@ func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
 func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{194}
+	return file_rpc_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *SetLeverageResponse) GetExchange() string {
@@ -12560,7 +13008,7 @@ type GetCollateralRequest struct {
 
 func (x *GetCollateralRequest) Reset() {
 	*x = GetCollateralRequest{}
-	mi := &file_rpc_proto_msgTypes[195]
+	mi := &file_rpc_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12572,7 +13020,7 @@ func (x *GetCollateralRequest) String() string {
 func (*GetCollateralRequest) ProtoMessage() {}
 
 func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[195]
+	mi := &file_rpc_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12585,7 +13033,7 @@ func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
 func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{195}
+	return file_rpc_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *GetCollateralRequest) GetExchange() string {
@@ -12642,7 +13090,7 @@ type GetCollateralResponse struct {
 
 func (x *GetCollateralResponse) Reset() {
 	*x = GetCollateralResponse{}
-	mi := &file_rpc_proto_msgTypes[196]
+	mi := &file_rpc_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12654,7 +13102,7 @@ func (x *GetCollateralResponse) String() string {
 func (*GetCollateralResponse) ProtoMessage() {}
 
 func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[196]
+	mi := &file_rpc_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12667,7 +13115,7 @@ func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
 func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{196}
+	return file_rpc_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *GetCollateralResponse) GetSubAccount() string {
@@ -12768,7 +13216,7 @@ type CollateralForCurrency struct {
 
 func (x *CollateralForCurrency) Reset() {
 	*x = CollateralForCurrency{}
-	mi := &file_rpc_proto_msgTypes[197]
+	mi := &file_rpc_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12780,7 +13228,7 @@ func (x *CollateralForCurrency) String() string {
 func (*CollateralForCurrency) ProtoMessage() {}
 
 func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[197]
+	mi := &file_rpc_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12793,7 +13241,7 @@ func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
 func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{197}
+	return file_rpc_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *CollateralForCurrency) GetCurrency() string {
@@ -12902,7 +13350,7 @@ type CollateralByPosition struct {
 
 func (x *CollateralByPosition) Reset() {
 	*x = CollateralByPosition{}
-	mi := &file_rpc_proto_msgTypes[198]
+	mi := &file_rpc_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12914,7 +13362,7 @@ func (x *CollateralByPosition) String() string {
 func (*CollateralByPosition) ProtoMessage() {}
 
 func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[198]
+	mi := &file_rpc_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12927,7 +13375,7 @@ func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
 func (*CollateralByPosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{198}
+	return file_rpc_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *CollateralByPosition) GetCurrency() string {
@@ -12995,7 +13443,7 @@ type CollateralUsedBreakdown struct {
 
 func (x *CollateralUsedBreakdown) Reset() {
 	*x = CollateralUsedBreakdown{}
-	mi := &file_rpc_proto_msgTypes[199]
+	mi := &file_rpc_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13007,7 +13455,7 @@ func (x *CollateralUsedBreakdown) String() string {
 func (*CollateralUsedBreakdown) ProtoMessage() {}
 
 func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[199]
+	mi := &file_rpc_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13020,7 +13468,7 @@ func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
 func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{199}
+	return file_rpc_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
@@ -13096,7 +13544,7 @@ type GetFundingRatesRequest struct {
 
 func (x *GetFundingRatesRequest) Reset() {
 	*x = GetFundingRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[200]
+	mi := &file_rpc_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13108,7 +13556,7 @@ func (x *GetFundingRatesRequest) String() string {
 func (*GetFundingRatesRequest) ProtoMessage() {}
 
 func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[200]
+	mi := &file_rpc_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13121,7 +13569,7 @@ func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
 func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{200}
+	return file_rpc_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *GetFundingRatesRequest) GetExchange() string {
@@ -13196,7 +13644,7 @@ type GetFundingRatesResponse struct {
 
 func (x *GetFundingRatesResponse) Reset() {
 	*x = GetFundingRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[201]
+	mi := &file_rpc_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13208,7 +13656,7 @@ func (x *GetFundingRatesResponse) String() string {
 func (*GetFundingRatesResponse) ProtoMessage() {}
 
 func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[201]
+	mi := &file_rpc_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13221,7 +13669,7 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
 func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{201}
+	return file_rpc_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *GetFundingRatesResponse) GetRates() *FundingData {
@@ -13243,7 +13691,7 @@ type GetLatestFundingRateRequest struct {
 
 func (x *GetLatestFundingRateRequest) Reset() {
 	*x = GetLatestFundingRateRequest{}
-	mi := &file_rpc_proto_msgTypes[202]
+	mi := &file_rpc_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13255,7 +13703,7 @@ func (x *GetLatestFundingRateRequest) String() string {
 func (*GetLatestFundingRateRequest) ProtoMessage() {}
 
 func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[202]
+	mi := &file_rpc_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13268,7 +13716,7 @@ func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
 func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{202}
+	return file_rpc_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *GetLatestFundingRateRequest) GetExchange() string {
@@ -13308,7 +13756,7 @@ type GetLatestFundingRateResponse struct {
 
 func (x *GetLatestFundingRateResponse) Reset() {
 	*x = GetLatestFundingRateResponse{}
-	mi := &file_rpc_proto_msgTypes[203]
+	mi := &file_rpc_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13320,7 +13768,7 @@ func (x *GetLatestFundingRateResponse) String() string {
 func (*GetLatestFundingRateResponse) ProtoMessage() {}
 
 func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[203]
+	mi := &file_rpc_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13333,7 +13781,7 @@ func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
 func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{203}
+	return file_rpc_proto_rawDescGZIP(), []int{207}
 }
 
 func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
@@ -13351,7 +13799,7 @@ type ShutdownRequest struct {
 
 func (x *ShutdownRequest) Reset() {
 	*x = ShutdownRequest{}
-	mi := &file_rpc_proto_msgTypes[204]
+	mi := &file_rpc_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13363,7 +13811,7 @@ func (x *ShutdownRequest) String() string {
 func (*ShutdownRequest) ProtoMessage() {}
 
 func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[204]
+	mi := &file_rpc_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13376,7 +13824,7 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{204}
+	return file_rpc_proto_rawDescGZIP(), []int{208}
 }
 
 type ShutdownResponse struct {
@@ -13387,7 +13835,7 @@ type ShutdownResponse struct {
 
 func (x *ShutdownResponse) Reset() {
 	*x = ShutdownResponse{}
-	mi := &file_rpc_proto_msgTypes[205]
+	mi := &file_rpc_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13399,7 +13847,7 @@ func (x *ShutdownResponse) String() string {
 func (*ShutdownResponse) ProtoMessage() {}
 
 func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[205]
+	mi := &file_rpc_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13412,7 +13860,7 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{205}
+	return file_rpc_proto_rawDescGZIP(), []int{209}
 }
 
 type GetTechnicalAnalysisRequest struct {
@@ -13439,7 +13887,7 @@ type GetTechnicalAnalysisRequest struct {
 
 func (x *GetTechnicalAnalysisRequest) Reset() {
 	*x = GetTechnicalAnalysisRequest{}
-	mi := &file_rpc_proto_msgTypes[206]
+	mi := &file_rpc_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13451,7 +13899,7 @@ func (x *GetTechnicalAnalysisRequest) String() string {
 func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
 
 func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[206]
+	mi := &file_rpc_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13464,7 +13912,7 @@ func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
 func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{206}
+	return file_rpc_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *GetTechnicalAnalysisRequest) GetExchange() string {
@@ -13588,7 +14036,7 @@ type ListOfSignals struct {
 
 func (x *ListOfSignals) Reset() {
 	*x = ListOfSignals{}
-	mi := &file_rpc_proto_msgTypes[207]
+	mi := &file_rpc_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13600,7 +14048,7 @@ func (x *ListOfSignals) String() string {
 func (*ListOfSignals) ProtoMessage() {}
 
 func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[207]
+	mi := &file_rpc_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13613,7 +14061,7 @@ func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
 func (*ListOfSignals) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{207}
+	return file_rpc_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *ListOfSignals) GetSignals() []float64 {
@@ -13632,7 +14080,7 @@ type GetTechnicalAnalysisResponse struct {
 
 func (x *GetTechnicalAnalysisResponse) Reset() {
 	*x = GetTechnicalAnalysisResponse{}
-	mi := &file_rpc_proto_msgTypes[208]
+	mi := &file_rpc_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13644,7 +14092,7 @@ func (x *GetTechnicalAnalysisResponse) String() string {
 func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
 
 func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[208]
+	mi := &file_rpc_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13657,7 +14105,7 @@ func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
 func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{208}
+	return file_rpc_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
@@ -13688,7 +14136,7 @@ type GetMarginRatesHistoryRequest struct {
 
 func (x *GetMarginRatesHistoryRequest) Reset() {
 	*x = GetMarginRatesHistoryRequest{}
-	mi := &file_rpc_proto_msgTypes[209]
+	mi := &file_rpc_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13700,7 +14148,7 @@ func (x *GetMarginRatesHistoryRequest) String() string {
 func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
 
 func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[209]
+	mi := &file_rpc_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13713,7 +14161,7 @@ func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{209}
+	return file_rpc_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *GetMarginRatesHistoryRequest) GetExchange() string {
@@ -13817,7 +14265,7 @@ type LendingPayment struct {
 
 func (x *LendingPayment) Reset() {
 	*x = LendingPayment{}
-	mi := &file_rpc_proto_msgTypes[210]
+	mi := &file_rpc_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13829,7 +14277,7 @@ func (x *LendingPayment) String() string {
 func (*LendingPayment) ProtoMessage() {}
 
 func (x *LendingPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[210]
+	mi := &file_rpc_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13842,7 +14290,7 @@ func (x *LendingPayment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
 func (*LendingPayment) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{210}
+	return file_rpc_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *LendingPayment) GetPayment() string {
@@ -13869,7 +14317,7 @@ type BorrowCost struct {
 
 func (x *BorrowCost) Reset() {
 	*x = BorrowCost{}
-	mi := &file_rpc_proto_msgTypes[211]
+	mi := &file_rpc_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13881,7 +14329,7 @@ func (x *BorrowCost) String() string {
 func (*BorrowCost) ProtoMessage() {}
 
 func (x *BorrowCost) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[211]
+	mi := &file_rpc_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13894,7 +14342,7 @@ func (x *BorrowCost) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
 func (*BorrowCost) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{211}
+	return file_rpc_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *BorrowCost) GetCost() string {
@@ -13927,7 +14375,7 @@ type MarginRate struct {
 
 func (x *MarginRate) Reset() {
 	*x = MarginRate{}
-	mi := &file_rpc_proto_msgTypes[212]
+	mi := &file_rpc_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13939,7 +14387,7 @@ func (x *MarginRate) String() string {
 func (*MarginRate) ProtoMessage() {}
 
 func (x *MarginRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[212]
+	mi := &file_rpc_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13952,7 +14400,7 @@ func (x *MarginRate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
 func (*MarginRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{212}
+	return file_rpc_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *MarginRate) GetTime() string {
@@ -14028,7 +14476,7 @@ type GetMarginRatesHistoryResponse struct {
 
 func (x *GetMarginRatesHistoryResponse) Reset() {
 	*x = GetMarginRatesHistoryResponse{}
-	mi := &file_rpc_proto_msgTypes[213]
+	mi := &file_rpc_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14040,7 +14488,7 @@ func (x *GetMarginRatesHistoryResponse) String() string {
 func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
 
 func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[213]
+	mi := &file_rpc_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14053,7 +14501,7 @@ func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{213}
+	return file_rpc_proto_rawDescGZIP(), []int{217}
 }
 
 func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
@@ -14134,7 +14582,7 @@ type GetOrderbookMovementRequest struct {
 
 func (x *GetOrderbookMovementRequest) Reset() {
 	*x = GetOrderbookMovementRequest{}
-	mi := &file_rpc_proto_msgTypes[214]
+	mi := &file_rpc_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14146,7 +14594,7 @@ func (x *GetOrderbookMovementRequest) String() string {
 func (*GetOrderbookMovementRequest) ProtoMessage() {}
 
 func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[214]
+	mi := &file_rpc_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14159,7 +14607,7 @@ func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{214}
+	return file_rpc_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *GetOrderbookMovementRequest) GetExchange() string {
@@ -14233,7 +14681,7 @@ type GetOrderbookMovementResponse struct {
 
 func (x *GetOrderbookMovementResponse) Reset() {
 	*x = GetOrderbookMovementResponse{}
-	mi := &file_rpc_proto_msgTypes[215]
+	mi := &file_rpc_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14245,7 +14693,7 @@ func (x *GetOrderbookMovementResponse) String() string {
 func (*GetOrderbookMovementResponse) ProtoMessage() {}
 
 func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[215]
+	mi := &file_rpc_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14258,7 +14706,7 @@ func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{215}
+	return file_rpc_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
@@ -14373,7 +14821,7 @@ type GetOrderbookAmountByNominalRequest struct {
 
 func (x *GetOrderbookAmountByNominalRequest) Reset() {
 	*x = GetOrderbookAmountByNominalRequest{}
-	mi := &file_rpc_proto_msgTypes[216]
+	mi := &file_rpc_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14385,7 +14833,7 @@ func (x *GetOrderbookAmountByNominalRequest) String() string {
 func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[216]
+	mi := &file_rpc_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14398,7 +14846,7 @@ func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{216}
+	return file_rpc_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
@@ -14462,7 +14910,7 @@ type GetOrderbookAmountByNominalResponse struct {
 
 func (x *GetOrderbookAmountByNominalResponse) Reset() {
 	*x = GetOrderbookAmountByNominalResponse{}
-	mi := &file_rpc_proto_msgTypes[217]
+	mi := &file_rpc_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14474,7 +14922,7 @@ func (x *GetOrderbookAmountByNominalResponse) String() string {
 func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[217]
+	mi := &file_rpc_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14487,7 +14935,7 @@ func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{217}
+	return file_rpc_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
@@ -14581,7 +15029,7 @@ type GetOrderbookAmountByImpactRequest struct {
 
 func (x *GetOrderbookAmountByImpactRequest) Reset() {
 	*x = GetOrderbookAmountByImpactRequest{}
-	mi := &file_rpc_proto_msgTypes[218]
+	mi := &file_rpc_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14593,7 +15041,7 @@ func (x *GetOrderbookAmountByImpactRequest) String() string {
 func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[218]
+	mi := &file_rpc_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14606,7 +15054,7 @@ func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{218}
+	return file_rpc_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
@@ -14670,7 +15118,7 @@ type GetOrderbookAmountByImpactResponse struct {
 
 func (x *GetOrderbookAmountByImpactResponse) Reset() {
 	*x = GetOrderbookAmountByImpactResponse{}
-	mi := &file_rpc_proto_msgTypes[219]
+	mi := &file_rpc_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14682,7 +15130,7 @@ func (x *GetOrderbookAmountByImpactResponse) String() string {
 func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
 
 func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[219]
+	mi := &file_rpc_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14695,7 +15143,7 @@ func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{219}
+	return file_rpc_proto_rawDescGZIP(), []int{223}
 }
 
 func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
@@ -14783,21 +15231,4576 @@ type GetOpenInterestRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestRequest) Reset() {
-	*x = GetOpenInterestRequest{}
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *GetOpenInterestRequest) Reset() {
+	*x = GetOpenInterestRequest{}
+	mi := &file_rpc_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestRequest) ProtoMessage() {}
+
+func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *GetOpenInterestRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OpenInterestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenInterestDataRequest) Reset() {
+	*x = OpenInterestDataRequest{}
+	mi := &file_rpc_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenInterestDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenInterestDataRequest) ProtoMessage() {}
+
+func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *OpenInterestDataRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetOpenInterestResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestResponse) Reset() {
+	*x = GetOpenInterestResponse{}
+	mi := &file_rpc_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestResponse) ProtoMessage() {}
+
+func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OpenInterestDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenInterestDataResponse) Reset() {
+	*x = OpenInterestDataResponse{}
+	mi := &file_rpc_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenInterestDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenInterestDataResponse) ProtoMessage() {}
+
+func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *OpenInterestDataResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *OpenInterestDataResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+	if x != nil {
+		return x.OpenInterest
+	}
+	return 0
+}
+
+type GetCurrencyTradeURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLRequest) Reset() {
+	*x = GetCurrencyTradeURLRequest{}
+	mi := &file_rpc_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyTradeURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *GetCurrencyTradeURLRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetCurrencyTradeURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLResponse) Reset() {
+	*x = GetCurrencyTradeURLResponse{}
+	mi := &file_rpc_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyTradeURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SyntheticOrder struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Exchange          string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair              *CurrencyPair          `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side              string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Amount            float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	EntryPrice        float64                `protobuf:"fixed64,9,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	TakeProfitPrice   float64                `protobuf:"fixed64,10,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	StopLossPrice     float64                `protobuf:"fixed64,11,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
+	TrailingDistance  float64                `protobuf:"fixed64,12,opt,name=trailing_distance,json=trailingDistance,proto3" json:"trailing_distance,omitempty"`
+	TrailingPercent   float64                `protobuf:"fixed64,13,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	TriggerPrice      float64                `protobuf:"fixed64,14,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LastPrice         float64                `protobuf:"fixed64,15,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	EntryOrderId      string                 `protobuf:"bytes,16,opt,name=entry_order_id,json=entryOrderId,proto3" json:"entry_order_id,omitempty"`
+	TakeProfitOrderId string                 `protobuf:"bytes,17,opt,name=take_profit_order_id,json=takeProfitOrderId,proto3" json:"take_profit_order_id,omitempty"`
+	StopOrderId       string                 `protobuf:"bytes,18,opt,name=stop_order_id,json=stopOrderId,proto3" json:"stop_order_id,omitempty"`
+	Error             string                 `protobuf:"bytes,19,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SyntheticOrder) Reset() {
+	*x = SyntheticOrder{}
+	mi := &file_rpc_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyntheticOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyntheticOrder) ProtoMessage() {}
+
+func (x *SyntheticOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyntheticOrder.ProtoReflect.Descriptor instead.
+func (*SyntheticOrder) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *SyntheticOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SyntheticOrder) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetEntryPrice() float64 {
+	if x != nil {
+		return x.EntryPrice
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetTakeProfitPrice() float64 {
+	if x != nil {
+		return x.TakeProfitPrice
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetStopLossPrice() float64 {
+	if x != nil {
+		return x.StopLossPrice
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetTrailingDistance() float64 {
+	if x != nil {
+		return x.TrailingDistance
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetTrailingPercent() float64 {
+	if x != nil {
+		return x.TrailingPercent
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetTriggerPrice() float64 {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *SyntheticOrder) GetEntryOrderId() string {
+	if x != nil {
+		return x.EntryOrderId
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetTakeProfitOrderId() string {
+	if x != nil {
+		return x.TakeProfitOrderId
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetStopOrderId() string {
+	if x != nil {
+		return x.StopOrderId
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyntheticOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SyntheticOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddSyntheticOrderRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Type             string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Exchange         string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset            string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair             *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side             string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Amount           float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	EntryPrice       float64                `protobuf:"fixed64,7,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	TakeProfitPrice  float64                `protobuf:"fixed64,8,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	StopLossPrice    float64                `protobuf:"fixed64,9,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
+	TrailingDistance float64                `protobuf:"fixed64,10,opt,name=trailing_distance,json=trailingDistance,proto3" json:"trailing_distance,omitempty"`
+	TrailingPercent  float64                `protobuf:"fixed64,11,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddSyntheticOrderRequest) Reset() {
+	*x = AddSyntheticOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSyntheticOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSyntheticOrderRequest) ProtoMessage() {}
+
+func (x *AddSyntheticOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSyntheticOrderRequest.ProtoReflect.Descriptor instead.
+func (*AddSyntheticOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *AddSyntheticOrderRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddSyntheticOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *AddSyntheticOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AddSyntheticOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *AddSyntheticOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *AddSyntheticOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddSyntheticOrderRequest) GetEntryPrice() float64 {
+	if x != nil {
+		return x.EntryPrice
+	}
+	return 0
+}
+
+func (x *AddSyntheticOrderRequest) GetTakeProfitPrice() float64 {
+	if x != nil {
+		return x.TakeProfitPrice
+	}
+	return 0
+}
+
+func (x *AddSyntheticOrderRequest) GetStopLossPrice() float64 {
+	if x != nil {
+		return x.StopLossPrice
+	}
+	return 0
+}
+
+func (x *AddSyntheticOrderRequest) GetTrailingDistance() float64 {
+	if x != nil {
+		return x.TrailingDistance
+	}
+	return 0
+}
+
+func (x *AddSyntheticOrderRequest) GetTrailingPercent() float64 {
+	if x != nil {
+		return x.TrailingPercent
+	}
+	return 0
+}
+
+type GetSyntheticOrdersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSyntheticOrdersRequest) Reset() {
+	*x = GetSyntheticOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyntheticOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyntheticOrdersRequest) ProtoMessage() {}
+
+func (x *GetSyntheticOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyntheticOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetSyntheticOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *GetSyntheticOrdersRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type GetSyntheticOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*SyntheticOrder      `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyntheticOrdersResponse) Reset() {
+	*x = GetSyntheticOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyntheticOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyntheticOrdersResponse) ProtoMessage() {}
+
+func (x *GetSyntheticOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyntheticOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetSyntheticOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *GetSyntheticOrdersResponse) GetOrders() []*SyntheticOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type CancelSyntheticOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSyntheticOrderRequest) Reset() {
+	*x = CancelSyntheticOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSyntheticOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSyntheticOrderRequest) ProtoMessage() {}
+
+func (x *CancelSyntheticOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSyntheticOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelSyntheticOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *CancelSyntheticOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ExecutionAlgorithm struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Exchange          string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair              *CurrencyPair          `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side              string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Amount            float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price             float64                `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	Duration          int64                  `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	Slices            int64                  `protobuf:"varint,11,opt,name=slices,proto3" json:"slices,omitempty"`
+	Interval          int64                  `protobuf:"varint,12,opt,name=interval,proto3" json:"interval,omitempty"`
+	ProfileDays       int64                  `protobuf:"varint,13,opt,name=profile_days,json=profileDays,proto3" json:"profile_days,omitempty"`
+	ClipSize          float64                `protobuf:"fixed64,14,opt,name=clip_size,json=clipSize,proto3" json:"clip_size,omitempty"`
+	ClipVariance      float64                `protobuf:"fixed64,15,opt,name=clip_variance,json=clipVariance,proto3" json:"clip_variance,omitempty"`
+	ParticipationRate float64                `protobuf:"fixed64,16,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	ArrivalPrice      float64                `protobuf:"fixed64,17,opt,name=arrival_price,json=arrivalPrice,proto3" json:"arrival_price,omitempty"`
+	SubmittedAmount   float64                `protobuf:"fixed64,18,opt,name=submitted_amount,json=submittedAmount,proto3" json:"submitted_amount,omitempty"`
+	FilledAmount      float64                `protobuf:"fixed64,19,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	AveragePrice      float64                `protobuf:"fixed64,20,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	Slippage          float64                `protobuf:"fixed64,21,opt,name=slippage,proto3" json:"slippage,omitempty"`
+	MarketVolume      float64                `protobuf:"fixed64,22,opt,name=market_volume,json=marketVolume,proto3" json:"market_volume,omitempty"`
+	ChildOrderIds     []string               `protobuf:"bytes,23,rep,name=child_order_ids,json=childOrderIds,proto3" json:"child_order_ids,omitempty"`
+	Error             string                 `protobuf:"bytes,24,opt,name=error,proto3" json:"error,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExecutionAlgorithm) Reset() {
+	*x = ExecutionAlgorithm{}
+	mi := &file_rpc_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionAlgorithm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionAlgorithm) ProtoMessage() {}
+
+func (x *ExecutionAlgorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionAlgorithm.ProtoReflect.Descriptor instead.
+func (*ExecutionAlgorithm) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *ExecutionAlgorithm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ExecutionAlgorithm) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetSlices() int64 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetProfileDays() int64 {
+	if x != nil {
+		return x.ProfileDays
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetClipSize() float64 {
+	if x != nil {
+		return x.ClipSize
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetClipVariance() float64 {
+	if x != nil {
+		return x.ClipVariance
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetParticipationRate() float64 {
+	if x != nil {
+		return x.ParticipationRate
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetArrivalPrice() float64 {
+	if x != nil {
+		return x.ArrivalPrice
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetSubmittedAmount() float64 {
+	if x != nil {
+		return x.SubmittedAmount
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetFilledAmount() float64 {
+	if x != nil {
+		return x.FilledAmount
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetSlippage() float64 {
+	if x != nil {
+		return x.Slippage
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetMarketVolume() float64 {
+	if x != nil {
+		return x.MarketVolume
+	}
+	return 0
+}
+
+func (x *ExecutionAlgorithm) GetChildOrderIds() []string {
+	if x != nil {
+		return x.ChildOrderIds
+	}
+	return nil
+}
+
+func (x *ExecutionAlgorithm) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExecutionAlgorithm) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExecutionAlgorithm) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExecutionAlgorithm) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExecutionAlgorithm) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type StartExecutionAlgorithmRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Exchange          string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair              *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side              string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Amount            float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price             float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Duration          int64                  `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Slices            int64                  `protobuf:"varint,9,opt,name=slices,proto3" json:"slices,omitempty"`
+	Interval          int64                  `protobuf:"varint,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	ProfileDays       int64                  `protobuf:"varint,11,opt,name=profile_days,json=profileDays,proto3" json:"profile_days,omitempty"`
+	ClipSize          float64                `protobuf:"fixed64,12,opt,name=clip_size,json=clipSize,proto3" json:"clip_size,omitempty"`
+	ClipVariance      float64                `protobuf:"fixed64,13,opt,name=clip_variance,json=clipVariance,proto3" json:"clip_variance,omitempty"`
+	ParticipationRate float64                `protobuf:"fixed64,14,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartExecutionAlgorithmRequest) Reset() {
+	*x = StartExecutionAlgorithmRequest{}
+	mi := &file_rpc_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartExecutionAlgorithmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartExecutionAlgorithmRequest) ProtoMessage() {}
+
+func (x *StartExecutionAlgorithmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartExecutionAlgorithmRequest.ProtoReflect.Descriptor instead.
+func (*StartExecutionAlgorithmRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *StartExecutionAlgorithmRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StartExecutionAlgorithmRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *StartExecutionAlgorithmRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *StartExecutionAlgorithmRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *StartExecutionAlgorithmRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *StartExecutionAlgorithmRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetSlices() int64 {
+	if x != nil {
+		return x.Slices
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetProfileDays() int64 {
+	if x != nil {
+		return x.ProfileDays
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetClipSize() float64 {
+	if x != nil {
+		return x.ClipSize
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetClipVariance() float64 {
+	if x != nil {
+		return x.ClipVariance
+	}
+	return 0
+}
+
+func (x *StartExecutionAlgorithmRequest) GetParticipationRate() float64 {
+	if x != nil {
+		return x.ParticipationRate
+	}
+	return 0
+}
+
+type GenericExecutionAlgorithmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenericExecutionAlgorithmRequest) Reset() {
+	*x = GenericExecutionAlgorithmRequest{}
+	mi := &file_rpc_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericExecutionAlgorithmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericExecutionAlgorithmRequest) ProtoMessage() {}
+
+func (x *GenericExecutionAlgorithmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericExecutionAlgorithmRequest.ProtoReflect.Descriptor instead.
+func (*GenericExecutionAlgorithmRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *GenericExecutionAlgorithmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetExecutionAlgorithmsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetExecutionAlgorithmsRequest) Reset() {
+	*x = GetExecutionAlgorithmsRequest{}
+	mi := &file_rpc_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionAlgorithmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionAlgorithmsRequest) ProtoMessage() {}
+
+func (x *GetExecutionAlgorithmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionAlgorithmsRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionAlgorithmsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *GetExecutionAlgorithmsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetExecutionAlgorithmsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type GetExecutionAlgorithmsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithms    []*ExecutionAlgorithm  `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionAlgorithmsResponse) Reset() {
+	*x = GetExecutionAlgorithmsResponse{}
+	mi := &file_rpc_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionAlgorithmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionAlgorithmsResponse) ProtoMessage() {}
+
+func (x *GetExecutionAlgorithmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionAlgorithmsResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionAlgorithmsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *GetExecutionAlgorithmsResponse) GetAlgorithms() []*ExecutionAlgorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+type GetRiskStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskStatusRequest) Reset() {
+	*x = GetRiskStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskStatusRequest) ProtoMessage() {}
+
+func (x *GetRiskStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRiskStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
+}
+
+type RiskViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Check         string                 `protobuf:"bytes,5,opt,name=check,proto3" json:"check,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskViolation) Reset() {
+	*x = RiskViolation{}
+	mi := &file_rpc_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskViolation) ProtoMessage() {}
+
+func (x *RiskViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskViolation.ProtoReflect.Descriptor instead.
+func (*RiskViolation) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *RiskViolation) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RiskViolation) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *RiskViolation) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *RiskViolation) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *RiskViolation) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *RiskViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetRiskStatusResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Enabled               bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Checks                []string               `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	KillSwitchActive      bool                   `protobuf:"varint,3,opt,name=kill_switch_active,json=killSwitchActive,proto3" json:"kill_switch_active,omitempty"`
+	KillSwitchReason      string                 `protobuf:"bytes,4,opt,name=kill_switch_reason,json=killSwitchReason,proto3" json:"kill_switch_reason,omitempty"`
+	KillSwitchActivatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=kill_switch_activated_at,json=killSwitchActivatedAt,proto3" json:"kill_switch_activated_at,omitempty"`
+	Violations            []*RiskViolation       `protobuf:"bytes,6,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetRiskStatusResponse) Reset() {
+	*x = GetRiskStatusResponse{}
+	mi := &file_rpc_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskStatusResponse) ProtoMessage() {}
+
+func (x *GetRiskStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRiskStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *GetRiskStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetRiskStatusResponse) GetChecks() []string {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GetRiskStatusResponse) GetKillSwitchActive() bool {
+	if x != nil {
+		return x.KillSwitchActive
+	}
+	return false
+}
+
+func (x *GetRiskStatusResponse) GetKillSwitchReason() string {
+	if x != nil {
+		return x.KillSwitchReason
+	}
+	return ""
+}
+
+func (x *GetRiskStatusResponse) GetKillSwitchActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KillSwitchActivatedAt
+	}
+	return nil
+}
+
+func (x *GetRiskStatusResponse) GetViolations() []*RiskViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type SetKillSwitchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKillSwitchRequest) Reset() {
+	*x = SetKillSwitchRequest{}
+	mi := &file_rpc_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKillSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKillSwitchRequest) ProtoMessage() {}
+
+func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKillSwitchRequest.ProtoReflect.Descriptor instead.
+func (*SetKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *SetKillSwitchRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SetKillSwitchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ConsolidatedOrderbookSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidatedOrderbookSource) Reset() {
+	*x = ConsolidatedOrderbookSource{}
+	mi := &file_rpc_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedOrderbookSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedOrderbookSource) ProtoMessage() {}
+
+func (x *ConsolidatedOrderbookSource) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedOrderbookSource.ProtoReflect.Descriptor instead.
+func (*ConsolidatedOrderbookSource) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *ConsolidatedOrderbookSource) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderbookSource) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetConsolidatedOrderbookRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sources       []*ConsolidatedOrderbookSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	AssetType     string                         `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Quote         string                         `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	MaxLevels     int64                          `protobuf:"varint,4,opt,name=max_levels,json=maxLevels,proto3" json:"max_levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsolidatedOrderbookRequest) Reset() {
+	*x = GetConsolidatedOrderbookRequest{}
+	mi := &file_rpc_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsolidatedOrderbookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsolidatedOrderbookRequest) ProtoMessage() {}
+
+func (x *GetConsolidatedOrderbookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsolidatedOrderbookRequest.ProtoReflect.Descriptor instead.
+func (*GetConsolidatedOrderbookRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *GetConsolidatedOrderbookRequest) GetSources() []*ConsolidatedOrderbookSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *GetConsolidatedOrderbookRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GetConsolidatedOrderbookRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *GetConsolidatedOrderbookRequest) GetMaxLevels() int64 {
+	if x != nil {
+		return x.MaxLevels
+	}
+	return 0
+}
+
+type ConsolidatedOrderbookItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	CurrencyPair  string                 `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	NativePrice   float64                `protobuf:"fixed64,5,opt,name=native_price,json=nativePrice,proto3" json:"native_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidatedOrderbookItem) Reset() {
+	*x = ConsolidatedOrderbookItem{}
+	mi := &file_rpc_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedOrderbookItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedOrderbookItem) ProtoMessage() {}
+
+func (x *ConsolidatedOrderbookItem) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedOrderbookItem.ProtoReflect.Descriptor instead.
+func (*ConsolidatedOrderbookItem) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *ConsolidatedOrderbookItem) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderbookItem) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderbookItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookItem) GetNativePrice() float64 {
+	if x != nil {
+		return x.NativePrice
+	}
+	return 0
+}
+
+type ConsolidatedOrderbookVenue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	CurrencyPair  string                 `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Bids          int64                  `protobuf:"varint,4,opt,name=bids,proto3" json:"bids,omitempty"`
+	Asks          int64                  `protobuf:"varint,5,opt,name=asks,proto3" json:"asks,omitempty"`
+	LastUpdated   int64                  `protobuf:"varint,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidatedOrderbookVenue) Reset() {
+	*x = ConsolidatedOrderbookVenue{}
+	mi := &file_rpc_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedOrderbookVenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedOrderbookVenue) ProtoMessage() {}
+
+func (x *ConsolidatedOrderbookVenue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedOrderbookVenue.ProtoReflect.Descriptor instead.
+func (*ConsolidatedOrderbookVenue) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *ConsolidatedOrderbookVenue) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderbookVenue) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderbookVenue) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookVenue) GetBids() int64 {
+	if x != nil {
+		return x.Bids
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookVenue) GetAsks() int64 {
+	if x != nil {
+		return x.Asks
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookVenue) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookVenue) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConsolidatedOrderbookResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Pair          *CurrencyPair                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Bids          []*ConsolidatedOrderbookItem  `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*ConsolidatedOrderbookItem  `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty"`
+	Venues        []*ConsolidatedOrderbookVenue `protobuf:"bytes,5,rep,name=venues,proto3" json:"venues,omitempty"`
+	LastUpdated   int64                         `protobuf:"varint,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Error         string                        `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidatedOrderbookResponse) Reset() {
+	*x = ConsolidatedOrderbookResponse{}
+	mi := &file_rpc_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedOrderbookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedOrderbookResponse) ProtoMessage() {}
+
+func (x *ConsolidatedOrderbookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedOrderbookResponse.ProtoReflect.Descriptor instead.
+func (*ConsolidatedOrderbookResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *ConsolidatedOrderbookResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ConsolidatedOrderbookResponse) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderbookResponse) GetBids() []*ConsolidatedOrderbookItem {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *ConsolidatedOrderbookResponse) GetAsks() []*ConsolidatedOrderbookItem {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *ConsolidatedOrderbookResponse) GetVenues() []*ConsolidatedOrderbookVenue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *ConsolidatedOrderbookResponse) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderbookResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SimulateConsolidatedOrderRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sources       []*ConsolidatedOrderbookSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	AssetType     string                         `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Quote         string                         `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	Side          string                         `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Amount        float64                        `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateConsolidatedOrderRequest) Reset() {
+	*x = SimulateConsolidatedOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateConsolidatedOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateConsolidatedOrderRequest) ProtoMessage() {}
+
+func (x *SimulateConsolidatedOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateConsolidatedOrderRequest.ProtoReflect.Descriptor instead.
+func (*SimulateConsolidatedOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *SimulateConsolidatedOrderRequest) GetSources() []*ConsolidatedOrderbookSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *SimulateConsolidatedOrderRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *SimulateConsolidatedOrderRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *SimulateConsolidatedOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *SimulateConsolidatedOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ConsolidatedWhaleBombRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sources       []*ConsolidatedOrderbookSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	AssetType     string                         `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Quote         string                         `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	Side          string                         `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	PriceTarget   float64                        `protobuf:"fixed64,5,opt,name=price_target,json=priceTarget,proto3" json:"price_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidatedWhaleBombRequest) Reset() {
+	*x = ConsolidatedWhaleBombRequest{}
+	mi := &file_rpc_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedWhaleBombRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedWhaleBombRequest) ProtoMessage() {}
+
+func (x *ConsolidatedWhaleBombRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedWhaleBombRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidatedWhaleBombRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *ConsolidatedWhaleBombRequest) GetSources() []*ConsolidatedOrderbookSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ConsolidatedWhaleBombRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *ConsolidatedWhaleBombRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *ConsolidatedWhaleBombRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *ConsolidatedWhaleBombRequest) GetPriceTarget() float64 {
+	if x != nil {
+		return x.PriceTarget
+	}
+	return 0
+}
+
+type ConsolidatedOrderFill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	CurrencyPair  string                 `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	BaseAmount    float64                `protobuf:"fixed64,3,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	QuoteAmount   float64                `protobuf:"fixed64,4,opt,name=quote_amount,json=quoteAmount,proto3" json:"quote_amount,omitempty"`
+	AveragePrice  float64                `protobuf:"fixed64,5,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	Levels        int64                  `protobuf:"varint,6,opt,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidatedOrderFill) Reset() {
+	*x = ConsolidatedOrderFill{}
+	mi := &file_rpc_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedOrderFill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedOrderFill) ProtoMessage() {}
+
+func (x *ConsolidatedOrderFill) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedOrderFill.ProtoReflect.Descriptor instead.
+func (*ConsolidatedOrderFill) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *ConsolidatedOrderFill) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderFill) GetCurrencyPair() string {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return ""
+}
+
+func (x *ConsolidatedOrderFill) GetBaseAmount() float64 {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderFill) GetQuoteAmount() float64 {
+	if x != nil {
+		return x.QuoteAmount
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderFill) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *ConsolidatedOrderFill) GetLevels() int64 {
+	if x != nil {
+		return x.Levels
+	}
+	return 0
+}
+
+type SimulateConsolidatedOrderResponse struct {
+	state              protoimpl.MessageState       `protogen:"open.v1"`
+	Orders             []*ConsolidatedOrderbookItem `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Fills              []*ConsolidatedOrderFill     `protobuf:"bytes,2,rep,name=fills,proto3" json:"fills,omitempty"`
+	Amount             float64                      `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinimumPrice       float64                      `protobuf:"fixed64,4,opt,name=minimum_price,json=minimumPrice,proto3" json:"minimum_price,omitempty"`
+	MaximumPrice       float64                      `protobuf:"fixed64,5,opt,name=maximum_price,json=maximumPrice,proto3" json:"maximum_price,omitempty"`
+	PercentageGainLoss float64                      `protobuf:"fixed64,6,opt,name=percentage_gain_loss,json=percentageGainLoss,proto3" json:"percentage_gain_loss,omitempty"`
+	Status             string                       `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SimulateConsolidatedOrderResponse) Reset() {
+	*x = SimulateConsolidatedOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateConsolidatedOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateConsolidatedOrderResponse) ProtoMessage() {}
+
+func (x *SimulateConsolidatedOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateConsolidatedOrderResponse.ProtoReflect.Descriptor instead.
+func (*SimulateConsolidatedOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetOrders() []*ConsolidatedOrderbookItem {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetFills() []*ConsolidatedOrderFill {
+	if x != nil {
+		return x.Fills
+	}
+	return nil
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetMinimumPrice() float64 {
+	if x != nil {
+		return x.MinimumPrice
+	}
+	return 0
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetMaximumPrice() float64 {
+	if x != nil {
+		return x.MaximumPrice
+	}
+	return 0
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetPercentageGainLoss() float64 {
+	if x != nil {
+		return x.PercentageGainLoss
+	}
+	return 0
+}
+
+func (x *SimulateConsolidatedOrderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RouteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          *CurrencyPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteOrderRequest) Reset() {
+	*x = RouteOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteOrderRequest) ProtoMessage() {}
+
+func (x *RouteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteOrderRequest.ProtoReflect.Descriptor instead.
+func (*RouteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *RouteOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *RouteOrderRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *RouteOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *RouteOrderRequest) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *RouteOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RouteOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RouteOrderRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type RoutedChildOrder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount         float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpectedPrice  float64                `protobuf:"fixed64,3,opt,name=expected_price,json=expectedPrice,proto3" json:"expected_price,omitempty"`
+	FeeRate        float64                `protobuf:"fixed64,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	OrderId        string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ExecutedAmount float64                `protobuf:"fixed64,7,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	AveragePrice   float64                `protobuf:"fixed64,8,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	Error          string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RoutedChildOrder) Reset() {
+	*x = RoutedChildOrder{}
+	mi := &file_rpc_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutedChildOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutedChildOrder) ProtoMessage() {}
+
+func (x *RoutedChildOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutedChildOrder.ProtoReflect.Descriptor instead.
+func (*RoutedChildOrder) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *RoutedChildOrder) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *RoutedChildOrder) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RoutedChildOrder) GetExpectedPrice() float64 {
+	if x != nil {
+		return x.ExpectedPrice
+	}
+	return 0
+}
+
+func (x *RoutedChildOrder) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *RoutedChildOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RoutedChildOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoutedChildOrder) GetExecutedAmount() float64 {
+	if x != nil {
+		return x.ExecutedAmount
+	}
+	return 0
+}
+
+func (x *RoutedChildOrder) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *RoutedChildOrder) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RouteExclusion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteExclusion) Reset() {
+	*x = RouteExclusion{}
+	mi := &file_rpc_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteExclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteExclusion) ProtoMessage() {}
+
+func (x *RouteExclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteExclusion.ProtoReflect.Descriptor instead.
+func (*RouteExclusion) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *RouteExclusion) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *RouteExclusion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RouteOrderResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string                 `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	RequestedAmount      float64                `protobuf:"fixed64,5,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	RoutedAmount         float64                `protobuf:"fixed64,6,opt,name=routed_amount,json=routedAmount,proto3" json:"routed_amount,omitempty"`
+	FilledAmount         float64                `protobuf:"fixed64,7,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	ExpectedPrice        float64                `protobuf:"fixed64,8,opt,name=expected_price,json=expectedPrice,proto3" json:"expected_price,omitempty"`
+	EffectivePrice       float64                `protobuf:"fixed64,9,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	BestSingleVenue      string                 `protobuf:"bytes,10,opt,name=best_single_venue,json=bestSingleVenue,proto3" json:"best_single_venue,omitempty"`
+	BestSingleVenuePrice float64                `protobuf:"fixed64,11,opt,name=best_single_venue_price,json=bestSingleVenuePrice,proto3" json:"best_single_venue_price,omitempty"`
+	PriceImprovement     float64                `protobuf:"fixed64,12,opt,name=price_improvement,json=priceImprovement,proto3" json:"price_improvement,omitempty"`
+	Children             []*RoutedChildOrder    `protobuf:"bytes,13,rep,name=children,proto3" json:"children,omitempty"`
+	Excluded             []*RouteExclusion      `protobuf:"bytes,14,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RouteOrderResponse) Reset() {
+	*x = RouteOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteOrderResponse) ProtoMessage() {}
+
+func (x *RouteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteOrderResponse.ProtoReflect.Descriptor instead.
+func (*RouteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *RouteOrderResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *RouteOrderResponse) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *RouteOrderResponse) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *RouteOrderResponse) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *RouteOrderResponse) GetRequestedAmount() float64 {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetRoutedAmount() float64 {
+	if x != nil {
+		return x.RoutedAmount
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetFilledAmount() float64 {
+	if x != nil {
+		return x.FilledAmount
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetExpectedPrice() float64 {
+	if x != nil {
+		return x.ExpectedPrice
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetBestSingleVenue() string {
+	if x != nil {
+		return x.BestSingleVenue
+	}
+	return ""
+}
+
+func (x *RouteOrderResponse) GetBestSingleVenuePrice() float64 {
+	if x != nil {
+		return x.BestSingleVenuePrice
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetPriceImprovement() float64 {
+	if x != nil {
+		return x.PriceImprovement
+	}
+	return 0
+}
+
+func (x *RouteOrderResponse) GetChildren() []*RoutedChildOrder {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *RouteOrderResponse) GetExcluded() []*RouteExclusion {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+type GetArbitrageOpportunitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArbitrageOpportunitiesRequest) Reset() {
+	*x = GetArbitrageOpportunitiesRequest{}
+	mi := &file_rpc_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArbitrageOpportunitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArbitrageOpportunitiesRequest) ProtoMessage() {}
+
+func (x *GetArbitrageOpportunitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArbitrageOpportunitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{257}
+}
+
+type ArbitrageLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AveragePrice  float64                `protobuf:"fixed64,5,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	FeeRate       float64                `protobuf:"fixed64,6,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	OrderId       string                 `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArbitrageLeg) Reset() {
+	*x = ArbitrageLeg{}
+	mi := &file_rpc_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArbitrageLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArbitrageLeg) ProtoMessage() {}
+
+func (x *ArbitrageLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArbitrageLeg.ProtoReflect.Descriptor instead.
+func (*ArbitrageLeg) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *ArbitrageLeg) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ArbitrageLeg) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ArbitrageLeg) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *ArbitrageLeg) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ArbitrageLeg) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *ArbitrageLeg) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *ArbitrageLeg) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ArbitrageLeg) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ArbitrageOpportunity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartAmount   float64                `protobuf:"fixed64,5,opt,name=start_amount,json=startAmount,proto3" json:"start_amount,omitempty"`
+	EndAmount     float64                `protobuf:"fixed64,6,opt,name=end_amount,json=endAmount,proto3" json:"end_amount,omitempty"`
+	Profit        float64                `protobuf:"fixed64,7,opt,name=profit,proto3" json:"profit,omitempty"`
+	NetEdge       float64                `protobuf:"fixed64,8,opt,name=net_edge,json=netEdge,proto3" json:"net_edge,omitempty"`
+	GrossEdge     float64                `protobuf:"fixed64,9,opt,name=gross_edge,json=grossEdge,proto3" json:"gross_edge,omitempty"`
+	Transferable  bool                   `protobuf:"varint,10,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	Legs          []*ArbitrageLeg        `protobuf:"bytes,11,rep,name=legs,proto3" json:"legs,omitempty"`
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	Executed      bool                   `protobuf:"varint,13,opt,name=executed,proto3" json:"executed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArbitrageOpportunity) Reset() {
+	*x = ArbitrageOpportunity{}
+	mi := &file_rpc_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArbitrageOpportunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArbitrageOpportunity) ProtoMessage() {}
+
+func (x *ArbitrageOpportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArbitrageOpportunity.ProtoReflect.Descriptor instead.
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *ArbitrageOpportunity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetStartAmount() float64 {
+	if x != nil {
+		return x.StartAmount
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetEndAmount() float64 {
+	if x != nil {
+		return x.EndAmount
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetProfit() float64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetNetEdge() float64 {
+	if x != nil {
+		return x.NetEdge
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetGrossEdge() float64 {
+	if x != nil {
+		return x.GrossEdge
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetTransferable() bool {
+	if x != nil {
+		return x.Transferable
+	}
+	return false
+}
+
+func (x *ArbitrageOpportunity) GetLegs() []*ArbitrageLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *ArbitrageOpportunity) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *ArbitrageOpportunity) GetExecuted() bool {
+	if x != nil {
+		return x.Executed
+	}
+	return false
+}
+
+type GetArbitrageOpportunitiesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Opportunities []*ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArbitrageOpportunitiesResponse) Reset() {
+	*x = GetArbitrageOpportunitiesResponse{}
+	mi := &file_rpc_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArbitrageOpportunitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArbitrageOpportunitiesResponse) ProtoMessage() {}
+
+func (x *GetArbitrageOpportunitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArbitrageOpportunitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *GetArbitrageOpportunitiesResponse) GetOpportunities() []*ArbitrageOpportunity {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
+type GetLedgerPnLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currencies    []string               `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerPnLRequest) Reset() {
+	*x = GetLedgerPnLRequest{}
+	mi := &file_rpc_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerPnLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerPnLRequest) ProtoMessage() {}
+
+func (x *GetLedgerPnLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[261]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerPnLRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerPnLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{261}
+}
+
+func (x *GetLedgerPnLRequest) GetCurrencies() []string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type LedgerLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Cost          float64                `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Acquired      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerLot) Reset() {
+	*x = LedgerLot{}
+	mi := &file_rpc_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerLot) ProtoMessage() {}
+
+func (x *LedgerLot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[262]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerLot.ProtoReflect.Descriptor instead.
+func (*LedgerLot) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *LedgerLot) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *LedgerLot) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerLot) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *LedgerLot) GetAcquired() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Acquired
+	}
+	return nil
+}
+
+type LedgerAssetPnL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	CostBasis     float64                `protobuf:"fixed64,3,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	AverageCost   float64                `protobuf:"fixed64,4,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`
+	MarketPrice   float64                `protobuf:"fixed64,5,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+	MarketValue   float64                `protobuf:"fixed64,6,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	Realised      float64                `protobuf:"fixed64,7,opt,name=realised,proto3" json:"realised,omitempty"`
+	Unrealised    float64                `protobuf:"fixed64,8,opt,name=unrealised,proto3" json:"unrealised,omitempty"`
+	Total         float64                `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
+	Disposed      float64                `protobuf:"fixed64,10,opt,name=disposed,proto3" json:"disposed,omitempty"`
+	Unmatched     float64                `protobuf:"fixed64,11,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+	Lots          []*LedgerLot           `protobuf:"bytes,12,rep,name=lots,proto3" json:"lots,omitempty"`
+	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerAssetPnL) Reset() {
+	*x = LedgerAssetPnL{}
+	mi := &file_rpc_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerAssetPnL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerAssetPnL) ProtoMessage() {}
+
+func (x *LedgerAssetPnL) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[263]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerAssetPnL.ProtoReflect.Descriptor instead.
+func (*LedgerAssetPnL) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *LedgerAssetPnL) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LedgerAssetPnL) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetAverageCost() float64 {
+	if x != nil {
+		return x.AverageCost
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetMarketPrice() float64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetRealised() float64 {
+	if x != nil {
+		return x.Realised
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetUnrealised() float64 {
+	if x != nil {
+		return x.Unrealised
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetDisposed() float64 {
+	if x != nil {
+		return x.Disposed
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetUnmatched() float64 {
+	if x != nil {
+		return x.Unmatched
+	}
+	return 0
+}
+
+func (x *LedgerAssetPnL) GetLots() []*LedgerLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+func (x *LedgerAssetPnL) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetLedgerPnLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fiat          string                 `protobuf:"bytes,1,opt,name=fiat,proto3" json:"fiat,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Assets        []*LedgerAssetPnL      `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`
+	CostBasis     float64                `protobuf:"fixed64,4,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	MarketValue   float64                `protobuf:"fixed64,5,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	Realised      float64                `protobuf:"fixed64,6,opt,name=realised,proto3" json:"realised,omitempty"`
+	Unrealised    float64                `protobuf:"fixed64,7,opt,name=unrealised,proto3" json:"unrealised,omitempty"`
+	Total         float64                `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerPnLResponse) Reset() {
+	*x = GetLedgerPnLResponse{}
+	mi := &file_rpc_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerPnLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerPnLResponse) ProtoMessage() {}
+
+func (x *GetLedgerPnLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[264]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerPnLResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerPnLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{264}
+}
+
+func (x *GetLedgerPnLResponse) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+func (x *GetLedgerPnLResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GetLedgerPnLResponse) GetAssets() []*LedgerAssetPnL {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *GetLedgerPnLResponse) GetCostBasis() float64 {
+	if x != nil {
+		return x.CostBasis
+	}
+	return 0
+}
+
+func (x *GetLedgerPnLResponse) GetMarketValue() float64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *GetLedgerPnLResponse) GetRealised() float64 {
+	if x != nil {
+		return x.Realised
+	}
+	return 0
+}
+
+func (x *GetLedgerPnLResponse) GetUnrealised() float64 {
+	if x != nil {
+		return x.Unrealised
+	}
+	return 0
+}
+
+func (x *GetLedgerPnLResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AddLedgerTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeCurrency   string                 `protobuf:"bytes,7,opt,name=fee_currency,json=feeCurrency,proto3" json:"fee_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLedgerTransferRequest) Reset() {
+	*x = AddLedgerTransferRequest{}
+	mi := &file_rpc_proto_msgTypes[265]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLedgerTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLedgerTransferRequest) ProtoMessage() {}
+
+func (x *AddLedgerTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[265]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLedgerTransferRequest.ProtoReflect.Descriptor instead.
+func (*AddLedgerTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{265}
+}
+
+func (x *AddLedgerTransferRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *AddLedgerTransferRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddLedgerTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AddLedgerTransferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddLedgerTransferRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AddLedgerTransferRequest) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *AddLedgerTransferRequest) GetFeeCurrency() string {
+	if x != nil {
+		return x.FeeCurrency
+	}
+	return ""
+}
+
+type GetPortfolioEquityCurveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioEquityCurveRequest) Reset() {
+	*x = GetPortfolioEquityCurveRequest{}
+	mi := &file_rpc_proto_msgTypes[266]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioEquityCurveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioEquityCurveRequest) ProtoMessage() {}
+
+func (x *GetPortfolioEquityCurveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[266]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioEquityCurveRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioEquityCurveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{266}
+}
+
+func (x *GetPortfolioEquityCurveRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type EquityPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquityPoint) Reset() {
+	*x = EquityPoint{}
+	mi := &file_rpc_proto_msgTypes[267]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquityPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquityPoint) ProtoMessage() {}
+
+func (x *EquityPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[267]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquityPoint.ProtoReflect.Descriptor instead.
+func (*EquityPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{267}
+}
+
+func (x *EquityPoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EquityPoint) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EquityPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type GetPortfolioEquityCurveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fiat          string                 `protobuf:"bytes,1,opt,name=fiat,proto3" json:"fiat,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Points        []*EquityPoint         `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioEquityCurveResponse) Reset() {
+	*x = GetPortfolioEquityCurveResponse{}
+	mi := &file_rpc_proto_msgTypes[268]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioEquityCurveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioEquityCurveResponse) ProtoMessage() {}
+
+func (x *GetPortfolioEquityCurveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[268]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioEquityCurveResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioEquityCurveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{268}
+}
+
+func (x *GetPortfolioEquityCurveResponse) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetPortfolioEquityCurveResponse) GetPoints() []*EquityPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[269]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[269]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{269}
+}
+
+type ReloadConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       []string               `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadConfigResponse) Reset() {
+	*x = ReloadConfigResponse{}
+	mi := &file_rpc_proto_msgTypes[270]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResponse) ProtoMessage() {}
+
+func (x *ReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[270]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{270}
+}
+
+func (x *ReloadConfigResponse) GetApplied() []string {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+type RPCToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	Expired       bool                   `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RPCToken) Reset() {
+	*x = RPCToken{}
+	mi := &file_rpc_proto_msgTypes[271]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RPCToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPCToken) ProtoMessage() {}
+
+func (x *RPCToken) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[271]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPCToken.ProtoReflect.Descriptor instead.
+func (*RPCToken) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{271}
+}
+
+func (x *RPCToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RPCToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RPCToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *RPCToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RPCToken) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *RPCToken) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type CreateRPCTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Expires       string                 `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRPCTokenRequest) Reset() {
+	*x = CreateRPCTokenRequest{}
+	mi := &file_rpc_proto_msgTypes[272]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRPCTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRPCTokenRequest) ProtoMessage() {}
+
+func (x *CreateRPCTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[272]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRPCTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateRPCTokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{272}
+}
+
+func (x *CreateRPCTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRPCTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateRPCTokenRequest) GetExpires() string {
+	if x != nil {
+		return x.Expires
+	}
+	return ""
+}
+
+type CreateRPCTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Details       *RPCToken              `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRPCTokenResponse) Reset() {
+	*x = CreateRPCTokenResponse{}
+	mi := &file_rpc_proto_msgTypes[273]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRPCTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRPCTokenResponse) ProtoMessage() {}
+
+func (x *CreateRPCTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[273]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRPCTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateRPCTokenResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{273}
+}
+
+func (x *CreateRPCTokenResponse) GetDetails() *RPCToken {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *CreateRPCTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetRPCTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRPCTokensRequest) Reset() {
+	*x = GetRPCTokensRequest{}
+	mi := &file_rpc_proto_msgTypes[274]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRPCTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRPCTokensRequest) ProtoMessage() {}
+
+func (x *GetRPCTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[274]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRPCTokensRequest.ProtoReflect.Descriptor instead.
+func (*GetRPCTokensRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{274}
+}
+
+type GetRPCTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*RPCToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRPCTokensResponse) Reset() {
+	*x = GetRPCTokensResponse{}
+	mi := &file_rpc_proto_msgTypes[275]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRPCTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRPCTokensResponse) ProtoMessage() {}
+
+func (x *GetRPCTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[275]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRPCTokensResponse.ProtoReflect.Descriptor instead.
+func (*GetRPCTokensResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{275}
+}
+
+func (x *GetRPCTokensResponse) GetTokens() []*RPCToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeRPCTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRPCTokenRequest) Reset() {
+	*x = RevokeRPCTokenRequest{}
+	mi := &file_rpc_proto_msgTypes[276]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRPCTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRPCTokenRequest) ProtoMessage() {}
+
+func (x *RevokeRPCTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[276]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRPCTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRPCTokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{276}
+}
+
+func (x *RevokeRPCTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PendingWithdrawal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	BankAccountId string                 `protobuf:"bytes,8,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires,proto3" json:"expires,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingWithdrawal) Reset() {
+	*x = PendingWithdrawal{}
+	mi := &file_rpc_proto_msgTypes[277]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingWithdrawal) ProtoMessage() {}
+
+func (x *PendingWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[277]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingWithdrawal.ProtoReflect.Descriptor instead.
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{277}
+}
+
+func (x *PendingWithdrawal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PendingWithdrawal) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetBankAccountId() string {
+	if x != nil {
+		return x.BankAccountId
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PendingWithdrawal) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type GetPendingWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingWithdrawalsRequest) Reset() {
+	*x = GetPendingWithdrawalsRequest{}
+	mi := &file_rpc_proto_msgTypes[278]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingWithdrawalsRequest) ProtoMessage() {}
+
+func (x *GetPendingWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[278]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{278}
+}
+
+type GetPendingWithdrawalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*PendingWithdrawal   `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingWithdrawalsResponse) Reset() {
+	*x = GetPendingWithdrawalsResponse{}
+	mi := &file_rpc_proto_msgTypes[279]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingWithdrawalsResponse) ProtoMessage() {}
+
+func (x *GetPendingWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[279]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{279}
+}
+
+func (x *GetPendingWithdrawalsResponse) GetWithdrawals() []*PendingWithdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+type ApproveWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TotpCode      string                 `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveWithdrawalRequest) Reset() {
+	*x = ApproveWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[280]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveWithdrawalRequest) ProtoMessage() {}
+
+func (x *ApproveWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[280]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{280}
+}
+
+func (x *ApproveWithdrawalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveWithdrawalRequest) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type RejectWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectWithdrawalRequest) Reset() {
+	*x = RejectWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[281]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWithdrawalRequest) ProtoMessage() {}
+
+func (x *RejectWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[281]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RejectWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{281}
+}
+
+func (x *RejectWithdrawalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectWithdrawalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetFundingRateScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote         string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFundingRateScanRequest) Reset() {
+	*x = GetFundingRateScanRequest{}
+	mi := &file_rpc_proto_msgTypes[282]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFundingRateScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFundingRateScanRequest) ProtoMessage() {}
+
+func (x *GetFundingRateScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[282]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFundingRateScanRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingRateScanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{282}
+}
+
+func (x *GetFundingRateScanRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *GetFundingRateScanRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+type PerpetualFundingRate struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Underlying              *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	SettlementType          string                 `protobuf:"bytes,5,opt,name=settlement_type,json=settlementType,proto3" json:"settlement_type,omitempty"`
+	Rate                    float64                `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	PredictedRate           float64                `protobuf:"fixed64,7,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
+	FundingInterval         string                 `protobuf:"bytes,8,opt,name=funding_interval,json=fundingInterval,proto3" json:"funding_interval,omitempty"`
+	AnnualisedRate          float64                `protobuf:"fixed64,9,opt,name=annualised_rate,json=annualisedRate,proto3" json:"annualised_rate,omitempty"`
+	AnnualisedPredictedRate float64                `protobuf:"fixed64,10,opt,name=annualised_predicted_rate,json=annualisedPredictedRate,proto3" json:"annualised_predicted_rate,omitempty"`
+	TakerFee                float64                `protobuf:"fixed64,11,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	RateTime                *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=rate_time,json=rateTime,proto3" json:"rate_time,omitempty"`
+	TimeOfNextRate          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PerpetualFundingRate) Reset() {
+	*x = PerpetualFundingRate{}
+	mi := &file_rpc_proto_msgTypes[283]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerpetualFundingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerpetualFundingRate) ProtoMessage() {}
+
+func (x *PerpetualFundingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[283]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerpetualFundingRate.ProtoReflect.Descriptor instead.
+func (*PerpetualFundingRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{283}
+}
+
+func (x *PerpetualFundingRate) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *PerpetualFundingRate) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *PerpetualFundingRate) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *PerpetualFundingRate) GetUnderlying() *CurrencyPair {
+	if x != nil {
+		return x.Underlying
+	}
+	return nil
+}
+
+func (x *PerpetualFundingRate) GetSettlementType() string {
+	if x != nil {
+		return x.SettlementType
+	}
+	return ""
+}
+
+func (x *PerpetualFundingRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *PerpetualFundingRate) GetPredictedRate() float64 {
+	if x != nil {
+		return x.PredictedRate
+	}
+	return 0
+}
+
+func (x *PerpetualFundingRate) GetFundingInterval() string {
+	if x != nil {
+		return x.FundingInterval
+	}
+	return ""
+}
+
+func (x *PerpetualFundingRate) GetAnnualisedRate() float64 {
+	if x != nil {
+		return x.AnnualisedRate
+	}
+	return 0
+}
+
+func (x *PerpetualFundingRate) GetAnnualisedPredictedRate() float64 {
+	if x != nil {
+		return x.AnnualisedPredictedRate
+	}
+	return 0
+}
+
+func (x *PerpetualFundingRate) GetTakerFee() float64 {
+	if x != nil {
+		return x.TakerFee
+	}
+	return 0
+}
+
+func (x *PerpetualFundingRate) GetRateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RateTime
+	}
+	return nil
+}
+
+func (x *PerpetualFundingRate) GetTimeOfNextRate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeOfNextRate
+	}
+	return nil
+}
+
+type FundingLeg struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AnnualisedRate float64                `protobuf:"fixed64,4,opt,name=annualised_rate,json=annualisedRate,proto3" json:"annualised_rate,omitempty"`
+	TakerFee       float64                `protobuf:"fixed64,5,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FundingLeg) Reset() {
+	*x = FundingLeg{}
+	mi := &file_rpc_proto_msgTypes[284]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestRequest) String() string {
+func (x *FundingLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestRequest) ProtoMessage() {}
+func (*FundingLeg) ProtoMessage() {}
 
-func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *FundingLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[284]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14808,48 +19811,75 @@ func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{220}
+// Deprecated: Use FundingLeg.ProtoReflect.Descriptor instead.
+func (*FundingLeg) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{284}
 }
 
-func (x *GetOpenInterestRequest) GetExchange() string {
+func (x *FundingLeg) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+func (x *FundingLeg) GetAsset() string {
 	if x != nil {
-		return x.Data
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FundingLeg) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type OpenInterestDataRequest struct {
+func (x *FundingLeg) GetAnnualisedRate() float64 {
+	if x != nil {
+		return x.AnnualisedRate
+	}
+	return 0
+}
+
+func (x *FundingLeg) GetTakerFee() float64 {
+	if x != nil {
+		return x.TakerFee
+	}
+	return 0
+}
+
+type FundingOpportunity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Underlying    *CurrencyPair          `protobuf:"bytes,2,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	LongLeg       *FundingLeg            `protobuf:"bytes,3,opt,name=long_leg,json=longLeg,proto3" json:"long_leg,omitempty"`
+	ShortLeg      *FundingLeg            `protobuf:"bytes,4,opt,name=short_leg,json=shortLeg,proto3" json:"short_leg,omitempty"`
+	GrossRate     float64                `protobuf:"fixed64,5,opt,name=gross_rate,json=grossRate,proto3" json:"gross_rate,omitempty"`
+	FeeRate       float64                `protobuf:"fixed64,6,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	NetRate       float64                `protobuf:"fixed64,7,opt,name=net_rate,json=netRate,proto3" json:"net_rate,omitempty"`
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataRequest) Reset() {
-	*x = OpenInterestDataRequest{}
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *FundingOpportunity) Reset() {
+	*x = FundingOpportunity{}
+	mi := &file_rpc_proto_msgTypes[285]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataRequest) String() string {
+func (x *FundingOpportunity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataRequest) ProtoMessage() {}
+func (*FundingOpportunity) ProtoMessage() {}
 
-func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *FundingOpportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[285]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14860,94 +19890,91 @@ func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{221}
+// Deprecated: Use FundingOpportunity.ProtoReflect.Descriptor instead.
+func (*FundingOpportunity) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{285}
 }
 
-func (x *OpenInterestDataRequest) GetAsset() string {
+func (x *FundingOpportunity) GetType() string {
 	if x != nil {
-		return x.Asset
+		return x.Type
 	}
 	return ""
 }
 
-func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+func (x *FundingOpportunity) GetUnderlying() *CurrencyPair {
 	if x != nil {
-		return x.Pair
+		return x.Underlying
 	}
 	return nil
 }
 
-type GetOpenInterestResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FundingOpportunity) GetLongLeg() *FundingLeg {
+	if x != nil {
+		return x.LongLeg
+	}
+	return nil
 }
 
-func (x *GetOpenInterestResponse) Reset() {
-	*x = GetOpenInterestResponse{}
-	mi := &file_rpc_proto_msgTypes[222]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FundingOpportunity) GetShortLeg() *FundingLeg {
+	if x != nil {
+		return x.ShortLeg
+	}
+	return nil
 }
 
-func (x *GetOpenInterestResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FundingOpportunity) GetGrossRate() float64 {
+	if x != nil {
+		return x.GrossRate
+	}
+	return 0
 }
 
-func (*GetOpenInterestResponse) ProtoMessage() {}
-
-func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *FundingOpportunity) GetFeeRate() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FeeRate
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{222}
+func (x *FundingOpportunity) GetNetRate() float64 {
+	if x != nil {
+		return x.NetRate
+	}
+	return 0
 }
 
-func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+func (x *FundingOpportunity) GetDetectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.DetectedAt
 	}
 	return nil
 }
 
-type OpenInterestDataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+type GetFundingRateScanResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Rates         []*PerpetualFundingRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	Opportunities []*FundingOpportunity   `protobuf:"bytes,2,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	ScannedAt     *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataResponse) Reset() {
-	*x = OpenInterestDataResponse{}
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *GetFundingRateScanResponse) Reset() {
+	*x = GetFundingRateScanResponse{}
+	mi := &file_rpc_proto_msgTypes[286]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataResponse) String() string {
+func (x *GetFundingRateScanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataResponse) ProtoMessage() {}
+func (*GetFundingRateScanResponse) ProtoMessage() {}
 
-func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *GetFundingRateScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[286]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14958,63 +19985,57 @@ func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{223}
-}
-
-func (x *OpenInterestDataResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GetFundingRateScanResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingRateScanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{286}
 }
 
-func (x *OpenInterestDataResponse) GetAsset() string {
+func (x *GetFundingRateScanResponse) GetRates() []*PerpetualFundingRate {
 	if x != nil {
-		return x.Asset
+		return x.Rates
 	}
-	return ""
+	return nil
 }
 
-func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+func (x *GetFundingRateScanResponse) GetOpportunities() []*FundingOpportunity {
 	if x != nil {
-		return x.Pair
+		return x.Opportunities
 	}
 	return nil
 }
 
-func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+func (x *GetFundingRateScanResponse) GetScannedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OpenInterest
+		return x.ScannedAt
 	}
-	return 0
+	return nil
 }
 
-type GetCurrencyTradeURLRequest struct {
+type GetFundingOpportunityHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Base          string                 `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Quote         string                 `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLRequest) Reset() {
-	*x = GetCurrencyTradeURLRequest{}
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *GetFundingOpportunityHistoryRequest) Reset() {
+	*x = GetFundingOpportunityHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[287]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLRequest) String() string {
+func (x *GetFundingOpportunityHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+func (*GetFundingOpportunityHistoryRequest) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *GetFundingOpportunityHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[287]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15025,54 +20046,61 @@ func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{224}
+// Deprecated: Use GetFundingOpportunityHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingOpportunityHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{287}
 }
 
-func (x *GetCurrencyTradeURLRequest) GetExchange() string {
+func (x *GetFundingOpportunityHistoryRequest) GetStartDate() string {
 	if x != nil {
-		return x.Exchange
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+func (x *GetFundingOpportunityHistoryRequest) GetEndDate() string {
 	if x != nil {
-		return x.Asset
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
+func (x *GetFundingOpportunityHistoryRequest) GetBase() string {
 	if x != nil {
-		return x.Pair
+		return x.Base
 	}
-	return nil
+	return ""
 }
 
-type GetCurrencyTradeURLResponse struct {
+func (x *GetFundingOpportunityHistoryRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+type GetFundingOpportunityHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Opportunities []*FundingOpportunity  `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLResponse) Reset() {
-	*x = GetCurrencyTradeURLResponse{}
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetFundingOpportunityHistoryResponse) Reset() {
+	*x = GetFundingOpportunityHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[288]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLResponse) String() string {
+func (x *GetFundingOpportunityHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+func (*GetFundingOpportunityHistoryResponse) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetFundingOpportunityHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[288]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15083,16 +20111,16 @@ func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{225}
+// Deprecated: Use GetFundingOpportunityHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingOpportunityHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{288}
 }
 
-func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+func (x *GetFundingOpportunityHistoryResponse) GetOpportunities() []*FundingOpportunity {
 	if x != nil {
-		return x.Url
+		return x.Opportunities
 	}
-	return ""
+	return nil
 }
 
 var File_rpc_proto protoreflect.FileDescriptor
@@ -15462,7 +20490,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x17CancelAllOrdersResponse\x12&\n" +
 	"\x06orders\x18\x01 \x03(\v2\x0e.gctrpc.OrdersR\x06orders\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\"\x12\n" +
-	"\x10GetEventsRequest\"\xae\x01\n" +
+	"\x10GetEventsRequest\"\x96\x02\n" +
 	"\x0fConditionParams\x12\x1c\n" +
 	"\tcondition\x18\x01 \x01(\tR\tcondition\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1d\n" +
@@ -15470,15 +20498,47 @@ const file_rpc_proto_rawDesc = "" +
 	"check_bids\x18\x03 \x01(\bR\tcheckBids\x12\x1d\n" +
 	"\n" +
 	"check_asks\x18\x04 \x01(\bR\tcheckAsks\x12)\n" +
-	"\x10orderbook_amount\x18\x05 \x01(\x01R\x0forderbookAmount\"\xf5\x01\n" +
-	"\x11GetEventsResponse\x12\x0e\n" +
+	"\x10orderbook_amount\x18\x05 \x01(\x01R\x0forderbookAmount\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x1c\n" +
+	"\tindicator\x18\a \x01(\tR\tindicator\x12\x1a\n" +
+	"\binterval\x18\b \x01(\x03R\binterval\x12\x16\n" +
+	"\x06period\x18\t \x01(\x03R\x06period\"\xa9\x01\n" +
+	"\x0eEventCondition\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12/\n" +
+	"\x06params\x18\x02 \x01(\v2\x17.gctrpc.ConditionParamsR\x06params\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x126\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2\x16.gctrpc.EventConditionR\n" +
+	"conditions\"\xc0\x01\n" +
+	"\x11EventActionParams\x12\x12\n" +
+	"\x04side\x18\x01 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x02 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06script\x18\x05 \x01(\tR\x06script\x12\x18\n" +
+	"\arelayer\x18\x06 \x01(\tR\arelayer\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\x9a\x04\n" +
+	"\fEventDetails\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x12\n" +
 	"\x04item\x18\x03 \x01(\tR\x04item\x12B\n" +
-	"\x10condition_params\x18\x04 \x01(\v2\x17.gctrpc.ConditionParamsR\x0fconditionParams\x12(\n" +
-	"\x04pair\x18\x05 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x16\n" +
-	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1a\n" +
-	"\bexecuted\x18\a \x01(\bR\bexecuted\"\xe6\x01\n" +
+	"\x10condition_params\x18\x04 \x01(\v2\x17.gctrpc.ConditionParamsR\x0fconditionParams\x126\n" +
+	"\n" +
+	"conditions\x18\x05 \x01(\v2\x16.gctrpc.EventConditionR\n" +
+	"conditions\x12(\n" +
+	"\x04pair\x18\x06 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\a \x01(\tR\x05asset\x12\x16\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12>\n" +
+	"\raction_params\x18\t \x01(\v2\x19.gctrpc.EventActionParamsR\factionParams\x12\x16\n" +
+	"\x06repeat\x18\n" +
+	" \x01(\bR\x06repeat\x12\x1a\n" +
+	"\bcooldown\x18\v \x01(\x03R\bcooldown\x12#\n" +
+	"\rtrigger_count\x18\f \x01(\x03R\ftriggerCount\x12A\n" +
+	"\x0elast_triggered\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rlastTriggered\x12\x1a\n" +
+	"\bexecuted\x18\x0e \x01(\bR\bexecuted\"A\n" +
+	"\x11GetEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.gctrpc.EventDetailsR\x06events\"\x92\x03\n" +
 	"\x0fAddEventRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x12\n" +
 	"\x04item\x18\x02 \x01(\tR\x04item\x12B\n" +
@@ -15486,7 +20546,14 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"asset_type\x18\x05 \x01(\tR\tassetType\x12\x16\n" +
-	"\x06action\x18\x06 \x01(\tR\x06action\"\"\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x126\n" +
+	"\n" +
+	"conditions\x18\a \x01(\v2\x16.gctrpc.EventConditionR\n" +
+	"conditions\x12>\n" +
+	"\raction_params\x18\b \x01(\v2\x19.gctrpc.EventActionParamsR\factionParams\x12\x16\n" +
+	"\x06repeat\x18\t \x01(\bR\x06repeat\x12\x1a\n" +
+	"\bcooldown\x18\n" +
+	" \x01(\x03R\bcooldown\"\"\n" +
 	"\x10AddEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"$\n" +
 	"\x12RemoveEventRequest\x12\x0e\n" +
@@ -15516,13 +20583,14 @@ const file_rpc_proto_rawDesc = "" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12&\n" +
 	"\x0ecryptocurrency\x18\x02 \x01(\tR\x0ecryptocurrency\"<\n" +
 	"\"GetAvailableTransferChainsResponse\x12\x16\n" +
-	"\x06chains\x18\x01 \x03(\tR\x06chains\"\xaf\x01\n" +
+	"\x06chains\x18\x01 \x03(\tR\x06chains\"\xcc\x01\n" +
 	"\x13WithdrawFiatRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12&\n" +
-	"\x0fbank_account_id\x18\x05 \x01(\tR\rbankAccountId\"\xec\x01\n" +
+	"\x0fbank_account_id\x18\x05 \x01(\tR\rbankAccountId\x12\x1b\n" +
+	"\ttotp_code\x18\x06 \x01(\tR\btotpCode\"\x89\x02\n" +
 	"\x15WithdrawCryptoRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1f\n" +
@@ -15532,7 +20600,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x10\n" +
 	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x12\x14\n" +
-	"\x05chain\x18\b \x01(\tR\x05chain\":\n" +
+	"\x05chain\x18\b \x01(\tR\x05chain\x12\x1b\n" +
+	"\ttotp_code\x18\t \x01(\tR\btotpCode\":\n" +
 	"\x10WithdrawResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\",\n" +
@@ -15751,7 +20820,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x19GetExchangeAssetsResponse\x12\x16\n" +
 	"\x06assets\x18\x01 \x01(\tR\x06assets\"5\n" +
 	"\x17WebsocketGetInfoRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\"\x93\x02\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\xd2\x02\n" +
 	"\x18WebsocketGetInfoResponse\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1c\n" +
 	"\tsupported\x18\x02 \x01(\bR\tsupported\x12\x18\n" +
@@ -15760,7 +20829,15 @@ const file_rpc_proto_rawDesc = "" +
 	"\rauthenticated\x18\x05 \x01(\bR\rauthenticated\x12\x1f\n" +
 	"\vrunning_url\x18\x06 \x01(\tR\n" +
 	"runningUrl\x12#\n" +
-	"\rproxy_address\x18\a \x01(\tR\fproxyAddress\"P\n" +
+	"\rproxy_address\x18\a \x01(\tR\fproxyAddress\x12=\n" +
+	"\vconnections\x18\b \x03(\v2\x1b.gctrpc.WebsocketConnectionR\vconnections\"\xce\x01\n" +
+	"\x13WebsocketConnection\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12%\n" +
+	"\x0emessage_filter\x18\x02 \x01(\tR\rmessageFilter\x12\x14\n" +
+	"\x05shard\x18\x03 \x01(\x03R\x05shard\x12$\n" +
+	"\rauthenticated\x18\x04 \x01(\bR\rauthenticated\x12\x1c\n" +
+	"\tconnected\x18\x05 \x01(\bR\tconnected\x12$\n" +
+	"\rsubscriptions\x18\x06 \x01(\x03R\rsubscriptions\"P\n" +
 	"\x1aWebsocketSetEnabledRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
 	"\x06enable\x18\x02 \x01(\bR\x06enable\">\n" +
@@ -15804,7 +20881,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\tR\x06status\"W\n" +
 	"!SetExchangeTradeProcessingRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\bR\x06status\"\xa3\x06\n" +
+	"\x06status\x18\x02 \x01(\bR\x06status\"\xcc\x06\n" +
 	"\x1bUpsertDataHistoryJobRequest\x12\x1a\n" +
 	"\bnickname\x18\x01 \x01(\tR\bnickname\x12\x1a\n" +
 	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x14\n" +
@@ -15828,7 +20905,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x18decimal_place_comparison\x18\x10 \x01(\x04R\x16decimalPlaceComparison\x126\n" +
 	"\x17secondary_exchange_name\x18\x11 \x01(\tR\x15secondaryExchangeName\x12<\n" +
 	"\x1aissue_tolerance_percentage\x18\x12 \x01(\x01R\x18issueTolerancePercentage\x12(\n" +
-	"\x10replace_on_issue\x18\x13 \x01(\bR\x0ereplaceOnIssue\"V\n" +
+	"\x10replace_on_issue\x18\x13 \x01(\bR\x0ereplaceOnIssue\x12'\n" +
+	"\x0forderbook_depth\x18\x14 \x01(\x04R\x0eorderbookDepth\"V\n" +
 	"\x1bInsertSequentialJobsRequest\x127\n" +
 	"\x04jobs\x18\x01 \x03(\v2#.gctrpc.UpsertDataHistoryJobRequestR\x04jobs\"X\n" +
 	"\x1cInsertSequentialJobsResponse\x128\n" +
@@ -15839,7 +20917,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1fGetDataHistoryJobDetailsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12!\n" +
-	"\ffull_details\x18\x03 \x01(\bR\vfullDetails\"\x87\a\n" +
+	"\ffull_details\x18\x03 \x01(\bR\vfullDetails\"\xb0\a\n" +
 	"\x0eDataHistoryJob\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
@@ -15866,7 +20944,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x10replace_on_issue\x18\x14 \x01(\bR\x0ereplaceOnIssue\x12=\n" +
 	"\vjob_results\x18\x15 \x03(\v2\x1c.gctrpc.DataHistoryJobResultR\n" +
 	"jobResults\x12)\n" +
-	"\x10result_summaries\x18\x16 \x03(\tR\x0fresultSummaries\"\xa0\x01\n" +
+	"\x10result_summaries\x18\x16 \x03(\tR\x0fresultSummaries\x12'\n" +
+	"\x0forderbook_depth\x18\x17 \x01(\x04R\x0eorderbookDepth\"\xa0\x01\n" +
 	"\x14DataHistoryJobResult\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
@@ -16367,7 +21446,428 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\x9al\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xec\x05\n" +
+	"\x0eSyntheticOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x05 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x1f\n" +
+	"\ventry_price\x18\t \x01(\x01R\n" +
+	"entryPrice\x12*\n" +
+	"\x11take_profit_price\x18\n" +
+	" \x01(\x01R\x0ftakeProfitPrice\x12&\n" +
+	"\x0fstop_loss_price\x18\v \x01(\x01R\rstopLossPrice\x12+\n" +
+	"\x11trailing_distance\x18\f \x01(\x01R\x10trailingDistance\x12)\n" +
+	"\x10trailing_percent\x18\r \x01(\x01R\x0ftrailingPercent\x12#\n" +
+	"\rtrigger_price\x18\x0e \x01(\x01R\ftriggerPrice\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x0f \x01(\x01R\tlastPrice\x12$\n" +
+	"\x0eentry_order_id\x18\x10 \x01(\tR\fentryOrderId\x12/\n" +
+	"\x14take_profit_order_id\x18\x11 \x01(\tR\x11takeProfitOrderId\x12\"\n" +
+	"\rstop_order_id\x18\x12 \x01(\tR\vstopOrderId\x12\x14\n" +
+	"\x05error\x18\x13 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x83\x03\n" +
+	"\x18AddSyntheticOrderRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\ventry_price\x18\a \x01(\x01R\n" +
+	"entryPrice\x12*\n" +
+	"\x11take_profit_price\x18\b \x01(\x01R\x0ftakeProfitPrice\x12&\n" +
+	"\x0fstop_loss_price\x18\t \x01(\x01R\rstopLossPrice\x12+\n" +
+	"\x11trailing_distance\x18\n" +
+	" \x01(\x01R\x10trailingDistance\x12)\n" +
+	"\x10trailing_percent\x18\v \x01(\x01R\x0ftrailingPercent\"F\n" +
+	"\x19GetSyntheticOrdersRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"L\n" +
+	"\x1aGetSyntheticOrdersResponse\x12.\n" +
+	"\x06orders\x18\x01 \x03(\v2\x16.gctrpc.SyntheticOrderR\x06orders\"-\n" +
+	"\x1bCancelSyntheticOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xd3\a\n" +
+	"\x12ExecutionAlgorithm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x05 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\t \x01(\x01R\x05price\x12\x1a\n" +
+	"\bduration\x18\n" +
+	" \x01(\x03R\bduration\x12\x16\n" +
+	"\x06slices\x18\v \x01(\x03R\x06slices\x12\x1a\n" +
+	"\binterval\x18\f \x01(\x03R\binterval\x12!\n" +
+	"\fprofile_days\x18\r \x01(\x03R\vprofileDays\x12\x1b\n" +
+	"\tclip_size\x18\x0e \x01(\x01R\bclipSize\x12#\n" +
+	"\rclip_variance\x18\x0f \x01(\x01R\fclipVariance\x12-\n" +
+	"\x12participation_rate\x18\x10 \x01(\x01R\x11participationRate\x12#\n" +
+	"\rarrival_price\x18\x11 \x01(\x01R\farrivalPrice\x12)\n" +
+	"\x10submitted_amount\x18\x12 \x01(\x01R\x0fsubmittedAmount\x12#\n" +
+	"\rfilled_amount\x18\x13 \x01(\x01R\ffilledAmount\x12#\n" +
+	"\raverage_price\x18\x14 \x01(\x01R\faveragePrice\x12\x1a\n" +
+	"\bslippage\x18\x15 \x01(\x01R\bslippage\x12#\n" +
+	"\rmarket_volume\x18\x16 \x01(\x01R\fmarketVolume\x12&\n" +
+	"\x0fchild_order_ids\x18\x17 \x03(\tR\rchildOrderIds\x12\x14\n" +
+	"\x05error\x18\x18 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"start_time\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x129\n" +
+	"\n" +
+	"created_at\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb6\x03\n" +
+	"\x1eStartExecutionAlgorithmRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12\x1a\n" +
+	"\bduration\x18\b \x01(\x03R\bduration\x12\x16\n" +
+	"\x06slices\x18\t \x01(\x03R\x06slices\x12\x1a\n" +
+	"\binterval\x18\n" +
+	" \x01(\x03R\binterval\x12!\n" +
+	"\fprofile_days\x18\v \x01(\x03R\vprofileDays\x12\x1b\n" +
+	"\tclip_size\x18\f \x01(\x01R\bclipSize\x12#\n" +
+	"\rclip_variance\x18\r \x01(\x01R\fclipVariance\x12-\n" +
+	"\x12participation_rate\x18\x0e \x01(\x01R\x11participationRate\"2\n" +
+	" GenericExecutionAlgorithmRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x1dGetExecutionAlgorithmsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"\\\n" +
+	"\x1eGetExecutionAlgorithmsResponse\x12:\n" +
+	"\n" +
+	"algorithms\x18\x01 \x03(\v2\x1a.gctrpc.ExecutionAlgorithmR\n" +
+	"algorithms\"\x16\n" +
+	"\x14GetRiskStatusRequest\"\xcb\x01\n" +
+	"\rRiskViolation\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05check\x18\x05 \x01(\tR\x05check\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\xb1\x02\n" +
+	"\x15GetRiskStatusResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06checks\x18\x02 \x03(\tR\x06checks\x12,\n" +
+	"\x12kill_switch_active\x18\x03 \x01(\bR\x10killSwitchActive\x12,\n" +
+	"\x12kill_switch_reason\x18\x04 \x01(\tR\x10killSwitchReason\x12S\n" +
+	"\x18kill_switch_activated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x15killSwitchActivatedAt\x125\n" +
+	"\n" +
+	"violations\x18\x06 \x03(\v2\x15.gctrpc.RiskViolationR\n" +
+	"violations\"F\n" +
+	"\x14SetKillSwitchRequest\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"c\n" +
+	"\x1bConsolidatedOrderbookSource\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"\xb4\x01\n" +
+	"\x1fGetConsolidatedOrderbookRequest\x12=\n" +
+	"\asources\x18\x01 \x03(\v2#.gctrpc.ConsolidatedOrderbookSourceR\asources\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05quote\x18\x03 \x01(\tR\x05quote\x12\x1d\n" +
+	"\n" +
+	"max_levels\x18\x04 \x01(\x03R\tmaxLevels\"\xad\x01\n" +
+	"\x19ConsolidatedOrderbookItem\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12#\n" +
+	"\rcurrency_pair\x18\x02 \x01(\tR\fcurrencyPair\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12!\n" +
+	"\fnative_price\x18\x05 \x01(\x01R\vnativePrice\"\xd2\x01\n" +
+	"\x1aConsolidatedOrderbookVenue\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12#\n" +
+	"\rcurrency_pair\x18\x02 \x01(\tR\fcurrencyPair\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x12\n" +
+	"\x04bids\x18\x04 \x01(\x03R\x04bids\x12\x12\n" +
+	"\x04asks\x18\x05 \x01(\x03R\x04asks\x12!\n" +
+	"\flast_updated\x18\x06 \x01(\x03R\vlastUpdated\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xcb\x02\n" +
+	"\x1dConsolidatedOrderbookResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x125\n" +
+	"\x04bids\x18\x03 \x03(\v2!.gctrpc.ConsolidatedOrderbookItemR\x04bids\x125\n" +
+	"\x04asks\x18\x04 \x03(\v2!.gctrpc.ConsolidatedOrderbookItemR\x04asks\x12:\n" +
+	"\x06venues\x18\x05 \x03(\v2\".gctrpc.ConsolidatedOrderbookVenueR\x06venues\x12!\n" +
+	"\flast_updated\x18\x06 \x01(\x03R\vlastUpdated\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xc2\x01\n" +
+	" SimulateConsolidatedOrderRequest\x12=\n" +
+	"\asources\x18\x01 \x03(\v2#.gctrpc.ConsolidatedOrderbookSourceR\asources\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05quote\x18\x03 \x01(\tR\x05quote\x12\x12\n" +
+	"\x04side\x18\x04 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\"\xc9\x01\n" +
+	"\x1cConsolidatedWhaleBombRequest\x12=\n" +
+	"\asources\x18\x01 \x03(\v2#.gctrpc.ConsolidatedOrderbookSourceR\asources\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05quote\x18\x03 \x01(\tR\x05quote\x12\x12\n" +
+	"\x04side\x18\x04 \x01(\tR\x04side\x12!\n" +
+	"\fprice_target\x18\x05 \x01(\x01R\vpriceTarget\"\xd9\x01\n" +
+	"\x15ConsolidatedOrderFill\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12#\n" +
+	"\rcurrency_pair\x18\x02 \x01(\tR\fcurrencyPair\x12\x1f\n" +
+	"\vbase_amount\x18\x03 \x01(\x01R\n" +
+	"baseAmount\x12!\n" +
+	"\fquote_amount\x18\x04 \x01(\x01R\vquoteAmount\x12#\n" +
+	"\raverage_price\x18\x05 \x01(\x01R\faveragePrice\x12\x16\n" +
+	"\x06levels\x18\x06 \x01(\x03R\x06levels\"\xbf\x02\n" +
+	"!SimulateConsolidatedOrderResponse\x129\n" +
+	"\x06orders\x18\x01 \x03(\v2!.gctrpc.ConsolidatedOrderbookItemR\x06orders\x123\n" +
+	"\x05fills\x18\x02 \x03(\v2\x1d.gctrpc.ConsolidatedOrderFillR\x05fills\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12#\n" +
+	"\rminimum_price\x18\x04 \x01(\x01R\fminimumPrice\x12#\n" +
+	"\rmaximum_price\x18\x05 \x01(\x01R\fmaximumPrice\x120\n" +
+	"\x14percentage_gain_loss\x18\x06 \x01(\x01R\x12percentageGainLoss\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\xda\x01\n" +
+	"\x11RouteOrderRequest\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x04 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x1b\n" +
+	"\tclient_id\x18\a \x01(\tR\bclientId\"\x9f\x02\n" +
+	"\x10RoutedChildOrder\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12%\n" +
+	"\x0eexpected_price\x18\x03 \x01(\x01R\rexpectedPrice\x12\x19\n" +
+	"\bfee_rate\x18\x04 \x01(\x01R\afeeRate\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12'\n" +
+	"\x0fexecuted_amount\x18\a \x01(\x01R\x0eexecutedAmount\x12#\n" +
+	"\raverage_price\x18\b \x01(\x01R\faveragePrice\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"B\n" +
+	"\x0eRouteExclusion\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xcf\x04\n" +
+	"\x12RouteOrderResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x04 \x01(\tR\torderType\x12)\n" +
+	"\x10requested_amount\x18\x05 \x01(\x01R\x0frequestedAmount\x12#\n" +
+	"\rrouted_amount\x18\x06 \x01(\x01R\froutedAmount\x12#\n" +
+	"\rfilled_amount\x18\a \x01(\x01R\ffilledAmount\x12%\n" +
+	"\x0eexpected_price\x18\b \x01(\x01R\rexpectedPrice\x12'\n" +
+	"\x0feffective_price\x18\t \x01(\x01R\x0eeffectivePrice\x12*\n" +
+	"\x11best_single_venue\x18\n" +
+	" \x01(\tR\x0fbestSingleVenue\x125\n" +
+	"\x17best_single_venue_price\x18\v \x01(\x01R\x14bestSingleVenuePrice\x12+\n" +
+	"\x11price_improvement\x18\f \x01(\x01R\x10priceImprovement\x124\n" +
+	"\bchildren\x18\r \x03(\v2\x18.gctrpc.RoutedChildOrderR\bchildren\x122\n" +
+	"\bexcluded\x18\x0e \x03(\v2\x16.gctrpc.RouteExclusionR\bexcluded\"\"\n" +
+	" GetArbitrageOpportunitiesRequest\"\xf1\x01\n" +
+	"\fArbitrageLeg\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12#\n" +
+	"\raverage_price\x18\x05 \x01(\x01R\faveragePrice\x12\x19\n" +
+	"\bfee_rate\x18\x06 \x01(\x01R\afeeRate\x12\x19\n" +
+	"\border_id\x18\a \x01(\tR\aorderId\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\xb0\x03\n" +
+	"\x14ArbitrageOpportunity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12!\n" +
+	"\fstart_amount\x18\x05 \x01(\x01R\vstartAmount\x12\x1d\n" +
+	"\n" +
+	"end_amount\x18\x06 \x01(\x01R\tendAmount\x12\x16\n" +
+	"\x06profit\x18\a \x01(\x01R\x06profit\x12\x19\n" +
+	"\bnet_edge\x18\b \x01(\x01R\anetEdge\x12\x1d\n" +
+	"\n" +
+	"gross_edge\x18\t \x01(\x01R\tgrossEdge\x12\"\n" +
+	"\ftransferable\x18\n" +
+	" \x01(\bR\ftransferable\x12(\n" +
+	"\x04legs\x18\v \x03(\v2\x14.gctrpc.ArbitrageLegR\x04legs\x12;\n" +
+	"\vdetected_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\x12\x1a\n" +
+	"\bexecuted\x18\r \x01(\bR\bexecuted\"g\n" +
+	"!GetArbitrageOpportunitiesResponse\x12B\n" +
+	"\ropportunities\x18\x01 \x03(\v2\x1c.gctrpc.ArbitrageOpportunityR\ropportunities\"5\n" +
+	"\x13GetLedgerPnLRequest\x12\x1e\n" +
+	"\n" +
+	"currencies\x18\x01 \x03(\tR\n" +
+	"currencies\"\x8b\x01\n" +
+	"\tLedgerLot\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x126\n" +
+	"\bacquired\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bacquired\"\x95\x03\n" +
+	"\x0eLedgerAssetPnL\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\x03 \x01(\x01R\tcostBasis\x12!\n" +
+	"\faverage_cost\x18\x04 \x01(\x01R\vaverageCost\x12!\n" +
+	"\fmarket_price\x18\x05 \x01(\x01R\vmarketPrice\x12!\n" +
+	"\fmarket_value\x18\x06 \x01(\x01R\vmarketValue\x12\x1a\n" +
+	"\brealised\x18\a \x01(\x01R\brealised\x12\x1e\n" +
+	"\n" +
+	"unrealised\x18\b \x01(\x01R\n" +
+	"unrealised\x12\x14\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\x12\x1a\n" +
+	"\bdisposed\x18\n" +
+	" \x01(\x01R\bdisposed\x12\x1c\n" +
+	"\tunmatched\x18\v \x01(\x01R\tunmatched\x12%\n" +
+	"\x04lots\x18\f \x03(\v2\x11.gctrpc.LedgerLotR\x04lots\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\"\x86\x02\n" +
+	"\x14GetLedgerPnLResponse\x12\x12\n" +
+	"\x04fiat\x18\x01 \x01(\tR\x04fiat\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12.\n" +
+	"\x06assets\x18\x03 \x03(\v2\x16.gctrpc.LedgerAssetPnLR\x06assets\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\x04 \x01(\x01R\tcostBasis\x12!\n" +
+	"\fmarket_value\x18\x05 \x01(\x01R\vmarketValue\x12\x1a\n" +
+	"\brealised\x18\x06 \x01(\x01R\brealised\x12\x1e\n" +
+	"\n" +
+	"unrealised\x18\a \x01(\x01R\n" +
+	"unrealised\x12\x14\n" +
+	"\x05total\x18\b \x01(\x01R\x05total\"\xc9\x01\n" +
+	"\x18AddLedgerTransferRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x10\n" +
+	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12!\n" +
+	"\ffee_currency\x18\a \x01(\tR\vfeeCurrency\"\x92\x01\n" +
+	"\x1eGetPortfolioEquityCurveRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"u\n" +
+	"\vEquityPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"\x9a\x01\n" +
+	"\x1fGetPortfolioEquityCurveResponse\x12\x12\n" +
+	"\x04fiat\x18\x01 \x01(\tR\x04fiat\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12+\n" +
+	"\x06points\x18\x04 \x03(\v2\x13.gctrpc.EquityPointR\x06points\"\x15\n" +
+	"\x13ReloadConfigRequest\"0\n" +
+	"\x14ReloadConfigResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x03(\tR\aapplied\"\xd1\x01\n" +
+	"\bRPCToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\aexpires\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\x12\x18\n" +
+	"\aexpired\x18\x06 \x01(\bR\aexpired\"]\n" +
+	"\x15CreateRPCTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x18\n" +
+	"\aexpires\x18\x03 \x01(\tR\aexpires\"Z\n" +
+	"\x16CreateRPCTokenResponse\x12*\n" +
+	"\adetails\x18\x01 \x01(\v2\x10.gctrpc.RPCTokenR\adetails\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x15\n" +
+	"\x13GetRPCTokensRequest\"@\n" +
+	"\x14GetRPCTokensResponse\x12(\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x10.gctrpc.RPCTokenR\x06tokens\"'\n" +
+	"\x15RevokeRPCTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xff\x02\n" +
+	"\x11PendingWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12&\n" +
+	"\x0fbank_account_id\x18\b \x01(\tR\rbankAccountId\x12!\n" +
+	"\frequested_by\x18\t \x01(\tR\vrequestedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\aexpires\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\"\x1e\n" +
+	"\x1cGetPendingWithdrawalsRequest\"\\\n" +
+	"\x1dGetPendingWithdrawalsResponse\x12;\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x19.gctrpc.PendingWithdrawalR\vwithdrawals\"G\n" +
+	"\x18ApproveWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttotp_code\x18\x02 \x01(\tR\btotpCode\"A\n" +
+	"\x17RejectWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"E\n" +
+	"\x19GetFundingRateScanRequest\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\tR\x05quote\"\xb9\x04\n" +
+	"\x14PerpetualFundingRate\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x124\n" +
+	"\n" +
+	"underlying\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\n" +
+	"underlying\x12'\n" +
+	"\x0fsettlement_type\x18\x05 \x01(\tR\x0esettlementType\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\x01R\x04rate\x12%\n" +
+	"\x0epredicted_rate\x18\a \x01(\x01R\rpredictedRate\x12)\n" +
+	"\x10funding_interval\x18\b \x01(\tR\x0ffundingInterval\x12'\n" +
+	"\x0fannualised_rate\x18\t \x01(\x01R\x0eannualisedRate\x12:\n" +
+	"\x19annualised_predicted_rate\x18\n" +
+	" \x01(\x01R\x17annualisedPredictedRate\x12\x1b\n" +
+	"\ttaker_fee\x18\v \x01(\x01R\btakerFee\x127\n" +
+	"\trate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\brateTime\x12E\n" +
+	"\x11time_of_next_rate\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0etimeOfNextRate\"\xae\x01\n" +
+	"\n" +
+	"FundingLeg\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12'\n" +
+	"\x0fannualised_rate\x18\x04 \x01(\x01R\x0eannualisedRate\x12\x1b\n" +
+	"\ttaker_fee\x18\x05 \x01(\x01R\btakerFee\"\xd0\x02\n" +
+	"\x12FundingOpportunity\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x124\n" +
+	"\n" +
+	"underlying\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\n" +
+	"underlying\x12-\n" +
+	"\blong_leg\x18\x03 \x01(\v2\x12.gctrpc.FundingLegR\alongLeg\x12/\n" +
+	"\tshort_leg\x18\x04 \x01(\v2\x12.gctrpc.FundingLegR\bshortLeg\x12\x1d\n" +
+	"\n" +
+	"gross_rate\x18\x05 \x01(\x01R\tgrossRate\x12\x19\n" +
+	"\bfee_rate\x18\x06 \x01(\x01R\afeeRate\x12\x19\n" +
+	"\bnet_rate\x18\a \x01(\x01R\anetRate\x12;\n" +
+	"\vdetected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\"\xcd\x01\n" +
+	"\x1aGetFundingRateScanResponse\x122\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1c.gctrpc.PerpetualFundingRateR\x05rates\x12@\n" +
+	"\ropportunities\x18\x02 \x03(\v2\x1a.gctrpc.FundingOpportunityR\ropportunities\x129\n" +
+	"\n" +
+	"scanned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"\x89\x01\n" +
+	"#GetFundingOpportunityHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x12\n" +
+	"\x04base\x18\x03 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x04 \x01(\tR\x05quote\"h\n" +
+	"$GetFundingOpportunityHistoryResponse\x12@\n" +
+	"\ropportunities\x18\x01 \x03(\v2\x1a.gctrpc.FundingOpportunityR\ropportunities2\x98\x89\x01\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSusbsytemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16484,7 +21984,37 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12o\n" +
+	"\x11AddSyntheticOrder\x12 .gctrpc.AddSyntheticOrderRequest\x1a\x16.gctrpc.SyntheticOrder\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/addsyntheticorder\x12{\n" +
+	"\x12GetSyntheticOrders\x12!.gctrpc.GetSyntheticOrdersRequest\x1a\".gctrpc.GetSyntheticOrdersResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getsyntheticorders\x12y\n" +
+	"\x14CancelSyntheticOrder\x12#.gctrpc.CancelSyntheticOrderRequest\x1a\x17.gctrpc.GenericResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/cancelsyntheticorder\x12\x85\x01\n" +
+	"\x17StartExecutionAlgorithm\x12&.gctrpc.StartExecutionAlgorithmRequest\x1a\x1a.gctrpc.ExecutionAlgorithm\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/startexecutionalgorithm\x12\x84\x01\n" +
+	"\x17PauseExecutionAlgorithm\x12(.gctrpc.GenericExecutionAlgorithmRequest\x1a\x17.gctrpc.GenericResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/pauseexecutionalgorithm\x12\x86\x01\n" +
+	"\x18ResumeExecutionAlgorithm\x12(.gctrpc.GenericExecutionAlgorithmRequest\x1a\x17.gctrpc.GenericResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/resumeexecutionalgorithm\x12\x86\x01\n" +
+	"\x18CancelExecutionAlgorithm\x12(.gctrpc.GenericExecutionAlgorithmRequest\x1a\x17.gctrpc.GenericResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/cancelexecutionalgorithm\x12\x8b\x01\n" +
+	"\x16GetExecutionAlgorithms\x12%.gctrpc.GetExecutionAlgorithmsRequest\x1a&.gctrpc.GetExecutionAlgorithmsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/getexecutionalgorithms\x12g\n" +
+	"\rGetRiskStatus\x12\x1c.gctrpc.GetRiskStatusRequest\x1a\x1d.gctrpc.GetRiskStatusResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getriskstatus\x12d\n" +
+	"\rSetKillSwitch\x12\x1c.gctrpc.SetKillSwitchRequest\x1a\x17.gctrpc.GenericResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/setkillswitch\x12\x93\x01\n" +
+	"\x18GetConsolidatedOrderbook\x12'.gctrpc.GetConsolidatedOrderbookRequest\x1a%.gctrpc.ConsolidatedOrderbookResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/getconsolidatedorderbook\x12\xa1\x01\n" +
+	"\x1eGetConsolidatedOrderbookStream\x12'.gctrpc.GetConsolidatedOrderbookRequest\x1a%.gctrpc.ConsolidatedOrderbookResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/getconsolidatedorderbookstream0\x01\x12\x9a\x01\n" +
+	"\x19SimulateConsolidatedOrder\x12(.gctrpc.SimulateConsolidatedOrderRequest\x1a).gctrpc.SimulateConsolidatedOrderResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/simulateconsolidatedorder\x12\x8e\x01\n" +
+	"\x15ConsolidatedWhaleBomb\x12$.gctrpc.ConsolidatedWhaleBombRequest\x1a).gctrpc.SimulateConsolidatedOrderResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/consolidatedwhalebomb\x12^\n" +
+	"\n" +
+	"RouteOrder\x12\x19.gctrpc.RouteOrderRequest\x1a\x1a.gctrpc.RouteOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/routeorder\x12\x97\x01\n" +
+	"\x19GetArbitrageOpportunities\x12(.gctrpc.GetArbitrageOpportunitiesRequest\x1a).gctrpc.GetArbitrageOpportunitiesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/getarbitrageopportunities\x12\x94\x01\n" +
+	"\x1dGetArbitrageOpportunityStream\x12(.gctrpc.GetArbitrageOpportunitiesRequest\x1a\x1c.gctrpc.ArbitrageOpportunity\")\x82\xd3\xe4\x93\x02#\x12!/v1/getarbitrageopportunitystream0\x01\x12c\n" +
+	"\fGetLedgerPnL\x12\x1b.gctrpc.GetLedgerPnLRequest\x1a\x1c.gctrpc.GetLedgerPnLResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/getledgerpnl\x12p\n" +
+	"\x11AddLedgerTransfer\x12 .gctrpc.AddLedgerTransferRequest\x1a\x17.gctrpc.GenericResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/addledgertransfer\x12\x8f\x01\n" +
+	"\x17GetPortfolioEquityCurve\x12&.gctrpc.GetPortfolioEquityCurveRequest\x1a'.gctrpc.GetPortfolioEquityCurveResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getportfolioequitycurve\x12f\n" +
+	"\fReloadConfig\x12\x1b.gctrpc.ReloadConfigRequest\x1a\x1c.gctrpc.ReloadConfigResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/reloadconfig\x12n\n" +
+	"\x0eCreateRPCToken\x12\x1d.gctrpc.CreateRPCTokenRequest\x1a\x1e.gctrpc.CreateRPCTokenResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/createrpctoken\x12c\n" +
+	"\fGetRPCTokens\x12\x1b.gctrpc.GetRPCTokensRequest\x1a\x1c.gctrpc.GetRPCTokensResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/getrpctokens\x12g\n" +
+	"\x0eRevokeRPCToken\x12\x1d.gctrpc.RevokeRPCTokenRequest\x1a\x17.gctrpc.GenericResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/revokerpctoken\x12\x87\x01\n" +
+	"\x15GetPendingWithdrawals\x12$.gctrpc.GetPendingWithdrawalsRequest\x1a%.gctrpc.GetPendingWithdrawalsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getpendingwithdrawals\x12q\n" +
+	"\x11ApproveWithdrawal\x12 .gctrpc.ApproveWithdrawalRequest\x1a\x18.gctrpc.WithdrawResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/approvewithdrawal\x12m\n" +
+	"\x10RejectWithdrawal\x12\x1f.gctrpc.RejectWithdrawalRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/rejectwithdrawal\x12{\n" +
+	"\x12GetFundingRateScan\x12!.gctrpc.GetFundingRateScanRequest\x1a\".gctrpc.GetFundingRateScanResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getfundingratescan\x12\xa3\x01\n" +
+	"\x1cGetFundingOpportunityHistory\x12+.gctrpc.GetFundingOpportunityHistoryRequest\x1a,.gctrpc.GetFundingOpportunityHistoryResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/getfundingopportunityhistoryB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +22028,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 303)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16575,181 +22105,244 @@ var file_rpc_proto_goTypes = []any{
 	(*CancelAllOrdersResponse)(nil),                   // 72: gctrpc.CancelAllOrdersResponse
 	(*GetEventsRequest)(nil),                          // 73: gctrpc.GetEventsRequest
 	(*ConditionParams)(nil),                           // 74: gctrpc.ConditionParams
-	(*GetEventsResponse)(nil),                         // 75: gctrpc.GetEventsResponse
-	(*AddEventRequest)(nil),                           // 76: gctrpc.AddEventRequest
-	(*AddEventResponse)(nil),                          // 77: gctrpc.AddEventResponse
-	(*RemoveEventRequest)(nil),                        // 78: gctrpc.RemoveEventRequest
-	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 79: gctrpc.GetCryptocurrencyDepositAddressesRequest
-	(*DepositAddress)(nil),                            // 80: gctrpc.DepositAddress
-	(*DepositAddresses)(nil),                          // 81: gctrpc.DepositAddresses
-	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 82: gctrpc.GetCryptocurrencyDepositAddressesResponse
-	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 83: gctrpc.GetCryptocurrencyDepositAddressRequest
-	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 84: gctrpc.GetCryptocurrencyDepositAddressResponse
-	(*GetAvailableTransferChainsRequest)(nil),         // 85: gctrpc.GetAvailableTransferChainsRequest
-	(*GetAvailableTransferChainsResponse)(nil),        // 86: gctrpc.GetAvailableTransferChainsResponse
-	(*WithdrawFiatRequest)(nil),                       // 87: gctrpc.WithdrawFiatRequest
-	(*WithdrawCryptoRequest)(nil),                     // 88: gctrpc.WithdrawCryptoRequest
-	(*WithdrawResponse)(nil),                          // 89: gctrpc.WithdrawResponse
-	(*WithdrawalEventByIDRequest)(nil),                // 90: gctrpc.WithdrawalEventByIDRequest
-	(*WithdrawalEventByIDResponse)(nil),               // 91: gctrpc.WithdrawalEventByIDResponse
-	(*WithdrawalEventsByExchangeRequest)(nil),         // 92: gctrpc.WithdrawalEventsByExchangeRequest
-	(*WithdrawalEventsByDateRequest)(nil),             // 93: gctrpc.WithdrawalEventsByDateRequest
-	(*WithdrawalEventsByExchangeResponse)(nil),        // 94: gctrpc.WithdrawalEventsByExchangeResponse
-	(*WithdrawalEventResponse)(nil),                   // 95: gctrpc.WithdrawalEventResponse
-	(*WithdrawlExchangeEvent)(nil),                    // 96: gctrpc.WithdrawlExchangeEvent
-	(*WithdrawalRequestEvent)(nil),                    // 97: gctrpc.WithdrawalRequestEvent
-	(*FiatWithdrawalEvent)(nil),                       // 98: gctrpc.FiatWithdrawalEvent
-	(*CryptoWithdrawalEvent)(nil),                     // 99: gctrpc.CryptoWithdrawalEvent
-	(*GetLoggerDetailsRequest)(nil),                   // 100: gctrpc.GetLoggerDetailsRequest
-	(*GetLoggerDetailsResponse)(nil),                  // 101: gctrpc.GetLoggerDetailsResponse
-	(*SetLoggerDetailsRequest)(nil),                   // 102: gctrpc.SetLoggerDetailsRequest
-	(*GetExchangePairsRequest)(nil),                   // 103: gctrpc.GetExchangePairsRequest
-	(*GetExchangePairsResponse)(nil),                  // 104: gctrpc.GetExchangePairsResponse
-	(*SetExchangePairRequest)(nil),                    // 105: gctrpc.SetExchangePairRequest
-	(*GetOrderbookStreamRequest)(nil),                 // 106: gctrpc.GetOrderbookStreamRequest
-	(*GetExchangeOrderbookStreamRequest)(nil),         // 107: gctrpc.GetExchangeOrderbookStreamRequest
-	(*GetTickerStreamRequest)(nil),                    // 108: gctrpc.GetTickerStreamRequest
-	(*GetExchangeTickerStreamRequest)(nil),            // 109: gctrpc.GetExchangeTickerStreamRequest
-	(*GetAuditEventRequest)(nil),                      // 110: gctrpc.GetAuditEventRequest
-	(*GetAuditEventResponse)(nil),                     // 111: gctrpc.GetAuditEventResponse
-	(*GetSavedTradesRequest)(nil),                     // 112: gctrpc.GetSavedTradesRequest
-	(*SavedTrades)(nil),                               // 113: gctrpc.SavedTrades
-	(*SavedTradesResponse)(nil),                       // 114: gctrpc.SavedTradesResponse
-	(*ConvertTradesToCandlesRequest)(nil),             // 115: gctrpc.ConvertTradesToCandlesRequest
-	(*GetHistoricCandlesRequest)(nil),                 // 116: gctrpc.GetHistoricCandlesRequest
-	(*GetHistoricCandlesResponse)(nil),                // 117: gctrpc.GetHistoricCandlesResponse
-	(*Candle)(nil),                                    // 118: gctrpc.Candle
-	(*AuditEvent)(nil),                                // 119: gctrpc.AuditEvent
-	(*GCTScript)(nil),                                 // 120: gctrpc.GCTScript
-	(*GCTScriptExecuteRequest)(nil),                   // 121: gctrpc.GCTScriptExecuteRequest
-	(*GCTScriptStopRequest)(nil),                      // 122: gctrpc.GCTScriptStopRequest
-	(*GCTScriptStopAllRequest)(nil),                   // 123: gctrpc.GCTScriptStopAllRequest
-	(*GCTScriptStatusRequest)(nil),                    // 124: gctrpc.GCTScriptStatusRequest
-	(*GCTScriptListAllRequest)(nil),                   // 125: gctrpc.GCTScriptListAllRequest
-	(*GCTScriptUploadRequest)(nil),                    // 126: gctrpc.GCTScriptUploadRequest
-	(*GCTScriptReadScriptRequest)(nil),                // 127: gctrpc.GCTScriptReadScriptRequest
-	(*GCTScriptQueryRequest)(nil),                     // 128: gctrpc.GCTScriptQueryRequest
-	(*GCTScriptAutoLoadRequest)(nil),                  // 129: gctrpc.GCTScriptAutoLoadRequest
-	(*GCTScriptStatusResponse)(nil),                   // 130: gctrpc.GCTScriptStatusResponse
-	(*GCTScriptQueryResponse)(nil),                    // 131: gctrpc.GCTScriptQueryResponse
-	(*GenericResponse)(nil),                           // 132: gctrpc.GenericResponse
-	(*SetExchangeAssetRequest)(nil),                   // 133: gctrpc.SetExchangeAssetRequest
-	(*SetExchangeAllPairsRequest)(nil),                // 134: gctrpc.SetExchangeAllPairsRequest
-	(*UpdateExchangeSupportedPairsRequest)(nil),       // 135: gctrpc.UpdateExchangeSupportedPairsRequest
-	(*GetExchangeAssetsRequest)(nil),                  // 136: gctrpc.GetExchangeAssetsRequest
-	(*GetExchangeAssetsResponse)(nil),                 // 137: gctrpc.GetExchangeAssetsResponse
-	(*WebsocketGetInfoRequest)(nil),                   // 138: gctrpc.WebsocketGetInfoRequest
-	(*WebsocketGetInfoResponse)(nil),                  // 139: gctrpc.WebsocketGetInfoResponse
-	(*WebsocketSetEnabledRequest)(nil),                // 140: gctrpc.WebsocketSetEnabledRequest
-	(*WebsocketGetSubscriptionsRequest)(nil),          // 141: gctrpc.WebsocketGetSubscriptionsRequest
-	(*WebsocketSubscription)(nil),                     // 142: gctrpc.WebsocketSubscription
-	(*WebsocketGetSubscriptionsResponse)(nil),         // 143: gctrpc.WebsocketGetSubscriptionsResponse
-	(*WebsocketSetProxyRequest)(nil),                  // 144: gctrpc.WebsocketSetProxyRequest
-	(*WebsocketSetURLRequest)(nil),                    // 145: gctrpc.WebsocketSetURLRequest
-	(*FindMissingCandlePeriodsRequest)(nil),           // 146: gctrpc.FindMissingCandlePeriodsRequest
-	(*FindMissingTradePeriodsRequest)(nil),            // 147: gctrpc.FindMissingTradePeriodsRequest
-	(*FindMissingIntervalsResponse)(nil),              // 148: gctrpc.FindMissingIntervalsResponse
-	(*SetExchangeTradeProcessingRequest)(nil),         // 149: gctrpc.SetExchangeTradeProcessingRequest
-	(*UpsertDataHistoryJobRequest)(nil),               // 150: gctrpc.UpsertDataHistoryJobRequest
-	(*InsertSequentialJobsRequest)(nil),               // 151: gctrpc.InsertSequentialJobsRequest
-	(*InsertSequentialJobsResponse)(nil),              // 152: gctrpc.InsertSequentialJobsResponse
-	(*UpsertDataHistoryJobResponse)(nil),              // 153: gctrpc.UpsertDataHistoryJobResponse
-	(*GetDataHistoryJobDetailsRequest)(nil),           // 154: gctrpc.GetDataHistoryJobDetailsRequest
-	(*DataHistoryJob)(nil),                            // 155: gctrpc.DataHistoryJob
-	(*DataHistoryJobResult)(nil),                      // 156: gctrpc.DataHistoryJobResult
-	(*DataHistoryJobs)(nil),                           // 157: gctrpc.DataHistoryJobs
-	(*GetDataHistoryJobsBetweenRequest)(nil),          // 158: gctrpc.GetDataHistoryJobsBetweenRequest
-	(*SetDataHistoryJobStatusRequest)(nil),            // 159: gctrpc.SetDataHistoryJobStatusRequest
-	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 160: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	(*ModifyOrderRequest)(nil),                        // 161: gctrpc.ModifyOrderRequest
-	(*ModifyOrderResponse)(nil),                       // 162: gctrpc.ModifyOrderResponse
-	(*CurrencyStateGetAllRequest)(nil),                // 163: gctrpc.CurrencyStateGetAllRequest
-	(*CurrencyStateTradingRequest)(nil),               // 164: gctrpc.CurrencyStateTradingRequest
-	(*CurrencyStateTradingPairRequest)(nil),           // 165: gctrpc.CurrencyStateTradingPairRequest
-	(*CurrencyStateWithdrawRequest)(nil),              // 166: gctrpc.CurrencyStateWithdrawRequest
-	(*CurrencyStateDepositRequest)(nil),               // 167: gctrpc.CurrencyStateDepositRequest
-	(*CurrencyStateResponse)(nil),                     // 168: gctrpc.CurrencyStateResponse
-	(*CurrencyState)(nil),                             // 169: gctrpc.CurrencyState
-	(*FundingRate)(nil),                               // 170: gctrpc.FundingRate
-	(*FundingData)(nil),                               // 171: gctrpc.FundingData
-	(*FuturesPositionStats)(nil),                      // 172: gctrpc.FuturesPositionStats
-	(*FuturePosition)(nil),                            // 173: gctrpc.FuturePosition
-	(*GetManagedPositionRequest)(nil),                 // 174: gctrpc.GetManagedPositionRequest
-	(*GetAllManagedPositionsRequest)(nil),             // 175: gctrpc.GetAllManagedPositionsRequest
-	(*GetManagedPositionsResponse)(nil),               // 176: gctrpc.GetManagedPositionsResponse
-	(*GetFuturesPositionsSummaryRequest)(nil),         // 177: gctrpc.GetFuturesPositionsSummaryRequest
-	(*GetFuturesPositionsSummaryResponse)(nil),        // 178: gctrpc.GetFuturesPositionsSummaryResponse
-	(*GetFuturesPositionsOrdersRequest)(nil),          // 179: gctrpc.GetFuturesPositionsOrdersRequest
-	(*GetFuturesPositionsOrdersResponse)(nil),         // 180: gctrpc.GetFuturesPositionsOrdersResponse
-	(*GetCollateralModeRequest)(nil),                  // 181: gctrpc.GetCollateralModeRequest
-	(*GetCollateralModeResponse)(nil),                 // 182: gctrpc.GetCollateralModeResponse
-	(*SetCollateralModeRequest)(nil),                  // 183: gctrpc.SetCollateralModeRequest
-	(*SetCollateralModeResponse)(nil),                 // 184: gctrpc.SetCollateralModeResponse
-	(*GetMarginTypeRequest)(nil),                      // 185: gctrpc.GetMarginTypeRequest
-	(*GetMarginTypeResponse)(nil),                     // 186: gctrpc.GetMarginTypeResponse
-	(*ChangePositionMarginRequest)(nil),               // 187: gctrpc.ChangePositionMarginRequest
-	(*ChangePositionMarginResponse)(nil),              // 188: gctrpc.ChangePositionMarginResponse
-	(*SetMarginTypeRequest)(nil),                      // 189: gctrpc.SetMarginTypeRequest
-	(*SetMarginTypeResponse)(nil),                     // 190: gctrpc.SetMarginTypeResponse
-	(*GetLeverageRequest)(nil),                        // 191: gctrpc.GetLeverageRequest
-	(*GetLeverageResponse)(nil),                       // 192: gctrpc.GetLeverageResponse
-	(*SetLeverageRequest)(nil),                        // 193: gctrpc.SetLeverageRequest
-	(*SetLeverageResponse)(nil),                       // 194: gctrpc.SetLeverageResponse
-	(*GetCollateralRequest)(nil),                      // 195: gctrpc.GetCollateralRequest
-	(*GetCollateralResponse)(nil),                     // 196: gctrpc.GetCollateralResponse
-	(*CollateralForCurrency)(nil),                     // 197: gctrpc.CollateralForCurrency
-	(*CollateralByPosition)(nil),                      // 198: gctrpc.CollateralByPosition
-	(*CollateralUsedBreakdown)(nil),                   // 199: gctrpc.CollateralUsedBreakdown
-	(*GetFundingRatesRequest)(nil),                    // 200: gctrpc.GetFundingRatesRequest
-	(*GetFundingRatesResponse)(nil),                   // 201: gctrpc.GetFundingRatesResponse
-	(*GetLatestFundingRateRequest)(nil),               // 202: gctrpc.GetLatestFundingRateRequest
-	(*GetLatestFundingRateResponse)(nil),              // 203: gctrpc.GetLatestFundingRateResponse
-	(*ShutdownRequest)(nil),                           // 204: gctrpc.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 205: gctrpc.ShutdownResponse
-	(*GetTechnicalAnalysisRequest)(nil),               // 206: gctrpc.GetTechnicalAnalysisRequest
-	(*ListOfSignals)(nil),                             // 207: gctrpc.ListOfSignals
-	(*GetTechnicalAnalysisResponse)(nil),              // 208: gctrpc.GetTechnicalAnalysisResponse
-	(*GetMarginRatesHistoryRequest)(nil),              // 209: gctrpc.GetMarginRatesHistoryRequest
-	(*LendingPayment)(nil),                            // 210: gctrpc.LendingPayment
-	(*BorrowCost)(nil),                                // 211: gctrpc.BorrowCost
-	(*MarginRate)(nil),                                // 212: gctrpc.MarginRate
-	(*GetMarginRatesHistoryResponse)(nil),             // 213: gctrpc.GetMarginRatesHistoryResponse
-	(*GetOrderbookMovementRequest)(nil),               // 214: gctrpc.GetOrderbookMovementRequest
-	(*GetOrderbookMovementResponse)(nil),              // 215: gctrpc.GetOrderbookMovementResponse
-	(*GetOrderbookAmountByNominalRequest)(nil),        // 216: gctrpc.GetOrderbookAmountByNominalRequest
-	(*GetOrderbookAmountByNominalResponse)(nil),       // 217: gctrpc.GetOrderbookAmountByNominalResponse
-	(*GetOrderbookAmountByImpactRequest)(nil),         // 218: gctrpc.GetOrderbookAmountByImpactRequest
-	(*GetOrderbookAmountByImpactResponse)(nil),        // 219: gctrpc.GetOrderbookAmountByImpactResponse
-	(*GetOpenInterestRequest)(nil),                    // 220: gctrpc.GetOpenInterestRequest
-	(*OpenInterestDataRequest)(nil),                   // 221: gctrpc.OpenInterestDataRequest
-	(*GetOpenInterestResponse)(nil),                   // 222: gctrpc.GetOpenInterestResponse
-	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
-	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
-	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*EventCondition)(nil),                            // 75: gctrpc.EventCondition
+	(*EventActionParams)(nil),                         // 76: gctrpc.EventActionParams
+	(*EventDetails)(nil),                              // 77: gctrpc.EventDetails
+	(*GetEventsResponse)(nil),                         // 78: gctrpc.GetEventsResponse
+	(*AddEventRequest)(nil),                           // 79: gctrpc.AddEventRequest
+	(*AddEventResponse)(nil),                          // 80: gctrpc.AddEventResponse
+	(*RemoveEventRequest)(nil),                        // 81: gctrpc.RemoveEventRequest
+	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 82: gctrpc.GetCryptocurrencyDepositAddressesRequest
+	(*DepositAddress)(nil),                            // 83: gctrpc.DepositAddress
+	(*DepositAddresses)(nil),                          // 84: gctrpc.DepositAddresses
+	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 85: gctrpc.GetCryptocurrencyDepositAddressesResponse
+	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 86: gctrpc.GetCryptocurrencyDepositAddressRequest
+	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 87: gctrpc.GetCryptocurrencyDepositAddressResponse
+	(*GetAvailableTransferChainsRequest)(nil),         // 88: gctrpc.GetAvailableTransferChainsRequest
+	(*GetAvailableTransferChainsResponse)(nil),        // 89: gctrpc.GetAvailableTransferChainsResponse
+	(*WithdrawFiatRequest)(nil),                       // 90: gctrpc.WithdrawFiatRequest
+	(*WithdrawCryptoRequest)(nil),                     // 91: gctrpc.WithdrawCryptoRequest
+	(*WithdrawResponse)(nil),                          // 92: gctrpc.WithdrawResponse
+	(*WithdrawalEventByIDRequest)(nil),                // 93: gctrpc.WithdrawalEventByIDRequest
+	(*WithdrawalEventByIDResponse)(nil),               // 94: gctrpc.WithdrawalEventByIDResponse
+	(*WithdrawalEventsByExchangeRequest)(nil),         // 95: gctrpc.WithdrawalEventsByExchangeRequest
+	(*WithdrawalEventsByDateRequest)(nil),             // 96: gctrpc.WithdrawalEventsByDateRequest
+	(*WithdrawalEventsByExchangeResponse)(nil),        // 97: gctrpc.WithdrawalEventsByExchangeResponse
+	(*WithdrawalEventResponse)(nil),                   // 98: gctrpc.WithdrawalEventResponse
+	(*WithdrawlExchangeEvent)(nil),                    // 99: gctrpc.WithdrawlExchangeEvent
+	(*WithdrawalRequestEvent)(nil),                    // 100: gctrpc.WithdrawalRequestEvent
+	(*FiatWithdrawalEvent)(nil),                       // 101: gctrpc.FiatWithdrawalEvent
+	(*CryptoWithdrawalEvent)(nil),                     // 102: gctrpc.CryptoWithdrawalEvent
+	(*GetLoggerDetailsRequest)(nil),                   // 103: gctrpc.GetLoggerDetailsRequest
+	(*GetLoggerDetailsResponse)(nil),                  // 104: gctrpc.GetLoggerDetailsResponse
+	(*SetLoggerDetailsRequest)(nil),                   // 105: gctrpc.SetLoggerDetailsRequest
+	(*GetExchangePairsRequest)(nil),                   // 106: gctrpc.GetExchangePairsRequest
+	(*GetExchangePairsResponse)(nil),                  // 107: gctrpc.GetExchangePairsResponse
+	(*SetExchangePairRequest)(nil),                    // 108: gctrpc.SetExchangePairRequest
+	(*GetOrderbookStreamRequest)(nil),                 // 109: gctrpc.GetOrderbookStreamRequest
+	(*GetExchangeOrderbookStreamRequest)(nil),         // 110: gctrpc.GetExchangeOrderbookStreamRequest
+	(*GetTickerStreamRequest)(nil),                    // 111: gctrpc.GetTickerStreamRequest
+	(*GetExchangeTickerStreamRequest)(nil),            // 112: gctrpc.GetExchangeTickerStreamRequest
+	(*GetAuditEventRequest)(nil),                      // 113: gctrpc.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),                     // 114: gctrpc.GetAuditEventResponse
+	(*GetSavedTradesRequest)(nil),                     // 115: gctrpc.GetSavedTradesRequest
+	(*SavedTrades)(nil),                               // 116: gctrpc.SavedTrades
+	(*SavedTradesResponse)(nil),                       // 117: gctrpc.SavedTradesResponse
+	(*ConvertTradesToCandlesRequest)(nil),             // 118: gctrpc.ConvertTradesToCandlesRequest
+	(*GetHistoricCandlesRequest)(nil),                 // 119: gctrpc.GetHistoricCandlesRequest
+	(*GetHistoricCandlesResponse)(nil),                // 120: gctrpc.GetHistoricCandlesResponse
+	(*Candle)(nil),                                    // 121: gctrpc.Candle
+	(*AuditEvent)(nil),                                // 122: gctrpc.AuditEvent
+	(*GCTScript)(nil),                                 // 123: gctrpc.GCTScript
+	(*GCTScriptExecuteRequest)(nil),                   // 124: gctrpc.GCTScriptExecuteRequest
+	(*GCTScriptStopRequest)(nil),                      // 125: gctrpc.GCTScriptStopRequest
+	(*GCTScriptStopAllRequest)(nil),                   // 126: gctrpc.GCTScriptStopAllRequest
+	(*GCTScriptStatusRequest)(nil),                    // 127: gctrpc.GCTScriptStatusRequest
+	(*GCTScriptListAllRequest)(nil),                   // 128: gctrpc.GCTScriptListAllRequest
+	(*GCTScriptUploadRequest)(nil),                    // 129: gctrpc.GCTScriptUploadRequest
+	(*GCTScriptReadScriptRequest)(nil),                // 130: gctrpc.GCTScriptReadScriptRequest
+	(*GCTScriptQueryRequest)(nil),                     // 131: gctrpc.GCTScriptQueryRequest
+	(*GCTScriptAutoLoadRequest)(nil),                  // 132: gctrpc.GCTScriptAutoLoadRequest
+	(*GCTScriptStatusResponse)(nil),                   // 133: gctrpc.GCTScriptStatusResponse
+	(*GCTScriptQueryResponse)(nil),                    // 134: gctrpc.GCTScriptQueryResponse
+	(*GenericResponse)(nil),                           // 135: gctrpc.GenericResponse
+	(*SetExchangeAssetRequest)(nil),                   // 136: gctrpc.SetExchangeAssetRequest
+	(*SetExchangeAllPairsRequest)(nil),                // 137: gctrpc.SetExchangeAllPairsRequest
+	(*UpdateExchangeSupportedPairsRequest)(nil),       // 138: gctrpc.UpdateExchangeSupportedPairsRequest
+	(*GetExchangeAssetsRequest)(nil),                  // 139: gctrpc.GetExchangeAssetsRequest
+	(*GetExchangeAssetsResponse)(nil),                 // 140: gctrpc.GetExchangeAssetsResponse
+	(*WebsocketGetInfoRequest)(nil),                   // 141: gctrpc.WebsocketGetInfoRequest
+	(*WebsocketGetInfoResponse)(nil),                  // 142: gctrpc.WebsocketGetInfoResponse
+	(*WebsocketConnection)(nil),                       // 143: gctrpc.WebsocketConnection
+	(*WebsocketSetEnabledRequest)(nil),                // 144: gctrpc.WebsocketSetEnabledRequest
+	(*WebsocketGetSubscriptionsRequest)(nil),          // 145: gctrpc.WebsocketGetSubscriptionsRequest
+	(*WebsocketSubscription)(nil),                     // 146: gctrpc.WebsocketSubscription
+	(*WebsocketGetSubscriptionsResponse)(nil),         // 147: gctrpc.WebsocketGetSubscriptionsResponse
+	(*WebsocketSetProxyRequest)(nil),                  // 148: gctrpc.WebsocketSetProxyRequest
+	(*WebsocketSetURLRequest)(nil),                    // 149: gctrpc.WebsocketSetURLRequest
+	(*FindMissingCandlePeriodsRequest)(nil),           // 150: gctrpc.FindMissingCandlePeriodsRequest
+	(*FindMissingTradePeriodsRequest)(nil),            // 151: gctrpc.FindMissingTradePeriodsRequest
+	(*FindMissingIntervalsResponse)(nil),              // 152: gctrpc.FindMissingIntervalsResponse
+	(*SetExchangeTradeProcessingRequest)(nil),         // 153: gctrpc.SetExchangeTradeProcessingRequest
+	(*UpsertDataHistoryJobRequest)(nil),               // 154: gctrpc.UpsertDataHistoryJobRequest
+	(*InsertSequentialJobsRequest)(nil),               // 155: gctrpc.InsertSequentialJobsRequest
+	(*InsertSequentialJobsResponse)(nil),              // 156: gctrpc.InsertSequentialJobsResponse
+	(*UpsertDataHistoryJobResponse)(nil),              // 157: gctrpc.UpsertDataHistoryJobResponse
+	(*GetDataHistoryJobDetailsRequest)(nil),           // 158: gctrpc.GetDataHistoryJobDetailsRequest
+	(*DataHistoryJob)(nil),                            // 159: gctrpc.DataHistoryJob
+	(*DataHistoryJobResult)(nil),                      // 160: gctrpc.DataHistoryJobResult
+	(*DataHistoryJobs)(nil),                           // 161: gctrpc.DataHistoryJobs
+	(*GetDataHistoryJobsBetweenRequest)(nil),          // 162: gctrpc.GetDataHistoryJobsBetweenRequest
+	(*SetDataHistoryJobStatusRequest)(nil),            // 163: gctrpc.SetDataHistoryJobStatusRequest
+	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 164: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	(*ModifyOrderRequest)(nil),                        // 165: gctrpc.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),                       // 166: gctrpc.ModifyOrderResponse
+	(*CurrencyStateGetAllRequest)(nil),                // 167: gctrpc.CurrencyStateGetAllRequest
+	(*CurrencyStateTradingRequest)(nil),               // 168: gctrpc.CurrencyStateTradingRequest
+	(*CurrencyStateTradingPairRequest)(nil),           // 169: gctrpc.CurrencyStateTradingPairRequest
+	(*CurrencyStateWithdrawRequest)(nil),              // 170: gctrpc.CurrencyStateWithdrawRequest
+	(*CurrencyStateDepositRequest)(nil),               // 171: gctrpc.CurrencyStateDepositRequest
+	(*CurrencyStateResponse)(nil),                     // 172: gctrpc.CurrencyStateResponse
+	(*CurrencyState)(nil),                             // 173: gctrpc.CurrencyState
+	(*FundingRate)(nil),                               // 174: gctrpc.FundingRate
+	(*FundingData)(nil),                               // 175: gctrpc.FundingData
+	(*FuturesPositionStats)(nil),                      // 176: gctrpc.FuturesPositionStats
+	(*FuturePosition)(nil),                            // 177: gctrpc.FuturePosition
+	(*GetManagedPositionRequest)(nil),                 // 178: gctrpc.GetManagedPositionRequest
+	(*GetAllManagedPositionsRequest)(nil),             // 179: gctrpc.GetAllManagedPositionsRequest
+	(*GetManagedPositionsResponse)(nil),               // 180: gctrpc.GetManagedPositionsResponse
+	(*GetFuturesPositionsSummaryRequest)(nil),         // 181: gctrpc.GetFuturesPositionsSummaryRequest
+	(*GetFuturesPositionsSummaryResponse)(nil),        // 182: gctrpc.GetFuturesPositionsSummaryResponse
+	(*GetFuturesPositionsOrdersRequest)(nil),          // 183: gctrpc.GetFuturesPositionsOrdersRequest
+	(*GetFuturesPositionsOrdersResponse)(nil),         // 184: gctrpc.GetFuturesPositionsOrdersResponse
+	(*GetCollateralModeRequest)(nil),                  // 185: gctrpc.GetCollateralModeRequest
+	(*GetCollateralModeResponse)(nil),                 // 186: gctrpc.GetCollateralModeResponse
+	(*SetCollateralModeRequest)(nil),                  // 187: gctrpc.SetCollateralModeRequest
+	(*SetCollateralModeResponse)(nil),                 // 188: gctrpc.SetCollateralModeResponse
+	(*GetMarginTypeRequest)(nil),                      // 189: gctrpc.GetMarginTypeRequest
+	(*GetMarginTypeResponse)(nil),                     // 190: gctrpc.GetMarginTypeResponse
+	(*ChangePositionMarginRequest)(nil),               // 191: gctrpc.ChangePositionMarginRequest
+	(*ChangePositionMarginResponse)(nil),              // 192: gctrpc.ChangePositionMarginResponse
+	(*SetMarginTypeRequest)(nil),                      // 193: gctrpc.SetMarginTypeRequest
+	(*SetMarginTypeResponse)(nil),                     // 194: gctrpc.SetMarginTypeResponse
+	(*GetLeverageRequest)(nil),                        // 195: gctrpc.GetLeverageRequest
+	(*GetLeverageResponse)(nil),                       // 196: gctrpc.GetLeverageResponse
+	(*SetLeverageRequest)(nil),                        // 197: gctrpc.SetLeverageRequest
+	(*SetLeverageResponse)(nil),                       // 198: gctrpc.SetLeverageResponse
+	(*GetCollateralRequest)(nil),                      // 199: gctrpc.GetCollateralRequest
+	(*GetCollateralResponse)(nil),                     // 200: gctrpc.GetCollateralResponse
+	(*CollateralForCurrency)(nil),                     // 201: gctrpc.CollateralForCurrency
+	(*CollateralByPosition)(nil),                      // 202: gctrpc.CollateralByPosition
+	(*CollateralUsedBreakdown)(nil),                   // 203: gctrpc.CollateralUsedBreakdown
+	(*GetFundingRatesRequest)(nil),                    // 204: gctrpc.GetFundingRatesRequest
+	(*GetFundingRatesResponse)(nil),                   // 205: gctrpc.GetFundingRatesResponse
+	(*GetLatestFundingRateRequest)(nil),               // 206: gctrpc.GetLatestFundingRateRequest
+	(*GetLatestFundingRateResponse)(nil),              // 207: gctrpc.GetLatestFundingRateResponse
+	(*ShutdownRequest)(nil),                           // 208: gctrpc.ShutdownRequest
+	(*ShutdownResponse)(nil),                          // 209: gctrpc.ShutdownResponse
+	(*GetTechnicalAnalysisRequest)(nil),               // 210: gctrpc.GetTechnicalAnalysisRequest
+	(*ListOfSignals)(nil),                             // 211: gctrpc.ListOfSignals
+	(*GetTechnicalAnalysisResponse)(nil),              // 212: gctrpc.GetTechnicalAnalysisResponse
+	(*GetMarginRatesHistoryRequest)(nil),              // 213: gctrpc.GetMarginRatesHistoryRequest
+	(*LendingPayment)(nil),                            // 214: gctrpc.LendingPayment
+	(*BorrowCost)(nil),                                // 215: gctrpc.BorrowCost
+	(*MarginRate)(nil),                                // 216: gctrpc.MarginRate
+	(*GetMarginRatesHistoryResponse)(nil),             // 217: gctrpc.GetMarginRatesHistoryResponse
+	(*GetOrderbookMovementRequest)(nil),               // 218: gctrpc.GetOrderbookMovementRequest
+	(*GetOrderbookMovementResponse)(nil),              // 219: gctrpc.GetOrderbookMovementResponse
+	(*GetOrderbookAmountByNominalRequest)(nil),        // 220: gctrpc.GetOrderbookAmountByNominalRequest
+	(*GetOrderbookAmountByNominalResponse)(nil),       // 221: gctrpc.GetOrderbookAmountByNominalResponse
+	(*GetOrderbookAmountByImpactRequest)(nil),         // 222: gctrpc.GetOrderbookAmountByImpactRequest
+	(*GetOrderbookAmountByImpactResponse)(nil),        // 223: gctrpc.GetOrderbookAmountByImpactResponse
+	(*GetOpenInterestRequest)(nil),                    // 224: gctrpc.GetOpenInterestRequest
+	(*OpenInterestDataRequest)(nil),                   // 225: gctrpc.OpenInterestDataRequest
+	(*GetOpenInterestResponse)(nil),                   // 226: gctrpc.GetOpenInterestResponse
+	(*OpenInterestDataResponse)(nil),                  // 227: gctrpc.OpenInterestDataResponse
+	(*GetCurrencyTradeURLRequest)(nil),                // 228: gctrpc.GetCurrencyTradeURLRequest
+	(*GetCurrencyTradeURLResponse)(nil),               // 229: gctrpc.GetCurrencyTradeURLResponse
+	(*SyntheticOrder)(nil),                            // 230: gctrpc.SyntheticOrder
+	(*AddSyntheticOrderRequest)(nil),                  // 231: gctrpc.AddSyntheticOrderRequest
+	(*GetSyntheticOrdersRequest)(nil),                 // 232: gctrpc.GetSyntheticOrdersRequest
+	(*GetSyntheticOrdersResponse)(nil),                // 233: gctrpc.GetSyntheticOrdersResponse
+	(*CancelSyntheticOrderRequest)(nil),               // 234: gctrpc.CancelSyntheticOrderRequest
+	(*ExecutionAlgorithm)(nil),                        // 235: gctrpc.ExecutionAlgorithm
+	(*StartExecutionAlgorithmRequest)(nil),            // 236: gctrpc.StartExecutionAlgorithmRequest
+	(*GenericExecutionAlgorithmRequest)(nil),          // 237: gctrpc.GenericExecutionAlgorithmRequest
+	(*GetExecutionAlgorithmsRequest)(nil),             // 238: gctrpc.GetExecutionAlgorithmsRequest
+	(*GetExecutionAlgorithmsResponse)(nil),            // 239: gctrpc.GetExecutionAlgorithmsResponse
+	(*GetRiskStatusRequest)(nil),                      // 240: gctrpc.GetRiskStatusRequest
+	(*RiskViolation)(nil),                             // 241: gctrpc.RiskViolation
+	(*GetRiskStatusResponse)(nil),                     // 242: gctrpc.GetRiskStatusResponse
+	(*SetKillSwitchRequest)(nil),                      // 243: gctrpc.SetKillSwitchRequest
+	(*ConsolidatedOrderbookSource)(nil),               // 244: gctrpc.ConsolidatedOrderbookSource
+	(*GetConsolidatedOrderbookRequest)(nil),           // 245: gctrpc.GetConsolidatedOrderbookRequest
+	(*ConsolidatedOrderbookItem)(nil),                 // 246: gctrpc.ConsolidatedOrderbookItem
+	(*ConsolidatedOrderbookVenue)(nil),                // 247: gctrpc.ConsolidatedOrderbookVenue
+	(*ConsolidatedOrderbookResponse)(nil),             // 248: gctrpc.ConsolidatedOrderbookResponse
+	(*SimulateConsolidatedOrderRequest)(nil),          // 249: gctrpc.SimulateConsolidatedOrderRequest
+	(*ConsolidatedWhaleBombRequest)(nil),              // 250: gctrpc.ConsolidatedWhaleBombRequest
+	(*ConsolidatedOrderFill)(nil),                     // 251: gctrpc.ConsolidatedOrderFill
+	(*SimulateConsolidatedOrderResponse)(nil),         // 252: gctrpc.SimulateConsolidatedOrderResponse
+	(*RouteOrderRequest)(nil),                         // 253: gctrpc.RouteOrderRequest
+	(*RoutedChildOrder)(nil),                          // 254: gctrpc.RoutedChildOrder
+	(*RouteExclusion)(nil),                            // 255: gctrpc.RouteExclusion
+	(*RouteOrderResponse)(nil),                        // 256: gctrpc.RouteOrderResponse
+	(*GetArbitrageOpportunitiesRequest)(nil),          // 257: gctrpc.GetArbitrageOpportunitiesRequest
+	(*ArbitrageLeg)(nil),                              // 258: gctrpc.ArbitrageLeg
+	(*ArbitrageOpportunity)(nil),                      // 259: gctrpc.ArbitrageOpportunity
+	(*GetArbitrageOpportunitiesResponse)(nil),         // 260: gctrpc.GetArbitrageOpportunitiesResponse
+	(*GetLedgerPnLRequest)(nil),                       // 261: gctrpc.GetLedgerPnLRequest
+	(*LedgerLot)(nil),                                 // 262: gctrpc.LedgerLot
+	(*LedgerAssetPnL)(nil),                            // 263: gctrpc.LedgerAssetPnL
+	(*GetLedgerPnLResponse)(nil),                      // 264: gctrpc.GetLedgerPnLResponse
+	(*AddLedgerTransferRequest)(nil),                  // 265: gctrpc.AddLedgerTransferRequest
+	(*GetPortfolioEquityCurveRequest)(nil),            // 266: gctrpc.GetPortfolioEquityCurveRequest
+	(*EquityPoint)(nil),                               // 267: gctrpc.EquityPoint
+	(*GetPortfolioEquityCurveResponse)(nil),           // 268: gctrpc.GetPortfolioEquityCurveResponse
+	(*ReloadConfigRequest)(nil),                       // 269: gctrpc.ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),                      // 270: gctrpc.ReloadConfigResponse
+	(*RPCToken)(nil),                                  // 271: gctrpc.RPCToken
+	(*CreateRPCTokenRequest)(nil),                     // 272: gctrpc.CreateRPCTokenRequest
+	(*CreateRPCTokenResponse)(nil),                    // 273: gctrpc.CreateRPCTokenResponse
+	(*GetRPCTokensRequest)(nil),                       // 274: gctrpc.GetRPCTokensRequest
+	(*GetRPCTokensResponse)(nil),                      // 275: gctrpc.GetRPCTokensResponse
+	(*RevokeRPCTokenRequest)(nil),                     // 276: gctrpc.RevokeRPCTokenRequest
+	(*PendingWithdrawal)(nil),                         // 277: gctrpc.PendingWithdrawal
+	(*GetPendingWithdrawalsRequest)(nil),              // 278: gctrpc.GetPendingWithdrawalsRequest
+	(*GetPendingWithdrawalsResponse)(nil),             // 279: gctrpc.GetPendingWithdrawalsResponse
+	(*ApproveWithdrawalRequest)(nil),                  // 280: gctrpc.ApproveWithdrawalRequest
+	(*RejectWithdrawalRequest)(nil),                   // 281: gctrpc.RejectWithdrawalRequest
+	(*GetFundingRateScanRequest)(nil),                 // 282: gctrpc.GetFundingRateScanRequest
+	(*PerpetualFundingRate)(nil),                      // 283: gctrpc.PerpetualFundingRate
+	(*FundingLeg)(nil),                                // 284: gctrpc.FundingLeg
+	(*FundingOpportunity)(nil),                        // 285: gctrpc.FundingOpportunity
+	(*GetFundingRateScanResponse)(nil),                // 286: gctrpc.GetFundingRateScanResponse
+	(*GetFundingOpportunityHistoryRequest)(nil),       // 287: gctrpc.GetFundingOpportunityHistoryRequest
+	(*GetFundingOpportunityHistoryResponse)(nil),      // 288: gctrpc.GetFundingOpportunityHistoryResponse
+	nil,                           // 289: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                           // 290: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                           // 291: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                           // 292: gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
+	nil,                           // 293: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                           // 294: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                           // 295: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                           // 296: gctrpc.OnlineCoins.CoinsEntry
+	nil,                           // 297: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                           // 298: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                           // 299: gctrpc.Orders.OrderStatusEntry
+	nil,                           // 300: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                           // 301: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                           // 302: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	(*timestamppb.Timestamp)(nil), // 303: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSusbsytemsResponse.subsystems_status:type_name -> gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	289, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	290, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	291, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	292, // 3: gctrpc.GetSusbsytemsResponse.subsystems_status:type_name -> gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
+	293, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	294, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	295, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,16 +22354,16 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	303, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
 	33,  // 19: gctrpc.GetAccountInfoResponse.accounts:type_name -> gctrpc.Account
 	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
 	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	296, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
 	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
 	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	297, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
 	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	298, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
 	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
 	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
 	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
@@ -16784,356 +22377,482 @@ var file_rpc_proto_depIdxs = []int32{
 	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	299, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
 	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
 	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
-	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 45: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
-	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
-	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawlExchangeEvent
-	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
-	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
-	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
-	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	119, // 62: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
-	21,  // 63: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 64: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
-	113, // 65: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
-	21,  // 66: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 67: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 68: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
-	118, // 69: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
-	120, // 70: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
-	120, // 71: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
-	120, // 72: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
-	120, // 73: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
-	120, // 74: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
-	120, // 75: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
-	142, // 76: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
-	21,  // 77: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 78: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 79: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 80: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
-	150, // 81: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
-	153, // 82: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
-	21,  // 83: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
-	156, // 84: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
-	155, // 85: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
-	21,  // 86: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	169, // 87: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
-	21,  // 88: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
-	170, // 89: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
-	170, // 90: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
-	170, // 91: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
-	21,  // 92: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 93: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
-	172, // 94: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
-	171, // 95: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
-	21,  // 96: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
-	173, // 97: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 98: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 99: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 100: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
-	172, // 101: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
-	21,  // 102: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 103: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	173, // 104: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 105: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 106: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 107: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 108: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 109: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 110: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 111: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 112: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 113: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 114: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 115: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 116: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 117: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 118: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	199, // 119: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	197, // 120: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
-	198, // 121: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
-	199, // 122: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	21,  // 123: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 124: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
-	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
-	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
-	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
-	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
-	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
-	212, // 135: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
-	212, // 136: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
-	212, // 137: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
-	21,  // 138: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 139: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 140: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
-	221, // 141: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
-	21,  // 142: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
-	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
-	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountInfoStream:input_type -> gctrpc.GetAccountInfoRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSusbsytemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountInfoStream:output_type -> gctrpc.GetAccountInfoResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	74,  // 44: gctrpc.EventCondition.params:type_name -> gctrpc.ConditionParams
+	75,  // 45: gctrpc.EventCondition.conditions:type_name -> gctrpc.EventCondition
+	74,  // 46: gctrpc.EventDetails.condition_params:type_name -> gctrpc.ConditionParams
+	75,  // 47: gctrpc.EventDetails.conditions:type_name -> gctrpc.EventCondition
+	21,  // 48: gctrpc.EventDetails.pair:type_name -> gctrpc.CurrencyPair
+	76,  // 49: gctrpc.EventDetails.action_params:type_name -> gctrpc.EventActionParams
+	303, // 50: gctrpc.EventDetails.last_triggered:type_name -> google.protobuf.Timestamp
+	77,  // 51: gctrpc.GetEventsResponse.events:type_name -> gctrpc.EventDetails
+	74,  // 52: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 53: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
+	75,  // 54: gctrpc.AddEventRequest.conditions:type_name -> gctrpc.EventCondition
+	76,  // 55: gctrpc.AddEventRequest.action_params:type_name -> gctrpc.EventActionParams
+	83,  // 56: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
+	300, // 57: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	98,  // 58: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	98,  // 59: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	99,  // 60: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawlExchangeEvent
+	100, // 61: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
+	303, // 62: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	303, // 63: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 64: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
+	102, // 65: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
+	301, // 66: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	21,  // 67: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
+	21,  // 68: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 69: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	122, // 70: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
+	21,  // 71: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 72: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
+	116, // 73: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
+	21,  // 74: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 75: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 76: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
+	121, // 77: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
+	123, // 78: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
+	123, // 79: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
+	123, // 80: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
+	123, // 81: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
+	123, // 82: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
+	123, // 83: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
+	143, // 84: gctrpc.WebsocketGetInfoResponse.connections:type_name -> gctrpc.WebsocketConnection
+	146, // 85: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
+	21,  // 86: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 87: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 88: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 89: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
+	154, // 90: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
+	157, // 91: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
+	21,  // 92: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
+	160, // 93: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
+	159, // 94: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
+	21,  // 95: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	173, // 96: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
+	21,  // 97: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
+	174, // 98: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
+	174, // 99: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
+	174, // 100: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
+	21,  // 101: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
+	56,  // 102: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
+	176, // 103: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
+	175, // 104: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
+	21,  // 105: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
+	177, // 106: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 107: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 108: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 109: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
+	176, // 110: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
+	21,  // 111: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 112: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	177, // 113: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 114: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 115: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 116: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 117: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 118: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 119: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 120: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 121: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 122: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 123: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 124: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 125: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 126: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 127: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	203, // 128: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	201, // 129: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
+	202, // 130: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
+	203, // 131: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	21,  // 132: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
+	175, // 133: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
+	21,  // 134: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
+	175, // 135: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
+	21,  // 136: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	303, // 137: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	303, // 138: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	21,  // 139: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
+	302, // 140: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	216, // 141: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
+	214, // 142: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
+	215, // 143: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
+	216, // 144: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
+	216, // 145: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
+	216, // 146: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
+	21,  // 147: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 148: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 149: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
+	225, // 150: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
+	21,  // 151: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
+	227, // 152: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
+	21,  // 153: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 154: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 155: gctrpc.SyntheticOrder.pair:type_name -> gctrpc.CurrencyPair
+	303, // 156: gctrpc.SyntheticOrder.created_at:type_name -> google.protobuf.Timestamp
+	303, // 157: gctrpc.SyntheticOrder.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 158: gctrpc.AddSyntheticOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	230, // 159: gctrpc.GetSyntheticOrdersResponse.orders:type_name -> gctrpc.SyntheticOrder
+	21,  // 160: gctrpc.ExecutionAlgorithm.pair:type_name -> gctrpc.CurrencyPair
+	303, // 161: gctrpc.ExecutionAlgorithm.start_time:type_name -> google.protobuf.Timestamp
+	303, // 162: gctrpc.ExecutionAlgorithm.end_time:type_name -> google.protobuf.Timestamp
+	303, // 163: gctrpc.ExecutionAlgorithm.created_at:type_name -> google.protobuf.Timestamp
+	303, // 164: gctrpc.ExecutionAlgorithm.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 165: gctrpc.StartExecutionAlgorithmRequest.pair:type_name -> gctrpc.CurrencyPair
+	235, // 166: gctrpc.GetExecutionAlgorithmsResponse.algorithms:type_name -> gctrpc.ExecutionAlgorithm
+	303, // 167: gctrpc.RiskViolation.time:type_name -> google.protobuf.Timestamp
+	21,  // 168: gctrpc.RiskViolation.pair:type_name -> gctrpc.CurrencyPair
+	303, // 169: gctrpc.GetRiskStatusResponse.kill_switch_activated_at:type_name -> google.protobuf.Timestamp
+	241, // 170: gctrpc.GetRiskStatusResponse.violations:type_name -> gctrpc.RiskViolation
+	21,  // 171: gctrpc.ConsolidatedOrderbookSource.pair:type_name -> gctrpc.CurrencyPair
+	244, // 172: gctrpc.GetConsolidatedOrderbookRequest.sources:type_name -> gctrpc.ConsolidatedOrderbookSource
+	21,  // 173: gctrpc.ConsolidatedOrderbookResponse.pair:type_name -> gctrpc.CurrencyPair
+	246, // 174: gctrpc.ConsolidatedOrderbookResponse.bids:type_name -> gctrpc.ConsolidatedOrderbookItem
+	246, // 175: gctrpc.ConsolidatedOrderbookResponse.asks:type_name -> gctrpc.ConsolidatedOrderbookItem
+	247, // 176: gctrpc.ConsolidatedOrderbookResponse.venues:type_name -> gctrpc.ConsolidatedOrderbookVenue
+	244, // 177: gctrpc.SimulateConsolidatedOrderRequest.sources:type_name -> gctrpc.ConsolidatedOrderbookSource
+	244, // 178: gctrpc.ConsolidatedWhaleBombRequest.sources:type_name -> gctrpc.ConsolidatedOrderbookSource
+	246, // 179: gctrpc.SimulateConsolidatedOrderResponse.orders:type_name -> gctrpc.ConsolidatedOrderbookItem
+	251, // 180: gctrpc.SimulateConsolidatedOrderResponse.fills:type_name -> gctrpc.ConsolidatedOrderFill
+	21,  // 181: gctrpc.RouteOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 182: gctrpc.RouteOrderResponse.pair:type_name -> gctrpc.CurrencyPair
+	254, // 183: gctrpc.RouteOrderResponse.children:type_name -> gctrpc.RoutedChildOrder
+	255, // 184: gctrpc.RouteOrderResponse.excluded:type_name -> gctrpc.RouteExclusion
+	21,  // 185: gctrpc.ArbitrageLeg.pair:type_name -> gctrpc.CurrencyPair
+	258, // 186: gctrpc.ArbitrageOpportunity.legs:type_name -> gctrpc.ArbitrageLeg
+	303, // 187: gctrpc.ArbitrageOpportunity.detected_at:type_name -> google.protobuf.Timestamp
+	259, // 188: gctrpc.GetArbitrageOpportunitiesResponse.opportunities:type_name -> gctrpc.ArbitrageOpportunity
+	303, // 189: gctrpc.LedgerLot.acquired:type_name -> google.protobuf.Timestamp
+	262, // 190: gctrpc.LedgerAssetPnL.lots:type_name -> gctrpc.LedgerLot
+	263, // 191: gctrpc.GetLedgerPnLResponse.assets:type_name -> gctrpc.LedgerAssetPnL
+	303, // 192: gctrpc.EquityPoint.timestamp:type_name -> google.protobuf.Timestamp
+	267, // 193: gctrpc.GetPortfolioEquityCurveResponse.points:type_name -> gctrpc.EquityPoint
+	303, // 194: gctrpc.RPCToken.created_at:type_name -> google.protobuf.Timestamp
+	303, // 195: gctrpc.RPCToken.expires:type_name -> google.protobuf.Timestamp
+	271, // 196: gctrpc.CreateRPCTokenResponse.details:type_name -> gctrpc.RPCToken
+	271, // 197: gctrpc.GetRPCTokensResponse.tokens:type_name -> gctrpc.RPCToken
+	303, // 198: gctrpc.PendingWithdrawal.created_at:type_name -> google.protobuf.Timestamp
+	303, // 199: gctrpc.PendingWithdrawal.expires:type_name -> google.protobuf.Timestamp
+	277, // 200: gctrpc.GetPendingWithdrawalsResponse.withdrawals:type_name -> gctrpc.PendingWithdrawal
+	21,  // 201: gctrpc.PerpetualFundingRate.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 202: gctrpc.PerpetualFundingRate.underlying:type_name -> gctrpc.CurrencyPair
+	303, // 203: gctrpc.PerpetualFundingRate.rate_time:type_name -> google.protobuf.Timestamp
+	303, // 204: gctrpc.PerpetualFundingRate.time_of_next_rate:type_name -> google.protobuf.Timestamp
+	21,  // 205: gctrpc.FundingLeg.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 206: gctrpc.FundingOpportunity.underlying:type_name -> gctrpc.CurrencyPair
+	284, // 207: gctrpc.FundingOpportunity.long_leg:type_name -> gctrpc.FundingLeg
+	284, // 208: gctrpc.FundingOpportunity.short_leg:type_name -> gctrpc.FundingLeg
+	303, // 209: gctrpc.FundingOpportunity.detected_at:type_name -> google.protobuf.Timestamp
+	283, // 210: gctrpc.GetFundingRateScanResponse.rates:type_name -> gctrpc.PerpetualFundingRate
+	285, // 211: gctrpc.GetFundingRateScanResponse.opportunities:type_name -> gctrpc.FundingOpportunity
+	303, // 212: gctrpc.GetFundingRateScanResponse.scanned_at:type_name -> google.protobuf.Timestamp
+	285, // 213: gctrpc.GetFundingOpportunityHistoryResponse.opportunities:type_name -> gctrpc.FundingOpportunity
+	9,   // 214: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 215: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 216: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 217: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	44,  // 218: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	45,  // 219: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	46,  // 220: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	84,  // 221: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 222: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	211, // 223: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 224: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 225: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 226: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 227: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 228: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 229: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 230: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 231: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 232: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 233: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 234: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 235: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 236: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 237: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 238: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 239: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 240: gctrpc.GoCryptoTraderService.GetAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
+	32,  // 241: gctrpc.GoCryptoTraderService.UpdateAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
+	32,  // 242: gctrpc.GoCryptoTraderService.GetAccountInfoStream:input_type -> gctrpc.GetAccountInfoRequest
+	36,  // 243: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	39,  // 244: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	41,  // 245: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	48,  // 246: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	49,  // 247: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	50,  // 248: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	53,  // 249: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	58,  // 250: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	60,  // 251: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	61,  // 252: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	64,  // 253: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	66,  // 254: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	67,  // 255: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	68,  // 256: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	71,  // 257: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	73,  // 258: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	79,  // 259: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	81,  // 260: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	82,  // 261: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	86,  // 262: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	88,  // 263: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	90,  // 264: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	91,  // 265: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	93,  // 266: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	95,  // 267: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	96,  // 268: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	103, // 269: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	105, // 270: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	106, // 271: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	108, // 272: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	109, // 273: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	110, // 274: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	111, // 275: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	112, // 276: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	113, // 277: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	124, // 278: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	129, // 279: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	130, // 280: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	127, // 281: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	131, // 282: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	125, // 283: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	126, // 284: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	128, // 285: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	132, // 286: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	119, // 287: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	136, // 288: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	137, // 289: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	138, // 290: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	139, // 291: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	141, // 292: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	144, // 293: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	145, // 294: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	148, // 295: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	149, // 296: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	115, // 297: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	115, // 298: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	115, // 299: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	118, // 300: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	150, // 301: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	151, // 302: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	153, // 303: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	154, // 304: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	158, // 305: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 306: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	162, // 307: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	158, // 308: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	163, // 309: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	164, // 310: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	58,  // 311: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	165, // 312: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	167, // 313: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	168, // 314: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	171, // 315: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	170, // 316: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	169, // 317: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	181, // 318: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	183, // 319: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	199, // 320: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	208, // 321: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	210, // 322: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	213, // 323: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	178, // 324: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	179, // 325: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	204, // 326: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	206, // 327: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	218, // 328: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	220, // 329: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	222, // 330: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	185, // 331: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	195, // 332: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	187, // 333: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	193, // 334: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	197, // 335: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	191, // 336: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	224, // 337: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	228, // 338: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	231, // 339: gctrpc.GoCryptoTraderService.AddSyntheticOrder:input_type -> gctrpc.AddSyntheticOrderRequest
+	232, // 340: gctrpc.GoCryptoTraderService.GetSyntheticOrders:input_type -> gctrpc.GetSyntheticOrdersRequest
+	234, // 341: gctrpc.GoCryptoTraderService.CancelSyntheticOrder:input_type -> gctrpc.CancelSyntheticOrderRequest
+	236, // 342: gctrpc.GoCryptoTraderService.StartExecutionAlgorithm:input_type -> gctrpc.StartExecutionAlgorithmRequest
+	237, // 343: gctrpc.GoCryptoTraderService.PauseExecutionAlgorithm:input_type -> gctrpc.GenericExecutionAlgorithmRequest
+	237, // 344: gctrpc.GoCryptoTraderService.ResumeExecutionAlgorithm:input_type -> gctrpc.GenericExecutionAlgorithmRequest
+	237, // 345: gctrpc.GoCryptoTraderService.CancelExecutionAlgorithm:input_type -> gctrpc.GenericExecutionAlgorithmRequest
+	238, // 346: gctrpc.GoCryptoTraderService.GetExecutionAlgorithms:input_type -> gctrpc.GetExecutionAlgorithmsRequest
+	240, // 347: gctrpc.GoCryptoTraderService.GetRiskStatus:input_type -> gctrpc.GetRiskStatusRequest
+	243, // 348: gctrpc.GoCryptoTraderService.SetKillSwitch:input_type -> gctrpc.SetKillSwitchRequest
+	245, // 349: gctrpc.GoCryptoTraderService.GetConsolidatedOrderbook:input_type -> gctrpc.GetConsolidatedOrderbookRequest
+	245, // 350: gctrpc.GoCryptoTraderService.GetConsolidatedOrderbookStream:input_type -> gctrpc.GetConsolidatedOrderbookRequest
+	249, // 351: gctrpc.GoCryptoTraderService.SimulateConsolidatedOrder:input_type -> gctrpc.SimulateConsolidatedOrderRequest
+	250, // 352: gctrpc.GoCryptoTraderService.ConsolidatedWhaleBomb:input_type -> gctrpc.ConsolidatedWhaleBombRequest
+	253, // 353: gctrpc.GoCryptoTraderService.RouteOrder:input_type -> gctrpc.RouteOrderRequest
+	257, // 354: gctrpc.GoCryptoTraderService.GetArbitrageOpportunities:input_type -> gctrpc.GetArbitrageOpportunitiesRequest
+	257, // 355: gctrpc.GoCryptoTraderService.GetArbitrageOpportunityStream:input_type -> gctrpc.GetArbitrageOpportunitiesRequest
+	261, // 356: gctrpc.GoCryptoTraderService.GetLedgerPnL:input_type -> gctrpc.GetLedgerPnLRequest
+	265, // 357: gctrpc.GoCryptoTraderService.AddLedgerTransfer:input_type -> gctrpc.AddLedgerTransferRequest
+	266, // 358: gctrpc.GoCryptoTraderService.GetPortfolioEquityCurve:input_type -> gctrpc.GetPortfolioEquityCurveRequest
+	269, // 359: gctrpc.GoCryptoTraderService.ReloadConfig:input_type -> gctrpc.ReloadConfigRequest
+	272, // 360: gctrpc.GoCryptoTraderService.CreateRPCToken:input_type -> gctrpc.CreateRPCTokenRequest
+	274, // 361: gctrpc.GoCryptoTraderService.GetRPCTokens:input_type -> gctrpc.GetRPCTokensRequest
+	276, // 362: gctrpc.GoCryptoTraderService.RevokeRPCToken:input_type -> gctrpc.RevokeRPCTokenRequest
+	278, // 363: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:input_type -> gctrpc.GetPendingWithdrawalsRequest
+	280, // 364: gctrpc.GoCryptoTraderService.ApproveWithdrawal:input_type -> gctrpc.ApproveWithdrawalRequest
+	281, // 365: gctrpc.GoCryptoTraderService.RejectWithdrawal:input_type -> gctrpc.RejectWithdrawalRequest
+	282, // 366: gctrpc.GoCryptoTraderService.GetFundingRateScan:input_type -> gctrpc.GetFundingRateScanRequest
+	287, // 367: gctrpc.GoCryptoTraderService.GetFundingOpportunityHistory:input_type -> gctrpc.GetFundingOpportunityHistoryRequest
+	1,   // 368: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 369: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSusbsytemsResponse
+	135, // 370: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	135, // 371: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 372: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 373: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 374: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	135, // 375: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 376: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 377: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 378: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	135, // 379: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 380: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 381: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 382: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 383: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	35,  // 384: gctrpc.GoCryptoTraderService.GetAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
+	35,  // 385: gctrpc.GoCryptoTraderService.UpdateAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
+	35,  // 386: gctrpc.GoCryptoTraderService.GetAccountInfoStream:output_type -> gctrpc.GetAccountInfoResponse
+	37,  // 387: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	40,  // 388: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	47,  // 389: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	135, // 390: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	135, // 391: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	52,  // 392: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	55,  // 393: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	59,  // 394: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	56,  // 395: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	63,  // 396: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	65,  // 397: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	65,  // 398: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	135, // 399: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	70,  // 400: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	72,  // 401: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	78,  // 402: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	80,  // 403: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	135, // 404: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	85,  // 405: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	87,  // 406: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	89,  // 407: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	92,  // 408: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	92,  // 409: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	94,  // 410: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	97,  // 411: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	97,  // 412: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	104, // 413: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	104, // 414: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	107, // 415: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	135, // 416: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 417: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 418: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 419: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 420: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	114, // 421: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	135, // 422: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
+	135, // 423: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	134, // 424: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	133, // 425: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	134, // 426: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	135, // 427: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	135, // 428: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	133, // 429: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	135, // 430: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	120, // 431: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	135, // 432: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	135, // 433: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	135, // 434: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	140, // 435: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	142, // 436: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	135, // 437: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	147, // 438: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	135, // 439: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	135, // 440: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	117, // 441: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	117, // 442: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	117, // 443: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	120, // 444: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	152, // 445: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	152, // 446: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	135, // 447: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	157, // 448: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	159, // 449: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	161, // 450: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	161, // 451: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	159, // 452: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	135, // 453: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	135, // 454: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	59,  // 455: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	166, // 456: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	172, // 457: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	135, // 458: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	135, // 459: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	135, // 460: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	135, // 461: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	182, // 462: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	184, // 463: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	200, // 464: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	209, // 465: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	212, // 466: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	217, // 467: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	180, // 468: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	180, // 469: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	205, // 470: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	207, // 471: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	219, // 472: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	221, // 473: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	223, // 474: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	186, // 475: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	196, // 476: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	188, // 477: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	194, // 478: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	198, // 479: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	192, // 480: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	226, // 481: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	229, // 482: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	230, // 483: gctrpc.GoCryptoTraderService.AddSyntheticOrder:output_type -> gctrpc.SyntheticOrder
+	233, // 484: gctrpc.GoCryptoTraderService.GetSyntheticOrders:output_type -> gctrpc.GetSyntheticOrdersResponse
+	135, // 485: gctrpc.GoCryptoTraderService.CancelSyntheticOrder:output_type -> gctrpc.GenericResponse
+	235, // 486: gctrpc.GoCryptoTraderService.StartExecutionAlgorithm:output_type -> gctrpc.ExecutionAlgorithm
+	135, // 487: gctrpc.GoCryptoTraderService.PauseExecutionAlgorithm:output_type -> gctrpc.GenericResponse
+	135, // 488: gctrpc.GoCryptoTraderService.ResumeExecutionAlgorithm:output_type -> gctrpc.GenericResponse
+	135, // 489: gctrpc.GoCryptoTraderService.CancelExecutionAlgorithm:output_type -> gctrpc.GenericResponse
+	239, // 490: gctrpc.GoCryptoTraderService.GetExecutionAlgorithms:output_type -> gctrpc.GetExecutionAlgorithmsResponse
+	242, // 491: gctrpc.GoCryptoTraderService.GetRiskStatus:output_type -> gctrpc.GetRiskStatusResponse
+	135, // 492: gctrpc.GoCryptoTraderService.SetKillSwitch:output_type -> gctrpc.GenericResponse
+	248, // 493: gctrpc.GoCryptoTraderService.GetConsolidatedOrderbook:output_type -> gctrpc.ConsolidatedOrderbookResponse
+	248, // 494: gctrpc.GoCryptoTraderService.GetConsolidatedOrderbookStream:output_type -> gctrpc.ConsolidatedOrderbookResponse
+	252, // 495: gctrpc.GoCryptoTraderService.SimulateConsolidatedOrder:output_type -> gctrpc.SimulateConsolidatedOrderResponse
+	252, // 496: gctrpc.GoCryptoTraderService.ConsolidatedWhaleBomb:output_type -> gctrpc.SimulateConsolidatedOrderResponse
+	256, // 497: gctrpc.GoCryptoTraderService.RouteOrder:output_type -> gctrpc.RouteOrderResponse
+	260, // 498: gctrpc.GoCryptoTraderService.GetArbitrageOpportunities:output_type -> gctrpc.GetArbitrageOpportunitiesResponse
+	259, // 499: gctrpc.GoCryptoTraderService.GetArbitrageOpportunityStream:output_type -> gctrpc.ArbitrageOpportunity
+	264, // 500: gctrpc.GoCryptoTraderService.GetLedgerPnL:output_type -> gctrpc.GetLedgerPnLResponse
+	135, // 501: gctrpc.GoCryptoTraderService.AddLedgerTransfer:output_type -> gctrpc.GenericResponse
+	268, // 502: gctrpc.GoCryptoTraderService.GetPortfolioEquityCurve:output_type -> gctrpc.GetPortfolioEquityCurveResponse
+	270, // 503: gctrpc.GoCryptoTraderService.ReloadConfig:output_type -> gctrpc.ReloadConfigResponse
+	273, // 504: gctrpc.GoCryptoTraderService.CreateRPCToken:output_type -> gctrpc.CreateRPCTokenResponse
+	275, // 505: gctrpc.GoCryptoTraderService.GetRPCTokens:output_type -> gctrpc.GetRPCTokensResponse
+	135, // 506: gctrpc.GoCryptoTraderService.RevokeRPCToken:output_type -> gctrpc.GenericResponse
+	279, // 507: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:output_type -> gctrpc.GetPendingWithdrawalsResponse
+	92,  // 508: gctrpc.GoCryptoTraderService.ApproveWithdrawal:output_type -> gctrpc.WithdrawResponse
+	135, // 509: gctrpc.GoCryptoTraderService.RejectWithdrawal:output_type -> gctrpc.GenericResponse
+	286, // 510: gctrpc.GoCryptoTraderService.GetFundingRateScan:output_type -> gctrpc.GetFundingRateScanResponse
+	288, // 511: gctrpc.GoCryptoTraderService.GetFundingOpportunityHistory:output_type -> gctrpc.GetFundingOpportunityHistoryResponse
+	368, // [368:512] is the sub-list for method output_type
+	224, // [224:368] is the sub-list for method input_type
+	224, // [224:224] is the sub-list for extension type_name
+	224, // [224:224] is the sub-list for extension extendee
+	0,   // [0:224] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +22866,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   303,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
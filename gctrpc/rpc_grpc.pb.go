@@ -134,6 +134,35 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_AddSyntheticOrder_FullMethodName                 = "/gctrpc.GoCryptoTraderService/AddSyntheticOrder"
+	GoCryptoTraderService_GetSyntheticOrders_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetSyntheticOrders"
+	GoCryptoTraderService_CancelSyntheticOrder_FullMethodName              = "/gctrpc.GoCryptoTraderService/CancelSyntheticOrder"
+	GoCryptoTraderService_StartExecutionAlgorithm_FullMethodName           = "/gctrpc.GoCryptoTraderService/StartExecutionAlgorithm"
+	GoCryptoTraderService_PauseExecutionAlgorithm_FullMethodName           = "/gctrpc.GoCryptoTraderService/PauseExecutionAlgorithm"
+	GoCryptoTraderService_ResumeExecutionAlgorithm_FullMethodName          = "/gctrpc.GoCryptoTraderService/ResumeExecutionAlgorithm"
+	GoCryptoTraderService_CancelExecutionAlgorithm_FullMethodName          = "/gctrpc.GoCryptoTraderService/CancelExecutionAlgorithm"
+	GoCryptoTraderService_GetExecutionAlgorithms_FullMethodName            = "/gctrpc.GoCryptoTraderService/GetExecutionAlgorithms"
+	GoCryptoTraderService_GetRiskStatus_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetRiskStatus"
+	GoCryptoTraderService_SetKillSwitch_FullMethodName                     = "/gctrpc.GoCryptoTraderService/SetKillSwitch"
+	GoCryptoTraderService_GetConsolidatedOrderbook_FullMethodName          = "/gctrpc.GoCryptoTraderService/GetConsolidatedOrderbook"
+	GoCryptoTraderService_GetConsolidatedOrderbookStream_FullMethodName    = "/gctrpc.GoCryptoTraderService/GetConsolidatedOrderbookStream"
+	GoCryptoTraderService_SimulateConsolidatedOrder_FullMethodName         = "/gctrpc.GoCryptoTraderService/SimulateConsolidatedOrder"
+	GoCryptoTraderService_ConsolidatedWhaleBomb_FullMethodName             = "/gctrpc.GoCryptoTraderService/ConsolidatedWhaleBomb"
+	GoCryptoTraderService_RouteOrder_FullMethodName                        = "/gctrpc.GoCryptoTraderService/RouteOrder"
+	GoCryptoTraderService_GetArbitrageOpportunities_FullMethodName         = "/gctrpc.GoCryptoTraderService/GetArbitrageOpportunities"
+	GoCryptoTraderService_GetArbitrageOpportunityStream_FullMethodName     = "/gctrpc.GoCryptoTraderService/GetArbitrageOpportunityStream"
+	GoCryptoTraderService_GetLedgerPnL_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetLedgerPnL"
+	GoCryptoTraderService_AddLedgerTransfer_FullMethodName                 = "/gctrpc.GoCryptoTraderService/AddLedgerTransfer"
+	GoCryptoTraderService_GetPortfolioEquityCurve_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetPortfolioEquityCurve"
+	GoCryptoTraderService_ReloadConfig_FullMethodName                      = "/gctrpc.GoCryptoTraderService/ReloadConfig"
+	GoCryptoTraderService_CreateRPCToken_FullMethodName                    = "/gctrpc.GoCryptoTraderService/CreateRPCToken"
+	GoCryptoTraderService_GetRPCTokens_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetRPCTokens"
+	GoCryptoTraderService_RevokeRPCToken_FullMethodName                    = "/gctrpc.GoCryptoTraderService/RevokeRPCToken"
+	GoCryptoTraderService_GetPendingWithdrawals_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPendingWithdrawals"
+	GoCryptoTraderService_ApproveWithdrawal_FullMethodName                 = "/gctrpc.GoCryptoTraderService/ApproveWithdrawal"
+	GoCryptoTraderService_RejectWithdrawal_FullMethodName                  = "/gctrpc.GoCryptoTraderService/RejectWithdrawal"
+	GoCryptoTraderService_GetFundingRateScan_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetFundingRateScan"
+	GoCryptoTraderService_GetFundingOpportunityHistory_FullMethodName      = "/gctrpc.GoCryptoTraderService/GetFundingOpportunityHistory"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -255,6 +284,35 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	AddSyntheticOrder(ctx context.Context, in *AddSyntheticOrderRequest, opts ...grpc.CallOption) (*SyntheticOrder, error)
+	GetSyntheticOrders(ctx context.Context, in *GetSyntheticOrdersRequest, opts ...grpc.CallOption) (*GetSyntheticOrdersResponse, error)
+	CancelSyntheticOrder(ctx context.Context, in *CancelSyntheticOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	StartExecutionAlgorithm(ctx context.Context, in *StartExecutionAlgorithmRequest, opts ...grpc.CallOption) (*ExecutionAlgorithm, error)
+	PauseExecutionAlgorithm(ctx context.Context, in *GenericExecutionAlgorithmRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ResumeExecutionAlgorithm(ctx context.Context, in *GenericExecutionAlgorithmRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CancelExecutionAlgorithm(ctx context.Context, in *GenericExecutionAlgorithmRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetExecutionAlgorithms(ctx context.Context, in *GetExecutionAlgorithmsRequest, opts ...grpc.CallOption) (*GetExecutionAlgorithmsResponse, error)
+	GetRiskStatus(ctx context.Context, in *GetRiskStatusRequest, opts ...grpc.CallOption) (*GetRiskStatusResponse, error)
+	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*ConsolidatedOrderbookResponse, error)
+	GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetConsolidatedOrderbookStreamClient, error)
+	SimulateConsolidatedOrder(ctx context.Context, in *SimulateConsolidatedOrderRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error)
+	ConsolidatedWhaleBomb(ctx context.Context, in *ConsolidatedWhaleBombRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error)
+	RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageOpportunityStream(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetArbitrageOpportunityStreamClient, error)
+	GetLedgerPnL(ctx context.Context, in *GetLedgerPnLRequest, opts ...grpc.CallOption) (*GetLedgerPnLResponse, error)
+	AddLedgerTransfer(ctx context.Context, in *AddLedgerTransferRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetPortfolioEquityCurve(ctx context.Context, in *GetPortfolioEquityCurveRequest, opts ...grpc.CallOption) (*GetPortfolioEquityCurveResponse, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	CreateRPCToken(ctx context.Context, in *CreateRPCTokenRequest, opts ...grpc.CallOption) (*CreateRPCTokenResponse, error)
+	GetRPCTokens(ctx context.Context, in *GetRPCTokensRequest, opts ...grpc.CallOption) (*GetRPCTokensResponse, error)
+	RevokeRPCToken(ctx context.Context, in *RevokeRPCTokenRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetFundingRateScan(ctx context.Context, in *GetFundingRateScanRequest, opts ...grpc.CallOption) (*GetFundingRateScanResponse, error)
+	GetFundingOpportunityHistory(ctx context.Context, in *GetFundingOpportunityHistoryRequest, opts ...grpc.CallOption) (*GetFundingOpportunityHistoryResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -1438,6 +1496,313 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) AddSyntheticOrder(ctx context.Context, in *AddSyntheticOrderRequest, opts ...grpc.CallOption) (*SyntheticOrder, error) {
+	out := new(SyntheticOrder)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddSyntheticOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetSyntheticOrders(ctx context.Context, in *GetSyntheticOrdersRequest, opts ...grpc.CallOption) (*GetSyntheticOrdersResponse, error) {
+	out := new(GetSyntheticOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetSyntheticOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CancelSyntheticOrder(ctx context.Context, in *CancelSyntheticOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CancelSyntheticOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) StartExecutionAlgorithm(ctx context.Context, in *StartExecutionAlgorithmRequest, opts ...grpc.CallOption) (*ExecutionAlgorithm, error) {
+	out := new(ExecutionAlgorithm)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_StartExecutionAlgorithm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) PauseExecutionAlgorithm(ctx context.Context, in *GenericExecutionAlgorithmRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_PauseExecutionAlgorithm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ResumeExecutionAlgorithm(ctx context.Context, in *GenericExecutionAlgorithmRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ResumeExecutionAlgorithm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CancelExecutionAlgorithm(ctx context.Context, in *GenericExecutionAlgorithmRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CancelExecutionAlgorithm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetExecutionAlgorithms(ctx context.Context, in *GetExecutionAlgorithmsRequest, opts ...grpc.CallOption) (*GetExecutionAlgorithmsResponse, error) {
+	out := new(GetExecutionAlgorithmsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetExecutionAlgorithms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetRiskStatus(ctx context.Context, in *GetRiskStatusRequest, opts ...grpc.CallOption) (*GetRiskStatusResponse, error) {
+	out := new(GetRiskStatusResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRiskStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SetKillSwitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*ConsolidatedOrderbookResponse, error) {
+	out := new(ConsolidatedOrderbookResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetConsolidatedOrderbook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetConsolidatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetConsolidatedOrderbookStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetConsolidatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetConsolidatedOrderbookStreamClient interface {
+	Recv() (*ConsolidatedOrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetConsolidatedOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetConsolidatedOrderbookStreamClient) Recv() (*ConsolidatedOrderbookResponse, error) {
+	m := new(ConsolidatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderServiceClient) SimulateConsolidatedOrder(ctx context.Context, in *SimulateConsolidatedOrderRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error) {
+	out := new(SimulateConsolidatedOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SimulateConsolidatedOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ConsolidatedWhaleBomb(ctx context.Context, in *ConsolidatedWhaleBombRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error) {
+	out := new(SimulateConsolidatedOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ConsolidatedWhaleBomb_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error) {
+	out := new(RouteOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RouteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetArbitrageOpportunities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetArbitrageOpportunityStream(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetArbitrageOpportunityStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetArbitrageOpportunityStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetArbitrageOpportunityStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetArbitrageOpportunityStreamClient interface {
+	Recv() (*ArbitrageOpportunity, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetArbitrageOpportunityStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetArbitrageOpportunityStreamClient) Recv() (*ArbitrageOpportunity, error) {
+	m := new(ArbitrageOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetLedgerPnL(ctx context.Context, in *GetLedgerPnLRequest, opts ...grpc.CallOption) (*GetLedgerPnLResponse, error) {
+	out := new(GetLedgerPnLResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLedgerPnL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) AddLedgerTransfer(ctx context.Context, in *AddLedgerTransferRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddLedgerTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioEquityCurve(ctx context.Context, in *GetPortfolioEquityCurveRequest, opts ...grpc.CallOption) (*GetPortfolioEquityCurveResponse, error) {
+	out := new(GetPortfolioEquityCurveResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioEquityCurve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReloadConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CreateRPCToken(ctx context.Context, in *CreateRPCTokenRequest, opts ...grpc.CallOption) (*CreateRPCTokenResponse, error) {
+	out := new(CreateRPCTokenResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CreateRPCToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetRPCTokens(ctx context.Context, in *GetRPCTokensRequest, opts ...grpc.CallOption) (*GetRPCTokensResponse, error) {
+	out := new(GetRPCTokensResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRPCTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RevokeRPCToken(ctx context.Context, in *RevokeRPCTokenRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RevokeRPCToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error) {
+	out := new(GetPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPendingWithdrawals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetFundingRateScan(ctx context.Context, in *GetFundingRateScanRequest, opts ...grpc.CallOption) (*GetFundingRateScanResponse, error) {
+	out := new(GetFundingRateScanResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetFundingRateScan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetFundingOpportunityHistory(ctx context.Context, in *GetFundingOpportunityHistoryRequest, opts ...grpc.CallOption) (*GetFundingOpportunityHistoryResponse, error) {
+	out := new(GetFundingOpportunityHistoryResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetFundingOpportunityHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility
@@ -1557,6 +1922,35 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	AddSyntheticOrder(context.Context, *AddSyntheticOrderRequest) (*SyntheticOrder, error)
+	GetSyntheticOrders(context.Context, *GetSyntheticOrdersRequest) (*GetSyntheticOrdersResponse, error)
+	CancelSyntheticOrder(context.Context, *CancelSyntheticOrderRequest) (*GenericResponse, error)
+	StartExecutionAlgorithm(context.Context, *StartExecutionAlgorithmRequest) (*ExecutionAlgorithm, error)
+	PauseExecutionAlgorithm(context.Context, *GenericExecutionAlgorithmRequest) (*GenericResponse, error)
+	ResumeExecutionAlgorithm(context.Context, *GenericExecutionAlgorithmRequest) (*GenericResponse, error)
+	CancelExecutionAlgorithm(context.Context, *GenericExecutionAlgorithmRequest) (*GenericResponse, error)
+	GetExecutionAlgorithms(context.Context, *GetExecutionAlgorithmsRequest) (*GetExecutionAlgorithmsResponse, error)
+	GetRiskStatus(context.Context, *GetRiskStatusRequest) (*GetRiskStatusResponse, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error)
+	GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*ConsolidatedOrderbookResponse, error)
+	GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookRequest, GoCryptoTraderService_GetConsolidatedOrderbookStreamServer) error
+	SimulateConsolidatedOrder(context.Context, *SimulateConsolidatedOrderRequest) (*SimulateConsolidatedOrderResponse, error)
+	ConsolidatedWhaleBomb(context.Context, *ConsolidatedWhaleBombRequest) (*SimulateConsolidatedOrderResponse, error)
+	RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error)
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageOpportunityStream(*GetArbitrageOpportunitiesRequest, GoCryptoTraderService_GetArbitrageOpportunityStreamServer) error
+	GetLedgerPnL(context.Context, *GetLedgerPnLRequest) (*GetLedgerPnLResponse, error)
+	AddLedgerTransfer(context.Context, *AddLedgerTransferRequest) (*GenericResponse, error)
+	GetPortfolioEquityCurve(context.Context, *GetPortfolioEquityCurveRequest) (*GetPortfolioEquityCurveResponse, error)
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	CreateRPCToken(context.Context, *CreateRPCTokenRequest) (*CreateRPCTokenResponse, error)
+	GetRPCTokens(context.Context, *GetRPCTokensRequest) (*GetRPCTokensResponse, error)
+	RevokeRPCToken(context.Context, *RevokeRPCTokenRequest) (*GenericResponse, error)
+	GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error)
+	GetFundingRateScan(context.Context, *GetFundingRateScanRequest) (*GetFundingRateScanResponse, error)
+	GetFundingOpportunityHistory(context.Context, *GetFundingOpportunityHistoryRequest) (*GetFundingOpportunityHistoryResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1909,6 +2303,93 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) AddSyntheticOrder(context.Context, *AddSyntheticOrderRequest) (*SyntheticOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSyntheticOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetSyntheticOrders(context.Context, *GetSyntheticOrdersRequest) (*GetSyntheticOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyntheticOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelSyntheticOrder(context.Context, *CancelSyntheticOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSyntheticOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) StartExecutionAlgorithm(context.Context, *StartExecutionAlgorithmRequest) (*ExecutionAlgorithm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartExecutionAlgorithm not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) PauseExecutionAlgorithm(context.Context, *GenericExecutionAlgorithmRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExecutionAlgorithm not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ResumeExecutionAlgorithm(context.Context, *GenericExecutionAlgorithmRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecutionAlgorithm not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelExecutionAlgorithm(context.Context, *GenericExecutionAlgorithmRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecutionAlgorithm not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExecutionAlgorithms(context.Context, *GetExecutionAlgorithmsRequest) (*GetExecutionAlgorithmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionAlgorithms not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRiskStatus(context.Context, *GetRiskStatusRequest) (*GetRiskStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiskStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*ConsolidatedOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookRequest, GoCryptoTraderService_GetConsolidatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SimulateConsolidatedOrder(context.Context, *SimulateConsolidatedOrderRequest) (*SimulateConsolidatedOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateConsolidatedOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ConsolidatedWhaleBomb(context.Context, *ConsolidatedWhaleBombRequest) (*SimulateConsolidatedOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidatedWhaleBomb not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetArbitrageOpportunityStream(*GetArbitrageOpportunitiesRequest, GoCryptoTraderService_GetArbitrageOpportunityStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunityStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLedgerPnL(context.Context, *GetLedgerPnLRequest) (*GetLedgerPnLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerPnL not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) AddLedgerTransfer(context.Context, *AddLedgerTransferRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLedgerTransfer not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioEquityCurve(context.Context, *GetPortfolioEquityCurveRequest) (*GetPortfolioEquityCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioEquityCurve not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CreateRPCToken(context.Context, *CreateRPCTokenRequest) (*CreateRPCTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRPCToken not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRPCTokens(context.Context, *GetRPCTokensRequest) (*GetRPCTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCTokens not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RevokeRPCToken(context.Context, *RevokeRPCTokenRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRPCToken not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFundingRateScan(context.Context, *GetFundingRateScanRequest) (*GetFundingRateScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRateScan not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFundingOpportunityHistory(context.Context, *GetFundingOpportunityHistoryRequest) (*GetFundingOpportunityHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingOpportunityHistory not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 
 // UnsafeGoCryptoTraderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -4010,14 +4491,542 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
-// GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gctrpc.GoCryptoTraderService",
-	HandlerType: (*GoCryptoTraderServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _GoCryptoTraderService_AddSyntheticOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSyntheticOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).AddSyntheticOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_AddSyntheticOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).AddSyntheticOrder(ctx, req.(*AddSyntheticOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetSyntheticOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyntheticOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetSyntheticOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetSyntheticOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetSyntheticOrders(ctx, req.(*GetSyntheticOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CancelSyntheticOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSyntheticOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelSyntheticOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelSyntheticOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelSyntheticOrder(ctx, req.(*CancelSyntheticOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_StartExecutionAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartExecutionAlgorithmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).StartExecutionAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_StartExecutionAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).StartExecutionAlgorithm(ctx, req.(*StartExecutionAlgorithmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_PauseExecutionAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExecutionAlgorithmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).PauseExecutionAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_PauseExecutionAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).PauseExecutionAlgorithm(ctx, req.(*GenericExecutionAlgorithmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ResumeExecutionAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExecutionAlgorithmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ResumeExecutionAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ResumeExecutionAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ResumeExecutionAlgorithm(ctx, req.(*GenericExecutionAlgorithmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CancelExecutionAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExecutionAlgorithmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelExecutionAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelExecutionAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelExecutionAlgorithm(ctx, req.(*GenericExecutionAlgorithmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetExecutionAlgorithms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionAlgorithmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetExecutionAlgorithms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetExecutionAlgorithms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetExecutionAlgorithms(ctx, req.(*GetExecutionAlgorithmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetRiskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRiskStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRiskStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRiskStatus(ctx, req.(*GetRiskStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SetKillSwitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetConsolidatedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetConsolidatedOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetConsolidatedOrderbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetConsolidatedOrderbook(ctx, req.(*GetConsolidatedOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetConsolidatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsolidatedOrderbookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetConsolidatedOrderbookStream(m, &goCryptoTraderServiceGetConsolidatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetConsolidatedOrderbookStreamServer interface {
+	Send(*ConsolidatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetConsolidatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetConsolidatedOrderbookStreamServer) Send(m *ConsolidatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_SimulateConsolidatedOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateConsolidatedOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SimulateConsolidatedOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SimulateConsolidatedOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SimulateConsolidatedOrder(ctx, req.(*SimulateConsolidatedOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ConsolidatedWhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidatedWhaleBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ConsolidatedWhaleBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ConsolidatedWhaleBomb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ConsolidatedWhaleBomb(ctx, req.(*ConsolidatedWhaleBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RouteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RouteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, req.(*RouteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetArbitrageOpportunities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetArbitrageOpportunityStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArbitrageOpportunitiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunityStream(m, &goCryptoTraderServiceGetArbitrageOpportunityStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetArbitrageOpportunityStreamServer interface {
+	Send(*ArbitrageOpportunity) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetArbitrageOpportunityStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetArbitrageOpportunityStreamServer) Send(m *ArbitrageOpportunity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetLedgerPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerPnLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetLedgerPnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetLedgerPnL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetLedgerPnL(ctx, req.(*GetLedgerPnLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_AddLedgerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLedgerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).AddLedgerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_AddLedgerTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).AddLedgerTransfer(ctx, req.(*AddLedgerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioEquityCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioEquityCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioEquityCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioEquityCurve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioEquityCurve(ctx, req.(*GetPortfolioEquityCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ReloadConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CreateRPCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRPCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CreateRPCToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CreateRPCToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CreateRPCToken(ctx, req.(*CreateRPCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetRPCTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRPCTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRPCTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRPCTokens(ctx, req.(*GetRPCTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RevokeRPCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRPCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RevokeRPCToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RevokeRPCToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RevokeRPCToken(ctx, req.(*RevokeRPCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPendingWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, req.(*GetPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, req.(*ApproveWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, req.(*RejectWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetFundingRateScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRateScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetFundingRateScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetFundingRateScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetFundingRateScan(ctx, req.(*GetFundingRateScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetFundingOpportunityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingOpportunityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetFundingOpportunityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetFundingOpportunityHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetFundingOpportunityHistory(ctx, req.(*GetFundingOpportunityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gctrpc.GoCryptoTraderService",
+	HandlerType: (*GoCryptoTraderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "GetInfo",
 			Handler:    _GoCryptoTraderService_GetInfo_Handler,
 		},
@@ -4453,6 +5462,114 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "AddSyntheticOrder",
+			Handler:    _GoCryptoTraderService_AddSyntheticOrder_Handler,
+		},
+		{
+			MethodName: "GetSyntheticOrders",
+			Handler:    _GoCryptoTraderService_GetSyntheticOrders_Handler,
+		},
+		{
+			MethodName: "CancelSyntheticOrder",
+			Handler:    _GoCryptoTraderService_CancelSyntheticOrder_Handler,
+		},
+		{
+			MethodName: "StartExecutionAlgorithm",
+			Handler:    _GoCryptoTraderService_StartExecutionAlgorithm_Handler,
+		},
+		{
+			MethodName: "PauseExecutionAlgorithm",
+			Handler:    _GoCryptoTraderService_PauseExecutionAlgorithm_Handler,
+		},
+		{
+			MethodName: "ResumeExecutionAlgorithm",
+			Handler:    _GoCryptoTraderService_ResumeExecutionAlgorithm_Handler,
+		},
+		{
+			MethodName: "CancelExecutionAlgorithm",
+			Handler:    _GoCryptoTraderService_CancelExecutionAlgorithm_Handler,
+		},
+		{
+			MethodName: "GetExecutionAlgorithms",
+			Handler:    _GoCryptoTraderService_GetExecutionAlgorithms_Handler,
+		},
+		{
+			MethodName: "GetRiskStatus",
+			Handler:    _GoCryptoTraderService_GetRiskStatus_Handler,
+		},
+		{
+			MethodName: "SetKillSwitch",
+			Handler:    _GoCryptoTraderService_SetKillSwitch_Handler,
+		},
+		{
+			MethodName: "GetConsolidatedOrderbook",
+			Handler:    _GoCryptoTraderService_GetConsolidatedOrderbook_Handler,
+		},
+		{
+			MethodName: "SimulateConsolidatedOrder",
+			Handler:    _GoCryptoTraderService_SimulateConsolidatedOrder_Handler,
+		},
+		{
+			MethodName: "ConsolidatedWhaleBomb",
+			Handler:    _GoCryptoTraderService_ConsolidatedWhaleBomb_Handler,
+		},
+		{
+			MethodName: "RouteOrder",
+			Handler:    _GoCryptoTraderService_RouteOrder_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTraderService_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetLedgerPnL",
+			Handler:    _GoCryptoTraderService_GetLedgerPnL_Handler,
+		},
+		{
+			MethodName: "AddLedgerTransfer",
+			Handler:    _GoCryptoTraderService_AddLedgerTransfer_Handler,
+		},
+		{
+			MethodName: "GetPortfolioEquityCurve",
+			Handler:    _GoCryptoTraderService_GetPortfolioEquityCurve_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _GoCryptoTraderService_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "CreateRPCToken",
+			Handler:    _GoCryptoTraderService_CreateRPCToken_Handler,
+		},
+		{
+			MethodName: "GetRPCTokens",
+			Handler:    _GoCryptoTraderService_GetRPCTokens_Handler,
+		},
+		{
+			MethodName: "RevokeRPCToken",
+			Handler:    _GoCryptoTraderService_RevokeRPCToken_Handler,
+		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _GoCryptoTraderService_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTraderService_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTraderService_RejectWithdrawal_Handler,
+		},
+		{
+			MethodName: "GetFundingRateScan",
+			Handler:    _GoCryptoTraderService_GetFundingRateScan_Handler,
+		},
+		{
+			MethodName: "GetFundingOpportunityHistory",
+			Handler:    _GoCryptoTraderService_GetFundingOpportunityHistory_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4485,6 +5602,16 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConsolidatedOrderbookStream",
+			Handler:       _GoCryptoTraderService_GetConsolidatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetArbitrageOpportunityStream",
+			Handler:       _GoCryptoTraderService_GetArbitrageOpportunityStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -111,9 +111,15 @@ func MockWsInstance[T any, PT interface {
 	b.API.AuthenticatedWebsocketSupport = true
 	err := b.API.Endpoints.SetRunningURL("RestSpotURL", s.URL)
 	require.NoError(tb, err, "Endpoints.SetRunningURL must not error for RestSpotURL")
-	for _, auth := range []bool{true, false} {
-		err = b.Websocket.SetWebsocketURL("ws"+strings.TrimPrefix(s.URL, "http"), auth, true)
-		require.NoErrorf(tb, err, "SetWebsocketURL must not error for auth: %v", auth)
+	wsURL := "ws" + strings.TrimPrefix(s.URL, "http")
+	if b.Websocket.IsMultiConnectionManaged() {
+		err = b.Websocket.SetConnectionURLs(wsURL)
+		require.NoError(tb, err, "SetConnectionURLs must not error")
+	} else {
+		for _, auth := range []bool{true, false} {
+			err = b.Websocket.SetWebsocketURL(wsURL, auth, true)
+			require.NoErrorf(tb, err, "SetWebsocketURL must not error for auth: %v", auth)
+		}
 	}
 
 	// For testing we never want to use the default subscriptions; Tests of GenerateSubscriptions should be exercising it directly
@@ -127,6 +133,21 @@ func MockWsInstance[T any, PT interface {
 	return e
 }
 
+// MockWsReplayInstance creates a new Exchange instance connected to a server replaying a websocket recording
+// Recordings are captured from a live exchange with websocket.Manager.StartRecording
+// Inbound frames are sent as soon as the connection is made unless cfg requests timing or awaiting outbound messages
+func MockWsReplayInstance[T any, PT interface {
+	*T
+	exchange.IBotExchange
+}](tb testing.TB, recordingPath string, cfg *mock.WebsocketReplayConfig) *T {
+	tb.Helper()
+
+	frames, err := mock.LoadWebsocketRecording(recordingPath)
+	require.NoErrorf(tb, err, "LoadWebsocketRecording must not error for %q", recordingPath)
+
+	return MockWsInstance[T, PT](tb, mock.NewWebsocketReplayHandler(frames, cfg))
+}
+
 // FixtureError contains an error and the message that caused it
 type FixtureError struct {
 	Err error
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
 )
@@ -35,3 +36,9 @@ func TestMockWsInstance(t *testing.T) {
 	b := MockWsInstance[binance.Exchange](t, mockws.CurryWsMockUpgrader(t, func(_ testing.TB, _ []byte, _ *gws.Conn) error { return nil }))
 	require.NotNil(t, b, "MockWsInstance must not be nil")
 }
+
+// TestMockWsReplayInstance exercises MockWsReplayInstance
+func TestMockWsReplayInstance(t *testing.T) {
+	b := MockWsReplayInstance[binance.Exchange](t, "testdata/ws_replay.json", &mock.WebsocketReplayConfig{AwaitOutbound: true})
+	require.NotNil(t, b, "MockWsReplayInstance must not be nil")
+}
@@ -60,6 +60,16 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableSyntheticOrderManager, "syntheticordermanager", false, "enables the synthetic order manager which emulates OCO, bracket and trailing stop orders")
+	flag.BoolVar(&settings.EnableExecutionAlgorithmManager, "executionalgorithmmanager", false, "enables the execution algorithm manager which works parent orders with TWAP, VWAP, iceberg and POV algorithms")
+	flag.BoolVar(&settings.EnableTickRecorder, "tickrecorder", false, "enables the tick recorder which writes websocket orderbook and trade updates to disk for backtesting")
+	flag.BoolVar(&settings.EnablePaperTrading, "papertrading", false, "wraps loaded exchanges in a paper trading simulator which fills orders against live orderbooks using simulated balances")
+	flag.BoolVar(&settings.EnableArbitrageManager, "arbitragemanager", false, "enables the arbitrage manager which scans orderbooks for cross-exchange and triangular arbitrage opportunities")
+	flag.BoolVar(&settings.EnableLedgerManager, "ledgermanager", false, "enables the ledger manager which tracks the cost basis and realised and unrealised PnL of spot holdings")
+	flag.BoolVar(&settings.EnableConfigReloader, "configreloader", false, "enables the config reloader which applies changes made to the config file without a restart")
+	flag.BoolVar(&settings.EnableTransferManager, "transfermanager", false, "enables the transfer manager which tracks the lifecycle of withdrawals and deposits")
+	flag.BoolVar(&settings.EnableFundingRateScanner, "fundingratescanner", false, "enables the funding rate scanner which ranks perpetual funding rate carry opportunities across exchanges")
+	flag.BoolVar(&settings.EnableMetricsManager, "metricsmanager", false, "enables the metrics manager which serves engine, request and websocket metrics in the Prometheus text format")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
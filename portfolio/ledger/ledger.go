@@ -0,0 +1,347 @@
+package ledger
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// dustAmount is the remaining lot amount below which a partially matched lot
+// is considered fully disposed of
+const dustAmount = 1e-12
+
+// New returns a ledger which reports PnL in the supplied fiat currency
+func New(method Method, fiat currency.Code) (*Ledger, error) {
+	if method > AverageCost {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMethod, method)
+	}
+	if !fiat.IsFiatCurrency() {
+		return nil, fmt.Errorf("%w: %q", errNotFiatCurrency, fiat)
+	}
+	return &Ledger{
+		method:   method,
+		fiat:     fiat,
+		holdings: make(map[*currency.Item]*holding),
+		entries:  make(map[string]struct{}),
+	}, nil
+}
+
+// String implements the stringer interface
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "FIFO"
+	case LIFO:
+		return "LIFO"
+	case AverageCost:
+		return "AVERAGE_COST"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// StringToMethod converts a string to a lot matching method
+func StringToMethod(s string) (Method, error) {
+	switch strings.ToUpper(s) {
+	case "FIFO":
+		return FIFO, nil
+	case "LIFO":
+		return LIFO, nil
+	case "AVERAGE_COST", "AVERAGECOST", "AVERAGE":
+		return AverageCost, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMethod, s)
+	}
+}
+
+// Process records an entry against the ledger, matching any disposals
+// against the lots held with the ledger's method
+func (l *Ledger) Process(e *Entry) error {
+	if err := e.validate(); err != nil {
+		return err
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if _, ok := l.entries[e.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateEntry, e.ID)
+	}
+	switch e.Type {
+	case Trade:
+		if err := l.processTrade(e); err != nil {
+			return err
+		}
+	case Deposit:
+		amount := e.Amount
+		if e.FeeCurrency.IsEmpty() || e.FeeCurrency.Equal(e.Currency) {
+			if e.Fee >= amount {
+				return fmt.Errorf("%w: %v fee on %v %s", errFeeExceedsAmount, e.Fee, amount, e.Currency)
+			}
+			amount -= e.Fee
+		} else {
+			l.dispose(e.FeeCurrency, e.Fee, 0)
+		}
+		l.acquire(e.Currency, amount, e.Amount*e.Price, e)
+	case Withdrawal:
+		l.getHolding(e.Currency).match(e.Amount, l.method)
+		feeCurrency := e.FeeCurrency
+		if feeCurrency.IsEmpty() {
+			feeCurrency = e.Currency
+		}
+		l.dispose(feeCurrency, e.Fee, 0)
+	}
+	l.entries[e.ID] = struct{}{}
+	return nil
+}
+
+// processTrade acquires the currency bought and disposes of the currency
+// sold, valuing both legs at the fiat value of the quote currency
+func (l *Ledger) processTrade(e *Entry) error {
+	base, quote := e.Pair.Base, e.Pair.Quote
+	feeCurrency := e.FeeCurrency
+	if feeCurrency.IsEmpty() {
+		feeCurrency = quote
+	}
+	notional := e.Amount * e.Price
+	if e.Side.IsLong() {
+		acquired, spent := e.Amount, notional
+		switch {
+		case feeCurrency.Equal(quote):
+			spent += e.Fee
+		case feeCurrency.Equal(base):
+			if e.Fee >= acquired {
+				return fmt.Errorf("%w: %v fee on %v %s", errFeeExceedsAmount, e.Fee, acquired, base)
+			}
+			acquired -= e.Fee
+		default:
+			l.dispose(feeCurrency, e.Fee, 0)
+		}
+		cost := spent * e.FiatRate
+		l.dispose(quote, spent, cost)
+		l.acquire(base, acquired, cost, e)
+		return nil
+	}
+
+	disposed, received := e.Amount, notional
+	switch {
+	case feeCurrency.Equal(quote):
+		if e.Fee >= received {
+			return fmt.Errorf("%w: %v fee on %v %s", errFeeExceedsAmount, e.Fee, received, quote)
+		}
+		received -= e.Fee
+	case feeCurrency.Equal(base):
+		disposed += e.Fee
+	default:
+		l.dispose(feeCurrency, e.Fee, 0)
+	}
+	proceeds := received * e.FiatRate
+	l.dispose(base, disposed, proceeds)
+	l.acquire(quote, received, proceeds, e)
+	return nil
+}
+
+// acquire adds a lot of a currency at the supplied fiat cost
+func (l *Ledger) acquire(c currency.Code, amount, cost float64, e *Entry) {
+	if c.IsFiatCurrency() || amount <= 0 {
+		return
+	}
+	h := l.getHolding(c)
+	if l.method == AverageCost && len(h.lots) > 0 {
+		h.lots[0].Amount += amount
+		h.lots[0].Cost += cost
+		if h.lots[0].Exchange != e.Exchange {
+			h.lots[0].Exchange = ""
+		}
+		return
+	}
+	h.lots = append(h.lots, Lot{
+		Exchange: e.Exchange,
+		Amount:   amount,
+		Cost:     cost,
+		Acquired: e.Time,
+	})
+}
+
+// dispose matches an amount of a currency against its lots and realises the
+// difference between the proceeds and the cost of the lots matched. Any
+// amount without lots to match against is treated as having a cost equal to
+// its share of the proceeds
+func (l *Ledger) dispose(c currency.Code, amount, proceeds float64) {
+	if c.IsFiatCurrency() || amount <= 0 {
+		return
+	}
+	h := l.getHolding(c)
+	cost, matched := h.match(amount, l.method)
+	if unmatched := amount - matched; unmatched > dustAmount {
+		cost += proceeds * unmatched / amount
+		h.unmatched += unmatched
+	}
+	h.realised += proceeds - cost
+	h.disposed += amount
+}
+
+// getHolding returns the holding of a currency, creating it when required
+func (l *Ledger) getHolding(c currency.Code) *holding {
+	h, ok := l.holdings[c.Item]
+	if !ok {
+		h = &holding{currency: c}
+		l.holdings[c.Item] = h
+	}
+	return h
+}
+
+// match removes up to amount from the lots held and returns the cost and
+// amount of the lots removed
+func (h *holding) match(amount float64, method Method) (cost, matched float64) {
+	for amount > dustAmount && len(h.lots) > 0 {
+		i := 0
+		if method == LIFO {
+			i = len(h.lots) - 1
+		}
+		lot := &h.lots[i]
+		if lot.Amount-amount <= dustAmount {
+			cost += lot.Cost
+			matched += lot.Amount
+			amount -= lot.Amount
+			h.lots = slices.Delete(h.lots, i, i+1)
+			continue
+		}
+		portion := lot.Cost * amount / lot.Amount
+		lot.Cost -= portion
+		lot.Amount -= amount
+		cost += portion
+		matched += amount
+		amount = 0
+	}
+	return cost, matched
+}
+
+// snapshot returns a copy of the holding
+func (h *holding) snapshot() Holding {
+	s := Holding{
+		Currency:  h.currency,
+		Lots:      slices.Clone(h.lots),
+		Realised:  h.realised,
+		Disposed:  h.disposed,
+		Unmatched: h.unmatched,
+	}
+	for i := range h.lots {
+		s.Amount += h.lots[i].Amount
+		s.CostBasis += h.lots[i].Cost
+	}
+	return s
+}
+
+// GetHoldings returns a snapshot of every currency tracked by the ledger,
+// ordered by currency. Supplying currencies limits the holdings returned
+func (l *Ledger) GetHoldings(codes ...currency.Code) []Holding {
+	l.m.RLock()
+	defer l.m.RUnlock()
+	resp := make([]Holding, 0, len(l.holdings))
+	for _, h := range l.holdings {
+		if len(codes) > 0 && !slices.ContainsFunc(codes, h.currency.Equal) {
+			continue
+		}
+		resp = append(resp, h.snapshot())
+	}
+	slices.SortFunc(resp, func(a, b Holding) int {
+		return strings.Compare(a.Currency.String(), b.Currency.String())
+	})
+	return resp
+}
+
+// GetPnL returns the realised and unrealised PnL of each currency tracked by
+// the ledger and their totals. Unrealised PnL is calculated against the
+// market prices returned by price. Supplying currencies limits the assets
+// returned
+func (l *Ledger) GetPnL(price PriceFunc, codes ...currency.Code) (*PnL, error) {
+	if price == nil {
+		return nil, errNilPriceFunc
+	}
+	holdings := l.GetHoldings(codes...)
+	resp := &PnL{
+		Fiat:   l.fiat,
+		Method: l.method,
+		Assets: make([]AssetPnL, len(holdings)),
+	}
+	for i := range holdings {
+		a := AssetPnL{Holding: holdings[i]}
+		if a.Amount > 0 {
+			a.AverageCost = a.CostBasis / a.Amount
+			a.MarketPrice, a.Err = price(a.Currency)
+			if a.Err == nil {
+				a.MarketValue = a.Amount * a.MarketPrice
+				a.Unrealised = a.MarketValue - a.CostBasis
+			}
+		}
+		a.Total = a.Realised + a.Unrealised
+		resp.Assets[i] = a
+		resp.CostBasis += a.CostBasis
+		resp.MarketValue += a.MarketValue
+		resp.Realised += a.Realised
+		resp.Unrealised += a.Unrealised
+	}
+	resp.Total = resp.Realised + resp.Unrealised
+	return resp, nil
+}
+
+// GetFiat returns the fiat currency the ledger reports PnL in
+func (l *Ledger) GetFiat() currency.Code {
+	return l.fiat
+}
+
+// GetMethod returns the lot matching method of the ledger
+func (l *Ledger) GetMethod() Method {
+	return l.method
+}
+
+// validate checks an entry has the fields its type requires
+func (e *Entry) validate() error {
+	if e == nil {
+		return errNilEntry
+	}
+	if e.ID == "" {
+		return errEntryIDUnset
+	}
+	if e.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if e.Time.IsZero() {
+		return errTimeUnset
+	}
+	if e.Amount <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidAmount, e.Amount)
+	}
+	if e.Fee < 0 {
+		return fmt.Errorf("%w: %v", errInvalidFee, e.Fee)
+	}
+	switch e.Type {
+	case Trade:
+		if e.Pair.IsEmpty() {
+			return currency.ErrCurrencyPairEmpty
+		}
+		if !e.Side.IsLong() && !e.Side.IsShort() {
+			return fmt.Errorf("%w: %s", errInvalidSide, e.Side)
+		}
+		if e.Price <= 0 {
+			return fmt.Errorf("%w: %v", errInvalidPrice, e.Price)
+		}
+		if e.FiatRate <= 0 {
+			return fmt.Errorf("%w: %v", errInvalidFiatRate, e.FiatRate)
+		}
+	case Deposit, Withdrawal:
+		if e.Currency.IsEmpty() {
+			return errCurrencyUnset
+		}
+		if e.Currency.IsFiatCurrency() {
+			return fmt.Errorf("%w: %s", errFiatNotTracked, e.Currency)
+		}
+		if e.Type == Deposit && e.Price <= 0 {
+			return fmt.Errorf("%w: %v", errInvalidPrice, e.Price)
+		}
+	default:
+		return fmt.Errorf("%w: %q", errInvalidEntryType, e.Type)
+	}
+	return nil
+}
@@ -0,0 +1,308 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var errTestNoPrice = errors.New("no price")
+
+// testTrade returns a BTC-USD trade entry
+func testTrade(id string, side order.Side, amount, price float64) *Entry {
+	return &Entry{
+		ID:       id,
+		Exchange: "test",
+		Type:     Trade,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Side:     side,
+		Amount:   amount,
+		Price:    price,
+		FiatRate: 1,
+		Time:     time.Now(),
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(AverageCost+1, currency.USD)
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+	_, err = New(FIFO, currency.BTC)
+	assert.ErrorIs(t, err, errNotFiatCurrency)
+	l, err := New(LIFO, currency.AUD)
+	require.NoError(t, err, "New must not error")
+	assert.Equal(t, LIFO, l.GetMethod())
+	assert.Equal(t, currency.AUD, l.GetFiat())
+}
+
+func TestStringToMethod(t *testing.T) {
+	t.Parallel()
+	for _, m := range []Method{FIFO, LIFO, AverageCost} {
+		resp, err := StringToMethod(m.String())
+		require.NoError(t, err, "StringToMethod must not error")
+		assert.Equal(t, m, resp)
+	}
+	resp, err := StringToMethod("average")
+	require.NoError(t, err, "StringToMethod must not error")
+	assert.Equal(t, AverageCost, resp)
+	_, err = StringToMethod("hifo")
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+	assert.Equal(t, "UNKNOWN", Method(99).String())
+}
+
+func TestProcessValidation(t *testing.T) {
+	t.Parallel()
+	l, err := New(FIFO, currency.USD)
+	require.NoError(t, err, "New must not error")
+
+	assert.ErrorIs(t, l.Process(nil), errNilEntry)
+
+	e := testTrade("", order.Buy, 1, 100)
+	assert.ErrorIs(t, l.Process(e), errEntryIDUnset)
+	e = testTrade("1", order.Buy, 1, 100)
+	e.Exchange = ""
+	assert.ErrorIs(t, l.Process(e), errExchangeNameUnset)
+	e = testTrade("1", order.Buy, 1, 100)
+	e.Time = time.Time{}
+	assert.ErrorIs(t, l.Process(e), errTimeUnset)
+	assert.ErrorIs(t, l.Process(testTrade("1", order.Buy, 0, 100)), errInvalidAmount)
+	e = testTrade("1", order.Buy, 1, 100)
+	e.Fee = -1
+	assert.ErrorIs(t, l.Process(e), errInvalidFee)
+	e = testTrade("1", order.Buy, 1, 100)
+	e.Pair = currency.EMPTYPAIR
+	assert.ErrorIs(t, l.Process(e), currency.ErrCurrencyPairEmpty)
+	assert.ErrorIs(t, l.Process(testTrade("1", order.AnySide, 1, 100)), errInvalidSide)
+	assert.ErrorIs(t, l.Process(testTrade("1", order.Buy, 1, 0)), errInvalidPrice)
+	e = testTrade("1", order.Buy, 1, 100)
+	e.FiatRate = 0
+	assert.ErrorIs(t, l.Process(e), errInvalidFiatRate)
+	e = testTrade("1", order.Buy, 1, 100)
+	e.Type = "LOAN"
+	assert.ErrorIs(t, l.Process(e), errInvalidEntryType)
+
+	e = &Entry{ID: "1", Exchange: "test", Type: Deposit, Amount: 1, Price: 1, Time: time.Now()}
+	assert.ErrorIs(t, l.Process(e), errCurrencyUnset)
+	e.Currency = currency.EUR
+	assert.ErrorIs(t, l.Process(e), errFiatNotTracked)
+	e.Currency = currency.BTC
+	e.Price = 0
+	assert.ErrorIs(t, l.Process(e), errInvalidPrice)
+	e.Price = 1
+	e.Fee = 1
+	assert.ErrorIs(t, l.Process(e), errFeeExceedsAmount)
+
+	e = testTrade("1", order.Buy, 1, 100)
+	e.FeeCurrency = currency.BTC
+	e.Fee = 1
+	assert.ErrorIs(t, l.Process(e), errFeeExceedsAmount)
+	e = testTrade("1", order.Sell, 1, 100)
+	e.Fee = 100
+	assert.ErrorIs(t, l.Process(e), errFeeExceedsAmount)
+	assert.Empty(t, l.GetHoldings(), "rejected entries should not change the ledger")
+
+	require.NoError(t, l.Process(testTrade("1", order.Buy, 1, 100)), "Process must not error")
+	assert.ErrorIs(t, l.Process(testTrade("1", order.Buy, 1, 100)), ErrDuplicateEntry)
+}
+
+func TestLotMatching(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		method    Method
+		realised  float64
+		costBasis float64
+		lots      int
+	}{
+		{method: FIFO, realised: 300, costBasis: 250, lots: 1},
+		{method: LIFO, realised: 150, costBasis: 100, lots: 1},
+		{method: AverageCost, realised: 600 - 1100.0/3, costBasis: 550.0 / 3, lots: 1},
+	} {
+		t.Run(tc.method.String(), func(t *testing.T) {
+			t.Parallel()
+			l, err := New(tc.method, currency.USD)
+			require.NoError(t, err, "New must not error")
+			require.NoError(t, l.Process(testTrade("1", order.Buy, 1, 100)), "Process must not error")
+			require.NoError(t, l.Process(testTrade("2", order.Bid, 1, 200)), "Process must not error")
+			require.NoError(t, l.Process(testTrade("3", order.Buy, 1, 250)), "Process must not error")
+			require.NoError(t, l.Process(testTrade("4", order.Sell, 2, 300)), "Process must not error")
+
+			h := l.GetHoldings()
+			require.Len(t, h, 1, "fiat quote currencies should not be tracked")
+			assert.Equal(t, currency.BTC, h[0].Currency)
+			assert.InDelta(t, 1, h[0].Amount, 1e-9)
+			assert.InDelta(t, tc.costBasis, h[0].CostBasis, 1e-9)
+			assert.InDelta(t, tc.realised, h[0].Realised, 1e-9)
+			assert.InDelta(t, 2, h[0].Disposed, 1e-9)
+			assert.Zero(t, h[0].Unmatched)
+			assert.Len(t, h[0].Lots, tc.lots)
+		})
+	}
+}
+
+func TestProcessTradeFees(t *testing.T) {
+	t.Parallel()
+	l, err := New(FIFO, currency.USD)
+	require.NoError(t, err, "New must not error")
+
+	e := testTrade("1", order.Buy, 1, 100)
+	e.Fee = 1
+	require.NoError(t, l.Process(e), "Process must not error")
+	e = testTrade("2", order.Buy, 1, 100)
+	e.Fee = 0.01
+	e.FeeCurrency = currency.BTC
+	require.NoError(t, l.Process(e), "Process must not error")
+	h := l.GetHoldings(currency.BTC)
+	require.Len(t, h, 1)
+	assert.InDelta(t, 1.99, h[0].Amount, 1e-9, "base fees should reduce the amount acquired")
+	assert.InDelta(t, 201, h[0].CostBasis, 1e-9, "quote fees should be added to the cost")
+
+	e = testTrade("3", order.Sell, 0.5, 110)
+	e.Fee = 5
+	require.NoError(t, l.Process(e), "Process must not error")
+	h = l.GetHoldings(currency.BTC)
+	assert.InDelta(t, 50-50.5, h[0].Realised, 1e-9, "quote fees should reduce the proceeds")
+
+	require.NoError(t, l.Process(&Entry{
+		ID:       "4",
+		Exchange: "test",
+		Type:     Deposit,
+		Currency: currency.BNB,
+		Amount:   1,
+		Price:    10,
+		Time:     time.Now(),
+	}), "Process must not error")
+	e = testTrade("5", order.Sell, 0.49, 110)
+	e.Fee = 0.1
+	e.FeeCurrency = currency.BNB
+	require.NoError(t, l.Process(e), "Process must not error")
+	h = l.GetHoldings(currency.BNB)
+	require.Len(t, h, 1)
+	assert.InDelta(t, 0.9, h[0].Amount, 1e-9)
+	assert.InDelta(t, -1, h[0].Realised, 1e-9, "fees in other currencies should be realised at their cost")
+}
+
+func TestProcessCryptoQuote(t *testing.T) {
+	t.Parallel()
+	l, err := New(FIFO, currency.USD)
+	require.NoError(t, err, "New must not error")
+	require.NoError(t, l.Process(testTrade("1", order.Buy, 1, 100)), "Process must not error")
+
+	// Buy 10 ETH with 0.5 BTC when BTC is worth 300 USD
+	require.NoError(t, l.Process(&Entry{
+		ID:       "2",
+		Exchange: "test",
+		Type:     Trade,
+		Pair:     currency.NewPair(currency.ETH, currency.BTC),
+		Side:     order.Buy,
+		Amount:   10,
+		Price:    0.05,
+		FiatRate: 300,
+		Time:     time.Now(),
+	}), "Process must not error")
+	h := l.GetHoldings()
+	require.Len(t, h, 2)
+	assert.Equal(t, currency.BTC, h[0].Currency)
+	assert.InDelta(t, 0.5, h[0].Amount, 1e-9)
+	assert.InDelta(t, 100, h[0].Realised, 1e-9, "spending the quote should realise its PnL")
+	assert.Equal(t, currency.ETH, h[1].Currency)
+	assert.InDelta(t, 150, h[1].CostBasis, 1e-9, "the base should be acquired at the fiat value of the quote spent")
+
+	// Sell 20 ETH, of which 10 is unmatched
+	require.NoError(t, l.Process(&Entry{
+		ID:       "3",
+		Exchange: "test",
+		Type:     Trade,
+		Pair:     currency.NewPair(currency.ETH, currency.BTC),
+		Side:     order.Sell,
+		Amount:   20,
+		Price:    0.05,
+		FiatRate: 400,
+		Time:     time.Now(),
+	}), "Process must not error")
+	h = l.GetHoldings()
+	assert.InDelta(t, 1.5, h[0].Amount, 1e-9)
+	assert.InDelta(t, 450, h[0].CostBasis, 1e-9, "the quote received should be acquired at the fiat value of the proceeds")
+	assert.InDelta(t, 50, h[1].Realised, 1e-9, "unmatched disposals should not realise PnL")
+	assert.InDelta(t, 10, h[1].Unmatched, 1e-9)
+	assert.Zero(t, h[1].Amount)
+}
+
+func TestProcessTransfers(t *testing.T) {
+	t.Parallel()
+	l, err := New(FIFO, currency.USD)
+	require.NoError(t, err, "New must not error")
+	require.NoError(t, l.Process(&Entry{
+		ID:       "1",
+		Exchange: "test",
+		Type:     Deposit,
+		Currency: currency.BTC,
+		Amount:   2,
+		Price:    100,
+		Fee:      0.5,
+		Time:     time.Now(),
+	}), "Process must not error")
+	h := l.GetHoldings()
+	require.Len(t, h, 1)
+	assert.InDelta(t, 1.5, h[0].Amount, 1e-9)
+	assert.InDelta(t, 200, h[0].CostBasis, 1e-9)
+
+	require.NoError(t, l.Process(&Entry{
+		ID:       "2",
+		Exchange: "test",
+		Type:     Withdrawal,
+		Currency: currency.BTC,
+		Amount:   0.6,
+		Fee:      0.15,
+		Time:     time.Now(),
+	}), "Process must not error")
+	h = l.GetHoldings()
+	assert.InDelta(t, 0.75, h[0].Amount, 1e-9)
+	assert.InDelta(t, 100, h[0].CostBasis, 1e-9)
+	assert.InDelta(t, -20, h[0].Realised, 1e-9, "withdrawal fees should be realised at their cost")
+}
+
+func TestGetPnL(t *testing.T) {
+	t.Parallel()
+	l, err := New(FIFO, currency.USD)
+	require.NoError(t, err, "New must not error")
+	_, err = l.GetPnL(nil)
+	assert.ErrorIs(t, err, errNilPriceFunc)
+
+	require.NoError(t, l.Process(testTrade("1", order.Buy, 2, 100)), "Process must not error")
+	require.NoError(t, l.Process(testTrade("2", order.Sell, 1, 150)), "Process must not error")
+	e := testTrade("3", order.Buy, 1, 10)
+	e.Pair = currency.NewPair(currency.ETH, currency.USD)
+	require.NoError(t, l.Process(e), "Process must not error")
+
+	price := func(c currency.Code) (float64, error) {
+		if c.Equal(currency.BTC) {
+			return 120, nil
+		}
+		return 0, errTestNoPrice
+	}
+	pnl, err := l.GetPnL(price)
+	require.NoError(t, err, "GetPnL must not error")
+	assert.Equal(t, currency.USD, pnl.Fiat)
+	assert.Equal(t, FIFO, pnl.Method)
+	require.Len(t, pnl.Assets, 2)
+	btc := pnl.Assets[0]
+	assert.InDelta(t, 100, btc.AverageCost, 1e-9)
+	assert.InDelta(t, 120, btc.MarketValue, 1e-9)
+	assert.InDelta(t, 50, btc.Realised, 1e-9)
+	assert.InDelta(t, 20, btc.Unrealised, 1e-9)
+	assert.InDelta(t, 70, btc.Total, 1e-9)
+	assert.ErrorIs(t, pnl.Assets[1].Err, errTestNoPrice)
+	assert.Zero(t, pnl.Assets[1].Unrealised)
+	assert.InDelta(t, 110, pnl.CostBasis, 1e-9)
+	assert.InDelta(t, 70, pnl.Total, 1e-9)
+
+	pnl, err = l.GetPnL(price, currency.ETH)
+	require.NoError(t, err, "GetPnL must not error")
+	require.Len(t, pnl.Assets, 1)
+	assert.Equal(t, currency.ETH, pnl.Assets[0].Currency)
+}
@@ -0,0 +1,163 @@
+package ledger
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Lot matching methods
+const (
+	// FIFO matches disposals against the oldest acquired lots first
+	FIFO Method = iota
+	// LIFO matches disposals against the most recently acquired lots first
+	LIFO
+	// AverageCost pools all acquisitions of a currency into a single lot at
+	// their weighted average cost
+	AverageCost
+)
+
+// Entry types
+const (
+	// Trade is a spot order fill which acquires one currency of a pair and
+	// disposes of the other
+	Trade EntryType = "TRADE"
+	// Deposit acquires a currency at the price supplied
+	Deposit EntryType = "DEPOSIT"
+	// Withdrawal removes a currency from the ledger without realising PnL,
+	// other than on its fee
+	Withdrawal EntryType = "WITHDRAWAL"
+)
+
+var (
+	// ErrDuplicateEntry is returned when an entry ID has already been
+	// processed
+	ErrDuplicateEntry = errors.New("duplicate ledger entry")
+	// ErrInvalidMethod is returned when a lot matching method is not
+	// recognised
+	ErrInvalidMethod = errors.New("invalid lot matching method")
+
+	errNilEntry          = errors.New("nil ledger entry")
+	errEntryIDUnset      = errors.New("ledger entry ID unset")
+	errExchangeNameUnset = errors.New("exchange name unset")
+	errInvalidEntryType  = errors.New("invalid ledger entry type")
+	errInvalidAmount     = errors.New("amount must be greater than zero")
+	errInvalidPrice      = errors.New("price must be greater than zero")
+	errInvalidFiatRate   = errors.New("fiat rate must be greater than zero")
+	errInvalidFee        = errors.New("fee cannot be negative")
+	errInvalidSide       = errors.New("trade side must be buy or sell")
+	errFeeExceedsAmount  = errors.New("fee exceeds amount")
+	errNotFiatCurrency   = errors.New("ledger currency must be a fiat currency")
+	errNilPriceFunc      = errors.New("nil price function")
+	errTimeUnset         = errors.New("entry time unset")
+	errCurrencyUnset     = errors.New("currency unset")
+	errFiatNotTracked    = errors.New("fiat currencies are not tracked by the ledger")
+)
+
+// Method defines how disposals are matched against acquired lots
+type Method uint8
+
+// EntryType defines the kind of transaction recorded in the ledger
+type EntryType string
+
+// PriceFunc returns the value of a single unit of a currency in the ledger
+// fiat currency
+type PriceFunc func(currency.Code) (float64, error)
+
+// Ledger tracks the cost basis of spot holdings as lots and accumulates the
+// PnL realised when they are disposed of. Holdings are pooled across
+// exchanges and fiat currencies are not tracked
+type Ledger struct {
+	m        sync.RWMutex
+	method   Method
+	fiat     currency.Code
+	holdings map[*currency.Item]*holding
+	entries  map[string]struct{}
+}
+
+// Entry is a transaction recorded in the ledger
+type Entry struct {
+	ID       string
+	Exchange string
+	Type     EntryType
+	// Pair and Side are set for trades and Currency for deposits and
+	// withdrawals
+	Pair     currency.Pair
+	Side     order.Side
+	Currency currency.Code
+	OrderID  string
+	TradeID  string
+	// Amount is the base amount of a trade or the amount transferred
+	Amount float64
+	// Price is the quote price of a trade or the fiat value of a single unit
+	// of a deposit
+	Price float64
+	// Fee is charged in FeeCurrency, which defaults to the quote of a trade
+	// or the currency transferred
+	Fee         float64
+	FeeCurrency currency.Code
+	// FiatRate is the fiat value of a single unit of the quote currency of a
+	// trade at the time it was filled
+	FiatRate float64
+	Time     time.Time
+}
+
+// Lot is an acquired amount of a currency which has not yet been disposed of
+type Lot struct {
+	Exchange string
+	Amount   float64
+	// Cost is the total fiat cost of the lot
+	Cost     float64
+	Acquired time.Time
+}
+
+// holding tracks the lots and realised PnL of a single currency
+type holding struct {
+	currency  currency.Code
+	lots      []Lot
+	realised  float64
+	disposed  float64
+	unmatched float64
+}
+
+// Holding is a snapshot of the lots held for a currency
+type Holding struct {
+	Currency currency.Code
+	Amount   float64
+	// CostBasis is the total fiat cost of the lots held
+	CostBasis float64
+	Lots      []Lot
+	Realised  float64
+	// Disposed is the total amount disposed of, of which Unmatched had no
+	// tracked lots to match against
+	Disposed  float64
+	Unmatched float64
+}
+
+// AssetPnL is the realised and unrealised PnL of a currency
+type AssetPnL struct {
+	Holding
+	AverageCost float64
+	MarketPrice float64
+	MarketValue float64
+	Unrealised  float64
+	Total       float64
+	// Err is set when the market price of the currency could not be
+	// determined, in which case unrealised PnL is not calculated
+	Err error
+}
+
+// PnL is the realised and unrealised PnL of the ledger in its fiat currency
+type PnL struct {
+	Fiat        currency.Code
+	Method      Method
+	Assets      []AssetPnL
+	CostBasis   float64
+	MarketValue float64
+	Realised    float64
+	Unrealised  float64
+	Total       float64
+}
@@ -574,6 +574,33 @@ func (b *Base) IsWhiteListed(address string) bool {
 	})
 }
 
+// WhiteListedAt returns when an address was whitelisted for withdraw
+// transfers, or a zero time if it is not whitelisted or the time is unknown
+func (b *Base) WhiteListedAt(address string) time.Time {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	for i := range b.Addresses {
+		if b.Addresses[i].Address == address && b.Addresses[i].WhiteListed {
+			return b.Addresses[i].WhiteListedAt
+		}
+	}
+	return time.Time{}
+}
+
+// SetWhiteListedTimes sets the whitelisted time of whitelisted addresses which
+// do not have one
+func (b *Base) SetWhiteListedTimes(t time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for i := range b.Addresses {
+		if b.Addresses[i].WhiteListed && b.Addresses[i].WhiteListedAt.IsZero() {
+			b.Addresses[i].WhiteListedAt = t
+		}
+	}
+}
+
 // GetProvider returns a provider by name
 func (p providers) GetProvider(name string) (provider, bool) {
 	for _, provider := range p {
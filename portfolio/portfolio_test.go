@@ -394,3 +394,48 @@ func TestGetProvider(t *testing.T) {
 	assert.False(t, ok, "GetProvider should return false for non-existent provider")
 	assert.Equal(t, provider{}, p, "GetProvider should return an empty provider for non-existent provider")
 }
+
+func TestWhiteListedAt(t *testing.T) {
+	t.Parallel()
+	whiteListedAt := time.Now().Add(-time.Hour)
+	b := Base{
+		Addresses: []Address{
+			{
+				Address:       core.BitcoinDonationAddress,
+				WhiteListed:   true,
+				WhiteListedAt: whiteListedAt,
+			},
+			{
+				Address: testBTCAddress,
+			},
+		},
+	}
+	assert.Equal(t, whiteListedAt, b.WhiteListedAt(core.BitcoinDonationAddress), "WhiteListedAt should return the whitelisted time")
+	assert.Zero(t, b.WhiteListedAt(testBTCAddress), "WhiteListedAt should return a zero time for a non-whitelisted address")
+}
+
+func TestSetWhiteListedTimes(t *testing.T) {
+	t.Parallel()
+	whiteListedAt := time.Now().Add(-time.Hour)
+	b := Base{
+		Addresses: []Address{
+			{
+				Address:       core.BitcoinDonationAddress,
+				WhiteListed:   true,
+				WhiteListedAt: whiteListedAt,
+			},
+			{
+				Address:     testBTCAddress,
+				WhiteListed: true,
+			},
+			{
+				Address: testLTCAddress,
+			},
+		},
+	}
+	now := time.Now()
+	b.SetWhiteListedTimes(now)
+	assert.Equal(t, whiteListedAt, b.Addresses[0].WhiteListedAt, "SetWhiteListedTimes should not change an existing whitelisted time")
+	assert.Equal(t, now, b.Addresses[1].WhiteListedAt, "SetWhiteListedTimes should set a missing whitelisted time")
+	assert.Zero(t, b.Addresses[2].WhiteListedAt, "SetWhiteListedTimes should not set a time on a non-whitelisted address")
+}
@@ -27,6 +27,7 @@ type Address struct {
 	Balance            float64
 	Description        string
 	WhiteListed        bool
+	WhiteListedAt      time.Time `json:",omitzero"`
 	ColdStorage        bool
 	SupportedExchanges string
 }
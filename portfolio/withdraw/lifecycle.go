@@ -0,0 +1,37 @@
+package withdraw
+
+import "strings"
+
+// StringToLifecycleStatus maps a descriptive transfer status returned by an
+// exchange to a lifecycle status. Statuses which are not recognised are
+// treated as pending
+func StringToLifecycleStatus(status string) string {
+	s := strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToLower(status))
+	switch {
+	case containsAny(s, "fail", "reject", "cancel", "refund", "error", "expire", "invalid", "denied", "return"):
+		return StatusFailed
+	case containsAny(s, "unconfirmed", "confirming", "confirmation", "waiting for confirm"):
+		return StatusConfirming
+	case containsAny(s, "complete", "success", "settled", "finish", "done", "credited", "confirmed"):
+		return StatusCompleted
+	case containsAny(s, "broadcast", "processing", "withdrawing", "sending", "in progress", "on chain"):
+		return StatusBroadcast
+	default:
+		return StatusPending
+	}
+}
+
+// IsFinalStatus returns whether a lifecycle status is completed or failed,
+// after which a transfer no longer changes
+func IsFinalStatus(status string) bool {
+	return status == StatusCompleted || status == StatusFailed
+}
+
+func containsAny(s string, substrings ...string) bool {
+	for i := range substrings {
+		if strings.Contains(s, substrings[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package withdraw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToLifecycleStatus(t *testing.T) {
+	t.Parallel()
+	for status, expected := range map[string]string{
+		"":                    StatusPending,
+		"Initial":             StatusPending,
+		"AWAITING_APPROVAL":   StatusPending,
+		"Processing":          StatusBroadcast,
+		"in-progress":         StatusBroadcast,
+		"Broadcast":           StatusBroadcast,
+		"unconfirmed":         StatusConfirming,
+		"CONFIRMING":          StatusConfirming,
+		"confirmed":           StatusCompleted,
+		"Success":             StatusCompleted,
+		"Settled":             StatusCompleted,
+		"COMPLETED":           StatusCompleted,
+		"Failure":             StatusFailed,
+		"cancelled":           StatusFailed,
+		"Rejected by review":  StatusFailed,
+		"refunded":            StatusFailed,
+		"withdrawal_canceled": StatusFailed,
+	} {
+		assert.Equalf(t, expected, StringToLifecycleStatus(status), "StringToLifecycleStatus should map %q correctly", status)
+	}
+}
+
+func TestIsFinalStatus(t *testing.T) {
+	t.Parallel()
+	assert.True(t, IsFinalStatus(StatusCompleted), "completed should be final")
+	assert.True(t, IsFinalStatus(StatusFailed), "failed should be final")
+	assert.False(t, IsFinalStatus(StatusConfirming), "confirming should not be final")
+	assert.False(t, IsFinalStatus(StatusPendingApproval), "pending approval should not be final")
+}
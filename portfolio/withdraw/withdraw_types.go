@@ -7,6 +7,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common/cache"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 )
 
@@ -37,6 +38,32 @@ const (
 	ErrStrFeeCannotBeNegative = "fee amount cannot be negative"
 )
 
+// Statuses recorded for requests held or refused by a withdrawal policy
+const (
+	// StatusPendingApproval is the status of a request awaiting approval
+	StatusPendingApproval = "pending_approval"
+	// StatusRejected is the status of a request which was refused by a
+	// withdrawal policy or an approver
+	StatusRejected = "rejected"
+)
+
+// Lifecycle statuses a submitted withdrawal or a deposit moves through,
+// mapped from the statuses returned by exchanges
+const (
+	// StatusPending is the status of a transfer the exchange has not yet sent
+	// to the network
+	StatusPending = "pending"
+	// StatusBroadcast is the status of a transfer sent to the network
+	StatusBroadcast = "broadcast"
+	// StatusConfirming is the status of a transfer awaiting confirmations
+	StatusConfirming = "confirming"
+	// StatusCompleted is the status of a settled transfer
+	StatusCompleted = "completed"
+	// StatusFailed is the status of a transfer which failed, was cancelled or
+	// was refused by the exchange
+	StatusFailed = "failed"
+)
+
 var (
 	// ErrRequestCannotBeNil message to return when a request is nil
 	ErrRequestCannotBeNil = errors.New("request cannot be nil")
@@ -90,6 +117,8 @@ type Request struct {
 	Description string        `json:"description"`
 	Amount      float64       `json:"amount"`
 	Type        RequestType   `json:"type"`
+	// Asset is the asset type the funds are withdrawn from, spot when unset
+	Asset asset.Item `json:"asset,omitempty"`
 
 	// Used exclusively in Binance.US
 	ClientOrderID string `json:"clientID"`
@@ -101,6 +130,10 @@ type Request struct {
 	OneTimePassword int64
 	PIN             int64
 
+	// ConfirmationCode is a TOTP code confirming the request, required when
+	// a withdrawal policy is enforced
+	ConfirmationCode string `json:"-"`
+
 	Crypto CryptoRequest `json:"crypto"`
 	Fiat   FiatRequest   `json:"fiat"`
 }
@@ -122,4 +155,7 @@ type ExchangeResponse struct {
 	UUID   uuid.UUID
 	ID     string `json:"id"`
 	Status string `json:"status"`
+	// TxID is the hash of the transaction which broadcast the transfer
+	TxID          string `json:"tx_id,omitempty"`
+	Confirmations int64  `json:"confirmations,omitempty"`
 }